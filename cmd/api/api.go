@@ -2,10 +2,14 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/httputil"
+	"net/smtp"
 	"net/url"
 	"strings"
 	"time"
@@ -24,7 +28,16 @@ import (
 	apiv1 "github.com/sourcepods/sourcepods/pkg/api/v1"
 	"github.com/sourcepods/sourcepods/pkg/authorization"
 	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/event"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/importer"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/issue"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/lfs"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/markup"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/mirror"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/notification"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pullrequest"
 	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/status"
 	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
 	"github.com/sourcepods/sourcepods/pkg/storage"
 	"github.com/uber/jaeger-client-go"
@@ -33,22 +46,38 @@ import (
 )
 
 type apiConf struct {
-	HTTPAddr        string
-	HTTPPrivateAddr string
-	APIPrefix       string
-	DatabaseDriver  string
-	DatabaseDSN     string
-	LogJSON         bool
-	LogLevel        string
-	StorageGRPCURL  string
-	StorageHTTPURL  string
-	TracingURL      string
+	Admins               cli.StringSlice
+	DigestInterval       time.Duration
+	HTTPAddr             string
+	HTTPPrivateAddr      string
+	ImportMaxSize        int64
+	ImportTimeout        time.Duration
+	LFSRoot              string
+	LFSSecret            string
+	MirrorCredentialsDir string
+	APIPrefix            string
+	DatabaseDriver       string
+	DatabaseDSN          string
+	LogJSON              bool
+	LogLevel             string
+	SMTPAddr             string
+	SMTPFrom             string
+	SMTPPassword         string
+	SMTPUsername         string
+	StorageGRPCURL       string
+	StorageHTTPURL       string
+	TracingURL           string
 }
 
 var (
 	apiConfig = apiConf{}
 
 	apiFlags = []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  cmd.FlagAdmins,
+			Usage: "The usernames of the admins, who can see and export all events",
+			Value: &apiConfig.Admins,
+		},
 		cli.StringFlag{
 			Name:        cmd.FlagAPIPrefix,
 			Usage:       "The prefix the api is serving from, default: /",
@@ -67,6 +96,12 @@ var (
 			Usage:       "The database connection data",
 			Destination: &apiConfig.DatabaseDSN,
 		},
+		cli.DurationFlag{
+			Name:        cmd.FlagDigestInterval,
+			Usage:       "How often users are emailed a digest of their notifications",
+			Value:       time.Hour,
+			Destination: &apiConfig.DigestInterval,
+		},
 		cli.StringFlag{
 			Name:        cmd.FlagHTTPAddr,
 			Usage:       "The address SourcePods API runs on",
@@ -79,6 +114,32 @@ var (
 			Value:       ":3021",
 			Destination: &apiConfig.HTTPPrivateAddr,
 		},
+		cli.Int64Flag{
+			Name:        cmd.FlagImportMaxSize,
+			Usage:       "The most bytes a repository imported from an external URL may take, 0 uses the storage's default",
+			Destination: &apiConfig.ImportMaxSize,
+		},
+		cli.DurationFlag{
+			Name:        cmd.FlagImportTimeout,
+			Usage:       "How long importing a repository from an external URL may take, 0 uses the storage's default",
+			Destination: &apiConfig.ImportTimeout,
+		},
+		cli.StringFlag{
+			Name:        cmd.FlagLFSRoot,
+			Usage:       "The folder to store Git LFS objects in, Git LFS is disabled if empty",
+			Destination: &apiConfig.LFSRoot,
+		},
+		cli.StringFlag{
+			Name:        cmd.FlagLFSSecret,
+			Usage:       "The secret to sign the tokens of Git LFS requests with, shared with the ssh server",
+			EnvVar:      cmd.EnvLFSSecret,
+			Destination: &apiConfig.LFSSecret,
+		},
+		cli.StringFlag{
+			Name:        cmd.FlagMirrorCredentialsDir,
+			Usage:       "The directory with a directory per owner with a file per credentials their mirrors reference, holding username:password or a token",
+			Destination: &apiConfig.MirrorCredentialsDir,
+		},
 		cli.BoolFlag{
 			Name:        cmd.FlagLogJSON,
 			Usage:       "The logger will log json lines",
@@ -90,6 +151,28 @@ var (
 			Value:       "info",
 			Destination: &apiConfig.LogLevel,
 		},
+		cli.StringFlag{
+			Name:        cmd.FlagSMTPAddr,
+			Usage:       "The address of the SMTP server to email notifications with, like localhost:1025 for MailHog. Emails are disabled if empty",
+			Destination: &apiConfig.SMTPAddr,
+		},
+		cli.StringFlag{
+			Name:        cmd.FlagSMTPFrom,
+			Usage:       "The address notifications are emailed from",
+			Value:       "SourcePods <notifications@localhost>",
+			Destination: &apiConfig.SMTPFrom,
+		},
+		cli.StringFlag{
+			Name:        cmd.FlagSMTPUsername,
+			Usage:       "The username to authenticate with the SMTP server, no authentication if empty",
+			Destination: &apiConfig.SMTPUsername,
+		},
+		cli.StringFlag{
+			Name:        cmd.FlagSMTPPassword,
+			Usage:       "The password to authenticate with the SMTP server",
+			EnvVar:      cmd.EnvSMTPPassword,
+			Destination: &apiConfig.SMTPPassword,
+		},
 		cli.StringFlag{
 			Name:        cmd.FlagStorageGRPCURL,
 			Usage:       "The storage's gprc url to connect with",
@@ -143,9 +226,17 @@ func apiAction(c *cli.Context) error {
 	// Stores
 	//
 	var (
-		repositories repository.Store
-		sessions     session.Store
-		users        user.Store
+		events        event.Store
+		imports       importer.Store
+		issues        issue.Store
+		lfsStore      lfs.Store
+		mirrors       mirror.Store
+		notifications notification.Store
+		pullRequests  pullrequest.Store
+		repositories  repository.Store
+		sessions      session.Store
+		statuses      status.Store
+		users         user.Store
 	)
 
 	switch apiConfig.DatabaseDriver {
@@ -157,8 +248,16 @@ func apiAction(c *cli.Context) error {
 		defer db.Close()
 
 		users = user.NewPostgresStore(db)
+		events = event.NewPostgresStore(db)
 		sessions = session.NewPostgresStore(db)
 		repositories = repository.NewPostgresStore(db)
+		pullRequests = pullrequest.NewPostgresStore(db)
+		issues = issue.NewPostgresStore(db)
+		statuses = status.NewPostgresStore(db)
+		imports = importer.NewPostgresStore(db)
+		mirrors = mirror.NewPostgresStore(db)
+		lfsStore = lfs.NewPostgresStore(db)
+		notifications = notification.NewPostgresStore(db)
 	}
 
 	//
@@ -193,15 +292,139 @@ func apiAction(c *cli.Context) error {
 	us = user.NewLoggingService(us, api.GetRequestID, log.WithPrefix(logger, "service", "user"))
 	us = user.NewTracingService(us, api.GetRequestID)
 
+	// Stats of repositories that aren't pushed to are computed again hourly,
+	// in case a push has been missed.
+	stats := repository.NewStatsCache(time.Hour)
+
 	var rs repository.Service
-	rs = repository.NewService(repositories, storageClient)
+	rs = repository.NewService(repositories, storageClient, stats, statuses.(repository.Protections))
 	rs = repository.NewLoggingService(rs, api.GetRequestID, log.WithPrefix(logger, "service", "repository"))
 	rs = repository.NewTracingService(rs, api.GetRequestID)
 
+	var es event.Service
+	es = event.NewService(events, rs, us, apiConfig.Admins)
+	es = event.NewLoggingService(es, api.GetRequestID, log.WithPrefix(logger, "service", "event"))
+	es = event.NewTracingService(es, api.GetRequestID)
+
+	var ns notification.Service
+	ns = notification.NewService(notifications, repositories, us)
+	ns = notification.NewLoggingService(ns, api.GetRequestID, log.WithPrefix(logger, "service", "notification"))
+	ns = notification.NewTracingService(ns, api.GetRequestID)
+
+	es = notification.NewEventService(es, ns)
+
+	rs = event.NewRepositoryService(rs, es)
+	as = event.NewAuthorizationService(as, es)
+
+	var sts status.Service
+	sts = status.NewService(statuses, rs)
+	sts = status.NewLoggingService(sts, api.GetRequestID, log.WithPrefix(logger, "service", "status"))
+	sts = status.NewTracingService(sts, api.GetRequestID)
+
+	var ps pullrequest.Service
+	ps = pullrequest.NewService(pullRequests, rs, us, storageClient, sts)
+	ps = pullrequest.NewLoggingService(ps, api.GetRequestID, log.WithPrefix(logger, "service", "pullrequest"))
+	ps = pullrequest.NewTracingService(ps, api.GetRequestID)
+	ps = event.NewPullRequestService(ps, rs, es)
+
+	var is issue.Service
+	is = issue.NewService(issues, rs, us)
+	is = issue.NewLoggingService(is, api.GetRequestID, log.WithPrefix(logger, "service", "issue"))
+	is = issue.NewTracingService(is, api.GetRequestID)
+	is = event.NewIssueService(is, rs, es)
+
+	var mks markup.Service
+	mks = markup.NewService(us, rs, is, ps)
+	mks = markup.NewLoggingService(mks, api.GetRequestID, log.WithPrefix(logger, "service", "markup"))
+	mks = markup.NewTracingService(mks, api.GetRequestID)
+
+	var ims importer.Service
+	ims = importer.NewService(imports, rs, storageClient, importer.Limits{
+		Timeout: apiConfig.ImportTimeout,
+		MaxSize: apiConfig.ImportMaxSize,
+	}, log.WithPrefix(logger, "service", "importer"))
+	ims = importer.NewLoggingService(ims, api.GetRequestID, log.WithPrefix(logger, "service", "importer"))
+	ims = importer.NewTracingService(ims, api.GetRequestID)
+
+	// Imports only run in the process that started them, those of a previous process never finish.
+	interrupted, err := ims.FailInterrupted(context.Background())
+	if err != nil {
+		return err
+	}
+	if interrupted > 0 {
+		level.Info(logger).Log("msg", "failed interrupted imports", "count", interrupted)
+	}
+
+	scheduler := mirror.NewScheduler(
+		mirrors,
+		storageClient,
+		mirror.CredentialsDir(apiConfig.MirrorCredentialsDir),
+		apiMetrics.MirrorLag,
+		log.WithPrefix(logger, "service", "mirror"),
+	)
+
+	var ms mirror.Service
+	ms = mirror.NewService(mirrors, rs, mirror.CredentialsDir(apiConfig.MirrorCredentialsDir), scheduler)
+	ms = mirror.NewLoggingService(ms, api.GetRequestID, log.WithPrefix(logger, "service", "mirror"))
+	ms = mirror.NewTracingService(ms, api.GetRequestID)
+
+	var mailer *notification.Mailer
+	if apiConfig.SMTPAddr != "" {
+		sender := notification.SMTPSender{Addr: apiConfig.SMTPAddr}
+		if apiConfig.SMTPUsername != "" {
+			host, _, err := net.SplitHostPort(apiConfig.SMTPAddr)
+			if err != nil {
+				return err
+			}
+			sender.Auth = smtp.PlainAuth("", apiConfig.SMTPUsername, apiConfig.SMTPPassword, host)
+		}
+
+		mailer, err = notification.NewMailer(
+			notifications,
+			sender,
+			apiConfig.SMTPFrom,
+			apiConfig.DigestInterval,
+			log.WithPrefix(logger, "service", "notification"),
+		)
+		if err != nil {
+			return err
+		}
+	} else {
+		level.Info(logger).Log("msg", "notification emails are disabled, no smtp address given")
+	}
+
+	var lfsHandler http.Handler
+	if apiConfig.LFSRoot != "" {
+		objects, err := lfs.NewLocalObjectStore(apiConfig.LFSRoot)
+		if err != nil {
+			return err
+		}
+
+		secret := apiConfig.LFSSecret
+		if secret == "" {
+			level.Warn(logger).Log("msg", "no lfs secret given, lfs tokens won't be valid after restarts")
+			b := make([]byte, 32)
+			if _, err := rand.Read(b); err != nil {
+				return err
+			}
+			secret = hex.EncodeToString(b)
+		}
+		tokens := lfs.NewTokens(secret)
+
+		var ls lfs.Service
+		ls = lfs.NewService(lfsStore, objects, rs)
+		ls = lfs.NewLoggingService(ls, api.GetRequestID, log.WithPrefix(logger, "service", "lfs"))
+		ls = lfs.NewTracingService(ls, api.GetRequestID)
+
+		lfsHandler = lfs.Authenticate(tokens, us, as)(lfs.NewHandler(ls, tokens))
+	} else {
+		level.Info(logger).Log("msg", "git lfs is disabled, no lfs root given")
+	}
+
 	//
 	// OpenAPI
 	//
-	openapi, err := apiv1.New(rs, us)
+	openapi, err := apiv1.New(rs, us, ps, is, sts, es, ims, ms, ns, mks)
 	if err != nil {
 		return err
 	}
@@ -222,10 +445,27 @@ func apiAction(c *cli.Context) error {
 			router.Group(func(router chi.Router) {
 				router.Use(session.Authorized(ss))
 				router.Mount("/sessions", session.NewHandler(ss))
+				router.Mount("/v1/events/export", event.NewExportHandler(es))
+
+				// Renamed and transferred repositories redirect from their old owner/name.
+				router.Group(func(router chi.Router) {
+					router.Use(repository.Redirect(rs))
+					router.Mount("/v1/repositories/{owner}/{name}/archive", repository.NewArchiveHandler(rs))
+					router.Mount("/v1/repositories/{owner}/{name}/blame", repository.NewBlameHandler(rs))
+					router.Mount("/v1/repositories/{owner}/{name}", middleware.NoCache(openapi.Handler))
+				})
+
 				router.Mount("/v1", middleware.NoCache(openapi.Handler))
 			})
 
-			router.Mount("/{owner}/{name}.git", githttp)
+			router.Route("/{owner}/{name}.git", func(router chi.Router) {
+				router.Use(repository.Redirect(rs))
+				if lfsHandler != nil {
+					router.Mount("/info/lfs", lfsHandler)
+				}
+				router.With(repository.GitAccess(rs, as)).Mount("/", githttp)
+			})
+			router.With(session.Optional(ss), repository.Redirect(rs)).Mount("/{owner}/{name}/raw", repository.NewRawHandler(rs))
 		})
 
 		if apiConfig.APIPrefix != "/" {
@@ -273,6 +513,42 @@ func apiAction(c *cli.Context) error {
 		}, func(err error) {
 		})
 	}
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		gr.Add(func() error {
+			level.Info(logger).Log("msg", "starting to record pushes")
+			return event.RecordPushes(ctx, storageClient, repositories, es, log.WithPrefix(logger, "service", "event"))
+		}, func(err error) {
+			cancel()
+		})
+	}
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		gr.Add(func() error {
+			level.Info(logger).Log("msg", "starting to invalidate stats of pushed repositories")
+			return stats.Run(ctx, storageClient, log.WithPrefix(logger, "service", "repository"))
+		}, func(err error) {
+			cancel()
+		})
+	}
+	if mailer != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		gr.Add(func() error {
+			level.Info(logger).Log("msg", "starting to email notification digests", "interval", apiConfig.DigestInterval)
+			return mailer.Run(ctx)
+		}, func(err error) {
+			cancel()
+		})
+	}
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		gr.Add(func() error {
+			level.Info(logger).Log("msg", "starting mirror scheduler")
+			return scheduler.Run(ctx)
+		}, func(err error) {
+			cancel()
+		})
+	}
 	{
 		gr.Add(func() error {
 			level.Info(logger).Log(
@@ -323,6 +599,7 @@ type APIMetrics struct {
 	LoginAttempts   metrics.Counter
 	SessionsCreated metrics.Counter
 	SessionsCleared metrics.Counter
+	MirrorLag       metrics.Gauge
 }
 
 func apiMetrics() *APIMetrics {
@@ -347,6 +624,12 @@ func apiMetrics() *APIMetrics {
 			Name:      "cleared_total",
 			Help:      "Number of cleared sessions",
 		}, []string{}),
+		MirrorLag: prometheus.NewGaugeFrom(prom.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "mirrors",
+			Name:      "lag_seconds",
+			Help:      "Seconds since mirrors were last synced successfully",
+		}, []string{"repository", "direction"}),
 	}
 }
 
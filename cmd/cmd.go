@@ -9,25 +9,41 @@ import (
 )
 
 const (
-	FlagAPIPrefix       = "api-prefix"
-	FlagAPIURL          = "api-url"
-	FlagDatabaseDriver  = "database-driver"
-	FlagDatabaseDSN     = "database-dsn"
-	FlagGRPCAddr        = "grpc-addr"
-	FlagHTTPAddr        = "http-addr"
-	FlagHTTPPrivateAddr = "http-private-addr"
-	FlagLogJSON         = "log-json"
-	FlagLogLevel        = "log-level"
-	FlagMigrationsPath  = "migrations-path"
-	FlagRoot            = "root"
-	FlagSSHAddr         = "ssh-addr"
-	FlagSSHHostKeyPath  = "ssh-host-key"
-	FlagStorageGRPCURL  = "storage-grpc-url"
-	FlagStorageHTTPURL  = "storage-http-url"
-	FlagTracingURL      = "tracing-url"
+	FlagAdmins               = "admins"
+	FlagAPIPrefix            = "api-prefix"
+	FlagAPIURL               = "api-url"
+	FlagDatabaseDriver       = "database-driver"
+	FlagDatabaseDSN          = "database-dsn"
+	FlagDigestInterval       = "digest-interval"
+	FlagGRPCAddr             = "grpc-addr"
+	FlagHTTPAddr             = "http-addr"
+	FlagHTTPPrivateAddr      = "http-private-addr"
+	FlagImportMaxSize        = "import-max-size"
+	FlagImportTimeout        = "import-timeout"
+	FlagLFSRoot              = "lfs-root"
+	FlagLFSSecret            = "lfs-secret"
+	FlagLogJSON              = "log-json"
+	FlagLogLevel             = "log-level"
+	FlagMaintenanceInterval  = "maintenance-interval"
+	FlagMigrationsPath       = "migrations-path"
+	FlagMirrorCredentialsDir = "mirror-credentials-dir"
+	FlagRoot                 = "root"
+	FlagSMTPAddr             = "smtp-addr"
+	FlagSMTPFrom             = "smtp-from"
+	FlagSMTPPassword         = "smtp-password"
+	FlagSMTPUsername         = "smtp-username"
+	FlagSSHAddr              = "ssh-addr"
+	FlagSSHHostKeyPath       = "ssh-host-key"
+	FlagStorageGRPCURL       = "storage-grpc-url"
+	FlagStorageHTTPURL       = "storage-http-url"
+	FlagTracingURL           = "tracing-url"
 
 	//EnvDatabaseDSN is the data source name string to connect to the database with
 	EnvDatabaseDSN = "GITPODS_DATABASE_DSN"
+	//EnvLFSSecret is the secret to sign the tokens of Git LFS requests with
+	EnvLFSSecret = "GITPODS_LFS_SECRET"
+	//EnvSMTPPassword is the password to authenticate with the SMTP server
+	EnvSMTPPassword = "GITPODS_SMTP_PASSWORD"
 )
 
 func NewLogger(json bool, loglevel string) log.Logger {
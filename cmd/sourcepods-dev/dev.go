@@ -52,6 +52,15 @@ var (
 			Usage: "The address to run the API on",
 			Value: ":3020",
 		},
+		cli.StringFlag{
+			Name:  "smtp-addr",
+			Usage: "The address of a local SMTP server like MailHog to email notifications to, e.g. localhost:1025",
+		},
+		cli.DurationFlag{
+			Name:  "digest-interval",
+			Usage: "How often notification digests are emailed",
+			Value: time.Minute,
+		},
 		// Storage
 		cli.StringFlag{
 			Name:  "storage-addr",
@@ -82,6 +91,9 @@ var (
 	}
 )
 
+// devLFSSecret signs the Git LFS tokens of the api in development.
+const devLFSSecret = "sourcepods-dev"
+
 func devAction(c *cli.Context) error {
 	// Global
 	databaseDSNFlag := c.String("database-dsn")
@@ -92,6 +104,8 @@ func devAction(c *cli.Context) error {
 
 	// API
 	apiAddrFlag := c.String("api-addr")
+	smtpAddrFlag := c.String("smtp-addr")
+	digestIntervalFlag := c.Duration("digest-interval")
 
 	// Storage
 	storageAddrFlag := c.String("storage-addr")
@@ -130,8 +144,12 @@ func devAction(c *cli.Context) error {
 
 	apiRunner := NewRunner("api", []string{
 		fmt.Sprintf("%s=%s", cmd.EnvDatabaseDSN, databaseDSNFlag),
+		fmt.Sprintf("%s=%s", cmd.EnvLFSSecret, devLFSSecret),
 	}, []string{
+		fmt.Sprintf("--%s=%s", cmd.FlagDigestInterval, digestIntervalFlag),
 		fmt.Sprintf("--%s=%s", cmd.FlagHTTPAddr, apiAddrFlag),
+		fmt.Sprintf("--%s=%s", cmd.FlagLFSRoot, "./dev/lfs"),
+		fmt.Sprintf("--%s=%s", cmd.FlagSMTPAddr, smtpAddrFlag),
 		fmt.Sprintf("--%s=%s", cmd.FlagLogLevel, loglevelFlag),
 		fmt.Sprintf("--%s=%s", cmd.FlagStorageGRPCURL, "localhost:3033"),
 		fmt.Sprintf("--%s=%s", cmd.FlagStorageHTTPURL, "http://localhost:3030"),
@@ -147,7 +165,9 @@ func devAction(c *cli.Context) error {
 		fmt.Sprintf("--%s=%v", cmd.FlagLogJSON, logJSONFlag),
 	})
 
-	sshRunner := NewRunner("ssh", []string{}, []string{
+	sshRunner := NewRunner("ssh", []string{
+		fmt.Sprintf("%s=%s", cmd.EnvDatabaseDSN, databaseDSNFlag),
+	}, []string{
 		fmt.Sprintf("--%s=%s", cmd.FlagLogLevel, loglevelFlag),
 		fmt.Sprintf("--%s=%s", cmd.FlagSSHAddr, sshAddrFlag),
 		fmt.Sprintf("--%s=%s", cmd.FlagSSHHostKeyPath, "./dev/keys/"),
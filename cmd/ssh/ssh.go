@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"os"
 	"os/signal"
@@ -9,8 +10,11 @@ import (
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
+	_ "github.com/lib/pq"
 	"github.com/oklog/run"
 	"github.com/sourcepods/sourcepods/cmd"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/status"
 	"github.com/sourcepods/sourcepods/pkg/ssh"
 	"github.com/sourcepods/sourcepods/pkg/storage"
 	jaeger "github.com/uber/jaeger-client-go"
@@ -19,6 +23,7 @@ import (
 )
 
 type sshConf struct {
+	DatabaseDSN    string
 	HostKeyPath    string
 	LogJSON        bool
 	LogLevel       string
@@ -30,6 +35,12 @@ type sshConf struct {
 var (
 	sshConfig = sshConf{}
 	sshFlags  = []cli.Flag{
+		cli.StringFlag{
+			Name:        cmd.FlagDatabaseDSN,
+			EnvVar:      cmd.EnvDatabaseDSN,
+			Usage:       "The database connection data, to find the repositories to serve",
+			Destination: &sshConfig.DatabaseDSN,
+		},
 		cli.StringFlag{
 			Name:        cmd.FlagSSHAddr,
 			Value:       ":3022",
@@ -110,9 +121,18 @@ func sshAction(c *cli.Context) error {
 		return err
 	}
 
+	db, err := sql.Open("postgres", sshConfig.DatabaseDSN)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	// The ssh server only finds repositories, the stats aren't used.
+	rs := repository.NewService(repository.NewPostgresStore(db), storageClient, repository.NewStatsCache(time.Hour), status.NewPostgresStore(db))
+
 	var gr run.Group
 	{
-		sig := make(chan os.Signal)
+		sig := make(chan os.Signal, 1)
 		gr.Add(func() error {
 			signal.Notify(sig, os.Interrupt)
 			<-sig
@@ -122,7 +142,7 @@ func sshAction(c *cli.Context) error {
 		})
 	}
 	{
-		ss := ssh.NewServer(sshConfig.SSHAddr, sshConfig.HostKeyPath, logger, storageClient)
+		ss := ssh.NewServer(sshConfig.SSHAddr, sshConfig.HostKeyPath, logger, storageClient, rs)
 		gr.Add(func() error {
 			level.Info(logger).Log(
 				"msg", "starting SourcePods git-ssh server",
@@ -22,12 +22,13 @@ import (
 )
 
 type storageConf struct {
-	GRPCAddr   string
-	HTTPAddr   string
-	LogJSON    bool
-	LogLevel   string
-	Root       string
-	TracingURL string
+	GRPCAddr            string
+	HTTPAddr            string
+	LogJSON             bool
+	LogLevel            string
+	MaintenanceInterval time.Duration
+	Root                string
+	TracingURL          string
 }
 
 var (
@@ -55,6 +56,12 @@ var (
 			Value:       "info",
 			Destination: &storageConfig.LogLevel,
 		},
+		cli.DurationFlag{
+			Name:        cmd.FlagMaintenanceInterval,
+			Usage:       "How often all repositories are checked for maintenance, pushed ones are checked right away",
+			Value:       24 * time.Hour,
+			Destination: &storageConfig.MaintenanceInterval,
+		},
 		cli.StringFlag{
 			Name:        cmd.FlagRoot,
 			Usage:       "The root folder to store all git repositories in",
@@ -112,7 +119,22 @@ func storageAction(c *cli.Context) error {
 		root = filepath.Join(wd, root)
 	}
 
-	gitStorage, err := storage.NewLocalStorage(storageConfig.Root, storage.LoggerOption(logger))
+	if storageConfig.MaintenanceInterval <= 0 {
+		return errors.New("the maintenance interval has to be positive")
+	}
+
+	indexer := storage.NewIndexer(log.WithPrefix(logger, "component", "indexer"))
+	maintainer := storage.NewMaintainer(storageConfig.MaintenanceInterval, log.WithPrefix(logger, "component", "maintainer"))
+	events := storage.NewEvents()
+
+	gitStorage, err := storage.NewLocalStorage(storageConfig.Root,
+		storage.LoggerOption(logger),
+		storage.PostReceiveOption(func(id string) {
+			indexer.Enqueue(id)
+			maintainer.Enqueue(id)
+		}),
+		storage.EventsOption(events),
+	)
 	if err != nil {
 		return err
 	}
@@ -124,7 +146,7 @@ func storageAction(c *cli.Context) error {
 
 	var gr run.Group
 	{
-		sig := make(chan os.Signal)
+		sig := make(chan os.Signal, 1)
 		gr.Add(func() error {
 			signal.Notify(sig, os.Interrupt)
 			<-sig
@@ -133,6 +155,27 @@ func storageAction(c *cli.Context) error {
 			close(sig)
 		})
 	}
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		gr.Add(func() error {
+			level.Info(logger).Log("msg", "starting search indexer")
+			return indexer.Run(ctx, gitStorage)
+		}, func(err error) {
+			cancel()
+		})
+	}
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		gr.Add(func() error {
+			level.Info(logger).Log(
+				"msg", "starting repository maintainer",
+				"interval", storageConfig.MaintenanceInterval,
+			)
+			return maintainer.Run(ctx, gitStorage)
+		}, func(err error) {
+			cancel()
+		})
+	}
 	{
 		gh := NewGitHTTP(storageConfig.Root)
 		gh.Logger = logger
@@ -163,7 +206,7 @@ func storageAction(c *cli.Context) error {
 		})
 	}
 	{
-		gs := storage.NewStorageServer(gitStorage)
+		gs := storage.NewStorageServer(gitStorage, events, log.WithPrefix(logger, "component", "grpc"))
 		gr.Add(func() error {
 			level.Info(logger).Log(
 				"msg", "starting SourcePods storage grpc server",
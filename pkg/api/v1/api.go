@@ -1,7 +1,13 @@
 package v1
 
 import (
+	"context"
+	"fmt"
+	"html"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/runtime/middleware"
@@ -9,11 +15,31 @@ import (
 	"github.com/sourcepods/sourcepods/pkg/api/v1/models"
 	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi"
 	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/events"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/issues"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/markdown"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/mirrors"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/notifications"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/pullrequests"
 	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/repositories"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/search"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/stars"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/statuses"
 	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/users"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/watching"
 	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/event"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/importer"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/issue"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/markup"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/mirror"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/notification"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pullrequest"
 	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/status"
 	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+	"github.com/sourcepods/sourcepods/pkg/storage"
 )
 
 // API has the http.Handler for the OpenAPI implementation
@@ -22,7 +48,7 @@ type API struct {
 }
 
 // New creates a new API that adds our own Handler implementations
-func New(rs repository.Service, us user.Service) (*API, error) {
+func New(rs repository.Service, us user.Service, ps pullrequest.Service, is issue.Service, ss status.Service, es event.Service, ims importer.Service, ms mirror.Service, ns notification.Service, mks markup.Service) (*API, error) {
 	swaggerSpec, err := loads.Analyzed(restapi.SwaggerJSON, "")
 	if err != nil {
 		return nil, err
@@ -34,21 +60,118 @@ func New(rs repository.Service, us user.Service) (*API, error) {
 		return middleware.Spec("", nil, sourcepodsAPI.Context().RoutesHandler(b))
 	}
 
+	sourcepodsAPI.EventsListEventsHandler = ListEventsHandler(es)
+	sourcepodsAPI.EventsListRepositoryEventsHandler = ListRepositoryEventsHandler(es)
+	sourcepodsAPI.EventsListUserEventsHandler = ListUserEventsHandler(es)
+	sourcepodsAPI.IssuesCreateIssueHandler = CreateIssueHandler(is)
+	sourcepodsAPI.IssuesCreateIssueCommentHandler = CreateIssueCommentHandler(is)
+	sourcepodsAPI.IssuesCreateLabelHandler = CreateLabelHandler(is)
+	sourcepodsAPI.IssuesCreateMilestoneHandler = CreateMilestoneHandler(is)
+	sourcepodsAPI.IssuesDeleteLabelHandler = DeleteLabelHandler(is)
+	sourcepodsAPI.IssuesGetIssueHandler = GetIssueHandler(is)
+	sourcepodsAPI.IssuesListIssueCommentsHandler = ListIssueCommentsHandler(is)
+	sourcepodsAPI.IssuesListIssuesHandler = ListIssuesHandler(is)
+	sourcepodsAPI.IssuesListLabelsHandler = ListLabelsHandler(is)
+	sourcepodsAPI.IssuesListMilestonesHandler = ListMilestonesHandler(is)
+	sourcepodsAPI.IssuesUpdateIssueHandler = UpdateIssueHandler(is)
+	sourcepodsAPI.MarkdownRenderMarkdownHandler = RenderMarkdownHandler(mks)
+	sourcepodsAPI.MirrorsCreateRepositoryMirrorHandler = CreateRepositoryMirrorHandler(ms)
+	sourcepodsAPI.MirrorsDeleteRepositoryMirrorHandler = DeleteRepositoryMirrorHandler(ms)
+	sourcepodsAPI.MirrorsListRepositoryMirrorsHandler = ListRepositoryMirrorsHandler(ms)
+	sourcepodsAPI.MirrorsSyncRepositoryMirrorHandler = SyncRepositoryMirrorHandler(ms)
+	sourcepodsAPI.NotificationsGetNotificationPreferencesHandler = GetNotificationPreferencesHandler(ns)
+	sourcepodsAPI.NotificationsListNotificationsHandler = ListNotificationsHandler(ns)
+	sourcepodsAPI.NotificationsMarkNotificationReadHandler = MarkNotificationReadHandler(ns)
+	sourcepodsAPI.NotificationsMarkNotificationsReadHandler = MarkNotificationsReadHandler(ns)
+	sourcepodsAPI.NotificationsUpdateNotificationPreferencesHandler = UpdateNotificationPreferencesHandler(ns)
+	sourcepodsAPI.PullrequestsCreatePullRequestHandler = CreatePullRequestHandler(ps)
+	sourcepodsAPI.PullrequestsCreatePullRequestCommentHandler = CreatePullRequestCommentHandler(ps)
+	sourcepodsAPI.PullrequestsGetPullRequestHandler = GetPullRequestHandler(ps)
+	sourcepodsAPI.PullrequestsGetPullRequestCommitsHandler = GetPullRequestCommitsHandler(ps)
+	sourcepodsAPI.PullrequestsGetPullRequestDiffHandler = GetPullRequestDiffHandler(ps)
+	sourcepodsAPI.PullrequestsListPullRequestCommentsHandler = ListPullRequestCommentsHandler(ps)
+	sourcepodsAPI.PullrequestsListPullRequestsHandler = ListPullRequestsHandler(ps)
+	sourcepodsAPI.PullrequestsUpdatePullRequestHandler = UpdatePullRequestHandler(ps)
+	sourcepodsAPI.PullrequestsMergePullRequestHandler = MergePullRequestHandler(ps)
 	sourcepodsAPI.RepositoriesCreateRepositoryHandler = CreateRepositoryHandler(rs)
+	sourcepodsAPI.RepositoriesCreateRepositoryBranchHandler = CreateRepositoryBranchHandler(rs)
+	sourcepodsAPI.RepositoriesDeleteRepositoryBranchHandler = DeleteRepositoryBranchHandler(rs)
 	sourcepodsAPI.RepositoriesGetOwnerRepositoriesHandler = GetOwnerRepositoriesHandler(rs)
-	sourcepodsAPI.RepositoriesGetRepositoryBranchesHandler = GetRepositoryBranchesHandler(rs)
+	sourcepodsAPI.RepositoriesGetRepositoryBranchesHandler = GetRepositoryBranchesHandler(rs, ss)
 	sourcepodsAPI.RepositoriesGetRepositoryHandler = GetRepositoryHandler(rs)
+	sourcepodsAPI.RepositoriesGetRepositoryReadmeHandler = GetRepositoryReadmeHandler(rs, mks)
 	sourcepodsAPI.RepositoriesGetRepositoryTreeHandler = GetRepositoryTreeHandler(rs)
+	sourcepodsAPI.RepositoriesRenameRepositoryBranchHandler = RenameRepositoryBranchHandler(rs)
+	sourcepodsAPI.RepositoriesSearchRepositoryHandler = SearchRepositoryHandler(rs)
+	sourcepodsAPI.RepositoriesDeleteRepositoryHandler = DeleteRepositoryHandler(rs)
+	sourcepodsAPI.RepositoriesForkRepositoryHandler = ForkRepositoryHandler(rs)
+	sourcepodsAPI.RepositoriesUpdateRepositoryHandler = UpdateRepositoryHandler(rs)
+	sourcepodsAPI.RepositoriesTransferRepositoryHandler = TransferRepositoryHandler(rs)
+	sourcepodsAPI.RepositoriesGetRepositoryForksHandler = GetRepositoryForksHandler(rs)
+	sourcepodsAPI.RepositoriesGetRepositoryNetworkHandler = GetRepositoryNetworkHandler(rs)
+	sourcepodsAPI.RepositoriesImportRepositoryHandler = ImportRepositoryHandler(ims)
+	sourcepodsAPI.RepositoriesGetRepositoryImportHandler = GetRepositoryImportHandler(ims)
+	sourcepodsAPI.StarsCheckRepositoryStarredHandler = CheckRepositoryStarredHandler(rs)
+	sourcepodsAPI.StarsListStarredRepositoriesHandler = ListStarredRepositoriesHandler(rs)
+	sourcepodsAPI.StarsListUserStarredRepositoriesHandler = ListUserStarredRepositoriesHandler(rs)
+	sourcepodsAPI.StarsStarRepositoryHandler = StarRepositoryHandler(rs)
+	sourcepodsAPI.StarsUnstarRepositoryHandler = UnstarRepositoryHandler(rs)
+	sourcepodsAPI.StatusesCreateStatusHandler = CreateStatusHandler(ss)
+	sourcepodsAPI.StatusesGetBranchProtectionHandler = GetBranchProtectionHandler(ss)
+	sourcepodsAPI.StatusesGetCombinedStatusHandler = GetCombinedStatusHandler(ss)
+	sourcepodsAPI.StatusesListStatusesHandler = ListStatusesHandler(ss)
+	sourcepodsAPI.StatusesUpdateBranchProtectionHandler = UpdateBranchProtectionHandler(ss)
+	sourcepodsAPI.SearchSearchCodeHandler = SearchCodeHandler(rs)
+	sourcepodsAPI.SearchSearchRepositoriesHandler = SearchRepositoriesHandler(rs)
+	sourcepodsAPI.SearchSearchUsersHandler = SearchUsersHandler(us)
 	sourcepodsAPI.UsersGetUserHandler = GetUserHandler(us)
 	sourcepodsAPI.UsersGetUserMeHandler = GetUserMeHandler(us)
 	sourcepodsAPI.UsersListUsersHandler = ListUsersHandler(us)
 	sourcepodsAPI.UsersUpdateUserHandler = UpdateUserHandler(us)
+	sourcepodsAPI.WatchingListRepositoryWatchersHandler = ListRepositoryWatchersHandler(rs)
+	sourcepodsAPI.WatchingUnwatchRepositoryHandler = UnwatchRepositoryHandler(rs)
+	sourcepodsAPI.WatchingWatchRepositoryHandler = WatchRepositoryHandler(rs)
 
 	return &API{
 		Handler: sourcepodsAPI.Serve(nil),
 	}, nil
 }
 
+func pageOptions(cursor *string, perPage *int64) pagination.Options {
+	opts := pagination.Options{PerPage: int(*perPage)}
+	if cursor != nil {
+		opts.Cursor = *cursor
+	}
+	return opts
+}
+
+// nextLink returns the Link header for the page of the next cursor, it's empty without one.
+func nextLink(r *http.Request, next string) string {
+	if next == "" {
+		return ""
+	}
+
+	u := *r.URL
+	query := u.Query()
+	query.Set("cursor", next)
+	u.RawQuery = query.Encode()
+
+	return fmt.Sprintf(`<%s>; rel="next"`, u.RequestURI())
+}
+
+// rawURL returns the URL the raw files of a repository are served at,
+// which is next to the API under the same prefix.
+func rawURL(r *http.Request, owner, name string) string {
+	prefix := r.URL.Path
+	if i := strings.LastIndex(prefix, "/v1/repositories/"); i >= 0 {
+		prefix = prefix[:i]
+	} else {
+		prefix = ""
+	}
+
+	return prefix + (&url.URL{Path: "/" + owner + "/" + name + "/raw"}).EscapedPath()
+}
+
 func convertRepository(r *repository.Repository) *models.Repository {
 	return &models.Repository{
 		ID:            strfmt.UUID(r.ID),
@@ -56,13 +179,67 @@ func convertRepository(r *repository.Repository) *models.Repository {
 		Description:   r.Description,
 		DefaultBranch: r.DefaultBranch,
 		Website:       r.Website,
+		Private:       r.Private,
+		ParentID:      strfmt.UUID(r.ParentID),
 		CreatedAt:     strfmt.DateTime(r.Created),
 		UpdatedAt:     strfmt.DateTime(r.Updated),
+		Topics:        r.Topics,
+		Stars:         int64(r.Stars),
+		Watchers:      int64(r.Watchers),
 
 		//Owner: nil, // TODO: Include via query parameter if wanted
 	}
 }
 
+// convertOwnedRepository converts the repository and includes its owner.
+func convertOwnedRepository(o *repository.OwnedRepository) *models.Repository {
+	r := convertRepository(o.Repository)
+	owner := o.Owner
+	r.Owner = &models.User{
+		ID:       strfmt.UUID(o.OwnerID),
+		Username: &owner,
+	}
+	return r
+}
+
+// repositoryStats returns the converted stats of a repository,
+// they're left out if they can't be computed rather than failing the whole response.
+func repositoryStats(ctx context.Context, rs repository.Service, owner, name string) *models.RepositoryStats {
+	st, err := rs.Stats(ctx, owner, name)
+	if err != nil {
+		return nil
+	}
+
+	stats := &models.RepositoryStats{
+		Size:          st.Size,
+		LooseObjects:  st.LooseObjects,
+		PackedObjects: st.PackedObjects,
+		Commits:       st.Commits,
+		Forks:         int64(st.Forks),
+		Languages:     make([]*models.Language, 0, len(st.Languages)),
+	}
+	for _, l := range st.Languages {
+		name, bytes := l.Name, l.Bytes
+		stats.Languages = append(stats.Languages, &models.Language{Name: &name, Bytes: &bytes})
+	}
+
+	return stats
+}
+
+func convertValidationErrors(v repository.ValidationErrors) *models.ValidationError {
+	message := "The given repository input is invalid"
+	payload := &models.ValidationError{
+		Message: &message,
+	}
+	for _, verr := range v.Errors {
+		payload.Errors = append(payload.Errors, &models.ValidationErrorErrorsItems0{
+			Field:   verr.Field,
+			Message: verr.Error.Error(),
+		})
+	}
+	return payload
+}
+
 //CreateRepositoryHandler creates a new repository from given input
 func CreateRepositoryHandler(rs repository.Service) repositories.CreateRepositoryHandlerFunc {
 	return func(params repositories.CreateRepositoryParams) middleware.Responder {
@@ -73,20 +250,12 @@ func CreateRepositoryHandler(rs repository.Service) repositories.CreateRepositor
 			Name:        *params.NewRepository.Name,
 			Description: params.NewRepository.Description,
 			Website:     params.NewRepository.Website,
+			Private:     params.NewRepository.Private,
+			Topics:      params.NewRepository.Topics,
 		})
 		if err != nil {
 			if v, ok := err.(repository.ValidationErrors); ok {
-				message := "The given repository input is invalid"
-				payload := &models.ValidationError{
-					Message: &message,
-				}
-				for _, verr := range v.Errors {
-					payload.Errors = append(payload.Errors, &models.ValidationErrorErrorsItems0{
-						Field:   verr.Field,
-						Message: verr.Error.Error(),
-					})
-				}
-				return repositories.NewCreateRepositoryUnprocessableEntity().WithPayload(payload)
+				return repositories.NewCreateRepositoryUnprocessableEntity().WithPayload(convertValidationErrors(v))
 			}
 
 			return repositories.NewCreateRepositoryDefault(http.StatusInternalServerError)
@@ -99,7 +268,10 @@ func CreateRepositoryHandler(rs repository.Service) repositories.CreateRepositor
 //GetOwnerRepositoriesHandler gets a repository by the owner's username
 func GetOwnerRepositoriesHandler(rs repository.Service) repositories.GetOwnerRepositoriesHandlerFunc {
 	return func(params repositories.GetOwnerRepositoriesParams) middleware.Responder {
-		list, _, err := rs.List(params.HTTPRequest.Context(), params.Owner)
+		list, next, err := rs.List(params.HTTPRequest.Context(), params.Owner, repository.ListOptions{
+			Sort: *params.Sort,
+			Page: pageOptions(params.Cursor, params.PerPage),
+		})
 		if err != nil {
 			if err == repository.ErrOwnerNotFound {
 				message := "owner not found"
@@ -107,195 +279,2362 @@ func GetOwnerRepositoriesHandler(rs repository.Service) repositories.GetOwnerRep
 					Message: &message,
 				})
 			}
+			if err == pagination.ErrCursorInvalid {
+				message := err.Error()
+				return repositories.NewGetOwnerRepositoriesUnprocessableEntity().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
 			return repositories.NewGetOwnerRepositoriesDefault(http.StatusInternalServerError)
 		}
 
 		var payload []*models.Repository
 		for _, r := range list {
-			payload = append(payload, convertRepository(r))
+			repo := convertRepository(r)
+			repo.Stats = repositoryStats(params.HTTPRequest.Context(), rs, params.Owner, r.Name)
+			payload = append(payload, repo)
 		}
 
-		return repositories.NewGetOwnerRepositoriesOK().WithPayload(payload)
+		return repositories.NewGetOwnerRepositoriesOK().
+			WithLink(nextLink(params.HTTPRequest, next)).
+			WithXNextCursor(next).
+			WithPayload(payload)
 	}
 }
 
-//GetRepositoryBranchesHandler gets all branches of a repository
-func GetRepositoryBranchesHandler(rs repository.Service) repositories.GetRepositoryBranchesHandlerFunc {
-	return func(params repositories.GetRepositoryBranchesParams) middleware.Responder {
-		branches, err := rs.Branches(params.HTTPRequest.Context(), params.Owner, params.Name)
+//DeleteRepositoryHandler deletes a repository of the current user
+func DeleteRepositoryHandler(rs repository.Service) repositories.DeleteRepositoryHandlerFunc {
+	return func(params repositories.DeleteRepositoryParams) middleware.Responder {
+		err := rs.Delete(params.HTTPRequest.Context(), params.Owner, params.Name)
 		if err != nil {
-			if err == repository.ErrRepositoryNotFound {
-				message := "repository not found"
-				return repositories.NewGetRepositoryBranchesNotFound().WithPayload(&models.Error{
+			message := err.Error()
+			switch err {
+			case repository.ErrRepositoryNotFound:
+				return repositories.NewDeleteRepositoryNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrPermissionDenied:
+				return repositories.NewDeleteRepositoryForbidden().WithPayload(&models.Error{
 					Message: &message,
 				})
 			}
+			return repositories.NewDeleteRepositoryDefault(http.StatusInternalServerError)
+		}
 
-			return repositories.NewGetRepositoryBranchesDefault(http.StatusInternalServerError)
+		return repositories.NewDeleteRepositoryNoContent()
+	}
+}
+
+//UpdateRepositoryHandler updates a repository's name, description, website, default branch or topics
+func UpdateRepositoryHandler(rs repository.Service) repositories.UpdateRepositoryHandlerFunc {
+	return func(params repositories.UpdateRepositoryParams) middleware.Responder {
+		update := repository.Update{
+			Name:          params.Update.Name,
+			Description:   params.Update.Description,
+			Website:       params.Update.Website,
+			DefaultBranch: params.Update.DefaultBranch,
+		}
+		if params.Update.Topics != nil {
+			update.Topics = &params.Update.Topics
 		}
 
-		var payload []*models.Branch
+		r, err := rs.Update(params.HTTPRequest.Context(), params.Owner, params.Name, update)
+		if err != nil {
+			if v, ok := err.(repository.ValidationErrors); ok {
+				return repositories.NewUpdateRepositoryUnprocessableEntity().WithPayload(convertValidationErrors(v))
+			}
+			if err == repository.ErrBranchNotFound {
+				return repositories.NewUpdateRepositoryUnprocessableEntity().WithPayload(convertValidationErrors(repository.ValidationErrors{
+					Errors: []repository.ValidationError{{Field: "default_branch", Error: err}},
+				}))
+			}
 
-		for _, b := range branches {
-			payload = append(payload, &models.Branch{
-				Name: b.Name,
-				Sha1: b.Sha1,
-				Type: b.Type,
-			})
+			message := err.Error()
+			switch err {
+			case repository.ErrRepositoryNotFound:
+				return repositories.NewUpdateRepositoryNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrPermissionDenied:
+				return repositories.NewUpdateRepositoryForbidden().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrAlreadyExists:
+				return repositories.NewUpdateRepositoryConflict().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return repositories.NewUpdateRepositoryDefault(http.StatusInternalServerError)
 		}
 
-		return repositories.NewGetRepositoryBranchesOK().WithPayload(payload)
+		return repositories.NewUpdateRepositoryOK().WithPayload(convertRepository(r))
 	}
 }
 
-//GetRepositoryHandler gets a repository by name and the owner's username
-func GetRepositoryHandler(rs repository.Service) repositories.GetRepositoryHandlerFunc {
-	return func(params repositories.GetRepositoryParams) middleware.Responder {
-		r, _, err := rs.Find(params.HTTPRequest.Context(), params.Owner, params.Name)
+//TransferRepositoryHandler transfers a repository to another owner
+func TransferRepositoryHandler(rs repository.Service) repositories.TransferRepositoryHandlerFunc {
+	return func(params repositories.TransferRepositoryParams) middleware.Responder {
+		r, err := rs.Transfer(params.HTTPRequest.Context(), params.Owner, params.Name, *params.Transfer.NewOwner)
 		if err != nil {
-			if err == repository.ErrRepositoryNotFound {
-				message := "repository not found"
-				return repositories.NewGetRepositoryNotFound().WithPayload(&models.Error{
+			message := err.Error()
+			switch err {
+			case repository.ErrRepositoryNotFound:
+				return repositories.NewTransferRepositoryNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrPermissionDenied:
+				return repositories.NewTransferRepositoryForbidden().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrAlreadyExists:
+				return repositories.NewTransferRepositoryConflict().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrOwnerNotFound:
+				return repositories.NewTransferRepositoryUnprocessableEntity().WithPayload(&models.Error{
 					Message: &message,
 				})
 			}
-			return repositories.NewGetRepositoryDefault(http.StatusInternalServerError)
+			return repositories.NewTransferRepositoryDefault(http.StatusInternalServerError)
 		}
 
-		return repositories.NewGetRepositoryOK().WithPayload(convertRepository(r))
+		return repositories.NewTransferRepositoryOK().WithPayload(convertRepository(r))
 	}
 }
 
-//GetRepositoryTreeHandler gets a repository's tree for a given rev and path
-func GetRepositoryTreeHandler(rs repository.Service) repositories.GetRepositoryTreeHandlerFunc {
-	return func(params repositories.GetRepositoryTreeParams) middleware.Responder {
-		rev := "master" // TODO: lookup default branch in database
-		if params.Ref != nil {
-			rev = *params.Ref
+//ForkRepositoryHandler forks a repository into the current user's repositories
+func ForkRepositoryHandler(rs repository.Service) repositories.ForkRepositoryHandlerFunc {
+	return func(params repositories.ForkRepositoryParams) middleware.Responder {
+		r, err := rs.Fork(params.HTTPRequest.Context(), params.Owner, params.Name, params.Fork.Name)
+		if err != nil {
+			if v, ok := err.(repository.ValidationErrors); ok {
+				return repositories.NewForkRepositoryUnprocessableEntity().WithPayload(convertValidationErrors(v))
+			}
+
+			message := err.Error()
+			switch err {
+			case repository.ErrRepositoryNotFound:
+				return repositories.NewForkRepositoryNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrAlreadyExists:
+				return repositories.NewForkRepositoryConflict().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrPermissionDenied:
+				return repositories.NewForkRepositoryDefault(http.StatusForbidden).WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return repositories.NewForkRepositoryDefault(http.StatusInternalServerError)
 		}
 
-		path := "." // Use root as path by default
-		if params.Path != nil {
-			path = *params.Path
+		return repositories.NewForkRepositoryOK().WithPayload(convertRepository(r))
+	}
+}
+
+//GetRepositoryForksHandler gets the forks of a repository
+func GetRepositoryForksHandler(rs repository.Service) repositories.GetRepositoryForksHandlerFunc {
+	return func(params repositories.GetRepositoryForksParams) middleware.Responder {
+		forks, err := rs.Forks(params.HTTPRequest.Context(), params.Owner, params.Name)
+		if err != nil {
+			if err == repository.ErrRepositoryNotFound {
+				message := err.Error()
+				return repositories.NewGetRepositoryForksNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return repositories.NewGetRepositoryForksDefault(http.StatusInternalServerError)
 		}
 
-		tree, err := rs.Tree(
-			params.HTTPRequest.Context(),
-			params.Owner,
-			params.Name,
-			rev,
-			path,
-		)
+		payload := make([]*models.Repository, 0, len(forks))
+		for _, f := range forks {
+			payload = append(payload, convertOwnedRepository(f))
+		}
+
+		return repositories.NewGetRepositoryForksOK().WithPayload(payload)
+	}
+}
+
+//GetRepositoryNetworkHandler gets the fork network a repository belongs to
+func GetRepositoryNetworkHandler(rs repository.Service) repositories.GetRepositoryNetworkHandlerFunc {
+	return func(params repositories.GetRepositoryNetworkParams) middleware.Responder {
+		network, err := rs.Network(params.HTTPRequest.Context(), params.Owner, params.Name)
 		if err != nil {
 			if err == repository.ErrRepositoryNotFound {
-				message := "repository not found"
-				return repositories.NewGetRepositoryTreeNotFound().WithPayload(&models.Error{
+				message := err.Error()
+				return repositories.NewGetRepositoryNetworkNotFound().WithPayload(&models.Error{
 					Message: &message,
 				})
 			}
-			return repositories.NewGetRepositoryTreeDefault(http.StatusInternalServerError)
+			return repositories.NewGetRepositoryNetworkDefault(http.StatusInternalServerError)
 		}
 
-		var payload []*models.TreeEntry
-		for _, te := range tree {
-			te := te
-			payload = append(payload, &models.TreeEntry{
-				Mode:   &te.Mode,
-				Object: &te.Object,
-				Path:   &te.Path,
-				Type:   &te.Type,
-			})
+		payload := make([]*models.Repository, 0, len(network))
+		for _, r := range network {
+			payload = append(payload, convertOwnedRepository(r))
 		}
 
-		return repositories.NewGetRepositoryTreeOK().WithPayload(payload)
+		return repositories.NewGetRepositoryNetworkOK().WithPayload(payload)
 	}
 }
 
-func convertUser(u *user.User) *models.User {
-	return &models.User{
-		ID:        strfmt.UUID(u.ID),
-		Email:     strfmt.Email(u.Email),
-		Username:  &u.Username,
-		Name:      u.Name,
-		CreatedAt: strfmt.DateTime(u.Created),
-		UpdatedAt: strfmt.DateTime(u.Updated),
+func convertImportJob(j *importer.Job) *models.ImportJob {
+	job := &models.ImportJob{
+		ID:        strfmt.UUID(j.ID),
+		State:     &j.State,
+		Owner:     j.Owner,
+		Name:      j.Name,
+		URL:       j.URL,
+		Progress:  j.Progress,
+		Error:     j.Error,
+		CreatedAt: strfmt.DateTime(j.Created),
+		UpdatedAt: strfmt.DateTime(j.Updated),
+	}
+	if !j.Finished.IsZero() {
+		job.FinishedAt = strfmt.DateTime(j.Finished)
 	}
+	return job
 }
 
-// ListUsersHandler gets a list of users from the user.Service and returns a API response
-func ListUsersHandler(us user.Service) users.ListUsersHandlerFunc {
-	return func(params users.ListUsersParams) middleware.Responder {
-		list, err := us.FindAll(params.HTTPRequest.Context())
+//ImportRepositoryHandler creates a repository and imports it from an external git URL in the background
+func ImportRepositoryHandler(ims importer.Service) repositories.ImportRepositoryHandlerFunc {
+	return func(params repositories.ImportRepositoryParams) middleware.Responder {
+		j, err := ims.Import(params.HTTPRequest.Context(), &repository.Repository{
+			Name:        *params.Import.Name,
+			Description: params.Import.Description,
+			Website:     params.Import.Website,
+			Private:     params.Import.Private,
+		}, importer.Source{
+			URL:      *params.Import.URL,
+			Username: params.Import.Username,
+			Password: params.Import.Password.String(),
+		})
 		if err != nil {
-			return users.NewListUsersDefault(http.StatusInternalServerError)
+			if v, ok := err.(repository.ValidationErrors); ok {
+				return repositories.NewImportRepositoryUnprocessableEntity().WithPayload(convertValidationErrors(v))
+			}
+			if v, ok := err.(importer.ValidationErrors); ok {
+				return repositories.NewImportRepositoryUnprocessableEntity().WithPayload(importInputError(v))
+			}
+
+			message := err.Error()
+			switch err {
+			case repository.ErrAlreadyExists:
+				return repositories.NewImportRepositoryConflict().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case importer.ErrPermissionDenied:
+				return repositories.NewImportRepositoryDefault(http.StatusForbidden).WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return repositories.NewImportRepositoryDefault(http.StatusInternalServerError)
 		}
 
-		var payload []*models.User
+		return repositories.NewImportRepositoryAccepted().WithPayload(convertImportJob(j))
+	}
+}
 
-		for _, u := range list {
-			payload = append(payload, convertUser(u))
+func importInputError(v importer.ValidationErrors) *models.ValidationError {
+	message := "The given import input is invalid"
+	payload := &models.ValidationError{
+		Message: &message,
+	}
+	for _, verr := range v.Errors {
+		payload.Errors = append(payload.Errors, &models.ValidationErrorErrorsItems0{
+			Field:   verr.Field,
+			Message: verr.Error.Error(),
+		})
+	}
+	return payload
+}
+
+//GetRepositoryImportHandler gets the status of an import started by the current user
+func GetRepositoryImportHandler(ims importer.Service) repositories.GetRepositoryImportHandlerFunc {
+	return func(params repositories.GetRepositoryImportParams) middleware.Responder {
+		j, err := ims.Find(params.HTTPRequest.Context(), params.ID)
+		if err != nil {
+			if err == importer.ErrJobNotFound {
+				message := err.Error()
+				return repositories.NewGetRepositoryImportNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return repositories.NewGetRepositoryImportDefault(http.StatusInternalServerError)
 		}
 
-		return users.NewListUsersOK().WithPayload(payload)
+		return repositories.NewGetRepositoryImportOK().WithPayload(convertImportJob(j))
 	}
 }
 
-// GetUserMeHandler gets the currently authenticated user
-func GetUserMeHandler(us user.Service) users.GetUserMeHandlerFunc {
-	return func(params users.GetUserMeParams) middleware.Responder {
-		sessUser := session.GetSessionUser(params.HTTPRequest.Context())
+func convertMirror(m *mirror.Mirror) *models.Mirror {
+	return &models.Mirror{
+		ID:            strfmt.UUID(m.ID),
+		Direction:     &m.Direction,
+		URL:           &m.URL,
+		Credentials:   m.Credentials,
+		Interval:      int64(m.Interval.Seconds()),
+		NextSyncAt:    strfmt.DateTime(m.NextSync),
+		LastSuccessAt: strfmt.DateTime(m.LastSuccess),
+		LastFailureAt: strfmt.DateTime(m.LastFailure),
+		LastError:     m.LastError,
+		CreatedAt:     strfmt.DateTime(m.Created),
+	}
+}
 
-		u, err := us.FindByUsername(params.HTTPRequest.Context(), sessUser.Username)
+//ListRepositoryMirrorsHandler lists the mirrors of a repository
+func ListRepositoryMirrorsHandler(ms mirror.Service) mirrors.ListRepositoryMirrorsHandlerFunc {
+	return func(params mirrors.ListRepositoryMirrorsParams) middleware.Responder {
+		list, err := ms.List(params.HTTPRequest.Context(), params.Owner, params.Name)
 		if err != nil {
-			return users.NewGetUserMeDefault(http.StatusInternalServerError)
+			message := err.Error()
+			payload := &models.Error{Message: &message}
+			if err == mirror.ErrRepositoryNotFound {
+				return mirrors.NewListRepositoryMirrorsNotFound().WithPayload(payload)
+			}
+			if err == mirror.ErrPermissionDenied {
+				return mirrors.NewListRepositoryMirrorsForbidden().WithPayload(payload)
+			}
+			return mirrors.NewListRepositoryMirrorsDefault(http.StatusInternalServerError)
 		}
 
-		return users.NewGetUserMeOK().WithPayload(convertUser(u))
+		payload := make([]*models.Mirror, 0, len(list))
+		for _, m := range list {
+			payload = append(payload, convertMirror(m))
+		}
+
+		return mirrors.NewListRepositoryMirrorsOK().WithPayload(payload)
 	}
 }
 
-// GetUserHandler gets a user from the user.Service and returns a API response
-func GetUserHandler(us user.Service) users.GetUserHandlerFunc {
-	return func(params users.GetUserParams) middleware.Responder {
-		u, err := us.FindByUsername(params.HTTPRequest.Context(), params.Username)
+//CreateRepositoryMirrorHandler creates a mirror of a repository and syncs it right away
+func CreateRepositoryMirrorHandler(ms mirror.Service) mirrors.CreateRepositoryMirrorHandlerFunc {
+	return func(params mirrors.CreateRepositoryMirrorParams) middleware.Responder {
+		m, err := ms.Create(params.HTTPRequest.Context(), params.Owner, params.Name, &mirror.Mirror{
+			Direction:   *params.Mirror.Direction,
+			URL:         *params.Mirror.URL,
+			Credentials: params.Mirror.Credentials,
+			Interval:    time.Duration(params.Mirror.Interval) * time.Second,
+		})
 		if err != nil {
-			if err == user.ErrNotFound {
-				message := "user not found"
-				return users.NewGetUserNotFound().WithPayload(&models.Error{
-					Message: &message,
-				})
+			if v, ok := err.(mirror.ValidationErrors); ok {
+				return mirrors.NewCreateRepositoryMirrorUnprocessableEntity().WithPayload(mirrorInputError(v))
 			}
-			return users.NewGetUserDefault(http.StatusInternalServerError)
+
+			message := err.Error()
+			payload := &models.Error{Message: &message}
+			switch err {
+			case mirror.ErrRepositoryNotFound:
+				return mirrors.NewCreateRepositoryMirrorNotFound().WithPayload(payload)
+			case mirror.ErrPermissionDenied:
+				return mirrors.NewCreateRepositoryMirrorForbidden().WithPayload(payload)
+			case mirror.ErrPullMirrorExists:
+				return mirrors.NewCreateRepositoryMirrorConflict().WithPayload(payload)
+			}
+			return mirrors.NewCreateRepositoryMirrorDefault(http.StatusInternalServerError)
 		}
 
-		return users.NewGetUserOK().WithPayload(convertUser(u))
+		return mirrors.NewCreateRepositoryMirrorOK().WithPayload(convertMirror(m))
 	}
 }
 
-// UpdateUserHandler receives a updated user and returns a API response after updating
-func UpdateUserHandler(us user.Service) users.UpdateUserHandlerFunc {
-	return func(params users.UpdateUserParams) middleware.Responder {
-		old, err := us.FindByUsername(params.HTTPRequest.Context(), params.Username)
+func mirrorInputError(v mirror.ValidationErrors) *models.ValidationError {
+	message := "The given mirror input is invalid"
+	payload := &models.ValidationError{
+		Message: &message,
+	}
+	for _, verr := range v.Errors {
+		payload.Errors = append(payload.Errors, &models.ValidationErrorErrorsItems0{
+			Field:   verr.Field,
+			Message: verr.Error.Error(),
+		})
+	}
+	return payload
+}
+
+//DeleteRepositoryMirrorHandler deletes a mirror of a repository
+func DeleteRepositoryMirrorHandler(ms mirror.Service) mirrors.DeleteRepositoryMirrorHandlerFunc {
+	return func(params mirrors.DeleteRepositoryMirrorParams) middleware.Responder {
+		err := ms.Delete(params.HTTPRequest.Context(), params.Owner, params.Name, params.ID)
 		if err != nil {
-			if err == user.ErrNotFound {
-				message := "user not found"
-				return users.NewUpdateUserNotFound().WithPayload(&models.Error{
+			message := err.Error()
+			payload := &models.Error{Message: &message}
+			if err == mirror.ErrRepositoryNotFound || err == mirror.ErrMirrorNotFound {
+				return mirrors.NewDeleteRepositoryMirrorNotFound().WithPayload(payload)
+			}
+			if err == mirror.ErrPermissionDenied {
+				return mirrors.NewDeleteRepositoryMirrorForbidden().WithPayload(payload)
+			}
+			return mirrors.NewDeleteRepositoryMirrorDefault(http.StatusInternalServerError)
+		}
+
+		return mirrors.NewDeleteRepositoryMirrorNoContent()
+	}
+}
+
+//SyncRepositoryMirrorHandler syncs a mirror of a repository in the background now
+func SyncRepositoryMirrorHandler(ms mirror.Service) mirrors.SyncRepositoryMirrorHandlerFunc {
+	return func(params mirrors.SyncRepositoryMirrorParams) middleware.Responder {
+		m, err := ms.Sync(params.HTTPRequest.Context(), params.Owner, params.Name, params.ID)
+		if err != nil {
+			message := err.Error()
+			payload := &models.Error{Message: &message}
+			if err == mirror.ErrRepositoryNotFound || err == mirror.ErrMirrorNotFound {
+				return mirrors.NewSyncRepositoryMirrorNotFound().WithPayload(payload)
+			}
+			if err == mirror.ErrPermissionDenied {
+				return mirrors.NewSyncRepositoryMirrorForbidden().WithPayload(payload)
+			}
+			return mirrors.NewSyncRepositoryMirrorDefault(http.StatusInternalServerError)
+		}
+
+		return mirrors.NewSyncRepositoryMirrorAccepted().WithPayload(convertMirror(m))
+	}
+}
+
+//GetRepositoryBranchesHandler gets all branches of a repository
+func GetRepositoryBranchesHandler(rs repository.Service, ss status.Service) repositories.GetRepositoryBranchesHandlerFunc {
+	return func(params repositories.GetRepositoryBranchesParams) middleware.Responder {
+		opts := repository.BranchesOptions{
+			Sort: *params.Sort,
+			Page: pageOptions(params.Cursor, params.PerPage),
+		}
+		if params.Prefix != nil {
+			opts.Prefix = *params.Prefix
+		}
+
+		branches, next, err := rs.Branches(params.HTTPRequest.Context(), params.Owner, params.Name, opts)
+		if err != nil {
+			if err == repository.ErrRepositoryNotFound {
+				message := "repository not found"
+				return repositories.NewGetRepositoryBranchesNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			if err == pagination.ErrCursorInvalid {
+				message := err.Error()
+				return repositories.NewGetRepositoryBranchesUnprocessableEntity().WithPayload(&models.Error{
 					Message: &message,
 				})
 			}
-			return users.NewUpdateUserDefault(http.StatusInternalServerError)
-		}
 
-		old.Name = *params.UpdatedUser.Name
+			return repositories.NewGetRepositoryBranchesDefault(http.StatusInternalServerError)
+		}
 
-		updated, err := us.Update(params.HTTPRequest.Context(), old)
+		sha1s := make([]string, 0, len(branches))
+		for _, b := range branches {
+			sha1s = append(sha1s, b.Sha1)
+		}
+		summaries, err := ss.Summaries(params.HTTPRequest.Context(), params.Owner, params.Name, sha1s)
 		if err != nil {
-			message := "updated user is invalid"
-			return users.NewUpdateUserUnprocessableEntity().WithPayload(&models.ValidationError{
-				Message: &message,
-			})
+			return repositories.NewGetRepositoryBranchesDefault(http.StatusInternalServerError)
+		}
+		protections, err := ss.Protections(params.HTTPRequest.Context(), params.Owner, params.Name)
+		if err != nil {
+			return repositories.NewGetRepositoryBranchesDefault(http.StatusInternalServerError)
+		}
+		requiredChecks := make(map[string][]string, len(protections))
+		for _, p := range protections {
+			requiredChecks[p.Branch] = p.RequiredChecks
 		}
 
-		return users.NewUpdateUserOK().WithPayload(convertUser(updated))
+		var payload []*models.Branch
+
+		for _, b := range branches {
+			branch := convertBranch(b)
+			branch.Status = summaries[b.Sha1].State
+			branch.RequiredChecks = requiredChecks[b.Name]
+			payload = append(payload, branch)
+		}
+
+		return repositories.NewGetRepositoryBranchesOK().
+			WithLink(nextLink(params.HTTPRequest, next)).
+			WithXNextCursor(next).
+			WithPayload(payload)
+	}
+}
+
+func convertBranch(b *repository.Branch) *models.Branch {
+	branch := &models.Branch{
+		Name:      b.Name,
+		Sha1:      b.Sha1,
+		Type:      b.Type,
+		Protected: b.Protected,
+		Subject:   b.Subject,
+		Ahead:     int64(b.Ahead),
+		Behind:    int64(b.Behind),
+	}
+	if b.Author.Name != "" {
+		branch.AuthorName = b.Author.Name
+		branch.AuthorEmail = b.Author.Email
+		branch.AuthorDate = strfmt.DateTime(b.Author.Date)
+	}
+	return branch
+}
+
+//CreateRepositoryBranchHandler creates a new branch pointing to the given rev
+func CreateRepositoryBranchHandler(rs repository.Service) repositories.CreateRepositoryBranchHandlerFunc {
+	return func(params repositories.CreateRepositoryBranchParams) middleware.Responder {
+		b, err := rs.CreateBranch(
+			params.HTTPRequest.Context(),
+			params.Owner,
+			params.Name,
+			params.Branch,
+			*params.NewBranch.Rev,
+		)
+		if err != nil {
+			switch err {
+			case repository.ErrRepositoryNotFound, repository.ErrRevNotFound:
+				message := err.Error()
+				return repositories.NewCreateRepositoryBranchNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrPermissionDenied:
+				message := err.Error()
+				return repositories.NewCreateRepositoryBranchForbidden().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrBranchAlreadyExists:
+				message := err.Error()
+				return repositories.NewCreateRepositoryBranchConflict().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrBranchNameInvalid:
+				message := "The given branch input is invalid"
+				return repositories.NewCreateRepositoryBranchUnprocessableEntity().WithPayload(&models.ValidationError{
+					Message: &message,
+					Errors: []*models.ValidationErrorErrorsItems0{{
+						Field:   "branch",
+						Message: err.Error(),
+					}},
+				})
+			}
+			return repositories.NewCreateRepositoryBranchDefault(http.StatusInternalServerError)
+		}
+
+		return repositories.NewCreateRepositoryBranchOK().WithPayload(convertBranch(b))
+	}
+}
+
+//DeleteRepositoryBranchHandler deletes a branch unless it's protected
+func DeleteRepositoryBranchHandler(rs repository.Service) repositories.DeleteRepositoryBranchHandlerFunc {
+	return func(params repositories.DeleteRepositoryBranchParams) middleware.Responder {
+		var sha1 string
+		if params.Sha1 != nil {
+			sha1 = *params.Sha1
+		}
+
+		err := rs.DeleteBranch(
+			params.HTTPRequest.Context(),
+			params.Owner,
+			params.Name,
+			params.Branch,
+			sha1,
+		)
+		if err != nil {
+			message := err.Error()
+			switch err {
+			case repository.ErrBranchProtected, repository.ErrPermissionDenied:
+				return repositories.NewDeleteRepositoryBranchForbidden().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrRepositoryNotFound, repository.ErrBranchNotFound:
+				return repositories.NewDeleteRepositoryBranchNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrBranchChanged:
+				return repositories.NewDeleteRepositoryBranchConflict().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return repositories.NewDeleteRepositoryBranchDefault(http.StatusInternalServerError)
+		}
+
+		return repositories.NewDeleteRepositoryBranchNoContent()
+	}
+}
+
+//RenameRepositoryBranchHandler renames a branch unless it's protected
+func RenameRepositoryBranchHandler(rs repository.Service) repositories.RenameRepositoryBranchHandlerFunc {
+	return func(params repositories.RenameRepositoryBranchParams) middleware.Responder {
+		b, err := rs.RenameBranch(
+			params.HTTPRequest.Context(),
+			params.Owner,
+			params.Name,
+			params.Branch,
+			*params.RenamedBranch.Name,
+		)
+		if err != nil {
+			message := err.Error()
+			switch err {
+			case repository.ErrBranchProtected, repository.ErrPermissionDenied:
+				return repositories.NewRenameRepositoryBranchForbidden().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrRepositoryNotFound, repository.ErrBranchNotFound:
+				return repositories.NewRenameRepositoryBranchNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrBranchAlreadyExists, repository.ErrBranchChanged:
+				return repositories.NewRenameRepositoryBranchConflict().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrBranchNameInvalid:
+				message := "The given branch input is invalid"
+				return repositories.NewRenameRepositoryBranchUnprocessableEntity().WithPayload(&models.ValidationError{
+					Message: &message,
+					Errors: []*models.ValidationErrorErrorsItems0{{
+						Field:   "name",
+						Message: err.Error(),
+					}},
+				})
+			}
+			return repositories.NewRenameRepositoryBranchDefault(http.StatusInternalServerError)
+		}
+
+		return repositories.NewRenameRepositoryBranchOK().WithPayload(convertBranch(b))
+	}
+}
+
+//GetRepositoryHandler gets a repository by name and the owner's username
+func GetRepositoryHandler(rs repository.Service) repositories.GetRepositoryHandlerFunc {
+	return func(params repositories.GetRepositoryParams) middleware.Responder {
+		r, _, err := rs.Find(params.HTTPRequest.Context(), params.Owner, params.Name)
+		if err != nil {
+			if err == repository.ErrRepositoryNotFound {
+				message := "repository not found"
+				return repositories.NewGetRepositoryNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return repositories.NewGetRepositoryDefault(http.StatusInternalServerError)
+		}
+
+		payload := convertRepository(r)
+		payload.Stats = repositoryStats(params.HTTPRequest.Context(), rs, params.Owner, r.Name)
+
+		return repositories.NewGetRepositoryOK().WithPayload(payload)
+	}
+}
+
+//GetRepositoryTreeHandler gets a repository's tree for a given rev and path
+func GetRepositoryTreeHandler(rs repository.Service) repositories.GetRepositoryTreeHandlerFunc {
+	return func(params repositories.GetRepositoryTreeParams) middleware.Responder {
+		rev := "master" // TODO: lookup default branch in database
+		if params.Ref != nil {
+			rev = *params.Ref
+		}
+
+		path := "." // Use root as path by default
+		if params.Path != nil {
+			path = *params.Path
+		}
+
+		tree, err := rs.Tree(
+			params.HTTPRequest.Context(),
+			params.Owner,
+			params.Name,
+			rev,
+			path,
+		)
+		if err != nil {
+			if err == repository.ErrRepositoryNotFound {
+				message := "repository not found"
+				return repositories.NewGetRepositoryTreeNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return repositories.NewGetRepositoryTreeDefault(http.StatusInternalServerError)
+		}
+
+		var payload []*models.TreeEntry
+		for _, te := range tree {
+			te := te
+			payload = append(payload, &models.TreeEntry{
+				Mode:   &te.Mode,
+				Object: &te.Object,
+				Path:   &te.Path,
+				Type:   &te.Type,
+			})
+		}
+
+		return repositories.NewGetRepositoryTreeOK().WithPayload(payload)
+	}
+}
+
+//GetRepositoryReadmeHandler gets a repository's README rendered as HTML,
+//links relative to the repository point to its raw files
+func GetRepositoryReadmeHandler(rs repository.Service, mks markup.Service) repositories.GetRepositoryReadmeHandlerFunc {
+	return func(params repositories.GetRepositoryReadmeParams) middleware.Responder {
+		var rev string
+		if params.Ref != nil {
+			rev = *params.Ref
+		}
+
+		readme, err := rs.Readme(params.HTTPRequest.Context(), params.Owner, params.Name, rev)
+		if err != nil {
+			message := err.Error()
+			switch err {
+			case repository.ErrRepositoryNotFound, repository.ErrRevNotFound, repository.ErrReadmeNotFound:
+				return repositories.NewGetRepositoryReadmeNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrFileTooLarge:
+				return repositories.NewGetRepositoryReadmeUnprocessableEntity().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return repositories.NewGetRepositoryReadmeDefault(http.StatusInternalServerError)
+		}
+
+		rendered := "<pre>" + html.EscapeString(readme.Content) + "</pre>"
+		if readme.Markdown {
+			raw := rawURL(params.HTTPRequest, params.Owner, params.Name) + "/" + (&url.URL{Path: readme.Rev}).EscapedPath()
+			rendered, err = mks.Render(params.HTTPRequest.Context(), readme.Content, markup.Options{
+				Repository: params.Owner + "/" + params.Name,
+				Links:      markup.RelativeLinks(raw),
+			})
+			if err != nil {
+				return repositories.NewGetRepositoryReadmeDefault(http.StatusInternalServerError)
+			}
+		}
+
+		return repositories.NewGetRepositoryReadmeOK().WithPayload(&models.Readme{
+			Path: &readme.Path,
+			Sha1: readme.Sha1,
+			Size: readme.Size,
+			Ref:  &readme.Rev,
+			HTML: &rendered,
+		})
+	}
+}
+
+//SearchRepositoryHandler searches the files of a repository for lines matching a query
+func SearchRepositoryHandler(rs repository.Service) repositories.SearchRepositoryHandlerFunc {
+	return func(params repositories.SearchRepositoryParams) middleware.Responder {
+		var rev string
+		if params.Ref != nil {
+			rev = *params.Ref
+		}
+
+		matches, err := rs.Search(
+			params.HTTPRequest.Context(),
+			params.Owner,
+			params.Name,
+			rev,
+			storage.SearchOptions{
+				Query:      params.Q,
+				Regexp:     *params.Regexp,
+				IgnoreCase: *params.IgnoreCase,
+				Paths:      params.Path,
+				Limit:      int(*params.Limit),
+				Context:    int(*params.Context),
+			},
+		)
+		if err != nil {
+			message := err.Error()
+			switch err {
+			case repository.ErrRepositoryNotFound, repository.ErrRevNotFound:
+				return repositories.NewSearchRepositoryNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrSearchQueryInvalid:
+				return repositories.NewSearchRepositoryUnprocessableEntity().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrSearchTimeout:
+				return repositories.NewSearchRepositoryDefault(http.StatusGatewayTimeout).WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return repositories.NewSearchRepositoryDefault(http.StatusInternalServerError)
+		}
+
+		payload := make([]*models.SearchMatch, 0, len(matches))
+		for _, m := range matches {
+			m := m
+			line := int64(m.Line)
+			payload = append(payload, &models.SearchMatch{
+				Path:   &m.Path,
+				Line:   &line,
+				Text:   &m.Text,
+				Before: m.Before,
+				After:  m.After,
+			})
+		}
+
+		return repositories.NewSearchRepositoryOK().WithPayload(payload)
+	}
+}
+
+//SearchCodeHandler searches the code of all repositories visible to the user
+func SearchCodeHandler(rs repository.Service) search.SearchCodeHandlerFunc {
+	return func(params search.SearchCodeParams) middleware.Responder {
+		matches, err := rs.SearchCode(params.HTTPRequest.Context(), storage.IndexSearchOptions{
+			Query:      params.Q,
+			IgnoreCase: *params.IgnoreCase,
+			Limit:      int(*params.Limit),
+		})
+		if err != nil {
+			message := err.Error()
+			switch err {
+			case repository.ErrSearchQueryInvalid:
+				return search.NewSearchCodeUnprocessableEntity().WithPayload(&models.Error{
+					Message: &message,
+				})
+			case repository.ErrSearchTimeout:
+				return search.NewSearchCodeDefault(http.StatusGatewayTimeout).WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return search.NewSearchCodeDefault(http.StatusInternalServerError)
+		}
+
+		payload := make([]*models.CodeMatch, 0, len(matches))
+		for _, m := range matches {
+			m := m
+			line := int64(m.Line)
+			payload = append(payload, &models.CodeMatch{
+				Owner:      &m.Owner,
+				Repository: &m.Repository,
+				Path:       &m.Path,
+				Line:       &line,
+				Text:       &m.Text,
+			})
+		}
+
+		return search.NewSearchCodeOK().WithPayload(payload)
+	}
+}
+
+//SearchRepositoriesHandler searches the repositories visible to the user by name and description
+func SearchRepositoriesHandler(rs repository.Service) search.SearchRepositoriesHandlerFunc {
+	return func(params search.SearchRepositoriesParams) middleware.Responder {
+		opts := repository.SearchOptions{
+			Query: params.Q,
+			Page:  pageOptions(params.Cursor, params.PerPage),
+		}
+		if params.Sort != nil {
+			opts.Sort = *params.Sort
+		}
+
+		results, next, err := rs.SearchRepositories(params.HTTPRequest.Context(), opts)
+		if err != nil {
+			if err == repository.ErrSearchQueryInvalid || err == pagination.ErrCursorInvalid {
+				message := err.Error()
+				return search.NewSearchRepositoriesUnprocessableEntity().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return search.NewSearchRepositoriesDefault(http.StatusInternalServerError)
+		}
+
+		payload := make([]*models.Repository, 0, len(results))
+		for _, res := range results {
+			payload = append(payload, convertOwnedRepository(res))
+		}
+
+		return search.NewSearchRepositoriesOK().
+			WithLink(nextLink(params.HTTPRequest, next)).
+			WithXNextCursor(next).
+			WithPayload(payload)
+	}
+}
+
+//SearchUsersHandler searches users by username and name
+func SearchUsersHandler(us user.Service) search.SearchUsersHandlerFunc {
+	return func(params search.SearchUsersParams) middleware.Responder {
+		opts := user.SearchOptions{
+			Query: params.Q,
+			Page:  pageOptions(params.Cursor, params.PerPage),
+		}
+		if params.Sort != nil {
+			opts.Sort = *params.Sort
+		}
+
+		list, next, err := us.Search(params.HTTPRequest.Context(), opts)
+		if err != nil {
+			if err == user.ErrSearchQueryInvalid || err == pagination.ErrCursorInvalid {
+				message := err.Error()
+				return search.NewSearchUsersUnprocessableEntity().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return search.NewSearchUsersDefault(http.StatusInternalServerError)
+		}
+
+		payload := make([]*models.User, 0, len(list))
+		for _, u := range list {
+			payload = append(payload, convertUser(u))
+		}
+
+		return search.NewSearchUsersOK().
+			WithLink(nextLink(params.HTTPRequest, next)).
+			WithXNextCursor(next).
+			WithPayload(payload)
+	}
+}
+
+func convertUser(u *user.User) *models.User {
+	return &models.User{
+		ID:        strfmt.UUID(u.ID),
+		Email:     strfmt.Email(u.Email),
+		Username:  &u.Username,
+		Name:      u.Name,
+		CreatedAt: strfmt.DateTime(u.Created),
+		UpdatedAt: strfmt.DateTime(u.Updated),
+	}
+}
+
+// ListUsersHandler gets a list of users from the user.Service and returns a API response
+func ListUsersHandler(us user.Service) users.ListUsersHandlerFunc {
+	return func(params users.ListUsersParams) middleware.Responder {
+		list, next, err := us.FindAll(params.HTTPRequest.Context(), user.ListOptions{
+			Sort: *params.Sort,
+			Page: pageOptions(params.Cursor, params.PerPage),
+		})
+		if err != nil {
+			if err == pagination.ErrCursorInvalid {
+				message := err.Error()
+				return users.NewListUsersUnprocessableEntity().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return users.NewListUsersDefault(http.StatusInternalServerError)
+		}
+
+		var payload []*models.User
+
+		for _, u := range list {
+			payload = append(payload, convertUser(u))
+		}
+
+		return users.NewListUsersOK().
+			WithLink(nextLink(params.HTTPRequest, next)).
+			WithXNextCursor(next).
+			WithPayload(payload)
+	}
+}
+
+// GetUserMeHandler gets the currently authenticated user
+func GetUserMeHandler(us user.Service) users.GetUserMeHandlerFunc {
+	return func(params users.GetUserMeParams) middleware.Responder {
+		sessUser := session.GetSessionUser(params.HTTPRequest.Context())
+
+		u, err := us.FindByUsername(params.HTTPRequest.Context(), sessUser.Username)
+		if err != nil {
+			return users.NewGetUserMeDefault(http.StatusInternalServerError)
+		}
+
+		return users.NewGetUserMeOK().WithPayload(convertUser(u))
+	}
+}
+
+// GetUserHandler gets a user from the user.Service and returns a API response
+func GetUserHandler(us user.Service) users.GetUserHandlerFunc {
+	return func(params users.GetUserParams) middleware.Responder {
+		u, err := us.FindByUsername(params.HTTPRequest.Context(), params.Username)
+		if err != nil {
+			if err == user.ErrNotFound {
+				message := "user not found"
+				return users.NewGetUserNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return users.NewGetUserDefault(http.StatusInternalServerError)
+		}
+
+		return users.NewGetUserOK().WithPayload(convertUser(u))
+	}
+}
+
+// UpdateUserHandler receives a updated user and returns a API response after updating
+func UpdateUserHandler(us user.Service) users.UpdateUserHandlerFunc {
+	return func(params users.UpdateUserParams) middleware.Responder {
+		old, err := us.FindByUsername(params.HTTPRequest.Context(), params.Username)
+		if err != nil {
+			if err == user.ErrNotFound {
+				message := "user not found"
+				return users.NewUpdateUserNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return users.NewUpdateUserDefault(http.StatusInternalServerError)
+		}
+
+		old.Name = *params.UpdatedUser.Name
+
+		updated, err := us.Update(params.HTTPRequest.Context(), old)
+		if err != nil {
+			message := "updated user is invalid"
+			return users.NewUpdateUserUnprocessableEntity().WithPayload(&models.ValidationError{
+				Message: &message,
+			})
+		}
+
+		return users.NewUpdateUserOK().WithPayload(convertUser(updated))
+	}
+}
+
+func convertPullRequest(pr *pullrequest.PullRequest) *models.PullRequest {
+	number := int64(pr.Number)
+	author := pr.Author
+	m := &models.PullRequest{
+		ID:     strfmt.UUID(pr.ID),
+		Number: &number,
+		Title:  &pr.Title,
+		Body:   pr.Body,
+		State:  &pr.State,
+		Source: &models.PullRequestRef{
+			Owner:      pr.Source.Owner,
+			Repository: pr.Source.Repository,
+			Branch:     pr.Source.Branch,
+		},
+		Target: &models.PullRequestRef{
+			Owner:      pr.Target.Owner,
+			Repository: pr.Target.Repository,
+			Branch:     pr.Target.Branch,
+		},
+		Head:      pr.Head,
+		Base:      pr.Base,
+		Mergeable: pr.Mergeable,
+		Conflicts: pr.Conflicts,
+		Author: &models.User{
+			ID:       strfmt.UUID(pr.AuthorID),
+			Username: &author,
+		},
+		CreatedAt: strfmt.DateTime(pr.Created),
+		UpdatedAt: strfmt.DateTime(pr.Updated),
+	}
+	if !pr.Closed.IsZero() {
+		m.ClosedAt = strfmt.DateTime(pr.Closed)
+	}
+	return m
+}
+
+func convertComment(c *pullrequest.Comment) *models.PullRequestComment {
+	author := c.Author
+	return &models.PullRequestComment{
+		ID:   strfmt.UUID(c.ID),
+		Body: &c.Body,
+		Author: &models.User{
+			ID:       strfmt.UUID(c.AuthorID),
+			Username: &author,
+		},
+		Path:      c.Path,
+		Line:      int64(c.Line),
+		Commit:    c.Commit,
+		CreatedAt: strfmt.DateTime(c.Created),
+		UpdatedAt: strfmt.DateTime(c.Updated),
+	}
+}
+
+func convertCommit(c storage.Commit) *models.Commit {
+	sha1 := c.Hash
+	return &models.Commit{
+		Sha1:           &sha1,
+		Tree:           c.Tree,
+		Parent:         c.Parent,
+		Message:        c.Message,
+		Body:           c.Body,
+		AuthorName:     c.Author.Name,
+		AuthorEmail:    c.Author.Email,
+		AuthorDate:     strfmt.DateTime(c.Author.Date),
+		CommitterName:  c.Committer.Name,
+		CommitterEmail: c.Committer.Email,
+		CommitterDate:  strfmt.DateTime(c.Committer.Date),
+	}
+}
+
+// pullRequestInputError returns the payload for invalid input to create or update pull requests,
+// it's nil for any other error.
+func pullRequestInputError(err error) *models.ValidationError {
+	message := err.Error()
+	switch err {
+	case pullrequest.ErrBranchNotFound, pullrequest.ErrSourceInvalid, pullrequest.ErrNoCommits,
+		pullrequest.ErrStateInvalid, pullrequest.ErrCommentPositionInvalid, pullrequest.ErrMergeStrategyInvalid:
+		return &models.ValidationError{Message: &message}
+	}
+
+	v, ok := err.(pullrequest.ValidationErrors)
+	if !ok {
+		return nil
+	}
+	message = "The given pull request input is invalid"
+	payload := &models.ValidationError{
+		Message: &message,
+	}
+	for _, verr := range v.Errors {
+		payload.Errors = append(payload.Errors, &models.ValidationErrorErrorsItems0{
+			Field:   verr.Field,
+			Message: verr.Error.Error(),
+		})
+	}
+	return payload
+}
+
+func pullRequestNotFound(err error) bool {
+	return err == pullrequest.ErrRepositoryNotFound || err == pullrequest.ErrPullRequestNotFound
+}
+
+//ListPullRequestsHandler lists a page of a repository's pull requests
+func ListPullRequestsHandler(ps pullrequest.Service) pullrequests.ListPullRequestsHandlerFunc {
+	return func(params pullrequests.ListPullRequestsParams) middleware.Responder {
+		state := *params.State
+		if state == "all" {
+			state = ""
+		}
+
+		list, next, err := ps.List(params.HTTPRequest.Context(), params.Owner, params.Name, pullrequest.ListOptions{
+			State: state,
+			Page:  pageOptions(params.Cursor, params.PerPage),
+		})
+		if err != nil {
+			message := err.Error()
+			if err == pullrequest.ErrRepositoryNotFound {
+				return pullrequests.NewListPullRequestsNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			if err == pagination.ErrCursorInvalid {
+				return pullrequests.NewListPullRequestsUnprocessableEntity().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return pullrequests.NewListPullRequestsDefault(http.StatusInternalServerError)
+		}
+
+		payload := make([]*models.PullRequest, 0, len(list))
+		for _, pr := range list {
+			payload = append(payload, convertPullRequest(pr))
+		}
+
+		return pullrequests.NewListPullRequestsOK().
+			WithLink(nextLink(params.HTTPRequest, next)).
+			WithXNextCursor(next).
+			WithPayload(payload)
+	}
+}
+
+//CreatePullRequestHandler opens a pull request
+func CreatePullRequestHandler(ps pullrequest.Service) pullrequests.CreatePullRequestHandlerFunc {
+	return func(params pullrequests.CreatePullRequestParams) middleware.Responder {
+		body := params.PullRequest
+		pr, err := ps.Create(params.HTTPRequest.Context(), params.Owner, params.Name, &pullrequest.PullRequest{
+			Title: *body.Title,
+			Body:  body.Body,
+			Source: pullrequest.Ref{
+				Owner:      body.SourceOwner,
+				Repository: body.SourceRepository,
+				Branch:     *body.SourceBranch,
+			},
+			Target: pullrequest.Ref{
+				Branch: *body.TargetBranch,
+			},
+		})
+		if err != nil {
+			if payload := pullRequestInputError(err); payload != nil {
+				return pullrequests.NewCreatePullRequestUnprocessableEntity().WithPayload(payload)
+			}
+
+			message := err.Error()
+			if err == pullrequest.ErrRepositoryNotFound {
+				return pullrequests.NewCreatePullRequestNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			if err == pullrequest.ErrPermissionDenied {
+				return pullrequests.NewCreatePullRequestDefault(http.StatusForbidden).WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return pullrequests.NewCreatePullRequestDefault(http.StatusInternalServerError)
+		}
+
+		return pullrequests.NewCreatePullRequestOK().WithPayload(convertPullRequest(pr))
+	}
+}
+
+//GetPullRequestHandler gets a pull request by its number
+func GetPullRequestHandler(ps pullrequest.Service) pullrequests.GetPullRequestHandlerFunc {
+	return func(params pullrequests.GetPullRequestParams) middleware.Responder {
+		pr, err := ps.Find(params.HTTPRequest.Context(), params.Owner, params.Name, int(params.Number))
+		if err != nil {
+			if pullRequestNotFound(err) {
+				message := err.Error()
+				return pullrequests.NewGetPullRequestNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return pullrequests.NewGetPullRequestDefault(http.StatusInternalServerError)
+		}
+
+		return pullrequests.NewGetPullRequestOK().WithPayload(convertPullRequest(pr))
+	}
+}
+
+//UpdatePullRequestHandler updates the title, body or state of a pull request
+func UpdatePullRequestHandler(ps pullrequest.Service) pullrequests.UpdatePullRequestHandlerFunc {
+	return func(params pullrequests.UpdatePullRequestParams) middleware.Responder {
+		pr, err := ps.Update(params.HTTPRequest.Context(), params.Owner, params.Name, int(params.Number), pullrequest.Update{
+			Title: params.Update.Title,
+			Body:  params.Update.Body,
+			State: params.Update.State,
+		})
+		if err != nil {
+			if payload := pullRequestInputError(err); payload != nil {
+				return pullrequests.NewUpdatePullRequestUnprocessableEntity().WithPayload(payload)
+			}
+
+			message := err.Error()
+			if pullRequestNotFound(err) {
+				return pullrequests.NewUpdatePullRequestNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			if err == pullrequest.ErrPermissionDenied {
+				return pullrequests.NewUpdatePullRequestForbidden().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return pullrequests.NewUpdatePullRequestDefault(http.StatusInternalServerError)
+		}
+
+		return pullrequests.NewUpdatePullRequestOK().WithPayload(convertPullRequest(pr))
+	}
+}
+
+//MergePullRequestHandler merges a pull request into its target branch
+func MergePullRequestHandler(ps pullrequest.Service) pullrequests.MergePullRequestHandlerFunc {
+	return func(params pullrequests.MergePullRequestParams) middleware.Responder {
+		pr, err := ps.Merge(params.HTTPRequest.Context(), params.Owner, params.Name, int(params.Number), pullrequest.Merge{
+			Strategy: *params.Merge.Strategy,
+			Message:  params.Merge.Message,
+			Sha1:     params.Merge.Sha,
+		})
+		if err != nil {
+			if payload := pullRequestInputError(err); payload != nil {
+				return pullrequests.NewMergePullRequestUnprocessableEntity().WithPayload(payload)
+			}
+
+			message := err.Error()
+			payload := &models.Error{Message: &message}
+			if pullRequestNotFound(err) {
+				return pullrequests.NewMergePullRequestNotFound().WithPayload(payload)
+			}
+			if err == pullrequest.ErrPermissionDenied {
+				return pullrequests.NewMergePullRequestForbidden().WithPayload(payload)
+			}
+			if err == pullrequest.ErrNotMergeable || err == pullrequest.ErrChecksUnsatisfied {
+				return pullrequests.NewMergePullRequestMethodNotAllowed().WithPayload(payload)
+			}
+			if err == pullrequest.ErrHeadChanged {
+				return pullrequests.NewMergePullRequestConflict().WithPayload(payload)
+			}
+			return pullrequests.NewMergePullRequestDefault(http.StatusInternalServerError)
+		}
+
+		return pullrequests.NewMergePullRequestOK().WithPayload(convertPullRequest(pr))
+	}
+}
+
+//GetPullRequestCommitsHandler gets the commits of a pull request
+func GetPullRequestCommitsHandler(ps pullrequest.Service) pullrequests.GetPullRequestCommitsHandlerFunc {
+	return func(params pullrequests.GetPullRequestCommitsParams) middleware.Responder {
+		commits, err := ps.Commits(params.HTTPRequest.Context(), params.Owner, params.Name, int(params.Number))
+		if err != nil {
+			if pullRequestNotFound(err) {
+				message := err.Error()
+				return pullrequests.NewGetPullRequestCommitsNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return pullrequests.NewGetPullRequestCommitsDefault(http.StatusInternalServerError)
+		}
+
+		payload := make([]*models.Commit, 0, len(commits))
+		for _, c := range commits {
+			payload = append(payload, convertCommit(c))
+		}
+
+		return pullrequests.NewGetPullRequestCommitsOK().WithPayload(payload)
+	}
+}
+
+//GetPullRequestDiffHandler gets the changes of a pull request
+func GetPullRequestDiffHandler(ps pullrequest.Service) pullrequests.GetPullRequestDiffHandlerFunc {
+	return func(params pullrequests.GetPullRequestDiffParams) middleware.Responder {
+		diff, err := ps.Diff(params.HTTPRequest.Context(), params.Owner, params.Name, int(params.Number))
+		if err != nil {
+			if pullRequestNotFound(err) {
+				message := err.Error()
+				return pullrequests.NewGetPullRequestDiffNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return pullrequests.NewGetPullRequestDiffDefault(http.StatusInternalServerError)
+		}
+
+		payload := &models.Diff{
+			Files:     make([]*models.FileDiff, 0, len(diff.Files)),
+			Truncated: diff.Truncated,
+		}
+		for _, f := range diff.Files {
+			f := f
+			payload.Files = append(payload.Files, &models.FileDiff{
+				Path:      &f.Path,
+				OldPath:   f.OldPath,
+				Status:    &f.Status,
+				Binary:    f.Binary,
+				Additions: int64(f.Additions),
+				Deletions: int64(f.Deletions),
+				Patch:     f.Patch,
+			})
+		}
+
+		return pullrequests.NewGetPullRequestDiffOK().WithPayload(payload)
+	}
+}
+
+//ListPullRequestCommentsHandler lists the comments of a pull request
+func ListPullRequestCommentsHandler(ps pullrequest.Service) pullrequests.ListPullRequestCommentsHandlerFunc {
+	return func(params pullrequests.ListPullRequestCommentsParams) middleware.Responder {
+		comments, err := ps.Comments(params.HTTPRequest.Context(), params.Owner, params.Name, int(params.Number))
+		if err != nil {
+			if pullRequestNotFound(err) {
+				message := err.Error()
+				return pullrequests.NewListPullRequestCommentsNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return pullrequests.NewListPullRequestCommentsDefault(http.StatusInternalServerError)
+		}
+
+		payload := make([]*models.PullRequestComment, 0, len(comments))
+		for _, c := range comments {
+			payload = append(payload, convertComment(c))
+		}
+
+		return pullrequests.NewListPullRequestCommentsOK().WithPayload(payload)
+	}
+}
+
+//CreatePullRequestCommentHandler comments on a pull request
+func CreatePullRequestCommentHandler(ps pullrequest.Service) pullrequests.CreatePullRequestCommentHandlerFunc {
+	return func(params pullrequests.CreatePullRequestCommentParams) middleware.Responder {
+		c, err := ps.CreateComment(params.HTTPRequest.Context(), params.Owner, params.Name, int(params.Number), &pullrequest.Comment{
+			Body: *params.Comment.Body,
+			Path: params.Comment.Path,
+			Line: int(params.Comment.Line),
+		})
+		if err != nil {
+			if payload := pullRequestInputError(err); payload != nil {
+				return pullrequests.NewCreatePullRequestCommentUnprocessableEntity().WithPayload(payload)
+			}
+
+			message := err.Error()
+			if pullRequestNotFound(err) {
+				return pullrequests.NewCreatePullRequestCommentNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			if err == pullrequest.ErrPermissionDenied {
+				return pullrequests.NewCreatePullRequestCommentDefault(http.StatusForbidden).WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return pullrequests.NewCreatePullRequestCommentDefault(http.StatusInternalServerError)
+		}
+
+		return pullrequests.NewCreatePullRequestCommentOK().WithPayload(convertComment(c))
+	}
+}
+
+func convertIssue(i *issue.Issue) *models.Issue {
+	number := int64(i.Number)
+	author := i.Author
+	m := &models.Issue{
+		ID:     strfmt.UUID(i.ID),
+		Number: &number,
+		Title:  &i.Title,
+		Body:   i.Body,
+		State:  &i.State,
+		Author: &models.User{
+			ID:       strfmt.UUID(i.AuthorID),
+			Username: &author,
+		},
+		Labels:    make([]*models.Label, 0, len(i.Labels)),
+		Assignees: make([]*models.User, 0, len(i.Assignees)),
+		CreatedAt: strfmt.DateTime(i.Created),
+		UpdatedAt: strfmt.DateTime(i.Updated),
+	}
+	for _, l := range i.Labels {
+		m.Labels = append(m.Labels, convertLabel(l))
+	}
+	for _, a := range i.Assignees {
+		username := a.Username
+		m.Assignees = append(m.Assignees, &models.User{
+			ID:       strfmt.UUID(a.ID),
+			Username: &username,
+		})
+	}
+	if i.Milestone != nil {
+		m.Milestone = convertMilestone(i.Milestone)
+	}
+	if !i.Closed.IsZero() {
+		m.ClosedAt = strfmt.DateTime(i.Closed)
+	}
+	return m
+}
+
+func convertLabel(l *issue.Label) *models.Label {
+	return &models.Label{
+		ID:          strfmt.UUID(l.ID),
+		Name:        &l.Name,
+		Color:       &l.Color,
+		Description: l.Description,
+	}
+}
+
+func convertMilestone(m *issue.Milestone) *models.Milestone {
+	milestone := &models.Milestone{
+		ID:          strfmt.UUID(m.ID),
+		Title:       &m.Title,
+		Description: m.Description,
+		State:       m.State,
+		CreatedAt:   strfmt.DateTime(m.Created),
+		UpdatedAt:   strfmt.DateTime(m.Updated),
+	}
+	if !m.Due.IsZero() {
+		milestone.DueOn = strfmt.DateTime(m.Due)
+	}
+	return milestone
+}
+
+func convertIssueComment(c *issue.Comment) *models.IssueComment {
+	author := c.Author
+	return &models.IssueComment{
+		ID:   strfmt.UUID(c.ID),
+		Body: &c.Body,
+		Author: &models.User{
+			ID:       strfmt.UUID(c.AuthorID),
+			Username: &author,
+		},
+		CreatedAt: strfmt.DateTime(c.Created),
+		UpdatedAt: strfmt.DateTime(c.Updated),
+	}
+}
+
+func issueInputError(err error) *models.ValidationError {
+	message := err.Error()
+	switch err {
+	case issue.ErrLabelNotFound, issue.ErrMilestoneNotFound, issue.ErrAssigneeNotFound, issue.ErrStateInvalid:
+		return &models.ValidationError{Message: &message}
+	}
+
+	v, ok := err.(issue.ValidationErrors)
+	if !ok {
+		return nil
+	}
+	message = "The given issue input is invalid"
+	payload := &models.ValidationError{
+		Message: &message,
+	}
+	for _, verr := range v.Errors {
+		payload.Errors = append(payload.Errors, &models.ValidationErrorErrorsItems0{
+			Field:   verr.Field,
+			Message: verr.Error.Error(),
+		})
+	}
+	return payload
+}
+
+func issueNotFound(err error) bool {
+	return err == issue.ErrRepositoryNotFound || err == issue.ErrIssueNotFound
+}
+
+//ListIssuesHandler lists a page of a repository's issues
+func ListIssuesHandler(is issue.Service) issues.ListIssuesHandlerFunc {
+	return func(params issues.ListIssuesParams) middleware.Responder {
+		opts := issue.ListOptions{
+			State: *params.State,
+			Page:  pageOptions(params.Cursor, params.PerPage),
+		}
+		if opts.State == "all" {
+			opts.State = ""
+		}
+		if params.Label != nil {
+			opts.Label = *params.Label
+		}
+		if params.Assignee != nil {
+			opts.Assignee = *params.Assignee
+		}
+
+		list, next, err := is.List(params.HTTPRequest.Context(), params.Owner, params.Name, opts)
+		if err != nil {
+			message := err.Error()
+			if err == issue.ErrRepositoryNotFound {
+				return issues.NewListIssuesNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			if err == pagination.ErrCursorInvalid {
+				return issues.NewListIssuesUnprocessableEntity().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return issues.NewListIssuesDefault(http.StatusInternalServerError)
+		}
+
+		payload := make([]*models.Issue, 0, len(list))
+		for _, i := range list {
+			payload = append(payload, convertIssue(i))
+		}
+
+		return issues.NewListIssuesOK().
+			WithLink(nextLink(params.HTTPRequest, next)).
+			WithXNextCursor(next).
+			WithPayload(payload)
+	}
+}
+
+//CreateIssueHandler opens an issue
+func CreateIssueHandler(is issue.Service) issues.CreateIssueHandlerFunc {
+	return func(params issues.CreateIssueParams) middleware.Responder {
+		body := params.Issue
+		i := &issue.Issue{
+			Title: *body.Title,
+			Body:  body.Body,
+		}
+		for _, name := range body.Labels {
+			i.Labels = append(i.Labels, &issue.Label{Name: name})
+		}
+		for _, username := range body.Assignees {
+			i.Assignees = append(i.Assignees, &issue.Assignee{Username: username})
+		}
+		if body.Milestone != "" {
+			i.Milestone = &issue.Milestone{Title: body.Milestone}
+		}
+
+		i, err := is.Create(params.HTTPRequest.Context(), params.Owner, params.Name, i)
+		if err != nil {
+			if payload := issueInputError(err); payload != nil {
+				return issues.NewCreateIssueUnprocessableEntity().WithPayload(payload)
+			}
+
+			message := err.Error()
+			if err == issue.ErrRepositoryNotFound {
+				return issues.NewCreateIssueNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			if err == issue.ErrPermissionDenied {
+				return issues.NewCreateIssueForbidden().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return issues.NewCreateIssueDefault(http.StatusInternalServerError)
+		}
+
+		return issues.NewCreateIssueOK().WithPayload(convertIssue(i))
+	}
+}
+
+//GetIssueHandler gets an issue by its number
+func GetIssueHandler(is issue.Service) issues.GetIssueHandlerFunc {
+	return func(params issues.GetIssueParams) middleware.Responder {
+		i, err := is.Find(params.HTTPRequest.Context(), params.Owner, params.Name, int(params.Number))
+		if err != nil {
+			if issueNotFound(err) {
+				message := err.Error()
+				return issues.NewGetIssueNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return issues.NewGetIssueDefault(http.StatusInternalServerError)
+		}
+
+		return issues.NewGetIssueOK().WithPayload(convertIssue(i))
+	}
+}
+
+//UpdateIssueHandler updates an issue
+func UpdateIssueHandler(is issue.Service) issues.UpdateIssueHandlerFunc {
+	return func(params issues.UpdateIssueParams) middleware.Responder {
+		body := params.Update
+		update := issue.Update{
+			Title:     body.Title,
+			Body:      body.Body,
+			State:     body.State,
+			Milestone: body.Milestone,
+		}
+		// Missing arrays are nil, empty arrays remove all labels or assignees.
+		if body.Labels != nil {
+			update.Labels = &body.Labels
+		}
+		if body.Assignees != nil {
+			update.Assignees = &body.Assignees
+		}
+
+		i, err := is.Update(params.HTTPRequest.Context(), params.Owner, params.Name, int(params.Number), update)
+		if err != nil {
+			if payload := issueInputError(err); payload != nil {
+				return issues.NewUpdateIssueUnprocessableEntity().WithPayload(payload)
+			}
+
+			message := err.Error()
+			if issueNotFound(err) {
+				return issues.NewUpdateIssueNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			if err == issue.ErrPermissionDenied {
+				return issues.NewUpdateIssueForbidden().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return issues.NewUpdateIssueDefault(http.StatusInternalServerError)
+		}
+
+		return issues.NewUpdateIssueOK().WithPayload(convertIssue(i))
+	}
+}
+
+//ListIssueCommentsHandler gets the comments of an issue
+func ListIssueCommentsHandler(is issue.Service) issues.ListIssueCommentsHandlerFunc {
+	return func(params issues.ListIssueCommentsParams) middleware.Responder {
+		comments, err := is.Comments(params.HTTPRequest.Context(), params.Owner, params.Name, int(params.Number))
+		if err != nil {
+			if issueNotFound(err) {
+				message := err.Error()
+				return issues.NewListIssueCommentsNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return issues.NewListIssueCommentsDefault(http.StatusInternalServerError)
+		}
+
+		payload := make([]*models.IssueComment, 0, len(comments))
+		for _, c := range comments {
+			payload = append(payload, convertIssueComment(c))
+		}
+
+		return issues.NewListIssueCommentsOK().WithPayload(payload)
+	}
+}
+
+//CreateIssueCommentHandler comments on an issue
+func CreateIssueCommentHandler(is issue.Service) issues.CreateIssueCommentHandlerFunc {
+	return func(params issues.CreateIssueCommentParams) middleware.Responder {
+		c, err := is.CreateComment(params.HTTPRequest.Context(), params.Owner, params.Name, int(params.Number), &issue.Comment{
+			Body: *params.Comment.Body,
+		})
+		if err != nil {
+			if payload := issueInputError(err); payload != nil {
+				return issues.NewCreateIssueCommentUnprocessableEntity().WithPayload(payload)
+			}
+
+			message := err.Error()
+			if issueNotFound(err) {
+				return issues.NewCreateIssueCommentNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			if err == issue.ErrPermissionDenied {
+				return issues.NewCreateIssueCommentDefault(http.StatusForbidden).WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return issues.NewCreateIssueCommentDefault(http.StatusInternalServerError)
+		}
+
+		return issues.NewCreateIssueCommentOK().WithPayload(convertIssueComment(c))
+	}
+}
+
+//ListLabelsHandler lists a repository's labels
+func ListLabelsHandler(is issue.Service) issues.ListLabelsHandlerFunc {
+	return func(params issues.ListLabelsParams) middleware.Responder {
+		labels, err := is.Labels(params.HTTPRequest.Context(), params.Owner, params.Name)
+		if err != nil {
+			if err == issue.ErrRepositoryNotFound {
+				message := err.Error()
+				return issues.NewListLabelsNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return issues.NewListLabelsDefault(http.StatusInternalServerError)
+		}
+
+		payload := make([]*models.Label, 0, len(labels))
+		for _, l := range labels {
+			payload = append(payload, convertLabel(l))
+		}
+
+		return issues.NewListLabelsOK().WithPayload(payload)
+	}
+}
+
+//CreateLabelHandler creates a label in a repository
+func CreateLabelHandler(is issue.Service) issues.CreateLabelHandlerFunc {
+	return func(params issues.CreateLabelParams) middleware.Responder {
+		l, err := is.CreateLabel(params.HTTPRequest.Context(), params.Owner, params.Name, &issue.Label{
+			Name:        *params.Label.Name,
+			Color:       *params.Label.Color,
+			Description: params.Label.Description,
+		})
+		if err != nil {
+			if payload := issueInputError(err); payload != nil {
+				return issues.NewCreateLabelUnprocessableEntity().WithPayload(payload)
+			}
+
+			message := err.Error()
+			payload := &models.Error{Message: &message}
+			if err == issue.ErrRepositoryNotFound {
+				return issues.NewCreateLabelNotFound().WithPayload(payload)
+			}
+			if err == issue.ErrPermissionDenied {
+				return issues.NewCreateLabelForbidden().WithPayload(payload)
+			}
+			if err == issue.ErrLabelExists {
+				return issues.NewCreateLabelConflict().WithPayload(payload)
+			}
+			return issues.NewCreateLabelDefault(http.StatusInternalServerError)
+		}
+
+		return issues.NewCreateLabelOK().WithPayload(convertLabel(l))
+	}
+}
+
+//DeleteLabelHandler deletes a label from a repository
+func DeleteLabelHandler(is issue.Service) issues.DeleteLabelHandlerFunc {
+	return func(params issues.DeleteLabelParams) middleware.Responder {
+		err := is.DeleteLabel(params.HTTPRequest.Context(), params.Owner, params.Name, params.Label)
+		if err != nil {
+			message := err.Error()
+			payload := &models.Error{Message: &message}
+			if err == issue.ErrRepositoryNotFound || err == issue.ErrLabelNotFound {
+				return issues.NewDeleteLabelNotFound().WithPayload(payload)
+			}
+			if err == issue.ErrPermissionDenied {
+				return issues.NewDeleteLabelForbidden().WithPayload(payload)
+			}
+			return issues.NewDeleteLabelDefault(http.StatusInternalServerError)
+		}
+
+		return issues.NewDeleteLabelNoContent()
+	}
+}
+
+//ListMilestonesHandler lists a repository's milestones
+func ListMilestonesHandler(is issue.Service) issues.ListMilestonesHandlerFunc {
+	return func(params issues.ListMilestonesParams) middleware.Responder {
+		milestones, err := is.Milestones(params.HTTPRequest.Context(), params.Owner, params.Name)
+		if err != nil {
+			if err == issue.ErrRepositoryNotFound {
+				message := err.Error()
+				return issues.NewListMilestonesNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return issues.NewListMilestonesDefault(http.StatusInternalServerError)
+		}
+
+		payload := make([]*models.Milestone, 0, len(milestones))
+		for _, m := range milestones {
+			payload = append(payload, convertMilestone(m))
+		}
+
+		return issues.NewListMilestonesOK().WithPayload(payload)
+	}
+}
+
+//CreateMilestoneHandler creates a milestone in a repository
+func CreateMilestoneHandler(is issue.Service) issues.CreateMilestoneHandlerFunc {
+	return func(params issues.CreateMilestoneParams) middleware.Responder {
+		m, err := is.CreateMilestone(params.HTTPRequest.Context(), params.Owner, params.Name, &issue.Milestone{
+			Title:       *params.Milestone.Title,
+			Description: params.Milestone.Description,
+			Due:         time.Time(params.Milestone.DueOn),
+		})
+		if err != nil {
+			if payload := issueInputError(err); payload != nil {
+				return issues.NewCreateMilestoneUnprocessableEntity().WithPayload(payload)
+			}
+
+			message := err.Error()
+			payload := &models.Error{Message: &message}
+			if err == issue.ErrRepositoryNotFound {
+				return issues.NewCreateMilestoneNotFound().WithPayload(payload)
+			}
+			if err == issue.ErrPermissionDenied {
+				return issues.NewCreateMilestoneForbidden().WithPayload(payload)
+			}
+			if err == issue.ErrMilestoneExists {
+				return issues.NewCreateMilestoneConflict().WithPayload(payload)
+			}
+			return issues.NewCreateMilestoneDefault(http.StatusInternalServerError)
+		}
+
+		return issues.NewCreateMilestoneOK().WithPayload(convertMilestone(m))
+	}
+}
+
+func convertStatus(s *status.Status) *models.Status {
+	creator := s.Creator
+	return &models.Status{
+		ID:          strfmt.UUID(s.ID),
+		Sha:         s.Sha1,
+		Context:     s.Context,
+		State:       &s.State,
+		TargetURL:   s.TargetURL,
+		Description: s.Description,
+		Creator: &models.User{
+			ID:       strfmt.UUID(s.CreatorID),
+			Username: &creator,
+		},
+		CreatedAt: strfmt.DateTime(s.Created),
+	}
+}
+
+func convertProtection(p *status.Protection) *models.BranchProtection {
+	checks := p.RequiredChecks
+	if checks == nil {
+		checks = []string{}
+	}
+	return &models.BranchProtection{
+		Branch:         p.Branch,
+		RequiredChecks: checks,
+	}
+}
+
+func statusInputError(err error) *models.ValidationError {
+	v, ok := err.(status.ValidationErrors)
+	if !ok {
+		return nil
+	}
+	message := "The given status input is invalid"
+	payload := &models.ValidationError{
+		Message: &message,
+	}
+	for _, verr := range v.Errors {
+		payload.Errors = append(payload.Errors, &models.ValidationErrorErrorsItems0{
+			Field:   verr.Field,
+			Message: verr.Error.Error(),
+		})
+	}
+	return payload
+}
+
+func statusNotFound(err error) bool {
+	return err == status.ErrRepositoryNotFound || err == status.ErrCommitNotFound
+}
+
+//ListStatusesHandler lists the statuses of a commit
+func ListStatusesHandler(ss status.Service) statuses.ListStatusesHandlerFunc {
+	return func(params statuses.ListStatusesParams) middleware.Responder {
+		list, err := ss.List(params.HTTPRequest.Context(), params.Owner, params.Name, params.Ref)
+		if err != nil {
+			if statusNotFound(err) {
+				message := err.Error()
+				return statuses.NewListStatusesNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return statuses.NewListStatusesDefault(http.StatusInternalServerError)
+		}
+
+		payload := make([]*models.Status, 0, len(list))
+		for _, s := range list {
+			payload = append(payload, convertStatus(s))
+		}
+
+		return statuses.NewListStatusesOK().WithPayload(payload)
+	}
+}
+
+//CreateStatusHandler reports the status of a commit
+func CreateStatusHandler(ss status.Service) statuses.CreateStatusHandlerFunc {
+	return func(params statuses.CreateStatusParams) middleware.Responder {
+		s, err := ss.Create(params.HTTPRequest.Context(), params.Owner, params.Name, params.Ref, &status.Status{
+			Context:     params.Status.Context,
+			State:       *params.Status.State,
+			TargetURL:   params.Status.TargetURL,
+			Description: params.Status.Description,
+		})
+		if err != nil {
+			if payload := statusInputError(err); payload != nil {
+				return statuses.NewCreateStatusUnprocessableEntity().WithPayload(payload)
+			}
+
+			message := err.Error()
+			payload := &models.Error{Message: &message}
+			if statusNotFound(err) {
+				return statuses.NewCreateStatusNotFound().WithPayload(payload)
+			}
+			if err == status.ErrPermissionDenied {
+				return statuses.NewCreateStatusForbidden().WithPayload(payload)
+			}
+			return statuses.NewCreateStatusDefault(http.StatusInternalServerError)
+		}
+
+		return statuses.NewCreateStatusOK().WithPayload(convertStatus(s))
+	}
+}
+
+//GetCombinedStatusHandler gets the combined status of a commit
+func GetCombinedStatusHandler(ss status.Service) statuses.GetCombinedStatusHandlerFunc {
+	return func(params statuses.GetCombinedStatusParams) middleware.Responder {
+		c, err := ss.Combined(params.HTTPRequest.Context(), params.Owner, params.Name, params.Ref)
+		if err != nil {
+			if statusNotFound(err) {
+				message := err.Error()
+				return statuses.NewGetCombinedStatusNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return statuses.NewGetCombinedStatusDefault(http.StatusInternalServerError)
+		}
+
+		payload := &models.CombinedStatus{
+			Sha:      &c.Sha1,
+			State:    &c.State,
+			Statuses: make([]*models.Status, 0, len(c.Statuses)),
+		}
+		for _, s := range c.Statuses {
+			payload.Statuses = append(payload.Statuses, convertStatus(s))
+		}
+
+		return statuses.NewGetCombinedStatusOK().WithPayload(payload)
+	}
+}
+
+//GetBranchProtectionHandler gets the required status checks of a branch
+func GetBranchProtectionHandler(ss status.Service) statuses.GetBranchProtectionHandlerFunc {
+	return func(params statuses.GetBranchProtectionParams) middleware.Responder {
+		p, err := ss.Protection(params.HTTPRequest.Context(), params.Owner, params.Name, params.Branch)
+		if err != nil {
+			if err == status.ErrRepositoryNotFound {
+				message := err.Error()
+				return statuses.NewGetBranchProtectionNotFound().WithPayload(&models.Error{
+					Message: &message,
+				})
+			}
+			return statuses.NewGetBranchProtectionDefault(http.StatusInternalServerError)
+		}
+
+		return statuses.NewGetBranchProtectionOK().WithPayload(convertProtection(p))
+	}
+}
+
+//UpdateBranchProtectionHandler replaces the required status checks of a branch
+func UpdateBranchProtectionHandler(ss status.Service) statuses.UpdateBranchProtectionHandlerFunc {
+	return func(params statuses.UpdateBranchProtectionParams) middleware.Responder {
+		p, err := ss.UpdateProtection(params.HTTPRequest.Context(), params.Owner, params.Name, &status.Protection{
+			Branch:         params.Branch,
+			RequiredChecks: params.Protection.RequiredChecks,
+		})
+		if err != nil {
+			if payload := statusInputError(err); payload != nil {
+				return statuses.NewUpdateBranchProtectionUnprocessableEntity().WithPayload(payload)
+			}
+
+			message := err.Error()
+			payload := &models.Error{Message: &message}
+			if err == status.ErrRepositoryNotFound {
+				return statuses.NewUpdateBranchProtectionNotFound().WithPayload(payload)
+			}
+			if err == status.ErrPermissionDenied {
+				return statuses.NewUpdateBranchProtectionForbidden().WithPayload(payload)
+			}
+			return statuses.NewUpdateBranchProtectionDefault(http.StatusInternalServerError)
+		}
+
+		return statuses.NewUpdateBranchProtectionOK().WithPayload(convertProtection(p))
+	}
+}
+
+func convertEvent(e *event.Event) *models.Event {
+	m := &models.Event{
+		ID:         strfmt.UUID(e.ID),
+		Type:       &e.Type,
+		Repository: e.Repository,
+		Public:     e.Public,
+		Data:       e.Data,
+		CreatedAt:  strfmt.DateTime(e.Created),
+	}
+	if e.ActorID != "" {
+		actor := e.Actor
+		m.Actor = &models.User{
+			ID:       strfmt.UUID(e.ActorID),
+			Username: &actor,
+		}
+	}
+	return m
+}
+
+func convertEvents(list []*event.Event) []*models.Event {
+	payload := []*models.Event{}
+	for _, e := range list {
+		payload = append(payload, convertEvent(e))
+	}
+	return payload
+}
+
+//ListEventsHandler lists the events of all users and repositories for admins
+func ListEventsHandler(es event.Service) events.ListEventsHandlerFunc {
+	return func(params events.ListEventsParams) middleware.Responder {
+		list, next, err := es.Feed(params.HTTPRequest.Context(), pageOptions(params.Cursor, params.PerPage))
+		if err != nil {
+			message := err.Error()
+			payload := &models.Error{Message: &message}
+			if err == event.ErrPermissionDenied {
+				return events.NewListEventsForbidden().WithPayload(payload)
+			}
+			if err == pagination.ErrCursorInvalid {
+				return events.NewListEventsUnprocessableEntity().WithPayload(payload)
+			}
+			return events.NewListEventsDefault(http.StatusInternalServerError)
+		}
+
+		return events.NewListEventsOK().
+			WithLink(nextLink(params.HTTPRequest, next)).
+			WithXNextCursor(next).
+			WithPayload(convertEvents(list))
+	}
+}
+
+//ListRepositoryEventsHandler lists the events of a repository
+func ListRepositoryEventsHandler(es event.Service) events.ListRepositoryEventsHandlerFunc {
+	return func(params events.ListRepositoryEventsParams) middleware.Responder {
+		list, next, err := es.RepositoryFeed(params.HTTPRequest.Context(), params.Owner, params.Name, pageOptions(params.Cursor, params.PerPage))
+		if err != nil {
+			message := err.Error()
+			payload := &models.Error{Message: &message}
+			if err == event.ErrRepositoryNotFound {
+				return events.NewListRepositoryEventsNotFound().WithPayload(payload)
+			}
+			if err == pagination.ErrCursorInvalid {
+				return events.NewListRepositoryEventsUnprocessableEntity().WithPayload(payload)
+			}
+			return events.NewListRepositoryEventsDefault(http.StatusInternalServerError)
+		}
+
+		return events.NewListRepositoryEventsOK().
+			WithLink(nextLink(params.HTTPRequest, next)).
+			WithXNextCursor(next).
+			WithPayload(convertEvents(list))
+	}
+}
+
+//ListUserEventsHandler lists the events done by a user
+func ListUserEventsHandler(es event.Service) events.ListUserEventsHandlerFunc {
+	return func(params events.ListUserEventsParams) middleware.Responder {
+		list, next, err := es.UserFeed(params.HTTPRequest.Context(), params.Username, pageOptions(params.Cursor, params.PerPage))
+		if err != nil {
+			message := err.Error()
+			payload := &models.Error{Message: &message}
+			if err == event.ErrUserNotFound {
+				return events.NewListUserEventsNotFound().WithPayload(payload)
+			}
+			if err == pagination.ErrCursorInvalid {
+				return events.NewListUserEventsUnprocessableEntity().WithPayload(payload)
+			}
+			return events.NewListUserEventsDefault(http.StatusInternalServerError)
+		}
+
+		return events.NewListUserEventsOK().
+			WithLink(nextLink(params.HTTPRequest, next)).
+			WithXNextCursor(next).
+			WithPayload(convertEvents(list))
+	}
+}
+
+//RenderMarkdownHandler renders Markdown to sanitized HTML
+func RenderMarkdownHandler(mks markup.Service) markdown.RenderMarkdownHandlerFunc {
+	return func(params markdown.RenderMarkdownParams) middleware.Responder {
+		html, err := mks.Render(params.HTTPRequest.Context(), *params.Markdown.Text, markup.Options{
+			Repository: params.Markdown.Repository,
+		})
+		if err != nil {
+			if err == markup.ErrTextTooLarge || err == markup.ErrRepositoryInvalid {
+				message := err.Error()
+				return markdown.NewRenderMarkdownUnprocessableEntity().WithPayload(&models.Error{Message: &message})
+			}
+			return markdown.NewRenderMarkdownDefault(http.StatusInternalServerError)
+		}
+
+		return markdown.NewRenderMarkdownOK().WithPayload(&models.Markdown{HTML: &html})
+	}
+}
+
+func convertNotifications(list []*notification.Notification) []*models.Notification {
+	payload := make([]*models.Notification, 0, len(list))
+	for _, n := range list {
+		reason := n.Reason
+		unread := n.Read.IsZero()
+		payload = append(payload, &models.Notification{
+			ID:        strfmt.UUID(n.ID),
+			Reason:    &reason,
+			Unread:    &unread,
+			Event:     convertEvent(n.Event),
+			CreatedAt: strfmt.DateTime(n.Created),
+		})
+	}
+	return payload
+}
+
+func convertNotificationPreferences(p notification.Preferences) []*models.NotificationPreference {
+	payload := make([]*models.NotificationPreference, 0, len(p))
+	for _, reason := range notification.Reasons {
+		reason := reason
+		d := p[reason]
+		payload = append(payload, &models.NotificationPreference{
+			Reason: &reason,
+			Web:    &d.Web,
+			Email:  &d.Email,
+		})
+	}
+	return payload
+}
+
+//ListNotificationsHandler lists the notifications of the current user
+func ListNotificationsHandler(ns notification.Service) notifications.ListNotificationsHandlerFunc {
+	return func(params notifications.ListNotificationsParams) middleware.Responder {
+		opts := notification.ListOptions{
+			All:  params.All != nil && *params.All,
+			Page: pageOptions(params.Cursor, params.PerPage),
+		}
+
+		list, next, err := ns.List(params.HTTPRequest.Context(), opts)
+		if err != nil {
+			if err == pagination.ErrCursorInvalid {
+				message := err.Error()
+				return notifications.NewListNotificationsUnprocessableEntity().WithPayload(&models.Error{Message: &message})
+			}
+			return notifications.NewListNotificationsDefault(http.StatusInternalServerError)
+		}
+
+		return notifications.NewListNotificationsOK().
+			WithLink(nextLink(params.HTTPRequest, next)).
+			WithXNextCursor(next).
+			WithPayload(convertNotifications(list))
+	}
+}
+
+//MarkNotificationReadHandler marks a notification of the current user as read
+func MarkNotificationReadHandler(ns notification.Service) notifications.MarkNotificationReadHandlerFunc {
+	return func(params notifications.MarkNotificationReadParams) middleware.Responder {
+		if err := ns.MarkRead(params.HTTPRequest.Context(), params.ID.String()); err != nil {
+			if err == notification.ErrNotFound {
+				message := err.Error()
+				return notifications.NewMarkNotificationReadNotFound().WithPayload(&models.Error{Message: &message})
+			}
+			return notifications.NewMarkNotificationReadDefault(http.StatusInternalServerError)
+		}
+
+		return notifications.NewMarkNotificationReadNoContent()
+	}
+}
+
+//MarkNotificationsReadHandler marks all notifications of the current user as read
+func MarkNotificationsReadHandler(ns notification.Service) notifications.MarkNotificationsReadHandlerFunc {
+	return func(params notifications.MarkNotificationsReadParams) middleware.Responder {
+		if err := ns.MarkAllRead(params.HTTPRequest.Context()); err != nil {
+			return notifications.NewMarkNotificationsReadDefault(http.StatusInternalServerError)
+		}
+
+		return notifications.NewMarkNotificationsReadNoContent()
+	}
+}
+
+//GetNotificationPreferencesHandler gets how the current user's notifications are delivered
+func GetNotificationPreferencesHandler(ns notification.Service) notifications.GetNotificationPreferencesHandlerFunc {
+	return func(params notifications.GetNotificationPreferencesParams) middleware.Responder {
+		p, err := ns.Preferences(params.HTTPRequest.Context())
+		if err != nil {
+			return notifications.NewGetNotificationPreferencesDefault(http.StatusInternalServerError)
+		}
+
+		return notifications.NewGetNotificationPreferencesOK().WithPayload(convertNotificationPreferences(p))
+	}
+}
+
+//UpdateNotificationPreferencesHandler changes how the current user's notifications are delivered
+func UpdateNotificationPreferencesHandler(ns notification.Service) notifications.UpdateNotificationPreferencesHandlerFunc {
+	return func(params notifications.UpdateNotificationPreferencesParams) middleware.Responder {
+		update := notification.Preferences{}
+		for _, p := range params.Preferences {
+			update[*p.Reason] = notification.Delivery{Web: *p.Web, Email: *p.Email}
+		}
+
+		p, err := ns.UpdatePreferences(params.HTTPRequest.Context(), update)
+		if err != nil {
+			if err == notification.ErrReasonInvalid {
+				message := err.Error()
+				return notifications.NewUpdateNotificationPreferencesUnprocessableEntity().WithPayload(&models.Error{Message: &message})
+			}
+			return notifications.NewUpdateNotificationPreferencesDefault(http.StatusInternalServerError)
+		}
+
+		return notifications.NewUpdateNotificationPreferencesOK().WithPayload(convertNotificationPreferences(p))
+	}
+}
+
+func convertStarredRepositories(list []*repository.StarredRepository) []*models.Repository {
+	payload := make([]*models.Repository, 0, len(list))
+	for _, sr := range list {
+		payload = append(payload, convertOwnedRepository(&sr.OwnedRepository))
+	}
+	return payload
+}
+
+// ListStarredRepositoriesHandler lists the repositories starred by the current user
+func ListStarredRepositoriesHandler(rs repository.Service) stars.ListStarredRepositoriesHandlerFunc {
+	return func(params stars.ListStarredRepositoriesParams) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		u := session.GetSessionUser(ctx)
+
+		list, next, err := rs.Starred(ctx, u.Username, pageOptions(params.Cursor, params.PerPage))
+		if err != nil {
+			if err == pagination.ErrCursorInvalid {
+				message := err.Error()
+				return stars.NewListStarredRepositoriesUnprocessableEntity().WithPayload(&models.Error{Message: &message})
+			}
+			return stars.NewListStarredRepositoriesDefault(http.StatusInternalServerError)
+		}
+
+		return stars.NewListStarredRepositoriesOK().
+			WithLink(nextLink(params.HTTPRequest, next)).
+			WithXNextCursor(next).
+			WithPayload(convertStarredRepositories(list))
+	}
+}
+
+// ListUserStarredRepositoriesHandler lists the repositories starred by a user
+func ListUserStarredRepositoriesHandler(rs repository.Service) stars.ListUserStarredRepositoriesHandlerFunc {
+	return func(params stars.ListUserStarredRepositoriesParams) middleware.Responder {
+		list, next, err := rs.Starred(params.HTTPRequest.Context(), params.Username, pageOptions(params.Cursor, params.PerPage))
+		if err != nil {
+			if err == repository.ErrOwnerNotFound {
+				message := "user not found"
+				return stars.NewListUserStarredRepositoriesNotFound().WithPayload(&models.Error{Message: &message})
+			}
+			if err == pagination.ErrCursorInvalid {
+				message := err.Error()
+				return stars.NewListUserStarredRepositoriesUnprocessableEntity().WithPayload(&models.Error{Message: &message})
+			}
+			return stars.NewListUserStarredRepositoriesDefault(http.StatusInternalServerError)
+		}
+
+		return stars.NewListUserStarredRepositoriesOK().
+			WithLink(nextLink(params.HTTPRequest, next)).
+			WithXNextCursor(next).
+			WithPayload(convertStarredRepositories(list))
+	}
+}
+
+// CheckRepositoryStarredHandler checks if the current user starred a repository
+func CheckRepositoryStarredHandler(rs repository.Service) stars.CheckRepositoryStarredHandlerFunc {
+	return func(params stars.CheckRepositoryStarredParams) middleware.Responder {
+		starred, err := rs.IsStarred(params.HTTPRequest.Context(), params.Owner, params.Name)
+		if err != nil && err != repository.ErrRepositoryNotFound {
+			return stars.NewCheckRepositoryStarredDefault(http.StatusInternalServerError)
+		}
+		if !starred {
+			message := "repository is not starred"
+			if err != nil {
+				message = err.Error()
+			}
+			return stars.NewCheckRepositoryStarredNotFound().WithPayload(&models.Error{Message: &message})
+		}
+
+		return stars.NewCheckRepositoryStarredNoContent()
+	}
+}
+
+// StarRepositoryHandler stars a repository for the current user
+func StarRepositoryHandler(rs repository.Service) stars.StarRepositoryHandlerFunc {
+	return func(params stars.StarRepositoryParams) middleware.Responder {
+		if err := rs.Star(params.HTTPRequest.Context(), params.Owner, params.Name); err != nil {
+			if err == repository.ErrRepositoryNotFound {
+				message := err.Error()
+				return stars.NewStarRepositoryNotFound().WithPayload(&models.Error{Message: &message})
+			}
+			return stars.NewStarRepositoryDefault(http.StatusInternalServerError)
+		}
+
+		return stars.NewStarRepositoryNoContent()
+	}
+}
+
+// UnstarRepositoryHandler unstars a repository for the current user
+func UnstarRepositoryHandler(rs repository.Service) stars.UnstarRepositoryHandlerFunc {
+	return func(params stars.UnstarRepositoryParams) middleware.Responder {
+		if err := rs.Unstar(params.HTTPRequest.Context(), params.Owner, params.Name); err != nil {
+			if err == repository.ErrRepositoryNotFound {
+				message := err.Error()
+				return stars.NewUnstarRepositoryNotFound().WithPayload(&models.Error{Message: &message})
+			}
+			return stars.NewUnstarRepositoryDefault(http.StatusInternalServerError)
+		}
+
+		return stars.NewUnstarRepositoryNoContent()
+	}
+}
+
+// ListRepositoryWatchersHandler lists the users watching a repository
+func ListRepositoryWatchersHandler(rs repository.Service) watching.ListRepositoryWatchersHandlerFunc {
+	return func(params watching.ListRepositoryWatchersParams) middleware.Responder {
+		watchers, err := rs.Watchers(params.HTTPRequest.Context(), params.Owner, params.Name)
+		if err != nil {
+			if err == repository.ErrRepositoryNotFound {
+				message := err.Error()
+				return watching.NewListRepositoryWatchersNotFound().WithPayload(&models.Error{Message: &message})
+			}
+			return watching.NewListRepositoryWatchersDefault(http.StatusInternalServerError)
+		}
+
+		payload := make([]*models.User, 0, len(watchers))
+		for _, w := range watchers {
+			username := w.Username
+			payload = append(payload, &models.User{
+				ID:       strfmt.UUID(w.UserID),
+				Username: &username,
+			})
+		}
+
+		return watching.NewListRepositoryWatchersOK().WithPayload(payload)
+	}
+}
+
+// WatchRepositoryHandler makes the current user watch a repository
+func WatchRepositoryHandler(rs repository.Service) watching.WatchRepositoryHandlerFunc {
+	return func(params watching.WatchRepositoryParams) middleware.Responder {
+		if err := rs.Watch(params.HTTPRequest.Context(), params.Owner, params.Name); err != nil {
+			if err == repository.ErrRepositoryNotFound {
+				message := err.Error()
+				return watching.NewWatchRepositoryNotFound().WithPayload(&models.Error{Message: &message})
+			}
+			return watching.NewWatchRepositoryDefault(http.StatusInternalServerError)
+		}
+
+		return watching.NewWatchRepositoryNoContent()
+	}
+}
+
+// UnwatchRepositoryHandler makes the current user stop watching a repository
+func UnwatchRepositoryHandler(rs repository.Service) watching.UnwatchRepositoryHandlerFunc {
+	return func(params watching.UnwatchRepositoryParams) middleware.Responder {
+		if err := rs.Unwatch(params.HTTPRequest.Context(), params.Owner, params.Name); err != nil {
+			if err == repository.ErrRepositoryNotFound {
+				message := err.Error()
+				return watching.NewUnwatchRepositoryNotFound().WithPayload(&models.Error{Message: &message})
+			}
+			return watching.NewUnwatchRepositoryDefault(http.StatusInternalServerError)
+		}
+
+		return watching.NewUnwatchRepositoryNoContent()
 	}
 }
@@ -2,11 +2,14 @@ package v1
 
 import (
 	"context"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/importer"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
 	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
 	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
 	"github.com/sourcepods/sourcepods/pkg/storage"
@@ -15,7 +18,7 @@ import (
 
 type repositoryTestService struct{}
 
-func (repositoryTestService) List(ctx context.Context, owner string) ([]*repository.Repository, string, error) {
+func (repositoryTestService) List(ctx context.Context, owner string, opts repository.ListOptions) ([]*repository.Repository, string, error) {
 	panic("implement me")
 }
 
@@ -27,7 +30,19 @@ func (repositoryTestService) Create(ctx context.Context, owner string, repositor
 	panic("implement me")
 }
 
-func (repositoryTestService) Branches(ctx context.Context, owner string, name string) ([]*repository.Branch, error) {
+func (repositoryTestService) Branches(ctx context.Context, owner string, name string, opts repository.BranchesOptions) ([]*repository.Branch, string, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) CreateBranch(ctx context.Context, owner string, name string, branch string, rev string) (*repository.Branch, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) DeleteBranch(ctx context.Context, owner string, name string, branch string, sha1 string) error {
+	panic("implement me")
+}
+
+func (repositoryTestService) RenameBranch(ctx context.Context, owner string, name string, branch string, newName string) (*repository.Branch, error) {
 	panic("implement me")
 }
 
@@ -39,12 +54,104 @@ func (repositoryTestService) Tree(ctx context.Context, owner string, name string
 	panic("implement me")
 }
 
+func (repositoryTestService) Blob(ctx context.Context, owner string, name string, rev string, path string) (storage.Blob, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) ReadBlob(ctx context.Context, owner string, name string, sha1 string, offset int64, limit int64, w io.Writer) error {
+	panic("implement me")
+}
+
+func (repositoryTestService) Blame(ctx context.Context, owner string, name string, rev string, path string) ([]storage.BlameHunk, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) Readme(ctx context.Context, owner string, name string, rev string) (*repository.Readme, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) Search(ctx context.Context, owner string, name string, rev string, opts storage.SearchOptions) ([]storage.SearchMatch, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) SearchCode(ctx context.Context, opts storage.IndexSearchOptions) ([]*repository.CodeMatch, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) SearchRepositories(ctx context.Context, opts repository.SearchOptions) ([]*repository.OwnedRepository, string, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) Delete(ctx context.Context, owner string, name string) error {
+	panic("implement me")
+}
+
+func (repositoryTestService) Update(ctx context.Context, owner string, name string, update repository.Update) (*repository.Repository, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) Transfer(ctx context.Context, owner string, name string, newOwner string) (*repository.Repository, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) Redirect(ctx context.Context, owner string, name string) (string, string, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) Fork(ctx context.Context, owner string, name string, forkName string) (*repository.Repository, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) Forks(ctx context.Context, owner string, name string) ([]*repository.OwnedRepository, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) Network(ctx context.Context, owner string, name string) ([]*repository.OwnedRepository, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) Archive(ctx context.Context, owner string, name string, rev string, format string, prefix string, w io.Writer) error {
+	panic("implement me")
+}
+
+func (repositoryTestService) Stats(ctx context.Context, owner string, name string) (*repository.Stats, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) Star(ctx context.Context, owner string, name string) error {
+	panic("implement me")
+}
+
+func (repositoryTestService) Unstar(ctx context.Context, owner string, name string) error {
+	panic("implement me")
+}
+
+func (repositoryTestService) IsStarred(ctx context.Context, owner string, name string) (bool, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) Starred(ctx context.Context, username string, opts pagination.Options) ([]*repository.StarredRepository, string, error) {
+	panic("implement me")
+}
+
+func (repositoryTestService) Watch(ctx context.Context, owner string, name string) error {
+	panic("implement me")
+}
+
+func (repositoryTestService) Unwatch(ctx context.Context, owner string, name string) error {
+	panic("implement me")
+}
+
+func (repositoryTestService) Watchers(ctx context.Context, owner string, name string) ([]*repository.Watcher, error) {
+	panic("implement me")
+}
+
 type userTestService struct {
-	FinAll func(context.Context) ([]*user.User, error)
+	FinAll func(context.Context, user.ListOptions) ([]*user.User, string, error)
 }
 
-func (u userTestService) FindAll(ctx context.Context) ([]*user.User, error) {
-	return u.FinAll(ctx)
+func (u userTestService) FindAll(ctx context.Context, opts user.ListOptions) ([]*user.User, string, error) {
+	return u.FinAll(ctx, opts)
 }
 
 func (u userTestService) Find(context.Context, string) (*user.User, error) {
@@ -59,6 +166,10 @@ func (u userTestService) FindRepositoryOwner(ctx context.Context, repositoryID s
 	panic("implement me")
 }
 
+func (u userTestService) Search(context.Context, user.SearchOptions) ([]*user.User, string, error) {
+	panic("implement me")
+}
+
 func (u userTestService) Create(context.Context, *user.User) (*user.User, error) {
 	panic("implement me")
 }
@@ -70,25 +181,29 @@ func (u userTestService) Update(context.Context, *user.User) (*user.User, error)
 func (u userTestService) Delete(context.Context, string) error { panic("implement me") }
 
 func TestUsersListUsersHandler(t *testing.T) {
-	findAll := func(ctx context.Context) ([]*user.User, error) {
+	findAll := func(ctx context.Context, opts user.ListOptions) ([]*user.User, string, error) {
+		assert.Equal(t, user.SortName, opts.Sort)
+		assert.Equal(t, 1, opts.Page.PerPage)
 		return []*user.User{{
 			ID:       "2849392e-6eca-43f0-9bec-b16beac5c2b1",
 			Email:    "mail@example.com",
 			Username: "username",
 			Name:     "User Name",
 			Password: "secret",
-		}}, nil
+		}}, "next", nil
 	}
 
-	api, err := New(repositoryTestService{}, userTestService{FinAll: findAll})
+	api, err := New(repositoryTestService{}, userTestService{FinAll: findAll}, nil, nil, nil, nil, nil, nil, nil, nil)
 	assert.NoError(t, err)
 
 	ts := httptest.NewServer(api.Handler)
 	defer ts.Close()
 
-	res, err := http.Get(ts.URL + "/v1/users")
+	res, err := http.Get(ts.URL + "/v1/users?per_page=1")
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, `</v1/users?cursor=next&per_page=1>; rel="next"`, res.Header.Get("Link"))
+	assert.Equal(t, "next", res.Header.Get("X-Next-Cursor"))
 
 	defer res.Body.Close()
 
@@ -105,3 +220,56 @@ func TestUsersListUsersHandler(t *testing.T) {
 	assert.NoError(t, err)
 	assert.JSONEq(t, expectedUser, string(body))
 }
+
+type importTestService struct {
+	importer.Service
+}
+
+func (importTestService) Find(ctx context.Context, id string) (*importer.Job, error) {
+	if id != "b5d0e0c4-d4f3-4a4b-9d64-6a2a25ad3a1b" {
+		return nil, importer.ErrJobNotFound
+	}
+	return &importer.Job{
+		ID:       id,
+		Owner:    "foo",
+		Name:     "bar",
+		URL:      "https://example.com/bar.git",
+		State:    importer.StateRunning,
+		Progress: "Receiving objects:  50% (1/2)",
+	}, nil
+}
+
+func TestRepositoriesGetRepositoryImportHandler(t *testing.T) {
+	api, err := New(repositoryTestService{}, userTestService{}, nil, nil, nil, nil, importTestService{}, nil, nil, nil)
+	assert.NoError(t, err)
+
+	ts := httptest.NewServer(api.Handler)
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + "/v1/repositories/import/b5d0e0c4-d4f3-4a4b-9d64-6a2a25ad3a1b")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	defer res.Body.Close()
+
+	expectedJob := `{
+		"id": "b5d0e0c4-d4f3-4a4b-9d64-6a2a25ad3a1b",
+		"state": "running",
+		"owner": "foo",
+		"name": "bar",
+		"url": "https://example.com/bar.git",
+		"progress": "Receiving objects:  50% (1/2)",
+		"created_at": "0001-01-01T00:00:00.000Z",
+		"updated_at": "0001-01-01T00:00:00.000Z",
+		"finished_at": "0001-01-01T00:00:00.000Z"
+	}`
+
+	body, err := ioutil.ReadAll(res.Body)
+	assert.NoError(t, err)
+	assert.JSONEq(t, expectedJob, string(body))
+
+	res, err = http.Get(ts.URL + "/v1/repositories/import/unknown")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	res.Body.Close()
+}
@@ -6,27 +6,132 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
 	strfmt "github.com/go-openapi/strfmt"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Branch branch
 // swagger:model branch
 type Branch struct {
 
+	// ahead
+	Ahead int64 `json:"ahead,omitempty"`
+
+	// author date
+	// Format: date-time
+	AuthorDate strfmt.DateTime `json:"author_date,omitempty"`
+
+	// author email
+	AuthorEmail string `json:"author_email,omitempty"`
+
+	// author name
+	AuthorName string `json:"author_name,omitempty"`
+
+	// behind
+	Behind int64 `json:"behind,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
+	// protected
+	Protected bool `json:"protected,omitempty"`
+
+	// The contexts of statuses required to succeed before merging into the branch
+	RequiredChecks []string `json:"required_checks"`
+
 	// sha1
 	Sha1 string `json:"sha1,omitempty"`
 
+	// The state combined from the statuses of the branch's head
+	// Enum: [pending success failure]
+	Status string `json:"status,omitempty"`
+
+	// subject
+	Subject string `json:"subject,omitempty"`
+
 	// type
 	Type string `json:"type,omitempty"`
 }
 
 // Validate validates this branch
 func (m *Branch) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAuthorDate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Branch) validateAuthorDate(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AuthorDate) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("author_date", "body", "date-time", m.AuthorDate.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var branchTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","success","failure"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		branchTypeStatusPropEnum = append(branchTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// BranchStatusPending captures enum value "pending"
+	BranchStatusPending string = "pending"
+
+	// BranchStatusSuccess captures enum value "success"
+	BranchStatusSuccess string = "success"
+
+	// BranchStatusFailure captures enum value "failure"
+	BranchStatusFailure string = "failure"
+)
+
+// prop value enum
+func (m *Branch) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, branchTypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Branch) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
 	return nil
 }
 
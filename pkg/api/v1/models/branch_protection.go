@@ -0,0 +1,68 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BranchProtection branch protection
+// swagger:model branchProtection
+type BranchProtection struct {
+
+	// branch
+	// Read Only: true
+	Branch string `json:"branch,omitempty"`
+
+	// required checks
+	// Required: true
+	RequiredChecks []string `json:"required_checks"`
+}
+
+// Validate validates this branch protection
+func (m *BranchProtection) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRequiredChecks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BranchProtection) validateRequiredChecks(formats strfmt.Registry) error {
+
+	if err := validate.Required("required_checks", "body", m.RequiredChecks); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BranchProtection) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BranchProtection) UnmarshalBinary(b []byte) error {
+	var res BranchProtection
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
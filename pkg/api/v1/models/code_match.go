@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CodeMatch code match
+// swagger:model codeMatch
+type CodeMatch struct {
+
+	// line
+	// Required: true
+	Line *int64 `json:"line"`
+
+	// owner
+	// Required: true
+	Owner *string `json:"owner"`
+
+	// path
+	// Required: true
+	Path *string `json:"path"`
+
+	// repository
+	// Required: true
+	Repository *string `json:"repository"`
+
+	// text
+	// Required: true
+	Text *string `json:"text"`
+}
+
+// Validate validates this code match
+func (m *CodeMatch) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLine(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOwner(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePath(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRepository(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateText(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CodeMatch) validateLine(formats strfmt.Registry) error {
+
+	if err := validate.Required("line", "body", m.Line); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CodeMatch) validateOwner(formats strfmt.Registry) error {
+
+	if err := validate.Required("owner", "body", m.Owner); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CodeMatch) validatePath(formats strfmt.Registry) error {
+
+	if err := validate.Required("path", "body", m.Path); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CodeMatch) validateRepository(formats strfmt.Registry) error {
+
+	if err := validate.Required("repository", "body", m.Repository); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CodeMatch) validateText(formats strfmt.Registry) error {
+
+	if err := validate.Required("text", "body", m.Text); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CodeMatch) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CodeMatch) UnmarshalBinary(b []byte) error {
+	var res CodeMatch
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
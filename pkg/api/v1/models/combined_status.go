@@ -0,0 +1,155 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CombinedStatus combined status
+// swagger:model combinedStatus
+type CombinedStatus struct {
+
+	// sha
+	// Required: true
+	Sha *string `json:"sha"`
+
+	// state
+	// Required: true
+	// Enum: [pending success failure]
+	State *string `json:"state"`
+
+	// The latest status of each context
+	// Required: true
+	Statuses []*Status `json:"statuses"`
+}
+
+// Validate validates this combined status
+func (m *CombinedStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSha(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatuses(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CombinedStatus) validateSha(formats strfmt.Registry) error {
+
+	if err := validate.Required("sha", "body", m.Sha); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var combinedStatusTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","success","failure"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		combinedStatusTypeStatePropEnum = append(combinedStatusTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// CombinedStatusStatePending captures enum value "pending"
+	CombinedStatusStatePending string = "pending"
+
+	// CombinedStatusStateSuccess captures enum value "success"
+	CombinedStatusStateSuccess string = "success"
+
+	// CombinedStatusStateFailure captures enum value "failure"
+	CombinedStatusStateFailure string = "failure"
+)
+
+// prop value enum
+func (m *CombinedStatus) validateStateEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, combinedStatusTypeStatePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *CombinedStatus) validateState(formats strfmt.Registry) error {
+
+	if err := validate.Required("state", "body", m.State); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", *m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CombinedStatus) validateStatuses(formats strfmt.Registry) error {
+
+	if err := validate.Required("statuses", "body", m.Statuses); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Statuses); i++ {
+		if swag.IsZero(m.Statuses[i]) { // not required
+			continue
+		}
+
+		if m.Statuses[i] != nil {
+			if err := m.Statuses[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("statuses" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CombinedStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CombinedStatus) UnmarshalBinary(b []byte) error {
+	var res CombinedStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
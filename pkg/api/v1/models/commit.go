@@ -0,0 +1,130 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Commit commit
+// swagger:model commit
+type Commit struct {
+
+	// author date
+	// Format: date-time
+	AuthorDate strfmt.DateTime `json:"author_date,omitempty"`
+
+	// author email
+	AuthorEmail string `json:"author_email,omitempty"`
+
+	// author name
+	AuthorName string `json:"author_name,omitempty"`
+
+	// body
+	Body string `json:"body,omitempty"`
+
+	// committer date
+	// Format: date-time
+	CommitterDate strfmt.DateTime `json:"committer_date,omitempty"`
+
+	// committer email
+	CommitterEmail string `json:"committer_email,omitempty"`
+
+	// committer name
+	CommitterName string `json:"committer_name,omitempty"`
+
+	// message
+	Message string `json:"message,omitempty"`
+
+	// parent
+	Parent string `json:"parent,omitempty"`
+
+	// sha1
+	// Required: true
+	Sha1 *string `json:"sha1"`
+
+	// tree
+	Tree string `json:"tree,omitempty"`
+}
+
+// Validate validates this commit
+func (m *Commit) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAuthorDate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCommitterDate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSha1(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Commit) validateAuthorDate(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AuthorDate) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("author_date", "body", "date-time", m.AuthorDate.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Commit) validateCommitterDate(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CommitterDate) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("committer_date", "body", "date-time", m.CommitterDate.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Commit) validateSha1(formats strfmt.Registry) error {
+
+	if err := validate.Required("sha1", "body", m.Sha1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Commit) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Commit) UnmarshalBinary(b []byte) error {
+	var res Commit
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,139 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// FileDiff file diff
+// swagger:model fileDiff
+type FileDiff struct {
+
+	// additions
+	Additions int64 `json:"additions,omitempty"`
+
+	// binary
+	Binary bool `json:"binary,omitempty"`
+
+	// deletions
+	Deletions int64 `json:"deletions,omitempty"`
+
+	// The path before the file was renamed
+	OldPath string `json:"old_path,omitempty"`
+
+	// patch
+	Patch string `json:"patch,omitempty"`
+
+	// path
+	// Required: true
+	Path *string `json:"path"`
+
+	// status
+	// Required: true
+	// Enum: [added deleted modified renamed]
+	Status *string `json:"status"`
+}
+
+// Validate validates this file diff
+func (m *FileDiff) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePath(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FileDiff) validatePath(formats strfmt.Registry) error {
+
+	if err := validate.Required("path", "body", m.Path); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var fileDiffTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["added","deleted","modified","renamed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		fileDiffTypeStatusPropEnum = append(fileDiffTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// FileDiffStatusAdded captures enum value "added"
+	FileDiffStatusAdded string = "added"
+
+	// FileDiffStatusDeleted captures enum value "deleted"
+	FileDiffStatusDeleted string = "deleted"
+
+	// FileDiffStatusModified captures enum value "modified"
+	FileDiffStatusModified string = "modified"
+
+	// FileDiffStatusRenamed captures enum value "renamed"
+	FileDiffStatusRenamed string = "renamed"
+)
+
+// prop value enum
+func (m *FileDiff) validateStatusEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, fileDiffTypeStatusPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *FileDiff) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FileDiff) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FileDiff) UnmarshalBinary(b []byte) error {
+	var res FileDiff
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,211 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ImportJob import job
+// swagger:model importJob
+type ImportJob struct {
+
+	// created at
+	// Read Only: true
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// Why the import failed
+	Error string `json:"error,omitempty"`
+
+	// finished at
+	// Read Only: true
+	// Format: date-time
+	FinishedAt strfmt.DateTime `json:"finished_at,omitempty"`
+
+	// id
+	// Required: true
+	// Read Only: true
+	// Format: uuid
+	ID strfmt.UUID `json:"id"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// owner
+	Owner string `json:"owner,omitempty"`
+
+	// The last progress reported while importing
+	Progress string `json:"progress,omitempty"`
+
+	// state
+	// Required: true
+	// Enum: [queued running succeeded failed]
+	State *string `json:"state"`
+
+	// updated at
+	// Read Only: true
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty"`
+
+	// url
+	URL string `json:"url,omitempty"`
+}
+
+// Validate validates this import job
+func (m *ImportJob) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFinishedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ImportJob) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ImportJob) validateFinishedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.FinishedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("finished_at", "body", "date-time", m.FinishedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ImportJob) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", strfmt.UUID(m.ID)); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var importJobTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["queued","running","succeeded","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		importJobTypeStatePropEnum = append(importJobTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// ImportJobStateQueued captures enum value "queued"
+	ImportJobStateQueued string = "queued"
+
+	// ImportJobStateRunning captures enum value "running"
+	ImportJobStateRunning string = "running"
+
+	// ImportJobStateSucceeded captures enum value "succeeded"
+	ImportJobStateSucceeded string = "succeeded"
+
+	// ImportJobStateFailed captures enum value "failed"
+	ImportJobStateFailed string = "failed"
+)
+
+// prop value enum
+func (m *ImportJob) validateStateEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, importJobTypeStatePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ImportJob) validateState(formats strfmt.Registry) error {
+
+	if err := validate.Required("state", "body", m.State); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", *m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ImportJob) validateUpdatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ImportJob) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ImportJob) UnmarshalBinary(b []byte) error {
+	var res ImportJob
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,339 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Issue issue
+// swagger:model issue
+type Issue struct {
+
+	// assignees
+	Assignees []*User `json:"assignees"`
+
+	// author
+	Author *User `json:"author,omitempty"`
+
+	// body
+	Body string `json:"body,omitempty"`
+
+	// closed at
+	// Format: date-time
+	ClosedAt strfmt.DateTime `json:"closed_at,omitempty"`
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// id
+	// Required: true
+	// Read Only: true
+	// Format: uuid
+	ID strfmt.UUID `json:"id"`
+
+	// labels
+	Labels []*Label `json:"labels"`
+
+	// milestone
+	Milestone *Milestone `json:"milestone,omitempty"`
+
+	// number
+	// Required: true
+	Number *int64 `json:"number"`
+
+	// state
+	// Required: true
+	// Enum: [open closed]
+	State *string `json:"state"`
+
+	// title
+	// Required: true
+	Title *string `json:"title"`
+
+	// updated at
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty"`
+}
+
+// Validate validates this issue
+func (m *Issue) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAssignees(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAuthor(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClosedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLabels(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMilestone(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNumber(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTitle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Issue) validateAssignees(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Assignees) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Assignees); i++ {
+		if swag.IsZero(m.Assignees[i]) { // not required
+			continue
+		}
+
+		if m.Assignees[i] != nil {
+			if err := m.Assignees[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("assignees" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Issue) validateAuthor(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Author) { // not required
+		return nil
+	}
+
+	if m.Author != nil {
+		if err := m.Author.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("author")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Issue) validateClosedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClosedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("closed_at", "body", "date-time", m.ClosedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Issue) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Issue) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", strfmt.UUID(m.ID)); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Issue) validateLabels(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Labels) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Labels); i++ {
+		if swag.IsZero(m.Labels[i]) { // not required
+			continue
+		}
+
+		if m.Labels[i] != nil {
+			if err := m.Labels[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("labels" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Issue) validateMilestone(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Milestone) { // not required
+		return nil
+	}
+
+	if m.Milestone != nil {
+		if err := m.Milestone.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("milestone")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Issue) validateNumber(formats strfmt.Registry) error {
+
+	if err := validate.Required("number", "body", m.Number); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var issueTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["open","closed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		issueTypeStatePropEnum = append(issueTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// IssueStateOpen captures enum value "open"
+	IssueStateOpen string = "open"
+
+	// IssueStateClosed captures enum value "closed"
+	IssueStateClosed string = "closed"
+)
+
+// prop value enum
+func (m *Issue) validateStateEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, issueTypeStatePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Issue) validateState(formats strfmt.Registry) error {
+
+	if err := validate.Required("state", "body", m.State); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", *m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Issue) validateTitle(formats strfmt.Registry) error {
+
+	if err := validate.Required("title", "body", m.Title); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Issue) validateUpdatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Issue) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Issue) UnmarshalBinary(b []byte) error {
+	var res Issue
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
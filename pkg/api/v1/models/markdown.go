@@ -0,0 +1,64 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Markdown markdown
+// swagger:model markdown
+type Markdown struct {
+
+	// The Markdown rendered to sanitized HTML
+	// Required: true
+	HTML *string `json:"html"`
+}
+
+// Validate validates this markdown
+func (m *Markdown) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHTML(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Markdown) validateHTML(formats strfmt.Registry) error {
+
+	if err := validate.Required("html", "body", m.HTML); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Markdown) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Markdown) UnmarshalBinary(b []byte) error {
+	var res Markdown
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,208 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Milestone milestone
+// swagger:model milestone
+type Milestone struct {
+
+	// created at
+	// Read Only: true
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// due on
+	// Format: date-time
+	DueOn strfmt.DateTime `json:"due_on,omitempty"`
+
+	// id
+	// Read Only: true
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// state
+	// Read Only: true
+	// Enum: [open closed]
+	State string `json:"state,omitempty"`
+
+	// title
+	// Required: true
+	Title *string `json:"title"`
+
+	// updated at
+	// Read Only: true
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty"`
+}
+
+// Validate validates this milestone
+func (m *Milestone) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDueOn(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTitle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Milestone) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Milestone) validateDueOn(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DueOn) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("due_on", "body", "date-time", m.DueOn.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Milestone) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var milestoneTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["open","closed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		milestoneTypeStatePropEnum = append(milestoneTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// MilestoneStateOpen captures enum value "open"
+	MilestoneStateOpen string = "open"
+
+	// MilestoneStateClosed captures enum value "closed"
+	MilestoneStateClosed string = "closed"
+)
+
+// prop value enum
+func (m *Milestone) validateStateEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, milestoneTypeStatePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Milestone) validateState(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.State) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Milestone) validateTitle(formats strfmt.Registry) error {
+
+	if err := validate.Required("title", "body", m.Title); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Milestone) validateUpdatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Milestone) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Milestone) UnmarshalBinary(b []byte) error {
+	var res Milestone
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
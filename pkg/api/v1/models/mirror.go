@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Mirror mirror
+// swagger:model mirror
+type Mirror struct {
+
+	// created at
+	// Read Only: true
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// The reference of the credentials to authenticate with
+	Credentials string `json:"credentials,omitempty"`
+
+	// direction
+	// Required: true
+	// Enum: [pull push]
+	Direction *string `json:"direction"`
+
+	// id
+	// Required: true
+	// Read Only: true
+	// Format: uuid
+	ID strfmt.UUID `json:"id"`
+
+	// The seconds between syncs
+	Interval int64 `json:"interval,omitempty"`
+
+	// Why the last failed sync failed
+	// Read Only: true
+	LastError string `json:"last_error,omitempty"`
+
+	// last failure at
+	// Read Only: true
+	// Format: date-time
+	LastFailureAt strfmt.DateTime `json:"last_failure_at,omitempty"`
+
+	// last success at
+	// Read Only: true
+	// Format: date-time
+	LastSuccessAt strfmt.DateTime `json:"last_success_at,omitempty"`
+
+	// next sync at
+	// Read Only: true
+	// Format: date-time
+	NextSyncAt strfmt.DateTime `json:"next_sync_at,omitempty"`
+
+	// url
+	// Required: true
+	URL *string `json:"url"`
+}
+
+// Validate validates this mirror
+func (m *Mirror) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDirection(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastFailureAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastSuccessAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNextSyncAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Mirror) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var mirrorTypeDirectionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pull","push"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		mirrorTypeDirectionPropEnum = append(mirrorTypeDirectionPropEnum, v)
+	}
+}
+
+const (
+
+	// MirrorDirectionPull captures enum value "pull"
+	MirrorDirectionPull string = "pull"
+
+	// MirrorDirectionPush captures enum value "push"
+	MirrorDirectionPush string = "push"
+)
+
+// prop value enum
+func (m *Mirror) validateDirectionEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, mirrorTypeDirectionPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Mirror) validateDirection(formats strfmt.Registry) error {
+
+	if err := validate.Required("direction", "body", m.Direction); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateDirectionEnum("direction", "body", *m.Direction); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Mirror) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", strfmt.UUID(m.ID)); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Mirror) validateLastFailureAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LastFailureAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_failure_at", "body", "date-time", m.LastFailureAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Mirror) validateLastSuccessAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LastSuccessAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_success_at", "body", "date-time", m.LastSuccessAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Mirror) validateNextSyncAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NextSyncAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("next_sync_at", "body", "date-time", m.NextSyncAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Mirror) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("url", "body", m.URL); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Mirror) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Mirror) UnmarshalBinary(b []byte) error {
+	var res Mirror
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,314 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PullRequest pull request
+// swagger:model pullRequest
+type PullRequest struct {
+
+	// author
+	Author *User `json:"author,omitempty"`
+
+	// The commit the source branch branched off the target branch
+	Base string `json:"base,omitempty"`
+
+	// body
+	Body string `json:"body,omitempty"`
+
+	// closed at
+	// Format: date-time
+	ClosedAt strfmt.DateTime `json:"closed_at,omitempty"`
+
+	// conflicts
+	Conflicts []string `json:"conflicts"`
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// The commit of the source branch
+	Head string `json:"head,omitempty"`
+
+	// id
+	// Required: true
+	// Read Only: true
+	// Format: uuid
+	ID strfmt.UUID `json:"id"`
+
+	// Whether the pull request can be merged without conflicts, only known for open pull requests
+	Mergeable *bool `json:"mergeable,omitempty"`
+
+	// number
+	// Required: true
+	Number *int64 `json:"number"`
+
+	// source
+	Source *PullRequestRef `json:"source,omitempty"`
+
+	// state
+	// Required: true
+	// Enum: [open closed merged]
+	State *string `json:"state"`
+
+	// target
+	Target *PullRequestRef `json:"target,omitempty"`
+
+	// title
+	// Required: true
+	Title *string `json:"title"`
+
+	// updated at
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty"`
+}
+
+// Validate validates this pull request
+func (m *PullRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAuthor(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClosedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNumber(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSource(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTarget(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTitle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PullRequest) validateAuthor(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Author) { // not required
+		return nil
+	}
+
+	if m.Author != nil {
+		if err := m.Author.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("author")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PullRequest) validateClosedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ClosedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("closed_at", "body", "date-time", m.ClosedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PullRequest) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PullRequest) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", strfmt.UUID(m.ID)); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PullRequest) validateNumber(formats strfmt.Registry) error {
+
+	if err := validate.Required("number", "body", m.Number); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PullRequest) validateSource(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Source) { // not required
+		return nil
+	}
+
+	if m.Source != nil {
+		if err := m.Source.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("source")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var pullRequestTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["open","closed","merged"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		pullRequestTypeStatePropEnum = append(pullRequestTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// PullRequestStateOpen captures enum value "open"
+	PullRequestStateOpen string = "open"
+
+	// PullRequestStateClosed captures enum value "closed"
+	PullRequestStateClosed string = "closed"
+
+	// PullRequestStateMerged captures enum value "merged"
+	PullRequestStateMerged string = "merged"
+)
+
+// prop value enum
+func (m *PullRequest) validateStateEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, pullRequestTypeStatePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PullRequest) validateState(formats strfmt.Registry) error {
+
+	if err := validate.Required("state", "body", m.State); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", *m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PullRequest) validateTarget(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Target) { // not required
+		return nil
+	}
+
+	if m.Target != nil {
+		if err := m.Target.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("target")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PullRequest) validateTitle(formats strfmt.Registry) error {
+
+	if err := validate.Required("title", "body", m.Title); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PullRequest) validateUpdatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PullRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PullRequest) UnmarshalBinary(b []byte) error {
+	var res PullRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
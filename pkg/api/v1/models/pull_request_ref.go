@@ -0,0 +1,49 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// PullRequestRef pull request ref
+// swagger:model pullRequestRef
+type PullRequestRef struct {
+
+	// branch
+	Branch string `json:"branch,omitempty"`
+
+	// owner
+	Owner string `json:"owner,omitempty"`
+
+	// repository
+	Repository string `json:"repository,omitempty"`
+}
+
+// Validate validates this pull request ref
+func (m *PullRequestRef) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PullRequestRef) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PullRequestRef) UnmarshalBinary(b []byte) error {
+	var res PullRequestRef
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Readme readme
+// swagger:model readme
+type Readme struct {
+
+	// The README rendered to sanitized HTML, relative links point to the raw files
+	// Required: true
+	HTML *string `json:"html"`
+
+	// The path of the README in the repository
+	// Required: true
+	Path *string `json:"path"`
+
+	// The ref the README was found at
+	// Required: true
+	Ref *string `json:"ref"`
+
+	// sha1
+	Sha1 string `json:"sha1,omitempty"`
+
+	// size
+	Size int64 `json:"size,omitempty"`
+}
+
+// Validate validates this readme
+func (m *Readme) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHTML(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePath(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRef(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Readme) validateHTML(formats strfmt.Registry) error {
+
+	if err := validate.Required("html", "body", m.HTML); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Readme) validatePath(formats strfmt.Registry) error {
+
+	if err := validate.Required("path", "body", m.Path); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Readme) validateRef(formats strfmt.Registry) error {
+
+	if err := validate.Required("ref", "body", m.Ref); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Readme) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Readme) UnmarshalBinary(b []byte) error {
+	var res Readme
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
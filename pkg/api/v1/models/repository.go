@@ -40,10 +40,31 @@ type Repository struct {
 	// owner
 	Owner *User `json:"owner,omitempty"`
 
+	// The repository this one was forked from
+	// Format: uuid
+	ParentID strfmt.UUID `json:"parent_id,omitempty"`
+
+	// private
+	Private bool `json:"private,omitempty"`
+
+	// The number of users who starred the repository
+	// Read Only: true
+	Stars int64 `json:"stars,omitempty"`
+
+	// stats
+	Stats *RepositoryStats `json:"stats,omitempty"`
+
+	// The topics tagging the repository, lowercase letters, numbers and hyphens
+	Topics []string `json:"topics"`
+
 	// updated at
 	// Format: date-time
 	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty"`
 
+	// The number of users watching the repository
+	// Read Only: true
+	Watchers int64 `json:"watchers,omitempty"`
+
 	// website
 	Website string `json:"website,omitempty"`
 }
@@ -68,6 +89,14 @@ func (m *Repository) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateParentID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStats(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateUpdatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -131,6 +160,37 @@ func (m *Repository) validateOwner(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Repository) validateParentID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ParentID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("parent_id", "body", "uuid", m.ParentID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Repository) validateStats(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Stats) { // not required
+		return nil
+	}
+
+	if m.Stats != nil {
+		if err := m.Stats.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("stats")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Repository) validateUpdatedAt(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.UpdatedAt) { // not required
@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// RepositoryStats repository stats
+// swagger:model repositoryStats
+type RepositoryStats struct {
+
+	// Commits reachable from the default branch
+	Commits int64 `json:"commits,omitempty"`
+
+	// Public forks of the repository
+	Forks int64 `json:"forks,omitempty"`
+
+	// Languages of the files on the default branch, most bytes first
+	Languages []*Language `json:"languages"`
+
+	// loose objects
+	LooseObjects int64 `json:"loose_objects,omitempty"`
+
+	// packed objects
+	PackedObjects int64 `json:"packed_objects,omitempty"`
+
+	// Bytes of all objects on disk
+	Size int64 `json:"size,omitempty"`
+}
+
+// Validate validates this repository stats
+func (m *RepositoryStats) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLanguages(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RepositoryStats) validateLanguages(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Languages) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Languages); i++ {
+		if swag.IsZero(m.Languages[i]) { // not required
+			continue
+		}
+
+		if m.Languages[i] != nil {
+			if err := m.Languages[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("languages" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RepositoryStats) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RepositoryStats) UnmarshalBinary(b []byte) error {
+	var res RepositoryStats
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,189 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Status status
+// swagger:model status
+type Status struct {
+
+	// Identifies the system reporting the status, defaults to default
+	Context string `json:"context,omitempty"`
+
+	// created at
+	// Read Only: true
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// creator
+	Creator *User `json:"creator,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// id
+	// Read Only: true
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// sha
+	// Read Only: true
+	Sha string `json:"sha,omitempty"`
+
+	// state
+	// Required: true
+	// Enum: [pending success failure error]
+	State *string `json:"state"`
+
+	// Where to find details about the status, e.g. the build's log
+	TargetURL string `json:"target_url,omitempty"`
+}
+
+// Validate validates this status
+func (m *Status) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreator(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Status) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Status) validateCreator(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Creator) { // not required
+		return nil
+	}
+
+	if m.Creator != nil {
+		if err := m.Creator.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("creator")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Status) validateID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var statusTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","success","failure","error"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		statusTypeStatePropEnum = append(statusTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// StatusStatePending captures enum value "pending"
+	StatusStatePending string = "pending"
+
+	// StatusStateSuccess captures enum value "success"
+	StatusStateSuccess string = "success"
+
+	// StatusStateFailure captures enum value "failure"
+	StatusStateFailure string = "failure"
+
+	// StatusStateError captures enum value "error"
+	StatusStateError string = "error"
+)
+
+// prop value enum
+func (m *Status) validateStateEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, statusTypeStatePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Status) validateState(formats strfmt.Registry) error {
+
+	if err := validate.Required("state", "body", m.State); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", *m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Status) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Status) UnmarshalBinary(b []byte) error {
+	var res Status
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -11,8 +11,18 @@ import (
 	middleware "github.com/go-openapi/runtime/middleware"
 
 	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/events"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/issues"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/markdown"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/mirrors"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/notifications"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/pullrequests"
 	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/repositories"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/search"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/stars"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/statuses"
 	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/users"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/watching"
 )
 
 //go:generate swagger generate server --target ../../v1 --name Sourcepods --spec ../../../../swagger.yaml --exclude-main
@@ -35,18 +45,96 @@ func configureAPI(api *operations.SourcepodsAPI) http.Handler {
 
 	api.JSONProducer = runtime.JSONProducer()
 
+	api.StarsCheckRepositoryStarredHandler = stars.CheckRepositoryStarredHandlerFunc(func(params stars.CheckRepositoryStarredParams) middleware.Responder {
+		return middleware.NotImplemented("operation stars.CheckRepositoryStarred has not yet been implemented")
+	})
+	api.IssuesCreateIssueHandler = issues.CreateIssueHandlerFunc(func(params issues.CreateIssueParams) middleware.Responder {
+		return middleware.NotImplemented("operation issues.CreateIssue has not yet been implemented")
+	})
+	api.IssuesCreateIssueCommentHandler = issues.CreateIssueCommentHandlerFunc(func(params issues.CreateIssueCommentParams) middleware.Responder {
+		return middleware.NotImplemented("operation issues.CreateIssueComment has not yet been implemented")
+	})
+	api.IssuesCreateLabelHandler = issues.CreateLabelHandlerFunc(func(params issues.CreateLabelParams) middleware.Responder {
+		return middleware.NotImplemented("operation issues.CreateLabel has not yet been implemented")
+	})
+	api.IssuesCreateMilestoneHandler = issues.CreateMilestoneHandlerFunc(func(params issues.CreateMilestoneParams) middleware.Responder {
+		return middleware.NotImplemented("operation issues.CreateMilestone has not yet been implemented")
+	})
+	api.PullrequestsCreatePullRequestHandler = pullrequests.CreatePullRequestHandlerFunc(func(params pullrequests.CreatePullRequestParams) middleware.Responder {
+		return middleware.NotImplemented("operation pullrequests.CreatePullRequest has not yet been implemented")
+	})
+	api.PullrequestsCreatePullRequestCommentHandler = pullrequests.CreatePullRequestCommentHandlerFunc(func(params pullrequests.CreatePullRequestCommentParams) middleware.Responder {
+		return middleware.NotImplemented("operation pullrequests.CreatePullRequestComment has not yet been implemented")
+	})
 	api.RepositoriesCreateRepositoryHandler = repositories.CreateRepositoryHandlerFunc(func(params repositories.CreateRepositoryParams) middleware.Responder {
 		return middleware.NotImplemented("operation repositories.CreateRepository has not yet been implemented")
 	})
+	api.RepositoriesCreateRepositoryBranchHandler = repositories.CreateRepositoryBranchHandlerFunc(func(params repositories.CreateRepositoryBranchParams) middleware.Responder {
+		return middleware.NotImplemented("operation repositories.CreateRepositoryBranch has not yet been implemented")
+	})
+	api.MirrorsCreateRepositoryMirrorHandler = mirrors.CreateRepositoryMirrorHandlerFunc(func(params mirrors.CreateRepositoryMirrorParams) middleware.Responder {
+		return middleware.NotImplemented("operation mirrors.CreateRepositoryMirror has not yet been implemented")
+	})
+	api.StatusesCreateStatusHandler = statuses.CreateStatusHandlerFunc(func(params statuses.CreateStatusParams) middleware.Responder {
+		return middleware.NotImplemented("operation statuses.CreateStatus has not yet been implemented")
+	})
+	api.IssuesDeleteLabelHandler = issues.DeleteLabelHandlerFunc(func(params issues.DeleteLabelParams) middleware.Responder {
+		return middleware.NotImplemented("operation issues.DeleteLabel has not yet been implemented")
+	})
+	api.RepositoriesDeleteRepositoryHandler = repositories.DeleteRepositoryHandlerFunc(func(params repositories.DeleteRepositoryParams) middleware.Responder {
+		return middleware.NotImplemented("operation repositories.DeleteRepository has not yet been implemented")
+	})
+	api.RepositoriesDeleteRepositoryBranchHandler = repositories.DeleteRepositoryBranchHandlerFunc(func(params repositories.DeleteRepositoryBranchParams) middleware.Responder {
+		return middleware.NotImplemented("operation repositories.DeleteRepositoryBranch has not yet been implemented")
+	})
+	api.MirrorsDeleteRepositoryMirrorHandler = mirrors.DeleteRepositoryMirrorHandlerFunc(func(params mirrors.DeleteRepositoryMirrorParams) middleware.Responder {
+		return middleware.NotImplemented("operation mirrors.DeleteRepositoryMirror has not yet been implemented")
+	})
+	api.RepositoriesForkRepositoryHandler = repositories.ForkRepositoryHandlerFunc(func(params repositories.ForkRepositoryParams) middleware.Responder {
+		return middleware.NotImplemented("operation repositories.ForkRepository has not yet been implemented")
+	})
+	api.StatusesGetBranchProtectionHandler = statuses.GetBranchProtectionHandlerFunc(func(params statuses.GetBranchProtectionParams) middleware.Responder {
+		return middleware.NotImplemented("operation statuses.GetBranchProtection has not yet been implemented")
+	})
+	api.StatusesGetCombinedStatusHandler = statuses.GetCombinedStatusHandlerFunc(func(params statuses.GetCombinedStatusParams) middleware.Responder {
+		return middleware.NotImplemented("operation statuses.GetCombinedStatus has not yet been implemented")
+	})
+	api.IssuesGetIssueHandler = issues.GetIssueHandlerFunc(func(params issues.GetIssueParams) middleware.Responder {
+		return middleware.NotImplemented("operation issues.GetIssue has not yet been implemented")
+	})
+	api.NotificationsGetNotificationPreferencesHandler = notifications.GetNotificationPreferencesHandlerFunc(func(params notifications.GetNotificationPreferencesParams) middleware.Responder {
+		return middleware.NotImplemented("operation notifications.GetNotificationPreferences has not yet been implemented")
+	})
 	api.RepositoriesGetOwnerRepositoriesHandler = repositories.GetOwnerRepositoriesHandlerFunc(func(params repositories.GetOwnerRepositoriesParams) middleware.Responder {
 		return middleware.NotImplemented("operation repositories.GetOwnerRepositories has not yet been implemented")
 	})
+	api.PullrequestsGetPullRequestHandler = pullrequests.GetPullRequestHandlerFunc(func(params pullrequests.GetPullRequestParams) middleware.Responder {
+		return middleware.NotImplemented("operation pullrequests.GetPullRequest has not yet been implemented")
+	})
+	api.PullrequestsGetPullRequestCommitsHandler = pullrequests.GetPullRequestCommitsHandlerFunc(func(params pullrequests.GetPullRequestCommitsParams) middleware.Responder {
+		return middleware.NotImplemented("operation pullrequests.GetPullRequestCommits has not yet been implemented")
+	})
+	api.PullrequestsGetPullRequestDiffHandler = pullrequests.GetPullRequestDiffHandlerFunc(func(params pullrequests.GetPullRequestDiffParams) middleware.Responder {
+		return middleware.NotImplemented("operation pullrequests.GetPullRequestDiff has not yet been implemented")
+	})
 	api.RepositoriesGetRepositoryHandler = repositories.GetRepositoryHandlerFunc(func(params repositories.GetRepositoryParams) middleware.Responder {
 		return middleware.NotImplemented("operation repositories.GetRepository has not yet been implemented")
 	})
 	api.RepositoriesGetRepositoryBranchesHandler = repositories.GetRepositoryBranchesHandlerFunc(func(params repositories.GetRepositoryBranchesParams) middleware.Responder {
 		return middleware.NotImplemented("operation repositories.GetRepositoryBranches has not yet been implemented")
 	})
+	api.RepositoriesGetRepositoryForksHandler = repositories.GetRepositoryForksHandlerFunc(func(params repositories.GetRepositoryForksParams) middleware.Responder {
+		return middleware.NotImplemented("operation repositories.GetRepositoryForks has not yet been implemented")
+	})
+	api.RepositoriesGetRepositoryImportHandler = repositories.GetRepositoryImportHandlerFunc(func(params repositories.GetRepositoryImportParams) middleware.Responder {
+		return middleware.NotImplemented("operation repositories.GetRepositoryImport has not yet been implemented")
+	})
+	api.RepositoriesGetRepositoryNetworkHandler = repositories.GetRepositoryNetworkHandlerFunc(func(params repositories.GetRepositoryNetworkParams) middleware.Responder {
+		return middleware.NotImplemented("operation repositories.GetRepositoryNetwork has not yet been implemented")
+	})
+	api.RepositoriesGetRepositoryReadmeHandler = repositories.GetRepositoryReadmeHandlerFunc(func(params repositories.GetRepositoryReadmeParams) middleware.Responder {
+		return middleware.NotImplemented("operation repositories.GetRepositoryReadme has not yet been implemented")
+	})
 	api.RepositoriesGetRepositoryTreeHandler = repositories.GetRepositoryTreeHandlerFunc(func(params repositories.GetRepositoryTreeParams) middleware.Responder {
 		return middleware.NotImplemented("operation repositories.GetRepositoryTree has not yet been implemented")
 	})
@@ -56,12 +144,120 @@ func configureAPI(api *operations.SourcepodsAPI) http.Handler {
 	api.UsersGetUserMeHandler = users.GetUserMeHandlerFunc(func(params users.GetUserMeParams) middleware.Responder {
 		return middleware.NotImplemented("operation users.GetUserMe has not yet been implemented")
 	})
+	api.RepositoriesImportRepositoryHandler = repositories.ImportRepositoryHandlerFunc(func(params repositories.ImportRepositoryParams) middleware.Responder {
+		return middleware.NotImplemented("operation repositories.ImportRepository has not yet been implemented")
+	})
+	api.EventsListEventsHandler = events.ListEventsHandlerFunc(func(params events.ListEventsParams) middleware.Responder {
+		return middleware.NotImplemented("operation events.ListEvents has not yet been implemented")
+	})
+	api.IssuesListIssueCommentsHandler = issues.ListIssueCommentsHandlerFunc(func(params issues.ListIssueCommentsParams) middleware.Responder {
+		return middleware.NotImplemented("operation issues.ListIssueComments has not yet been implemented")
+	})
+	api.IssuesListIssuesHandler = issues.ListIssuesHandlerFunc(func(params issues.ListIssuesParams) middleware.Responder {
+		return middleware.NotImplemented("operation issues.ListIssues has not yet been implemented")
+	})
+	api.IssuesListLabelsHandler = issues.ListLabelsHandlerFunc(func(params issues.ListLabelsParams) middleware.Responder {
+		return middleware.NotImplemented("operation issues.ListLabels has not yet been implemented")
+	})
+	api.IssuesListMilestonesHandler = issues.ListMilestonesHandlerFunc(func(params issues.ListMilestonesParams) middleware.Responder {
+		return middleware.NotImplemented("operation issues.ListMilestones has not yet been implemented")
+	})
+	api.NotificationsListNotificationsHandler = notifications.ListNotificationsHandlerFunc(func(params notifications.ListNotificationsParams) middleware.Responder {
+		return middleware.NotImplemented("operation notifications.ListNotifications has not yet been implemented")
+	})
+	api.PullrequestsListPullRequestCommentsHandler = pullrequests.ListPullRequestCommentsHandlerFunc(func(params pullrequests.ListPullRequestCommentsParams) middleware.Responder {
+		return middleware.NotImplemented("operation pullrequests.ListPullRequestComments has not yet been implemented")
+	})
+	api.PullrequestsListPullRequestsHandler = pullrequests.ListPullRequestsHandlerFunc(func(params pullrequests.ListPullRequestsParams) middleware.Responder {
+		return middleware.NotImplemented("operation pullrequests.ListPullRequests has not yet been implemented")
+	})
+	api.EventsListRepositoryEventsHandler = events.ListRepositoryEventsHandlerFunc(func(params events.ListRepositoryEventsParams) middleware.Responder {
+		return middleware.NotImplemented("operation events.ListRepositoryEvents has not yet been implemented")
+	})
+	api.MirrorsListRepositoryMirrorsHandler = mirrors.ListRepositoryMirrorsHandlerFunc(func(params mirrors.ListRepositoryMirrorsParams) middleware.Responder {
+		return middleware.NotImplemented("operation mirrors.ListRepositoryMirrors has not yet been implemented")
+	})
+	api.WatchingListRepositoryWatchersHandler = watching.ListRepositoryWatchersHandlerFunc(func(params watching.ListRepositoryWatchersParams) middleware.Responder {
+		return middleware.NotImplemented("operation watching.ListRepositoryWatchers has not yet been implemented")
+	})
+	api.StarsListStarredRepositoriesHandler = stars.ListStarredRepositoriesHandlerFunc(func(params stars.ListStarredRepositoriesParams) middleware.Responder {
+		return middleware.NotImplemented("operation stars.ListStarredRepositories has not yet been implemented")
+	})
+	api.StatusesListStatusesHandler = statuses.ListStatusesHandlerFunc(func(params statuses.ListStatusesParams) middleware.Responder {
+		return middleware.NotImplemented("operation statuses.ListStatuses has not yet been implemented")
+	})
+	api.EventsListUserEventsHandler = events.ListUserEventsHandlerFunc(func(params events.ListUserEventsParams) middleware.Responder {
+		return middleware.NotImplemented("operation events.ListUserEvents has not yet been implemented")
+	})
+	api.StarsListUserStarredRepositoriesHandler = stars.ListUserStarredRepositoriesHandlerFunc(func(params stars.ListUserStarredRepositoriesParams) middleware.Responder {
+		return middleware.NotImplemented("operation stars.ListUserStarredRepositories has not yet been implemented")
+	})
 	api.UsersListUsersHandler = users.ListUsersHandlerFunc(func(params users.ListUsersParams) middleware.Responder {
 		return middleware.NotImplemented("operation users.ListUsers has not yet been implemented")
 	})
+	api.NotificationsMarkNotificationReadHandler = notifications.MarkNotificationReadHandlerFunc(func(params notifications.MarkNotificationReadParams) middleware.Responder {
+		return middleware.NotImplemented("operation notifications.MarkNotificationRead has not yet been implemented")
+	})
+	api.NotificationsMarkNotificationsReadHandler = notifications.MarkNotificationsReadHandlerFunc(func(params notifications.MarkNotificationsReadParams) middleware.Responder {
+		return middleware.NotImplemented("operation notifications.MarkNotificationsRead has not yet been implemented")
+	})
+	api.PullrequestsMergePullRequestHandler = pullrequests.MergePullRequestHandlerFunc(func(params pullrequests.MergePullRequestParams) middleware.Responder {
+		return middleware.NotImplemented("operation pullrequests.MergePullRequest has not yet been implemented")
+	})
+	api.RepositoriesRenameRepositoryBranchHandler = repositories.RenameRepositoryBranchHandlerFunc(func(params repositories.RenameRepositoryBranchParams) middleware.Responder {
+		return middleware.NotImplemented("operation repositories.RenameRepositoryBranch has not yet been implemented")
+	})
+	api.MarkdownRenderMarkdownHandler = markdown.RenderMarkdownHandlerFunc(func(params markdown.RenderMarkdownParams) middleware.Responder {
+		return middleware.NotImplemented("operation markdown.RenderMarkdown has not yet been implemented")
+	})
+	api.SearchSearchCodeHandler = search.SearchCodeHandlerFunc(func(params search.SearchCodeParams) middleware.Responder {
+		return middleware.NotImplemented("operation search.SearchCode has not yet been implemented")
+	})
+	api.SearchSearchRepositoriesHandler = search.SearchRepositoriesHandlerFunc(func(params search.SearchRepositoriesParams) middleware.Responder {
+		return middleware.NotImplemented("operation search.SearchRepositories has not yet been implemented")
+	})
+	api.RepositoriesSearchRepositoryHandler = repositories.SearchRepositoryHandlerFunc(func(params repositories.SearchRepositoryParams) middleware.Responder {
+		return middleware.NotImplemented("operation repositories.SearchRepository has not yet been implemented")
+	})
+	api.SearchSearchUsersHandler = search.SearchUsersHandlerFunc(func(params search.SearchUsersParams) middleware.Responder {
+		return middleware.NotImplemented("operation search.SearchUsers has not yet been implemented")
+	})
+	api.StarsStarRepositoryHandler = stars.StarRepositoryHandlerFunc(func(params stars.StarRepositoryParams) middleware.Responder {
+		return middleware.NotImplemented("operation stars.StarRepository has not yet been implemented")
+	})
+	api.MirrorsSyncRepositoryMirrorHandler = mirrors.SyncRepositoryMirrorHandlerFunc(func(params mirrors.SyncRepositoryMirrorParams) middleware.Responder {
+		return middleware.NotImplemented("operation mirrors.SyncRepositoryMirror has not yet been implemented")
+	})
+	api.RepositoriesTransferRepositoryHandler = repositories.TransferRepositoryHandlerFunc(func(params repositories.TransferRepositoryParams) middleware.Responder {
+		return middleware.NotImplemented("operation repositories.TransferRepository has not yet been implemented")
+	})
+	api.StarsUnstarRepositoryHandler = stars.UnstarRepositoryHandlerFunc(func(params stars.UnstarRepositoryParams) middleware.Responder {
+		return middleware.NotImplemented("operation stars.UnstarRepository has not yet been implemented")
+	})
+	api.WatchingUnwatchRepositoryHandler = watching.UnwatchRepositoryHandlerFunc(func(params watching.UnwatchRepositoryParams) middleware.Responder {
+		return middleware.NotImplemented("operation watching.UnwatchRepository has not yet been implemented")
+	})
+	api.StatusesUpdateBranchProtectionHandler = statuses.UpdateBranchProtectionHandlerFunc(func(params statuses.UpdateBranchProtectionParams) middleware.Responder {
+		return middleware.NotImplemented("operation statuses.UpdateBranchProtection has not yet been implemented")
+	})
+	api.IssuesUpdateIssueHandler = issues.UpdateIssueHandlerFunc(func(params issues.UpdateIssueParams) middleware.Responder {
+		return middleware.NotImplemented("operation issues.UpdateIssue has not yet been implemented")
+	})
+	api.NotificationsUpdateNotificationPreferencesHandler = notifications.UpdateNotificationPreferencesHandlerFunc(func(params notifications.UpdateNotificationPreferencesParams) middleware.Responder {
+		return middleware.NotImplemented("operation notifications.UpdateNotificationPreferences has not yet been implemented")
+	})
+	api.PullrequestsUpdatePullRequestHandler = pullrequests.UpdatePullRequestHandlerFunc(func(params pullrequests.UpdatePullRequestParams) middleware.Responder {
+		return middleware.NotImplemented("operation pullrequests.UpdatePullRequest has not yet been implemented")
+	})
+	api.RepositoriesUpdateRepositoryHandler = repositories.UpdateRepositoryHandlerFunc(func(params repositories.UpdateRepositoryParams) middleware.Responder {
+		return middleware.NotImplemented("operation repositories.UpdateRepository has not yet been implemented")
+	})
 	api.UsersUpdateUserHandler = users.UpdateUserHandlerFunc(func(params users.UpdateUserParams) middleware.Responder {
 		return middleware.NotImplemented("operation users.UpdateUser has not yet been implemented")
 	})
+	api.WatchingWatchRepositoryHandler = watching.WatchRepositoryHandlerFunc(func(params watching.WatchRepositoryParams) middleware.Responder {
+		return middleware.NotImplemented("operation watching.WatchRepository has not yet been implemented")
+	})
 
 	api.ServerShutdown = func() {}
 
@@ -40,88 +40,49 @@ func init() {
   },
   "basePath": "/v1",
   "paths": {
-    "/repositories": {
-      "post": {
+    "/events": {
+      "get": {
         "tags": [
-          "repositories"
+          "events"
         ],
-        "summary": "Create a new repository",
-        "operationId": "createRepository",
+        "summary": "Get the events of all users and repositories, most recent first",
+        "operationId": "listEvents",
         "parameters": [
           {
-            "description": "The repository to create",
-            "name": "newRepository",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "required": [
-                "name"
-              ],
-              "properties": {
-                "description": {
-                  "type": "string"
-                },
-                "name": {
-                  "type": "string"
-                },
-                "website": {
-                  "type": "string"
-                }
-              }
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "The repository has been created and is returned to you",
-            "schema": {
-              "$ref": "#/definitions/repository"
-            }
-          },
-          "422": {
-            "description": "The new repository has not been created due to invalid input",
-            "schema": {
-              "$ref": "#/definitions/validationError"
-            }
+            "$ref": "#/parameters/cursor"
           },
-          "default": {
-            "description": "unexpected error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/repositories/{owner}": {
-      "get": {
-        "tags": [
-          "repositories"
-        ],
-        "summary": "Get a owner's repositories",
-        "operationId": "getOwnerRepositories",
-        "parameters": [
           {
-            "type": "string",
-            "description": "The owner's username",
-            "name": "owner",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/perPage"
           }
         ],
         "responses": {
           "200": {
-            "description": "The repositories found by its owner name",
+            "description": "The events",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/repository"
+                "$ref": "#/definitions/event"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
               }
             }
           },
-          "404": {
-            "description": "The owner could not be found by this username",
+          "403": {
+            "description": "Only admins can list all events",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -135,38 +96,46 @@ func init() {
         }
       }
     },
-    "/repositories/{owner}/{name}": {
-      "get": {
+    "/markdown": {
+      "post": {
+        "description": "Renders GitHub flavored Markdown to sanitized HTML. Mentioned users, issues like owner/name#123 and commits of the repository are linked, code blocks are highlighted.",
         "tags": [
-          "repositories"
+          "markdown"
         ],
-        "summary": "Get a repository by owner name and its name",
-        "operationId": "getRepository",
+        "summary": "Render Markdown to HTML",
+        "operationId": "renderMarkdown",
         "parameters": [
           {
-            "type": "string",
-            "description": "The owner's username",
-            "name": "owner",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The repository's name",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "description": "The Markdown to render",
+            "name": "markdown",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "text"
+              ],
+              "properties": {
+                "repository": {
+                  "description": "The owner/name of the repository the text is about,",
+                  "type": "string"
+                },
+                "text": {
+                  "type": "string"
+                }
+              }
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "The repository found by its owner and name",
+            "description": "The rendered Markdown",
             "schema": {
-              "$ref": "#/definitions/repository"
+              "$ref": "#/definitions/markdown"
             }
           },
-          "404": {
-            "description": "The owner and name combination could not be found",
+          "422": {
+            "description": "The text is too large or the repository is not owner/name",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -180,41 +149,50 @@ func init() {
         }
       }
     },
-    "/repositories/{owner}/{name}/branches": {
+    "/notifications": {
       "get": {
         "tags": [
-          "repositories"
+          "notifications"
         ],
-        "summary": "Get all branches of a repository",
-        "operationId": "getRepositoryBranches",
+        "summary": "Get the notifications of the current user, most recent first",
+        "operationId": "listNotifications",
         "parameters": [
           {
-            "type": "string",
-            "description": "The owner's username",
-            "name": "owner",
-            "in": "path",
-            "required": true
+            "type": "boolean",
+            "default": false,
+            "description": "List read notifications too, only unread ones are listed otherwise",
+            "name": "all",
+            "in": "query"
           },
           {
-            "type": "string",
-            "description": "The repository's name",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/perPage"
           }
         ],
         "responses": {
           "200": {
-            "description": "The repository's branches",
+            "description": "The notifications",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/branch"
+                "$ref": "#/definitions/notification"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
               }
             }
           },
-          "404": {
-            "description": "The owner and name combination could not be found",
+          "422": {
+            "description": "The cursor is not valid",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -226,58 +204,16 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/repositories/{owner}/{name}/tree": {
-      "get": {
+      },
+      "put": {
         "tags": [
-          "repositories"
-        ],
-        "summary": "Get the tree including folders (tree) and files (blob) for a repository",
-        "operationId": "getRepositoryTree",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The owner's username",
-            "name": "owner",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The repository's name",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ref for the tree",
-            "name": "ref",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The path for the tree",
-            "name": "path",
-            "in": "query"
-          }
+          "notifications"
         ],
+        "summary": "Mark all notifications of the current user as read",
+        "operationId": "markNotificationsRead",
         "responses": {
-          "200": {
-            "description": "The repository's tree",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/treeEntry"
-              }
-            }
-          },
-          "404": {
-            "description": "The owner and name combination could not be found",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "204": {
+            "description": "All notifications are read"
           },
           "default": {
             "description": "unexpected error",
@@ -288,20 +224,20 @@ func init() {
         }
       }
     },
-    "/users": {
+    "/notifications/preferences": {
       "get": {
         "tags": [
-          "users"
+          "notifications"
         ],
-        "summary": "List all users",
-        "operationId": "listUsers",
+        "summary": "Get how the current user's notifications are delivered for every reason",
+        "operationId": "getNotificationPreferences",
         "responses": {
           "200": {
-            "description": "An array of all users",
+            "description": "The delivery for every reason",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/user"
+                "$ref": "#/definitions/notificationPreference"
               }
             }
           },
@@ -312,20 +248,40 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/users/me": {
-      "get": {
+      },
+      "put": {
         "tags": [
-          "users"
+          "notifications"
+        ],
+        "summary": "Change how the current user's notifications are delivered for the given reasons",
+        "operationId": "updateNotificationPreferences",
+        "parameters": [
+          {
+            "name": "preferences",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/notificationPreference"
+              }
+            }
+          }
         ],
-        "summary": "Get the current authenticated user",
-        "operationId": "getUserMe",
         "responses": {
           "200": {
-            "description": "The current authenticated user",
+            "description": "The delivery for every reason",
             "schema": {
-              "$ref": "#/definitions/user"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/notificationPreference"
+              }
+            }
+          },
+          "422": {
+            "description": "A reason is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "default": {
@@ -337,31 +293,29 @@ func init() {
         }
       }
     },
-    "/users/{username}": {
-      "get": {
+    "/notifications/threads/{id}": {
+      "patch": {
         "tags": [
-          "users"
+          "notifications"
         ],
-        "summary": "Get a user by their username",
-        "operationId": "getUser",
+        "summary": "Mark a notification of the current user as read",
+        "operationId": "markNotificationRead",
         "parameters": [
           {
             "type": "string",
-            "description": "The username of a user",
-            "name": "username",
+            "format": "uuid",
+            "description": "The notification's id",
+            "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "The user by their username",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
+          "204": {
+            "description": "The notification is read"
           },
           "404": {
-            "description": "The user is not found by their username",
+            "description": "The notification could not be found",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -373,24 +327,19 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
+      }
+    },
+    "/repositories": {
+      "post": {
         "tags": [
-          "users"
+          "repositories"
         ],
-        "summary": "Update the user's information",
-        "operationId": "updateUser",
+        "summary": "Create a new repository",
+        "operationId": "createRepository",
         "parameters": [
           {
-            "type": "string",
-            "description": "The username of the user to update",
-            "name": "username",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "The updated user",
-            "name": "updatedUser",
+            "description": "The repository to create",
+            "name": "newRepository",
             "in": "body",
             "required": true,
             "schema": {
@@ -399,8 +348,25 @@ func init() {
                 "name"
               ],
               "properties": {
+                "description": {
+                  "type": "string"
+                },
                 "name": {
                   "type": "string"
+                },
+                "private": {
+                  "description": "Private repositories are only visible to their owner",
+                  "type": "boolean"
+                },
+                "topics": {
+                  "description": "The topics tagging the repository",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "website": {
+                  "type": "string"
                 }
               }
             }
@@ -408,19 +374,13 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "The user has been updated",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "404": {
-            "description": "The user could not be found by this username",
+            "description": "The repository has been created and is returned to you",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/repository"
             }
           },
           "422": {
-            "description": "The updated user has invalid input",
+            "description": "The new repository has not been created due to invalid input",
             "schema": {
               "$ref": "#/definitions/validationError"
             }
@@ -433,194 +393,26 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "branch": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "sha1": {
-          "type": "string"
-        },
-        "type": {
-          "type": "string"
-        }
-      }
-    },
-    "error": {
-      "type": "object",
-      "required": [
-        "message"
-      ],
-      "properties": {
-        "message": {
-          "type": "string"
-        }
-      }
-    },
-    "repository": {
-      "type": "object",
-      "required": [
-        "id",
-        "name"
-      ],
-      "properties": {
-        "created_at": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "default_branch": {
-          "type": "string"
-        },
-        "description": {
-          "type": "string"
-        },
-        "id": {
-          "type": "string",
-          "format": "uuid",
-          "readOnly": true
-        },
-        "name": {
-          "type": "string"
-        },
-        "owner": {
-          "type": "object",
-          "$ref": "#/definitions/user"
-        },
-        "updated_at": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "website": {
-          "type": "string"
-        }
-      }
-    },
-    "treeEntry": {
-      "type": "object",
-      "required": [
-        "mode",
-        "type",
-        "object",
-        "path"
-      ],
-      "properties": {
-        "mode": {
-          "type": "string"
-        },
-        "object": {
-          "type": "string"
-        },
-        "path": {
-          "type": "string"
-        },
-        "type": {
-          "type": "string"
-        }
-      }
-    },
-    "user": {
-      "type": "object",
-      "required": [
-        "id",
-        "username"
-      ],
-      "properties": {
-        "created_at": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "email": {
-          "type": "string",
-          "format": "email"
-        },
-        "id": {
-          "type": "string",
-          "format": "uuid",
-          "readOnly": true
-        },
-        "name": {
-          "type": "string"
-        },
-        "updated_at": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "username": {
-          "type": "string"
-        }
-      }
-    },
-    "validationError": {
-      "type": "object",
-      "required": [
-        "message"
-      ],
-      "properties": {
-        "errors": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "field": {
-                "type": "string"
-              },
-              "message": {
-                "type": "string"
-              }
-            }
-          }
-        },
-        "message": {
-          "type": "string"
-        }
-      }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "This is the API for SourcePods - git in the cloud.",
-    "title": "SourcePods OpenAPI",
-    "license": {
-      "name": "Apache-2.0",
-      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
     },
-    "version": "1.0.0"
-  },
-  "basePath": "/v1",
-  "paths": {
-    "/repositories": {
+    "/repositories/import": {
       "post": {
+        "description": "The repository is created right away and its branches and tags are imported in the background. If the import fails the repository is deleted again.",
         "tags": [
           "repositories"
         ],
-        "summary": "Create a new repository",
-        "operationId": "createRepository",
+        "summary": "Import a repository from an external git URL",
+        "operationId": "importRepository",
         "parameters": [
           {
-            "description": "The repository to create",
-            "name": "newRepository",
+            "description": "The repository to create and where to import it from",
+            "name": "import",
             "in": "body",
             "required": true,
             "schema": {
               "type": "object",
               "required": [
-                "name"
+                "name",
+                "url"
               ],
               "properties": {
                 "description": {
@@ -629,6 +421,23 @@ func init() {
                 "name": {
                   "type": "string"
                 },
+                "password": {
+                  "description": "The password or token to authenticate with, it's not stored",
+                  "type": "string",
+                  "format": "password"
+                },
+                "private": {
+                  "description": "Private repositories are only visible to their owner",
+                  "type": "boolean"
+                },
+                "url": {
+                  "description": "The http, https or git URL to import from",
+                  "type": "string"
+                },
+                "username": {
+                  "description": "The username to authenticate with, it's not stored",
+                  "type": "string"
+                },
                 "website": {
                   "type": "string"
                 }
@@ -637,14 +446,20 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "The repository has been created and is returned to you",
+          "202": {
+            "description": "The repository has been created and its import started",
             "schema": {
-              "$ref": "#/definitions/repository"
+              "$ref": "#/definitions/importJob"
+            }
+          },
+          "409": {
+            "description": "A repository with the name already exists",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "422": {
-            "description": "The new repository has not been created due to invalid input",
+            "description": "The import has not been started due to invalid input",
             "schema": {
               "$ref": "#/definitions/validationError"
             }
@@ -658,6 +473,44 @@ func init() {
         }
       }
     },
+    "/repositories/import/{id}": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Get the status of an import",
+        "operationId": "getRepositoryImport",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The import's id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The import's status",
+            "schema": {
+              "$ref": "#/definitions/importJob"
+            }
+          },
+          "404": {
+            "description": "The import could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/repositories/{owner}": {
       "get": {
         "tags": [
@@ -672,6 +525,23 @@ func init() {
             "name": "owner",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "updated",
+              "name"
+            ],
+            "type": "string",
+            "default": "updated",
+            "description": "Sort repositories by their last update, most recent first, or by name",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/perPage"
           }
         ],
         "responses": {
@@ -682,6 +552,16 @@ func init() {
               "items": {
                 "$ref": "#/definitions/repository"
               }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
             }
           },
           "404": {
@@ -690,6 +570,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "default": {
             "description": "unexpected error",
             "schema": {
@@ -742,15 +628,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/repositories/{owner}/{name}/branches": {
-      "get": {
+      },
+      "delete": {
         "tags": [
           "repositories"
         ],
-        "summary": "Get all branches of a repository",
-        "operationId": "getRepositoryBranches",
+        "summary": "Delete a repository, its forks keep working on their own",
+        "operationId": "deleteRepository",
         "parameters": [
           {
             "type": "string",
@@ -768,13 +652,13 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "The repository's branches",
+          "204": {
+            "description": "The repository has been deleted"
+          },
+          "403": {
+            "description": "Only the owner can delete a repository",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/branch"
-              }
+              "$ref": "#/definitions/error"
             }
           },
           "404": {
@@ -790,15 +674,14 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/repositories/{owner}/{name}/tree": {
-      "get": {
+      },
+      "patch": {
+        "description": "The old name keeps redirecting to the repository until another repository takes it.",
         "tags": [
           "repositories"
         ],
-        "summary": "Get the tree including folders (tree) and files (blob) for a repository",
-        "operationId": "getRepositoryTree",
+        "summary": "Update a repository's name, description, website or default branch",
+        "operationId": "updateRepository",
         "parameters": [
           {
             "type": "string",
@@ -815,26 +698,52 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "description": "The ref for the tree",
-            "name": "ref",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The path for the tree",
-            "name": "path",
-            "in": "query"
+            "description": "The fields to update",
+            "name": "update",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "default_branch": {
+                  "type": "string",
+                  "x-nullable": true
+                },
+                "description": {
+                  "type": "string",
+                  "x-nullable": true
+                },
+                "name": {
+                  "type": "string",
+                  "x-nullable": true
+                },
+                "topics": {
+                  "description": "The topics replacing all of the repository's topics",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-nullable": true
+                },
+                "website": {
+                  "type": "string",
+                  "x-nullable": true
+                }
+              }
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "The repository's tree",
+            "description": "The repository has been updated",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/treeEntry"
-              }
+              "$ref": "#/definitions/repository"
+            }
+          },
+          "403": {
+            "description": "Only the owner can update a repository",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "404": {
@@ -843,6 +752,18 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "The owner already has a repository with the new name",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The fields are not valid or the default branch doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
           "default": {
             "description": "unexpected error",
             "schema": {
@@ -852,23 +773,84 @@ func init() {
         }
       }
     },
-    "/users": {
+    "/repositories/{owner}/{name}/branches": {
       "get": {
         "tags": [
-          "users"
+          "repositories"
+        ],
+        "summary": "Get all branches of a repository",
+        "operationId": "getRepositoryBranches",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Only return branches whose name starts with the prefix",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "name",
+              "committerdate"
+            ],
+            "type": "string",
+            "default": "name",
+            "description": "Sort branches by name or by their last commit, newest first",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/perPage"
+          }
         ],
-        "summary": "List all users",
-        "operationId": "listUsers",
         "responses": {
           "200": {
-            "description": "An array of all users",
+            "description": "The repository's branches",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/user"
+                "$ref": "#/definitions/branch"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
               }
             }
           },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "default": {
             "description": "unexpected error",
             "schema": {
@@ -878,18 +860,82 @@ func init() {
         }
       }
     },
-    "/users/me": {
-      "get": {
+    "/repositories/{owner}/{name}/branches/{branch}": {
+      "post": {
         "tags": [
-          "users"
+          "repositories"
+        ],
+        "summary": "Create a new branch pointing to a rev",
+        "operationId": "createRepositoryBranch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The branch's name",
+            "name": "branch",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The rev the new branch points to",
+            "name": "newBranch",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "rev"
+              ],
+              "properties": {
+                "rev": {
+                  "type": "string"
+                }
+              }
+            }
+          }
         ],
-        "summary": "Get the current authenticated user",
-        "operationId": "getUserMe",
         "responses": {
           "200": {
-            "description": "The current authenticated user",
+            "description": "The branch has been created and is returned to you",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/branch"
+            }
+          },
+          "403": {
+            "description": "Only the owner can create branches",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The repository or the rev could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "A branch with this name already exists",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The branch name is not valid",
+            "schema": {
+              "$ref": "#/definitions/validationError"
             }
           },
           "default": {
@@ -899,33 +945,60 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/users/{username}": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "users"
+          "repositories"
         ],
-        "summary": "Get a user by their username",
-        "operationId": "getUser",
+        "summary": "Delete a branch",
+        "operationId": "deleteRepositoryBranch",
         "parameters": [
           {
             "type": "string",
-            "description": "The username of a user",
-            "name": "username",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The branch's name",
+            "name": "branch",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Only delete the branch if it still points to this commit",
+            "name": "sha1",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "The user by their username",
+          "204": {
+            "description": "The branch has been deleted"
+          },
+          "403": {
+            "description": "The branch is protected or the user isn't the owner",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/error"
             }
           },
           "404": {
-            "description": "The user is not found by their username",
+            "description": "The repository or the branch could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "The branch has been changed in the meantime",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -940,21 +1013,35 @@ func init() {
       },
       "patch": {
         "tags": [
-          "users"
+          "repositories"
         ],
-        "summary": "Update the user's information",
-        "operationId": "updateUser",
+        "summary": "Rename a branch",
+        "operationId": "renameRepositoryBranch",
         "parameters": [
           {
             "type": "string",
-            "description": "The username of the user to update",
-            "name": "username",
+            "description": "The owner's username",
+            "name": "owner",
             "in": "path",
             "required": true
           },
           {
-            "description": "The updated user",
-            "name": "updatedUser",
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The branch's name",
+            "name": "branch",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new name of the branch",
+            "name": "renamedBranch",
             "in": "body",
             "required": true,
             "schema": {
@@ -972,19 +1059,31 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "The user has been updated",
+            "description": "The branch has been renamed and is returned to you",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/branch"
+            }
+          },
+          "403": {
+            "description": "The branch is protected or the user isn't the owner",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "404": {
-            "description": "The user could not be found by this username",
+            "description": "The repository or the branch could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "A branch with the new name already exists or the branch has been changed",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
           "422": {
-            "description": "The updated user has invalid input",
+            "description": "The new branch name is not valid",
             "schema": {
               "$ref": "#/definitions/validationError"
             }
@@ -997,31 +1096,9708 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
+    },
+    "/repositories/{owner}/{name}/branches/{branch}/protection": {
+      "get": {
+        "tags": [
+          "statuses"
+        ],
+        "summary": "Get the protection of a branch with its required status checks",
+        "operationId": "getBranchProtection",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The branch's name",
+            "name": "branch",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The branch's protection, without required checks if it isn't protected",
+            "schema": {
+              "$ref": "#/definitions/branchProtection"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "statuses"
+        ],
+        "summary": "Replace the required status checks of a branch",
+        "operationId": "updateBranchProtection",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The branch's name",
+            "name": "branch",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The contexts of the statuses required to succeed before merging pull requests, none remove the protection",
+            "name": "protection",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/branchProtection"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The branch's protection has been updated",
+            "schema": {
+              "$ref": "#/definitions/branchProtection"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can protect branches",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The required checks are not valid",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/events": {
+      "get": {
+        "tags": [
+          "events"
+        ],
+        "summary": "Get the events of a repository, most recent first",
+        "operationId": "listRepositoryEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/perPage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's events",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/event"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "404": {
+            "description": "The repository could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/forks": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Get the forks of a repository",
+        "operationId": "getRepositoryForks",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The forks of the repository with their owners",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/repository"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Fork a repository into the current user's repositories",
+        "operationId": "forkRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The fork to create",
+            "name": "fork",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "name": {
+                  "description": "The fork's name, by default the repository's name",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The fork has been created and is returned to you",
+            "schema": {
+              "$ref": "#/definitions/repository"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "The current user already has a repository with this name",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The fork has not been created due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/issues": {
+      "get": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Get a repository's issues, the most recent first",
+        "operationId": "listIssues",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "open",
+              "closed",
+              "all"
+            ],
+            "type": "string",
+            "default": "open",
+            "description": "Only return issues in this state",
+            "name": "state",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return issues with the label of this name",
+            "name": "label",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return issues assigned to the user with this username",
+            "name": "assignee",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/perPage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's issues",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/issue"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Open an issue",
+        "operationId": "createIssue",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The issue to open",
+            "name": "issue",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "title"
+              ],
+              "properties": {
+                "assignees": {
+                  "description": "The usernames of the issue's assignees, only the repository's owner can set them",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "body": {
+                  "type": "string"
+                },
+                "labels": {
+                  "description": "The names of the issue's labels, only the repository's owner can set them",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "milestone": {
+                  "description": "The title of the issue's milestone, only the repository's owner can set it",
+                  "type": "string"
+                },
+                "title": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The issue has been opened",
+            "schema": {
+              "$ref": "#/definitions/issue"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can set labels, assignees and milestone",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The issue has not been opened due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/issues/{number}": {
+      "get": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Get an issue by its number",
+        "operationId": "getIssue",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The issue's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The issue",
+            "schema": {
+              "$ref": "#/definitions/issue"
+            }
+          },
+          "404": {
+            "description": "The issue could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Update an issue",
+        "operationId": "updateIssue",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The issue's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The fields to update",
+            "name": "update",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "assignees": {
+                  "description": "Replaces the issue's assignees by their usernames",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-nullable": true
+                },
+                "body": {
+                  "type": "string",
+                  "x-nullable": true
+                },
+                "labels": {
+                  "description": "Replaces the issue's labels by their names",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-nullable": true
+                },
+                "milestone": {
+                  "description": "The title of the issue's milestone, empty removes it from its milestone",
+                  "type": "string",
+                  "x-nullable": true
+                },
+                "state": {
+                  "type": "string",
+                  "enum": [
+                    "open",
+                    "closed"
+                  ],
+                  "x-nullable": true
+                },
+                "title": {
+                  "type": "string",
+                  "x-nullable": true
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The issue has been updated",
+            "schema": {
+              "$ref": "#/definitions/issue"
+            }
+          },
+          "403": {
+            "description": "Only the author and the repository's owner can update an issue, only the owner can change labels, assignees and milestone",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The issue could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The issue has not been updated due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/issues/{number}/comments": {
+      "get": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Get the comments of an issue, oldest first",
+        "operationId": "listIssueComments",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The issue's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The issue's comments",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/issueComment"
+              }
+            }
+          },
+          "404": {
+            "description": "The issue could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Comment on an issue",
+        "operationId": "createIssueComment",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The issue's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The comment to create",
+            "name": "comment",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "body"
+              ],
+              "properties": {
+                "body": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The comment has been created",
+            "schema": {
+              "$ref": "#/definitions/issueComment"
+            }
+          },
+          "404": {
+            "description": "The issue could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The comment has not been created due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/labels": {
+      "get": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Get a repository's labels by name",
+        "operationId": "listLabels",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's labels",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/label"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Create a label in a repository",
+        "operationId": "createLabel",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The label to create",
+            "name": "label",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/label"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The label has been created",
+            "schema": {
+              "$ref": "#/definitions/label"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can create labels",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "A label with the name already exists",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The label has not been created due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/labels/{label}": {
+      "delete": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Delete a label from a repository and all its issues",
+        "operationId": "deleteLabel",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The label's name",
+            "name": "label",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The label has been deleted"
+          },
+          "403": {
+            "description": "Only the repository's owner can delete labels",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The label could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/milestones": {
+      "get": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Get a repository's milestones, the ones due first first",
+        "operationId": "listMilestones",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's milestones",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/milestone"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Create a milestone in a repository",
+        "operationId": "createMilestone",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The milestone to create",
+            "name": "milestone",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/milestone"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The milestone has been created",
+            "schema": {
+              "$ref": "#/definitions/milestone"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can create milestones",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "A milestone with the title already exists",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The milestone has not been created due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/mirrors": {
+      "get": {
+        "tags": [
+          "mirrors"
+        ],
+        "summary": "Get the mirrors of a repository",
+        "operationId": "listRepositoryMirrors",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The mirrors of the repository",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/mirror"
+              }
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can see its mirrors",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Pull mirrors fetch the remote's branches and tags, push mirrors push all refs to the remote. The mirror is synced right away and then every interval.",
+        "tags": [
+          "mirrors"
+        ],
+        "summary": "Mirror a repository with a remote",
+        "operationId": "createRepositoryMirror",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The mirror to create",
+            "name": "mirror",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "direction",
+                "url"
+              ],
+              "properties": {
+                "credentials": {
+                  "description": "The reference of the owner's credentials to authenticate with, configured by the administrator",
+                  "type": "string"
+                },
+                "direction": {
+                  "type": "string",
+                  "enum": [
+                    "pull",
+                    "push"
+                  ]
+                },
+                "interval": {
+                  "description": "The seconds between syncs, at least 300, defaults to 3600",
+                  "type": "integer"
+                },
+                "url": {
+                  "description": "The http, https or git URL of the remote",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The mirror has been created",
+            "schema": {
+              "$ref": "#/definitions/mirror"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can create mirrors",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "The repository already pulls from a mirror",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The mirror has not been created due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/mirrors/{id}": {
+      "delete": {
+        "tags": [
+          "mirrors"
+        ],
+        "summary": "Delete a mirror of a repository",
+        "operationId": "deleteRepositoryMirror",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The mirror's id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The mirror has been deleted"
+          },
+          "403": {
+            "description": "Only the repository's owner can delete mirrors",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The mirror could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/mirrors/{id}/sync": {
+      "post": {
+        "description": "The mirror is synced in the background, its last_success_at or last_failure_at change once it's done.",
+        "tags": [
+          "mirrors"
+        ],
+        "summary": "Sync a mirror now",
+        "operationId": "syncRepositoryMirror",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The mirror's id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The sync has been started",
+            "schema": {
+              "$ref": "#/definitions/mirror"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can sync mirrors",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The mirror could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/network": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Get the fork network of a repository",
+        "operationId": "getRepositoryNetwork",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository the network was forked from originally, followed by all forks level by level",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/repository"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/pulls": {
+      "get": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Get a repository's pull requests, the most recent first",
+        "operationId": "listPullRequests",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "open",
+              "closed",
+              "merged",
+              "all"
+            ],
+            "type": "string",
+            "default": "open",
+            "description": "Only return pull requests in this state",
+            "name": "state",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/perPage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's pull requests",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/pullRequest"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Open a pull request to merge a branch of the repository or one of its forks",
+        "operationId": "createPullRequest",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The pull request to open",
+            "name": "pullRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "title",
+                "source_branch",
+                "target_branch"
+              ],
+              "properties": {
+                "body": {
+                  "type": "string"
+                },
+                "source_branch": {
+                  "type": "string"
+                },
+                "source_owner": {
+                  "description": "The owner of the source repository, by default the repository's owner",
+                  "type": "string"
+                },
+                "source_repository": {
+                  "description": "The name of the source repository, by default the repository's name",
+                  "type": "string"
+                },
+                "target_branch": {
+                  "type": "string"
+                },
+                "title": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request has been opened",
+            "schema": {
+              "$ref": "#/definitions/pullRequest"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The pull request has not been opened due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/pulls/{number}": {
+      "get": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Get a pull request by its number",
+        "operationId": "getPullRequest",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request with its mergeability if it's open",
+            "schema": {
+              "$ref": "#/definitions/pullRequest"
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Update the title, body or state of a pull request",
+        "operationId": "updatePullRequest",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The fields to update",
+            "name": "update",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "body": {
+                  "type": "string",
+                  "x-nullable": true
+                },
+                "state": {
+                  "type": "string",
+                  "enum": [
+                    "open",
+                    "closed"
+                  ],
+                  "x-nullable": true
+                },
+                "title": {
+                  "type": "string",
+                  "x-nullable": true
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request has been updated",
+            "schema": {
+              "$ref": "#/definitions/pullRequest"
+            }
+          },
+          "403": {
+            "description": "Only the author and the repository's owner can update a pull request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The pull request has not been updated due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/pulls/{number}/comments": {
+      "get": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Get the comments of a pull request, oldest first",
+        "operationId": "listPullRequestComments",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request's comments",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/pullRequestComment"
+              }
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Comment on a pull request or on a line of a file it changes",
+        "operationId": "createPullRequestComment",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The comment to create",
+            "name": "comment",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "body"
+              ],
+              "properties": {
+                "body": {
+                  "type": "string"
+                },
+                "line": {
+                  "description": "The line of the file at the pull request's head",
+                  "type": "integer"
+                },
+                "path": {
+                  "description": "The file a review comment is anchored to",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The comment has been created",
+            "schema": {
+              "$ref": "#/definitions/pullRequestComment"
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The comment has not been created due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/pulls/{number}/commits": {
+      "get": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Get the commits of a pull request, oldest first",
+        "operationId": "getPullRequestCommits",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request's commits",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/commit"
+              }
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/pulls/{number}/diff": {
+      "get": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Get the changes of a pull request",
+        "operationId": "getPullRequestDiff",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request's diff",
+            "schema": {
+              "$ref": "#/definitions/diff"
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/pulls/{number}/merge": {
+      "put": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Merge a pull request into its target branch",
+        "operationId": "mergePullRequest",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "How to merge the pull request",
+            "name": "merge",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "strategy"
+              ],
+              "properties": {
+                "message": {
+                  "description": "The message of the merge or squashed commit",
+                  "type": "string"
+                },
+                "sha": {
+                  "description": "The head the pull request is expected to have",
+                  "type": "string"
+                },
+                "strategy": {
+                  "type": "string",
+                  "enum": [
+                    "merge",
+                    "squash",
+                    "rebase"
+                  ]
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request has been merged",
+            "schema": {
+              "$ref": "#/definitions/pullRequest"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can merge a pull request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "The pull request is not open, has conflicts or required status checks have not succeeded",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "The pull request's head or target branch has been changed",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The merge strategy is not valid",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/readme": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Get the README of a repository rendered as HTML",
+        "operationId": "getRepositoryReadme",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ref to get the README at, the default branch if empty",
+            "name": "ref",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's README",
+            "schema": {
+              "$ref": "#/definitions/readme"
+            }
+          },
+          "404": {
+            "description": "The repository, ref or README could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The README is too large to be rendered",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/search": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Search the files of a repository",
+        "operationId": "searchRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The text or regular expression to search for",
+            "name": "q",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ref to search, defaults to the default branch",
+            "name": "ref",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Interpret q as POSIX extended regular expression",
+            "name": "regexp",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Match regardless of case",
+            "name": "ignore_case",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Only search files matching one of the globs, e.g. **/*.go",
+            "name": "path",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximum number of matches returned",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "maximum": 5,
+            "type": "integer",
+            "default": 0,
+            "description": "The number of lines returned before and after each match",
+            "name": "context",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The lines matching the query",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/searchMatch"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination or the ref could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The query is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/statuses/{ref}": {
+      "get": {
+        "tags": [
+          "statuses"
+        ],
+        "summary": "Get the statuses of a commit, most recent first",
+        "operationId": "listStatuses",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The sha1 of the commit or a rev resolving to it",
+            "name": "ref",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The commit's statuses",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/status"
+              }
+            }
+          },
+          "404": {
+            "description": "The repository or commit could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "statuses"
+        ],
+        "summary": "Report the status of a commit, e.g. from CI",
+        "operationId": "createStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The sha1 of the commit or a rev resolving to it",
+            "name": "ref",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The status to report, it replaces earlier statuses with the same context",
+            "name": "status",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/status"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The status has been created",
+            "schema": {
+              "$ref": "#/definitions/status"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can report statuses",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The repository or commit could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The status is not valid",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/statuses/{ref}/combined": {
+      "get": {
+        "tags": [
+          "statuses"
+        ],
+        "summary": "Get the state of a commit combined from the latest status of each context",
+        "operationId": "getCombinedStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The sha1 of the commit or a rev resolving to it",
+            "name": "ref",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The commit's combined status",
+            "schema": {
+              "$ref": "#/definitions/combinedStatus"
+            }
+          },
+          "404": {
+            "description": "The repository or commit could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/subscription": {
+      "put": {
+        "tags": [
+          "watching"
+        ],
+        "summary": "Watch a repository to be notified about its activity",
+        "operationId": "watchRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The current user watches the repository"
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "watching"
+        ],
+        "summary": "Stop watching a repository",
+        "operationId": "unwatchRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The current user doesn't watch the repository"
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/transfer": {
+      "post": {
+        "description": "The old owner and name keep redirecting to the repository until another repository takes them.",
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Transfer a repository to another owner",
+        "operationId": "transferRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new owner",
+            "name": "transfer",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "new_owner"
+              ],
+              "properties": {
+                "new_owner": {
+                  "description": "The username of the new owner",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository has been transferred",
+            "schema": {
+              "$ref": "#/definitions/repository"
+            }
+          },
+          "403": {
+            "description": "Only the owner can transfer a repository",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "The new owner already has a repository with the name",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The new owner could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/tree": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Get the tree including folders (tree) and files (blob) for a repository",
+        "operationId": "getRepositoryTree",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ref for the tree",
+            "name": "ref",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path for the tree",
+            "name": "path",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's tree",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/treeEntry"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/watchers": {
+      "get": {
+        "tags": [
+          "watching"
+        ],
+        "summary": "Get the users watching a repository",
+        "operationId": "listRepositoryWatchers",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The users watching the repository, in the order they started watching it",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/user"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/search/code": {
+      "get": {
+        "tags": [
+          "search"
+        ],
+        "summary": "Search the default branches of all repositories",
+        "operationId": "searchCode",
+        "parameters": [
+          {
+            "minLength": 3,
+            "type": "string",
+            "description": "The text to search for",
+            "name": "q",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Match regardless of case",
+            "name": "ignore_case",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximum number of matches returned",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The lines matching the query, ranked by repository and path",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/codeMatch"
+              }
+            }
+          },
+          "422": {
+            "description": "The query is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/search/repositories": {
+      "get": {
+        "tags": [
+          "search"
+        ],
+        "summary": "Search repositories by name and description",
+        "operationId": "searchRepositories",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "The words to search for",
+            "name": "q",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "name",
+              "updated"
+            ],
+            "type": "string",
+            "description": "Sort by name or most recently updated, by default the best matches come first",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/perPage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repositories visible to the user matching all words of the query",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/repository"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "422": {
+            "description": "The query or the cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/search/users": {
+      "get": {
+        "tags": [
+          "search"
+        ],
+        "summary": "Search users by username and name",
+        "operationId": "searchUsers",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "The words to search for",
+            "name": "q",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "name",
+              "updated"
+            ],
+            "type": "string",
+            "description": "Sort by name or most recently updated, by default the best matches come first",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/perPage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The users matching all words of the query",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/user"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "422": {
+            "description": "The query or the cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/user/starred": {
+      "get": {
+        "tags": [
+          "stars"
+        ],
+        "summary": "Get the repositories starred by the current user, most recently starred first",
+        "operationId": "listStarredRepositories",
+        "parameters": [
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/perPage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The starred repositories with their owners",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/repository"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/user/starred/{owner}/{name}": {
+      "get": {
+        "tags": [
+          "stars"
+        ],
+        "summary": "Check if the current user starred a repository",
+        "operationId": "checkRepositoryStarred",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The current user starred the repository"
+          },
+          "404": {
+            "description": "The repository isn't starred or could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "stars"
+        ],
+        "summary": "Star a repository",
+        "operationId": "starRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The current user starred the repository"
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "stars"
+        ],
+        "summary": "Unstar a repository",
+        "operationId": "unstarRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The current user doesn't star the repository"
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users": {
+      "get": {
+        "tags": [
+          "users"
+        ],
+        "summary": "List all users",
+        "operationId": "listUsers",
+        "parameters": [
+          {
+            "enum": [
+              "name",
+              "updated"
+            ],
+            "type": "string",
+            "default": "name",
+            "description": "Sort users by name or by their last update, most recent first",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/perPage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "An array of all users",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/user"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/me": {
+      "get": {
+        "tags": [
+          "users"
+        ],
+        "summary": "Get the current authenticated user",
+        "operationId": "getUserMe",
+        "responses": {
+          "200": {
+            "description": "The current authenticated user",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{username}": {
+      "get": {
+        "tags": [
+          "users"
+        ],
+        "summary": "Get a user by their username",
+        "operationId": "getUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The username of a user",
+            "name": "username",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The user by their username",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "404": {
+            "description": "The user is not found by their username",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "tags": [
+          "users"
+        ],
+        "summary": "Update the user's information",
+        "operationId": "updateUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The username of the user to update",
+            "name": "username",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The updated user",
+            "name": "updatedUser",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "name"
+              ],
+              "properties": {
+                "name": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The user has been updated",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "404": {
+            "description": "The user could not be found by this username",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The updated user has invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{username}/events": {
+      "get": {
+        "tags": [
+          "events"
+        ],
+        "summary": "Get the events done by a user, most recent first, only public ones for other users",
+        "operationId": "listUserEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The username of a user",
+            "name": "username",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/perPage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The user's events",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/event"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "404": {
+            "description": "The user could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{username}/starred": {
+      "get": {
+        "tags": [
+          "stars"
+        ],
+        "summary": "Get the repositories starred by a user, most recently starred first",
+        "operationId": "listUserStarredRepositories",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The username of a user",
+            "name": "username",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/perPage"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The starred repositories with their owners",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/repository"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "404": {
+            "description": "The user could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "branch": {
+      "type": "object",
+      "properties": {
+        "ahead": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "author_date": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "author_email": {
+          "type": "string"
+        },
+        "author_name": {
+          "type": "string"
+        },
+        "behind": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string"
+        },
+        "protected": {
+          "type": "boolean"
+        },
+        "required_checks": {
+          "description": "The contexts of statuses required to succeed before merging into the branch",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "sha1": {
+          "type": "string"
+        },
+        "status": {
+          "description": "The state combined from the statuses of the branch's head",
+          "type": "string",
+          "enum": [
+            "pending",
+            "success",
+            "failure"
+          ]
+        },
+        "subject": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "branchProtection": {
+      "type": "object",
+      "required": [
+        "required_checks"
+      ],
+      "properties": {
+        "branch": {
+          "type": "string",
+          "readOnly": true
+        },
+        "required_checks": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "codeMatch": {
+      "type": "object",
+      "required": [
+        "owner",
+        "repository",
+        "path",
+        "line",
+        "text"
+      ],
+      "properties": {
+        "line": {
+          "type": "integer"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "repository": {
+          "type": "string"
+        },
+        "text": {
+          "type": "string"
+        }
+      }
+    },
+    "combinedStatus": {
+      "type": "object",
+      "required": [
+        "sha",
+        "state",
+        "statuses"
+      ],
+      "properties": {
+        "sha": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "success",
+            "failure"
+          ]
+        },
+        "statuses": {
+          "description": "The latest status of each context",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/status"
+          }
+        }
+      }
+    },
+    "commit": {
+      "type": "object",
+      "required": [
+        "sha1"
+      ],
+      "properties": {
+        "author_date": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "author_email": {
+          "type": "string"
+        },
+        "author_name": {
+          "type": "string"
+        },
+        "body": {
+          "type": "string"
+        },
+        "committer_date": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "committer_email": {
+          "type": "string"
+        },
+        "committer_name": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "parent": {
+          "type": "string"
+        },
+        "sha1": {
+          "type": "string"
+        },
+        "tree": {
+          "type": "string"
+        }
+      }
+    },
+    "diff": {
+      "type": "object",
+      "properties": {
+        "files": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/fileDiff"
+          }
+        },
+        "truncated": {
+          "description": "The diff is too large and the last files are missing",
+          "type": "boolean"
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "id",
+        "type"
+      ],
+      "properties": {
+        "actor": {
+          "$ref": "#/definitions/user"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "data": {
+          "description": "Details depending on the event's type",
+          "type": "object",
+          "additionalProperties": true
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "public": {
+          "type": "boolean"
+        },
+        "repository": {
+          "description": "The repository as owner/name at the time of the event",
+          "type": "string"
+        },
+        "type": {
+          "description": "What happened, e.g. repository.created, push or login.failed",
+          "type": "string"
+        }
+      }
+    },
+    "fileDiff": {
+      "type": "object",
+      "required": [
+        "path",
+        "status"
+      ],
+      "properties": {
+        "additions": {
+          "type": "integer"
+        },
+        "binary": {
+          "type": "boolean"
+        },
+        "deletions": {
+          "type": "integer"
+        },
+        "old_path": {
+          "description": "The path before the file was renamed",
+          "type": "string"
+        },
+        "patch": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "added",
+            "deleted",
+            "modified",
+            "renamed"
+          ]
+        }
+      }
+    },
+    "importJob": {
+      "type": "object",
+      "required": [
+        "id",
+        "state"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "error": {
+          "description": "Why the import failed",
+          "type": "string"
+        },
+        "finished_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "progress": {
+          "description": "The last progress reported while importing",
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "queued",
+            "running",
+            "succeeded",
+            "failed"
+          ]
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "issue": {
+      "type": "object",
+      "required": [
+        "id",
+        "number",
+        "title",
+        "state"
+      ],
+      "properties": {
+        "assignees": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/user"
+          }
+        },
+        "author": {
+          "$ref": "#/definitions/user"
+        },
+        "body": {
+          "type": "string"
+        },
+        "closed_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "labels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/label"
+          }
+        },
+        "milestone": {
+          "$ref": "#/definitions/milestone"
+        },
+        "number": {
+          "type": "integer"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "open",
+            "closed"
+          ]
+        },
+        "title": {
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "issueComment": {
+      "type": "object",
+      "required": [
+        "id",
+        "body"
+      ],
+      "properties": {
+        "author": {
+          "$ref": "#/definitions/user"
+        },
+        "body": {
+          "type": "string"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "label": {
+      "type": "object",
+      "required": [
+        "name",
+        "color"
+      ],
+      "properties": {
+        "color": {
+          "description": "A hex color like #d73a4a",
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "language": {
+      "type": "object",
+      "required": [
+        "name",
+        "bytes"
+      ],
+      "properties": {
+        "bytes": {
+          "description": "Bytes of all files written in the language",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "markdown": {
+      "type": "object",
+      "required": [
+        "html"
+      ],
+      "properties": {
+        "html": {
+          "description": "The Markdown rendered to sanitized HTML",
+          "type": "string"
+        }
+      }
+    },
+    "milestone": {
+      "type": "object",
+      "required": [
+        "title"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "description": {
+          "type": "string"
+        },
+        "due_on": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "open",
+            "closed"
+          ],
+          "readOnly": true
+        },
+        "title": {
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        }
+      }
+    },
+    "mirror": {
+      "type": "object",
+      "required": [
+        "id",
+        "direction",
+        "url"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "credentials": {
+          "description": "The reference of the credentials to authenticate with",
+          "type": "string"
+        },
+        "direction": {
+          "type": "string",
+          "enum": [
+            "pull",
+            "push"
+          ]
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "interval": {
+          "description": "The seconds between syncs",
+          "type": "integer"
+        },
+        "last_error": {
+          "description": "Why the last failed sync failed",
+          "type": "string",
+          "readOnly": true
+        },
+        "last_failure_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "last_success_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "next_sync_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "notification": {
+      "type": "object",
+      "required": [
+        "id",
+        "reason",
+        "unread",
+        "event"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "event": {
+          "$ref": "#/definitions/event"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "reason": {
+          "description": "Why the user is notified, security, mention, author or watching",
+          "type": "string"
+        },
+        "unread": {
+          "type": "boolean"
+        }
+      }
+    },
+    "notificationPreference": {
+      "type": "object",
+      "required": [
+        "reason",
+        "web",
+        "email"
+      ],
+      "properties": {
+        "email": {
+          "description": "Email the notifications, batched in digests",
+          "type": "boolean"
+        },
+        "reason": {
+          "description": "The reason of the notifications, security, mention, author or watching",
+          "type": "string"
+        },
+        "web": {
+          "description": "List the notifications in the inbox",
+          "type": "boolean"
+        }
+      }
+    },
+    "pullRequest": {
+      "type": "object",
+      "required": [
+        "id",
+        "number",
+        "title",
+        "state"
+      ],
+      "properties": {
+        "author": {
+          "$ref": "#/definitions/user"
+        },
+        "base": {
+          "description": "The commit the source branch branched off the target branch",
+          "type": "string"
+        },
+        "body": {
+          "type": "string"
+        },
+        "closed_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "conflicts": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "head": {
+          "description": "The commit of the source branch",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "mergeable": {
+          "description": "Whether the pull request can be merged without conflicts, only known for open pull requests",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "number": {
+          "type": "integer"
+        },
+        "source": {
+          "$ref": "#/definitions/pullRequestRef"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "open",
+            "closed",
+            "merged"
+          ]
+        },
+        "target": {
+          "$ref": "#/definitions/pullRequestRef"
+        },
+        "title": {
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "pullRequestComment": {
+      "type": "object",
+      "required": [
+        "id",
+        "body"
+      ],
+      "properties": {
+        "author": {
+          "$ref": "#/definitions/user"
+        },
+        "body": {
+          "type": "string"
+        },
+        "commit": {
+          "description": "The commit the path and line refer to",
+          "type": "string"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "line": {
+          "type": "integer"
+        },
+        "path": {
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "pullRequestRef": {
+      "type": "object",
+      "properties": {
+        "branch": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "repository": {
+          "type": "string"
+        }
+      }
+    },
+    "readme": {
+      "type": "object",
+      "required": [
+        "path",
+        "ref",
+        "html"
+      ],
+      "properties": {
+        "html": {
+          "description": "The README rendered to sanitized HTML, relative links point to the raw files",
+          "type": "string"
+        },
+        "path": {
+          "description": "The path of the README in the repository",
+          "type": "string"
+        },
+        "ref": {
+          "description": "The ref the README was found at",
+          "type": "string"
+        },
+        "sha1": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "repository": {
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "default_branch": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "object",
+          "$ref": "#/definitions/user"
+        },
+        "parent_id": {
+          "description": "The repository this one was forked from",
+          "type": "string",
+          "format": "uuid"
+        },
+        "private": {
+          "type": "boolean"
+        },
+        "stars": {
+          "description": "The number of users who starred the repository",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "stats": {
+          "type": "object",
+          "$ref": "#/definitions/repositoryStats"
+        },
+        "topics": {
+          "description": "The topics tagging the repository, lowercase letters, numbers and hyphens",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "watchers": {
+          "description": "The number of users watching the repository",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "website": {
+          "type": "string"
+        }
+      }
+    },
+    "repositoryStats": {
+      "type": "object",
+      "properties": {
+        "commits": {
+          "description": "Commits reachable from the default branch",
+          "type": "integer",
+          "format": "int64"
+        },
+        "forks": {
+          "description": "Public forks of the repository",
+          "type": "integer",
+          "format": "int64"
+        },
+        "languages": {
+          "description": "Languages of the files on the default branch, most bytes first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/language"
+          }
+        },
+        "loose_objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "packed_objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "description": "Bytes of all objects on disk",
+          "type": "integer",
+          "format": "int64"
+        }
+      },
+      "readOnly": true
+    },
+    "searchMatch": {
+      "type": "object",
+      "required": [
+        "path",
+        "line",
+        "text"
+      ],
+      "properties": {
+        "after": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "before": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "line": {
+          "type": "integer"
+        },
+        "path": {
+          "type": "string"
+        },
+        "text": {
+          "type": "string"
+        }
+      }
+    },
+    "status": {
+      "type": "object",
+      "required": [
+        "state"
+      ],
+      "properties": {
+        "context": {
+          "description": "Identifies the system reporting the status, defaults to default",
+          "type": "string"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "creator": {
+          "$ref": "#/definitions/user"
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "sha": {
+          "type": "string",
+          "readOnly": true
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "success",
+            "failure",
+            "error"
+          ]
+        },
+        "target_url": {
+          "description": "Where to find details about the status, e.g. the build's log",
+          "type": "string"
+        }
+      }
+    },
+    "treeEntry": {
+      "type": "object",
+      "required": [
+        "mode",
+        "type",
+        "object",
+        "path"
+      ],
+      "properties": {
+        "mode": {
+          "type": "string"
+        },
+        "object": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "user": {
+      "type": "object",
+      "required": [
+        "id",
+        "username"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "email": {
+          "type": "string",
+          "format": "email"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "validationError": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "field": {
+                "type": "string"
+              },
+              "message": {
+                "type": "string"
+              }
+            }
+          }
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "cursor": {
+      "type": "string",
+      "description": "The cursor of the page to return, as returned with the previous page",
+      "name": "cursor",
+      "in": "query"
+    },
+    "perPage": {
+      "maximum": 100,
+      "minimum": 1,
+      "type": "integer",
+      "default": 30,
+      "description": "The number of items per page",
+      "name": "per_page",
+      "in": "query"
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "This is the API for SourcePods - git in the cloud.",
+    "title": "SourcePods OpenAPI",
+    "license": {
+      "name": "Apache-2.0",
+      "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+    },
+    "version": "1.0.0"
+  },
+  "basePath": "/v1",
+  "paths": {
+    "/events": {
+      "get": {
+        "tags": [
+          "events"
+        ],
+        "summary": "Get the events of all users and repositories, most recent first",
+        "operationId": "listEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned with the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "The number of items per page",
+            "name": "per_page",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The events",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/event"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "403": {
+            "description": "Only admins can list all events",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/markdown": {
+      "post": {
+        "description": "Renders GitHub flavored Markdown to sanitized HTML. Mentioned users, issues like owner/name#123 and commits of the repository are linked, code blocks are highlighted.",
+        "tags": [
+          "markdown"
+        ],
+        "summary": "Render Markdown to HTML",
+        "operationId": "renderMarkdown",
+        "parameters": [
+          {
+            "description": "The Markdown to render",
+            "name": "markdown",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "text"
+              ],
+              "properties": {
+                "repository": {
+                  "description": "The owner/name of the repository the text is about,",
+                  "type": "string"
+                },
+                "text": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The rendered Markdown",
+            "schema": {
+              "$ref": "#/definitions/markdown"
+            }
+          },
+          "422": {
+            "description": "The text is too large or the repository is not owner/name",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/notifications": {
+      "get": {
+        "tags": [
+          "notifications"
+        ],
+        "summary": "Get the notifications of the current user, most recent first",
+        "operationId": "listNotifications",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "List read notifications too, only unread ones are listed otherwise",
+            "name": "all",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned with the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "The number of items per page",
+            "name": "per_page",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The notifications",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/notification"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "notifications"
+        ],
+        "summary": "Mark all notifications of the current user as read",
+        "operationId": "markNotificationsRead",
+        "responses": {
+          "204": {
+            "description": "All notifications are read"
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/notifications/preferences": {
+      "get": {
+        "tags": [
+          "notifications"
+        ],
+        "summary": "Get how the current user's notifications are delivered for every reason",
+        "operationId": "getNotificationPreferences",
+        "responses": {
+          "200": {
+            "description": "The delivery for every reason",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/notificationPreference"
+              }
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "notifications"
+        ],
+        "summary": "Change how the current user's notifications are delivered for the given reasons",
+        "operationId": "updateNotificationPreferences",
+        "parameters": [
+          {
+            "name": "preferences",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/notificationPreference"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The delivery for every reason",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/notificationPreference"
+              }
+            }
+          },
+          "422": {
+            "description": "A reason is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/notifications/threads/{id}": {
+      "patch": {
+        "tags": [
+          "notifications"
+        ],
+        "summary": "Mark a notification of the current user as read",
+        "operationId": "markNotificationRead",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The notification's id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The notification is read"
+          },
+          "404": {
+            "description": "The notification could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories": {
+      "post": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Create a new repository",
+        "operationId": "createRepository",
+        "parameters": [
+          {
+            "description": "The repository to create",
+            "name": "newRepository",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "name"
+              ],
+              "properties": {
+                "description": {
+                  "type": "string"
+                },
+                "name": {
+                  "type": "string"
+                },
+                "private": {
+                  "description": "Private repositories are only visible to their owner",
+                  "type": "boolean"
+                },
+                "topics": {
+                  "description": "The topics tagging the repository",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "website": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository has been created and is returned to you",
+            "schema": {
+              "$ref": "#/definitions/repository"
+            }
+          },
+          "422": {
+            "description": "The new repository has not been created due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/import": {
+      "post": {
+        "description": "The repository is created right away and its branches and tags are imported in the background. If the import fails the repository is deleted again.",
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Import a repository from an external git URL",
+        "operationId": "importRepository",
+        "parameters": [
+          {
+            "description": "The repository to create and where to import it from",
+            "name": "import",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "name",
+                "url"
+              ],
+              "properties": {
+                "description": {
+                  "type": "string"
+                },
+                "name": {
+                  "type": "string"
+                },
+                "password": {
+                  "description": "The password or token to authenticate with, it's not stored",
+                  "type": "string",
+                  "format": "password"
+                },
+                "private": {
+                  "description": "Private repositories are only visible to their owner",
+                  "type": "boolean"
+                },
+                "url": {
+                  "description": "The http, https or git URL to import from",
+                  "type": "string"
+                },
+                "username": {
+                  "description": "The username to authenticate with, it's not stored",
+                  "type": "string"
+                },
+                "website": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The repository has been created and its import started",
+            "schema": {
+              "$ref": "#/definitions/importJob"
+            }
+          },
+          "409": {
+            "description": "A repository with the name already exists",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The import has not been started due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/import/{id}": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Get the status of an import",
+        "operationId": "getRepositoryImport",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The import's id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The import's status",
+            "schema": {
+              "$ref": "#/definitions/importJob"
+            }
+          },
+          "404": {
+            "description": "The import could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Get a owner's repositories",
+        "operationId": "getOwnerRepositories",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "updated",
+              "name"
+            ],
+            "type": "string",
+            "default": "updated",
+            "description": "Sort repositories by their last update, most recent first, or by name",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned with the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "The number of items per page",
+            "name": "per_page",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repositories found by its owner name",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/repository"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner could not be found by this username",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Get a repository by owner name and its name",
+        "operationId": "getRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository found by its owner and name",
+            "schema": {
+              "$ref": "#/definitions/repository"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Delete a repository, its forks keep working on their own",
+        "operationId": "deleteRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The repository has been deleted"
+          },
+          "403": {
+            "description": "Only the owner can delete a repository",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "The old name keeps redirecting to the repository until another repository takes it.",
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Update a repository's name, description, website or default branch",
+        "operationId": "updateRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The fields to update",
+            "name": "update",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "default_branch": {
+                  "type": "string",
+                  "x-nullable": true
+                },
+                "description": {
+                  "type": "string",
+                  "x-nullable": true
+                },
+                "name": {
+                  "type": "string",
+                  "x-nullable": true
+                },
+                "topics": {
+                  "description": "The topics replacing all of the repository's topics",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-nullable": true
+                },
+                "website": {
+                  "type": "string",
+                  "x-nullable": true
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository has been updated",
+            "schema": {
+              "$ref": "#/definitions/repository"
+            }
+          },
+          "403": {
+            "description": "Only the owner can update a repository",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "The owner already has a repository with the new name",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The fields are not valid or the default branch doesn't exist",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/branches": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Get all branches of a repository",
+        "operationId": "getRepositoryBranches",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Only return branches whose name starts with the prefix",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "name",
+              "committerdate"
+            ],
+            "type": "string",
+            "default": "name",
+            "description": "Sort branches by name or by their last commit, newest first",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned with the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "The number of items per page",
+            "name": "per_page",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's branches",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/branch"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/branches/{branch}": {
+      "post": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Create a new branch pointing to a rev",
+        "operationId": "createRepositoryBranch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The branch's name",
+            "name": "branch",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The rev the new branch points to",
+            "name": "newBranch",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "rev"
+              ],
+              "properties": {
+                "rev": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The branch has been created and is returned to you",
+            "schema": {
+              "$ref": "#/definitions/branch"
+            }
+          },
+          "403": {
+            "description": "Only the owner can create branches",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The repository or the rev could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "A branch with this name already exists",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The branch name is not valid",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Delete a branch",
+        "operationId": "deleteRepositoryBranch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The branch's name",
+            "name": "branch",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Only delete the branch if it still points to this commit",
+            "name": "sha1",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The branch has been deleted"
+          },
+          "403": {
+            "description": "The branch is protected or the user isn't the owner",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The repository or the branch could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "The branch has been changed in the meantime",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Rename a branch",
+        "operationId": "renameRepositoryBranch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The branch's name",
+            "name": "branch",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new name of the branch",
+            "name": "renamedBranch",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "name"
+              ],
+              "properties": {
+                "name": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The branch has been renamed and is returned to you",
+            "schema": {
+              "$ref": "#/definitions/branch"
+            }
+          },
+          "403": {
+            "description": "The branch is protected or the user isn't the owner",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The repository or the branch could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "A branch with the new name already exists or the branch has been changed",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The new branch name is not valid",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/branches/{branch}/protection": {
+      "get": {
+        "tags": [
+          "statuses"
+        ],
+        "summary": "Get the protection of a branch with its required status checks",
+        "operationId": "getBranchProtection",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The branch's name",
+            "name": "branch",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The branch's protection, without required checks if it isn't protected",
+            "schema": {
+              "$ref": "#/definitions/branchProtection"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "statuses"
+        ],
+        "summary": "Replace the required status checks of a branch",
+        "operationId": "updateBranchProtection",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The branch's name",
+            "name": "branch",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The contexts of the statuses required to succeed before merging pull requests, none remove the protection",
+            "name": "protection",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/branchProtection"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The branch's protection has been updated",
+            "schema": {
+              "$ref": "#/definitions/branchProtection"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can protect branches",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The required checks are not valid",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/events": {
+      "get": {
+        "tags": [
+          "events"
+        ],
+        "summary": "Get the events of a repository, most recent first",
+        "operationId": "listRepositoryEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned with the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "The number of items per page",
+            "name": "per_page",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's events",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/event"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "404": {
+            "description": "The repository could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/forks": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Get the forks of a repository",
+        "operationId": "getRepositoryForks",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The forks of the repository with their owners",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/repository"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Fork a repository into the current user's repositories",
+        "operationId": "forkRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The fork to create",
+            "name": "fork",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "name": {
+                  "description": "The fork's name, by default the repository's name",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The fork has been created and is returned to you",
+            "schema": {
+              "$ref": "#/definitions/repository"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "The current user already has a repository with this name",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The fork has not been created due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/issues": {
+      "get": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Get a repository's issues, the most recent first",
+        "operationId": "listIssues",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "open",
+              "closed",
+              "all"
+            ],
+            "type": "string",
+            "default": "open",
+            "description": "Only return issues in this state",
+            "name": "state",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return issues with the label of this name",
+            "name": "label",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only return issues assigned to the user with this username",
+            "name": "assignee",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned with the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "The number of items per page",
+            "name": "per_page",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's issues",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/issue"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Open an issue",
+        "operationId": "createIssue",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The issue to open",
+            "name": "issue",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "title"
+              ],
+              "properties": {
+                "assignees": {
+                  "description": "The usernames of the issue's assignees, only the repository's owner can set them",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "body": {
+                  "type": "string"
+                },
+                "labels": {
+                  "description": "The names of the issue's labels, only the repository's owner can set them",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "milestone": {
+                  "description": "The title of the issue's milestone, only the repository's owner can set it",
+                  "type": "string"
+                },
+                "title": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The issue has been opened",
+            "schema": {
+              "$ref": "#/definitions/issue"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can set labels, assignees and milestone",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The issue has not been opened due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/issues/{number}": {
+      "get": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Get an issue by its number",
+        "operationId": "getIssue",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The issue's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The issue",
+            "schema": {
+              "$ref": "#/definitions/issue"
+            }
+          },
+          "404": {
+            "description": "The issue could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Update an issue",
+        "operationId": "updateIssue",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The issue's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The fields to update",
+            "name": "update",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "assignees": {
+                  "description": "Replaces the issue's assignees by their usernames",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-nullable": true
+                },
+                "body": {
+                  "type": "string",
+                  "x-nullable": true
+                },
+                "labels": {
+                  "description": "Replaces the issue's labels by their names",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-nullable": true
+                },
+                "milestone": {
+                  "description": "The title of the issue's milestone, empty removes it from its milestone",
+                  "type": "string",
+                  "x-nullable": true
+                },
+                "state": {
+                  "type": "string",
+                  "enum": [
+                    "open",
+                    "closed"
+                  ],
+                  "x-nullable": true
+                },
+                "title": {
+                  "type": "string",
+                  "x-nullable": true
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The issue has been updated",
+            "schema": {
+              "$ref": "#/definitions/issue"
+            }
+          },
+          "403": {
+            "description": "Only the author and the repository's owner can update an issue, only the owner can change labels, assignees and milestone",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The issue could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The issue has not been updated due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/issues/{number}/comments": {
+      "get": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Get the comments of an issue, oldest first",
+        "operationId": "listIssueComments",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The issue's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The issue's comments",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/issueComment"
+              }
+            }
+          },
+          "404": {
+            "description": "The issue could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Comment on an issue",
+        "operationId": "createIssueComment",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The issue's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The comment to create",
+            "name": "comment",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "body"
+              ],
+              "properties": {
+                "body": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The comment has been created",
+            "schema": {
+              "$ref": "#/definitions/issueComment"
+            }
+          },
+          "404": {
+            "description": "The issue could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The comment has not been created due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/labels": {
+      "get": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Get a repository's labels by name",
+        "operationId": "listLabels",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's labels",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/label"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Create a label in a repository",
+        "operationId": "createLabel",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The label to create",
+            "name": "label",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/label"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The label has been created",
+            "schema": {
+              "$ref": "#/definitions/label"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can create labels",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "A label with the name already exists",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The label has not been created due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/labels/{label}": {
+      "delete": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Delete a label from a repository and all its issues",
+        "operationId": "deleteLabel",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The label's name",
+            "name": "label",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The label has been deleted"
+          },
+          "403": {
+            "description": "Only the repository's owner can delete labels",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The label could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/milestones": {
+      "get": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Get a repository's milestones, the ones due first first",
+        "operationId": "listMilestones",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's milestones",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/milestone"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "issues"
+        ],
+        "summary": "Create a milestone in a repository",
+        "operationId": "createMilestone",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The milestone to create",
+            "name": "milestone",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/milestone"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The milestone has been created",
+            "schema": {
+              "$ref": "#/definitions/milestone"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can create milestones",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "A milestone with the title already exists",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The milestone has not been created due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/mirrors": {
+      "get": {
+        "tags": [
+          "mirrors"
+        ],
+        "summary": "Get the mirrors of a repository",
+        "operationId": "listRepositoryMirrors",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The mirrors of the repository",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/mirror"
+              }
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can see its mirrors",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Pull mirrors fetch the remote's branches and tags, push mirrors push all refs to the remote. The mirror is synced right away and then every interval.",
+        "tags": [
+          "mirrors"
+        ],
+        "summary": "Mirror a repository with a remote",
+        "operationId": "createRepositoryMirror",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The mirror to create",
+            "name": "mirror",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "direction",
+                "url"
+              ],
+              "properties": {
+                "credentials": {
+                  "description": "The reference of the owner's credentials to authenticate with, configured by the administrator",
+                  "type": "string"
+                },
+                "direction": {
+                  "type": "string",
+                  "enum": [
+                    "pull",
+                    "push"
+                  ]
+                },
+                "interval": {
+                  "description": "The seconds between syncs, at least 300, defaults to 3600",
+                  "type": "integer"
+                },
+                "url": {
+                  "description": "The http, https or git URL of the remote",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The mirror has been created",
+            "schema": {
+              "$ref": "#/definitions/mirror"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can create mirrors",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "The repository already pulls from a mirror",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The mirror has not been created due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/mirrors/{id}": {
+      "delete": {
+        "tags": [
+          "mirrors"
+        ],
+        "summary": "Delete a mirror of a repository",
+        "operationId": "deleteRepositoryMirror",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The mirror's id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The mirror has been deleted"
+          },
+          "403": {
+            "description": "Only the repository's owner can delete mirrors",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The mirror could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/mirrors/{id}/sync": {
+      "post": {
+        "description": "The mirror is synced in the background, its last_success_at or last_failure_at change once it's done.",
+        "tags": [
+          "mirrors"
+        ],
+        "summary": "Sync a mirror now",
+        "operationId": "syncRepositoryMirror",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The mirror's id",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "The sync has been started",
+            "schema": {
+              "$ref": "#/definitions/mirror"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can sync mirrors",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The mirror could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/network": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Get the fork network of a repository",
+        "operationId": "getRepositoryNetwork",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository the network was forked from originally, followed by all forks level by level",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/repository"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/pulls": {
+      "get": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Get a repository's pull requests, the most recent first",
+        "operationId": "listPullRequests",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "open",
+              "closed",
+              "merged",
+              "all"
+            ],
+            "type": "string",
+            "default": "open",
+            "description": "Only return pull requests in this state",
+            "name": "state",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned with the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "The number of items per page",
+            "name": "per_page",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's pull requests",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/pullRequest"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Open a pull request to merge a branch of the repository or one of its forks",
+        "operationId": "createPullRequest",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The pull request to open",
+            "name": "pullRequest",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "title",
+                "source_branch",
+                "target_branch"
+              ],
+              "properties": {
+                "body": {
+                  "type": "string"
+                },
+                "source_branch": {
+                  "type": "string"
+                },
+                "source_owner": {
+                  "description": "The owner of the source repository, by default the repository's owner",
+                  "type": "string"
+                },
+                "source_repository": {
+                  "description": "The name of the source repository, by default the repository's name",
+                  "type": "string"
+                },
+                "target_branch": {
+                  "type": "string"
+                },
+                "title": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request has been opened",
+            "schema": {
+              "$ref": "#/definitions/pullRequest"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The pull request has not been opened due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/pulls/{number}": {
+      "get": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Get a pull request by its number",
+        "operationId": "getPullRequest",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request with its mergeability if it's open",
+            "schema": {
+              "$ref": "#/definitions/pullRequest"
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Update the title, body or state of a pull request",
+        "operationId": "updatePullRequest",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The fields to update",
+            "name": "update",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "body": {
+                  "type": "string",
+                  "x-nullable": true
+                },
+                "state": {
+                  "type": "string",
+                  "enum": [
+                    "open",
+                    "closed"
+                  ],
+                  "x-nullable": true
+                },
+                "title": {
+                  "type": "string",
+                  "x-nullable": true
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request has been updated",
+            "schema": {
+              "$ref": "#/definitions/pullRequest"
+            }
+          },
+          "403": {
+            "description": "Only the author and the repository's owner can update a pull request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The pull request has not been updated due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/pulls/{number}/comments": {
+      "get": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Get the comments of a pull request, oldest first",
+        "operationId": "listPullRequestComments",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request's comments",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/pullRequestComment"
+              }
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Comment on a pull request or on a line of a file it changes",
+        "operationId": "createPullRequestComment",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The comment to create",
+            "name": "comment",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "body"
+              ],
+              "properties": {
+                "body": {
+                  "type": "string"
+                },
+                "line": {
+                  "description": "The line of the file at the pull request's head",
+                  "type": "integer"
+                },
+                "path": {
+                  "description": "The file a review comment is anchored to",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The comment has been created",
+            "schema": {
+              "$ref": "#/definitions/pullRequestComment"
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The comment has not been created due to invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/pulls/{number}/commits": {
+      "get": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Get the commits of a pull request, oldest first",
+        "operationId": "getPullRequestCommits",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request's commits",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/commit"
+              }
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/pulls/{number}/diff": {
+      "get": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Get the changes of a pull request",
+        "operationId": "getPullRequestDiff",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request's diff",
+            "schema": {
+              "$ref": "#/definitions/diff"
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/pulls/{number}/merge": {
+      "put": {
+        "tags": [
+          "pullrequests"
+        ],
+        "summary": "Merge a pull request into its target branch",
+        "operationId": "mergePullRequest",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "The pull request's number",
+            "name": "number",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "How to merge the pull request",
+            "name": "merge",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "strategy"
+              ],
+              "properties": {
+                "message": {
+                  "description": "The message of the merge or squashed commit",
+                  "type": "string"
+                },
+                "sha": {
+                  "description": "The head the pull request is expected to have",
+                  "type": "string"
+                },
+                "strategy": {
+                  "type": "string",
+                  "enum": [
+                    "merge",
+                    "squash",
+                    "rebase"
+                  ]
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The pull request has been merged",
+            "schema": {
+              "$ref": "#/definitions/pullRequest"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can merge a pull request",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The pull request could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "405": {
+            "description": "The pull request is not open, has conflicts or required status checks have not succeeded",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "The pull request's head or target branch has been changed",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The merge strategy is not valid",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/readme": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Get the README of a repository rendered as HTML",
+        "operationId": "getRepositoryReadme",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ref to get the README at, the default branch if empty",
+            "name": "ref",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's README",
+            "schema": {
+              "$ref": "#/definitions/readme"
+            }
+          },
+          "404": {
+            "description": "The repository, ref or README could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The README is too large to be rendered",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/search": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Search the files of a repository",
+        "operationId": "searchRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The text or regular expression to search for",
+            "name": "q",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ref to search, defaults to the default branch",
+            "name": "ref",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Interpret q as POSIX extended regular expression",
+            "name": "regexp",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Match regardless of case",
+            "name": "ignore_case",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Only search files matching one of the globs, e.g. **/*.go",
+            "name": "path",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximum number of matches returned",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "maximum": 5,
+            "minimum": 0,
+            "type": "integer",
+            "default": 0,
+            "description": "The number of lines returned before and after each match",
+            "name": "context",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The lines matching the query",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/searchMatch"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination or the ref could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The query is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/statuses/{ref}": {
+      "get": {
+        "tags": [
+          "statuses"
+        ],
+        "summary": "Get the statuses of a commit, most recent first",
+        "operationId": "listStatuses",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The sha1 of the commit or a rev resolving to it",
+            "name": "ref",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The commit's statuses",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/status"
+              }
+            }
+          },
+          "404": {
+            "description": "The repository or commit could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "statuses"
+        ],
+        "summary": "Report the status of a commit, e.g. from CI",
+        "operationId": "createStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The sha1 of the commit or a rev resolving to it",
+            "name": "ref",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The status to report, it replaces earlier statuses with the same context",
+            "name": "status",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/status"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The status has been created",
+            "schema": {
+              "$ref": "#/definitions/status"
+            }
+          },
+          "403": {
+            "description": "Only the repository's owner can report statuses",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The repository or commit could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The status is not valid",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/statuses/{ref}/combined": {
+      "get": {
+        "tags": [
+          "statuses"
+        ],
+        "summary": "Get the state of a commit combined from the latest status of each context",
+        "operationId": "getCombinedStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The sha1 of the commit or a rev resolving to it",
+            "name": "ref",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The commit's combined status",
+            "schema": {
+              "$ref": "#/definitions/combinedStatus"
+            }
+          },
+          "404": {
+            "description": "The repository or commit could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/subscription": {
+      "put": {
+        "tags": [
+          "watching"
+        ],
+        "summary": "Watch a repository to be notified about its activity",
+        "operationId": "watchRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The current user watches the repository"
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "watching"
+        ],
+        "summary": "Stop watching a repository",
+        "operationId": "unwatchRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The current user doesn't watch the repository"
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/transfer": {
+      "post": {
+        "description": "The old owner and name keep redirecting to the repository until another repository takes them.",
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Transfer a repository to another owner",
+        "operationId": "transferRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The new owner",
+            "name": "transfer",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "new_owner"
+              ],
+              "properties": {
+                "new_owner": {
+                  "description": "The username of the new owner",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository has been transferred",
+            "schema": {
+              "$ref": "#/definitions/repository"
+            }
+          },
+          "403": {
+            "description": "Only the owner can transfer a repository",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "The new owner already has a repository with the name",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The new owner could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/tree": {
+      "get": {
+        "tags": [
+          "repositories"
+        ],
+        "summary": "Get the tree including folders (tree) and files (blob) for a repository",
+        "operationId": "getRepositoryTree",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ref for the tree",
+            "name": "ref",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path for the tree",
+            "name": "path",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repository's tree",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/treeEntry"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/repositories/{owner}/{name}/watchers": {
+      "get": {
+        "tags": [
+          "watching"
+        ],
+        "summary": "Get the users watching a repository",
+        "operationId": "listRepositoryWatchers",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The users watching the repository, in the order they started watching it",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/user"
+              }
+            }
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/search/code": {
+      "get": {
+        "tags": [
+          "search"
+        ],
+        "summary": "Search the default branches of all repositories",
+        "operationId": "searchCode",
+        "parameters": [
+          {
+            "minLength": 3,
+            "type": "string",
+            "description": "The text to search for",
+            "name": "q",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Match regardless of case",
+            "name": "ignore_case",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximum number of matches returned",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The lines matching the query, ranked by repository and path",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/codeMatch"
+              }
+            }
+          },
+          "422": {
+            "description": "The query is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/search/repositories": {
+      "get": {
+        "tags": [
+          "search"
+        ],
+        "summary": "Search repositories by name and description",
+        "operationId": "searchRepositories",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "The words to search for",
+            "name": "q",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "name",
+              "updated"
+            ],
+            "type": "string",
+            "description": "Sort by name or most recently updated, by default the best matches come first",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned with the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "The number of items per page",
+            "name": "per_page",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The repositories visible to the user matching all words of the query",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/repository"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "422": {
+            "description": "The query or the cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/search/users": {
+      "get": {
+        "tags": [
+          "search"
+        ],
+        "summary": "Search users by username and name",
+        "operationId": "searchUsers",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "The words to search for",
+            "name": "q",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "name",
+              "updated"
+            ],
+            "type": "string",
+            "description": "Sort by name or most recently updated, by default the best matches come first",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned with the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "The number of items per page",
+            "name": "per_page",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The users matching all words of the query",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/user"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "422": {
+            "description": "The query or the cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/user/starred": {
+      "get": {
+        "tags": [
+          "stars"
+        ],
+        "summary": "Get the repositories starred by the current user, most recently starred first",
+        "operationId": "listStarredRepositories",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned with the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "The number of items per page",
+            "name": "per_page",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The starred repositories with their owners",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/repository"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/user/starred/{owner}/{name}": {
+      "get": {
+        "tags": [
+          "stars"
+        ],
+        "summary": "Check if the current user starred a repository",
+        "operationId": "checkRepositoryStarred",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The current user starred the repository"
+          },
+          "404": {
+            "description": "The repository isn't starred or could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "stars"
+        ],
+        "summary": "Star a repository",
+        "operationId": "starRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The current user starred the repository"
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "stars"
+        ],
+        "summary": "Unstar a repository",
+        "operationId": "unstarRepository",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The owner's username",
+            "name": "owner",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The repository's name",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "The current user doesn't star the repository"
+          },
+          "404": {
+            "description": "The owner and name combination could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users": {
+      "get": {
+        "tags": [
+          "users"
+        ],
+        "summary": "List all users",
+        "operationId": "listUsers",
+        "parameters": [
+          {
+            "enum": [
+              "name",
+              "updated"
+            ],
+            "type": "string",
+            "default": "name",
+            "description": "Sort users by name or by their last update, most recent first",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned with the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "The number of items per page",
+            "name": "per_page",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "An array of all users",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/user"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/me": {
+      "get": {
+        "tags": [
+          "users"
+        ],
+        "summary": "Get the current authenticated user",
+        "operationId": "getUserMe",
+        "responses": {
+          "200": {
+            "description": "The current authenticated user",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{username}": {
+      "get": {
+        "tags": [
+          "users"
+        ],
+        "summary": "Get a user by their username",
+        "operationId": "getUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The username of a user",
+            "name": "username",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The user by their username",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "404": {
+            "description": "The user is not found by their username",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "tags": [
+          "users"
+        ],
+        "summary": "Update the user's information",
+        "operationId": "updateUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The username of the user to update",
+            "name": "username",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The updated user",
+            "name": "updatedUser",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "name"
+              ],
+              "properties": {
+                "name": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The user has been updated",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "404": {
+            "description": "The user could not be found by this username",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The updated user has invalid input",
+            "schema": {
+              "$ref": "#/definitions/validationError"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{username}/events": {
+      "get": {
+        "tags": [
+          "events"
+        ],
+        "summary": "Get the events done by a user, most recent first, only public ones for other users",
+        "operationId": "listUserEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The username of a user",
+            "name": "username",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned with the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "The number of items per page",
+            "name": "per_page",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The user's events",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/event"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "404": {
+            "description": "The user could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{username}/starred": {
+      "get": {
+        "tags": [
+          "stars"
+        ],
+        "summary": "Get the repositories starred by a user, most recently starred first",
+        "operationId": "listUserStarredRepositories",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The username of a user",
+            "name": "username",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The cursor of the page to return, as returned with the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "The number of items per page",
+            "name": "per_page",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The starred repositories with their owners",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/repository"
+              }
+            },
+            "headers": {
+              "Link": {
+                "type": "string",
+                "description": "The URL of the next page with rel=\"next\", missing on the last page"
+              },
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "The cursor of the next page, missing on the last page"
+              }
+            }
+          },
+          "404": {
+            "description": "The user could not be found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "422": {
+            "description": "The cursor is not valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "default": {
+            "description": "unexpected error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
     "branch": {
       "type": "object",
       "properties": {
-        "name": {
+        "ahead": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "author_date": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "author_email": {
+          "type": "string"
+        },
+        "author_name": {
+          "type": "string"
+        },
+        "behind": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string"
+        },
+        "protected": {
+          "type": "boolean"
+        },
+        "required_checks": {
+          "description": "The contexts of statuses required to succeed before merging into the branch",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "sha1": {
+          "type": "string"
+        },
+        "status": {
+          "description": "The state combined from the statuses of the branch's head",
+          "type": "string",
+          "enum": [
+            "pending",
+            "success",
+            "failure"
+          ]
+        },
+        "subject": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "branchProtection": {
+      "type": "object",
+      "required": [
+        "required_checks"
+      ],
+      "properties": {
+        "branch": {
+          "type": "string",
+          "readOnly": true
+        },
+        "required_checks": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "codeMatch": {
+      "type": "object",
+      "required": [
+        "owner",
+        "repository",
+        "path",
+        "line",
+        "text"
+      ],
+      "properties": {
+        "line": {
+          "type": "integer"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "repository": {
+          "type": "string"
+        },
+        "text": {
+          "type": "string"
+        }
+      }
+    },
+    "combinedStatus": {
+      "type": "object",
+      "required": [
+        "sha",
+        "state",
+        "statuses"
+      ],
+      "properties": {
+        "sha": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "success",
+            "failure"
+          ]
+        },
+        "statuses": {
+          "description": "The latest status of each context",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/status"
+          }
+        }
+      }
+    },
+    "commit": {
+      "type": "object",
+      "required": [
+        "sha1"
+      ],
+      "properties": {
+        "author_date": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "author_email": {
+          "type": "string"
+        },
+        "author_name": {
+          "type": "string"
+        },
+        "body": {
+          "type": "string"
+        },
+        "committer_date": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "committer_email": {
+          "type": "string"
+        },
+        "committer_name": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "parent": {
+          "type": "string"
+        },
+        "sha1": {
+          "type": "string"
+        },
+        "tree": {
+          "type": "string"
+        }
+      }
+    },
+    "diff": {
+      "type": "object",
+      "properties": {
+        "files": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/fileDiff"
+          }
+        },
+        "truncated": {
+          "description": "The diff is too large and the last files are missing",
+          "type": "boolean"
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "id",
+        "type"
+      ],
+      "properties": {
+        "actor": {
+          "$ref": "#/definitions/user"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "data": {
+          "description": "Details depending on the event's type",
+          "type": "object",
+          "additionalProperties": true
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "public": {
+          "type": "boolean"
+        },
+        "repository": {
+          "description": "The repository as owner/name at the time of the event",
+          "type": "string"
+        },
+        "type": {
+          "description": "What happened, e.g. repository.created, push or login.failed",
+          "type": "string"
+        }
+      }
+    },
+    "fileDiff": {
+      "type": "object",
+      "required": [
+        "path",
+        "status"
+      ],
+      "properties": {
+        "additions": {
+          "type": "integer"
+        },
+        "binary": {
+          "type": "boolean"
+        },
+        "deletions": {
+          "type": "integer"
+        },
+        "old_path": {
+          "description": "The path before the file was renamed",
+          "type": "string"
+        },
+        "patch": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "added",
+            "deleted",
+            "modified",
+            "renamed"
+          ]
+        }
+      }
+    },
+    "importJob": {
+      "type": "object",
+      "required": [
+        "id",
+        "state"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "error": {
+          "description": "Why the import failed",
+          "type": "string"
+        },
+        "finished_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "progress": {
+          "description": "The last progress reported while importing",
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "queued",
+            "running",
+            "succeeded",
+            "failed"
+          ]
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "issue": {
+      "type": "object",
+      "required": [
+        "id",
+        "number",
+        "title",
+        "state"
+      ],
+      "properties": {
+        "assignees": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/user"
+          }
+        },
+        "author": {
+          "$ref": "#/definitions/user"
+        },
+        "body": {
+          "type": "string"
+        },
+        "closed_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "labels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/label"
+          }
+        },
+        "milestone": {
+          "$ref": "#/definitions/milestone"
+        },
+        "number": {
+          "type": "integer"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "open",
+            "closed"
+          ]
+        },
+        "title": {
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "issueComment": {
+      "type": "object",
+      "required": [
+        "id",
+        "body"
+      ],
+      "properties": {
+        "author": {
+          "$ref": "#/definitions/user"
+        },
+        "body": {
+          "type": "string"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "label": {
+      "type": "object",
+      "required": [
+        "name",
+        "color"
+      ],
+      "properties": {
+        "color": {
+          "description": "A hex color like #d73a4a",
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "language": {
+      "type": "object",
+      "required": [
+        "name",
+        "bytes"
+      ],
+      "properties": {
+        "bytes": {
+          "description": "Bytes of all files written in the language",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "markdown": {
+      "type": "object",
+      "required": [
+        "html"
+      ],
+      "properties": {
+        "html": {
+          "description": "The Markdown rendered to sanitized HTML",
+          "type": "string"
+        }
+      }
+    },
+    "milestone": {
+      "type": "object",
+      "required": [
+        "title"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "description": {
+          "type": "string"
+        },
+        "due_on": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "open",
+            "closed"
+          ],
+          "readOnly": true
+        },
+        "title": {
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        }
+      }
+    },
+    "mirror": {
+      "type": "object",
+      "required": [
+        "id",
+        "direction",
+        "url"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "credentials": {
+          "description": "The reference of the credentials to authenticate with",
           "type": "string"
         },
-        "sha1": {
+        "direction": {
+          "type": "string",
+          "enum": [
+            "pull",
+            "push"
+          ]
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "interval": {
+          "description": "The seconds between syncs",
+          "type": "integer"
+        },
+        "last_error": {
+          "description": "Why the last failed sync failed",
+          "type": "string",
+          "readOnly": true
+        },
+        "last_failure_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "last_success_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "next_sync_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "url": {
           "type": "string"
+        }
+      }
+    },
+    "notification": {
+      "type": "object",
+      "required": [
+        "id",
+        "reason",
+        "unread",
+        "event"
+      ],
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
         },
-        "type": {
+        "event": {
+          "$ref": "#/definitions/event"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "reason": {
+          "description": "Why the user is notified, security, mention, author or watching",
           "type": "string"
+        },
+        "unread": {
+          "type": "boolean"
         }
       }
     },
-    "error": {
+    "notificationPreference": {
       "type": "object",
       "required": [
-        "message"
+        "reason",
+        "web",
+        "email"
       ],
       "properties": {
-        "message": {
+        "email": {
+          "description": "Email the notifications, batched in digests",
+          "type": "boolean"
+        },
+        "reason": {
+          "description": "The reason of the notifications, security, mention, author or watching",
+          "type": "string"
+        },
+        "web": {
+          "description": "List the notifications in the inbox",
+          "type": "boolean"
+        }
+      }
+    },
+    "pullRequest": {
+      "type": "object",
+      "required": [
+        "id",
+        "number",
+        "title",
+        "state"
+      ],
+      "properties": {
+        "author": {
+          "$ref": "#/definitions/user"
+        },
+        "base": {
+          "description": "The commit the source branch branched off the target branch",
+          "type": "string"
+        },
+        "body": {
+          "type": "string"
+        },
+        "closed_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "conflicts": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "head": {
+          "description": "The commit of the source branch",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "mergeable": {
+          "description": "Whether the pull request can be merged without conflicts, only known for open pull requests",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "number": {
+          "type": "integer"
+        },
+        "source": {
+          "$ref": "#/definitions/pullRequestRef"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "open",
+            "closed",
+            "merged"
+          ]
+        },
+        "target": {
+          "$ref": "#/definitions/pullRequestRef"
+        },
+        "title": {
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "pullRequestComment": {
+      "type": "object",
+      "required": [
+        "id",
+        "body"
+      ],
+      "properties": {
+        "author": {
+          "$ref": "#/definitions/user"
+        },
+        "body": {
+          "type": "string"
+        },
+        "commit": {
+          "description": "The commit the path and line refer to",
+          "type": "string"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "line": {
+          "type": "integer"
+        },
+        "path": {
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "pullRequestRef": {
+      "type": "object",
+      "properties": {
+        "branch": {
+          "type": "string"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "repository": {
+          "type": "string"
+        }
+      }
+    },
+    "readme": {
+      "type": "object",
+      "required": [
+        "path",
+        "ref",
+        "html"
+      ],
+      "properties": {
+        "html": {
+          "description": "The README rendered to sanitized HTML, relative links point to the raw files",
+          "type": "string"
+        },
+        "path": {
+          "description": "The path of the README in the repository",
+          "type": "string"
+        },
+        "ref": {
+          "description": "The ref the README was found at",
+          "type": "string"
+        },
+        "sha1": {
           "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
@@ -1054,15 +10830,158 @@ func init() {
           "type": "object",
           "$ref": "#/definitions/user"
         },
+        "parent_id": {
+          "description": "The repository this one was forked from",
+          "type": "string",
+          "format": "uuid"
+        },
+        "private": {
+          "type": "boolean"
+        },
+        "stars": {
+          "description": "The number of users who starred the repository",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "stats": {
+          "type": "object",
+          "$ref": "#/definitions/repositoryStats"
+        },
+        "topics": {
+          "description": "The topics tagging the repository, lowercase letters, numbers and hyphens",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "updated_at": {
           "type": "string",
           "format": "date-time"
         },
+        "watchers": {
+          "description": "The number of users watching the repository",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
         "website": {
           "type": "string"
         }
       }
     },
+    "repositoryStats": {
+      "type": "object",
+      "properties": {
+        "commits": {
+          "description": "Commits reachable from the default branch",
+          "type": "integer",
+          "format": "int64"
+        },
+        "forks": {
+          "description": "Public forks of the repository",
+          "type": "integer",
+          "format": "int64"
+        },
+        "languages": {
+          "description": "Languages of the files on the default branch, most bytes first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/language"
+          }
+        },
+        "loose_objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "packed_objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "description": "Bytes of all objects on disk",
+          "type": "integer",
+          "format": "int64"
+        }
+      },
+      "readOnly": true
+    },
+    "searchMatch": {
+      "type": "object",
+      "required": [
+        "path",
+        "line",
+        "text"
+      ],
+      "properties": {
+        "after": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "before": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "line": {
+          "type": "integer"
+        },
+        "path": {
+          "type": "string"
+        },
+        "text": {
+          "type": "string"
+        }
+      }
+    },
+    "status": {
+      "type": "object",
+      "required": [
+        "state"
+      ],
+      "properties": {
+        "context": {
+          "description": "Identifies the system reporting the status, defaults to default",
+          "type": "string"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "creator": {
+          "$ref": "#/definitions/user"
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid",
+          "readOnly": true
+        },
+        "sha": {
+          "type": "string",
+          "readOnly": true
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "success",
+            "failure",
+            "error"
+          ]
+        },
+        "target_url": {
+          "description": "Where to find details about the status, e.g. the build's log",
+          "type": "string"
+        }
+      }
+    },
     "treeEntry": {
       "type": "object",
       "required": [
@@ -1143,6 +11062,23 @@ func init() {
         }
       }
     }
+  },
+  "parameters": {
+    "cursor": {
+      "type": "string",
+      "description": "The cursor of the page to return, as returned with the previous page",
+      "name": "cursor",
+      "in": "query"
+    },
+    "perPage": {
+      "maximum": 100,
+      "minimum": 1,
+      "type": "integer",
+      "default": 30,
+      "description": "The number of items per page",
+      "name": "per_page",
+      "in": "query"
+    }
   }
 }`))
 }
@@ -0,0 +1,141 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewListEventsParams creates a new ListEventsParams object
+// with the default values initialized.
+func NewListEventsParams() ListEventsParams {
+
+	var (
+		// initialize parameters with default values
+
+		perPageDefault = int64(30)
+	)
+
+	return ListEventsParams{
+		PerPage: &perPageDefault,
+	}
+}
+
+// ListEventsParams contains all the bound params for the list events operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listEvents
+type ListEventsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The cursor of the page to return, as returned with the previous page
+	  In: query
+	*/
+	Cursor *string
+	/*The number of items per page
+	  Maximum: 100
+	  Minimum: 1
+	  In: query
+	  Default: 30
+	*/
+	PerPage *int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListEventsParams() beforehand.
+func (o *ListEventsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPerPage, qhkPerPage, _ := qs.GetOK("per_page")
+	if err := o.bindPerPage(qPerPage, qhkPerPage, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindCursor binds and validates parameter Cursor from query.
+func (o *ListEventsParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Cursor = &raw
+
+	return nil
+}
+
+// bindPerPage binds and validates parameter PerPage from query.
+func (o *ListEventsParams) bindPerPage(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListEventsParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("per_page", "query", "int64", raw)
+	}
+	o.PerPage = &value
+
+	if err := o.validatePerPage(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validatePerPage carries on validations for parameter PerPage
+func (o *ListEventsParams) validatePerPage(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("per_page", "query", int64(*o.PerPage), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("per_page", "query", int64(*o.PerPage), 100, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListEventsOKCode is the HTTP code returned for type ListEventsOK
+const ListEventsOKCode int = 200
+
+/*ListEventsOK The events
+
+swagger:response listEventsOK
+*/
+type ListEventsOK struct {
+	/*The URL of the next page with rel="next", missing on the last page
+
+	 */
+	Link string `json:"Link"`
+	/*The cursor of the next page, missing on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Event `json:"body,omitempty"`
+}
+
+// NewListEventsOK creates ListEventsOK with default headers values
+func NewListEventsOK() *ListEventsOK {
+
+	return &ListEventsOK{}
+}
+
+// WithLink adds the link to the list events o k response
+func (o *ListEventsOK) WithLink(link string) *ListEventsOK {
+	o.Link = link
+	return o
+}
+
+// SetLink sets the link to the list events o k response
+func (o *ListEventsOK) SetLink(link string) {
+	o.Link = link
+}
+
+// WithXNextCursor adds the xNextCursor to the list events o k response
+func (o *ListEventsOK) WithXNextCursor(xNextCursor string) *ListEventsOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the list events o k response
+func (o *ListEventsOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
+// WithPayload adds the payload to the list events o k response
+func (o *ListEventsOK) WithPayload(payload []*models.Event) *ListEventsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list events o k response
+func (o *ListEventsOK) SetPayload(payload []*models.Event) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Link
+
+	link := o.Link
+	if link != "" {
+		rw.Header().Set("Link", link)
+	}
+
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Event, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListEventsForbiddenCode is the HTTP code returned for type ListEventsForbidden
+const ListEventsForbiddenCode int = 403
+
+/*ListEventsForbidden Only admins can list all events
+
+swagger:response listEventsForbidden
+*/
+type ListEventsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListEventsForbidden creates ListEventsForbidden with default headers values
+func NewListEventsForbidden() *ListEventsForbidden {
+
+	return &ListEventsForbidden{}
+}
+
+// WithPayload adds the payload to the list events forbidden response
+func (o *ListEventsForbidden) WithPayload(payload *models.Error) *ListEventsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list events forbidden response
+func (o *ListEventsForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListEventsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListEventsUnprocessableEntityCode is the HTTP code returned for type ListEventsUnprocessableEntity
+const ListEventsUnprocessableEntityCode int = 422
+
+/*ListEventsUnprocessableEntity The cursor is not valid
+
+swagger:response listEventsUnprocessableEntity
+*/
+type ListEventsUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListEventsUnprocessableEntity creates ListEventsUnprocessableEntity with default headers values
+func NewListEventsUnprocessableEntity() *ListEventsUnprocessableEntity {
+
+	return &ListEventsUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the list events unprocessable entity response
+func (o *ListEventsUnprocessableEntity) WithPayload(payload *models.Error) *ListEventsUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list events unprocessable entity response
+func (o *ListEventsUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListEventsUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListEventsDefault unexpected error
+
+swagger:response listEventsDefault
+*/
+type ListEventsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListEventsDefault creates ListEventsDefault with default headers values
+func NewListEventsDefault(code int) *ListEventsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListEventsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list events default response
+func (o *ListEventsDefault) WithStatusCode(code int) *ListEventsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list events default response
+func (o *ListEventsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list events default response
+func (o *ListEventsDefault) WithPayload(payload *models.Error) *ListEventsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list events default response
+func (o *ListEventsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListEventsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// ListRepositoryEventsHandlerFunc turns a function with the right signature into a list repository events handler
+type ListRepositoryEventsHandlerFunc func(ListRepositoryEventsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListRepositoryEventsHandlerFunc) Handle(params ListRepositoryEventsParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListRepositoryEventsHandler interface for that can handle valid list repository events params
+type ListRepositoryEventsHandler interface {
+	Handle(ListRepositoryEventsParams) middleware.Responder
+}
+
+// NewListRepositoryEvents creates a new http.Handler for the list repository events operation
+func NewListRepositoryEvents(ctx *middleware.Context, handler ListRepositoryEventsHandler) *ListRepositoryEvents {
+	return &ListRepositoryEvents{Context: ctx, Handler: handler}
+}
+
+/*ListRepositoryEvents swagger:route GET /repositories/{owner}/{name}/events events listRepositoryEvents
+
+Get the events of a repository, most recent first
+
+*/
+type ListRepositoryEvents struct {
+	Context *middleware.Context
+	Handler ListRepositoryEventsHandler
+}
+
+func (o *ListRepositoryEvents) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListRepositoryEventsParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListRepositoryEventsOKCode is the HTTP code returned for type ListRepositoryEventsOK
+const ListRepositoryEventsOKCode int = 200
+
+/*ListRepositoryEventsOK The repository's events
+
+swagger:response listRepositoryEventsOK
+*/
+type ListRepositoryEventsOK struct {
+	/*The URL of the next page with rel="next", missing on the last page
+
+	 */
+	Link string `json:"Link"`
+	/*The cursor of the next page, missing on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Event `json:"body,omitempty"`
+}
+
+// NewListRepositoryEventsOK creates ListRepositoryEventsOK with default headers values
+func NewListRepositoryEventsOK() *ListRepositoryEventsOK {
+
+	return &ListRepositoryEventsOK{}
+}
+
+// WithLink adds the link to the list repository events o k response
+func (o *ListRepositoryEventsOK) WithLink(link string) *ListRepositoryEventsOK {
+	o.Link = link
+	return o
+}
+
+// SetLink sets the link to the list repository events o k response
+func (o *ListRepositoryEventsOK) SetLink(link string) {
+	o.Link = link
+}
+
+// WithXNextCursor adds the xNextCursor to the list repository events o k response
+func (o *ListRepositoryEventsOK) WithXNextCursor(xNextCursor string) *ListRepositoryEventsOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the list repository events o k response
+func (o *ListRepositoryEventsOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
+// WithPayload adds the payload to the list repository events o k response
+func (o *ListRepositoryEventsOK) WithPayload(payload []*models.Event) *ListRepositoryEventsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list repository events o k response
+func (o *ListRepositoryEventsOK) SetPayload(payload []*models.Event) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRepositoryEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Link
+
+	link := o.Link
+	if link != "" {
+		rw.Header().Set("Link", link)
+	}
+
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Event, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListRepositoryEventsNotFoundCode is the HTTP code returned for type ListRepositoryEventsNotFound
+const ListRepositoryEventsNotFoundCode int = 404
+
+/*ListRepositoryEventsNotFound The repository could not be found
+
+swagger:response listRepositoryEventsNotFound
+*/
+type ListRepositoryEventsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListRepositoryEventsNotFound creates ListRepositoryEventsNotFound with default headers values
+func NewListRepositoryEventsNotFound() *ListRepositoryEventsNotFound {
+
+	return &ListRepositoryEventsNotFound{}
+}
+
+// WithPayload adds the payload to the list repository events not found response
+func (o *ListRepositoryEventsNotFound) WithPayload(payload *models.Error) *ListRepositoryEventsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list repository events not found response
+func (o *ListRepositoryEventsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRepositoryEventsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListRepositoryEventsUnprocessableEntityCode is the HTTP code returned for type ListRepositoryEventsUnprocessableEntity
+const ListRepositoryEventsUnprocessableEntityCode int = 422
+
+/*ListRepositoryEventsUnprocessableEntity The cursor is not valid
+
+swagger:response listRepositoryEventsUnprocessableEntity
+*/
+type ListRepositoryEventsUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListRepositoryEventsUnprocessableEntity creates ListRepositoryEventsUnprocessableEntity with default headers values
+func NewListRepositoryEventsUnprocessableEntity() *ListRepositoryEventsUnprocessableEntity {
+
+	return &ListRepositoryEventsUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the list repository events unprocessable entity response
+func (o *ListRepositoryEventsUnprocessableEntity) WithPayload(payload *models.Error) *ListRepositoryEventsUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list repository events unprocessable entity response
+func (o *ListRepositoryEventsUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRepositoryEventsUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListRepositoryEventsDefault unexpected error
+
+swagger:response listRepositoryEventsDefault
+*/
+type ListRepositoryEventsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListRepositoryEventsDefault creates ListRepositoryEventsDefault with default headers values
+func NewListRepositoryEventsDefault(code int) *ListRepositoryEventsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListRepositoryEventsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list repository events default response
+func (o *ListRepositoryEventsDefault) WithStatusCode(code int) *ListRepositoryEventsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list repository events default response
+func (o *ListRepositoryEventsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list repository events default response
+func (o *ListRepositoryEventsDefault) WithPayload(payload *models.Error) *ListRepositoryEventsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list repository events default response
+func (o *ListRepositoryEventsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRepositoryEventsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+
+	"github.com/go-openapi/swag"
+)
+
+// ListRepositoryEventsURL generates an URL for the list repository events operation
+type ListRepositoryEventsURL struct {
+	Name  string
+	Owner string
+
+	Cursor  *string
+	PerPage *int64
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListRepositoryEventsURL) WithBasePath(bp string) *ListRepositoryEventsURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListRepositoryEventsURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *ListRepositoryEventsURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/repositories/{owner}/{name}/events"
+
+	name := o.Name
+	if name != "" {
+		_path = strings.Replace(_path, "{name}", name, -1)
+	} else {
+		return nil, errors.New("Name is required on ListRepositoryEventsURL")
+	}
+
+	owner := o.Owner
+	if owner != "" {
+		_path = strings.Replace(_path, "{owner}", owner, -1)
+	} else {
+		return nil, errors.New("Owner is required on ListRepositoryEventsURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var cursor string
+	if o.Cursor != nil {
+		cursor = *o.Cursor
+	}
+	if cursor != "" {
+		qs.Set("cursor", cursor)
+	}
+
+	var perPage string
+	if o.PerPage != nil {
+		perPage = swag.FormatInt64(*o.PerPage)
+	}
+	if perPage != "" {
+		qs.Set("per_page", perPage)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *ListRepositoryEventsURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *ListRepositoryEventsURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *ListRepositoryEventsURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on ListRepositoryEventsURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on ListRepositoryEventsURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *ListRepositoryEventsURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
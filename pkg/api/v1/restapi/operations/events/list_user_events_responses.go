@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListUserEventsOKCode is the HTTP code returned for type ListUserEventsOK
+const ListUserEventsOKCode int = 200
+
+/*ListUserEventsOK The user's events
+
+swagger:response listUserEventsOK
+*/
+type ListUserEventsOK struct {
+	/*The URL of the next page with rel="next", missing on the last page
+
+	 */
+	Link string `json:"Link"`
+	/*The cursor of the next page, missing on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Event `json:"body,omitempty"`
+}
+
+// NewListUserEventsOK creates ListUserEventsOK with default headers values
+func NewListUserEventsOK() *ListUserEventsOK {
+
+	return &ListUserEventsOK{}
+}
+
+// WithLink adds the link to the list user events o k response
+func (o *ListUserEventsOK) WithLink(link string) *ListUserEventsOK {
+	o.Link = link
+	return o
+}
+
+// SetLink sets the link to the list user events o k response
+func (o *ListUserEventsOK) SetLink(link string) {
+	o.Link = link
+}
+
+// WithXNextCursor adds the xNextCursor to the list user events o k response
+func (o *ListUserEventsOK) WithXNextCursor(xNextCursor string) *ListUserEventsOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the list user events o k response
+func (o *ListUserEventsOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
+// WithPayload adds the payload to the list user events o k response
+func (o *ListUserEventsOK) WithPayload(payload []*models.Event) *ListUserEventsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list user events o k response
+func (o *ListUserEventsOK) SetPayload(payload []*models.Event) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUserEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Link
+
+	link := o.Link
+	if link != "" {
+		rw.Header().Set("Link", link)
+	}
+
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Event, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListUserEventsNotFoundCode is the HTTP code returned for type ListUserEventsNotFound
+const ListUserEventsNotFoundCode int = 404
+
+/*ListUserEventsNotFound The user could not be found
+
+swagger:response listUserEventsNotFound
+*/
+type ListUserEventsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListUserEventsNotFound creates ListUserEventsNotFound with default headers values
+func NewListUserEventsNotFound() *ListUserEventsNotFound {
+
+	return &ListUserEventsNotFound{}
+}
+
+// WithPayload adds the payload to the list user events not found response
+func (o *ListUserEventsNotFound) WithPayload(payload *models.Error) *ListUserEventsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list user events not found response
+func (o *ListUserEventsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUserEventsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListUserEventsUnprocessableEntityCode is the HTTP code returned for type ListUserEventsUnprocessableEntity
+const ListUserEventsUnprocessableEntityCode int = 422
+
+/*ListUserEventsUnprocessableEntity The cursor is not valid
+
+swagger:response listUserEventsUnprocessableEntity
+*/
+type ListUserEventsUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListUserEventsUnprocessableEntity creates ListUserEventsUnprocessableEntity with default headers values
+func NewListUserEventsUnprocessableEntity() *ListUserEventsUnprocessableEntity {
+
+	return &ListUserEventsUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the list user events unprocessable entity response
+func (o *ListUserEventsUnprocessableEntity) WithPayload(payload *models.Error) *ListUserEventsUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list user events unprocessable entity response
+func (o *ListUserEventsUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUserEventsUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListUserEventsDefault unexpected error
+
+swagger:response listUserEventsDefault
+*/
+type ListUserEventsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListUserEventsDefault creates ListUserEventsDefault with default headers values
+func NewListUserEventsDefault(code int) *ListUserEventsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListUserEventsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list user events default response
+func (o *ListUserEventsDefault) WithStatusCode(code int) *ListUserEventsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list user events default response
+func (o *ListUserEventsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list user events default response
+func (o *ListUserEventsDefault) WithPayload(payload *models.Error) *ListUserEventsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list user events default response
+func (o *ListUserEventsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUserEventsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
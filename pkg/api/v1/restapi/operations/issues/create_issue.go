@@ -0,0 +1,124 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+	validate "github.com/go-openapi/validate"
+)
+
+// CreateIssueHandlerFunc turns a function with the right signature into a create issue handler
+type CreateIssueHandlerFunc func(CreateIssueParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateIssueHandlerFunc) Handle(params CreateIssueParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateIssueHandler interface for that can handle valid create issue params
+type CreateIssueHandler interface {
+	Handle(CreateIssueParams) middleware.Responder
+}
+
+// NewCreateIssue creates a new http.Handler for the create issue operation
+func NewCreateIssue(ctx *middleware.Context, handler CreateIssueHandler) *CreateIssue {
+	return &CreateIssue{Context: ctx, Handler: handler}
+}
+
+/*CreateIssue swagger:route POST /repositories/{owner}/{name}/issues issues createIssue
+
+Open an issue
+
+*/
+type CreateIssue struct {
+	Context *middleware.Context
+	Handler CreateIssueHandler
+}
+
+func (o *CreateIssue) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCreateIssueParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// CreateIssueBody create issue body
+// swagger:model CreateIssueBody
+type CreateIssueBody struct {
+
+	// The usernames of the issue's assignees, only the repository's owner can set them
+	Assignees []string `json:"assignees"`
+
+	// body
+	Body string `json:"body,omitempty"`
+
+	// The names of the issue's labels, only the repository's owner can set them
+	Labels []string `json:"labels"`
+
+	// The title of the issue's milestone, only the repository's owner can set it
+	Milestone string `json:"milestone,omitempty"`
+
+	// title
+	// Required: true
+	Title *string `json:"title"`
+}
+
+// Validate validates this create issue body
+func (o *CreateIssueBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateTitle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateIssueBody) validateTitle(formats strfmt.Registry) error {
+
+	if err := validate.Required("issue"+"."+"title", "body", o.Title); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateIssueBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateIssueBody) UnmarshalBinary(b []byte) error {
+	var res CreateIssueBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
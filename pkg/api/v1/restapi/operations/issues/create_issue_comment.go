@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+	validate "github.com/go-openapi/validate"
+)
+
+// CreateIssueCommentHandlerFunc turns a function with the right signature into a create issue comment handler
+type CreateIssueCommentHandlerFunc func(CreateIssueCommentParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateIssueCommentHandlerFunc) Handle(params CreateIssueCommentParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateIssueCommentHandler interface for that can handle valid create issue comment params
+type CreateIssueCommentHandler interface {
+	Handle(CreateIssueCommentParams) middleware.Responder
+}
+
+// NewCreateIssueComment creates a new http.Handler for the create issue comment operation
+func NewCreateIssueComment(ctx *middleware.Context, handler CreateIssueCommentHandler) *CreateIssueComment {
+	return &CreateIssueComment{Context: ctx, Handler: handler}
+}
+
+/*CreateIssueComment swagger:route POST /repositories/{owner}/{name}/issues/{number}/comments issues createIssueComment
+
+Comment on an issue
+
+*/
+type CreateIssueComment struct {
+	Context *middleware.Context
+	Handler CreateIssueCommentHandler
+}
+
+func (o *CreateIssueComment) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCreateIssueCommentParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// CreateIssueCommentBody create issue comment body
+// swagger:model CreateIssueCommentBody
+type CreateIssueCommentBody struct {
+
+	// body
+	// Required: true
+	Body *string `json:"body"`
+}
+
+// Validate validates this create issue comment body
+func (o *CreateIssueCommentBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateBody(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateIssueCommentBody) validateBody(formats strfmt.Registry) error {
+
+	if err := validate.Required("comment"+"."+"body", "body", o.Body); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateIssueCommentBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateIssueCommentBody) UnmarshalBinary(b []byte) error {
+	var res CreateIssueCommentBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
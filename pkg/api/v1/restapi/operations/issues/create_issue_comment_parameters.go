@@ -0,0 +1,157 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewCreateIssueCommentParams creates a new CreateIssueCommentParams object
+// no default values defined in spec.
+func NewCreateIssueCommentParams() CreateIssueCommentParams {
+
+	return CreateIssueCommentParams{}
+}
+
+// CreateIssueCommentParams contains all the bound params for the create issue comment operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters createIssueComment
+type CreateIssueCommentParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The comment to create
+	  Required: true
+	  In: body
+	*/
+	Comment CreateIssueCommentBody
+	/*The repository's name
+	  Required: true
+	  In: path
+	*/
+	Name string
+	/*The issue's number
+	  Required: true
+	  In: path
+	*/
+	Number int64
+	/*The owner's username
+	  Required: true
+	  In: path
+	*/
+	Owner string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewCreateIssueCommentParams() beforehand.
+func (o *CreateIssueCommentParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body CreateIssueCommentBody
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("comment", "body"))
+			} else {
+				res = append(res, errors.NewParseError("comment", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Comment = body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("comment", "body"))
+	}
+	rName, rhkName, _ := route.Params.GetOK("name")
+	if err := o.bindName(rName, rhkName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rNumber, rhkNumber, _ := route.Params.GetOK("number")
+	if err := o.bindNumber(rNumber, rhkNumber, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rOwner, rhkOwner, _ := route.Params.GetOK("owner")
+	if err := o.bindOwner(rOwner, rhkOwner, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindName binds and validates parameter Name from path.
+func (o *CreateIssueCommentParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Name = raw
+
+	return nil
+}
+
+// bindNumber binds and validates parameter Number from path.
+func (o *CreateIssueCommentParams) bindNumber(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("number", "path", "int64", raw)
+	}
+	o.Number = value
+
+	return nil
+}
+
+// bindOwner binds and validates parameter Owner from path.
+func (o *CreateIssueCommentParams) bindOwner(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Owner = raw
+
+	return nil
+}
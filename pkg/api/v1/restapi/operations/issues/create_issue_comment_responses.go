@@ -0,0 +1,204 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// CreateIssueCommentOKCode is the HTTP code returned for type CreateIssueCommentOK
+const CreateIssueCommentOKCode int = 200
+
+/*CreateIssueCommentOK The comment has been created
+
+swagger:response createIssueCommentOK
+*/
+type CreateIssueCommentOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.IssueComment `json:"body,omitempty"`
+}
+
+// NewCreateIssueCommentOK creates CreateIssueCommentOK with default headers values
+func NewCreateIssueCommentOK() *CreateIssueCommentOK {
+
+	return &CreateIssueCommentOK{}
+}
+
+// WithPayload adds the payload to the create issue comment o k response
+func (o *CreateIssueCommentOK) WithPayload(payload *models.IssueComment) *CreateIssueCommentOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create issue comment o k response
+func (o *CreateIssueCommentOK) SetPayload(payload *models.IssueComment) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateIssueCommentOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateIssueCommentNotFoundCode is the HTTP code returned for type CreateIssueCommentNotFound
+const CreateIssueCommentNotFoundCode int = 404
+
+/*CreateIssueCommentNotFound The issue could not be found
+
+swagger:response createIssueCommentNotFound
+*/
+type CreateIssueCommentNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateIssueCommentNotFound creates CreateIssueCommentNotFound with default headers values
+func NewCreateIssueCommentNotFound() *CreateIssueCommentNotFound {
+
+	return &CreateIssueCommentNotFound{}
+}
+
+// WithPayload adds the payload to the create issue comment not found response
+func (o *CreateIssueCommentNotFound) WithPayload(payload *models.Error) *CreateIssueCommentNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create issue comment not found response
+func (o *CreateIssueCommentNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateIssueCommentNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateIssueCommentUnprocessableEntityCode is the HTTP code returned for type CreateIssueCommentUnprocessableEntity
+const CreateIssueCommentUnprocessableEntityCode int = 422
+
+/*CreateIssueCommentUnprocessableEntity The comment has not been created due to invalid input
+
+swagger:response createIssueCommentUnprocessableEntity
+*/
+type CreateIssueCommentUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewCreateIssueCommentUnprocessableEntity creates CreateIssueCommentUnprocessableEntity with default headers values
+func NewCreateIssueCommentUnprocessableEntity() *CreateIssueCommentUnprocessableEntity {
+
+	return &CreateIssueCommentUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the create issue comment unprocessable entity response
+func (o *CreateIssueCommentUnprocessableEntity) WithPayload(payload *models.ValidationError) *CreateIssueCommentUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create issue comment unprocessable entity response
+func (o *CreateIssueCommentUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateIssueCommentUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateIssueCommentDefault unexpected error
+
+swagger:response createIssueCommentDefault
+*/
+type CreateIssueCommentDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateIssueCommentDefault creates CreateIssueCommentDefault with default headers values
+func NewCreateIssueCommentDefault(code int) *CreateIssueCommentDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateIssueCommentDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create issue comment default response
+func (o *CreateIssueCommentDefault) WithStatusCode(code int) *CreateIssueCommentDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create issue comment default response
+func (o *CreateIssueCommentDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create issue comment default response
+func (o *CreateIssueCommentDefault) WithPayload(payload *models.Error) *CreateIssueCommentDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create issue comment default response
+func (o *CreateIssueCommentDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateIssueCommentDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
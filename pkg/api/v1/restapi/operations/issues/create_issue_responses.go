@@ -0,0 +1,248 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// CreateIssueOKCode is the HTTP code returned for type CreateIssueOK
+const CreateIssueOKCode int = 200
+
+/*CreateIssueOK The issue has been opened
+
+swagger:response createIssueOK
+*/
+type CreateIssueOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Issue `json:"body,omitempty"`
+}
+
+// NewCreateIssueOK creates CreateIssueOK with default headers values
+func NewCreateIssueOK() *CreateIssueOK {
+
+	return &CreateIssueOK{}
+}
+
+// WithPayload adds the payload to the create issue o k response
+func (o *CreateIssueOK) WithPayload(payload *models.Issue) *CreateIssueOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create issue o k response
+func (o *CreateIssueOK) SetPayload(payload *models.Issue) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateIssueOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateIssueForbiddenCode is the HTTP code returned for type CreateIssueForbidden
+const CreateIssueForbiddenCode int = 403
+
+/*CreateIssueForbidden Only the repository's owner can set labels, assignees and milestone
+
+swagger:response createIssueForbidden
+*/
+type CreateIssueForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateIssueForbidden creates CreateIssueForbidden with default headers values
+func NewCreateIssueForbidden() *CreateIssueForbidden {
+
+	return &CreateIssueForbidden{}
+}
+
+// WithPayload adds the payload to the create issue forbidden response
+func (o *CreateIssueForbidden) WithPayload(payload *models.Error) *CreateIssueForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create issue forbidden response
+func (o *CreateIssueForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateIssueForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateIssueNotFoundCode is the HTTP code returned for type CreateIssueNotFound
+const CreateIssueNotFoundCode int = 404
+
+/*CreateIssueNotFound The owner and name combination could not be found
+
+swagger:response createIssueNotFound
+*/
+type CreateIssueNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateIssueNotFound creates CreateIssueNotFound with default headers values
+func NewCreateIssueNotFound() *CreateIssueNotFound {
+
+	return &CreateIssueNotFound{}
+}
+
+// WithPayload adds the payload to the create issue not found response
+func (o *CreateIssueNotFound) WithPayload(payload *models.Error) *CreateIssueNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create issue not found response
+func (o *CreateIssueNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateIssueNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateIssueUnprocessableEntityCode is the HTTP code returned for type CreateIssueUnprocessableEntity
+const CreateIssueUnprocessableEntityCode int = 422
+
+/*CreateIssueUnprocessableEntity The issue has not been opened due to invalid input
+
+swagger:response createIssueUnprocessableEntity
+*/
+type CreateIssueUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewCreateIssueUnprocessableEntity creates CreateIssueUnprocessableEntity with default headers values
+func NewCreateIssueUnprocessableEntity() *CreateIssueUnprocessableEntity {
+
+	return &CreateIssueUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the create issue unprocessable entity response
+func (o *CreateIssueUnprocessableEntity) WithPayload(payload *models.ValidationError) *CreateIssueUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create issue unprocessable entity response
+func (o *CreateIssueUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateIssueUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateIssueDefault unexpected error
+
+swagger:response createIssueDefault
+*/
+type CreateIssueDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateIssueDefault creates CreateIssueDefault with default headers values
+func NewCreateIssueDefault(code int) *CreateIssueDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateIssueDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create issue default response
+func (o *CreateIssueDefault) WithStatusCode(code int) *CreateIssueDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create issue default response
+func (o *CreateIssueDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create issue default response
+func (o *CreateIssueDefault) WithPayload(payload *models.Error) *CreateIssueDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create issue default response
+func (o *CreateIssueDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateIssueDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// CreateLabelHandlerFunc turns a function with the right signature into a create label handler
+type CreateLabelHandlerFunc func(CreateLabelParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateLabelHandlerFunc) Handle(params CreateLabelParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateLabelHandler interface for that can handle valid create label params
+type CreateLabelHandler interface {
+	Handle(CreateLabelParams) middleware.Responder
+}
+
+// NewCreateLabel creates a new http.Handler for the create label operation
+func NewCreateLabel(ctx *middleware.Context, handler CreateLabelHandler) *CreateLabel {
+	return &CreateLabel{Context: ctx, Handler: handler}
+}
+
+/*CreateLabel swagger:route POST /repositories/{owner}/{name}/labels issues createLabel
+
+Create a label in a repository
+
+*/
+type CreateLabel struct {
+	Context *middleware.Context
+	Handler CreateLabelHandler
+}
+
+func (o *CreateLabel) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCreateLabelParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
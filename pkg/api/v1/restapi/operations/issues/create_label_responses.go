@@ -0,0 +1,292 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// CreateLabelOKCode is the HTTP code returned for type CreateLabelOK
+const CreateLabelOKCode int = 200
+
+/*CreateLabelOK The label has been created
+
+swagger:response createLabelOK
+*/
+type CreateLabelOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Label `json:"body,omitempty"`
+}
+
+// NewCreateLabelOK creates CreateLabelOK with default headers values
+func NewCreateLabelOK() *CreateLabelOK {
+
+	return &CreateLabelOK{}
+}
+
+// WithPayload adds the payload to the create label o k response
+func (o *CreateLabelOK) WithPayload(payload *models.Label) *CreateLabelOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create label o k response
+func (o *CreateLabelOK) SetPayload(payload *models.Label) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateLabelOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateLabelForbiddenCode is the HTTP code returned for type CreateLabelForbidden
+const CreateLabelForbiddenCode int = 403
+
+/*CreateLabelForbidden Only the repository's owner can create labels
+
+swagger:response createLabelForbidden
+*/
+type CreateLabelForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateLabelForbidden creates CreateLabelForbidden with default headers values
+func NewCreateLabelForbidden() *CreateLabelForbidden {
+
+	return &CreateLabelForbidden{}
+}
+
+// WithPayload adds the payload to the create label forbidden response
+func (o *CreateLabelForbidden) WithPayload(payload *models.Error) *CreateLabelForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create label forbidden response
+func (o *CreateLabelForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateLabelForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateLabelNotFoundCode is the HTTP code returned for type CreateLabelNotFound
+const CreateLabelNotFoundCode int = 404
+
+/*CreateLabelNotFound The owner and name combination could not be found
+
+swagger:response createLabelNotFound
+*/
+type CreateLabelNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateLabelNotFound creates CreateLabelNotFound with default headers values
+func NewCreateLabelNotFound() *CreateLabelNotFound {
+
+	return &CreateLabelNotFound{}
+}
+
+// WithPayload adds the payload to the create label not found response
+func (o *CreateLabelNotFound) WithPayload(payload *models.Error) *CreateLabelNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create label not found response
+func (o *CreateLabelNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateLabelNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateLabelConflictCode is the HTTP code returned for type CreateLabelConflict
+const CreateLabelConflictCode int = 409
+
+/*CreateLabelConflict A label with the name already exists
+
+swagger:response createLabelConflict
+*/
+type CreateLabelConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateLabelConflict creates CreateLabelConflict with default headers values
+func NewCreateLabelConflict() *CreateLabelConflict {
+
+	return &CreateLabelConflict{}
+}
+
+// WithPayload adds the payload to the create label conflict response
+func (o *CreateLabelConflict) WithPayload(payload *models.Error) *CreateLabelConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create label conflict response
+func (o *CreateLabelConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateLabelConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateLabelUnprocessableEntityCode is the HTTP code returned for type CreateLabelUnprocessableEntity
+const CreateLabelUnprocessableEntityCode int = 422
+
+/*CreateLabelUnprocessableEntity The label has not been created due to invalid input
+
+swagger:response createLabelUnprocessableEntity
+*/
+type CreateLabelUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewCreateLabelUnprocessableEntity creates CreateLabelUnprocessableEntity with default headers values
+func NewCreateLabelUnprocessableEntity() *CreateLabelUnprocessableEntity {
+
+	return &CreateLabelUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the create label unprocessable entity response
+func (o *CreateLabelUnprocessableEntity) WithPayload(payload *models.ValidationError) *CreateLabelUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create label unprocessable entity response
+func (o *CreateLabelUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateLabelUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateLabelDefault unexpected error
+
+swagger:response createLabelDefault
+*/
+type CreateLabelDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateLabelDefault creates CreateLabelDefault with default headers values
+func NewCreateLabelDefault(code int) *CreateLabelDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateLabelDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create label default response
+func (o *CreateLabelDefault) WithStatusCode(code int) *CreateLabelDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create label default response
+func (o *CreateLabelDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create label default response
+func (o *CreateLabelDefault) WithPayload(payload *models.Error) *CreateLabelDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create label default response
+func (o *CreateLabelDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateLabelDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// CreateMilestoneHandlerFunc turns a function with the right signature into a create milestone handler
+type CreateMilestoneHandlerFunc func(CreateMilestoneParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateMilestoneHandlerFunc) Handle(params CreateMilestoneParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateMilestoneHandler interface for that can handle valid create milestone params
+type CreateMilestoneHandler interface {
+	Handle(CreateMilestoneParams) middleware.Responder
+}
+
+// NewCreateMilestone creates a new http.Handler for the create milestone operation
+func NewCreateMilestone(ctx *middleware.Context, handler CreateMilestoneHandler) *CreateMilestone {
+	return &CreateMilestone{Context: ctx, Handler: handler}
+}
+
+/*CreateMilestone swagger:route POST /repositories/{owner}/{name}/milestones issues createMilestone
+
+Create a milestone in a repository
+
+*/
+type CreateMilestone struct {
+	Context *middleware.Context
+	Handler CreateMilestoneHandler
+}
+
+func (o *CreateMilestone) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCreateMilestoneParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
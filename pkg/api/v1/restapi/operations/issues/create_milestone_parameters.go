@@ -0,0 +1,129 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// NewCreateMilestoneParams creates a new CreateMilestoneParams object
+// no default values defined in spec.
+func NewCreateMilestoneParams() CreateMilestoneParams {
+
+	return CreateMilestoneParams{}
+}
+
+// CreateMilestoneParams contains all the bound params for the create milestone operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters createMilestone
+type CreateMilestoneParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The milestone to create
+	  Required: true
+	  In: body
+	*/
+	Milestone *models.Milestone
+	/*The repository's name
+	  Required: true
+	  In: path
+	*/
+	Name string
+	/*The owner's username
+	  Required: true
+	  In: path
+	*/
+	Owner string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewCreateMilestoneParams() beforehand.
+func (o *CreateMilestoneParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.Milestone
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("milestone", "body"))
+			} else {
+				res = append(res, errors.NewParseError("milestone", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Milestone = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("milestone", "body"))
+	}
+	rName, rhkName, _ := route.Params.GetOK("name")
+	if err := o.bindName(rName, rhkName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rOwner, rhkOwner, _ := route.Params.GetOK("owner")
+	if err := o.bindOwner(rOwner, rhkOwner, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindName binds and validates parameter Name from path.
+func (o *CreateMilestoneParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Name = raw
+
+	return nil
+}
+
+// bindOwner binds and validates parameter Owner from path.
+func (o *CreateMilestoneParams) bindOwner(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Owner = raw
+
+	return nil
+}
@@ -0,0 +1,292 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// CreateMilestoneOKCode is the HTTP code returned for type CreateMilestoneOK
+const CreateMilestoneOKCode int = 200
+
+/*CreateMilestoneOK The milestone has been created
+
+swagger:response createMilestoneOK
+*/
+type CreateMilestoneOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Milestone `json:"body,omitempty"`
+}
+
+// NewCreateMilestoneOK creates CreateMilestoneOK with default headers values
+func NewCreateMilestoneOK() *CreateMilestoneOK {
+
+	return &CreateMilestoneOK{}
+}
+
+// WithPayload adds the payload to the create milestone o k response
+func (o *CreateMilestoneOK) WithPayload(payload *models.Milestone) *CreateMilestoneOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create milestone o k response
+func (o *CreateMilestoneOK) SetPayload(payload *models.Milestone) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateMilestoneOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateMilestoneForbiddenCode is the HTTP code returned for type CreateMilestoneForbidden
+const CreateMilestoneForbiddenCode int = 403
+
+/*CreateMilestoneForbidden Only the repository's owner can create milestones
+
+swagger:response createMilestoneForbidden
+*/
+type CreateMilestoneForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateMilestoneForbidden creates CreateMilestoneForbidden with default headers values
+func NewCreateMilestoneForbidden() *CreateMilestoneForbidden {
+
+	return &CreateMilestoneForbidden{}
+}
+
+// WithPayload adds the payload to the create milestone forbidden response
+func (o *CreateMilestoneForbidden) WithPayload(payload *models.Error) *CreateMilestoneForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create milestone forbidden response
+func (o *CreateMilestoneForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateMilestoneForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateMilestoneNotFoundCode is the HTTP code returned for type CreateMilestoneNotFound
+const CreateMilestoneNotFoundCode int = 404
+
+/*CreateMilestoneNotFound The owner and name combination could not be found
+
+swagger:response createMilestoneNotFound
+*/
+type CreateMilestoneNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateMilestoneNotFound creates CreateMilestoneNotFound with default headers values
+func NewCreateMilestoneNotFound() *CreateMilestoneNotFound {
+
+	return &CreateMilestoneNotFound{}
+}
+
+// WithPayload adds the payload to the create milestone not found response
+func (o *CreateMilestoneNotFound) WithPayload(payload *models.Error) *CreateMilestoneNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create milestone not found response
+func (o *CreateMilestoneNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateMilestoneNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateMilestoneConflictCode is the HTTP code returned for type CreateMilestoneConflict
+const CreateMilestoneConflictCode int = 409
+
+/*CreateMilestoneConflict A milestone with the title already exists
+
+swagger:response createMilestoneConflict
+*/
+type CreateMilestoneConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateMilestoneConflict creates CreateMilestoneConflict with default headers values
+func NewCreateMilestoneConflict() *CreateMilestoneConflict {
+
+	return &CreateMilestoneConflict{}
+}
+
+// WithPayload adds the payload to the create milestone conflict response
+func (o *CreateMilestoneConflict) WithPayload(payload *models.Error) *CreateMilestoneConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create milestone conflict response
+func (o *CreateMilestoneConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateMilestoneConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateMilestoneUnprocessableEntityCode is the HTTP code returned for type CreateMilestoneUnprocessableEntity
+const CreateMilestoneUnprocessableEntityCode int = 422
+
+/*CreateMilestoneUnprocessableEntity The milestone has not been created due to invalid input
+
+swagger:response createMilestoneUnprocessableEntity
+*/
+type CreateMilestoneUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewCreateMilestoneUnprocessableEntity creates CreateMilestoneUnprocessableEntity with default headers values
+func NewCreateMilestoneUnprocessableEntity() *CreateMilestoneUnprocessableEntity {
+
+	return &CreateMilestoneUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the create milestone unprocessable entity response
+func (o *CreateMilestoneUnprocessableEntity) WithPayload(payload *models.ValidationError) *CreateMilestoneUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create milestone unprocessable entity response
+func (o *CreateMilestoneUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateMilestoneUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateMilestoneDefault unexpected error
+
+swagger:response createMilestoneDefault
+*/
+type CreateMilestoneDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateMilestoneDefault creates CreateMilestoneDefault with default headers values
+func NewCreateMilestoneDefault(code int) *CreateMilestoneDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateMilestoneDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create milestone default response
+func (o *CreateMilestoneDefault) WithStatusCode(code int) *CreateMilestoneDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create milestone default response
+func (o *CreateMilestoneDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create milestone default response
+func (o *CreateMilestoneDefault) WithPayload(payload *models.Error) *CreateMilestoneDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create milestone default response
+func (o *CreateMilestoneDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateMilestoneDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,184 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// DeleteLabelNoContentCode is the HTTP code returned for type DeleteLabelNoContent
+const DeleteLabelNoContentCode int = 204
+
+/*DeleteLabelNoContent The label has been deleted
+
+swagger:response deleteLabelNoContent
+*/
+type DeleteLabelNoContent struct {
+}
+
+// NewDeleteLabelNoContent creates DeleteLabelNoContent with default headers values
+func NewDeleteLabelNoContent() *DeleteLabelNoContent {
+
+	return &DeleteLabelNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteLabelNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// DeleteLabelForbiddenCode is the HTTP code returned for type DeleteLabelForbidden
+const DeleteLabelForbiddenCode int = 403
+
+/*DeleteLabelForbidden Only the repository's owner can delete labels
+
+swagger:response deleteLabelForbidden
+*/
+type DeleteLabelForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteLabelForbidden creates DeleteLabelForbidden with default headers values
+func NewDeleteLabelForbidden() *DeleteLabelForbidden {
+
+	return &DeleteLabelForbidden{}
+}
+
+// WithPayload adds the payload to the delete label forbidden response
+func (o *DeleteLabelForbidden) WithPayload(payload *models.Error) *DeleteLabelForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete label forbidden response
+func (o *DeleteLabelForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteLabelForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteLabelNotFoundCode is the HTTP code returned for type DeleteLabelNotFound
+const DeleteLabelNotFoundCode int = 404
+
+/*DeleteLabelNotFound The label could not be found
+
+swagger:response deleteLabelNotFound
+*/
+type DeleteLabelNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteLabelNotFound creates DeleteLabelNotFound with default headers values
+func NewDeleteLabelNotFound() *DeleteLabelNotFound {
+
+	return &DeleteLabelNotFound{}
+}
+
+// WithPayload adds the payload to the delete label not found response
+func (o *DeleteLabelNotFound) WithPayload(payload *models.Error) *DeleteLabelNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete label not found response
+func (o *DeleteLabelNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteLabelNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteLabelDefault unexpected error
+
+swagger:response deleteLabelDefault
+*/
+type DeleteLabelDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteLabelDefault creates DeleteLabelDefault with default headers values
+func NewDeleteLabelDefault(code int) *DeleteLabelDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteLabelDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete label default response
+func (o *DeleteLabelDefault) WithStatusCode(code int) *DeleteLabelDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete label default response
+func (o *DeleteLabelDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete label default response
+func (o *DeleteLabelDefault) WithPayload(payload *models.Error) *DeleteLabelDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete label default response
+func (o *DeleteLabelDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteLabelDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
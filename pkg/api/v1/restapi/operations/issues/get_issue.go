@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// GetIssueHandlerFunc turns a function with the right signature into a get issue handler
+type GetIssueHandlerFunc func(GetIssueParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetIssueHandlerFunc) Handle(params GetIssueParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetIssueHandler interface for that can handle valid get issue params
+type GetIssueHandler interface {
+	Handle(GetIssueParams) middleware.Responder
+}
+
+// NewGetIssue creates a new http.Handler for the get issue operation
+func NewGetIssue(ctx *middleware.Context, handler GetIssueHandler) *GetIssue {
+	return &GetIssue{Context: ctx, Handler: handler}
+}
+
+/*GetIssue swagger:route GET /repositories/{owner}/{name}/issues/{number} issues getIssue
+
+Get an issue by its number
+
+*/
+type GetIssue struct {
+	Context *middleware.Context
+	Handler GetIssueHandler
+}
+
+func (o *GetIssue) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetIssueParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
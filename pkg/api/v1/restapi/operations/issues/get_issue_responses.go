@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// GetIssueOKCode is the HTTP code returned for type GetIssueOK
+const GetIssueOKCode int = 200
+
+/*GetIssueOK The issue
+
+swagger:response getIssueOK
+*/
+type GetIssueOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Issue `json:"body,omitempty"`
+}
+
+// NewGetIssueOK creates GetIssueOK with default headers values
+func NewGetIssueOK() *GetIssueOK {
+
+	return &GetIssueOK{}
+}
+
+// WithPayload adds the payload to the get issue o k response
+func (o *GetIssueOK) WithPayload(payload *models.Issue) *GetIssueOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get issue o k response
+func (o *GetIssueOK) SetPayload(payload *models.Issue) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetIssueOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetIssueNotFoundCode is the HTTP code returned for type GetIssueNotFound
+const GetIssueNotFoundCode int = 404
+
+/*GetIssueNotFound The issue could not be found
+
+swagger:response getIssueNotFound
+*/
+type GetIssueNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetIssueNotFound creates GetIssueNotFound with default headers values
+func NewGetIssueNotFound() *GetIssueNotFound {
+
+	return &GetIssueNotFound{}
+}
+
+// WithPayload adds the payload to the get issue not found response
+func (o *GetIssueNotFound) WithPayload(payload *models.Error) *GetIssueNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get issue not found response
+func (o *GetIssueNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetIssueNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetIssueDefault unexpected error
+
+swagger:response getIssueDefault
+*/
+type GetIssueDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetIssueDefault creates GetIssueDefault with default headers values
+func NewGetIssueDefault(code int) *GetIssueDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetIssueDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get issue default response
+func (o *GetIssueDefault) WithStatusCode(code int) *GetIssueDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get issue default response
+func (o *GetIssueDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get issue default response
+func (o *GetIssueDefault) WithPayload(payload *models.Error) *GetIssueDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get issue default response
+func (o *GetIssueDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetIssueDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// ListIssueCommentsHandlerFunc turns a function with the right signature into a list issue comments handler
+type ListIssueCommentsHandlerFunc func(ListIssueCommentsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListIssueCommentsHandlerFunc) Handle(params ListIssueCommentsParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListIssueCommentsHandler interface for that can handle valid list issue comments params
+type ListIssueCommentsHandler interface {
+	Handle(ListIssueCommentsParams) middleware.Responder
+}
+
+// NewListIssueComments creates a new http.Handler for the list issue comments operation
+func NewListIssueComments(ctx *middleware.Context, handler ListIssueCommentsHandler) *ListIssueComments {
+	return &ListIssueComments{Context: ctx, Handler: handler}
+}
+
+/*ListIssueComments swagger:route GET /repositories/{owner}/{name}/issues/{number}/comments issues listIssueComments
+
+Get the comments of an issue, oldest first
+
+*/
+type ListIssueComments struct {
+	Context *middleware.Context
+	Handler ListIssueCommentsHandler
+}
+
+func (o *ListIssueComments) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListIssueCommentsParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
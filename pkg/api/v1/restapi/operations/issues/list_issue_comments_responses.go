@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListIssueCommentsOKCode is the HTTP code returned for type ListIssueCommentsOK
+const ListIssueCommentsOKCode int = 200
+
+/*ListIssueCommentsOK The issue's comments
+
+swagger:response listIssueCommentsOK
+*/
+type ListIssueCommentsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.IssueComment `json:"body,omitempty"`
+}
+
+// NewListIssueCommentsOK creates ListIssueCommentsOK with default headers values
+func NewListIssueCommentsOK() *ListIssueCommentsOK {
+
+	return &ListIssueCommentsOK{}
+}
+
+// WithPayload adds the payload to the list issue comments o k response
+func (o *ListIssueCommentsOK) WithPayload(payload []*models.IssueComment) *ListIssueCommentsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list issue comments o k response
+func (o *ListIssueCommentsOK) SetPayload(payload []*models.IssueComment) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListIssueCommentsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.IssueComment, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListIssueCommentsNotFoundCode is the HTTP code returned for type ListIssueCommentsNotFound
+const ListIssueCommentsNotFoundCode int = 404
+
+/*ListIssueCommentsNotFound The issue could not be found
+
+swagger:response listIssueCommentsNotFound
+*/
+type ListIssueCommentsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListIssueCommentsNotFound creates ListIssueCommentsNotFound with default headers values
+func NewListIssueCommentsNotFound() *ListIssueCommentsNotFound {
+
+	return &ListIssueCommentsNotFound{}
+}
+
+// WithPayload adds the payload to the list issue comments not found response
+func (o *ListIssueCommentsNotFound) WithPayload(payload *models.Error) *ListIssueCommentsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list issue comments not found response
+func (o *ListIssueCommentsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListIssueCommentsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListIssueCommentsDefault unexpected error
+
+swagger:response listIssueCommentsDefault
+*/
+type ListIssueCommentsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListIssueCommentsDefault creates ListIssueCommentsDefault with default headers values
+func NewListIssueCommentsDefault(code int) *ListIssueCommentsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListIssueCommentsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list issue comments default response
+func (o *ListIssueCommentsDefault) WithStatusCode(code int) *ListIssueCommentsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list issue comments default response
+func (o *ListIssueCommentsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list issue comments default response
+func (o *ListIssueCommentsDefault) WithPayload(payload *models.Error) *ListIssueCommentsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list issue comments default response
+func (o *ListIssueCommentsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListIssueCommentsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
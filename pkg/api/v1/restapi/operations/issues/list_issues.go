@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// ListIssuesHandlerFunc turns a function with the right signature into a list issues handler
+type ListIssuesHandlerFunc func(ListIssuesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListIssuesHandlerFunc) Handle(params ListIssuesParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListIssuesHandler interface for that can handle valid list issues params
+type ListIssuesHandler interface {
+	Handle(ListIssuesParams) middleware.Responder
+}
+
+// NewListIssues creates a new http.Handler for the list issues operation
+func NewListIssues(ctx *middleware.Context, handler ListIssuesHandler) *ListIssues {
+	return &ListIssues{Context: ctx, Handler: handler}
+}
+
+/*ListIssues swagger:route GET /repositories/{owner}/{name}/issues issues listIssues
+
+Get a repository's issues, the most recent first
+
+*/
+type ListIssues struct {
+	Context *middleware.Context
+	Handler ListIssuesHandler
+}
+
+func (o *ListIssues) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListIssuesParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,291 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewListIssuesParams creates a new ListIssuesParams object
+// with the default values initialized.
+func NewListIssuesParams() ListIssuesParams {
+
+	var (
+		// initialize parameters with default values
+
+		perPageDefault = int64(30)
+		stateDefault   = string("open")
+	)
+
+	return ListIssuesParams{
+		PerPage: &perPageDefault,
+
+		State: &stateDefault,
+	}
+}
+
+// ListIssuesParams contains all the bound params for the list issues operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listIssues
+type ListIssuesParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Only return issues assigned to the user with this username
+	  In: query
+	*/
+	Assignee *string
+	/*The cursor of the page to return, as returned with the previous page
+	  In: query
+	*/
+	Cursor *string
+	/*Only return issues with the label of this name
+	  In: query
+	*/
+	Label *string
+	/*The repository's name
+	  Required: true
+	  In: path
+	*/
+	Name string
+	/*The owner's username
+	  Required: true
+	  In: path
+	*/
+	Owner string
+	/*The number of items per page
+	  Maximum: 100
+	  Minimum: 1
+	  In: query
+	  Default: 30
+	*/
+	PerPage *int64
+	/*Only return issues in this state
+	  In: query
+	  Default: "open"
+	*/
+	State *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListIssuesParams() beforehand.
+func (o *ListIssuesParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qAssignee, qhkAssignee, _ := qs.GetOK("assignee")
+	if err := o.bindAssignee(qAssignee, qhkAssignee, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLabel, qhkLabel, _ := qs.GetOK("label")
+	if err := o.bindLabel(qLabel, qhkLabel, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rName, rhkName, _ := route.Params.GetOK("name")
+	if err := o.bindName(rName, rhkName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rOwner, rhkOwner, _ := route.Params.GetOK("owner")
+	if err := o.bindOwner(rOwner, rhkOwner, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPerPage, qhkPerPage, _ := qs.GetOK("per_page")
+	if err := o.bindPerPage(qPerPage, qhkPerPage, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qState, qhkState, _ := qs.GetOK("state")
+	if err := o.bindState(qState, qhkState, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAssignee binds and validates parameter Assignee from query.
+func (o *ListIssuesParams) bindAssignee(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Assignee = &raw
+
+	return nil
+}
+
+// bindCursor binds and validates parameter Cursor from query.
+func (o *ListIssuesParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Cursor = &raw
+
+	return nil
+}
+
+// bindLabel binds and validates parameter Label from query.
+func (o *ListIssuesParams) bindLabel(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Label = &raw
+
+	return nil
+}
+
+// bindName binds and validates parameter Name from path.
+func (o *ListIssuesParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Name = raw
+
+	return nil
+}
+
+// bindOwner binds and validates parameter Owner from path.
+func (o *ListIssuesParams) bindOwner(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Owner = raw
+
+	return nil
+}
+
+// bindPerPage binds and validates parameter PerPage from query.
+func (o *ListIssuesParams) bindPerPage(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListIssuesParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("per_page", "query", "int64", raw)
+	}
+	o.PerPage = &value
+
+	if err := o.validatePerPage(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validatePerPage carries on validations for parameter PerPage
+func (o *ListIssuesParams) validatePerPage(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("per_page", "query", int64(*o.PerPage), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("per_page", "query", int64(*o.PerPage), 100, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindState binds and validates parameter State from query.
+func (o *ListIssuesParams) bindState(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListIssuesParams()
+		return nil
+	}
+
+	o.State = &raw
+
+	if err := o.validateState(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateState carries on validations for parameter State
+func (o *ListIssuesParams) validateState(formats strfmt.Registry) error {
+
+	if err := validate.Enum("state", "query", *o.State, []interface{}{"open", "closed", "all"}); err != nil {
+		return err
+	}
+
+	return nil
+}
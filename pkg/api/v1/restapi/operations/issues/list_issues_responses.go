@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListIssuesOKCode is the HTTP code returned for type ListIssuesOK
+const ListIssuesOKCode int = 200
+
+/*ListIssuesOK The repository's issues
+
+swagger:response listIssuesOK
+*/
+type ListIssuesOK struct {
+	/*The URL of the next page with rel="next", missing on the last page
+
+	 */
+	Link string `json:"Link"`
+	/*The cursor of the next page, missing on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Issue `json:"body,omitempty"`
+}
+
+// NewListIssuesOK creates ListIssuesOK with default headers values
+func NewListIssuesOK() *ListIssuesOK {
+
+	return &ListIssuesOK{}
+}
+
+// WithLink adds the link to the list issues o k response
+func (o *ListIssuesOK) WithLink(link string) *ListIssuesOK {
+	o.Link = link
+	return o
+}
+
+// SetLink sets the link to the list issues o k response
+func (o *ListIssuesOK) SetLink(link string) {
+	o.Link = link
+}
+
+// WithXNextCursor adds the xNextCursor to the list issues o k response
+func (o *ListIssuesOK) WithXNextCursor(xNextCursor string) *ListIssuesOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the list issues o k response
+func (o *ListIssuesOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
+// WithPayload adds the payload to the list issues o k response
+func (o *ListIssuesOK) WithPayload(payload []*models.Issue) *ListIssuesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list issues o k response
+func (o *ListIssuesOK) SetPayload(payload []*models.Issue) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListIssuesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Link
+
+	link := o.Link
+	if link != "" {
+		rw.Header().Set("Link", link)
+	}
+
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Issue, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListIssuesNotFoundCode is the HTTP code returned for type ListIssuesNotFound
+const ListIssuesNotFoundCode int = 404
+
+/*ListIssuesNotFound The owner and name combination could not be found
+
+swagger:response listIssuesNotFound
+*/
+type ListIssuesNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListIssuesNotFound creates ListIssuesNotFound with default headers values
+func NewListIssuesNotFound() *ListIssuesNotFound {
+
+	return &ListIssuesNotFound{}
+}
+
+// WithPayload adds the payload to the list issues not found response
+func (o *ListIssuesNotFound) WithPayload(payload *models.Error) *ListIssuesNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list issues not found response
+func (o *ListIssuesNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListIssuesNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListIssuesUnprocessableEntityCode is the HTTP code returned for type ListIssuesUnprocessableEntity
+const ListIssuesUnprocessableEntityCode int = 422
+
+/*ListIssuesUnprocessableEntity The cursor is not valid
+
+swagger:response listIssuesUnprocessableEntity
+*/
+type ListIssuesUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListIssuesUnprocessableEntity creates ListIssuesUnprocessableEntity with default headers values
+func NewListIssuesUnprocessableEntity() *ListIssuesUnprocessableEntity {
+
+	return &ListIssuesUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the list issues unprocessable entity response
+func (o *ListIssuesUnprocessableEntity) WithPayload(payload *models.Error) *ListIssuesUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list issues unprocessable entity response
+func (o *ListIssuesUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListIssuesUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListIssuesDefault unexpected error
+
+swagger:response listIssuesDefault
+*/
+type ListIssuesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListIssuesDefault creates ListIssuesDefault with default headers values
+func NewListIssuesDefault(code int) *ListIssuesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListIssuesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list issues default response
+func (o *ListIssuesDefault) WithStatusCode(code int) *ListIssuesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list issues default response
+func (o *ListIssuesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list issues default response
+func (o *ListIssuesDefault) WithPayload(payload *models.Error) *ListIssuesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list issues default response
+func (o *ListIssuesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListIssuesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,159 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+
+	"github.com/go-openapi/swag"
+)
+
+// ListIssuesURL generates an URL for the list issues operation
+type ListIssuesURL struct {
+	Name  string
+	Owner string
+
+	Assignee *string
+	Cursor   *string
+	Label    *string
+	PerPage  *int64
+	State    *string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListIssuesURL) WithBasePath(bp string) *ListIssuesURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListIssuesURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *ListIssuesURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/repositories/{owner}/{name}/issues"
+
+	name := o.Name
+	if name != "" {
+		_path = strings.Replace(_path, "{name}", name, -1)
+	} else {
+		return nil, errors.New("Name is required on ListIssuesURL")
+	}
+
+	owner := o.Owner
+	if owner != "" {
+		_path = strings.Replace(_path, "{owner}", owner, -1)
+	} else {
+		return nil, errors.New("Owner is required on ListIssuesURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var assignee string
+	if o.Assignee != nil {
+		assignee = *o.Assignee
+	}
+	if assignee != "" {
+		qs.Set("assignee", assignee)
+	}
+
+	var cursor string
+	if o.Cursor != nil {
+		cursor = *o.Cursor
+	}
+	if cursor != "" {
+		qs.Set("cursor", cursor)
+	}
+
+	var label string
+	if o.Label != nil {
+		label = *o.Label
+	}
+	if label != "" {
+		qs.Set("label", label)
+	}
+
+	var perPage string
+	if o.PerPage != nil {
+		perPage = swag.FormatInt64(*o.PerPage)
+	}
+	if perPage != "" {
+		qs.Set("per_page", perPage)
+	}
+
+	var state string
+	if o.State != nil {
+		state = *o.State
+	}
+	if state != "" {
+		qs.Set("state", state)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *ListIssuesURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *ListIssuesURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *ListIssuesURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on ListIssuesURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on ListIssuesURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *ListIssuesURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
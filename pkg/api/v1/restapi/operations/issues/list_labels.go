@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// ListLabelsHandlerFunc turns a function with the right signature into a list labels handler
+type ListLabelsHandlerFunc func(ListLabelsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListLabelsHandlerFunc) Handle(params ListLabelsParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListLabelsHandler interface for that can handle valid list labels params
+type ListLabelsHandler interface {
+	Handle(ListLabelsParams) middleware.Responder
+}
+
+// NewListLabels creates a new http.Handler for the list labels operation
+func NewListLabels(ctx *middleware.Context, handler ListLabelsHandler) *ListLabels {
+	return &ListLabels{Context: ctx, Handler: handler}
+}
+
+/*ListLabels swagger:route GET /repositories/{owner}/{name}/labels issues listLabels
+
+Get a repository's labels by name
+
+*/
+type ListLabels struct {
+	Context *middleware.Context
+	Handler ListLabelsHandler
+}
+
+func (o *ListLabels) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListLabelsParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
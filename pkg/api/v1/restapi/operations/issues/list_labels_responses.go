@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListLabelsOKCode is the HTTP code returned for type ListLabelsOK
+const ListLabelsOKCode int = 200
+
+/*ListLabelsOK The repository's labels
+
+swagger:response listLabelsOK
+*/
+type ListLabelsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Label `json:"body,omitempty"`
+}
+
+// NewListLabelsOK creates ListLabelsOK with default headers values
+func NewListLabelsOK() *ListLabelsOK {
+
+	return &ListLabelsOK{}
+}
+
+// WithPayload adds the payload to the list labels o k response
+func (o *ListLabelsOK) WithPayload(payload []*models.Label) *ListLabelsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list labels o k response
+func (o *ListLabelsOK) SetPayload(payload []*models.Label) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListLabelsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Label, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListLabelsNotFoundCode is the HTTP code returned for type ListLabelsNotFound
+const ListLabelsNotFoundCode int = 404
+
+/*ListLabelsNotFound The owner and name combination could not be found
+
+swagger:response listLabelsNotFound
+*/
+type ListLabelsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListLabelsNotFound creates ListLabelsNotFound with default headers values
+func NewListLabelsNotFound() *ListLabelsNotFound {
+
+	return &ListLabelsNotFound{}
+}
+
+// WithPayload adds the payload to the list labels not found response
+func (o *ListLabelsNotFound) WithPayload(payload *models.Error) *ListLabelsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list labels not found response
+func (o *ListLabelsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListLabelsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListLabelsDefault unexpected error
+
+swagger:response listLabelsDefault
+*/
+type ListLabelsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListLabelsDefault creates ListLabelsDefault with default headers values
+func NewListLabelsDefault(code int) *ListLabelsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListLabelsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list labels default response
+func (o *ListLabelsDefault) WithStatusCode(code int) *ListLabelsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list labels default response
+func (o *ListLabelsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list labels default response
+func (o *ListLabelsDefault) WithPayload(payload *models.Error) *ListLabelsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list labels default response
+func (o *ListLabelsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListLabelsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
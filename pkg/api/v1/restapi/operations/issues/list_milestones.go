@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// ListMilestonesHandlerFunc turns a function with the right signature into a list milestones handler
+type ListMilestonesHandlerFunc func(ListMilestonesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListMilestonesHandlerFunc) Handle(params ListMilestonesParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListMilestonesHandler interface for that can handle valid list milestones params
+type ListMilestonesHandler interface {
+	Handle(ListMilestonesParams) middleware.Responder
+}
+
+// NewListMilestones creates a new http.Handler for the list milestones operation
+func NewListMilestones(ctx *middleware.Context, handler ListMilestonesHandler) *ListMilestones {
+	return &ListMilestones{Context: ctx, Handler: handler}
+}
+
+/*ListMilestones swagger:route GET /repositories/{owner}/{name}/milestones issues listMilestones
+
+Get a repository's milestones, the ones due first first
+
+*/
+type ListMilestones struct {
+	Context *middleware.Context
+	Handler ListMilestonesHandler
+}
+
+func (o *ListMilestones) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListMilestonesParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
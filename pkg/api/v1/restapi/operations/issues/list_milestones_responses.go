@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListMilestonesOKCode is the HTTP code returned for type ListMilestonesOK
+const ListMilestonesOKCode int = 200
+
+/*ListMilestonesOK The repository's milestones
+
+swagger:response listMilestonesOK
+*/
+type ListMilestonesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Milestone `json:"body,omitempty"`
+}
+
+// NewListMilestonesOK creates ListMilestonesOK with default headers values
+func NewListMilestonesOK() *ListMilestonesOK {
+
+	return &ListMilestonesOK{}
+}
+
+// WithPayload adds the payload to the list milestones o k response
+func (o *ListMilestonesOK) WithPayload(payload []*models.Milestone) *ListMilestonesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list milestones o k response
+func (o *ListMilestonesOK) SetPayload(payload []*models.Milestone) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListMilestonesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Milestone, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListMilestonesNotFoundCode is the HTTP code returned for type ListMilestonesNotFound
+const ListMilestonesNotFoundCode int = 404
+
+/*ListMilestonesNotFound The owner and name combination could not be found
+
+swagger:response listMilestonesNotFound
+*/
+type ListMilestonesNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListMilestonesNotFound creates ListMilestonesNotFound with default headers values
+func NewListMilestonesNotFound() *ListMilestonesNotFound {
+
+	return &ListMilestonesNotFound{}
+}
+
+// WithPayload adds the payload to the list milestones not found response
+func (o *ListMilestonesNotFound) WithPayload(payload *models.Error) *ListMilestonesNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list milestones not found response
+func (o *ListMilestonesNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListMilestonesNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListMilestonesDefault unexpected error
+
+swagger:response listMilestonesDefault
+*/
+type ListMilestonesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListMilestonesDefault creates ListMilestonesDefault with default headers values
+func NewListMilestonesDefault(code int) *ListMilestonesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListMilestonesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list milestones default response
+func (o *ListMilestonesDefault) WithStatusCode(code int) *ListMilestonesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list milestones default response
+func (o *ListMilestonesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list milestones default response
+func (o *ListMilestonesDefault) WithPayload(payload *models.Error) *ListMilestonesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list milestones default response
+func (o *ListMilestonesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListMilestonesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+	validate "github.com/go-openapi/validate"
+)
+
+// UpdateIssueHandlerFunc turns a function with the right signature into a update issue handler
+type UpdateIssueHandlerFunc func(UpdateIssueParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateIssueHandlerFunc) Handle(params UpdateIssueParams) middleware.Responder {
+	return fn(params)
+}
+
+// UpdateIssueHandler interface for that can handle valid update issue params
+type UpdateIssueHandler interface {
+	Handle(UpdateIssueParams) middleware.Responder
+}
+
+// NewUpdateIssue creates a new http.Handler for the update issue operation
+func NewUpdateIssue(ctx *middleware.Context, handler UpdateIssueHandler) *UpdateIssue {
+	return &UpdateIssue{Context: ctx, Handler: handler}
+}
+
+/*UpdateIssue swagger:route PATCH /repositories/{owner}/{name}/issues/{number} issues updateIssue
+
+Update an issue
+
+*/
+type UpdateIssue struct {
+	Context *middleware.Context
+	Handler UpdateIssueHandler
+}
+
+func (o *UpdateIssue) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewUpdateIssueParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// UpdateIssueBody update issue body
+// swagger:model UpdateIssueBody
+type UpdateIssueBody struct {
+
+	// Replaces the issue's assignees by their usernames
+	Assignees []string `json:"assignees"`
+
+	// body
+	Body *string `json:"body,omitempty"`
+
+	// Replaces the issue's labels by their names
+	Labels []string `json:"labels"`
+
+	// The title of the issue's milestone, empty removes it from its milestone
+	Milestone *string `json:"milestone,omitempty"`
+
+	// state
+	// Enum: [open closed]
+	State *string `json:"state,omitempty"`
+
+	// title
+	Title *string `json:"title,omitempty"`
+}
+
+// Validate validates this update issue body
+func (o *UpdateIssueBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var updateIssueBodyTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["open","closed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		updateIssueBodyTypeStatePropEnum = append(updateIssueBodyTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// UpdateIssueBodyStateOpen captures enum value "open"
+	UpdateIssueBodyStateOpen string = "open"
+
+	// UpdateIssueBodyStateClosed captures enum value "closed"
+	UpdateIssueBodyStateClosed string = "closed"
+)
+
+// prop value enum
+func (o *UpdateIssueBody) validateStateEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, updateIssueBodyTypeStatePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *UpdateIssueBody) validateState(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.State) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStateEnum("update"+"."+"state", "body", *o.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *UpdateIssueBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *UpdateIssueBody) UnmarshalBinary(b []byte) error {
+	var res UpdateIssueBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,248 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package issues
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// UpdateIssueOKCode is the HTTP code returned for type UpdateIssueOK
+const UpdateIssueOKCode int = 200
+
+/*UpdateIssueOK The issue has been updated
+
+swagger:response updateIssueOK
+*/
+type UpdateIssueOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Issue `json:"body,omitempty"`
+}
+
+// NewUpdateIssueOK creates UpdateIssueOK with default headers values
+func NewUpdateIssueOK() *UpdateIssueOK {
+
+	return &UpdateIssueOK{}
+}
+
+// WithPayload adds the payload to the update issue o k response
+func (o *UpdateIssueOK) WithPayload(payload *models.Issue) *UpdateIssueOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update issue o k response
+func (o *UpdateIssueOK) SetPayload(payload *models.Issue) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateIssueOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateIssueForbiddenCode is the HTTP code returned for type UpdateIssueForbidden
+const UpdateIssueForbiddenCode int = 403
+
+/*UpdateIssueForbidden Only the author and the repository's owner can update an issue, only the owner can change labels, assignees and milestone
+
+swagger:response updateIssueForbidden
+*/
+type UpdateIssueForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateIssueForbidden creates UpdateIssueForbidden with default headers values
+func NewUpdateIssueForbidden() *UpdateIssueForbidden {
+
+	return &UpdateIssueForbidden{}
+}
+
+// WithPayload adds the payload to the update issue forbidden response
+func (o *UpdateIssueForbidden) WithPayload(payload *models.Error) *UpdateIssueForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update issue forbidden response
+func (o *UpdateIssueForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateIssueForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateIssueNotFoundCode is the HTTP code returned for type UpdateIssueNotFound
+const UpdateIssueNotFoundCode int = 404
+
+/*UpdateIssueNotFound The issue could not be found
+
+swagger:response updateIssueNotFound
+*/
+type UpdateIssueNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateIssueNotFound creates UpdateIssueNotFound with default headers values
+func NewUpdateIssueNotFound() *UpdateIssueNotFound {
+
+	return &UpdateIssueNotFound{}
+}
+
+// WithPayload adds the payload to the update issue not found response
+func (o *UpdateIssueNotFound) WithPayload(payload *models.Error) *UpdateIssueNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update issue not found response
+func (o *UpdateIssueNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateIssueNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateIssueUnprocessableEntityCode is the HTTP code returned for type UpdateIssueUnprocessableEntity
+const UpdateIssueUnprocessableEntityCode int = 422
+
+/*UpdateIssueUnprocessableEntity The issue has not been updated due to invalid input
+
+swagger:response updateIssueUnprocessableEntity
+*/
+type UpdateIssueUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewUpdateIssueUnprocessableEntity creates UpdateIssueUnprocessableEntity with default headers values
+func NewUpdateIssueUnprocessableEntity() *UpdateIssueUnprocessableEntity {
+
+	return &UpdateIssueUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the update issue unprocessable entity response
+func (o *UpdateIssueUnprocessableEntity) WithPayload(payload *models.ValidationError) *UpdateIssueUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update issue unprocessable entity response
+func (o *UpdateIssueUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateIssueUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdateIssueDefault unexpected error
+
+swagger:response updateIssueDefault
+*/
+type UpdateIssueDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateIssueDefault creates UpdateIssueDefault with default headers values
+func NewUpdateIssueDefault(code int) *UpdateIssueDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdateIssueDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update issue default response
+func (o *UpdateIssueDefault) WithStatusCode(code int) *UpdateIssueDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update issue default response
+func (o *UpdateIssueDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update issue default response
+func (o *UpdateIssueDefault) WithPayload(payload *models.Error) *UpdateIssueDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update issue default response
+func (o *UpdateIssueDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateIssueDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package markdown
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+	validate "github.com/go-openapi/validate"
+)
+
+// RenderMarkdownHandlerFunc turns a function with the right signature into a render markdown handler
+type RenderMarkdownHandlerFunc func(RenderMarkdownParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RenderMarkdownHandlerFunc) Handle(params RenderMarkdownParams) middleware.Responder {
+	return fn(params)
+}
+
+// RenderMarkdownHandler interface for that can handle valid render markdown params
+type RenderMarkdownHandler interface {
+	Handle(RenderMarkdownParams) middleware.Responder
+}
+
+// NewRenderMarkdown creates a new http.Handler for the render markdown operation
+func NewRenderMarkdown(ctx *middleware.Context, handler RenderMarkdownHandler) *RenderMarkdown {
+	return &RenderMarkdown{Context: ctx, Handler: handler}
+}
+
+/*RenderMarkdown swagger:route POST /markdown markdown renderMarkdown
+
+Render Markdown to HTML
+
+Renders GitHub flavored Markdown to sanitized HTML. Mentioned users, issues like owner/name#123 and commits of the repository are linked, code blocks are highlighted.
+
+*/
+type RenderMarkdown struct {
+	Context *middleware.Context
+	Handler RenderMarkdownHandler
+}
+
+func (o *RenderMarkdown) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewRenderMarkdownParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// RenderMarkdownBody render markdown body
+// swagger:model RenderMarkdownBody
+type RenderMarkdownBody struct {
+
+	// The owner/name of the repository the text is about,
+	Repository string `json:"repository,omitempty"`
+
+	// text
+	// Required: true
+	Text *string `json:"text"`
+}
+
+// Validate validates this render markdown body
+func (o *RenderMarkdownBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateText(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *RenderMarkdownBody) validateText(formats strfmt.Registry) error {
+
+	if err := validate.Required("markdown"+"."+"text", "body", o.Text); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *RenderMarkdownBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *RenderMarkdownBody) UnmarshalBinary(b []byte) error {
+	var res RenderMarkdownBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,75 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package markdown
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// NewRenderMarkdownParams creates a new RenderMarkdownParams object
+// no default values defined in spec.
+func NewRenderMarkdownParams() RenderMarkdownParams {
+
+	return RenderMarkdownParams{}
+}
+
+// RenderMarkdownParams contains all the bound params for the render markdown operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters renderMarkdown
+type RenderMarkdownParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The Markdown to render
+	  Required: true
+	  In: body
+	*/
+	Markdown RenderMarkdownBody
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewRenderMarkdownParams() beforehand.
+func (o *RenderMarkdownParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body RenderMarkdownBody
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("markdown", "body"))
+			} else {
+				res = append(res, errors.NewParseError("markdown", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Markdown = body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("markdown", "body"))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
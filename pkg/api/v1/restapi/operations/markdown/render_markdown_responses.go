@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package markdown
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// RenderMarkdownOKCode is the HTTP code returned for type RenderMarkdownOK
+const RenderMarkdownOKCode int = 200
+
+/*RenderMarkdownOK The rendered Markdown
+
+swagger:response renderMarkdownOK
+*/
+type RenderMarkdownOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Markdown `json:"body,omitempty"`
+}
+
+// NewRenderMarkdownOK creates RenderMarkdownOK with default headers values
+func NewRenderMarkdownOK() *RenderMarkdownOK {
+
+	return &RenderMarkdownOK{}
+}
+
+// WithPayload adds the payload to the render markdown o k response
+func (o *RenderMarkdownOK) WithPayload(payload *models.Markdown) *RenderMarkdownOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the render markdown o k response
+func (o *RenderMarkdownOK) SetPayload(payload *models.Markdown) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RenderMarkdownOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RenderMarkdownUnprocessableEntityCode is the HTTP code returned for type RenderMarkdownUnprocessableEntity
+const RenderMarkdownUnprocessableEntityCode int = 422
+
+/*RenderMarkdownUnprocessableEntity The text is too large or the repository is not owner/name
+
+swagger:response renderMarkdownUnprocessableEntity
+*/
+type RenderMarkdownUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRenderMarkdownUnprocessableEntity creates RenderMarkdownUnprocessableEntity with default headers values
+func NewRenderMarkdownUnprocessableEntity() *RenderMarkdownUnprocessableEntity {
+
+	return &RenderMarkdownUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the render markdown unprocessable entity response
+func (o *RenderMarkdownUnprocessableEntity) WithPayload(payload *models.Error) *RenderMarkdownUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the render markdown unprocessable entity response
+func (o *RenderMarkdownUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RenderMarkdownUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*RenderMarkdownDefault unexpected error
+
+swagger:response renderMarkdownDefault
+*/
+type RenderMarkdownDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRenderMarkdownDefault creates RenderMarkdownDefault with default headers values
+func NewRenderMarkdownDefault(code int) *RenderMarkdownDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RenderMarkdownDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the render markdown default response
+func (o *RenderMarkdownDefault) WithStatusCode(code int) *RenderMarkdownDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the render markdown default response
+func (o *RenderMarkdownDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the render markdown default response
+func (o *RenderMarkdownDefault) WithPayload(payload *models.Error) *RenderMarkdownDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the render markdown default response
+func (o *RenderMarkdownDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RenderMarkdownDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
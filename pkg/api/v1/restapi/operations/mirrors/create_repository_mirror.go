@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mirrors
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+	validate "github.com/go-openapi/validate"
+)
+
+// CreateRepositoryMirrorHandlerFunc turns a function with the right signature into a create repository mirror handler
+type CreateRepositoryMirrorHandlerFunc func(CreateRepositoryMirrorParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateRepositoryMirrorHandlerFunc) Handle(params CreateRepositoryMirrorParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateRepositoryMirrorHandler interface for that can handle valid create repository mirror params
+type CreateRepositoryMirrorHandler interface {
+	Handle(CreateRepositoryMirrorParams) middleware.Responder
+}
+
+// NewCreateRepositoryMirror creates a new http.Handler for the create repository mirror operation
+func NewCreateRepositoryMirror(ctx *middleware.Context, handler CreateRepositoryMirrorHandler) *CreateRepositoryMirror {
+	return &CreateRepositoryMirror{Context: ctx, Handler: handler}
+}
+
+/*CreateRepositoryMirror swagger:route POST /repositories/{owner}/{name}/mirrors mirrors createRepositoryMirror
+
+Mirror a repository with a remote
+
+Pull mirrors fetch the remote's branches and tags, push mirrors push all refs to the remote. The mirror is synced right away and then every interval.
+
+*/
+type CreateRepositoryMirror struct {
+	Context *middleware.Context
+	Handler CreateRepositoryMirrorHandler
+}
+
+func (o *CreateRepositoryMirror) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCreateRepositoryMirrorParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// CreateRepositoryMirrorBody create repository mirror body
+// swagger:model CreateRepositoryMirrorBody
+type CreateRepositoryMirrorBody struct {
+
+	// The reference of the owner's credentials to authenticate with, configured by the administrator
+	Credentials string `json:"credentials,omitempty"`
+
+	// direction
+	// Required: true
+	// Enum: [pull push]
+	Direction *string `json:"direction"`
+
+	// The seconds between syncs, at least 300, defaults to 3600
+	Interval int64 `json:"interval,omitempty"`
+
+	// The http, https or git URL of the remote
+	// Required: true
+	URL *string `json:"url"`
+}
+
+// Validate validates this create repository mirror body
+func (o *CreateRepositoryMirrorBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateDirection(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var createRepositoryMirrorBodyTypeDirectionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pull","push"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createRepositoryMirrorBodyTypeDirectionPropEnum = append(createRepositoryMirrorBodyTypeDirectionPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateRepositoryMirrorBodyDirectionPull captures enum value "pull"
+	CreateRepositoryMirrorBodyDirectionPull string = "pull"
+
+	// CreateRepositoryMirrorBodyDirectionPush captures enum value "push"
+	CreateRepositoryMirrorBodyDirectionPush string = "push"
+)
+
+// prop value enum
+func (o *CreateRepositoryMirrorBody) validateDirectionEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, createRepositoryMirrorBodyTypeDirectionPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *CreateRepositoryMirrorBody) validateDirection(formats strfmt.Registry) error {
+
+	if err := validate.Required("mirror"+"."+"direction", "body", o.Direction); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := o.validateDirectionEnum("mirror"+"."+"direction", "body", *o.Direction); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreateRepositoryMirrorBody) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("mirror"+"."+"url", "body", o.URL); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateRepositoryMirrorBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateRepositoryMirrorBody) UnmarshalBinary(b []byte) error {
+	var res CreateRepositoryMirrorBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
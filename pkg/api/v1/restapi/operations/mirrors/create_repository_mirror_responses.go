@@ -0,0 +1,292 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mirrors
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// CreateRepositoryMirrorOKCode is the HTTP code returned for type CreateRepositoryMirrorOK
+const CreateRepositoryMirrorOKCode int = 200
+
+/*CreateRepositoryMirrorOK The mirror has been created
+
+swagger:response createRepositoryMirrorOK
+*/
+type CreateRepositoryMirrorOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Mirror `json:"body,omitempty"`
+}
+
+// NewCreateRepositoryMirrorOK creates CreateRepositoryMirrorOK with default headers values
+func NewCreateRepositoryMirrorOK() *CreateRepositoryMirrorOK {
+
+	return &CreateRepositoryMirrorOK{}
+}
+
+// WithPayload adds the payload to the create repository mirror o k response
+func (o *CreateRepositoryMirrorOK) WithPayload(payload *models.Mirror) *CreateRepositoryMirrorOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create repository mirror o k response
+func (o *CreateRepositoryMirrorOK) SetPayload(payload *models.Mirror) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRepositoryMirrorOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateRepositoryMirrorForbiddenCode is the HTTP code returned for type CreateRepositoryMirrorForbidden
+const CreateRepositoryMirrorForbiddenCode int = 403
+
+/*CreateRepositoryMirrorForbidden Only the repository's owner can create mirrors
+
+swagger:response createRepositoryMirrorForbidden
+*/
+type CreateRepositoryMirrorForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateRepositoryMirrorForbidden creates CreateRepositoryMirrorForbidden with default headers values
+func NewCreateRepositoryMirrorForbidden() *CreateRepositoryMirrorForbidden {
+
+	return &CreateRepositoryMirrorForbidden{}
+}
+
+// WithPayload adds the payload to the create repository mirror forbidden response
+func (o *CreateRepositoryMirrorForbidden) WithPayload(payload *models.Error) *CreateRepositoryMirrorForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create repository mirror forbidden response
+func (o *CreateRepositoryMirrorForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRepositoryMirrorForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateRepositoryMirrorNotFoundCode is the HTTP code returned for type CreateRepositoryMirrorNotFound
+const CreateRepositoryMirrorNotFoundCode int = 404
+
+/*CreateRepositoryMirrorNotFound The owner and name combination could not be found
+
+swagger:response createRepositoryMirrorNotFound
+*/
+type CreateRepositoryMirrorNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateRepositoryMirrorNotFound creates CreateRepositoryMirrorNotFound with default headers values
+func NewCreateRepositoryMirrorNotFound() *CreateRepositoryMirrorNotFound {
+
+	return &CreateRepositoryMirrorNotFound{}
+}
+
+// WithPayload adds the payload to the create repository mirror not found response
+func (o *CreateRepositoryMirrorNotFound) WithPayload(payload *models.Error) *CreateRepositoryMirrorNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create repository mirror not found response
+func (o *CreateRepositoryMirrorNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRepositoryMirrorNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateRepositoryMirrorConflictCode is the HTTP code returned for type CreateRepositoryMirrorConflict
+const CreateRepositoryMirrorConflictCode int = 409
+
+/*CreateRepositoryMirrorConflict The repository already pulls from a mirror
+
+swagger:response createRepositoryMirrorConflict
+*/
+type CreateRepositoryMirrorConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateRepositoryMirrorConflict creates CreateRepositoryMirrorConflict with default headers values
+func NewCreateRepositoryMirrorConflict() *CreateRepositoryMirrorConflict {
+
+	return &CreateRepositoryMirrorConflict{}
+}
+
+// WithPayload adds the payload to the create repository mirror conflict response
+func (o *CreateRepositoryMirrorConflict) WithPayload(payload *models.Error) *CreateRepositoryMirrorConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create repository mirror conflict response
+func (o *CreateRepositoryMirrorConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRepositoryMirrorConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateRepositoryMirrorUnprocessableEntityCode is the HTTP code returned for type CreateRepositoryMirrorUnprocessableEntity
+const CreateRepositoryMirrorUnprocessableEntityCode int = 422
+
+/*CreateRepositoryMirrorUnprocessableEntity The mirror has not been created due to invalid input
+
+swagger:response createRepositoryMirrorUnprocessableEntity
+*/
+type CreateRepositoryMirrorUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewCreateRepositoryMirrorUnprocessableEntity creates CreateRepositoryMirrorUnprocessableEntity with default headers values
+func NewCreateRepositoryMirrorUnprocessableEntity() *CreateRepositoryMirrorUnprocessableEntity {
+
+	return &CreateRepositoryMirrorUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the create repository mirror unprocessable entity response
+func (o *CreateRepositoryMirrorUnprocessableEntity) WithPayload(payload *models.ValidationError) *CreateRepositoryMirrorUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create repository mirror unprocessable entity response
+func (o *CreateRepositoryMirrorUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRepositoryMirrorUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateRepositoryMirrorDefault unexpected error
+
+swagger:response createRepositoryMirrorDefault
+*/
+type CreateRepositoryMirrorDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateRepositoryMirrorDefault creates CreateRepositoryMirrorDefault with default headers values
+func NewCreateRepositoryMirrorDefault(code int) *CreateRepositoryMirrorDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateRepositoryMirrorDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create repository mirror default response
+func (o *CreateRepositoryMirrorDefault) WithStatusCode(code int) *CreateRepositoryMirrorDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create repository mirror default response
+func (o *CreateRepositoryMirrorDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create repository mirror default response
+func (o *CreateRepositoryMirrorDefault) WithPayload(payload *models.Error) *CreateRepositoryMirrorDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create repository mirror default response
+func (o *CreateRepositoryMirrorDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRepositoryMirrorDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
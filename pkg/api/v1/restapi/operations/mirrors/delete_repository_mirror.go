@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mirrors
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteRepositoryMirrorHandlerFunc turns a function with the right signature into a delete repository mirror handler
+type DeleteRepositoryMirrorHandlerFunc func(DeleteRepositoryMirrorParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteRepositoryMirrorHandlerFunc) Handle(params DeleteRepositoryMirrorParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteRepositoryMirrorHandler interface for that can handle valid delete repository mirror params
+type DeleteRepositoryMirrorHandler interface {
+	Handle(DeleteRepositoryMirrorParams) middleware.Responder
+}
+
+// NewDeleteRepositoryMirror creates a new http.Handler for the delete repository mirror operation
+func NewDeleteRepositoryMirror(ctx *middleware.Context, handler DeleteRepositoryMirrorHandler) *DeleteRepositoryMirror {
+	return &DeleteRepositoryMirror{Context: ctx, Handler: handler}
+}
+
+/*DeleteRepositoryMirror swagger:route DELETE /repositories/{owner}/{name}/mirrors/{id} mirrors deleteRepositoryMirror
+
+Delete a mirror of a repository
+
+*/
+type DeleteRepositoryMirror struct {
+	Context *middleware.Context
+	Handler DeleteRepositoryMirrorHandler
+}
+
+func (o *DeleteRepositoryMirror) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewDeleteRepositoryMirrorParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
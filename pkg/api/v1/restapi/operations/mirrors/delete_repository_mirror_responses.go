@@ -0,0 +1,184 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mirrors
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// DeleteRepositoryMirrorNoContentCode is the HTTP code returned for type DeleteRepositoryMirrorNoContent
+const DeleteRepositoryMirrorNoContentCode int = 204
+
+/*DeleteRepositoryMirrorNoContent The mirror has been deleted
+
+swagger:response deleteRepositoryMirrorNoContent
+*/
+type DeleteRepositoryMirrorNoContent struct {
+}
+
+// NewDeleteRepositoryMirrorNoContent creates DeleteRepositoryMirrorNoContent with default headers values
+func NewDeleteRepositoryMirrorNoContent() *DeleteRepositoryMirrorNoContent {
+
+	return &DeleteRepositoryMirrorNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteRepositoryMirrorNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// DeleteRepositoryMirrorForbiddenCode is the HTTP code returned for type DeleteRepositoryMirrorForbidden
+const DeleteRepositoryMirrorForbiddenCode int = 403
+
+/*DeleteRepositoryMirrorForbidden Only the repository's owner can delete mirrors
+
+swagger:response deleteRepositoryMirrorForbidden
+*/
+type DeleteRepositoryMirrorForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteRepositoryMirrorForbidden creates DeleteRepositoryMirrorForbidden with default headers values
+func NewDeleteRepositoryMirrorForbidden() *DeleteRepositoryMirrorForbidden {
+
+	return &DeleteRepositoryMirrorForbidden{}
+}
+
+// WithPayload adds the payload to the delete repository mirror forbidden response
+func (o *DeleteRepositoryMirrorForbidden) WithPayload(payload *models.Error) *DeleteRepositoryMirrorForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete repository mirror forbidden response
+func (o *DeleteRepositoryMirrorForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteRepositoryMirrorForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteRepositoryMirrorNotFoundCode is the HTTP code returned for type DeleteRepositoryMirrorNotFound
+const DeleteRepositoryMirrorNotFoundCode int = 404
+
+/*DeleteRepositoryMirrorNotFound The mirror could not be found
+
+swagger:response deleteRepositoryMirrorNotFound
+*/
+type DeleteRepositoryMirrorNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteRepositoryMirrorNotFound creates DeleteRepositoryMirrorNotFound with default headers values
+func NewDeleteRepositoryMirrorNotFound() *DeleteRepositoryMirrorNotFound {
+
+	return &DeleteRepositoryMirrorNotFound{}
+}
+
+// WithPayload adds the payload to the delete repository mirror not found response
+func (o *DeleteRepositoryMirrorNotFound) WithPayload(payload *models.Error) *DeleteRepositoryMirrorNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete repository mirror not found response
+func (o *DeleteRepositoryMirrorNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteRepositoryMirrorNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteRepositoryMirrorDefault unexpected error
+
+swagger:response deleteRepositoryMirrorDefault
+*/
+type DeleteRepositoryMirrorDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteRepositoryMirrorDefault creates DeleteRepositoryMirrorDefault with default headers values
+func NewDeleteRepositoryMirrorDefault(code int) *DeleteRepositoryMirrorDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteRepositoryMirrorDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete repository mirror default response
+func (o *DeleteRepositoryMirrorDefault) WithStatusCode(code int) *DeleteRepositoryMirrorDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete repository mirror default response
+func (o *DeleteRepositoryMirrorDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete repository mirror default response
+func (o *DeleteRepositoryMirrorDefault) WithPayload(payload *models.Error) *DeleteRepositoryMirrorDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete repository mirror default response
+func (o *DeleteRepositoryMirrorDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteRepositoryMirrorDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
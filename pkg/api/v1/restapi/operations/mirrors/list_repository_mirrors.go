@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mirrors
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// ListRepositoryMirrorsHandlerFunc turns a function with the right signature into a list repository mirrors handler
+type ListRepositoryMirrorsHandlerFunc func(ListRepositoryMirrorsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListRepositoryMirrorsHandlerFunc) Handle(params ListRepositoryMirrorsParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListRepositoryMirrorsHandler interface for that can handle valid list repository mirrors params
+type ListRepositoryMirrorsHandler interface {
+	Handle(ListRepositoryMirrorsParams) middleware.Responder
+}
+
+// NewListRepositoryMirrors creates a new http.Handler for the list repository mirrors operation
+func NewListRepositoryMirrors(ctx *middleware.Context, handler ListRepositoryMirrorsHandler) *ListRepositoryMirrors {
+	return &ListRepositoryMirrors{Context: ctx, Handler: handler}
+}
+
+/*ListRepositoryMirrors swagger:route GET /repositories/{owner}/{name}/mirrors mirrors listRepositoryMirrors
+
+Get the mirrors of a repository
+
+*/
+type ListRepositoryMirrors struct {
+	Context *middleware.Context
+	Handler ListRepositoryMirrorsHandler
+}
+
+func (o *ListRepositoryMirrors) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListRepositoryMirrorsParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,207 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mirrors
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListRepositoryMirrorsOKCode is the HTTP code returned for type ListRepositoryMirrorsOK
+const ListRepositoryMirrorsOKCode int = 200
+
+/*ListRepositoryMirrorsOK The mirrors of the repository
+
+swagger:response listRepositoryMirrorsOK
+*/
+type ListRepositoryMirrorsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Mirror `json:"body,omitempty"`
+}
+
+// NewListRepositoryMirrorsOK creates ListRepositoryMirrorsOK with default headers values
+func NewListRepositoryMirrorsOK() *ListRepositoryMirrorsOK {
+
+	return &ListRepositoryMirrorsOK{}
+}
+
+// WithPayload adds the payload to the list repository mirrors o k response
+func (o *ListRepositoryMirrorsOK) WithPayload(payload []*models.Mirror) *ListRepositoryMirrorsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list repository mirrors o k response
+func (o *ListRepositoryMirrorsOK) SetPayload(payload []*models.Mirror) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRepositoryMirrorsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Mirror, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListRepositoryMirrorsForbiddenCode is the HTTP code returned for type ListRepositoryMirrorsForbidden
+const ListRepositoryMirrorsForbiddenCode int = 403
+
+/*ListRepositoryMirrorsForbidden Only the repository's owner can see its mirrors
+
+swagger:response listRepositoryMirrorsForbidden
+*/
+type ListRepositoryMirrorsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListRepositoryMirrorsForbidden creates ListRepositoryMirrorsForbidden with default headers values
+func NewListRepositoryMirrorsForbidden() *ListRepositoryMirrorsForbidden {
+
+	return &ListRepositoryMirrorsForbidden{}
+}
+
+// WithPayload adds the payload to the list repository mirrors forbidden response
+func (o *ListRepositoryMirrorsForbidden) WithPayload(payload *models.Error) *ListRepositoryMirrorsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list repository mirrors forbidden response
+func (o *ListRepositoryMirrorsForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRepositoryMirrorsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListRepositoryMirrorsNotFoundCode is the HTTP code returned for type ListRepositoryMirrorsNotFound
+const ListRepositoryMirrorsNotFoundCode int = 404
+
+/*ListRepositoryMirrorsNotFound The owner and name combination could not be found
+
+swagger:response listRepositoryMirrorsNotFound
+*/
+type ListRepositoryMirrorsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListRepositoryMirrorsNotFound creates ListRepositoryMirrorsNotFound with default headers values
+func NewListRepositoryMirrorsNotFound() *ListRepositoryMirrorsNotFound {
+
+	return &ListRepositoryMirrorsNotFound{}
+}
+
+// WithPayload adds the payload to the list repository mirrors not found response
+func (o *ListRepositoryMirrorsNotFound) WithPayload(payload *models.Error) *ListRepositoryMirrorsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list repository mirrors not found response
+func (o *ListRepositoryMirrorsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRepositoryMirrorsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListRepositoryMirrorsDefault unexpected error
+
+swagger:response listRepositoryMirrorsDefault
+*/
+type ListRepositoryMirrorsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListRepositoryMirrorsDefault creates ListRepositoryMirrorsDefault with default headers values
+func NewListRepositoryMirrorsDefault(code int) *ListRepositoryMirrorsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListRepositoryMirrorsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list repository mirrors default response
+func (o *ListRepositoryMirrorsDefault) WithStatusCode(code int) *ListRepositoryMirrorsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list repository mirrors default response
+func (o *ListRepositoryMirrorsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list repository mirrors default response
+func (o *ListRepositoryMirrorsDefault) WithPayload(payload *models.Error) *ListRepositoryMirrorsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list repository mirrors default response
+func (o *ListRepositoryMirrorsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRepositoryMirrorsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
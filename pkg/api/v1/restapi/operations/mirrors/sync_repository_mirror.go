@@ -0,0 +1,60 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mirrors
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// SyncRepositoryMirrorHandlerFunc turns a function with the right signature into a sync repository mirror handler
+type SyncRepositoryMirrorHandlerFunc func(SyncRepositoryMirrorParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SyncRepositoryMirrorHandlerFunc) Handle(params SyncRepositoryMirrorParams) middleware.Responder {
+	return fn(params)
+}
+
+// SyncRepositoryMirrorHandler interface for that can handle valid sync repository mirror params
+type SyncRepositoryMirrorHandler interface {
+	Handle(SyncRepositoryMirrorParams) middleware.Responder
+}
+
+// NewSyncRepositoryMirror creates a new http.Handler for the sync repository mirror operation
+func NewSyncRepositoryMirror(ctx *middleware.Context, handler SyncRepositoryMirrorHandler) *SyncRepositoryMirror {
+	return &SyncRepositoryMirror{Context: ctx, Handler: handler}
+}
+
+/*SyncRepositoryMirror swagger:route POST /repositories/{owner}/{name}/mirrors/{id}/sync mirrors syncRepositoryMirror
+
+Sync a mirror now
+
+The mirror is synced in the background, its last_success_at or last_failure_at change once it's done.
+
+*/
+type SyncRepositoryMirror struct {
+	Context *middleware.Context
+	Handler SyncRepositoryMirrorHandler
+}
+
+func (o *SyncRepositoryMirror) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewSyncRepositoryMirrorParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,204 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mirrors
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// SyncRepositoryMirrorAcceptedCode is the HTTP code returned for type SyncRepositoryMirrorAccepted
+const SyncRepositoryMirrorAcceptedCode int = 202
+
+/*SyncRepositoryMirrorAccepted The sync has been started
+
+swagger:response syncRepositoryMirrorAccepted
+*/
+type SyncRepositoryMirrorAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Mirror `json:"body,omitempty"`
+}
+
+// NewSyncRepositoryMirrorAccepted creates SyncRepositoryMirrorAccepted with default headers values
+func NewSyncRepositoryMirrorAccepted() *SyncRepositoryMirrorAccepted {
+
+	return &SyncRepositoryMirrorAccepted{}
+}
+
+// WithPayload adds the payload to the sync repository mirror accepted response
+func (o *SyncRepositoryMirrorAccepted) WithPayload(payload *models.Mirror) *SyncRepositoryMirrorAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the sync repository mirror accepted response
+func (o *SyncRepositoryMirrorAccepted) SetPayload(payload *models.Mirror) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SyncRepositoryMirrorAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SyncRepositoryMirrorForbiddenCode is the HTTP code returned for type SyncRepositoryMirrorForbidden
+const SyncRepositoryMirrorForbiddenCode int = 403
+
+/*SyncRepositoryMirrorForbidden Only the repository's owner can sync mirrors
+
+swagger:response syncRepositoryMirrorForbidden
+*/
+type SyncRepositoryMirrorForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSyncRepositoryMirrorForbidden creates SyncRepositoryMirrorForbidden with default headers values
+func NewSyncRepositoryMirrorForbidden() *SyncRepositoryMirrorForbidden {
+
+	return &SyncRepositoryMirrorForbidden{}
+}
+
+// WithPayload adds the payload to the sync repository mirror forbidden response
+func (o *SyncRepositoryMirrorForbidden) WithPayload(payload *models.Error) *SyncRepositoryMirrorForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the sync repository mirror forbidden response
+func (o *SyncRepositoryMirrorForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SyncRepositoryMirrorForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SyncRepositoryMirrorNotFoundCode is the HTTP code returned for type SyncRepositoryMirrorNotFound
+const SyncRepositoryMirrorNotFoundCode int = 404
+
+/*SyncRepositoryMirrorNotFound The mirror could not be found
+
+swagger:response syncRepositoryMirrorNotFound
+*/
+type SyncRepositoryMirrorNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSyncRepositoryMirrorNotFound creates SyncRepositoryMirrorNotFound with default headers values
+func NewSyncRepositoryMirrorNotFound() *SyncRepositoryMirrorNotFound {
+
+	return &SyncRepositoryMirrorNotFound{}
+}
+
+// WithPayload adds the payload to the sync repository mirror not found response
+func (o *SyncRepositoryMirrorNotFound) WithPayload(payload *models.Error) *SyncRepositoryMirrorNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the sync repository mirror not found response
+func (o *SyncRepositoryMirrorNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SyncRepositoryMirrorNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SyncRepositoryMirrorDefault unexpected error
+
+swagger:response syncRepositoryMirrorDefault
+*/
+type SyncRepositoryMirrorDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSyncRepositoryMirrorDefault creates SyncRepositoryMirrorDefault with default headers values
+func NewSyncRepositoryMirrorDefault(code int) *SyncRepositoryMirrorDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SyncRepositoryMirrorDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the sync repository mirror default response
+func (o *SyncRepositoryMirrorDefault) WithStatusCode(code int) *SyncRepositoryMirrorDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the sync repository mirror default response
+func (o *SyncRepositoryMirrorDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the sync repository mirror default response
+func (o *SyncRepositoryMirrorDefault) WithPayload(payload *models.Error) *SyncRepositoryMirrorDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the sync repository mirror default response
+func (o *SyncRepositoryMirrorDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SyncRepositoryMirrorDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
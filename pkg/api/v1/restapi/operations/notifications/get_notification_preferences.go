@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package notifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// GetNotificationPreferencesHandlerFunc turns a function with the right signature into a get notification preferences handler
+type GetNotificationPreferencesHandlerFunc func(GetNotificationPreferencesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetNotificationPreferencesHandlerFunc) Handle(params GetNotificationPreferencesParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetNotificationPreferencesHandler interface for that can handle valid get notification preferences params
+type GetNotificationPreferencesHandler interface {
+	Handle(GetNotificationPreferencesParams) middleware.Responder
+}
+
+// NewGetNotificationPreferences creates a new http.Handler for the get notification preferences operation
+func NewGetNotificationPreferences(ctx *middleware.Context, handler GetNotificationPreferencesHandler) *GetNotificationPreferences {
+	return &GetNotificationPreferences{Context: ctx, Handler: handler}
+}
+
+/*GetNotificationPreferences swagger:route GET /notifications/preferences notifications getNotificationPreferences
+
+Get how the current user's notifications are delivered for every reason
+
+*/
+type GetNotificationPreferences struct {
+	Context *middleware.Context
+	Handler GetNotificationPreferencesHandler
+}
+
+func (o *GetNotificationPreferences) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetNotificationPreferencesParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package notifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// GetNotificationPreferencesOKCode is the HTTP code returned for type GetNotificationPreferencesOK
+const GetNotificationPreferencesOKCode int = 200
+
+/*GetNotificationPreferencesOK The delivery for every reason
+
+swagger:response getNotificationPreferencesOK
+*/
+type GetNotificationPreferencesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.NotificationPreference `json:"body,omitempty"`
+}
+
+// NewGetNotificationPreferencesOK creates GetNotificationPreferencesOK with default headers values
+func NewGetNotificationPreferencesOK() *GetNotificationPreferencesOK {
+
+	return &GetNotificationPreferencesOK{}
+}
+
+// WithPayload adds the payload to the get notification preferences o k response
+func (o *GetNotificationPreferencesOK) WithPayload(payload []*models.NotificationPreference) *GetNotificationPreferencesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get notification preferences o k response
+func (o *GetNotificationPreferencesOK) SetPayload(payload []*models.NotificationPreference) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetNotificationPreferencesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.NotificationPreference, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+/*GetNotificationPreferencesDefault unexpected error
+
+swagger:response getNotificationPreferencesDefault
+*/
+type GetNotificationPreferencesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetNotificationPreferencesDefault creates GetNotificationPreferencesDefault with default headers values
+func NewGetNotificationPreferencesDefault(code int) *GetNotificationPreferencesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetNotificationPreferencesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get notification preferences default response
+func (o *GetNotificationPreferencesDefault) WithStatusCode(code int) *GetNotificationPreferencesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get notification preferences default response
+func (o *GetNotificationPreferencesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get notification preferences default response
+func (o *GetNotificationPreferencesDefault) WithPayload(payload *models.Error) *GetNotificationPreferencesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get notification preferences default response
+func (o *GetNotificationPreferencesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetNotificationPreferencesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
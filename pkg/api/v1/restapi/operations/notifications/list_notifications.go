@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package notifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// ListNotificationsHandlerFunc turns a function with the right signature into a list notifications handler
+type ListNotificationsHandlerFunc func(ListNotificationsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListNotificationsHandlerFunc) Handle(params ListNotificationsParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListNotificationsHandler interface for that can handle valid list notifications params
+type ListNotificationsHandler interface {
+	Handle(ListNotificationsParams) middleware.Responder
+}
+
+// NewListNotifications creates a new http.Handler for the list notifications operation
+func NewListNotifications(ctx *middleware.Context, handler ListNotificationsHandler) *ListNotifications {
+	return &ListNotifications{Context: ctx, Handler: handler}
+}
+
+/*ListNotifications swagger:route GET /notifications notifications listNotifications
+
+Get the notifications of the current user, most recent first
+
+*/
+type ListNotifications struct {
+	Context *middleware.Context
+	Handler ListNotificationsHandler
+}
+
+func (o *ListNotifications) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListNotificationsParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
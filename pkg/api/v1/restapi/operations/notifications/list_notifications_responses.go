@@ -0,0 +1,207 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package notifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListNotificationsOKCode is the HTTP code returned for type ListNotificationsOK
+const ListNotificationsOKCode int = 200
+
+/*ListNotificationsOK The notifications
+
+swagger:response listNotificationsOK
+*/
+type ListNotificationsOK struct {
+	/*The URL of the next page with rel="next", missing on the last page
+
+	 */
+	Link string `json:"Link"`
+	/*The cursor of the next page, missing on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Notification `json:"body,omitempty"`
+}
+
+// NewListNotificationsOK creates ListNotificationsOK with default headers values
+func NewListNotificationsOK() *ListNotificationsOK {
+
+	return &ListNotificationsOK{}
+}
+
+// WithLink adds the link to the list notifications o k response
+func (o *ListNotificationsOK) WithLink(link string) *ListNotificationsOK {
+	o.Link = link
+	return o
+}
+
+// SetLink sets the link to the list notifications o k response
+func (o *ListNotificationsOK) SetLink(link string) {
+	o.Link = link
+}
+
+// WithXNextCursor adds the xNextCursor to the list notifications o k response
+func (o *ListNotificationsOK) WithXNextCursor(xNextCursor string) *ListNotificationsOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the list notifications o k response
+func (o *ListNotificationsOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
+// WithPayload adds the payload to the list notifications o k response
+func (o *ListNotificationsOK) WithPayload(payload []*models.Notification) *ListNotificationsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list notifications o k response
+func (o *ListNotificationsOK) SetPayload(payload []*models.Notification) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListNotificationsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Link
+
+	link := o.Link
+	if link != "" {
+		rw.Header().Set("Link", link)
+	}
+
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Notification, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListNotificationsUnprocessableEntityCode is the HTTP code returned for type ListNotificationsUnprocessableEntity
+const ListNotificationsUnprocessableEntityCode int = 422
+
+/*ListNotificationsUnprocessableEntity The cursor is not valid
+
+swagger:response listNotificationsUnprocessableEntity
+*/
+type ListNotificationsUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListNotificationsUnprocessableEntity creates ListNotificationsUnprocessableEntity with default headers values
+func NewListNotificationsUnprocessableEntity() *ListNotificationsUnprocessableEntity {
+
+	return &ListNotificationsUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the list notifications unprocessable entity response
+func (o *ListNotificationsUnprocessableEntity) WithPayload(payload *models.Error) *ListNotificationsUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list notifications unprocessable entity response
+func (o *ListNotificationsUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListNotificationsUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListNotificationsDefault unexpected error
+
+swagger:response listNotificationsDefault
+*/
+type ListNotificationsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListNotificationsDefault creates ListNotificationsDefault with default headers values
+func NewListNotificationsDefault(code int) *ListNotificationsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListNotificationsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list notifications default response
+func (o *ListNotificationsDefault) WithStatusCode(code int) *ListNotificationsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list notifications default response
+func (o *ListNotificationsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list notifications default response
+func (o *ListNotificationsDefault) WithPayload(payload *models.Error) *ListNotificationsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list notifications default response
+func (o *ListNotificationsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListNotificationsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package notifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
+)
+
+// ListNotificationsURL generates an URL for the list notifications operation
+type ListNotificationsURL struct {
+	All     *bool
+	Cursor  *string
+	PerPage *int64
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListNotificationsURL) WithBasePath(bp string) *ListNotificationsURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListNotificationsURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *ListNotificationsURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/notifications"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var all string
+	if o.All != nil {
+		all = swag.FormatBool(*o.All)
+	}
+	if all != "" {
+		qs.Set("all", all)
+	}
+
+	var cursor string
+	if o.Cursor != nil {
+		cursor = *o.Cursor
+	}
+	if cursor != "" {
+		qs.Set("cursor", cursor)
+	}
+
+	var perPage string
+	if o.PerPage != nil {
+		perPage = swag.FormatInt64(*o.PerPage)
+	}
+	if perPage != "" {
+		qs.Set("per_page", perPage)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *ListNotificationsURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *ListNotificationsURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *ListNotificationsURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on ListNotificationsURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on ListNotificationsURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *ListNotificationsURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package notifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// MarkNotificationReadHandlerFunc turns a function with the right signature into a mark notification read handler
+type MarkNotificationReadHandlerFunc func(MarkNotificationReadParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn MarkNotificationReadHandlerFunc) Handle(params MarkNotificationReadParams) middleware.Responder {
+	return fn(params)
+}
+
+// MarkNotificationReadHandler interface for that can handle valid mark notification read params
+type MarkNotificationReadHandler interface {
+	Handle(MarkNotificationReadParams) middleware.Responder
+}
+
+// NewMarkNotificationRead creates a new http.Handler for the mark notification read operation
+func NewMarkNotificationRead(ctx *middleware.Context, handler MarkNotificationReadHandler) *MarkNotificationRead {
+	return &MarkNotificationRead{Context: ctx, Handler: handler}
+}
+
+/*MarkNotificationRead swagger:route PATCH /notifications/threads/{id} notifications markNotificationRead
+
+Mark a notification of the current user as read
+
+*/
+type MarkNotificationRead struct {
+	Context *middleware.Context
+	Handler MarkNotificationReadHandler
+}
+
+func (o *MarkNotificationRead) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewMarkNotificationReadParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,140 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package notifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// MarkNotificationReadNoContentCode is the HTTP code returned for type MarkNotificationReadNoContent
+const MarkNotificationReadNoContentCode int = 204
+
+/*MarkNotificationReadNoContent The notification is read
+
+swagger:response markNotificationReadNoContent
+*/
+type MarkNotificationReadNoContent struct {
+}
+
+// NewMarkNotificationReadNoContent creates MarkNotificationReadNoContent with default headers values
+func NewMarkNotificationReadNoContent() *MarkNotificationReadNoContent {
+
+	return &MarkNotificationReadNoContent{}
+}
+
+// WriteResponse to the client
+func (o *MarkNotificationReadNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// MarkNotificationReadNotFoundCode is the HTTP code returned for type MarkNotificationReadNotFound
+const MarkNotificationReadNotFoundCode int = 404
+
+/*MarkNotificationReadNotFound The notification could not be found
+
+swagger:response markNotificationReadNotFound
+*/
+type MarkNotificationReadNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewMarkNotificationReadNotFound creates MarkNotificationReadNotFound with default headers values
+func NewMarkNotificationReadNotFound() *MarkNotificationReadNotFound {
+
+	return &MarkNotificationReadNotFound{}
+}
+
+// WithPayload adds the payload to the mark notification read not found response
+func (o *MarkNotificationReadNotFound) WithPayload(payload *models.Error) *MarkNotificationReadNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the mark notification read not found response
+func (o *MarkNotificationReadNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MarkNotificationReadNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*MarkNotificationReadDefault unexpected error
+
+swagger:response markNotificationReadDefault
+*/
+type MarkNotificationReadDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewMarkNotificationReadDefault creates MarkNotificationReadDefault with default headers values
+func NewMarkNotificationReadDefault(code int) *MarkNotificationReadDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &MarkNotificationReadDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the mark notification read default response
+func (o *MarkNotificationReadDefault) WithStatusCode(code int) *MarkNotificationReadDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the mark notification read default response
+func (o *MarkNotificationReadDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the mark notification read default response
+func (o *MarkNotificationReadDefault) WithPayload(payload *models.Error) *MarkNotificationReadDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the mark notification read default response
+func (o *MarkNotificationReadDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MarkNotificationReadDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package notifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// MarkNotificationsReadHandlerFunc turns a function with the right signature into a mark notifications read handler
+type MarkNotificationsReadHandlerFunc func(MarkNotificationsReadParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn MarkNotificationsReadHandlerFunc) Handle(params MarkNotificationsReadParams) middleware.Responder {
+	return fn(params)
+}
+
+// MarkNotificationsReadHandler interface for that can handle valid mark notifications read params
+type MarkNotificationsReadHandler interface {
+	Handle(MarkNotificationsReadParams) middleware.Responder
+}
+
+// NewMarkNotificationsRead creates a new http.Handler for the mark notifications read operation
+func NewMarkNotificationsRead(ctx *middleware.Context, handler MarkNotificationsReadHandler) *MarkNotificationsRead {
+	return &MarkNotificationsRead{Context: ctx, Handler: handler}
+}
+
+/*MarkNotificationsRead swagger:route PUT /notifications notifications markNotificationsRead
+
+Mark all notifications of the current user as read
+
+*/
+type MarkNotificationsRead struct {
+	Context *middleware.Context
+	Handler MarkNotificationsReadHandler
+}
+
+func (o *MarkNotificationsRead) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewMarkNotificationsReadParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package notifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// MarkNotificationsReadNoContentCode is the HTTP code returned for type MarkNotificationsReadNoContent
+const MarkNotificationsReadNoContentCode int = 204
+
+/*MarkNotificationsReadNoContent All notifications are read
+
+swagger:response markNotificationsReadNoContent
+*/
+type MarkNotificationsReadNoContent struct {
+}
+
+// NewMarkNotificationsReadNoContent creates MarkNotificationsReadNoContent with default headers values
+func NewMarkNotificationsReadNoContent() *MarkNotificationsReadNoContent {
+
+	return &MarkNotificationsReadNoContent{}
+}
+
+// WriteResponse to the client
+func (o *MarkNotificationsReadNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*MarkNotificationsReadDefault unexpected error
+
+swagger:response markNotificationsReadDefault
+*/
+type MarkNotificationsReadDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewMarkNotificationsReadDefault creates MarkNotificationsReadDefault with default headers values
+func NewMarkNotificationsReadDefault(code int) *MarkNotificationsReadDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &MarkNotificationsReadDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the mark notifications read default response
+func (o *MarkNotificationsReadDefault) WithStatusCode(code int) *MarkNotificationsReadDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the mark notifications read default response
+func (o *MarkNotificationsReadDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the mark notifications read default response
+func (o *MarkNotificationsReadDefault) WithPayload(payload *models.Error) *MarkNotificationsReadDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the mark notifications read default response
+func (o *MarkNotificationsReadDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MarkNotificationsReadDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package notifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// UpdateNotificationPreferencesHandlerFunc turns a function with the right signature into a update notification preferences handler
+type UpdateNotificationPreferencesHandlerFunc func(UpdateNotificationPreferencesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateNotificationPreferencesHandlerFunc) Handle(params UpdateNotificationPreferencesParams) middleware.Responder {
+	return fn(params)
+}
+
+// UpdateNotificationPreferencesHandler interface for that can handle valid update notification preferences params
+type UpdateNotificationPreferencesHandler interface {
+	Handle(UpdateNotificationPreferencesParams) middleware.Responder
+}
+
+// NewUpdateNotificationPreferences creates a new http.Handler for the update notification preferences operation
+func NewUpdateNotificationPreferences(ctx *middleware.Context, handler UpdateNotificationPreferencesHandler) *UpdateNotificationPreferences {
+	return &UpdateNotificationPreferences{Context: ctx, Handler: handler}
+}
+
+/*UpdateNotificationPreferences swagger:route PUT /notifications/preferences notifications updateNotificationPreferences
+
+Change how the current user's notifications are delivered for the given reasons
+
+*/
+type UpdateNotificationPreferences struct {
+	Context *middleware.Context
+	Handler UpdateNotificationPreferencesHandler
+}
+
+func (o *UpdateNotificationPreferences) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewUpdateNotificationPreferencesParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
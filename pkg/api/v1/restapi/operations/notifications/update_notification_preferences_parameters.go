@@ -0,0 +1,82 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package notifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// NewUpdateNotificationPreferencesParams creates a new UpdateNotificationPreferencesParams object
+// no default values defined in spec.
+func NewUpdateNotificationPreferencesParams() UpdateNotificationPreferencesParams {
+
+	return UpdateNotificationPreferencesParams{}
+}
+
+// UpdateNotificationPreferencesParams contains all the bound params for the update notification preferences operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters updateNotificationPreferences
+type UpdateNotificationPreferencesParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: body
+	*/
+	Preferences []*models.NotificationPreference
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewUpdateNotificationPreferencesParams() beforehand.
+func (o *UpdateNotificationPreferencesParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body []*models.NotificationPreference
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("preferences", "body"))
+			} else {
+				res = append(res, errors.NewParseError("preferences", "body", "", err))
+			}
+		} else {
+			// validate array of body objects
+			for i := range body {
+				if body[i] == nil {
+					continue
+				}
+				if err := body[i].Validate(route.Formats); err != nil {
+					res = append(res, err)
+					break
+				}
+			}
+			if len(res) == 0 {
+				o.Preferences = body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("preferences", "body"))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
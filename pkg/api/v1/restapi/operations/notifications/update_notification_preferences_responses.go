@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package notifications
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// UpdateNotificationPreferencesOKCode is the HTTP code returned for type UpdateNotificationPreferencesOK
+const UpdateNotificationPreferencesOKCode int = 200
+
+/*UpdateNotificationPreferencesOK The delivery for every reason
+
+swagger:response updateNotificationPreferencesOK
+*/
+type UpdateNotificationPreferencesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.NotificationPreference `json:"body,omitempty"`
+}
+
+// NewUpdateNotificationPreferencesOK creates UpdateNotificationPreferencesOK with default headers values
+func NewUpdateNotificationPreferencesOK() *UpdateNotificationPreferencesOK {
+
+	return &UpdateNotificationPreferencesOK{}
+}
+
+// WithPayload adds the payload to the update notification preferences o k response
+func (o *UpdateNotificationPreferencesOK) WithPayload(payload []*models.NotificationPreference) *UpdateNotificationPreferencesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update notification preferences o k response
+func (o *UpdateNotificationPreferencesOK) SetPayload(payload []*models.NotificationPreference) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateNotificationPreferencesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.NotificationPreference, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// UpdateNotificationPreferencesUnprocessableEntityCode is the HTTP code returned for type UpdateNotificationPreferencesUnprocessableEntity
+const UpdateNotificationPreferencesUnprocessableEntityCode int = 422
+
+/*UpdateNotificationPreferencesUnprocessableEntity A reason is not valid
+
+swagger:response updateNotificationPreferencesUnprocessableEntity
+*/
+type UpdateNotificationPreferencesUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateNotificationPreferencesUnprocessableEntity creates UpdateNotificationPreferencesUnprocessableEntity with default headers values
+func NewUpdateNotificationPreferencesUnprocessableEntity() *UpdateNotificationPreferencesUnprocessableEntity {
+
+	return &UpdateNotificationPreferencesUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the update notification preferences unprocessable entity response
+func (o *UpdateNotificationPreferencesUnprocessableEntity) WithPayload(payload *models.Error) *UpdateNotificationPreferencesUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update notification preferences unprocessable entity response
+func (o *UpdateNotificationPreferencesUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateNotificationPreferencesUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdateNotificationPreferencesDefault unexpected error
+
+swagger:response updateNotificationPreferencesDefault
+*/
+type UpdateNotificationPreferencesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateNotificationPreferencesDefault creates UpdateNotificationPreferencesDefault with default headers values
+func NewUpdateNotificationPreferencesDefault(code int) *UpdateNotificationPreferencesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdateNotificationPreferencesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update notification preferences default response
+func (o *UpdateNotificationPreferencesDefault) WithStatusCode(code int) *UpdateNotificationPreferencesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update notification preferences default response
+func (o *UpdateNotificationPreferencesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update notification preferences default response
+func (o *UpdateNotificationPreferencesDefault) WithPayload(payload *models.Error) *UpdateNotificationPreferencesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update notification preferences default response
+func (o *UpdateNotificationPreferencesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateNotificationPreferencesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+	validate "github.com/go-openapi/validate"
+)
+
+// CreatePullRequestHandlerFunc turns a function with the right signature into a create pull request handler
+type CreatePullRequestHandlerFunc func(CreatePullRequestParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreatePullRequestHandlerFunc) Handle(params CreatePullRequestParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreatePullRequestHandler interface for that can handle valid create pull request params
+type CreatePullRequestHandler interface {
+	Handle(CreatePullRequestParams) middleware.Responder
+}
+
+// NewCreatePullRequest creates a new http.Handler for the create pull request operation
+func NewCreatePullRequest(ctx *middleware.Context, handler CreatePullRequestHandler) *CreatePullRequest {
+	return &CreatePullRequest{Context: ctx, Handler: handler}
+}
+
+/*CreatePullRequest swagger:route POST /repositories/{owner}/{name}/pulls pullrequests createPullRequest
+
+Open a pull request to merge a branch of the repository or one of its forks
+
+*/
+type CreatePullRequest struct {
+	Context *middleware.Context
+	Handler CreatePullRequestHandler
+}
+
+func (o *CreatePullRequest) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCreatePullRequestParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// CreatePullRequestBody create pull request body
+// swagger:model CreatePullRequestBody
+type CreatePullRequestBody struct {
+
+	// body
+	Body string `json:"body,omitempty"`
+
+	// source branch
+	// Required: true
+	SourceBranch *string `json:"source_branch"`
+
+	// The owner of the source repository, by default the repository's owner
+	SourceOwner string `json:"source_owner,omitempty"`
+
+	// The name of the source repository, by default the repository's name
+	SourceRepository string `json:"source_repository,omitempty"`
+
+	// target branch
+	// Required: true
+	TargetBranch *string `json:"target_branch"`
+
+	// title
+	// Required: true
+	Title *string `json:"title"`
+}
+
+// Validate validates this create pull request body
+func (o *CreatePullRequestBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateSourceBranch(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateTargetBranch(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateTitle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreatePullRequestBody) validateSourceBranch(formats strfmt.Registry) error {
+
+	if err := validate.Required("pullRequest"+"."+"source_branch", "body", o.SourceBranch); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreatePullRequestBody) validateTargetBranch(formats strfmt.Registry) error {
+
+	if err := validate.Required("pullRequest"+"."+"target_branch", "body", o.TargetBranch); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *CreatePullRequestBody) validateTitle(formats strfmt.Registry) error {
+
+	if err := validate.Required("pullRequest"+"."+"title", "body", o.Title); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreatePullRequestBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreatePullRequestBody) UnmarshalBinary(b []byte) error {
+	var res CreatePullRequestBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+	validate "github.com/go-openapi/validate"
+)
+
+// CreatePullRequestCommentHandlerFunc turns a function with the right signature into a create pull request comment handler
+type CreatePullRequestCommentHandlerFunc func(CreatePullRequestCommentParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreatePullRequestCommentHandlerFunc) Handle(params CreatePullRequestCommentParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreatePullRequestCommentHandler interface for that can handle valid create pull request comment params
+type CreatePullRequestCommentHandler interface {
+	Handle(CreatePullRequestCommentParams) middleware.Responder
+}
+
+// NewCreatePullRequestComment creates a new http.Handler for the create pull request comment operation
+func NewCreatePullRequestComment(ctx *middleware.Context, handler CreatePullRequestCommentHandler) *CreatePullRequestComment {
+	return &CreatePullRequestComment{Context: ctx, Handler: handler}
+}
+
+/*CreatePullRequestComment swagger:route POST /repositories/{owner}/{name}/pulls/{number}/comments pullrequests createPullRequestComment
+
+Comment on a pull request or on a line of a file it changes
+
+*/
+type CreatePullRequestComment struct {
+	Context *middleware.Context
+	Handler CreatePullRequestCommentHandler
+}
+
+func (o *CreatePullRequestComment) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCreatePullRequestCommentParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// CreatePullRequestCommentBody create pull request comment body
+// swagger:model CreatePullRequestCommentBody
+type CreatePullRequestCommentBody struct {
+
+	// body
+	// Required: true
+	Body *string `json:"body"`
+
+	// The line of the file at the pull request's head
+	Line int64 `json:"line,omitempty"`
+
+	// The file a review comment is anchored to
+	Path string `json:"path,omitempty"`
+}
+
+// Validate validates this create pull request comment body
+func (o *CreatePullRequestCommentBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateBody(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreatePullRequestCommentBody) validateBody(formats strfmt.Registry) error {
+
+	if err := validate.Required("comment"+"."+"body", "body", o.Body); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreatePullRequestCommentBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreatePullRequestCommentBody) UnmarshalBinary(b []byte) error {
+	var res CreatePullRequestCommentBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
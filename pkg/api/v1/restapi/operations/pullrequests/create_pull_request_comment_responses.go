@@ -0,0 +1,204 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// CreatePullRequestCommentOKCode is the HTTP code returned for type CreatePullRequestCommentOK
+const CreatePullRequestCommentOKCode int = 200
+
+/*CreatePullRequestCommentOK The comment has been created
+
+swagger:response createPullRequestCommentOK
+*/
+type CreatePullRequestCommentOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PullRequestComment `json:"body,omitempty"`
+}
+
+// NewCreatePullRequestCommentOK creates CreatePullRequestCommentOK with default headers values
+func NewCreatePullRequestCommentOK() *CreatePullRequestCommentOK {
+
+	return &CreatePullRequestCommentOK{}
+}
+
+// WithPayload adds the payload to the create pull request comment o k response
+func (o *CreatePullRequestCommentOK) WithPayload(payload *models.PullRequestComment) *CreatePullRequestCommentOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create pull request comment o k response
+func (o *CreatePullRequestCommentOK) SetPayload(payload *models.PullRequestComment) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePullRequestCommentOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreatePullRequestCommentNotFoundCode is the HTTP code returned for type CreatePullRequestCommentNotFound
+const CreatePullRequestCommentNotFoundCode int = 404
+
+/*CreatePullRequestCommentNotFound The pull request could not be found
+
+swagger:response createPullRequestCommentNotFound
+*/
+type CreatePullRequestCommentNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreatePullRequestCommentNotFound creates CreatePullRequestCommentNotFound with default headers values
+func NewCreatePullRequestCommentNotFound() *CreatePullRequestCommentNotFound {
+
+	return &CreatePullRequestCommentNotFound{}
+}
+
+// WithPayload adds the payload to the create pull request comment not found response
+func (o *CreatePullRequestCommentNotFound) WithPayload(payload *models.Error) *CreatePullRequestCommentNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create pull request comment not found response
+func (o *CreatePullRequestCommentNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePullRequestCommentNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreatePullRequestCommentUnprocessableEntityCode is the HTTP code returned for type CreatePullRequestCommentUnprocessableEntity
+const CreatePullRequestCommentUnprocessableEntityCode int = 422
+
+/*CreatePullRequestCommentUnprocessableEntity The comment has not been created due to invalid input
+
+swagger:response createPullRequestCommentUnprocessableEntity
+*/
+type CreatePullRequestCommentUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewCreatePullRequestCommentUnprocessableEntity creates CreatePullRequestCommentUnprocessableEntity with default headers values
+func NewCreatePullRequestCommentUnprocessableEntity() *CreatePullRequestCommentUnprocessableEntity {
+
+	return &CreatePullRequestCommentUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the create pull request comment unprocessable entity response
+func (o *CreatePullRequestCommentUnprocessableEntity) WithPayload(payload *models.ValidationError) *CreatePullRequestCommentUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create pull request comment unprocessable entity response
+func (o *CreatePullRequestCommentUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePullRequestCommentUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreatePullRequestCommentDefault unexpected error
+
+swagger:response createPullRequestCommentDefault
+*/
+type CreatePullRequestCommentDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreatePullRequestCommentDefault creates CreatePullRequestCommentDefault with default headers values
+func NewCreatePullRequestCommentDefault(code int) *CreatePullRequestCommentDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreatePullRequestCommentDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create pull request comment default response
+func (o *CreatePullRequestCommentDefault) WithStatusCode(code int) *CreatePullRequestCommentDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create pull request comment default response
+func (o *CreatePullRequestCommentDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create pull request comment default response
+func (o *CreatePullRequestCommentDefault) WithPayload(payload *models.Error) *CreatePullRequestCommentDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create pull request comment default response
+func (o *CreatePullRequestCommentDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePullRequestCommentDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,204 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// CreatePullRequestOKCode is the HTTP code returned for type CreatePullRequestOK
+const CreatePullRequestOKCode int = 200
+
+/*CreatePullRequestOK The pull request has been opened
+
+swagger:response createPullRequestOK
+*/
+type CreatePullRequestOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PullRequest `json:"body,omitempty"`
+}
+
+// NewCreatePullRequestOK creates CreatePullRequestOK with default headers values
+func NewCreatePullRequestOK() *CreatePullRequestOK {
+
+	return &CreatePullRequestOK{}
+}
+
+// WithPayload adds the payload to the create pull request o k response
+func (o *CreatePullRequestOK) WithPayload(payload *models.PullRequest) *CreatePullRequestOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create pull request o k response
+func (o *CreatePullRequestOK) SetPayload(payload *models.PullRequest) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePullRequestOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreatePullRequestNotFoundCode is the HTTP code returned for type CreatePullRequestNotFound
+const CreatePullRequestNotFoundCode int = 404
+
+/*CreatePullRequestNotFound The owner and name combination could not be found
+
+swagger:response createPullRequestNotFound
+*/
+type CreatePullRequestNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreatePullRequestNotFound creates CreatePullRequestNotFound with default headers values
+func NewCreatePullRequestNotFound() *CreatePullRequestNotFound {
+
+	return &CreatePullRequestNotFound{}
+}
+
+// WithPayload adds the payload to the create pull request not found response
+func (o *CreatePullRequestNotFound) WithPayload(payload *models.Error) *CreatePullRequestNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create pull request not found response
+func (o *CreatePullRequestNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePullRequestNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreatePullRequestUnprocessableEntityCode is the HTTP code returned for type CreatePullRequestUnprocessableEntity
+const CreatePullRequestUnprocessableEntityCode int = 422
+
+/*CreatePullRequestUnprocessableEntity The pull request has not been opened due to invalid input
+
+swagger:response createPullRequestUnprocessableEntity
+*/
+type CreatePullRequestUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewCreatePullRequestUnprocessableEntity creates CreatePullRequestUnprocessableEntity with default headers values
+func NewCreatePullRequestUnprocessableEntity() *CreatePullRequestUnprocessableEntity {
+
+	return &CreatePullRequestUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the create pull request unprocessable entity response
+func (o *CreatePullRequestUnprocessableEntity) WithPayload(payload *models.ValidationError) *CreatePullRequestUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create pull request unprocessable entity response
+func (o *CreatePullRequestUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePullRequestUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreatePullRequestDefault unexpected error
+
+swagger:response createPullRequestDefault
+*/
+type CreatePullRequestDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreatePullRequestDefault creates CreatePullRequestDefault with default headers values
+func NewCreatePullRequestDefault(code int) *CreatePullRequestDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreatePullRequestDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create pull request default response
+func (o *CreatePullRequestDefault) WithStatusCode(code int) *CreatePullRequestDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create pull request default response
+func (o *CreatePullRequestDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create pull request default response
+func (o *CreatePullRequestDefault) WithPayload(payload *models.Error) *CreatePullRequestDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create pull request default response
+func (o *CreatePullRequestDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePullRequestDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
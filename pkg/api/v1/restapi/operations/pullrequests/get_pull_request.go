@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// GetPullRequestHandlerFunc turns a function with the right signature into a get pull request handler
+type GetPullRequestHandlerFunc func(GetPullRequestParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetPullRequestHandlerFunc) Handle(params GetPullRequestParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetPullRequestHandler interface for that can handle valid get pull request params
+type GetPullRequestHandler interface {
+	Handle(GetPullRequestParams) middleware.Responder
+}
+
+// NewGetPullRequest creates a new http.Handler for the get pull request operation
+func NewGetPullRequest(ctx *middleware.Context, handler GetPullRequestHandler) *GetPullRequest {
+	return &GetPullRequest{Context: ctx, Handler: handler}
+}
+
+/*GetPullRequest swagger:route GET /repositories/{owner}/{name}/pulls/{number} pullrequests getPullRequest
+
+Get a pull request by its number
+
+*/
+type GetPullRequest struct {
+	Context *middleware.Context
+	Handler GetPullRequestHandler
+}
+
+func (o *GetPullRequest) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetPullRequestParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// GetPullRequestCommitsHandlerFunc turns a function with the right signature into a get pull request commits handler
+type GetPullRequestCommitsHandlerFunc func(GetPullRequestCommitsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetPullRequestCommitsHandlerFunc) Handle(params GetPullRequestCommitsParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetPullRequestCommitsHandler interface for that can handle valid get pull request commits params
+type GetPullRequestCommitsHandler interface {
+	Handle(GetPullRequestCommitsParams) middleware.Responder
+}
+
+// NewGetPullRequestCommits creates a new http.Handler for the get pull request commits operation
+func NewGetPullRequestCommits(ctx *middleware.Context, handler GetPullRequestCommitsHandler) *GetPullRequestCommits {
+	return &GetPullRequestCommits{Context: ctx, Handler: handler}
+}
+
+/*GetPullRequestCommits swagger:route GET /repositories/{owner}/{name}/pulls/{number}/commits pullrequests getPullRequestCommits
+
+Get the commits of a pull request, oldest first
+
+*/
+type GetPullRequestCommits struct {
+	Context *middleware.Context
+	Handler GetPullRequestCommitsHandler
+}
+
+func (o *GetPullRequestCommits) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetPullRequestCommitsParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
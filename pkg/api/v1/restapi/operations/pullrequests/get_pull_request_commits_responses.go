@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// GetPullRequestCommitsOKCode is the HTTP code returned for type GetPullRequestCommitsOK
+const GetPullRequestCommitsOKCode int = 200
+
+/*GetPullRequestCommitsOK The pull request's commits
+
+swagger:response getPullRequestCommitsOK
+*/
+type GetPullRequestCommitsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Commit `json:"body,omitempty"`
+}
+
+// NewGetPullRequestCommitsOK creates GetPullRequestCommitsOK with default headers values
+func NewGetPullRequestCommitsOK() *GetPullRequestCommitsOK {
+
+	return &GetPullRequestCommitsOK{}
+}
+
+// WithPayload adds the payload to the get pull request commits o k response
+func (o *GetPullRequestCommitsOK) WithPayload(payload []*models.Commit) *GetPullRequestCommitsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pull request commits o k response
+func (o *GetPullRequestCommitsOK) SetPayload(payload []*models.Commit) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPullRequestCommitsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Commit, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// GetPullRequestCommitsNotFoundCode is the HTTP code returned for type GetPullRequestCommitsNotFound
+const GetPullRequestCommitsNotFoundCode int = 404
+
+/*GetPullRequestCommitsNotFound The pull request could not be found
+
+swagger:response getPullRequestCommitsNotFound
+*/
+type GetPullRequestCommitsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPullRequestCommitsNotFound creates GetPullRequestCommitsNotFound with default headers values
+func NewGetPullRequestCommitsNotFound() *GetPullRequestCommitsNotFound {
+
+	return &GetPullRequestCommitsNotFound{}
+}
+
+// WithPayload adds the payload to the get pull request commits not found response
+func (o *GetPullRequestCommitsNotFound) WithPayload(payload *models.Error) *GetPullRequestCommitsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pull request commits not found response
+func (o *GetPullRequestCommitsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPullRequestCommitsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPullRequestCommitsDefault unexpected error
+
+swagger:response getPullRequestCommitsDefault
+*/
+type GetPullRequestCommitsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPullRequestCommitsDefault creates GetPullRequestCommitsDefault with default headers values
+func NewGetPullRequestCommitsDefault(code int) *GetPullRequestCommitsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetPullRequestCommitsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get pull request commits default response
+func (o *GetPullRequestCommitsDefault) WithStatusCode(code int) *GetPullRequestCommitsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get pull request commits default response
+func (o *GetPullRequestCommitsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get pull request commits default response
+func (o *GetPullRequestCommitsDefault) WithPayload(payload *models.Error) *GetPullRequestCommitsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pull request commits default response
+func (o *GetPullRequestCommitsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPullRequestCommitsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
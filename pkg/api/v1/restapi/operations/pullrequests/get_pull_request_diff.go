@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// GetPullRequestDiffHandlerFunc turns a function with the right signature into a get pull request diff handler
+type GetPullRequestDiffHandlerFunc func(GetPullRequestDiffParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetPullRequestDiffHandlerFunc) Handle(params GetPullRequestDiffParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetPullRequestDiffHandler interface for that can handle valid get pull request diff params
+type GetPullRequestDiffHandler interface {
+	Handle(GetPullRequestDiffParams) middleware.Responder
+}
+
+// NewGetPullRequestDiff creates a new http.Handler for the get pull request diff operation
+func NewGetPullRequestDiff(ctx *middleware.Context, handler GetPullRequestDiffHandler) *GetPullRequestDiff {
+	return &GetPullRequestDiff{Context: ctx, Handler: handler}
+}
+
+/*GetPullRequestDiff swagger:route GET /repositories/{owner}/{name}/pulls/{number}/diff pullrequests getPullRequestDiff
+
+Get the changes of a pull request
+
+*/
+type GetPullRequestDiff struct {
+	Context *middleware.Context
+	Handler GetPullRequestDiffHandler
+}
+
+func (o *GetPullRequestDiff) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetPullRequestDiffParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// GetPullRequestDiffOKCode is the HTTP code returned for type GetPullRequestDiffOK
+const GetPullRequestDiffOKCode int = 200
+
+/*GetPullRequestDiffOK The pull request's diff
+
+swagger:response getPullRequestDiffOK
+*/
+type GetPullRequestDiffOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Diff `json:"body,omitempty"`
+}
+
+// NewGetPullRequestDiffOK creates GetPullRequestDiffOK with default headers values
+func NewGetPullRequestDiffOK() *GetPullRequestDiffOK {
+
+	return &GetPullRequestDiffOK{}
+}
+
+// WithPayload adds the payload to the get pull request diff o k response
+func (o *GetPullRequestDiffOK) WithPayload(payload *models.Diff) *GetPullRequestDiffOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pull request diff o k response
+func (o *GetPullRequestDiffOK) SetPayload(payload *models.Diff) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPullRequestDiffOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetPullRequestDiffNotFoundCode is the HTTP code returned for type GetPullRequestDiffNotFound
+const GetPullRequestDiffNotFoundCode int = 404
+
+/*GetPullRequestDiffNotFound The pull request could not be found
+
+swagger:response getPullRequestDiffNotFound
+*/
+type GetPullRequestDiffNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPullRequestDiffNotFound creates GetPullRequestDiffNotFound with default headers values
+func NewGetPullRequestDiffNotFound() *GetPullRequestDiffNotFound {
+
+	return &GetPullRequestDiffNotFound{}
+}
+
+// WithPayload adds the payload to the get pull request diff not found response
+func (o *GetPullRequestDiffNotFound) WithPayload(payload *models.Error) *GetPullRequestDiffNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pull request diff not found response
+func (o *GetPullRequestDiffNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPullRequestDiffNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPullRequestDiffDefault unexpected error
+
+swagger:response getPullRequestDiffDefault
+*/
+type GetPullRequestDiffDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPullRequestDiffDefault creates GetPullRequestDiffDefault with default headers values
+func NewGetPullRequestDiffDefault(code int) *GetPullRequestDiffDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetPullRequestDiffDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get pull request diff default response
+func (o *GetPullRequestDiffDefault) WithStatusCode(code int) *GetPullRequestDiffDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get pull request diff default response
+func (o *GetPullRequestDiffDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get pull request diff default response
+func (o *GetPullRequestDiffDefault) WithPayload(payload *models.Error) *GetPullRequestDiffDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pull request diff default response
+func (o *GetPullRequestDiffDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPullRequestDiffDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// GetPullRequestOKCode is the HTTP code returned for type GetPullRequestOK
+const GetPullRequestOKCode int = 200
+
+/*GetPullRequestOK The pull request with its mergeability if it's open
+
+swagger:response getPullRequestOK
+*/
+type GetPullRequestOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PullRequest `json:"body,omitempty"`
+}
+
+// NewGetPullRequestOK creates GetPullRequestOK with default headers values
+func NewGetPullRequestOK() *GetPullRequestOK {
+
+	return &GetPullRequestOK{}
+}
+
+// WithPayload adds the payload to the get pull request o k response
+func (o *GetPullRequestOK) WithPayload(payload *models.PullRequest) *GetPullRequestOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pull request o k response
+func (o *GetPullRequestOK) SetPayload(payload *models.PullRequest) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPullRequestOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetPullRequestNotFoundCode is the HTTP code returned for type GetPullRequestNotFound
+const GetPullRequestNotFoundCode int = 404
+
+/*GetPullRequestNotFound The pull request could not be found
+
+swagger:response getPullRequestNotFound
+*/
+type GetPullRequestNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPullRequestNotFound creates GetPullRequestNotFound with default headers values
+func NewGetPullRequestNotFound() *GetPullRequestNotFound {
+
+	return &GetPullRequestNotFound{}
+}
+
+// WithPayload adds the payload to the get pull request not found response
+func (o *GetPullRequestNotFound) WithPayload(payload *models.Error) *GetPullRequestNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pull request not found response
+func (o *GetPullRequestNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPullRequestNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPullRequestDefault unexpected error
+
+swagger:response getPullRequestDefault
+*/
+type GetPullRequestDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPullRequestDefault creates GetPullRequestDefault with default headers values
+func NewGetPullRequestDefault(code int) *GetPullRequestDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetPullRequestDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get pull request default response
+func (o *GetPullRequestDefault) WithStatusCode(code int) *GetPullRequestDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get pull request default response
+func (o *GetPullRequestDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get pull request default response
+func (o *GetPullRequestDefault) WithPayload(payload *models.Error) *GetPullRequestDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pull request default response
+func (o *GetPullRequestDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPullRequestDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// ListPullRequestCommentsHandlerFunc turns a function with the right signature into a list pull request comments handler
+type ListPullRequestCommentsHandlerFunc func(ListPullRequestCommentsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListPullRequestCommentsHandlerFunc) Handle(params ListPullRequestCommentsParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListPullRequestCommentsHandler interface for that can handle valid list pull request comments params
+type ListPullRequestCommentsHandler interface {
+	Handle(ListPullRequestCommentsParams) middleware.Responder
+}
+
+// NewListPullRequestComments creates a new http.Handler for the list pull request comments operation
+func NewListPullRequestComments(ctx *middleware.Context, handler ListPullRequestCommentsHandler) *ListPullRequestComments {
+	return &ListPullRequestComments{Context: ctx, Handler: handler}
+}
+
+/*ListPullRequestComments swagger:route GET /repositories/{owner}/{name}/pulls/{number}/comments pullrequests listPullRequestComments
+
+Get the comments of a pull request, oldest first
+
+*/
+type ListPullRequestComments struct {
+	Context *middleware.Context
+	Handler ListPullRequestCommentsHandler
+}
+
+func (o *ListPullRequestComments) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListPullRequestCommentsParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListPullRequestCommentsOKCode is the HTTP code returned for type ListPullRequestCommentsOK
+const ListPullRequestCommentsOKCode int = 200
+
+/*ListPullRequestCommentsOK The pull request's comments
+
+swagger:response listPullRequestCommentsOK
+*/
+type ListPullRequestCommentsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.PullRequestComment `json:"body,omitempty"`
+}
+
+// NewListPullRequestCommentsOK creates ListPullRequestCommentsOK with default headers values
+func NewListPullRequestCommentsOK() *ListPullRequestCommentsOK {
+
+	return &ListPullRequestCommentsOK{}
+}
+
+// WithPayload adds the payload to the list pull request comments o k response
+func (o *ListPullRequestCommentsOK) WithPayload(payload []*models.PullRequestComment) *ListPullRequestCommentsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pull request comments o k response
+func (o *ListPullRequestCommentsOK) SetPayload(payload []*models.PullRequestComment) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPullRequestCommentsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.PullRequestComment, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListPullRequestCommentsNotFoundCode is the HTTP code returned for type ListPullRequestCommentsNotFound
+const ListPullRequestCommentsNotFoundCode int = 404
+
+/*ListPullRequestCommentsNotFound The pull request could not be found
+
+swagger:response listPullRequestCommentsNotFound
+*/
+type ListPullRequestCommentsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPullRequestCommentsNotFound creates ListPullRequestCommentsNotFound with default headers values
+func NewListPullRequestCommentsNotFound() *ListPullRequestCommentsNotFound {
+
+	return &ListPullRequestCommentsNotFound{}
+}
+
+// WithPayload adds the payload to the list pull request comments not found response
+func (o *ListPullRequestCommentsNotFound) WithPayload(payload *models.Error) *ListPullRequestCommentsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pull request comments not found response
+func (o *ListPullRequestCommentsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPullRequestCommentsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListPullRequestCommentsDefault unexpected error
+
+swagger:response listPullRequestCommentsDefault
+*/
+type ListPullRequestCommentsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPullRequestCommentsDefault creates ListPullRequestCommentsDefault with default headers values
+func NewListPullRequestCommentsDefault(code int) *ListPullRequestCommentsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListPullRequestCommentsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list pull request comments default response
+func (o *ListPullRequestCommentsDefault) WithStatusCode(code int) *ListPullRequestCommentsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list pull request comments default response
+func (o *ListPullRequestCommentsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list pull request comments default response
+func (o *ListPullRequestCommentsDefault) WithPayload(payload *models.Error) *ListPullRequestCommentsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pull request comments default response
+func (o *ListPullRequestCommentsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPullRequestCommentsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// ListPullRequestsHandlerFunc turns a function with the right signature into a list pull requests handler
+type ListPullRequestsHandlerFunc func(ListPullRequestsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListPullRequestsHandlerFunc) Handle(params ListPullRequestsParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListPullRequestsHandler interface for that can handle valid list pull requests params
+type ListPullRequestsHandler interface {
+	Handle(ListPullRequestsParams) middleware.Responder
+}
+
+// NewListPullRequests creates a new http.Handler for the list pull requests operation
+func NewListPullRequests(ctx *middleware.Context, handler ListPullRequestsHandler) *ListPullRequests {
+	return &ListPullRequests{Context: ctx, Handler: handler}
+}
+
+/*ListPullRequests swagger:route GET /repositories/{owner}/{name}/pulls pullrequests listPullRequests
+
+Get a repository's pull requests, the most recent first
+
+*/
+type ListPullRequests struct {
+	Context *middleware.Context
+	Handler ListPullRequestsHandler
+}
+
+func (o *ListPullRequests) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListPullRequestsParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListPullRequestsOKCode is the HTTP code returned for type ListPullRequestsOK
+const ListPullRequestsOKCode int = 200
+
+/*ListPullRequestsOK The repository's pull requests
+
+swagger:response listPullRequestsOK
+*/
+type ListPullRequestsOK struct {
+	/*The URL of the next page with rel="next", missing on the last page
+
+	 */
+	Link string `json:"Link"`
+	/*The cursor of the next page, missing on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.PullRequest `json:"body,omitempty"`
+}
+
+// NewListPullRequestsOK creates ListPullRequestsOK with default headers values
+func NewListPullRequestsOK() *ListPullRequestsOK {
+
+	return &ListPullRequestsOK{}
+}
+
+// WithLink adds the link to the list pull requests o k response
+func (o *ListPullRequestsOK) WithLink(link string) *ListPullRequestsOK {
+	o.Link = link
+	return o
+}
+
+// SetLink sets the link to the list pull requests o k response
+func (o *ListPullRequestsOK) SetLink(link string) {
+	o.Link = link
+}
+
+// WithXNextCursor adds the xNextCursor to the list pull requests o k response
+func (o *ListPullRequestsOK) WithXNextCursor(xNextCursor string) *ListPullRequestsOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the list pull requests o k response
+func (o *ListPullRequestsOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
+// WithPayload adds the payload to the list pull requests o k response
+func (o *ListPullRequestsOK) WithPayload(payload []*models.PullRequest) *ListPullRequestsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pull requests o k response
+func (o *ListPullRequestsOK) SetPayload(payload []*models.PullRequest) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPullRequestsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Link
+
+	link := o.Link
+	if link != "" {
+		rw.Header().Set("Link", link)
+	}
+
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.PullRequest, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListPullRequestsNotFoundCode is the HTTP code returned for type ListPullRequestsNotFound
+const ListPullRequestsNotFoundCode int = 404
+
+/*ListPullRequestsNotFound The owner and name combination could not be found
+
+swagger:response listPullRequestsNotFound
+*/
+type ListPullRequestsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPullRequestsNotFound creates ListPullRequestsNotFound with default headers values
+func NewListPullRequestsNotFound() *ListPullRequestsNotFound {
+
+	return &ListPullRequestsNotFound{}
+}
+
+// WithPayload adds the payload to the list pull requests not found response
+func (o *ListPullRequestsNotFound) WithPayload(payload *models.Error) *ListPullRequestsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pull requests not found response
+func (o *ListPullRequestsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPullRequestsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListPullRequestsUnprocessableEntityCode is the HTTP code returned for type ListPullRequestsUnprocessableEntity
+const ListPullRequestsUnprocessableEntityCode int = 422
+
+/*ListPullRequestsUnprocessableEntity The cursor is not valid
+
+swagger:response listPullRequestsUnprocessableEntity
+*/
+type ListPullRequestsUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPullRequestsUnprocessableEntity creates ListPullRequestsUnprocessableEntity with default headers values
+func NewListPullRequestsUnprocessableEntity() *ListPullRequestsUnprocessableEntity {
+
+	return &ListPullRequestsUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the list pull requests unprocessable entity response
+func (o *ListPullRequestsUnprocessableEntity) WithPayload(payload *models.Error) *ListPullRequestsUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pull requests unprocessable entity response
+func (o *ListPullRequestsUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPullRequestsUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListPullRequestsDefault unexpected error
+
+swagger:response listPullRequestsDefault
+*/
+type ListPullRequestsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPullRequestsDefault creates ListPullRequestsDefault with default headers values
+func NewListPullRequestsDefault(code int) *ListPullRequestsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListPullRequestsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list pull requests default response
+func (o *ListPullRequestsDefault) WithStatusCode(code int) *ListPullRequestsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list pull requests default response
+func (o *ListPullRequestsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list pull requests default response
+func (o *ListPullRequestsDefault) WithPayload(payload *models.Error) *ListPullRequestsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pull requests default response
+func (o *ListPullRequestsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPullRequestsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
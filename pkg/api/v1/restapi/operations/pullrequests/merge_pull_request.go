@@ -0,0 +1,157 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+	validate "github.com/go-openapi/validate"
+)
+
+// MergePullRequestHandlerFunc turns a function with the right signature into a merge pull request handler
+type MergePullRequestHandlerFunc func(MergePullRequestParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn MergePullRequestHandlerFunc) Handle(params MergePullRequestParams) middleware.Responder {
+	return fn(params)
+}
+
+// MergePullRequestHandler interface for that can handle valid merge pull request params
+type MergePullRequestHandler interface {
+	Handle(MergePullRequestParams) middleware.Responder
+}
+
+// NewMergePullRequest creates a new http.Handler for the merge pull request operation
+func NewMergePullRequest(ctx *middleware.Context, handler MergePullRequestHandler) *MergePullRequest {
+	return &MergePullRequest{Context: ctx, Handler: handler}
+}
+
+/*MergePullRequest swagger:route PUT /repositories/{owner}/{name}/pulls/{number}/merge pullrequests mergePullRequest
+
+Merge a pull request into its target branch
+
+*/
+type MergePullRequest struct {
+	Context *middleware.Context
+	Handler MergePullRequestHandler
+}
+
+func (o *MergePullRequest) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewMergePullRequestParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// MergePullRequestBody merge pull request body
+// swagger:model MergePullRequestBody
+type MergePullRequestBody struct {
+
+	// The message of the merge or squashed commit
+	Message string `json:"message,omitempty"`
+
+	// The head the pull request is expected to have
+	Sha string `json:"sha,omitempty"`
+
+	// strategy
+	// Required: true
+	// Enum: [merge squash rebase]
+	Strategy *string `json:"strategy"`
+}
+
+// Validate validates this merge pull request body
+func (o *MergePullRequestBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStrategy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var mergePullRequestBodyTypeStrategyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["merge","squash","rebase"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		mergePullRequestBodyTypeStrategyPropEnum = append(mergePullRequestBodyTypeStrategyPropEnum, v)
+	}
+}
+
+const (
+
+	// MergePullRequestBodyStrategyMerge captures enum value "merge"
+	MergePullRequestBodyStrategyMerge string = "merge"
+
+	// MergePullRequestBodyStrategySquash captures enum value "squash"
+	MergePullRequestBodyStrategySquash string = "squash"
+
+	// MergePullRequestBodyStrategyRebase captures enum value "rebase"
+	MergePullRequestBodyStrategyRebase string = "rebase"
+)
+
+// prop value enum
+func (o *MergePullRequestBody) validateStrategyEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, mergePullRequestBodyTypeStrategyPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *MergePullRequestBody) validateStrategy(formats strfmt.Registry) error {
+
+	if err := validate.Required("merge"+"."+"strategy", "body", o.Strategy); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := o.validateStrategyEnum("merge"+"."+"strategy", "body", *o.Strategy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *MergePullRequestBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *MergePullRequestBody) UnmarshalBinary(b []byte) error {
+	var res MergePullRequestBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,336 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// MergePullRequestOKCode is the HTTP code returned for type MergePullRequestOK
+const MergePullRequestOKCode int = 200
+
+/*MergePullRequestOK The pull request has been merged
+
+swagger:response mergePullRequestOK
+*/
+type MergePullRequestOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PullRequest `json:"body,omitempty"`
+}
+
+// NewMergePullRequestOK creates MergePullRequestOK with default headers values
+func NewMergePullRequestOK() *MergePullRequestOK {
+
+	return &MergePullRequestOK{}
+}
+
+// WithPayload adds the payload to the merge pull request o k response
+func (o *MergePullRequestOK) WithPayload(payload *models.PullRequest) *MergePullRequestOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the merge pull request o k response
+func (o *MergePullRequestOK) SetPayload(payload *models.PullRequest) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MergePullRequestOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// MergePullRequestForbiddenCode is the HTTP code returned for type MergePullRequestForbidden
+const MergePullRequestForbiddenCode int = 403
+
+/*MergePullRequestForbidden Only the repository's owner can merge a pull request
+
+swagger:response mergePullRequestForbidden
+*/
+type MergePullRequestForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewMergePullRequestForbidden creates MergePullRequestForbidden with default headers values
+func NewMergePullRequestForbidden() *MergePullRequestForbidden {
+
+	return &MergePullRequestForbidden{}
+}
+
+// WithPayload adds the payload to the merge pull request forbidden response
+func (o *MergePullRequestForbidden) WithPayload(payload *models.Error) *MergePullRequestForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the merge pull request forbidden response
+func (o *MergePullRequestForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MergePullRequestForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// MergePullRequestNotFoundCode is the HTTP code returned for type MergePullRequestNotFound
+const MergePullRequestNotFoundCode int = 404
+
+/*MergePullRequestNotFound The pull request could not be found
+
+swagger:response mergePullRequestNotFound
+*/
+type MergePullRequestNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewMergePullRequestNotFound creates MergePullRequestNotFound with default headers values
+func NewMergePullRequestNotFound() *MergePullRequestNotFound {
+
+	return &MergePullRequestNotFound{}
+}
+
+// WithPayload adds the payload to the merge pull request not found response
+func (o *MergePullRequestNotFound) WithPayload(payload *models.Error) *MergePullRequestNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the merge pull request not found response
+func (o *MergePullRequestNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MergePullRequestNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// MergePullRequestMethodNotAllowedCode is the HTTP code returned for type MergePullRequestMethodNotAllowed
+const MergePullRequestMethodNotAllowedCode int = 405
+
+/*MergePullRequestMethodNotAllowed The pull request is not open, has conflicts or required status checks have not succeeded
+
+swagger:response mergePullRequestMethodNotAllowed
+*/
+type MergePullRequestMethodNotAllowed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewMergePullRequestMethodNotAllowed creates MergePullRequestMethodNotAllowed with default headers values
+func NewMergePullRequestMethodNotAllowed() *MergePullRequestMethodNotAllowed {
+
+	return &MergePullRequestMethodNotAllowed{}
+}
+
+// WithPayload adds the payload to the merge pull request method not allowed response
+func (o *MergePullRequestMethodNotAllowed) WithPayload(payload *models.Error) *MergePullRequestMethodNotAllowed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the merge pull request method not allowed response
+func (o *MergePullRequestMethodNotAllowed) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MergePullRequestMethodNotAllowed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(405)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// MergePullRequestConflictCode is the HTTP code returned for type MergePullRequestConflict
+const MergePullRequestConflictCode int = 409
+
+/*MergePullRequestConflict The pull request's head or target branch has been changed
+
+swagger:response mergePullRequestConflict
+*/
+type MergePullRequestConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewMergePullRequestConflict creates MergePullRequestConflict with default headers values
+func NewMergePullRequestConflict() *MergePullRequestConflict {
+
+	return &MergePullRequestConflict{}
+}
+
+// WithPayload adds the payload to the merge pull request conflict response
+func (o *MergePullRequestConflict) WithPayload(payload *models.Error) *MergePullRequestConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the merge pull request conflict response
+func (o *MergePullRequestConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MergePullRequestConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// MergePullRequestUnprocessableEntityCode is the HTTP code returned for type MergePullRequestUnprocessableEntity
+const MergePullRequestUnprocessableEntityCode int = 422
+
+/*MergePullRequestUnprocessableEntity The merge strategy is not valid
+
+swagger:response mergePullRequestUnprocessableEntity
+*/
+type MergePullRequestUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewMergePullRequestUnprocessableEntity creates MergePullRequestUnprocessableEntity with default headers values
+func NewMergePullRequestUnprocessableEntity() *MergePullRequestUnprocessableEntity {
+
+	return &MergePullRequestUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the merge pull request unprocessable entity response
+func (o *MergePullRequestUnprocessableEntity) WithPayload(payload *models.ValidationError) *MergePullRequestUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the merge pull request unprocessable entity response
+func (o *MergePullRequestUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MergePullRequestUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*MergePullRequestDefault unexpected error
+
+swagger:response mergePullRequestDefault
+*/
+type MergePullRequestDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewMergePullRequestDefault creates MergePullRequestDefault with default headers values
+func NewMergePullRequestDefault(code int) *MergePullRequestDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &MergePullRequestDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the merge pull request default response
+func (o *MergePullRequestDefault) WithStatusCode(code int) *MergePullRequestDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the merge pull request default response
+func (o *MergePullRequestDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the merge pull request default response
+func (o *MergePullRequestDefault) WithPayload(payload *models.Error) *MergePullRequestDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the merge pull request default response
+func (o *MergePullRequestDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *MergePullRequestDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
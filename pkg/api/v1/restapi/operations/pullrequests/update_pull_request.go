@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+	validate "github.com/go-openapi/validate"
+)
+
+// UpdatePullRequestHandlerFunc turns a function with the right signature into a update pull request handler
+type UpdatePullRequestHandlerFunc func(UpdatePullRequestParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdatePullRequestHandlerFunc) Handle(params UpdatePullRequestParams) middleware.Responder {
+	return fn(params)
+}
+
+// UpdatePullRequestHandler interface for that can handle valid update pull request params
+type UpdatePullRequestHandler interface {
+	Handle(UpdatePullRequestParams) middleware.Responder
+}
+
+// NewUpdatePullRequest creates a new http.Handler for the update pull request operation
+func NewUpdatePullRequest(ctx *middleware.Context, handler UpdatePullRequestHandler) *UpdatePullRequest {
+	return &UpdatePullRequest{Context: ctx, Handler: handler}
+}
+
+/*UpdatePullRequest swagger:route PATCH /repositories/{owner}/{name}/pulls/{number} pullrequests updatePullRequest
+
+Update the title, body or state of a pull request
+
+*/
+type UpdatePullRequest struct {
+	Context *middleware.Context
+	Handler UpdatePullRequestHandler
+}
+
+func (o *UpdatePullRequest) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewUpdatePullRequestParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// UpdatePullRequestBody update pull request body
+// swagger:model UpdatePullRequestBody
+type UpdatePullRequestBody struct {
+
+	// body
+	Body *string `json:"body,omitempty"`
+
+	// state
+	// Enum: [open closed]
+	State *string `json:"state,omitempty"`
+
+	// title
+	Title *string `json:"title,omitempty"`
+}
+
+// Validate validates this update pull request body
+func (o *UpdatePullRequestBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var updatePullRequestBodyTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["open","closed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		updatePullRequestBodyTypeStatePropEnum = append(updatePullRequestBodyTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// UpdatePullRequestBodyStateOpen captures enum value "open"
+	UpdatePullRequestBodyStateOpen string = "open"
+
+	// UpdatePullRequestBodyStateClosed captures enum value "closed"
+	UpdatePullRequestBodyStateClosed string = "closed"
+)
+
+// prop value enum
+func (o *UpdatePullRequestBody) validateStateEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, updatePullRequestBodyTypeStatePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *UpdatePullRequestBody) validateState(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.State) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStateEnum("update"+"."+"state", "body", *o.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *UpdatePullRequestBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *UpdatePullRequestBody) UnmarshalBinary(b []byte) error {
+	var res UpdatePullRequestBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,248 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package pullrequests
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// UpdatePullRequestOKCode is the HTTP code returned for type UpdatePullRequestOK
+const UpdatePullRequestOKCode int = 200
+
+/*UpdatePullRequestOK The pull request has been updated
+
+swagger:response updatePullRequestOK
+*/
+type UpdatePullRequestOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PullRequest `json:"body,omitempty"`
+}
+
+// NewUpdatePullRequestOK creates UpdatePullRequestOK with default headers values
+func NewUpdatePullRequestOK() *UpdatePullRequestOK {
+
+	return &UpdatePullRequestOK{}
+}
+
+// WithPayload adds the payload to the update pull request o k response
+func (o *UpdatePullRequestOK) WithPayload(payload *models.PullRequest) *UpdatePullRequestOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pull request o k response
+func (o *UpdatePullRequestOK) SetPayload(payload *models.PullRequest) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePullRequestOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdatePullRequestForbiddenCode is the HTTP code returned for type UpdatePullRequestForbidden
+const UpdatePullRequestForbiddenCode int = 403
+
+/*UpdatePullRequestForbidden Only the author and the repository's owner can update a pull request
+
+swagger:response updatePullRequestForbidden
+*/
+type UpdatePullRequestForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdatePullRequestForbidden creates UpdatePullRequestForbidden with default headers values
+func NewUpdatePullRequestForbidden() *UpdatePullRequestForbidden {
+
+	return &UpdatePullRequestForbidden{}
+}
+
+// WithPayload adds the payload to the update pull request forbidden response
+func (o *UpdatePullRequestForbidden) WithPayload(payload *models.Error) *UpdatePullRequestForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pull request forbidden response
+func (o *UpdatePullRequestForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePullRequestForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdatePullRequestNotFoundCode is the HTTP code returned for type UpdatePullRequestNotFound
+const UpdatePullRequestNotFoundCode int = 404
+
+/*UpdatePullRequestNotFound The pull request could not be found
+
+swagger:response updatePullRequestNotFound
+*/
+type UpdatePullRequestNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdatePullRequestNotFound creates UpdatePullRequestNotFound with default headers values
+func NewUpdatePullRequestNotFound() *UpdatePullRequestNotFound {
+
+	return &UpdatePullRequestNotFound{}
+}
+
+// WithPayload adds the payload to the update pull request not found response
+func (o *UpdatePullRequestNotFound) WithPayload(payload *models.Error) *UpdatePullRequestNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pull request not found response
+func (o *UpdatePullRequestNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePullRequestNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdatePullRequestUnprocessableEntityCode is the HTTP code returned for type UpdatePullRequestUnprocessableEntity
+const UpdatePullRequestUnprocessableEntityCode int = 422
+
+/*UpdatePullRequestUnprocessableEntity The pull request has not been updated due to invalid input
+
+swagger:response updatePullRequestUnprocessableEntity
+*/
+type UpdatePullRequestUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewUpdatePullRequestUnprocessableEntity creates UpdatePullRequestUnprocessableEntity with default headers values
+func NewUpdatePullRequestUnprocessableEntity() *UpdatePullRequestUnprocessableEntity {
+
+	return &UpdatePullRequestUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the update pull request unprocessable entity response
+func (o *UpdatePullRequestUnprocessableEntity) WithPayload(payload *models.ValidationError) *UpdatePullRequestUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pull request unprocessable entity response
+func (o *UpdatePullRequestUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePullRequestUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdatePullRequestDefault unexpected error
+
+swagger:response updatePullRequestDefault
+*/
+type UpdatePullRequestDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdatePullRequestDefault creates UpdatePullRequestDefault with default headers values
+func NewUpdatePullRequestDefault(code int) *UpdatePullRequestDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdatePullRequestDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update pull request default response
+func (o *UpdatePullRequestDefault) WithStatusCode(code int) *UpdatePullRequestDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update pull request default response
+func (o *UpdatePullRequestDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update pull request default response
+func (o *UpdatePullRequestDefault) WithPayload(payload *models.Error) *UpdatePullRequestDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pull request default response
+func (o *UpdatePullRequestDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePullRequestDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -72,6 +72,12 @@ type CreateRepositoryBody struct {
 	// Required: true
 	Name *string `json:"name"`
 
+	// Private repositories are only visible to their owner
+	Private bool `json:"private,omitempty"`
+
+	// The topics tagging the repository
+	Topics []string `json:"topics"`
+
 	// website
 	Website string `json:"website,omitempty"`
 }
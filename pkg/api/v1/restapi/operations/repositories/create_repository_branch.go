@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+	validate "github.com/go-openapi/validate"
+)
+
+// CreateRepositoryBranchHandlerFunc turns a function with the right signature into a create repository branch handler
+type CreateRepositoryBranchHandlerFunc func(CreateRepositoryBranchParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateRepositoryBranchHandlerFunc) Handle(params CreateRepositoryBranchParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateRepositoryBranchHandler interface for that can handle valid create repository branch params
+type CreateRepositoryBranchHandler interface {
+	Handle(CreateRepositoryBranchParams) middleware.Responder
+}
+
+// NewCreateRepositoryBranch creates a new http.Handler for the create repository branch operation
+func NewCreateRepositoryBranch(ctx *middleware.Context, handler CreateRepositoryBranchHandler) *CreateRepositoryBranch {
+	return &CreateRepositoryBranch{Context: ctx, Handler: handler}
+}
+
+/*CreateRepositoryBranch swagger:route POST /repositories/{owner}/{name}/branches/{branch} repositories createRepositoryBranch
+
+Create a new branch pointing to a rev
+
+*/
+type CreateRepositoryBranch struct {
+	Context *middleware.Context
+	Handler CreateRepositoryBranchHandler
+}
+
+func (o *CreateRepositoryBranch) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCreateRepositoryBranchParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// CreateRepositoryBranchBody create repository branch body
+// swagger:model CreateRepositoryBranchBody
+type CreateRepositoryBranchBody struct {
+
+	// rev
+	// Required: true
+	Rev *string `json:"rev"`
+}
+
+// Validate validates this create repository branch body
+func (o *CreateRepositoryBranchBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateRev(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *CreateRepositoryBranchBody) validateRev(formats strfmt.Registry) error {
+
+	if err := validate.Required("newBranch"+"."+"rev", "body", o.Rev); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateRepositoryBranchBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateRepositoryBranchBody) UnmarshalBinary(b []byte) error {
+	var res CreateRepositoryBranchBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
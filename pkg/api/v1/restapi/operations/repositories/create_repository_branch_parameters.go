@@ -0,0 +1,152 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewCreateRepositoryBranchParams creates a new CreateRepositoryBranchParams object
+// no default values defined in spec.
+func NewCreateRepositoryBranchParams() CreateRepositoryBranchParams {
+
+	return CreateRepositoryBranchParams{}
+}
+
+// CreateRepositoryBranchParams contains all the bound params for the create repository branch operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters createRepositoryBranch
+type CreateRepositoryBranchParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The branch's name
+	  Required: true
+	  In: path
+	*/
+	Branch string
+	/*The repository's name
+	  Required: true
+	  In: path
+	*/
+	Name string
+	/*The rev the new branch points to
+	  Required: true
+	  In: body
+	*/
+	NewBranch CreateRepositoryBranchBody
+	/*The owner's username
+	  Required: true
+	  In: path
+	*/
+	Owner string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewCreateRepositoryBranchParams() beforehand.
+func (o *CreateRepositoryBranchParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rBranch, rhkBranch, _ := route.Params.GetOK("branch")
+	if err := o.bindBranch(rBranch, rhkBranch, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rName, rhkName, _ := route.Params.GetOK("name")
+	if err := o.bindName(rName, rhkName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body CreateRepositoryBranchBody
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("newBranch", "body"))
+			} else {
+				res = append(res, errors.NewParseError("newBranch", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.NewBranch = body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("newBranch", "body"))
+	}
+	rOwner, rhkOwner, _ := route.Params.GetOK("owner")
+	if err := o.bindOwner(rOwner, rhkOwner, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindBranch binds and validates parameter Branch from path.
+func (o *CreateRepositoryBranchParams) bindBranch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Branch = raw
+
+	return nil
+}
+
+// bindName binds and validates parameter Name from path.
+func (o *CreateRepositoryBranchParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Name = raw
+
+	return nil
+}
+
+// bindOwner binds and validates parameter Owner from path.
+func (o *CreateRepositoryBranchParams) bindOwner(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Owner = raw
+
+	return nil
+}
@@ -0,0 +1,292 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// CreateRepositoryBranchOKCode is the HTTP code returned for type CreateRepositoryBranchOK
+const CreateRepositoryBranchOKCode int = 200
+
+/*CreateRepositoryBranchOK The branch has been created and is returned to you
+
+swagger:response createRepositoryBranchOK
+*/
+type CreateRepositoryBranchOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Branch `json:"body,omitempty"`
+}
+
+// NewCreateRepositoryBranchOK creates CreateRepositoryBranchOK with default headers values
+func NewCreateRepositoryBranchOK() *CreateRepositoryBranchOK {
+
+	return &CreateRepositoryBranchOK{}
+}
+
+// WithPayload adds the payload to the create repository branch o k response
+func (o *CreateRepositoryBranchOK) WithPayload(payload *models.Branch) *CreateRepositoryBranchOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create repository branch o k response
+func (o *CreateRepositoryBranchOK) SetPayload(payload *models.Branch) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRepositoryBranchOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateRepositoryBranchForbiddenCode is the HTTP code returned for type CreateRepositoryBranchForbidden
+const CreateRepositoryBranchForbiddenCode int = 403
+
+/*CreateRepositoryBranchForbidden Only the owner can create branches
+
+swagger:response createRepositoryBranchForbidden
+*/
+type CreateRepositoryBranchForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateRepositoryBranchForbidden creates CreateRepositoryBranchForbidden with default headers values
+func NewCreateRepositoryBranchForbidden() *CreateRepositoryBranchForbidden {
+
+	return &CreateRepositoryBranchForbidden{}
+}
+
+// WithPayload adds the payload to the create repository branch forbidden response
+func (o *CreateRepositoryBranchForbidden) WithPayload(payload *models.Error) *CreateRepositoryBranchForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create repository branch forbidden response
+func (o *CreateRepositoryBranchForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRepositoryBranchForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateRepositoryBranchNotFoundCode is the HTTP code returned for type CreateRepositoryBranchNotFound
+const CreateRepositoryBranchNotFoundCode int = 404
+
+/*CreateRepositoryBranchNotFound The repository or the rev could not be found
+
+swagger:response createRepositoryBranchNotFound
+*/
+type CreateRepositoryBranchNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateRepositoryBranchNotFound creates CreateRepositoryBranchNotFound with default headers values
+func NewCreateRepositoryBranchNotFound() *CreateRepositoryBranchNotFound {
+
+	return &CreateRepositoryBranchNotFound{}
+}
+
+// WithPayload adds the payload to the create repository branch not found response
+func (o *CreateRepositoryBranchNotFound) WithPayload(payload *models.Error) *CreateRepositoryBranchNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create repository branch not found response
+func (o *CreateRepositoryBranchNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRepositoryBranchNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateRepositoryBranchConflictCode is the HTTP code returned for type CreateRepositoryBranchConflict
+const CreateRepositoryBranchConflictCode int = 409
+
+/*CreateRepositoryBranchConflict A branch with this name already exists
+
+swagger:response createRepositoryBranchConflict
+*/
+type CreateRepositoryBranchConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateRepositoryBranchConflict creates CreateRepositoryBranchConflict with default headers values
+func NewCreateRepositoryBranchConflict() *CreateRepositoryBranchConflict {
+
+	return &CreateRepositoryBranchConflict{}
+}
+
+// WithPayload adds the payload to the create repository branch conflict response
+func (o *CreateRepositoryBranchConflict) WithPayload(payload *models.Error) *CreateRepositoryBranchConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create repository branch conflict response
+func (o *CreateRepositoryBranchConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRepositoryBranchConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateRepositoryBranchUnprocessableEntityCode is the HTTP code returned for type CreateRepositoryBranchUnprocessableEntity
+const CreateRepositoryBranchUnprocessableEntityCode int = 422
+
+/*CreateRepositoryBranchUnprocessableEntity The branch name is not valid
+
+swagger:response createRepositoryBranchUnprocessableEntity
+*/
+type CreateRepositoryBranchUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewCreateRepositoryBranchUnprocessableEntity creates CreateRepositoryBranchUnprocessableEntity with default headers values
+func NewCreateRepositoryBranchUnprocessableEntity() *CreateRepositoryBranchUnprocessableEntity {
+
+	return &CreateRepositoryBranchUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the create repository branch unprocessable entity response
+func (o *CreateRepositoryBranchUnprocessableEntity) WithPayload(payload *models.ValidationError) *CreateRepositoryBranchUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create repository branch unprocessable entity response
+func (o *CreateRepositoryBranchUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRepositoryBranchUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateRepositoryBranchDefault unexpected error
+
+swagger:response createRepositoryBranchDefault
+*/
+type CreateRepositoryBranchDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateRepositoryBranchDefault creates CreateRepositoryBranchDefault with default headers values
+func NewCreateRepositoryBranchDefault(code int) *CreateRepositoryBranchDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateRepositoryBranchDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create repository branch default response
+func (o *CreateRepositoryBranchDefault) WithStatusCode(code int) *CreateRepositoryBranchDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create repository branch default response
+func (o *CreateRepositoryBranchDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create repository branch default response
+func (o *CreateRepositoryBranchDefault) WithPayload(payload *models.Error) *CreateRepositoryBranchDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create repository branch default response
+func (o *CreateRepositoryBranchDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRepositoryBranchDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteRepositoryBranchHandlerFunc turns a function with the right signature into a delete repository branch handler
+type DeleteRepositoryBranchHandlerFunc func(DeleteRepositoryBranchParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteRepositoryBranchHandlerFunc) Handle(params DeleteRepositoryBranchParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteRepositoryBranchHandler interface for that can handle valid delete repository branch params
+type DeleteRepositoryBranchHandler interface {
+	Handle(DeleteRepositoryBranchParams) middleware.Responder
+}
+
+// NewDeleteRepositoryBranch creates a new http.Handler for the delete repository branch operation
+func NewDeleteRepositoryBranch(ctx *middleware.Context, handler DeleteRepositoryBranchHandler) *DeleteRepositoryBranch {
+	return &DeleteRepositoryBranch{Context: ctx, Handler: handler}
+}
+
+/*DeleteRepositoryBranch swagger:route DELETE /repositories/{owner}/{name}/branches/{branch} repositories deleteRepositoryBranch
+
+Delete a branch
+
+*/
+type DeleteRepositoryBranch struct {
+	Context *middleware.Context
+	Handler DeleteRepositoryBranchHandler
+}
+
+func (o *DeleteRepositoryBranch) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewDeleteRepositoryBranchParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
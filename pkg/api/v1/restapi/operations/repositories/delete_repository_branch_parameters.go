@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewDeleteRepositoryBranchParams creates a new DeleteRepositoryBranchParams object
+// no default values defined in spec.
+func NewDeleteRepositoryBranchParams() DeleteRepositoryBranchParams {
+
+	return DeleteRepositoryBranchParams{}
+}
+
+// DeleteRepositoryBranchParams contains all the bound params for the delete repository branch operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters deleteRepositoryBranch
+type DeleteRepositoryBranchParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The branch's name
+	  Required: true
+	  In: path
+	*/
+	Branch string
+	/*The repository's name
+	  Required: true
+	  In: path
+	*/
+	Name string
+	/*The owner's username
+	  Required: true
+	  In: path
+	*/
+	Owner string
+	/*Only delete the branch if it still points to this commit
+	  In: query
+	*/
+	Sha1 *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewDeleteRepositoryBranchParams() beforehand.
+func (o *DeleteRepositoryBranchParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	rBranch, rhkBranch, _ := route.Params.GetOK("branch")
+	if err := o.bindBranch(rBranch, rhkBranch, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rName, rhkName, _ := route.Params.GetOK("name")
+	if err := o.bindName(rName, rhkName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rOwner, rhkOwner, _ := route.Params.GetOK("owner")
+	if err := o.bindOwner(rOwner, rhkOwner, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSha1, qhkSha1, _ := qs.GetOK("sha1")
+	if err := o.bindSha1(qSha1, qhkSha1, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindBranch binds and validates parameter Branch from path.
+func (o *DeleteRepositoryBranchParams) bindBranch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Branch = raw
+
+	return nil
+}
+
+// bindName binds and validates parameter Name from path.
+func (o *DeleteRepositoryBranchParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Name = raw
+
+	return nil
+}
+
+// bindOwner binds and validates parameter Owner from path.
+func (o *DeleteRepositoryBranchParams) bindOwner(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Owner = raw
+
+	return nil
+}
+
+// bindSha1 binds and validates parameter Sha1 from query.
+func (o *DeleteRepositoryBranchParams) bindSha1(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Sha1 = &raw
+
+	return nil
+}
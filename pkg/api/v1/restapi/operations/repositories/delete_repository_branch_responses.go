@@ -0,0 +1,228 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// DeleteRepositoryBranchNoContentCode is the HTTP code returned for type DeleteRepositoryBranchNoContent
+const DeleteRepositoryBranchNoContentCode int = 204
+
+/*DeleteRepositoryBranchNoContent The branch has been deleted
+
+swagger:response deleteRepositoryBranchNoContent
+*/
+type DeleteRepositoryBranchNoContent struct {
+}
+
+// NewDeleteRepositoryBranchNoContent creates DeleteRepositoryBranchNoContent with default headers values
+func NewDeleteRepositoryBranchNoContent() *DeleteRepositoryBranchNoContent {
+
+	return &DeleteRepositoryBranchNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteRepositoryBranchNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// DeleteRepositoryBranchForbiddenCode is the HTTP code returned for type DeleteRepositoryBranchForbidden
+const DeleteRepositoryBranchForbiddenCode int = 403
+
+/*DeleteRepositoryBranchForbidden The branch is protected or the user isn't the owner
+
+swagger:response deleteRepositoryBranchForbidden
+*/
+type DeleteRepositoryBranchForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteRepositoryBranchForbidden creates DeleteRepositoryBranchForbidden with default headers values
+func NewDeleteRepositoryBranchForbidden() *DeleteRepositoryBranchForbidden {
+
+	return &DeleteRepositoryBranchForbidden{}
+}
+
+// WithPayload adds the payload to the delete repository branch forbidden response
+func (o *DeleteRepositoryBranchForbidden) WithPayload(payload *models.Error) *DeleteRepositoryBranchForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete repository branch forbidden response
+func (o *DeleteRepositoryBranchForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteRepositoryBranchForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteRepositoryBranchNotFoundCode is the HTTP code returned for type DeleteRepositoryBranchNotFound
+const DeleteRepositoryBranchNotFoundCode int = 404
+
+/*DeleteRepositoryBranchNotFound The repository or the branch could not be found
+
+swagger:response deleteRepositoryBranchNotFound
+*/
+type DeleteRepositoryBranchNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteRepositoryBranchNotFound creates DeleteRepositoryBranchNotFound with default headers values
+func NewDeleteRepositoryBranchNotFound() *DeleteRepositoryBranchNotFound {
+
+	return &DeleteRepositoryBranchNotFound{}
+}
+
+// WithPayload adds the payload to the delete repository branch not found response
+func (o *DeleteRepositoryBranchNotFound) WithPayload(payload *models.Error) *DeleteRepositoryBranchNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete repository branch not found response
+func (o *DeleteRepositoryBranchNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteRepositoryBranchNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteRepositoryBranchConflictCode is the HTTP code returned for type DeleteRepositoryBranchConflict
+const DeleteRepositoryBranchConflictCode int = 409
+
+/*DeleteRepositoryBranchConflict The branch has been changed in the meantime
+
+swagger:response deleteRepositoryBranchConflict
+*/
+type DeleteRepositoryBranchConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteRepositoryBranchConflict creates DeleteRepositoryBranchConflict with default headers values
+func NewDeleteRepositoryBranchConflict() *DeleteRepositoryBranchConflict {
+
+	return &DeleteRepositoryBranchConflict{}
+}
+
+// WithPayload adds the payload to the delete repository branch conflict response
+func (o *DeleteRepositoryBranchConflict) WithPayload(payload *models.Error) *DeleteRepositoryBranchConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete repository branch conflict response
+func (o *DeleteRepositoryBranchConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteRepositoryBranchConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteRepositoryBranchDefault unexpected error
+
+swagger:response deleteRepositoryBranchDefault
+*/
+type DeleteRepositoryBranchDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteRepositoryBranchDefault creates DeleteRepositoryBranchDefault with default headers values
+func NewDeleteRepositoryBranchDefault(code int) *DeleteRepositoryBranchDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteRepositoryBranchDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete repository branch default response
+func (o *DeleteRepositoryBranchDefault) WithStatusCode(code int) *DeleteRepositoryBranchDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete repository branch default response
+func (o *DeleteRepositoryBranchDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete repository branch default response
+func (o *DeleteRepositoryBranchDefault) WithPayload(payload *models.Error) *DeleteRepositoryBranchDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete repository branch default response
+func (o *DeleteRepositoryBranchDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteRepositoryBranchDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+)
+
+// ForkRepositoryHandlerFunc turns a function with the right signature into a fork repository handler
+type ForkRepositoryHandlerFunc func(ForkRepositoryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ForkRepositoryHandlerFunc) Handle(params ForkRepositoryParams) middleware.Responder {
+	return fn(params)
+}
+
+// ForkRepositoryHandler interface for that can handle valid fork repository params
+type ForkRepositoryHandler interface {
+	Handle(ForkRepositoryParams) middleware.Responder
+}
+
+// NewForkRepository creates a new http.Handler for the fork repository operation
+func NewForkRepository(ctx *middleware.Context, handler ForkRepositoryHandler) *ForkRepository {
+	return &ForkRepository{Context: ctx, Handler: handler}
+}
+
+/*ForkRepository swagger:route POST /repositories/{owner}/{name}/forks repositories forkRepository
+
+Fork a repository into the current user's repositories
+
+*/
+type ForkRepository struct {
+	Context *middleware.Context
+	Handler ForkRepositoryHandler
+}
+
+func (o *ForkRepository) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewForkRepositoryParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// ForkRepositoryBody fork repository body
+// swagger:model ForkRepositoryBody
+type ForkRepositoryBody struct {
+
+	// The fork's name, by default the repository's name
+	Name string `json:"name,omitempty"`
+}
+
+// Validate validates this fork repository body
+func (o *ForkRepositoryBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ForkRepositoryBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ForkRepositoryBody) UnmarshalBinary(b []byte) error {
+	var res ForkRepositoryBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
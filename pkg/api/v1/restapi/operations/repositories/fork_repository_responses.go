@@ -0,0 +1,248 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ForkRepositoryOKCode is the HTTP code returned for type ForkRepositoryOK
+const ForkRepositoryOKCode int = 200
+
+/*ForkRepositoryOK The fork has been created and is returned to you
+
+swagger:response forkRepositoryOK
+*/
+type ForkRepositoryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Repository `json:"body,omitempty"`
+}
+
+// NewForkRepositoryOK creates ForkRepositoryOK with default headers values
+func NewForkRepositoryOK() *ForkRepositoryOK {
+
+	return &ForkRepositoryOK{}
+}
+
+// WithPayload adds the payload to the fork repository o k response
+func (o *ForkRepositoryOK) WithPayload(payload *models.Repository) *ForkRepositoryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the fork repository o k response
+func (o *ForkRepositoryOK) SetPayload(payload *models.Repository) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ForkRepositoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ForkRepositoryNotFoundCode is the HTTP code returned for type ForkRepositoryNotFound
+const ForkRepositoryNotFoundCode int = 404
+
+/*ForkRepositoryNotFound The owner and name combination could not be found
+
+swagger:response forkRepositoryNotFound
+*/
+type ForkRepositoryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewForkRepositoryNotFound creates ForkRepositoryNotFound with default headers values
+func NewForkRepositoryNotFound() *ForkRepositoryNotFound {
+
+	return &ForkRepositoryNotFound{}
+}
+
+// WithPayload adds the payload to the fork repository not found response
+func (o *ForkRepositoryNotFound) WithPayload(payload *models.Error) *ForkRepositoryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the fork repository not found response
+func (o *ForkRepositoryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ForkRepositoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ForkRepositoryConflictCode is the HTTP code returned for type ForkRepositoryConflict
+const ForkRepositoryConflictCode int = 409
+
+/*ForkRepositoryConflict The current user already has a repository with this name
+
+swagger:response forkRepositoryConflict
+*/
+type ForkRepositoryConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewForkRepositoryConflict creates ForkRepositoryConflict with default headers values
+func NewForkRepositoryConflict() *ForkRepositoryConflict {
+
+	return &ForkRepositoryConflict{}
+}
+
+// WithPayload adds the payload to the fork repository conflict response
+func (o *ForkRepositoryConflict) WithPayload(payload *models.Error) *ForkRepositoryConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the fork repository conflict response
+func (o *ForkRepositoryConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ForkRepositoryConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ForkRepositoryUnprocessableEntityCode is the HTTP code returned for type ForkRepositoryUnprocessableEntity
+const ForkRepositoryUnprocessableEntityCode int = 422
+
+/*ForkRepositoryUnprocessableEntity The fork has not been created due to invalid input
+
+swagger:response forkRepositoryUnprocessableEntity
+*/
+type ForkRepositoryUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewForkRepositoryUnprocessableEntity creates ForkRepositoryUnprocessableEntity with default headers values
+func NewForkRepositoryUnprocessableEntity() *ForkRepositoryUnprocessableEntity {
+
+	return &ForkRepositoryUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the fork repository unprocessable entity response
+func (o *ForkRepositoryUnprocessableEntity) WithPayload(payload *models.ValidationError) *ForkRepositoryUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the fork repository unprocessable entity response
+func (o *ForkRepositoryUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ForkRepositoryUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ForkRepositoryDefault unexpected error
+
+swagger:response forkRepositoryDefault
+*/
+type ForkRepositoryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewForkRepositoryDefault creates ForkRepositoryDefault with default headers values
+func NewForkRepositoryDefault(code int) *ForkRepositoryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ForkRepositoryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the fork repository default response
+func (o *ForkRepositoryDefault) WithStatusCode(code int) *ForkRepositoryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the fork repository default response
+func (o *ForkRepositoryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the fork repository default response
+func (o *ForkRepositoryDefault) WithPayload(payload *models.Error) *ForkRepositoryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the fork repository default response
+func (o *ForkRepositoryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ForkRepositoryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
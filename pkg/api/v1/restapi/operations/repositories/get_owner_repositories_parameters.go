@@ -9,16 +9,30 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 
 	strfmt "github.com/go-openapi/strfmt"
 )
 
 // NewGetOwnerRepositoriesParams creates a new GetOwnerRepositoriesParams object
-// no default values defined in spec.
+// with the default values initialized.
 func NewGetOwnerRepositoriesParams() GetOwnerRepositoriesParams {
 
-	return GetOwnerRepositoriesParams{}
+	var (
+		// initialize parameters with default values
+
+		perPageDefault = int64(30)
+		sortDefault    = string("updated")
+	)
+
+	return GetOwnerRepositoriesParams{
+		PerPage: &perPageDefault,
+
+		Sort: &sortDefault,
+	}
 }
 
 // GetOwnerRepositoriesParams contains all the bound params for the get owner repositories operation
@@ -30,11 +44,27 @@ type GetOwnerRepositoriesParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*The cursor of the page to return, as returned with the previous page
+	  In: query
+	*/
+	Cursor *string
 	/*The owner's username
 	  Required: true
 	  In: path
 	*/
 	Owner string
+	/*The number of items per page
+	  Maximum: 100
+	  Minimum: 1
+	  In: query
+	  Default: 30
+	*/
+	PerPage *int64
+	/*Sort repositories by their last update, most recent first, or by name
+	  In: query
+	  Default: "updated"
+	*/
+	Sort *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -46,17 +76,52 @@ func (o *GetOwnerRepositoriesParams) BindRequest(r *http.Request, route *middlew
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rOwner, rhkOwner, _ := route.Params.GetOK("owner")
 	if err := o.bindOwner(rOwner, rhkOwner, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qPerPage, qhkPerPage, _ := qs.GetOK("per_page")
+	if err := o.bindPerPage(qPerPage, qhkPerPage, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSort, qhkSort, _ := qs.GetOK("sort")
+	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindCursor binds and validates parameter Cursor from query.
+func (o *GetOwnerRepositoriesParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Cursor = &raw
+
+	return nil
+}
+
 // bindOwner binds and validates parameter Owner from path.
 func (o *GetOwnerRepositoriesParams) bindOwner(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -71,3 +136,77 @@ func (o *GetOwnerRepositoriesParams) bindOwner(rawData []string, hasKey bool, fo
 
 	return nil
 }
+
+// bindPerPage binds and validates parameter PerPage from query.
+func (o *GetOwnerRepositoriesParams) bindPerPage(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetOwnerRepositoriesParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("per_page", "query", "int64", raw)
+	}
+	o.PerPage = &value
+
+	if err := o.validatePerPage(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validatePerPage carries on validations for parameter PerPage
+func (o *GetOwnerRepositoriesParams) validatePerPage(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("per_page", "query", int64(*o.PerPage), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("per_page", "query", int64(*o.PerPage), 100, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSort binds and validates parameter Sort from query.
+func (o *GetOwnerRepositoriesParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetOwnerRepositoriesParams()
+		return nil
+	}
+
+	o.Sort = &raw
+
+	if err := o.validateSort(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSort carries on validations for parameter Sort
+func (o *GetOwnerRepositoriesParams) validateSort(formats strfmt.Registry) error {
+
+	if err := validate.Enum("sort", "query", *o.Sort, []interface{}{"updated", "name"}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -21,6 +21,14 @@ const GetOwnerRepositoriesOKCode int = 200
 swagger:response getOwnerRepositoriesOK
 */
 type GetOwnerRepositoriesOK struct {
+	/*The URL of the next page with rel="next", missing on the last page
+
+	 */
+	Link string `json:"Link"`
+	/*The cursor of the next page, missing on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
 
 	/*
 	  In: Body
@@ -34,6 +42,28 @@ func NewGetOwnerRepositoriesOK() *GetOwnerRepositoriesOK {
 	return &GetOwnerRepositoriesOK{}
 }
 
+// WithLink adds the link to the get owner repositories o k response
+func (o *GetOwnerRepositoriesOK) WithLink(link string) *GetOwnerRepositoriesOK {
+	o.Link = link
+	return o
+}
+
+// SetLink sets the link to the get owner repositories o k response
+func (o *GetOwnerRepositoriesOK) SetLink(link string) {
+	o.Link = link
+}
+
+// WithXNextCursor adds the xNextCursor to the get owner repositories o k response
+func (o *GetOwnerRepositoriesOK) WithXNextCursor(xNextCursor string) *GetOwnerRepositoriesOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the get owner repositories o k response
+func (o *GetOwnerRepositoriesOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
 // WithPayload adds the payload to the get owner repositories o k response
 func (o *GetOwnerRepositoriesOK) WithPayload(payload []*models.Repository) *GetOwnerRepositoriesOK {
 	o.Payload = payload
@@ -48,6 +78,20 @@ func (o *GetOwnerRepositoriesOK) SetPayload(payload []*models.Repository) {
 // WriteResponse to the client
 func (o *GetOwnerRepositoriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Link
+
+	link := o.Link
+	if link != "" {
+		rw.Header().Set("Link", link)
+	}
+
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -104,6 +148,50 @@ func (o *GetOwnerRepositoriesNotFound) WriteResponse(rw http.ResponseWriter, pro
 	}
 }
 
+// GetOwnerRepositoriesUnprocessableEntityCode is the HTTP code returned for type GetOwnerRepositoriesUnprocessableEntity
+const GetOwnerRepositoriesUnprocessableEntityCode int = 422
+
+/*GetOwnerRepositoriesUnprocessableEntity The cursor is not valid
+
+swagger:response getOwnerRepositoriesUnprocessableEntity
+*/
+type GetOwnerRepositoriesUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetOwnerRepositoriesUnprocessableEntity creates GetOwnerRepositoriesUnprocessableEntity with default headers values
+func NewGetOwnerRepositoriesUnprocessableEntity() *GetOwnerRepositoriesUnprocessableEntity {
+
+	return &GetOwnerRepositoriesUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the get owner repositories unprocessable entity response
+func (o *GetOwnerRepositoriesUnprocessableEntity) WithPayload(payload *models.Error) *GetOwnerRepositoriesUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get owner repositories unprocessable entity response
+func (o *GetOwnerRepositoriesUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetOwnerRepositoriesUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*GetOwnerRepositoriesDefault unexpected error
 
 swagger:response getOwnerRepositoriesDefault
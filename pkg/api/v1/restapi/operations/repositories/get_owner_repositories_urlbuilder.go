@@ -10,12 +10,18 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // GetOwnerRepositoriesURL generates an URL for the get owner repositories operation
 type GetOwnerRepositoriesURL struct {
 	Owner string
 
+	Cursor  *string
+	PerPage *int64
+	Sort    *string
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -55,6 +61,34 @@ func (o *GetOwnerRepositoriesURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var cursor string
+	if o.Cursor != nil {
+		cursor = *o.Cursor
+	}
+	if cursor != "" {
+		qs.Set("cursor", cursor)
+	}
+
+	var perPage string
+	if o.PerPage != nil {
+		perPage = swag.FormatInt64(*o.PerPage)
+	}
+	if perPage != "" {
+		qs.Set("per_page", perPage)
+	}
+
+	var sort string
+	if o.Sort != nil {
+		sort = *o.Sort
+	}
+	if sort != "" {
+		qs.Set("sort", sort)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -9,16 +9,31 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 
 	strfmt "github.com/go-openapi/strfmt"
 )
 
 // NewGetRepositoryBranchesParams creates a new GetRepositoryBranchesParams object
-// no default values defined in spec.
+// with the default values initialized.
 func NewGetRepositoryBranchesParams() GetRepositoryBranchesParams {
 
-	return GetRepositoryBranchesParams{}
+	var (
+		// initialize parameters with default values
+
+		perPageDefault = int64(30)
+
+		sortDefault = string("name")
+	)
+
+	return GetRepositoryBranchesParams{
+		PerPage: &perPageDefault,
+
+		Sort: &sortDefault,
+	}
 }
 
 // GetRepositoryBranchesParams contains all the bound params for the get repository branches operation
@@ -30,6 +45,10 @@ type GetRepositoryBranchesParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*The cursor of the page to return, as returned with the previous page
+	  In: query
+	*/
+	Cursor *string
 	/*The repository's name
 	  Required: true
 	  In: path
@@ -40,6 +59,22 @@ type GetRepositoryBranchesParams struct {
 	  In: path
 	*/
 	Owner string
+	/*The number of items per page
+	  Maximum: 100
+	  Minimum: 1
+	  In: query
+	  Default: 30
+	*/
+	PerPage *int64
+	/*Only return branches whose name starts with the prefix
+	  In: query
+	*/
+	Prefix *string
+	/*Sort branches by name or by their last commit, newest first
+	  In: query
+	  Default: "name"
+	*/
+	Sort *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -51,6 +86,13 @@ func (o *GetRepositoryBranchesParams) BindRequest(r *http.Request, route *middle
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rName, rhkName, _ := route.Params.GetOK("name")
 	if err := o.bindName(rName, rhkName, route.Formats); err != nil {
 		res = append(res, err)
@@ -61,12 +103,45 @@ func (o *GetRepositoryBranchesParams) BindRequest(r *http.Request, route *middle
 		res = append(res, err)
 	}
 
+	qPerPage, qhkPerPage, _ := qs.GetOK("per_page")
+	if err := o.bindPerPage(qPerPage, qhkPerPage, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPrefix, qhkPrefix, _ := qs.GetOK("prefix")
+	if err := o.bindPrefix(qPrefix, qhkPrefix, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSort, qhkSort, _ := qs.GetOK("sort")
+	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindCursor binds and validates parameter Cursor from query.
+func (o *GetRepositoryBranchesParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Cursor = &raw
+
+	return nil
+}
+
 // bindName binds and validates parameter Name from path.
 func (o *GetRepositoryBranchesParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -96,3 +171,95 @@ func (o *GetRepositoryBranchesParams) bindOwner(rawData []string, hasKey bool, f
 
 	return nil
 }
+
+// bindPerPage binds and validates parameter PerPage from query.
+func (o *GetRepositoryBranchesParams) bindPerPage(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetRepositoryBranchesParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("per_page", "query", "int64", raw)
+	}
+	o.PerPage = &value
+
+	if err := o.validatePerPage(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validatePerPage carries on validations for parameter PerPage
+func (o *GetRepositoryBranchesParams) validatePerPage(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("per_page", "query", int64(*o.PerPage), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("per_page", "query", int64(*o.PerPage), 100, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindPrefix binds and validates parameter Prefix from query.
+func (o *GetRepositoryBranchesParams) bindPrefix(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Prefix = &raw
+
+	return nil
+}
+
+// bindSort binds and validates parameter Sort from query.
+func (o *GetRepositoryBranchesParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetRepositoryBranchesParams()
+		return nil
+	}
+
+	o.Sort = &raw
+
+	if err := o.validateSort(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSort carries on validations for parameter Sort
+func (o *GetRepositoryBranchesParams) validateSort(formats strfmt.Registry) error {
+
+	if err := validate.Enum("sort", "query", *o.Sort, []interface{}{"name", "committerdate"}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -21,6 +21,14 @@ const GetRepositoryBranchesOKCode int = 200
 swagger:response getRepositoryBranchesOK
 */
 type GetRepositoryBranchesOK struct {
+	/*The URL of the next page with rel="next", missing on the last page
+
+	 */
+	Link string `json:"Link"`
+	/*The cursor of the next page, missing on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
 
 	/*
 	  In: Body
@@ -34,6 +42,28 @@ func NewGetRepositoryBranchesOK() *GetRepositoryBranchesOK {
 	return &GetRepositoryBranchesOK{}
 }
 
+// WithLink adds the link to the get repository branches o k response
+func (o *GetRepositoryBranchesOK) WithLink(link string) *GetRepositoryBranchesOK {
+	o.Link = link
+	return o
+}
+
+// SetLink sets the link to the get repository branches o k response
+func (o *GetRepositoryBranchesOK) SetLink(link string) {
+	o.Link = link
+}
+
+// WithXNextCursor adds the xNextCursor to the get repository branches o k response
+func (o *GetRepositoryBranchesOK) WithXNextCursor(xNextCursor string) *GetRepositoryBranchesOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the get repository branches o k response
+func (o *GetRepositoryBranchesOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
 // WithPayload adds the payload to the get repository branches o k response
 func (o *GetRepositoryBranchesOK) WithPayload(payload []*models.Branch) *GetRepositoryBranchesOK {
 	o.Payload = payload
@@ -48,6 +78,20 @@ func (o *GetRepositoryBranchesOK) SetPayload(payload []*models.Branch) {
 // WriteResponse to the client
 func (o *GetRepositoryBranchesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Link
+
+	link := o.Link
+	if link != "" {
+		rw.Header().Set("Link", link)
+	}
+
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -104,6 +148,50 @@ func (o *GetRepositoryBranchesNotFound) WriteResponse(rw http.ResponseWriter, pr
 	}
 }
 
+// GetRepositoryBranchesUnprocessableEntityCode is the HTTP code returned for type GetRepositoryBranchesUnprocessableEntity
+const GetRepositoryBranchesUnprocessableEntityCode int = 422
+
+/*GetRepositoryBranchesUnprocessableEntity The cursor is not valid
+
+swagger:response getRepositoryBranchesUnprocessableEntity
+*/
+type GetRepositoryBranchesUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetRepositoryBranchesUnprocessableEntity creates GetRepositoryBranchesUnprocessableEntity with default headers values
+func NewGetRepositoryBranchesUnprocessableEntity() *GetRepositoryBranchesUnprocessableEntity {
+
+	return &GetRepositoryBranchesUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the get repository branches unprocessable entity response
+func (o *GetRepositoryBranchesUnprocessableEntity) WithPayload(payload *models.Error) *GetRepositoryBranchesUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository branches unprocessable entity response
+func (o *GetRepositoryBranchesUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryBranchesUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*GetRepositoryBranchesDefault unexpected error
 
 swagger:response getRepositoryBranchesDefault
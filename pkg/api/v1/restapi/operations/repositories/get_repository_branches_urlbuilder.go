@@ -10,6 +10,8 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // GetRepositoryBranchesURL generates an URL for the get repository branches operation
@@ -17,6 +19,11 @@ type GetRepositoryBranchesURL struct {
 	Name  string
 	Owner string
 
+	Cursor  *string
+	PerPage *int64
+	Prefix  *string
+	Sort    *string
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -63,6 +70,42 @@ func (o *GetRepositoryBranchesURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var cursor string
+	if o.Cursor != nil {
+		cursor = *o.Cursor
+	}
+	if cursor != "" {
+		qs.Set("cursor", cursor)
+	}
+
+	var perPage string
+	if o.PerPage != nil {
+		perPage = swag.FormatInt64(*o.PerPage)
+	}
+	if perPage != "" {
+		qs.Set("per_page", perPage)
+	}
+
+	var prefix string
+	if o.Prefix != nil {
+		prefix = *o.Prefix
+	}
+	if prefix != "" {
+		qs.Set("prefix", prefix)
+	}
+
+	var sort string
+	if o.Sort != nil {
+		sort = *o.Sort
+	}
+	if sort != "" {
+		qs.Set("sort", sort)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// GetRepositoryForksHandlerFunc turns a function with the right signature into a get repository forks handler
+type GetRepositoryForksHandlerFunc func(GetRepositoryForksParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetRepositoryForksHandlerFunc) Handle(params GetRepositoryForksParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetRepositoryForksHandler interface for that can handle valid get repository forks params
+type GetRepositoryForksHandler interface {
+	Handle(GetRepositoryForksParams) middleware.Responder
+}
+
+// NewGetRepositoryForks creates a new http.Handler for the get repository forks operation
+func NewGetRepositoryForks(ctx *middleware.Context, handler GetRepositoryForksHandler) *GetRepositoryForks {
+	return &GetRepositoryForks{Context: ctx, Handler: handler}
+}
+
+/*GetRepositoryForks swagger:route GET /repositories/{owner}/{name}/forks repositories getRepositoryForks
+
+Get the forks of a repository
+
+*/
+type GetRepositoryForks struct {
+	Context *middleware.Context
+	Handler GetRepositoryForksHandler
+}
+
+func (o *GetRepositoryForks) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetRepositoryForksParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
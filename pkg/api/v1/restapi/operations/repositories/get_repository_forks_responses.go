@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// GetRepositoryForksOKCode is the HTTP code returned for type GetRepositoryForksOK
+const GetRepositoryForksOKCode int = 200
+
+/*GetRepositoryForksOK The forks of the repository with their owners
+
+swagger:response getRepositoryForksOK
+*/
+type GetRepositoryForksOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Repository `json:"body,omitempty"`
+}
+
+// NewGetRepositoryForksOK creates GetRepositoryForksOK with default headers values
+func NewGetRepositoryForksOK() *GetRepositoryForksOK {
+
+	return &GetRepositoryForksOK{}
+}
+
+// WithPayload adds the payload to the get repository forks o k response
+func (o *GetRepositoryForksOK) WithPayload(payload []*models.Repository) *GetRepositoryForksOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository forks o k response
+func (o *GetRepositoryForksOK) SetPayload(payload []*models.Repository) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryForksOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Repository, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// GetRepositoryForksNotFoundCode is the HTTP code returned for type GetRepositoryForksNotFound
+const GetRepositoryForksNotFoundCode int = 404
+
+/*GetRepositoryForksNotFound The owner and name combination could not be found
+
+swagger:response getRepositoryForksNotFound
+*/
+type GetRepositoryForksNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetRepositoryForksNotFound creates GetRepositoryForksNotFound with default headers values
+func NewGetRepositoryForksNotFound() *GetRepositoryForksNotFound {
+
+	return &GetRepositoryForksNotFound{}
+}
+
+// WithPayload adds the payload to the get repository forks not found response
+func (o *GetRepositoryForksNotFound) WithPayload(payload *models.Error) *GetRepositoryForksNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository forks not found response
+func (o *GetRepositoryForksNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryForksNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetRepositoryForksDefault unexpected error
+
+swagger:response getRepositoryForksDefault
+*/
+type GetRepositoryForksDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetRepositoryForksDefault creates GetRepositoryForksDefault with default headers values
+func NewGetRepositoryForksDefault(code int) *GetRepositoryForksDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetRepositoryForksDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get repository forks default response
+func (o *GetRepositoryForksDefault) WithStatusCode(code int) *GetRepositoryForksDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get repository forks default response
+func (o *GetRepositoryForksDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get repository forks default response
+func (o *GetRepositoryForksDefault) WithPayload(payload *models.Error) *GetRepositoryForksDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository forks default response
+func (o *GetRepositoryForksDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryForksDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// GetRepositoryImportHandlerFunc turns a function with the right signature into a get repository import handler
+type GetRepositoryImportHandlerFunc func(GetRepositoryImportParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetRepositoryImportHandlerFunc) Handle(params GetRepositoryImportParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetRepositoryImportHandler interface for that can handle valid get repository import params
+type GetRepositoryImportHandler interface {
+	Handle(GetRepositoryImportParams) middleware.Responder
+}
+
+// NewGetRepositoryImport creates a new http.Handler for the get repository import operation
+func NewGetRepositoryImport(ctx *middleware.Context, handler GetRepositoryImportHandler) *GetRepositoryImport {
+	return &GetRepositoryImport{Context: ctx, Handler: handler}
+}
+
+/*GetRepositoryImport swagger:route GET /repositories/import/{id} repositories getRepositoryImport
+
+Get the status of an import
+
+*/
+type GetRepositoryImport struct {
+	Context *middleware.Context
+	Handler GetRepositoryImportHandler
+}
+
+func (o *GetRepositoryImport) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetRepositoryImportParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// GetRepositoryImportOKCode is the HTTP code returned for type GetRepositoryImportOK
+const GetRepositoryImportOKCode int = 200
+
+/*GetRepositoryImportOK The import's status
+
+swagger:response getRepositoryImportOK
+*/
+type GetRepositoryImportOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ImportJob `json:"body,omitempty"`
+}
+
+// NewGetRepositoryImportOK creates GetRepositoryImportOK with default headers values
+func NewGetRepositoryImportOK() *GetRepositoryImportOK {
+
+	return &GetRepositoryImportOK{}
+}
+
+// WithPayload adds the payload to the get repository import o k response
+func (o *GetRepositoryImportOK) WithPayload(payload *models.ImportJob) *GetRepositoryImportOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository import o k response
+func (o *GetRepositoryImportOK) SetPayload(payload *models.ImportJob) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryImportOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetRepositoryImportNotFoundCode is the HTTP code returned for type GetRepositoryImportNotFound
+const GetRepositoryImportNotFoundCode int = 404
+
+/*GetRepositoryImportNotFound The import could not be found
+
+swagger:response getRepositoryImportNotFound
+*/
+type GetRepositoryImportNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetRepositoryImportNotFound creates GetRepositoryImportNotFound with default headers values
+func NewGetRepositoryImportNotFound() *GetRepositoryImportNotFound {
+
+	return &GetRepositoryImportNotFound{}
+}
+
+// WithPayload adds the payload to the get repository import not found response
+func (o *GetRepositoryImportNotFound) WithPayload(payload *models.Error) *GetRepositoryImportNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository import not found response
+func (o *GetRepositoryImportNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryImportNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetRepositoryImportDefault unexpected error
+
+swagger:response getRepositoryImportDefault
+*/
+type GetRepositoryImportDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetRepositoryImportDefault creates GetRepositoryImportDefault with default headers values
+func NewGetRepositoryImportDefault(code int) *GetRepositoryImportDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetRepositoryImportDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get repository import default response
+func (o *GetRepositoryImportDefault) WithStatusCode(code int) *GetRepositoryImportDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get repository import default response
+func (o *GetRepositoryImportDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get repository import default response
+func (o *GetRepositoryImportDefault) WithPayload(payload *models.Error) *GetRepositoryImportDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository import default response
+func (o *GetRepositoryImportDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryImportDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// GetRepositoryNetworkHandlerFunc turns a function with the right signature into a get repository network handler
+type GetRepositoryNetworkHandlerFunc func(GetRepositoryNetworkParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetRepositoryNetworkHandlerFunc) Handle(params GetRepositoryNetworkParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetRepositoryNetworkHandler interface for that can handle valid get repository network params
+type GetRepositoryNetworkHandler interface {
+	Handle(GetRepositoryNetworkParams) middleware.Responder
+}
+
+// NewGetRepositoryNetwork creates a new http.Handler for the get repository network operation
+func NewGetRepositoryNetwork(ctx *middleware.Context, handler GetRepositoryNetworkHandler) *GetRepositoryNetwork {
+	return &GetRepositoryNetwork{Context: ctx, Handler: handler}
+}
+
+/*GetRepositoryNetwork swagger:route GET /repositories/{owner}/{name}/network repositories getRepositoryNetwork
+
+Get the fork network of a repository
+
+*/
+type GetRepositoryNetwork struct {
+	Context *middleware.Context
+	Handler GetRepositoryNetworkHandler
+}
+
+func (o *GetRepositoryNetwork) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetRepositoryNetworkParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// GetRepositoryNetworkOKCode is the HTTP code returned for type GetRepositoryNetworkOK
+const GetRepositoryNetworkOKCode int = 200
+
+/*GetRepositoryNetworkOK The repository the network was forked from originally, followed by all forks level by level
+
+swagger:response getRepositoryNetworkOK
+*/
+type GetRepositoryNetworkOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Repository `json:"body,omitempty"`
+}
+
+// NewGetRepositoryNetworkOK creates GetRepositoryNetworkOK with default headers values
+func NewGetRepositoryNetworkOK() *GetRepositoryNetworkOK {
+
+	return &GetRepositoryNetworkOK{}
+}
+
+// WithPayload adds the payload to the get repository network o k response
+func (o *GetRepositoryNetworkOK) WithPayload(payload []*models.Repository) *GetRepositoryNetworkOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository network o k response
+func (o *GetRepositoryNetworkOK) SetPayload(payload []*models.Repository) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryNetworkOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Repository, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// GetRepositoryNetworkNotFoundCode is the HTTP code returned for type GetRepositoryNetworkNotFound
+const GetRepositoryNetworkNotFoundCode int = 404
+
+/*GetRepositoryNetworkNotFound The owner and name combination could not be found
+
+swagger:response getRepositoryNetworkNotFound
+*/
+type GetRepositoryNetworkNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetRepositoryNetworkNotFound creates GetRepositoryNetworkNotFound with default headers values
+func NewGetRepositoryNetworkNotFound() *GetRepositoryNetworkNotFound {
+
+	return &GetRepositoryNetworkNotFound{}
+}
+
+// WithPayload adds the payload to the get repository network not found response
+func (o *GetRepositoryNetworkNotFound) WithPayload(payload *models.Error) *GetRepositoryNetworkNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository network not found response
+func (o *GetRepositoryNetworkNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryNetworkNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetRepositoryNetworkDefault unexpected error
+
+swagger:response getRepositoryNetworkDefault
+*/
+type GetRepositoryNetworkDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetRepositoryNetworkDefault creates GetRepositoryNetworkDefault with default headers values
+func NewGetRepositoryNetworkDefault(code int) *GetRepositoryNetworkDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetRepositoryNetworkDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get repository network default response
+func (o *GetRepositoryNetworkDefault) WithStatusCode(code int) *GetRepositoryNetworkDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get repository network default response
+func (o *GetRepositoryNetworkDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get repository network default response
+func (o *GetRepositoryNetworkDefault) WithPayload(payload *models.Error) *GetRepositoryNetworkDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository network default response
+func (o *GetRepositoryNetworkDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryNetworkDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
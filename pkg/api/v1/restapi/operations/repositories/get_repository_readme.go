@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// GetRepositoryReadmeHandlerFunc turns a function with the right signature into a get repository readme handler
+type GetRepositoryReadmeHandlerFunc func(GetRepositoryReadmeParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetRepositoryReadmeHandlerFunc) Handle(params GetRepositoryReadmeParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetRepositoryReadmeHandler interface for that can handle valid get repository readme params
+type GetRepositoryReadmeHandler interface {
+	Handle(GetRepositoryReadmeParams) middleware.Responder
+}
+
+// NewGetRepositoryReadme creates a new http.Handler for the get repository readme operation
+func NewGetRepositoryReadme(ctx *middleware.Context, handler GetRepositoryReadmeHandler) *GetRepositoryReadme {
+	return &GetRepositoryReadme{Context: ctx, Handler: handler}
+}
+
+/*GetRepositoryReadme swagger:route GET /repositories/{owner}/{name}/readme repositories getRepositoryReadme
+
+Get the README of a repository rendered as HTML
+
+*/
+type GetRepositoryReadme struct {
+	Context *middleware.Context
+	Handler GetRepositoryReadmeHandler
+}
+
+func (o *GetRepositoryReadme) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetRepositoryReadmeParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,204 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// GetRepositoryReadmeOKCode is the HTTP code returned for type GetRepositoryReadmeOK
+const GetRepositoryReadmeOKCode int = 200
+
+/*GetRepositoryReadmeOK The repository's README
+
+swagger:response getRepositoryReadmeOK
+*/
+type GetRepositoryReadmeOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Readme `json:"body,omitempty"`
+}
+
+// NewGetRepositoryReadmeOK creates GetRepositoryReadmeOK with default headers values
+func NewGetRepositoryReadmeOK() *GetRepositoryReadmeOK {
+
+	return &GetRepositoryReadmeOK{}
+}
+
+// WithPayload adds the payload to the get repository readme o k response
+func (o *GetRepositoryReadmeOK) WithPayload(payload *models.Readme) *GetRepositoryReadmeOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository readme o k response
+func (o *GetRepositoryReadmeOK) SetPayload(payload *models.Readme) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryReadmeOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetRepositoryReadmeNotFoundCode is the HTTP code returned for type GetRepositoryReadmeNotFound
+const GetRepositoryReadmeNotFoundCode int = 404
+
+/*GetRepositoryReadmeNotFound The repository, ref or README could not be found
+
+swagger:response getRepositoryReadmeNotFound
+*/
+type GetRepositoryReadmeNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetRepositoryReadmeNotFound creates GetRepositoryReadmeNotFound with default headers values
+func NewGetRepositoryReadmeNotFound() *GetRepositoryReadmeNotFound {
+
+	return &GetRepositoryReadmeNotFound{}
+}
+
+// WithPayload adds the payload to the get repository readme not found response
+func (o *GetRepositoryReadmeNotFound) WithPayload(payload *models.Error) *GetRepositoryReadmeNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository readme not found response
+func (o *GetRepositoryReadmeNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryReadmeNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetRepositoryReadmeUnprocessableEntityCode is the HTTP code returned for type GetRepositoryReadmeUnprocessableEntity
+const GetRepositoryReadmeUnprocessableEntityCode int = 422
+
+/*GetRepositoryReadmeUnprocessableEntity The README is too large to be rendered
+
+swagger:response getRepositoryReadmeUnprocessableEntity
+*/
+type GetRepositoryReadmeUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetRepositoryReadmeUnprocessableEntity creates GetRepositoryReadmeUnprocessableEntity with default headers values
+func NewGetRepositoryReadmeUnprocessableEntity() *GetRepositoryReadmeUnprocessableEntity {
+
+	return &GetRepositoryReadmeUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the get repository readme unprocessable entity response
+func (o *GetRepositoryReadmeUnprocessableEntity) WithPayload(payload *models.Error) *GetRepositoryReadmeUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository readme unprocessable entity response
+func (o *GetRepositoryReadmeUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryReadmeUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetRepositoryReadmeDefault unexpected error
+
+swagger:response getRepositoryReadmeDefault
+*/
+type GetRepositoryReadmeDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetRepositoryReadmeDefault creates GetRepositoryReadmeDefault with default headers values
+func NewGetRepositoryReadmeDefault(code int) *GetRepositoryReadmeDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetRepositoryReadmeDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get repository readme default response
+func (o *GetRepositoryReadmeDefault) WithStatusCode(code int) *GetRepositoryReadmeDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get repository readme default response
+func (o *GetRepositoryReadmeDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get repository readme default response
+func (o *GetRepositoryReadmeDefault) WithPayload(payload *models.Error) *GetRepositoryReadmeDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get repository readme default response
+func (o *GetRepositoryReadmeDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetRepositoryReadmeDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
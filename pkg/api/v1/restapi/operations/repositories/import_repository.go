@@ -0,0 +1,164 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+	validate "github.com/go-openapi/validate"
+)
+
+// ImportRepositoryHandlerFunc turns a function with the right signature into a import repository handler
+type ImportRepositoryHandlerFunc func(ImportRepositoryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ImportRepositoryHandlerFunc) Handle(params ImportRepositoryParams) middleware.Responder {
+	return fn(params)
+}
+
+// ImportRepositoryHandler interface for that can handle valid import repository params
+type ImportRepositoryHandler interface {
+	Handle(ImportRepositoryParams) middleware.Responder
+}
+
+// NewImportRepository creates a new http.Handler for the import repository operation
+func NewImportRepository(ctx *middleware.Context, handler ImportRepositoryHandler) *ImportRepository {
+	return &ImportRepository{Context: ctx, Handler: handler}
+}
+
+/*ImportRepository swagger:route POST /repositories/import repositories importRepository
+
+Import a repository from an external git URL
+
+The repository is created right away and its branches and tags are imported in the background. If the import fails the repository is deleted again.
+
+*/
+type ImportRepository struct {
+	Context *middleware.Context
+	Handler ImportRepositoryHandler
+}
+
+func (o *ImportRepository) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewImportRepositoryParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// ImportRepositoryBody import repository body
+// swagger:model ImportRepositoryBody
+type ImportRepositoryBody struct {
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// name
+	// Required: true
+	Name *string `json:"name"`
+
+	// The password or token to authenticate with, it's not stored
+	// Format: password
+	Password strfmt.Password `json:"password,omitempty"`
+
+	// Private repositories are only visible to their owner
+	Private bool `json:"private,omitempty"`
+
+	// The http, https or git URL to import from
+	// Required: true
+	URL *string `json:"url"`
+
+	// The username to authenticate with, it's not stored
+	Username string `json:"username,omitempty"`
+
+	// website
+	Website string `json:"website,omitempty"`
+}
+
+// Validate validates this import repository body
+func (o *ImportRepositoryBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validatePassword(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ImportRepositoryBody) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("import"+"."+"name", "body", o.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ImportRepositoryBody) validatePassword(formats strfmt.Registry) error {
+
+	if swag.IsZero(o.Password) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("import"+"."+"password", "body", "password", o.Password.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ImportRepositoryBody) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("import"+"."+"url", "body", o.URL); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ImportRepositoryBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ImportRepositoryBody) UnmarshalBinary(b []byte) error {
+	var res ImportRepositoryBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
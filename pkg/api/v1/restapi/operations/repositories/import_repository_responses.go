@@ -0,0 +1,204 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ImportRepositoryAcceptedCode is the HTTP code returned for type ImportRepositoryAccepted
+const ImportRepositoryAcceptedCode int = 202
+
+/*ImportRepositoryAccepted The repository has been created and its import started
+
+swagger:response importRepositoryAccepted
+*/
+type ImportRepositoryAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ImportJob `json:"body,omitempty"`
+}
+
+// NewImportRepositoryAccepted creates ImportRepositoryAccepted with default headers values
+func NewImportRepositoryAccepted() *ImportRepositoryAccepted {
+
+	return &ImportRepositoryAccepted{}
+}
+
+// WithPayload adds the payload to the import repository accepted response
+func (o *ImportRepositoryAccepted) WithPayload(payload *models.ImportJob) *ImportRepositoryAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the import repository accepted response
+func (o *ImportRepositoryAccepted) SetPayload(payload *models.ImportJob) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportRepositoryAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportRepositoryConflictCode is the HTTP code returned for type ImportRepositoryConflict
+const ImportRepositoryConflictCode int = 409
+
+/*ImportRepositoryConflict A repository with the name already exists
+
+swagger:response importRepositoryConflict
+*/
+type ImportRepositoryConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewImportRepositoryConflict creates ImportRepositoryConflict with default headers values
+func NewImportRepositoryConflict() *ImportRepositoryConflict {
+
+	return &ImportRepositoryConflict{}
+}
+
+// WithPayload adds the payload to the import repository conflict response
+func (o *ImportRepositoryConflict) WithPayload(payload *models.Error) *ImportRepositoryConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the import repository conflict response
+func (o *ImportRepositoryConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportRepositoryConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ImportRepositoryUnprocessableEntityCode is the HTTP code returned for type ImportRepositoryUnprocessableEntity
+const ImportRepositoryUnprocessableEntityCode int = 422
+
+/*ImportRepositoryUnprocessableEntity The import has not been started due to invalid input
+
+swagger:response importRepositoryUnprocessableEntity
+*/
+type ImportRepositoryUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewImportRepositoryUnprocessableEntity creates ImportRepositoryUnprocessableEntity with default headers values
+func NewImportRepositoryUnprocessableEntity() *ImportRepositoryUnprocessableEntity {
+
+	return &ImportRepositoryUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the import repository unprocessable entity response
+func (o *ImportRepositoryUnprocessableEntity) WithPayload(payload *models.ValidationError) *ImportRepositoryUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the import repository unprocessable entity response
+func (o *ImportRepositoryUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportRepositoryUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ImportRepositoryDefault unexpected error
+
+swagger:response importRepositoryDefault
+*/
+type ImportRepositoryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewImportRepositoryDefault creates ImportRepositoryDefault with default headers values
+func NewImportRepositoryDefault(code int) *ImportRepositoryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ImportRepositoryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the import repository default response
+func (o *ImportRepositoryDefault) WithStatusCode(code int) *ImportRepositoryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the import repository default response
+func (o *ImportRepositoryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the import repository default response
+func (o *ImportRepositoryDefault) WithPayload(payload *models.Error) *ImportRepositoryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the import repository default response
+func (o *ImportRepositoryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ImportRepositoryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
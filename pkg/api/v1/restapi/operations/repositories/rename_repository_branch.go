@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+	validate "github.com/go-openapi/validate"
+)
+
+// RenameRepositoryBranchHandlerFunc turns a function with the right signature into a rename repository branch handler
+type RenameRepositoryBranchHandlerFunc func(RenameRepositoryBranchParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RenameRepositoryBranchHandlerFunc) Handle(params RenameRepositoryBranchParams) middleware.Responder {
+	return fn(params)
+}
+
+// RenameRepositoryBranchHandler interface for that can handle valid rename repository branch params
+type RenameRepositoryBranchHandler interface {
+	Handle(RenameRepositoryBranchParams) middleware.Responder
+}
+
+// NewRenameRepositoryBranch creates a new http.Handler for the rename repository branch operation
+func NewRenameRepositoryBranch(ctx *middleware.Context, handler RenameRepositoryBranchHandler) *RenameRepositoryBranch {
+	return &RenameRepositoryBranch{Context: ctx, Handler: handler}
+}
+
+/*RenameRepositoryBranch swagger:route PATCH /repositories/{owner}/{name}/branches/{branch} repositories renameRepositoryBranch
+
+Rename a branch
+
+*/
+type RenameRepositoryBranch struct {
+	Context *middleware.Context
+	Handler RenameRepositoryBranchHandler
+}
+
+func (o *RenameRepositoryBranch) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewRenameRepositoryBranchParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// RenameRepositoryBranchBody rename repository branch body
+// swagger:model RenameRepositoryBranchBody
+type RenameRepositoryBranchBody struct {
+
+	// name
+	// Required: true
+	Name *string `json:"name"`
+}
+
+// Validate validates this rename repository branch body
+func (o *RenameRepositoryBranchBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *RenameRepositoryBranchBody) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("renamedBranch"+"."+"name", "body", o.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *RenameRepositoryBranchBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *RenameRepositoryBranchBody) UnmarshalBinary(b []byte) error {
+	var res RenameRepositoryBranchBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
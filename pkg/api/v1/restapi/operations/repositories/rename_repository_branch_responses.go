@@ -0,0 +1,292 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// RenameRepositoryBranchOKCode is the HTTP code returned for type RenameRepositoryBranchOK
+const RenameRepositoryBranchOKCode int = 200
+
+/*RenameRepositoryBranchOK The branch has been renamed and is returned to you
+
+swagger:response renameRepositoryBranchOK
+*/
+type RenameRepositoryBranchOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Branch `json:"body,omitempty"`
+}
+
+// NewRenameRepositoryBranchOK creates RenameRepositoryBranchOK with default headers values
+func NewRenameRepositoryBranchOK() *RenameRepositoryBranchOK {
+
+	return &RenameRepositoryBranchOK{}
+}
+
+// WithPayload adds the payload to the rename repository branch o k response
+func (o *RenameRepositoryBranchOK) WithPayload(payload *models.Branch) *RenameRepositoryBranchOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rename repository branch o k response
+func (o *RenameRepositoryBranchOK) SetPayload(payload *models.Branch) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RenameRepositoryBranchOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RenameRepositoryBranchForbiddenCode is the HTTP code returned for type RenameRepositoryBranchForbidden
+const RenameRepositoryBranchForbiddenCode int = 403
+
+/*RenameRepositoryBranchForbidden The branch is protected or the user isn't the owner
+
+swagger:response renameRepositoryBranchForbidden
+*/
+type RenameRepositoryBranchForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRenameRepositoryBranchForbidden creates RenameRepositoryBranchForbidden with default headers values
+func NewRenameRepositoryBranchForbidden() *RenameRepositoryBranchForbidden {
+
+	return &RenameRepositoryBranchForbidden{}
+}
+
+// WithPayload adds the payload to the rename repository branch forbidden response
+func (o *RenameRepositoryBranchForbidden) WithPayload(payload *models.Error) *RenameRepositoryBranchForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rename repository branch forbidden response
+func (o *RenameRepositoryBranchForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RenameRepositoryBranchForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RenameRepositoryBranchNotFoundCode is the HTTP code returned for type RenameRepositoryBranchNotFound
+const RenameRepositoryBranchNotFoundCode int = 404
+
+/*RenameRepositoryBranchNotFound The repository or the branch could not be found
+
+swagger:response renameRepositoryBranchNotFound
+*/
+type RenameRepositoryBranchNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRenameRepositoryBranchNotFound creates RenameRepositoryBranchNotFound with default headers values
+func NewRenameRepositoryBranchNotFound() *RenameRepositoryBranchNotFound {
+
+	return &RenameRepositoryBranchNotFound{}
+}
+
+// WithPayload adds the payload to the rename repository branch not found response
+func (o *RenameRepositoryBranchNotFound) WithPayload(payload *models.Error) *RenameRepositoryBranchNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rename repository branch not found response
+func (o *RenameRepositoryBranchNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RenameRepositoryBranchNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RenameRepositoryBranchConflictCode is the HTTP code returned for type RenameRepositoryBranchConflict
+const RenameRepositoryBranchConflictCode int = 409
+
+/*RenameRepositoryBranchConflict A branch with the new name already exists or the branch has been changed
+
+swagger:response renameRepositoryBranchConflict
+*/
+type RenameRepositoryBranchConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRenameRepositoryBranchConflict creates RenameRepositoryBranchConflict with default headers values
+func NewRenameRepositoryBranchConflict() *RenameRepositoryBranchConflict {
+
+	return &RenameRepositoryBranchConflict{}
+}
+
+// WithPayload adds the payload to the rename repository branch conflict response
+func (o *RenameRepositoryBranchConflict) WithPayload(payload *models.Error) *RenameRepositoryBranchConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rename repository branch conflict response
+func (o *RenameRepositoryBranchConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RenameRepositoryBranchConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RenameRepositoryBranchUnprocessableEntityCode is the HTTP code returned for type RenameRepositoryBranchUnprocessableEntity
+const RenameRepositoryBranchUnprocessableEntityCode int = 422
+
+/*RenameRepositoryBranchUnprocessableEntity The new branch name is not valid
+
+swagger:response renameRepositoryBranchUnprocessableEntity
+*/
+type RenameRepositoryBranchUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewRenameRepositoryBranchUnprocessableEntity creates RenameRepositoryBranchUnprocessableEntity with default headers values
+func NewRenameRepositoryBranchUnprocessableEntity() *RenameRepositoryBranchUnprocessableEntity {
+
+	return &RenameRepositoryBranchUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the rename repository branch unprocessable entity response
+func (o *RenameRepositoryBranchUnprocessableEntity) WithPayload(payload *models.ValidationError) *RenameRepositoryBranchUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rename repository branch unprocessable entity response
+func (o *RenameRepositoryBranchUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RenameRepositoryBranchUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*RenameRepositoryBranchDefault unexpected error
+
+swagger:response renameRepositoryBranchDefault
+*/
+type RenameRepositoryBranchDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRenameRepositoryBranchDefault creates RenameRepositoryBranchDefault with default headers values
+func NewRenameRepositoryBranchDefault(code int) *RenameRepositoryBranchDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RenameRepositoryBranchDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the rename repository branch default response
+func (o *RenameRepositoryBranchDefault) WithStatusCode(code int) *RenameRepositoryBranchDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the rename repository branch default response
+func (o *RenameRepositoryBranchDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the rename repository branch default response
+func (o *RenameRepositoryBranchDefault) WithPayload(payload *models.Error) *RenameRepositoryBranchDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the rename repository branch default response
+func (o *RenameRepositoryBranchDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RenameRepositoryBranchDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
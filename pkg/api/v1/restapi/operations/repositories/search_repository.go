@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// SearchRepositoryHandlerFunc turns a function with the right signature into a search repository handler
+type SearchRepositoryHandlerFunc func(SearchRepositoryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SearchRepositoryHandlerFunc) Handle(params SearchRepositoryParams) middleware.Responder {
+	return fn(params)
+}
+
+// SearchRepositoryHandler interface for that can handle valid search repository params
+type SearchRepositoryHandler interface {
+	Handle(SearchRepositoryParams) middleware.Responder
+}
+
+// NewSearchRepository creates a new http.Handler for the search repository operation
+func NewSearchRepository(ctx *middleware.Context, handler SearchRepositoryHandler) *SearchRepository {
+	return &SearchRepository{Context: ctx, Handler: handler}
+}
+
+/*SearchRepository swagger:route GET /repositories/{owner}/{name}/search repositories searchRepository
+
+Search the files of a repository
+
+*/
+type SearchRepository struct {
+	Context *middleware.Context
+	Handler SearchRepositoryHandler
+}
+
+func (o *SearchRepository) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewSearchRepositoryParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,385 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewSearchRepositoryParams creates a new SearchRepositoryParams object
+// with the default values initialized.
+func NewSearchRepositoryParams() SearchRepositoryParams {
+
+	var (
+		// initialize parameters with default values
+
+		contextDefault    = int64(0)
+		ignoreCaseDefault = bool(false)
+		limitDefault      = int64(100)
+
+		regexpDefault = bool(false)
+	)
+
+	return SearchRepositoryParams{
+		Context: &contextDefault,
+
+		IgnoreCase: &ignoreCaseDefault,
+
+		Limit: &limitDefault,
+
+		Regexp: &regexpDefault,
+	}
+}
+
+// SearchRepositoryParams contains all the bound params for the search repository operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters searchRepository
+type SearchRepositoryParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The number of lines returned before and after each match
+	  Maximum: 5
+	  Minimum: 0
+	  In: query
+	  Default: 0
+	*/
+	Context *int64
+	/*Match regardless of case
+	  In: query
+	  Default: false
+	*/
+	IgnoreCase *bool
+	/*The maximum number of matches returned
+	  Maximum: 1000
+	  Minimum: 1
+	  In: query
+	  Default: 100
+	*/
+	Limit *int64
+	/*The repository's name
+	  Required: true
+	  In: path
+	*/
+	Name string
+	/*The owner's username
+	  Required: true
+	  In: path
+	*/
+	Owner string
+	/*Only search files matching one of the globs, e.g. *[*]/*.go
+	  In: query
+	  Collection Format: multi
+	*/
+	Path []string
+	/*The text or regular expression to search for
+	  Required: true
+	  In: query
+	*/
+	Q string
+	/*The ref to search, defaults to the default branch
+	  In: query
+	*/
+	Ref *string
+	/*Interpret q as POSIX extended regular expression
+	  In: query
+	  Default: false
+	*/
+	Regexp *bool
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewSearchRepositoryParams() beforehand.
+func (o *SearchRepositoryParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qContext, qhkContext, _ := qs.GetOK("context")
+	if err := o.bindContext(qContext, qhkContext, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qIgnoreCase, qhkIgnoreCase, _ := qs.GetOK("ignore_case")
+	if err := o.bindIgnoreCase(qIgnoreCase, qhkIgnoreCase, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rName, rhkName, _ := route.Params.GetOK("name")
+	if err := o.bindName(rName, rhkName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rOwner, rhkOwner, _ := route.Params.GetOK("owner")
+	if err := o.bindOwner(rOwner, rhkOwner, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPath, qhkPath, _ := qs.GetOK("path")
+	if err := o.bindPath(qPath, qhkPath, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qQ, qhkQ, _ := qs.GetOK("q")
+	if err := o.bindQ(qQ, qhkQ, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qRef, qhkRef, _ := qs.GetOK("ref")
+	if err := o.bindRef(qRef, qhkRef, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qRegexp, qhkRegexp, _ := qs.GetOK("regexp")
+	if err := o.bindRegexp(qRegexp, qhkRegexp, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindContext binds and validates parameter Context from query.
+func (o *SearchRepositoryParams) bindContext(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewSearchRepositoryParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("context", "query", "int64", raw)
+	}
+	o.Context = &value
+
+	if err := o.validateContext(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateContext carries on validations for parameter Context
+func (o *SearchRepositoryParams) validateContext(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("context", "query", int64(*o.Context), 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("context", "query", int64(*o.Context), 5, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindIgnoreCase binds and validates parameter IgnoreCase from query.
+func (o *SearchRepositoryParams) bindIgnoreCase(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewSearchRepositoryParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("ignore_case", "query", "bool", raw)
+	}
+	o.IgnoreCase = &value
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *SearchRepositoryParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewSearchRepositoryParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *SearchRepositoryParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", int64(*o.Limit), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", int64(*o.Limit), 1000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindName binds and validates parameter Name from path.
+func (o *SearchRepositoryParams) bindName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Name = raw
+
+	return nil
+}
+
+// bindOwner binds and validates parameter Owner from path.
+func (o *SearchRepositoryParams) bindOwner(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	o.Owner = raw
+
+	return nil
+}
+
+// bindPath binds and validates array parameter Path from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *SearchRepositoryParams) bindPath(rawData []string, hasKey bool, formats strfmt.Registry) error {
+
+	// CollectionFormat: multi
+	pathIC := rawData
+
+	if len(pathIC) == 0 {
+		return nil
+	}
+
+	var pathIR []string
+	for _, pathIV := range pathIC {
+		pathI := pathIV
+
+		pathIR = append(pathIR, pathI)
+	}
+
+	o.Path = pathIR
+
+	return nil
+}
+
+// bindQ binds and validates parameter Q from query.
+func (o *SearchRepositoryParams) bindQ(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("q", "query")
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+	if err := validate.RequiredString("q", "query", raw); err != nil {
+		return err
+	}
+
+	o.Q = raw
+
+	return nil
+}
+
+// bindRef binds and validates parameter Ref from query.
+func (o *SearchRepositoryParams) bindRef(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Ref = &raw
+
+	return nil
+}
+
+// bindRegexp binds and validates parameter Regexp from query.
+func (o *SearchRepositoryParams) bindRegexp(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewSearchRepositoryParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("regexp", "query", "bool", raw)
+	}
+	o.Regexp = &value
+
+	return nil
+}
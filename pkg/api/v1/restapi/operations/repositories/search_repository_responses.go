@@ -0,0 +1,207 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// SearchRepositoryOKCode is the HTTP code returned for type SearchRepositoryOK
+const SearchRepositoryOKCode int = 200
+
+/*SearchRepositoryOK The lines matching the query
+
+swagger:response searchRepositoryOK
+*/
+type SearchRepositoryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.SearchMatch `json:"body,omitempty"`
+}
+
+// NewSearchRepositoryOK creates SearchRepositoryOK with default headers values
+func NewSearchRepositoryOK() *SearchRepositoryOK {
+
+	return &SearchRepositoryOK{}
+}
+
+// WithPayload adds the payload to the search repository o k response
+func (o *SearchRepositoryOK) WithPayload(payload []*models.SearchMatch) *SearchRepositoryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search repository o k response
+func (o *SearchRepositoryOK) SetPayload(payload []*models.SearchMatch) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchRepositoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.SearchMatch, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// SearchRepositoryNotFoundCode is the HTTP code returned for type SearchRepositoryNotFound
+const SearchRepositoryNotFoundCode int = 404
+
+/*SearchRepositoryNotFound The owner and name combination or the ref could not be found
+
+swagger:response searchRepositoryNotFound
+*/
+type SearchRepositoryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSearchRepositoryNotFound creates SearchRepositoryNotFound with default headers values
+func NewSearchRepositoryNotFound() *SearchRepositoryNotFound {
+
+	return &SearchRepositoryNotFound{}
+}
+
+// WithPayload adds the payload to the search repository not found response
+func (o *SearchRepositoryNotFound) WithPayload(payload *models.Error) *SearchRepositoryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search repository not found response
+func (o *SearchRepositoryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchRepositoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SearchRepositoryUnprocessableEntityCode is the HTTP code returned for type SearchRepositoryUnprocessableEntity
+const SearchRepositoryUnprocessableEntityCode int = 422
+
+/*SearchRepositoryUnprocessableEntity The query is not valid
+
+swagger:response searchRepositoryUnprocessableEntity
+*/
+type SearchRepositoryUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSearchRepositoryUnprocessableEntity creates SearchRepositoryUnprocessableEntity with default headers values
+func NewSearchRepositoryUnprocessableEntity() *SearchRepositoryUnprocessableEntity {
+
+	return &SearchRepositoryUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the search repository unprocessable entity response
+func (o *SearchRepositoryUnprocessableEntity) WithPayload(payload *models.Error) *SearchRepositoryUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search repository unprocessable entity response
+func (o *SearchRepositoryUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchRepositoryUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SearchRepositoryDefault unexpected error
+
+swagger:response searchRepositoryDefault
+*/
+type SearchRepositoryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSearchRepositoryDefault creates SearchRepositoryDefault with default headers values
+func NewSearchRepositoryDefault(code int) *SearchRepositoryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SearchRepositoryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the search repository default response
+func (o *SearchRepositoryDefault) WithStatusCode(code int) *SearchRepositoryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the search repository default response
+func (o *SearchRepositoryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the search repository default response
+func (o *SearchRepositoryDefault) WithPayload(payload *models.Error) *SearchRepositoryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search repository default response
+func (o *SearchRepositoryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchRepositoryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,180 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+
+	"github.com/go-openapi/swag"
+)
+
+// SearchRepositoryURL generates an URL for the search repository operation
+type SearchRepositoryURL struct {
+	Name  string
+	Owner string
+
+	Context    *int64
+	IgnoreCase *bool
+	Limit      *int64
+	Path       []string
+	Q          string
+	Ref        *string
+	Regexp     *bool
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *SearchRepositoryURL) WithBasePath(bp string) *SearchRepositoryURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *SearchRepositoryURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *SearchRepositoryURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/repositories/{owner}/{name}/search"
+
+	name := o.Name
+	if name != "" {
+		_path = strings.Replace(_path, "{name}", name, -1)
+	} else {
+		return nil, errors.New("Name is required on SearchRepositoryURL")
+	}
+
+	owner := o.Owner
+	if owner != "" {
+		_path = strings.Replace(_path, "{owner}", owner, -1)
+	} else {
+		return nil, errors.New("Owner is required on SearchRepositoryURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var context string
+	if o.Context != nil {
+		context = swag.FormatInt64(*o.Context)
+	}
+	if context != "" {
+		qs.Set("context", context)
+	}
+
+	var ignoreCase string
+	if o.IgnoreCase != nil {
+		ignoreCase = swag.FormatBool(*o.IgnoreCase)
+	}
+	if ignoreCase != "" {
+		qs.Set("ignore_case", ignoreCase)
+	}
+
+	var limit string
+	if o.Limit != nil {
+		limit = swag.FormatInt64(*o.Limit)
+	}
+	if limit != "" {
+		qs.Set("limit", limit)
+	}
+
+	var pathIR []string
+	for _, pathI := range o.Path {
+		pathIS := pathI
+		if pathIS != "" {
+			pathIR = append(pathIR, pathIS)
+		}
+	}
+
+	path := swag.JoinByFormat(pathIR, "multi")
+
+	for _, qsv := range path {
+		qs.Add("path", qsv)
+	}
+
+	q := o.Q
+	if q != "" {
+		qs.Set("q", q)
+	}
+
+	var ref string
+	if o.Ref != nil {
+		ref = *o.Ref
+	}
+	if ref != "" {
+		qs.Set("ref", ref)
+	}
+
+	var regexp string
+	if o.Regexp != nil {
+		regexp = swag.FormatBool(*o.Regexp)
+	}
+	if regexp != "" {
+		qs.Set("regexp", regexp)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *SearchRepositoryURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *SearchRepositoryURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *SearchRepositoryURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on SearchRepositoryURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on SearchRepositoryURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *SearchRepositoryURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	errors "github.com/go-openapi/errors"
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+	validate "github.com/go-openapi/validate"
+)
+
+// TransferRepositoryHandlerFunc turns a function with the right signature into a transfer repository handler
+type TransferRepositoryHandlerFunc func(TransferRepositoryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn TransferRepositoryHandlerFunc) Handle(params TransferRepositoryParams) middleware.Responder {
+	return fn(params)
+}
+
+// TransferRepositoryHandler interface for that can handle valid transfer repository params
+type TransferRepositoryHandler interface {
+	Handle(TransferRepositoryParams) middleware.Responder
+}
+
+// NewTransferRepository creates a new http.Handler for the transfer repository operation
+func NewTransferRepository(ctx *middleware.Context, handler TransferRepositoryHandler) *TransferRepository {
+	return &TransferRepository{Context: ctx, Handler: handler}
+}
+
+/*TransferRepository swagger:route POST /repositories/{owner}/{name}/transfer repositories transferRepository
+
+Transfer a repository to another owner
+
+The old owner and name keep redirecting to the repository until another repository takes them.
+
+*/
+type TransferRepository struct {
+	Context *middleware.Context
+	Handler TransferRepositoryHandler
+}
+
+func (o *TransferRepository) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewTransferRepositoryParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// TransferRepositoryBody transfer repository body
+// swagger:model TransferRepositoryBody
+type TransferRepositoryBody struct {
+
+	// The username of the new owner
+	// Required: true
+	NewOwner *string `json:"new_owner"`
+}
+
+// Validate validates this transfer repository body
+func (o *TransferRepositoryBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateNewOwner(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *TransferRepositoryBody) validateNewOwner(formats strfmt.Registry) error {
+
+	if err := validate.Required("transfer"+"."+"new_owner", "body", o.NewOwner); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *TransferRepositoryBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *TransferRepositoryBody) UnmarshalBinary(b []byte) error {
+	var res TransferRepositoryBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,292 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// TransferRepositoryOKCode is the HTTP code returned for type TransferRepositoryOK
+const TransferRepositoryOKCode int = 200
+
+/*TransferRepositoryOK The repository has been transferred
+
+swagger:response transferRepositoryOK
+*/
+type TransferRepositoryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Repository `json:"body,omitempty"`
+}
+
+// NewTransferRepositoryOK creates TransferRepositoryOK with default headers values
+func NewTransferRepositoryOK() *TransferRepositoryOK {
+
+	return &TransferRepositoryOK{}
+}
+
+// WithPayload adds the payload to the transfer repository o k response
+func (o *TransferRepositoryOK) WithPayload(payload *models.Repository) *TransferRepositoryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the transfer repository o k response
+func (o *TransferRepositoryOK) SetPayload(payload *models.Repository) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TransferRepositoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// TransferRepositoryForbiddenCode is the HTTP code returned for type TransferRepositoryForbidden
+const TransferRepositoryForbiddenCode int = 403
+
+/*TransferRepositoryForbidden Only the owner can transfer a repository
+
+swagger:response transferRepositoryForbidden
+*/
+type TransferRepositoryForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTransferRepositoryForbidden creates TransferRepositoryForbidden with default headers values
+func NewTransferRepositoryForbidden() *TransferRepositoryForbidden {
+
+	return &TransferRepositoryForbidden{}
+}
+
+// WithPayload adds the payload to the transfer repository forbidden response
+func (o *TransferRepositoryForbidden) WithPayload(payload *models.Error) *TransferRepositoryForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the transfer repository forbidden response
+func (o *TransferRepositoryForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TransferRepositoryForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// TransferRepositoryNotFoundCode is the HTTP code returned for type TransferRepositoryNotFound
+const TransferRepositoryNotFoundCode int = 404
+
+/*TransferRepositoryNotFound The owner and name combination could not be found
+
+swagger:response transferRepositoryNotFound
+*/
+type TransferRepositoryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTransferRepositoryNotFound creates TransferRepositoryNotFound with default headers values
+func NewTransferRepositoryNotFound() *TransferRepositoryNotFound {
+
+	return &TransferRepositoryNotFound{}
+}
+
+// WithPayload adds the payload to the transfer repository not found response
+func (o *TransferRepositoryNotFound) WithPayload(payload *models.Error) *TransferRepositoryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the transfer repository not found response
+func (o *TransferRepositoryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TransferRepositoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// TransferRepositoryConflictCode is the HTTP code returned for type TransferRepositoryConflict
+const TransferRepositoryConflictCode int = 409
+
+/*TransferRepositoryConflict The new owner already has a repository with the name
+
+swagger:response transferRepositoryConflict
+*/
+type TransferRepositoryConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTransferRepositoryConflict creates TransferRepositoryConflict with default headers values
+func NewTransferRepositoryConflict() *TransferRepositoryConflict {
+
+	return &TransferRepositoryConflict{}
+}
+
+// WithPayload adds the payload to the transfer repository conflict response
+func (o *TransferRepositoryConflict) WithPayload(payload *models.Error) *TransferRepositoryConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the transfer repository conflict response
+func (o *TransferRepositoryConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TransferRepositoryConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// TransferRepositoryUnprocessableEntityCode is the HTTP code returned for type TransferRepositoryUnprocessableEntity
+const TransferRepositoryUnprocessableEntityCode int = 422
+
+/*TransferRepositoryUnprocessableEntity The new owner could not be found
+
+swagger:response transferRepositoryUnprocessableEntity
+*/
+type TransferRepositoryUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTransferRepositoryUnprocessableEntity creates TransferRepositoryUnprocessableEntity with default headers values
+func NewTransferRepositoryUnprocessableEntity() *TransferRepositoryUnprocessableEntity {
+
+	return &TransferRepositoryUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the transfer repository unprocessable entity response
+func (o *TransferRepositoryUnprocessableEntity) WithPayload(payload *models.Error) *TransferRepositoryUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the transfer repository unprocessable entity response
+func (o *TransferRepositoryUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TransferRepositoryUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*TransferRepositoryDefault unexpected error
+
+swagger:response transferRepositoryDefault
+*/
+type TransferRepositoryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTransferRepositoryDefault creates TransferRepositoryDefault with default headers values
+func NewTransferRepositoryDefault(code int) *TransferRepositoryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &TransferRepositoryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the transfer repository default response
+func (o *TransferRepositoryDefault) WithStatusCode(code int) *TransferRepositoryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the transfer repository default response
+func (o *TransferRepositoryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the transfer repository default response
+func (o *TransferRepositoryDefault) WithPayload(payload *models.Error) *TransferRepositoryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the transfer repository default response
+func (o *TransferRepositoryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TransferRepositoryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	strfmt "github.com/go-openapi/strfmt"
+	swag "github.com/go-openapi/swag"
+)
+
+// UpdateRepositoryHandlerFunc turns a function with the right signature into a update repository handler
+type UpdateRepositoryHandlerFunc func(UpdateRepositoryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateRepositoryHandlerFunc) Handle(params UpdateRepositoryParams) middleware.Responder {
+	return fn(params)
+}
+
+// UpdateRepositoryHandler interface for that can handle valid update repository params
+type UpdateRepositoryHandler interface {
+	Handle(UpdateRepositoryParams) middleware.Responder
+}
+
+// NewUpdateRepository creates a new http.Handler for the update repository operation
+func NewUpdateRepository(ctx *middleware.Context, handler UpdateRepositoryHandler) *UpdateRepository {
+	return &UpdateRepository{Context: ctx, Handler: handler}
+}
+
+/*UpdateRepository swagger:route PATCH /repositories/{owner}/{name} repositories updateRepository
+
+Update a repository's name, description, website or default branch
+
+The old name keeps redirecting to the repository until another repository takes it.
+
+*/
+type UpdateRepository struct {
+	Context *middleware.Context
+	Handler UpdateRepositoryHandler
+}
+
+func (o *UpdateRepository) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewUpdateRepositoryParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// UpdateRepositoryBody update repository body
+// swagger:model UpdateRepositoryBody
+type UpdateRepositoryBody struct {
+
+	// default branch
+	DefaultBranch *string `json:"default_branch,omitempty"`
+
+	// description
+	Description *string `json:"description,omitempty"`
+
+	// name
+	Name *string `json:"name,omitempty"`
+
+	// The topics replacing all of the repository's topics
+	Topics []string `json:"topics"`
+
+	// website
+	Website *string `json:"website,omitempty"`
+}
+
+// Validate validates this update repository body
+func (o *UpdateRepositoryBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *UpdateRepositoryBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *UpdateRepositoryBody) UnmarshalBinary(b []byte) error {
+	var res UpdateRepositoryBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
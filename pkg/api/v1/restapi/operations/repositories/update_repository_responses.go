@@ -0,0 +1,292 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package repositories
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// UpdateRepositoryOKCode is the HTTP code returned for type UpdateRepositoryOK
+const UpdateRepositoryOKCode int = 200
+
+/*UpdateRepositoryOK The repository has been updated
+
+swagger:response updateRepositoryOK
+*/
+type UpdateRepositoryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Repository `json:"body,omitempty"`
+}
+
+// NewUpdateRepositoryOK creates UpdateRepositoryOK with default headers values
+func NewUpdateRepositoryOK() *UpdateRepositoryOK {
+
+	return &UpdateRepositoryOK{}
+}
+
+// WithPayload adds the payload to the update repository o k response
+func (o *UpdateRepositoryOK) WithPayload(payload *models.Repository) *UpdateRepositoryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update repository o k response
+func (o *UpdateRepositoryOK) SetPayload(payload *models.Repository) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateRepositoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateRepositoryForbiddenCode is the HTTP code returned for type UpdateRepositoryForbidden
+const UpdateRepositoryForbiddenCode int = 403
+
+/*UpdateRepositoryForbidden Only the owner can update a repository
+
+swagger:response updateRepositoryForbidden
+*/
+type UpdateRepositoryForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateRepositoryForbidden creates UpdateRepositoryForbidden with default headers values
+func NewUpdateRepositoryForbidden() *UpdateRepositoryForbidden {
+
+	return &UpdateRepositoryForbidden{}
+}
+
+// WithPayload adds the payload to the update repository forbidden response
+func (o *UpdateRepositoryForbidden) WithPayload(payload *models.Error) *UpdateRepositoryForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update repository forbidden response
+func (o *UpdateRepositoryForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateRepositoryForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateRepositoryNotFoundCode is the HTTP code returned for type UpdateRepositoryNotFound
+const UpdateRepositoryNotFoundCode int = 404
+
+/*UpdateRepositoryNotFound The owner and name combination could not be found
+
+swagger:response updateRepositoryNotFound
+*/
+type UpdateRepositoryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateRepositoryNotFound creates UpdateRepositoryNotFound with default headers values
+func NewUpdateRepositoryNotFound() *UpdateRepositoryNotFound {
+
+	return &UpdateRepositoryNotFound{}
+}
+
+// WithPayload adds the payload to the update repository not found response
+func (o *UpdateRepositoryNotFound) WithPayload(payload *models.Error) *UpdateRepositoryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update repository not found response
+func (o *UpdateRepositoryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateRepositoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateRepositoryConflictCode is the HTTP code returned for type UpdateRepositoryConflict
+const UpdateRepositoryConflictCode int = 409
+
+/*UpdateRepositoryConflict The owner already has a repository with the new name
+
+swagger:response updateRepositoryConflict
+*/
+type UpdateRepositoryConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateRepositoryConflict creates UpdateRepositoryConflict with default headers values
+func NewUpdateRepositoryConflict() *UpdateRepositoryConflict {
+
+	return &UpdateRepositoryConflict{}
+}
+
+// WithPayload adds the payload to the update repository conflict response
+func (o *UpdateRepositoryConflict) WithPayload(payload *models.Error) *UpdateRepositoryConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update repository conflict response
+func (o *UpdateRepositoryConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateRepositoryConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateRepositoryUnprocessableEntityCode is the HTTP code returned for type UpdateRepositoryUnprocessableEntity
+const UpdateRepositoryUnprocessableEntityCode int = 422
+
+/*UpdateRepositoryUnprocessableEntity The fields are not valid or the default branch doesn't exist
+
+swagger:response updateRepositoryUnprocessableEntity
+*/
+type UpdateRepositoryUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewUpdateRepositoryUnprocessableEntity creates UpdateRepositoryUnprocessableEntity with default headers values
+func NewUpdateRepositoryUnprocessableEntity() *UpdateRepositoryUnprocessableEntity {
+
+	return &UpdateRepositoryUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the update repository unprocessable entity response
+func (o *UpdateRepositoryUnprocessableEntity) WithPayload(payload *models.ValidationError) *UpdateRepositoryUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update repository unprocessable entity response
+func (o *UpdateRepositoryUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateRepositoryUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdateRepositoryDefault unexpected error
+
+swagger:response updateRepositoryDefault
+*/
+type UpdateRepositoryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateRepositoryDefault creates UpdateRepositoryDefault with default headers values
+func NewUpdateRepositoryDefault(code int) *UpdateRepositoryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdateRepositoryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update repository default response
+func (o *UpdateRepositoryDefault) WithStatusCode(code int) *UpdateRepositoryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update repository default response
+func (o *UpdateRepositoryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update repository default response
+func (o *UpdateRepositoryDefault) WithPayload(payload *models.Error) *UpdateRepositoryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update repository default response
+func (o *UpdateRepositoryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateRepositoryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
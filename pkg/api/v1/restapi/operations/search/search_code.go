@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package search
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// SearchCodeHandlerFunc turns a function with the right signature into a search code handler
+type SearchCodeHandlerFunc func(SearchCodeParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SearchCodeHandlerFunc) Handle(params SearchCodeParams) middleware.Responder {
+	return fn(params)
+}
+
+// SearchCodeHandler interface for that can handle valid search code params
+type SearchCodeHandler interface {
+	Handle(SearchCodeParams) middleware.Responder
+}
+
+// NewSearchCode creates a new http.Handler for the search code operation
+func NewSearchCode(ctx *middleware.Context, handler SearchCodeHandler) *SearchCode {
+	return &SearchCode{Context: ctx, Handler: handler}
+}
+
+/*SearchCode swagger:route GET /search/code search searchCode
+
+Search the default branches of all repositories
+
+*/
+type SearchCode struct {
+	Context *middleware.Context
+	Handler SearchCodeHandler
+}
+
+func (o *SearchCode) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewSearchCodeParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
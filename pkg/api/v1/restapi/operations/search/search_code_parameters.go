@@ -0,0 +1,196 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package search
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewSearchCodeParams creates a new SearchCodeParams object
+// with the default values initialized.
+func NewSearchCodeParams() SearchCodeParams {
+
+	var (
+		// initialize parameters with default values
+
+		ignoreCaseDefault = bool(false)
+		limitDefault      = int64(100)
+	)
+
+	return SearchCodeParams{
+		IgnoreCase: &ignoreCaseDefault,
+
+		Limit: &limitDefault,
+	}
+}
+
+// SearchCodeParams contains all the bound params for the search code operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters searchCode
+type SearchCodeParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Match regardless of case
+	  In: query
+	  Default: false
+	*/
+	IgnoreCase *bool
+	/*The maximum number of matches returned
+	  Maximum: 1000
+	  Minimum: 1
+	  In: query
+	  Default: 100
+	*/
+	Limit *int64
+	/*The text to search for
+	  Required: true
+	  Min Length: 3
+	  In: query
+	*/
+	Q string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewSearchCodeParams() beforehand.
+func (o *SearchCodeParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qIgnoreCase, qhkIgnoreCase, _ := qs.GetOK("ignore_case")
+	if err := o.bindIgnoreCase(qIgnoreCase, qhkIgnoreCase, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qQ, qhkQ, _ := qs.GetOK("q")
+	if err := o.bindQ(qQ, qhkQ, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindIgnoreCase binds and validates parameter IgnoreCase from query.
+func (o *SearchCodeParams) bindIgnoreCase(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewSearchCodeParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("ignore_case", "query", "bool", raw)
+	}
+	o.IgnoreCase = &value
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *SearchCodeParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewSearchCodeParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *SearchCodeParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", int64(*o.Limit), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", int64(*o.Limit), 1000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindQ binds and validates parameter Q from query.
+func (o *SearchCodeParams) bindQ(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("q", "query")
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+	if err := validate.RequiredString("q", "query", raw); err != nil {
+		return err
+	}
+
+	o.Q = raw
+
+	if err := o.validateQ(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateQ carries on validations for parameter Q
+func (o *SearchCodeParams) validateQ(formats strfmt.Registry) error {
+
+	if err := validate.MinLength("q", "query", o.Q, 3); err != nil {
+		return err
+	}
+
+	return nil
+}
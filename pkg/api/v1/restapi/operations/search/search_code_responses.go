@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package search
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// SearchCodeOKCode is the HTTP code returned for type SearchCodeOK
+const SearchCodeOKCode int = 200
+
+/*SearchCodeOK The lines matching the query, ranked by repository and path
+
+swagger:response searchCodeOK
+*/
+type SearchCodeOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.CodeMatch `json:"body,omitempty"`
+}
+
+// NewSearchCodeOK creates SearchCodeOK with default headers values
+func NewSearchCodeOK() *SearchCodeOK {
+
+	return &SearchCodeOK{}
+}
+
+// WithPayload adds the payload to the search code o k response
+func (o *SearchCodeOK) WithPayload(payload []*models.CodeMatch) *SearchCodeOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search code o k response
+func (o *SearchCodeOK) SetPayload(payload []*models.CodeMatch) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchCodeOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.CodeMatch, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// SearchCodeUnprocessableEntityCode is the HTTP code returned for type SearchCodeUnprocessableEntity
+const SearchCodeUnprocessableEntityCode int = 422
+
+/*SearchCodeUnprocessableEntity The query is not valid
+
+swagger:response searchCodeUnprocessableEntity
+*/
+type SearchCodeUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSearchCodeUnprocessableEntity creates SearchCodeUnprocessableEntity with default headers values
+func NewSearchCodeUnprocessableEntity() *SearchCodeUnprocessableEntity {
+
+	return &SearchCodeUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the search code unprocessable entity response
+func (o *SearchCodeUnprocessableEntity) WithPayload(payload *models.Error) *SearchCodeUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search code unprocessable entity response
+func (o *SearchCodeUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchCodeUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SearchCodeDefault unexpected error
+
+swagger:response searchCodeDefault
+*/
+type SearchCodeDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSearchCodeDefault creates SearchCodeDefault with default headers values
+func NewSearchCodeDefault(code int) *SearchCodeDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SearchCodeDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the search code default response
+func (o *SearchCodeDefault) WithStatusCode(code int) *SearchCodeDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the search code default response
+func (o *SearchCodeDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the search code default response
+func (o *SearchCodeDefault) WithPayload(payload *models.Error) *SearchCodeDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search code default response
+func (o *SearchCodeDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchCodeDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
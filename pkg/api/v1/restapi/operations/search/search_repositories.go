@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package search
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// SearchRepositoriesHandlerFunc turns a function with the right signature into a search repositories handler
+type SearchRepositoriesHandlerFunc func(SearchRepositoriesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SearchRepositoriesHandlerFunc) Handle(params SearchRepositoriesParams) middleware.Responder {
+	return fn(params)
+}
+
+// SearchRepositoriesHandler interface for that can handle valid search repositories params
+type SearchRepositoriesHandler interface {
+	Handle(SearchRepositoriesParams) middleware.Responder
+}
+
+// NewSearchRepositories creates a new http.Handler for the search repositories operation
+func NewSearchRepositories(ctx *middleware.Context, handler SearchRepositoriesHandler) *SearchRepositories {
+	return &SearchRepositories{Context: ctx, Handler: handler}
+}
+
+/*SearchRepositories swagger:route GET /search/repositories search searchRepositories
+
+Search repositories by name and description
+
+*/
+type SearchRepositories struct {
+	Context *middleware.Context
+	Handler SearchRepositoriesHandler
+}
+
+func (o *SearchRepositories) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewSearchRepositoriesParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
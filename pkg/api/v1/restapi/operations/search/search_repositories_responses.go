@@ -0,0 +1,207 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package search
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// SearchRepositoriesOKCode is the HTTP code returned for type SearchRepositoriesOK
+const SearchRepositoriesOKCode int = 200
+
+/*SearchRepositoriesOK The repositories visible to the user matching all words of the query
+
+swagger:response searchRepositoriesOK
+*/
+type SearchRepositoriesOK struct {
+	/*The URL of the next page with rel="next", missing on the last page
+
+	 */
+	Link string `json:"Link"`
+	/*The cursor of the next page, missing on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Repository `json:"body,omitempty"`
+}
+
+// NewSearchRepositoriesOK creates SearchRepositoriesOK with default headers values
+func NewSearchRepositoriesOK() *SearchRepositoriesOK {
+
+	return &SearchRepositoriesOK{}
+}
+
+// WithLink adds the link to the search repositories o k response
+func (o *SearchRepositoriesOK) WithLink(link string) *SearchRepositoriesOK {
+	o.Link = link
+	return o
+}
+
+// SetLink sets the link to the search repositories o k response
+func (o *SearchRepositoriesOK) SetLink(link string) {
+	o.Link = link
+}
+
+// WithXNextCursor adds the xNextCursor to the search repositories o k response
+func (o *SearchRepositoriesOK) WithXNextCursor(xNextCursor string) *SearchRepositoriesOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the search repositories o k response
+func (o *SearchRepositoriesOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
+// WithPayload adds the payload to the search repositories o k response
+func (o *SearchRepositoriesOK) WithPayload(payload []*models.Repository) *SearchRepositoriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search repositories o k response
+func (o *SearchRepositoriesOK) SetPayload(payload []*models.Repository) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchRepositoriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Link
+
+	link := o.Link
+	if link != "" {
+		rw.Header().Set("Link", link)
+	}
+
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Repository, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// SearchRepositoriesUnprocessableEntityCode is the HTTP code returned for type SearchRepositoriesUnprocessableEntity
+const SearchRepositoriesUnprocessableEntityCode int = 422
+
+/*SearchRepositoriesUnprocessableEntity The query or the cursor is not valid
+
+swagger:response searchRepositoriesUnprocessableEntity
+*/
+type SearchRepositoriesUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSearchRepositoriesUnprocessableEntity creates SearchRepositoriesUnprocessableEntity with default headers values
+func NewSearchRepositoriesUnprocessableEntity() *SearchRepositoriesUnprocessableEntity {
+
+	return &SearchRepositoriesUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the search repositories unprocessable entity response
+func (o *SearchRepositoriesUnprocessableEntity) WithPayload(payload *models.Error) *SearchRepositoriesUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search repositories unprocessable entity response
+func (o *SearchRepositoriesUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchRepositoriesUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SearchRepositoriesDefault unexpected error
+
+swagger:response searchRepositoriesDefault
+*/
+type SearchRepositoriesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSearchRepositoriesDefault creates SearchRepositoriesDefault with default headers values
+func NewSearchRepositoriesDefault(code int) *SearchRepositoriesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SearchRepositoriesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the search repositories default response
+func (o *SearchRepositoriesDefault) WithStatusCode(code int) *SearchRepositoriesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the search repositories default response
+func (o *SearchRepositoriesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the search repositories default response
+func (o *SearchRepositoriesDefault) WithPayload(payload *models.Error) *SearchRepositoriesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search repositories default response
+func (o *SearchRepositoriesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchRepositoriesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package search
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// SearchUsersOKCode is the HTTP code returned for type SearchUsersOK
+const SearchUsersOKCode int = 200
+
+/*SearchUsersOK The users matching all words of the query
+
+swagger:response searchUsersOK
+*/
+type SearchUsersOK struct {
+	/*The URL of the next page with rel="next", missing on the last page
+
+	 */
+	Link string `json:"Link"`
+	/*The cursor of the next page, missing on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.User `json:"body,omitempty"`
+}
+
+// NewSearchUsersOK creates SearchUsersOK with default headers values
+func NewSearchUsersOK() *SearchUsersOK {
+
+	return &SearchUsersOK{}
+}
+
+// WithLink adds the link to the search users o k response
+func (o *SearchUsersOK) WithLink(link string) *SearchUsersOK {
+	o.Link = link
+	return o
+}
+
+// SetLink sets the link to the search users o k response
+func (o *SearchUsersOK) SetLink(link string) {
+	o.Link = link
+}
+
+// WithXNextCursor adds the xNextCursor to the search users o k response
+func (o *SearchUsersOK) WithXNextCursor(xNextCursor string) *SearchUsersOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the search users o k response
+func (o *SearchUsersOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
+// WithPayload adds the payload to the search users o k response
+func (o *SearchUsersOK) WithPayload(payload []*models.User) *SearchUsersOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search users o k response
+func (o *SearchUsersOK) SetPayload(payload []*models.User) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchUsersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Link
+
+	link := o.Link
+	if link != "" {
+		rw.Header().Set("Link", link)
+	}
+
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.User, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// SearchUsersUnprocessableEntityCode is the HTTP code returned for type SearchUsersUnprocessableEntity
+const SearchUsersUnprocessableEntityCode int = 422
+
+/*SearchUsersUnprocessableEntity The query or the cursor is not valid
+
+swagger:response searchUsersUnprocessableEntity
+*/
+type SearchUsersUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSearchUsersUnprocessableEntity creates SearchUsersUnprocessableEntity with default headers values
+func NewSearchUsersUnprocessableEntity() *SearchUsersUnprocessableEntity {
+
+	return &SearchUsersUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the search users unprocessable entity response
+func (o *SearchUsersUnprocessableEntity) WithPayload(payload *models.Error) *SearchUsersUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search users unprocessable entity response
+func (o *SearchUsersUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchUsersUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SearchUsersDefault unexpected error
+
+swagger:response searchUsersDefault
+*/
+type SearchUsersDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSearchUsersDefault creates SearchUsersDefault with default headers values
+func NewSearchUsersDefault(code int) *SearchUsersDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SearchUsersDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the search users default response
+func (o *SearchUsersDefault) WithStatusCode(code int) *SearchUsersDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the search users default response
+func (o *SearchUsersDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the search users default response
+func (o *SearchUsersDefault) WithPayload(payload *models.Error) *SearchUsersDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the search users default response
+func (o *SearchUsersDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SearchUsersDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -19,8 +19,18 @@ import (
 	strfmt "github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/events"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/issues"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/markdown"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/mirrors"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/notifications"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/pullrequests"
 	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/repositories"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/search"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/stars"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/statuses"
 	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/users"
+	"github.com/sourcepods/sourcepods/pkg/api/v1/restapi/operations/watching"
 )
 
 // NewSourcepodsAPI creates a new Sourcepods instance
@@ -40,18 +50,96 @@ func NewSourcepodsAPI(spec *loads.Document) *SourcepodsAPI {
 		BearerAuthenticator: security.BearerAuth,
 		JSONConsumer:        runtime.JSONConsumer(),
 		JSONProducer:        runtime.JSONProducer(),
+		StarsCheckRepositoryStarredHandler: stars.CheckRepositoryStarredHandlerFunc(func(params stars.CheckRepositoryStarredParams) middleware.Responder {
+			return middleware.NotImplemented("operation StarsCheckRepositoryStarred has not yet been implemented")
+		}),
+		IssuesCreateIssueHandler: issues.CreateIssueHandlerFunc(func(params issues.CreateIssueParams) middleware.Responder {
+			return middleware.NotImplemented("operation IssuesCreateIssue has not yet been implemented")
+		}),
+		IssuesCreateIssueCommentHandler: issues.CreateIssueCommentHandlerFunc(func(params issues.CreateIssueCommentParams) middleware.Responder {
+			return middleware.NotImplemented("operation IssuesCreateIssueComment has not yet been implemented")
+		}),
+		IssuesCreateLabelHandler: issues.CreateLabelHandlerFunc(func(params issues.CreateLabelParams) middleware.Responder {
+			return middleware.NotImplemented("operation IssuesCreateLabel has not yet been implemented")
+		}),
+		IssuesCreateMilestoneHandler: issues.CreateMilestoneHandlerFunc(func(params issues.CreateMilestoneParams) middleware.Responder {
+			return middleware.NotImplemented("operation IssuesCreateMilestone has not yet been implemented")
+		}),
+		PullrequestsCreatePullRequestHandler: pullrequests.CreatePullRequestHandlerFunc(func(params pullrequests.CreatePullRequestParams) middleware.Responder {
+			return middleware.NotImplemented("operation PullrequestsCreatePullRequest has not yet been implemented")
+		}),
+		PullrequestsCreatePullRequestCommentHandler: pullrequests.CreatePullRequestCommentHandlerFunc(func(params pullrequests.CreatePullRequestCommentParams) middleware.Responder {
+			return middleware.NotImplemented("operation PullrequestsCreatePullRequestComment has not yet been implemented")
+		}),
 		RepositoriesCreateRepositoryHandler: repositories.CreateRepositoryHandlerFunc(func(params repositories.CreateRepositoryParams) middleware.Responder {
 			return middleware.NotImplemented("operation RepositoriesCreateRepository has not yet been implemented")
 		}),
+		RepositoriesCreateRepositoryBranchHandler: repositories.CreateRepositoryBranchHandlerFunc(func(params repositories.CreateRepositoryBranchParams) middleware.Responder {
+			return middleware.NotImplemented("operation RepositoriesCreateRepositoryBranch has not yet been implemented")
+		}),
+		MirrorsCreateRepositoryMirrorHandler: mirrors.CreateRepositoryMirrorHandlerFunc(func(params mirrors.CreateRepositoryMirrorParams) middleware.Responder {
+			return middleware.NotImplemented("operation MirrorsCreateRepositoryMirror has not yet been implemented")
+		}),
+		StatusesCreateStatusHandler: statuses.CreateStatusHandlerFunc(func(params statuses.CreateStatusParams) middleware.Responder {
+			return middleware.NotImplemented("operation StatusesCreateStatus has not yet been implemented")
+		}),
+		IssuesDeleteLabelHandler: issues.DeleteLabelHandlerFunc(func(params issues.DeleteLabelParams) middleware.Responder {
+			return middleware.NotImplemented("operation IssuesDeleteLabel has not yet been implemented")
+		}),
+		RepositoriesDeleteRepositoryHandler: repositories.DeleteRepositoryHandlerFunc(func(params repositories.DeleteRepositoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation RepositoriesDeleteRepository has not yet been implemented")
+		}),
+		RepositoriesDeleteRepositoryBranchHandler: repositories.DeleteRepositoryBranchHandlerFunc(func(params repositories.DeleteRepositoryBranchParams) middleware.Responder {
+			return middleware.NotImplemented("operation RepositoriesDeleteRepositoryBranch has not yet been implemented")
+		}),
+		MirrorsDeleteRepositoryMirrorHandler: mirrors.DeleteRepositoryMirrorHandlerFunc(func(params mirrors.DeleteRepositoryMirrorParams) middleware.Responder {
+			return middleware.NotImplemented("operation MirrorsDeleteRepositoryMirror has not yet been implemented")
+		}),
+		RepositoriesForkRepositoryHandler: repositories.ForkRepositoryHandlerFunc(func(params repositories.ForkRepositoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation RepositoriesForkRepository has not yet been implemented")
+		}),
+		StatusesGetBranchProtectionHandler: statuses.GetBranchProtectionHandlerFunc(func(params statuses.GetBranchProtectionParams) middleware.Responder {
+			return middleware.NotImplemented("operation StatusesGetBranchProtection has not yet been implemented")
+		}),
+		StatusesGetCombinedStatusHandler: statuses.GetCombinedStatusHandlerFunc(func(params statuses.GetCombinedStatusParams) middleware.Responder {
+			return middleware.NotImplemented("operation StatusesGetCombinedStatus has not yet been implemented")
+		}),
+		IssuesGetIssueHandler: issues.GetIssueHandlerFunc(func(params issues.GetIssueParams) middleware.Responder {
+			return middleware.NotImplemented("operation IssuesGetIssue has not yet been implemented")
+		}),
+		NotificationsGetNotificationPreferencesHandler: notifications.GetNotificationPreferencesHandlerFunc(func(params notifications.GetNotificationPreferencesParams) middleware.Responder {
+			return middleware.NotImplemented("operation NotificationsGetNotificationPreferences has not yet been implemented")
+		}),
 		RepositoriesGetOwnerRepositoriesHandler: repositories.GetOwnerRepositoriesHandlerFunc(func(params repositories.GetOwnerRepositoriesParams) middleware.Responder {
 			return middleware.NotImplemented("operation RepositoriesGetOwnerRepositories has not yet been implemented")
 		}),
+		PullrequestsGetPullRequestHandler: pullrequests.GetPullRequestHandlerFunc(func(params pullrequests.GetPullRequestParams) middleware.Responder {
+			return middleware.NotImplemented("operation PullrequestsGetPullRequest has not yet been implemented")
+		}),
+		PullrequestsGetPullRequestCommitsHandler: pullrequests.GetPullRequestCommitsHandlerFunc(func(params pullrequests.GetPullRequestCommitsParams) middleware.Responder {
+			return middleware.NotImplemented("operation PullrequestsGetPullRequestCommits has not yet been implemented")
+		}),
+		PullrequestsGetPullRequestDiffHandler: pullrequests.GetPullRequestDiffHandlerFunc(func(params pullrequests.GetPullRequestDiffParams) middleware.Responder {
+			return middleware.NotImplemented("operation PullrequestsGetPullRequestDiff has not yet been implemented")
+		}),
 		RepositoriesGetRepositoryHandler: repositories.GetRepositoryHandlerFunc(func(params repositories.GetRepositoryParams) middleware.Responder {
 			return middleware.NotImplemented("operation RepositoriesGetRepository has not yet been implemented")
 		}),
 		RepositoriesGetRepositoryBranchesHandler: repositories.GetRepositoryBranchesHandlerFunc(func(params repositories.GetRepositoryBranchesParams) middleware.Responder {
 			return middleware.NotImplemented("operation RepositoriesGetRepositoryBranches has not yet been implemented")
 		}),
+		RepositoriesGetRepositoryForksHandler: repositories.GetRepositoryForksHandlerFunc(func(params repositories.GetRepositoryForksParams) middleware.Responder {
+			return middleware.NotImplemented("operation RepositoriesGetRepositoryForks has not yet been implemented")
+		}),
+		RepositoriesGetRepositoryImportHandler: repositories.GetRepositoryImportHandlerFunc(func(params repositories.GetRepositoryImportParams) middleware.Responder {
+			return middleware.NotImplemented("operation RepositoriesGetRepositoryImport has not yet been implemented")
+		}),
+		RepositoriesGetRepositoryNetworkHandler: repositories.GetRepositoryNetworkHandlerFunc(func(params repositories.GetRepositoryNetworkParams) middleware.Responder {
+			return middleware.NotImplemented("operation RepositoriesGetRepositoryNetwork has not yet been implemented")
+		}),
+		RepositoriesGetRepositoryReadmeHandler: repositories.GetRepositoryReadmeHandlerFunc(func(params repositories.GetRepositoryReadmeParams) middleware.Responder {
+			return middleware.NotImplemented("operation RepositoriesGetRepositoryReadme has not yet been implemented")
+		}),
 		RepositoriesGetRepositoryTreeHandler: repositories.GetRepositoryTreeHandlerFunc(func(params repositories.GetRepositoryTreeParams) middleware.Responder {
 			return middleware.NotImplemented("operation RepositoriesGetRepositoryTree has not yet been implemented")
 		}),
@@ -61,12 +149,120 @@ func NewSourcepodsAPI(spec *loads.Document) *SourcepodsAPI {
 		UsersGetUserMeHandler: users.GetUserMeHandlerFunc(func(params users.GetUserMeParams) middleware.Responder {
 			return middleware.NotImplemented("operation UsersGetUserMe has not yet been implemented")
 		}),
+		RepositoriesImportRepositoryHandler: repositories.ImportRepositoryHandlerFunc(func(params repositories.ImportRepositoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation RepositoriesImportRepository has not yet been implemented")
+		}),
+		EventsListEventsHandler: events.ListEventsHandlerFunc(func(params events.ListEventsParams) middleware.Responder {
+			return middleware.NotImplemented("operation EventsListEvents has not yet been implemented")
+		}),
+		IssuesListIssueCommentsHandler: issues.ListIssueCommentsHandlerFunc(func(params issues.ListIssueCommentsParams) middleware.Responder {
+			return middleware.NotImplemented("operation IssuesListIssueComments has not yet been implemented")
+		}),
+		IssuesListIssuesHandler: issues.ListIssuesHandlerFunc(func(params issues.ListIssuesParams) middleware.Responder {
+			return middleware.NotImplemented("operation IssuesListIssues has not yet been implemented")
+		}),
+		IssuesListLabelsHandler: issues.ListLabelsHandlerFunc(func(params issues.ListLabelsParams) middleware.Responder {
+			return middleware.NotImplemented("operation IssuesListLabels has not yet been implemented")
+		}),
+		IssuesListMilestonesHandler: issues.ListMilestonesHandlerFunc(func(params issues.ListMilestonesParams) middleware.Responder {
+			return middleware.NotImplemented("operation IssuesListMilestones has not yet been implemented")
+		}),
+		NotificationsListNotificationsHandler: notifications.ListNotificationsHandlerFunc(func(params notifications.ListNotificationsParams) middleware.Responder {
+			return middleware.NotImplemented("operation NotificationsListNotifications has not yet been implemented")
+		}),
+		PullrequestsListPullRequestCommentsHandler: pullrequests.ListPullRequestCommentsHandlerFunc(func(params pullrequests.ListPullRequestCommentsParams) middleware.Responder {
+			return middleware.NotImplemented("operation PullrequestsListPullRequestComments has not yet been implemented")
+		}),
+		PullrequestsListPullRequestsHandler: pullrequests.ListPullRequestsHandlerFunc(func(params pullrequests.ListPullRequestsParams) middleware.Responder {
+			return middleware.NotImplemented("operation PullrequestsListPullRequests has not yet been implemented")
+		}),
+		EventsListRepositoryEventsHandler: events.ListRepositoryEventsHandlerFunc(func(params events.ListRepositoryEventsParams) middleware.Responder {
+			return middleware.NotImplemented("operation EventsListRepositoryEvents has not yet been implemented")
+		}),
+		MirrorsListRepositoryMirrorsHandler: mirrors.ListRepositoryMirrorsHandlerFunc(func(params mirrors.ListRepositoryMirrorsParams) middleware.Responder {
+			return middleware.NotImplemented("operation MirrorsListRepositoryMirrors has not yet been implemented")
+		}),
+		WatchingListRepositoryWatchersHandler: watching.ListRepositoryWatchersHandlerFunc(func(params watching.ListRepositoryWatchersParams) middleware.Responder {
+			return middleware.NotImplemented("operation WatchingListRepositoryWatchers has not yet been implemented")
+		}),
+		StarsListStarredRepositoriesHandler: stars.ListStarredRepositoriesHandlerFunc(func(params stars.ListStarredRepositoriesParams) middleware.Responder {
+			return middleware.NotImplemented("operation StarsListStarredRepositories has not yet been implemented")
+		}),
+		StatusesListStatusesHandler: statuses.ListStatusesHandlerFunc(func(params statuses.ListStatusesParams) middleware.Responder {
+			return middleware.NotImplemented("operation StatusesListStatuses has not yet been implemented")
+		}),
+		EventsListUserEventsHandler: events.ListUserEventsHandlerFunc(func(params events.ListUserEventsParams) middleware.Responder {
+			return middleware.NotImplemented("operation EventsListUserEvents has not yet been implemented")
+		}),
+		StarsListUserStarredRepositoriesHandler: stars.ListUserStarredRepositoriesHandlerFunc(func(params stars.ListUserStarredRepositoriesParams) middleware.Responder {
+			return middleware.NotImplemented("operation StarsListUserStarredRepositories has not yet been implemented")
+		}),
 		UsersListUsersHandler: users.ListUsersHandlerFunc(func(params users.ListUsersParams) middleware.Responder {
 			return middleware.NotImplemented("operation UsersListUsers has not yet been implemented")
 		}),
+		NotificationsMarkNotificationReadHandler: notifications.MarkNotificationReadHandlerFunc(func(params notifications.MarkNotificationReadParams) middleware.Responder {
+			return middleware.NotImplemented("operation NotificationsMarkNotificationRead has not yet been implemented")
+		}),
+		NotificationsMarkNotificationsReadHandler: notifications.MarkNotificationsReadHandlerFunc(func(params notifications.MarkNotificationsReadParams) middleware.Responder {
+			return middleware.NotImplemented("operation NotificationsMarkNotificationsRead has not yet been implemented")
+		}),
+		PullrequestsMergePullRequestHandler: pullrequests.MergePullRequestHandlerFunc(func(params pullrequests.MergePullRequestParams) middleware.Responder {
+			return middleware.NotImplemented("operation PullrequestsMergePullRequest has not yet been implemented")
+		}),
+		RepositoriesRenameRepositoryBranchHandler: repositories.RenameRepositoryBranchHandlerFunc(func(params repositories.RenameRepositoryBranchParams) middleware.Responder {
+			return middleware.NotImplemented("operation RepositoriesRenameRepositoryBranch has not yet been implemented")
+		}),
+		MarkdownRenderMarkdownHandler: markdown.RenderMarkdownHandlerFunc(func(params markdown.RenderMarkdownParams) middleware.Responder {
+			return middleware.NotImplemented("operation MarkdownRenderMarkdown has not yet been implemented")
+		}),
+		SearchSearchCodeHandler: search.SearchCodeHandlerFunc(func(params search.SearchCodeParams) middleware.Responder {
+			return middleware.NotImplemented("operation SearchSearchCode has not yet been implemented")
+		}),
+		SearchSearchRepositoriesHandler: search.SearchRepositoriesHandlerFunc(func(params search.SearchRepositoriesParams) middleware.Responder {
+			return middleware.NotImplemented("operation SearchSearchRepositories has not yet been implemented")
+		}),
+		RepositoriesSearchRepositoryHandler: repositories.SearchRepositoryHandlerFunc(func(params repositories.SearchRepositoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation RepositoriesSearchRepository has not yet been implemented")
+		}),
+		SearchSearchUsersHandler: search.SearchUsersHandlerFunc(func(params search.SearchUsersParams) middleware.Responder {
+			return middleware.NotImplemented("operation SearchSearchUsers has not yet been implemented")
+		}),
+		StarsStarRepositoryHandler: stars.StarRepositoryHandlerFunc(func(params stars.StarRepositoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation StarsStarRepository has not yet been implemented")
+		}),
+		MirrorsSyncRepositoryMirrorHandler: mirrors.SyncRepositoryMirrorHandlerFunc(func(params mirrors.SyncRepositoryMirrorParams) middleware.Responder {
+			return middleware.NotImplemented("operation MirrorsSyncRepositoryMirror has not yet been implemented")
+		}),
+		RepositoriesTransferRepositoryHandler: repositories.TransferRepositoryHandlerFunc(func(params repositories.TransferRepositoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation RepositoriesTransferRepository has not yet been implemented")
+		}),
+		StarsUnstarRepositoryHandler: stars.UnstarRepositoryHandlerFunc(func(params stars.UnstarRepositoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation StarsUnstarRepository has not yet been implemented")
+		}),
+		WatchingUnwatchRepositoryHandler: watching.UnwatchRepositoryHandlerFunc(func(params watching.UnwatchRepositoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation WatchingUnwatchRepository has not yet been implemented")
+		}),
+		StatusesUpdateBranchProtectionHandler: statuses.UpdateBranchProtectionHandlerFunc(func(params statuses.UpdateBranchProtectionParams) middleware.Responder {
+			return middleware.NotImplemented("operation StatusesUpdateBranchProtection has not yet been implemented")
+		}),
+		IssuesUpdateIssueHandler: issues.UpdateIssueHandlerFunc(func(params issues.UpdateIssueParams) middleware.Responder {
+			return middleware.NotImplemented("operation IssuesUpdateIssue has not yet been implemented")
+		}),
+		NotificationsUpdateNotificationPreferencesHandler: notifications.UpdateNotificationPreferencesHandlerFunc(func(params notifications.UpdateNotificationPreferencesParams) middleware.Responder {
+			return middleware.NotImplemented("operation NotificationsUpdateNotificationPreferences has not yet been implemented")
+		}),
+		PullrequestsUpdatePullRequestHandler: pullrequests.UpdatePullRequestHandlerFunc(func(params pullrequests.UpdatePullRequestParams) middleware.Responder {
+			return middleware.NotImplemented("operation PullrequestsUpdatePullRequest has not yet been implemented")
+		}),
+		RepositoriesUpdateRepositoryHandler: repositories.UpdateRepositoryHandlerFunc(func(params repositories.UpdateRepositoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation RepositoriesUpdateRepository has not yet been implemented")
+		}),
 		UsersUpdateUserHandler: users.UpdateUserHandlerFunc(func(params users.UpdateUserParams) middleware.Responder {
 			return middleware.NotImplemented("operation UsersUpdateUser has not yet been implemented")
 		}),
+		WatchingWatchRepositoryHandler: watching.WatchRepositoryHandlerFunc(func(params watching.WatchRepositoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation WatchingWatchRepository has not yet been implemented")
+		}),
 	}
 }
 
@@ -98,24 +294,148 @@ type SourcepodsAPI struct {
 	// JSONProducer registers a producer for a "application/json" mime type
 	JSONProducer runtime.Producer
 
+	// StarsCheckRepositoryStarredHandler sets the operation handler for the check repository starred operation
+	StarsCheckRepositoryStarredHandler stars.CheckRepositoryStarredHandler
+	// IssuesCreateIssueHandler sets the operation handler for the create issue operation
+	IssuesCreateIssueHandler issues.CreateIssueHandler
+	// IssuesCreateIssueCommentHandler sets the operation handler for the create issue comment operation
+	IssuesCreateIssueCommentHandler issues.CreateIssueCommentHandler
+	// IssuesCreateLabelHandler sets the operation handler for the create label operation
+	IssuesCreateLabelHandler issues.CreateLabelHandler
+	// IssuesCreateMilestoneHandler sets the operation handler for the create milestone operation
+	IssuesCreateMilestoneHandler issues.CreateMilestoneHandler
+	// PullrequestsCreatePullRequestHandler sets the operation handler for the create pull request operation
+	PullrequestsCreatePullRequestHandler pullrequests.CreatePullRequestHandler
+	// PullrequestsCreatePullRequestCommentHandler sets the operation handler for the create pull request comment operation
+	PullrequestsCreatePullRequestCommentHandler pullrequests.CreatePullRequestCommentHandler
 	// RepositoriesCreateRepositoryHandler sets the operation handler for the create repository operation
 	RepositoriesCreateRepositoryHandler repositories.CreateRepositoryHandler
+	// RepositoriesCreateRepositoryBranchHandler sets the operation handler for the create repository branch operation
+	RepositoriesCreateRepositoryBranchHandler repositories.CreateRepositoryBranchHandler
+	// MirrorsCreateRepositoryMirrorHandler sets the operation handler for the create repository mirror operation
+	MirrorsCreateRepositoryMirrorHandler mirrors.CreateRepositoryMirrorHandler
+	// StatusesCreateStatusHandler sets the operation handler for the create status operation
+	StatusesCreateStatusHandler statuses.CreateStatusHandler
+	// IssuesDeleteLabelHandler sets the operation handler for the delete label operation
+	IssuesDeleteLabelHandler issues.DeleteLabelHandler
+	// RepositoriesDeleteRepositoryHandler sets the operation handler for the delete repository operation
+	RepositoriesDeleteRepositoryHandler repositories.DeleteRepositoryHandler
+	// RepositoriesDeleteRepositoryBranchHandler sets the operation handler for the delete repository branch operation
+	RepositoriesDeleteRepositoryBranchHandler repositories.DeleteRepositoryBranchHandler
+	// MirrorsDeleteRepositoryMirrorHandler sets the operation handler for the delete repository mirror operation
+	MirrorsDeleteRepositoryMirrorHandler mirrors.DeleteRepositoryMirrorHandler
+	// RepositoriesForkRepositoryHandler sets the operation handler for the fork repository operation
+	RepositoriesForkRepositoryHandler repositories.ForkRepositoryHandler
+	// StatusesGetBranchProtectionHandler sets the operation handler for the get branch protection operation
+	StatusesGetBranchProtectionHandler statuses.GetBranchProtectionHandler
+	// StatusesGetCombinedStatusHandler sets the operation handler for the get combined status operation
+	StatusesGetCombinedStatusHandler statuses.GetCombinedStatusHandler
+	// IssuesGetIssueHandler sets the operation handler for the get issue operation
+	IssuesGetIssueHandler issues.GetIssueHandler
+	// NotificationsGetNotificationPreferencesHandler sets the operation handler for the get notification preferences operation
+	NotificationsGetNotificationPreferencesHandler notifications.GetNotificationPreferencesHandler
 	// RepositoriesGetOwnerRepositoriesHandler sets the operation handler for the get owner repositories operation
 	RepositoriesGetOwnerRepositoriesHandler repositories.GetOwnerRepositoriesHandler
+	// PullrequestsGetPullRequestHandler sets the operation handler for the get pull request operation
+	PullrequestsGetPullRequestHandler pullrequests.GetPullRequestHandler
+	// PullrequestsGetPullRequestCommitsHandler sets the operation handler for the get pull request commits operation
+	PullrequestsGetPullRequestCommitsHandler pullrequests.GetPullRequestCommitsHandler
+	// PullrequestsGetPullRequestDiffHandler sets the operation handler for the get pull request diff operation
+	PullrequestsGetPullRequestDiffHandler pullrequests.GetPullRequestDiffHandler
 	// RepositoriesGetRepositoryHandler sets the operation handler for the get repository operation
 	RepositoriesGetRepositoryHandler repositories.GetRepositoryHandler
 	// RepositoriesGetRepositoryBranchesHandler sets the operation handler for the get repository branches operation
 	RepositoriesGetRepositoryBranchesHandler repositories.GetRepositoryBranchesHandler
+	// RepositoriesGetRepositoryForksHandler sets the operation handler for the get repository forks operation
+	RepositoriesGetRepositoryForksHandler repositories.GetRepositoryForksHandler
+	// RepositoriesGetRepositoryImportHandler sets the operation handler for the get repository import operation
+	RepositoriesGetRepositoryImportHandler repositories.GetRepositoryImportHandler
+	// RepositoriesGetRepositoryNetworkHandler sets the operation handler for the get repository network operation
+	RepositoriesGetRepositoryNetworkHandler repositories.GetRepositoryNetworkHandler
+	// RepositoriesGetRepositoryReadmeHandler sets the operation handler for the get repository readme operation
+	RepositoriesGetRepositoryReadmeHandler repositories.GetRepositoryReadmeHandler
 	// RepositoriesGetRepositoryTreeHandler sets the operation handler for the get repository tree operation
 	RepositoriesGetRepositoryTreeHandler repositories.GetRepositoryTreeHandler
 	// UsersGetUserHandler sets the operation handler for the get user operation
 	UsersGetUserHandler users.GetUserHandler
 	// UsersGetUserMeHandler sets the operation handler for the get user me operation
 	UsersGetUserMeHandler users.GetUserMeHandler
+	// RepositoriesImportRepositoryHandler sets the operation handler for the import repository operation
+	RepositoriesImportRepositoryHandler repositories.ImportRepositoryHandler
+	// EventsListEventsHandler sets the operation handler for the list events operation
+	EventsListEventsHandler events.ListEventsHandler
+	// IssuesListIssueCommentsHandler sets the operation handler for the list issue comments operation
+	IssuesListIssueCommentsHandler issues.ListIssueCommentsHandler
+	// IssuesListIssuesHandler sets the operation handler for the list issues operation
+	IssuesListIssuesHandler issues.ListIssuesHandler
+	// IssuesListLabelsHandler sets the operation handler for the list labels operation
+	IssuesListLabelsHandler issues.ListLabelsHandler
+	// IssuesListMilestonesHandler sets the operation handler for the list milestones operation
+	IssuesListMilestonesHandler issues.ListMilestonesHandler
+	// NotificationsListNotificationsHandler sets the operation handler for the list notifications operation
+	NotificationsListNotificationsHandler notifications.ListNotificationsHandler
+	// PullrequestsListPullRequestCommentsHandler sets the operation handler for the list pull request comments operation
+	PullrequestsListPullRequestCommentsHandler pullrequests.ListPullRequestCommentsHandler
+	// PullrequestsListPullRequestsHandler sets the operation handler for the list pull requests operation
+	PullrequestsListPullRequestsHandler pullrequests.ListPullRequestsHandler
+	// EventsListRepositoryEventsHandler sets the operation handler for the list repository events operation
+	EventsListRepositoryEventsHandler events.ListRepositoryEventsHandler
+	// MirrorsListRepositoryMirrorsHandler sets the operation handler for the list repository mirrors operation
+	MirrorsListRepositoryMirrorsHandler mirrors.ListRepositoryMirrorsHandler
+	// WatchingListRepositoryWatchersHandler sets the operation handler for the list repository watchers operation
+	WatchingListRepositoryWatchersHandler watching.ListRepositoryWatchersHandler
+	// StarsListStarredRepositoriesHandler sets the operation handler for the list starred repositories operation
+	StarsListStarredRepositoriesHandler stars.ListStarredRepositoriesHandler
+	// StatusesListStatusesHandler sets the operation handler for the list statuses operation
+	StatusesListStatusesHandler statuses.ListStatusesHandler
+	// EventsListUserEventsHandler sets the operation handler for the list user events operation
+	EventsListUserEventsHandler events.ListUserEventsHandler
+	// StarsListUserStarredRepositoriesHandler sets the operation handler for the list user starred repositories operation
+	StarsListUserStarredRepositoriesHandler stars.ListUserStarredRepositoriesHandler
 	// UsersListUsersHandler sets the operation handler for the list users operation
 	UsersListUsersHandler users.ListUsersHandler
+	// NotificationsMarkNotificationReadHandler sets the operation handler for the mark notification read operation
+	NotificationsMarkNotificationReadHandler notifications.MarkNotificationReadHandler
+	// NotificationsMarkNotificationsReadHandler sets the operation handler for the mark notifications read operation
+	NotificationsMarkNotificationsReadHandler notifications.MarkNotificationsReadHandler
+	// PullrequestsMergePullRequestHandler sets the operation handler for the merge pull request operation
+	PullrequestsMergePullRequestHandler pullrequests.MergePullRequestHandler
+	// RepositoriesRenameRepositoryBranchHandler sets the operation handler for the rename repository branch operation
+	RepositoriesRenameRepositoryBranchHandler repositories.RenameRepositoryBranchHandler
+	// MarkdownRenderMarkdownHandler sets the operation handler for the render markdown operation
+	MarkdownRenderMarkdownHandler markdown.RenderMarkdownHandler
+	// SearchSearchCodeHandler sets the operation handler for the search code operation
+	SearchSearchCodeHandler search.SearchCodeHandler
+	// SearchSearchRepositoriesHandler sets the operation handler for the search repositories operation
+	SearchSearchRepositoriesHandler search.SearchRepositoriesHandler
+	// RepositoriesSearchRepositoryHandler sets the operation handler for the search repository operation
+	RepositoriesSearchRepositoryHandler repositories.SearchRepositoryHandler
+	// SearchSearchUsersHandler sets the operation handler for the search users operation
+	SearchSearchUsersHandler search.SearchUsersHandler
+	// StarsStarRepositoryHandler sets the operation handler for the star repository operation
+	StarsStarRepositoryHandler stars.StarRepositoryHandler
+	// MirrorsSyncRepositoryMirrorHandler sets the operation handler for the sync repository mirror operation
+	MirrorsSyncRepositoryMirrorHandler mirrors.SyncRepositoryMirrorHandler
+	// RepositoriesTransferRepositoryHandler sets the operation handler for the transfer repository operation
+	RepositoriesTransferRepositoryHandler repositories.TransferRepositoryHandler
+	// StarsUnstarRepositoryHandler sets the operation handler for the unstar repository operation
+	StarsUnstarRepositoryHandler stars.UnstarRepositoryHandler
+	// WatchingUnwatchRepositoryHandler sets the operation handler for the unwatch repository operation
+	WatchingUnwatchRepositoryHandler watching.UnwatchRepositoryHandler
+	// StatusesUpdateBranchProtectionHandler sets the operation handler for the update branch protection operation
+	StatusesUpdateBranchProtectionHandler statuses.UpdateBranchProtectionHandler
+	// IssuesUpdateIssueHandler sets the operation handler for the update issue operation
+	IssuesUpdateIssueHandler issues.UpdateIssueHandler
+	// NotificationsUpdateNotificationPreferencesHandler sets the operation handler for the update notification preferences operation
+	NotificationsUpdateNotificationPreferencesHandler notifications.UpdateNotificationPreferencesHandler
+	// PullrequestsUpdatePullRequestHandler sets the operation handler for the update pull request operation
+	PullrequestsUpdatePullRequestHandler pullrequests.UpdatePullRequestHandler
+	// RepositoriesUpdateRepositoryHandler sets the operation handler for the update repository operation
+	RepositoriesUpdateRepositoryHandler repositories.UpdateRepositoryHandler
 	// UsersUpdateUserHandler sets the operation handler for the update user operation
 	UsersUpdateUserHandler users.UpdateUserHandler
+	// WatchingWatchRepositoryHandler sets the operation handler for the watch repository operation
+	WatchingWatchRepositoryHandler watching.WatchRepositoryHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -179,14 +499,102 @@ func (o *SourcepodsAPI) Validate() error {
 		unregistered = append(unregistered, "JSONProducer")
 	}
 
+	if o.StarsCheckRepositoryStarredHandler == nil {
+		unregistered = append(unregistered, "stars.CheckRepositoryStarredHandler")
+	}
+
+	if o.IssuesCreateIssueHandler == nil {
+		unregistered = append(unregistered, "issues.CreateIssueHandler")
+	}
+
+	if o.IssuesCreateIssueCommentHandler == nil {
+		unregistered = append(unregistered, "issues.CreateIssueCommentHandler")
+	}
+
+	if o.IssuesCreateLabelHandler == nil {
+		unregistered = append(unregistered, "issues.CreateLabelHandler")
+	}
+
+	if o.IssuesCreateMilestoneHandler == nil {
+		unregistered = append(unregistered, "issues.CreateMilestoneHandler")
+	}
+
+	if o.PullrequestsCreatePullRequestHandler == nil {
+		unregistered = append(unregistered, "pullrequests.CreatePullRequestHandler")
+	}
+
+	if o.PullrequestsCreatePullRequestCommentHandler == nil {
+		unregistered = append(unregistered, "pullrequests.CreatePullRequestCommentHandler")
+	}
+
 	if o.RepositoriesCreateRepositoryHandler == nil {
 		unregistered = append(unregistered, "repositories.CreateRepositoryHandler")
 	}
 
+	if o.RepositoriesCreateRepositoryBranchHandler == nil {
+		unregistered = append(unregistered, "repositories.CreateRepositoryBranchHandler")
+	}
+
+	if o.MirrorsCreateRepositoryMirrorHandler == nil {
+		unregistered = append(unregistered, "mirrors.CreateRepositoryMirrorHandler")
+	}
+
+	if o.StatusesCreateStatusHandler == nil {
+		unregistered = append(unregistered, "statuses.CreateStatusHandler")
+	}
+
+	if o.IssuesDeleteLabelHandler == nil {
+		unregistered = append(unregistered, "issues.DeleteLabelHandler")
+	}
+
+	if o.RepositoriesDeleteRepositoryHandler == nil {
+		unregistered = append(unregistered, "repositories.DeleteRepositoryHandler")
+	}
+
+	if o.RepositoriesDeleteRepositoryBranchHandler == nil {
+		unregistered = append(unregistered, "repositories.DeleteRepositoryBranchHandler")
+	}
+
+	if o.MirrorsDeleteRepositoryMirrorHandler == nil {
+		unregistered = append(unregistered, "mirrors.DeleteRepositoryMirrorHandler")
+	}
+
+	if o.RepositoriesForkRepositoryHandler == nil {
+		unregistered = append(unregistered, "repositories.ForkRepositoryHandler")
+	}
+
+	if o.StatusesGetBranchProtectionHandler == nil {
+		unregistered = append(unregistered, "statuses.GetBranchProtectionHandler")
+	}
+
+	if o.StatusesGetCombinedStatusHandler == nil {
+		unregistered = append(unregistered, "statuses.GetCombinedStatusHandler")
+	}
+
+	if o.IssuesGetIssueHandler == nil {
+		unregistered = append(unregistered, "issues.GetIssueHandler")
+	}
+
+	if o.NotificationsGetNotificationPreferencesHandler == nil {
+		unregistered = append(unregistered, "notifications.GetNotificationPreferencesHandler")
+	}
+
 	if o.RepositoriesGetOwnerRepositoriesHandler == nil {
 		unregistered = append(unregistered, "repositories.GetOwnerRepositoriesHandler")
 	}
 
+	if o.PullrequestsGetPullRequestHandler == nil {
+		unregistered = append(unregistered, "pullrequests.GetPullRequestHandler")
+	}
+
+	if o.PullrequestsGetPullRequestCommitsHandler == nil {
+		unregistered = append(unregistered, "pullrequests.GetPullRequestCommitsHandler")
+	}
+
+	if o.PullrequestsGetPullRequestDiffHandler == nil {
+		unregistered = append(unregistered, "pullrequests.GetPullRequestDiffHandler")
+	}
+
 	if o.RepositoriesGetRepositoryHandler == nil {
 		unregistered = append(unregistered, "repositories.GetRepositoryHandler")
 	}
@@ -195,6 +603,22 @@ func (o *SourcepodsAPI) Validate() error {
 		unregistered = append(unregistered, "repositories.GetRepositoryBranchesHandler")
 	}
 
+	if o.RepositoriesGetRepositoryForksHandler == nil {
+		unregistered = append(unregistered, "repositories.GetRepositoryForksHandler")
+	}
+
+	if o.RepositoriesGetRepositoryImportHandler == nil {
+		unregistered = append(unregistered, "repositories.GetRepositoryImportHandler")
+	}
+
+	if o.RepositoriesGetRepositoryNetworkHandler == nil {
+		unregistered = append(unregistered, "repositories.GetRepositoryNetworkHandler")
+	}
+
+	if o.RepositoriesGetRepositoryReadmeHandler == nil {
+		unregistered = append(unregistered, "repositories.GetRepositoryReadmeHandler")
+	}
+
 	if o.RepositoriesGetRepositoryTreeHandler == nil {
 		unregistered = append(unregistered, "repositories.GetRepositoryTreeHandler")
 	}
@@ -207,14 +631,158 @@ func (o *SourcepodsAPI) Validate() error {
 		unregistered = append(unregistered, "users.GetUserMeHandler")
 	}
 
+	if o.RepositoriesImportRepositoryHandler == nil {
+		unregistered = append(unregistered, "repositories.ImportRepositoryHandler")
+	}
+
+	if o.EventsListEventsHandler == nil {
+		unregistered = append(unregistered, "events.ListEventsHandler")
+	}
+
+	if o.IssuesListIssueCommentsHandler == nil {
+		unregistered = append(unregistered, "issues.ListIssueCommentsHandler")
+	}
+
+	if o.IssuesListIssuesHandler == nil {
+		unregistered = append(unregistered, "issues.ListIssuesHandler")
+	}
+
+	if o.IssuesListLabelsHandler == nil {
+		unregistered = append(unregistered, "issues.ListLabelsHandler")
+	}
+
+	if o.IssuesListMilestonesHandler == nil {
+		unregistered = append(unregistered, "issues.ListMilestonesHandler")
+	}
+
+	if o.NotificationsListNotificationsHandler == nil {
+		unregistered = append(unregistered, "notifications.ListNotificationsHandler")
+	}
+
+	if o.PullrequestsListPullRequestCommentsHandler == nil {
+		unregistered = append(unregistered, "pullrequests.ListPullRequestCommentsHandler")
+	}
+
+	if o.PullrequestsListPullRequestsHandler == nil {
+		unregistered = append(unregistered, "pullrequests.ListPullRequestsHandler")
+	}
+
+	if o.EventsListRepositoryEventsHandler == nil {
+		unregistered = append(unregistered, "events.ListRepositoryEventsHandler")
+	}
+
+	if o.MirrorsListRepositoryMirrorsHandler == nil {
+		unregistered = append(unregistered, "mirrors.ListRepositoryMirrorsHandler")
+	}
+
+	if o.WatchingListRepositoryWatchersHandler == nil {
+		unregistered = append(unregistered, "watching.ListRepositoryWatchersHandler")
+	}
+
+	if o.StarsListStarredRepositoriesHandler == nil {
+		unregistered = append(unregistered, "stars.ListStarredRepositoriesHandler")
+	}
+
+	if o.StatusesListStatusesHandler == nil {
+		unregistered = append(unregistered, "statuses.ListStatusesHandler")
+	}
+
+	if o.EventsListUserEventsHandler == nil {
+		unregistered = append(unregistered, "events.ListUserEventsHandler")
+	}
+
+	if o.StarsListUserStarredRepositoriesHandler == nil {
+		unregistered = append(unregistered, "stars.ListUserStarredRepositoriesHandler")
+	}
+
 	if o.UsersListUsersHandler == nil {
 		unregistered = append(unregistered, "users.ListUsersHandler")
 	}
 
+	if o.NotificationsMarkNotificationReadHandler == nil {
+		unregistered = append(unregistered, "notifications.MarkNotificationReadHandler")
+	}
+
+	if o.NotificationsMarkNotificationsReadHandler == nil {
+		unregistered = append(unregistered, "notifications.MarkNotificationsReadHandler")
+	}
+
+	if o.PullrequestsMergePullRequestHandler == nil {
+		unregistered = append(unregistered, "pullrequests.MergePullRequestHandler")
+	}
+
+	if o.RepositoriesRenameRepositoryBranchHandler == nil {
+		unregistered = append(unregistered, "repositories.RenameRepositoryBranchHandler")
+	}
+
+	if o.MarkdownRenderMarkdownHandler == nil {
+		unregistered = append(unregistered, "markdown.RenderMarkdownHandler")
+	}
+
+	if o.SearchSearchCodeHandler == nil {
+		unregistered = append(unregistered, "search.SearchCodeHandler")
+	}
+
+	if o.SearchSearchRepositoriesHandler == nil {
+		unregistered = append(unregistered, "search.SearchRepositoriesHandler")
+	}
+
+	if o.RepositoriesSearchRepositoryHandler == nil {
+		unregistered = append(unregistered, "repositories.SearchRepositoryHandler")
+	}
+
+	if o.SearchSearchUsersHandler == nil {
+		unregistered = append(unregistered, "search.SearchUsersHandler")
+	}
+
+	if o.StarsStarRepositoryHandler == nil {
+		unregistered = append(unregistered, "stars.StarRepositoryHandler")
+	}
+
+	if o.MirrorsSyncRepositoryMirrorHandler == nil {
+		unregistered = append(unregistered, "mirrors.SyncRepositoryMirrorHandler")
+	}
+
+	if o.RepositoriesTransferRepositoryHandler == nil {
+		unregistered = append(unregistered, "repositories.TransferRepositoryHandler")
+	}
+
+	if o.StarsUnstarRepositoryHandler == nil {
+		unregistered = append(unregistered, "stars.UnstarRepositoryHandler")
+	}
+
+	if o.WatchingUnwatchRepositoryHandler == nil {
+		unregistered = append(unregistered, "watching.UnwatchRepositoryHandler")
+	}
+
+	if o.StatusesUpdateBranchProtectionHandler == nil {
+		unregistered = append(unregistered, "statuses.UpdateBranchProtectionHandler")
+	}
+
+	if o.IssuesUpdateIssueHandler == nil {
+		unregistered = append(unregistered, "issues.UpdateIssueHandler")
+	}
+
+	if o.NotificationsUpdateNotificationPreferencesHandler == nil {
+		unregistered = append(unregistered, "notifications.UpdateNotificationPreferencesHandler")
+	}
+
+	if o.PullrequestsUpdatePullRequestHandler == nil {
+		unregistered = append(unregistered, "pullrequests.UpdatePullRequestHandler")
+	}
+
+	if o.RepositoriesUpdateRepositoryHandler == nil {
+		unregistered = append(unregistered, "repositories.UpdateRepositoryHandler")
+	}
+
 	if o.UsersUpdateUserHandler == nil {
 		unregistered = append(unregistered, "users.UpdateUserHandler")
 	}
 
+	if o.WatchingWatchRepositoryHandler == nil {
+		unregistered = append(unregistered, "watching.WatchRepositoryHandler")
+	}
+
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
 	}
@@ -313,16 +881,126 @@ func (o *SourcepodsAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/starred/{owner}/{name}"] = stars.NewCheckRepositoryStarred(o.context, o.StarsCheckRepositoryStarredHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/repositories/{owner}/{name}/issues"] = issues.NewCreateIssue(o.context, o.IssuesCreateIssueHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/repositories/{owner}/{name}/issues/{number}/comments"] = issues.NewCreateIssueComment(o.context, o.IssuesCreateIssueCommentHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/repositories/{owner}/{name}/labels"] = issues.NewCreateLabel(o.context, o.IssuesCreateLabelHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/repositories/{owner}/{name}/milestones"] = issues.NewCreateMilestone(o.context, o.IssuesCreateMilestoneHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/repositories/{owner}/{name}/pulls"] = pullrequests.NewCreatePullRequest(o.context, o.PullrequestsCreatePullRequestHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/repositories/{owner}/{name}/pulls/{number}/comments"] = pullrequests.NewCreatePullRequestComment(o.context, o.PullrequestsCreatePullRequestCommentHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/repositories"] = repositories.NewCreateRepository(o.context, o.RepositoriesCreateRepositoryHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/repositories/{owner}/{name}/branches/{branch}"] = repositories.NewCreateRepositoryBranch(o.context, o.RepositoriesCreateRepositoryBranchHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/repositories/{owner}/{name}/mirrors"] = mirrors.NewCreateRepositoryMirror(o.context, o.MirrorsCreateRepositoryMirrorHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/repositories/{owner}/{name}/statuses/{ref}"] = statuses.NewCreateStatus(o.context, o.StatusesCreateStatusHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/repositories/{owner}/{name}/labels/{label}"] = issues.NewDeleteLabel(o.context, o.IssuesDeleteLabelHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/repositories/{owner}/{name}"] = repositories.NewDeleteRepository(o.context, o.RepositoriesDeleteRepositoryHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/repositories/{owner}/{name}/branches/{branch}"] = repositories.NewDeleteRepositoryBranch(o.context, o.RepositoriesDeleteRepositoryBranchHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/repositories/{owner}/{name}/mirrors/{id}"] = mirrors.NewDeleteRepositoryMirror(o.context, o.MirrorsDeleteRepositoryMirrorHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/repositories/{owner}/{name}/forks"] = repositories.NewForkRepository(o.context, o.RepositoriesForkRepositoryHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/branches/{branch}/protection"] = statuses.NewGetBranchProtection(o.context, o.StatusesGetBranchProtectionHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/statuses/{ref}/combined"] = statuses.NewGetCombinedStatus(o.context, o.StatusesGetCombinedStatusHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/issues/{number}"] = issues.NewGetIssue(o.context, o.IssuesGetIssueHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/notifications/preferences"] = notifications.NewGetNotificationPreferences(o.context, o.NotificationsGetNotificationPreferencesHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/repositories/{owner}"] = repositories.NewGetOwnerRepositories(o.context, o.RepositoriesGetOwnerRepositoriesHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/pulls/{number}"] = pullrequests.NewGetPullRequest(o.context, o.PullrequestsGetPullRequestHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/pulls/{number}/commits"] = pullrequests.NewGetPullRequestCommits(o.context, o.PullrequestsGetPullRequestCommitsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/pulls/{number}/diff"] = pullrequests.NewGetPullRequestDiff(o.context, o.PullrequestsGetPullRequestDiffHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -333,6 +1011,26 @@ func (o *SourcepodsAPI) initHandlerCache() {
 	}
 	o.handlers["GET"]["/repositories/{owner}/{name}/branches"] = repositories.NewGetRepositoryBranches(o.context, o.RepositoriesGetRepositoryBranchesHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/forks"] = repositories.NewGetRepositoryForks(o.context, o.RepositoriesGetRepositoryForksHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/import/{id}"] = repositories.NewGetRepositoryImport(o.context, o.RepositoriesGetRepositoryImportHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/network"] = repositories.NewGetRepositoryNetwork(o.context, o.RepositoriesGetRepositoryNetworkHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/readme"] = repositories.NewGetRepositoryReadme(o.context, o.RepositoriesGetRepositoryReadmeHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -348,16 +1046,196 @@ func (o *SourcepodsAPI) initHandlerCache() {
 	}
 	o.handlers["GET"]["/users/me"] = users.NewGetUserMe(o.context, o.UsersGetUserMeHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/repositories/import"] = repositories.NewImportRepository(o.context, o.RepositoriesImportRepositoryHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/events"] = events.NewListEvents(o.context, o.EventsListEventsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/issues/{number}/comments"] = issues.NewListIssueComments(o.context, o.IssuesListIssueCommentsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/issues"] = issues.NewListIssues(o.context, o.IssuesListIssuesHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/labels"] = issues.NewListLabels(o.context, o.IssuesListLabelsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/milestones"] = issues.NewListMilestones(o.context, o.IssuesListMilestonesHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/notifications"] = notifications.NewListNotifications(o.context, o.NotificationsListNotificationsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/pulls/{number}/comments"] = pullrequests.NewListPullRequestComments(o.context, o.PullrequestsListPullRequestCommentsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/pulls"] = pullrequests.NewListPullRequests(o.context, o.PullrequestsListPullRequestsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/events"] = events.NewListRepositoryEvents(o.context, o.EventsListRepositoryEventsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/mirrors"] = mirrors.NewListRepositoryMirrors(o.context, o.MirrorsListRepositoryMirrorsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/watchers"] = watching.NewListRepositoryWatchers(o.context, o.WatchingListRepositoryWatchersHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/starred"] = stars.NewListStarredRepositories(o.context, o.StarsListStarredRepositoriesHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/statuses/{ref}"] = statuses.NewListStatuses(o.context, o.StatusesListStatusesHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users/{username}/events"] = events.NewListUserEvents(o.context, o.EventsListUserEventsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users/{username}/starred"] = stars.NewListUserStarredRepositories(o.context, o.StarsListUserStarredRepositoriesHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/users"] = users.NewListUsers(o.context, o.UsersListUsersHandler)
 
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/notifications/threads/{id}"] = notifications.NewMarkNotificationRead(o.context, o.NotificationsMarkNotificationReadHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/notifications"] = notifications.NewMarkNotificationsRead(o.context, o.NotificationsMarkNotificationsReadHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/repositories/{owner}/{name}/pulls/{number}/merge"] = pullrequests.NewMergePullRequest(o.context, o.PullrequestsMergePullRequestHandler)
+
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/repositories/{owner}/{name}/branches/{branch}"] = repositories.NewRenameRepositoryBranch(o.context, o.RepositoriesRenameRepositoryBranchHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/markdown"] = markdown.NewRenderMarkdown(o.context, o.MarkdownRenderMarkdownHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/search/code"] = search.NewSearchCode(o.context, o.SearchSearchCodeHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/search/repositories"] = search.NewSearchRepositories(o.context, o.SearchSearchRepositoriesHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/repositories/{owner}/{name}/search"] = repositories.NewSearchRepository(o.context, o.RepositoriesSearchRepositoryHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/search/users"] = search.NewSearchUsers(o.context, o.SearchSearchUsersHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/user/starred/{owner}/{name}"] = stars.NewStarRepository(o.context, o.StarsStarRepositoryHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/repositories/{owner}/{name}/mirrors/{id}/sync"] = mirrors.NewSyncRepositoryMirror(o.context, o.MirrorsSyncRepositoryMirrorHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/repositories/{owner}/{name}/transfer"] = repositories.NewTransferRepository(o.context, o.RepositoriesTransferRepositoryHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/user/starred/{owner}/{name}"] = stars.NewUnstarRepository(o.context, o.StarsUnstarRepositoryHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/repositories/{owner}/{name}/subscription"] = watching.NewUnwatchRepository(o.context, o.WatchingUnwatchRepositoryHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/repositories/{owner}/{name}/branches/{branch}/protection"] = statuses.NewUpdateBranchProtection(o.context, o.StatusesUpdateBranchProtectionHandler)
+
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/repositories/{owner}/{name}/issues/{number}"] = issues.NewUpdateIssue(o.context, o.IssuesUpdateIssueHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/notifications/preferences"] = notifications.NewUpdateNotificationPreferences(o.context, o.NotificationsUpdateNotificationPreferencesHandler)
+
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/repositories/{owner}/{name}/pulls/{number}"] = pullrequests.NewUpdatePullRequest(o.context, o.PullrequestsUpdatePullRequestHandler)
+
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/repositories/{owner}/{name}"] = repositories.NewUpdateRepository(o.context, o.RepositoriesUpdateRepositoryHandler)
+
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/users/{username}"] = users.NewUpdateUser(o.context, o.UsersUpdateUserHandler)
 
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/repositories/{owner}/{name}/subscription"] = watching.NewWatchRepository(o.context, o.WatchingWatchRepositoryHandler)
+
 }
 
 // Serve creates a http handler to serve the API over HTTP
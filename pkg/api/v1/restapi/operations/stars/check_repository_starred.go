@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package stars
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// CheckRepositoryStarredHandlerFunc turns a function with the right signature into a check repository starred handler
+type CheckRepositoryStarredHandlerFunc func(CheckRepositoryStarredParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CheckRepositoryStarredHandlerFunc) Handle(params CheckRepositoryStarredParams) middleware.Responder {
+	return fn(params)
+}
+
+// CheckRepositoryStarredHandler interface for that can handle valid check repository starred params
+type CheckRepositoryStarredHandler interface {
+	Handle(CheckRepositoryStarredParams) middleware.Responder
+}
+
+// NewCheckRepositoryStarred creates a new http.Handler for the check repository starred operation
+func NewCheckRepositoryStarred(ctx *middleware.Context, handler CheckRepositoryStarredHandler) *CheckRepositoryStarred {
+	return &CheckRepositoryStarred{Context: ctx, Handler: handler}
+}
+
+/*CheckRepositoryStarred swagger:route GET /user/starred/{owner}/{name} stars checkRepositoryStarred
+
+Check if the current user starred a repository
+
+*/
+type CheckRepositoryStarred struct {
+	Context *middleware.Context
+	Handler CheckRepositoryStarredHandler
+}
+
+func (o *CheckRepositoryStarred) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCheckRepositoryStarredParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
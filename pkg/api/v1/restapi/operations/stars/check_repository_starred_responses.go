@@ -0,0 +1,140 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package stars
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// CheckRepositoryStarredNoContentCode is the HTTP code returned for type CheckRepositoryStarredNoContent
+const CheckRepositoryStarredNoContentCode int = 204
+
+/*CheckRepositoryStarredNoContent The current user starred the repository
+
+swagger:response checkRepositoryStarredNoContent
+*/
+type CheckRepositoryStarredNoContent struct {
+}
+
+// NewCheckRepositoryStarredNoContent creates CheckRepositoryStarredNoContent with default headers values
+func NewCheckRepositoryStarredNoContent() *CheckRepositoryStarredNoContent {
+
+	return &CheckRepositoryStarredNoContent{}
+}
+
+// WriteResponse to the client
+func (o *CheckRepositoryStarredNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// CheckRepositoryStarredNotFoundCode is the HTTP code returned for type CheckRepositoryStarredNotFound
+const CheckRepositoryStarredNotFoundCode int = 404
+
+/*CheckRepositoryStarredNotFound The repository isn't starred or could not be found
+
+swagger:response checkRepositoryStarredNotFound
+*/
+type CheckRepositoryStarredNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCheckRepositoryStarredNotFound creates CheckRepositoryStarredNotFound with default headers values
+func NewCheckRepositoryStarredNotFound() *CheckRepositoryStarredNotFound {
+
+	return &CheckRepositoryStarredNotFound{}
+}
+
+// WithPayload adds the payload to the check repository starred not found response
+func (o *CheckRepositoryStarredNotFound) WithPayload(payload *models.Error) *CheckRepositoryStarredNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the check repository starred not found response
+func (o *CheckRepositoryStarredNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CheckRepositoryStarredNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CheckRepositoryStarredDefault unexpected error
+
+swagger:response checkRepositoryStarredDefault
+*/
+type CheckRepositoryStarredDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCheckRepositoryStarredDefault creates CheckRepositoryStarredDefault with default headers values
+func NewCheckRepositoryStarredDefault(code int) *CheckRepositoryStarredDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CheckRepositoryStarredDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the check repository starred default response
+func (o *CheckRepositoryStarredDefault) WithStatusCode(code int) *CheckRepositoryStarredDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the check repository starred default response
+func (o *CheckRepositoryStarredDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the check repository starred default response
+func (o *CheckRepositoryStarredDefault) WithPayload(payload *models.Error) *CheckRepositoryStarredDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the check repository starred default response
+func (o *CheckRepositoryStarredDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CheckRepositoryStarredDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
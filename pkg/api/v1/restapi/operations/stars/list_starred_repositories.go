@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package stars
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// ListStarredRepositoriesHandlerFunc turns a function with the right signature into a list starred repositories handler
+type ListStarredRepositoriesHandlerFunc func(ListStarredRepositoriesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListStarredRepositoriesHandlerFunc) Handle(params ListStarredRepositoriesParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListStarredRepositoriesHandler interface for that can handle valid list starred repositories params
+type ListStarredRepositoriesHandler interface {
+	Handle(ListStarredRepositoriesParams) middleware.Responder
+}
+
+// NewListStarredRepositories creates a new http.Handler for the list starred repositories operation
+func NewListStarredRepositories(ctx *middleware.Context, handler ListStarredRepositoriesHandler) *ListStarredRepositories {
+	return &ListStarredRepositories{Context: ctx, Handler: handler}
+}
+
+/*ListStarredRepositories swagger:route GET /user/starred stars listStarredRepositories
+
+Get the repositories starred by the current user, most recently starred first
+
+*/
+type ListStarredRepositories struct {
+	Context *middleware.Context
+	Handler ListStarredRepositoriesHandler
+}
+
+func (o *ListStarredRepositories) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListStarredRepositoriesParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,207 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package stars
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListStarredRepositoriesOKCode is the HTTP code returned for type ListStarredRepositoriesOK
+const ListStarredRepositoriesOKCode int = 200
+
+/*ListStarredRepositoriesOK The starred repositories with their owners
+
+swagger:response listStarredRepositoriesOK
+*/
+type ListStarredRepositoriesOK struct {
+	/*The URL of the next page with rel="next", missing on the last page
+
+	 */
+	Link string `json:"Link"`
+	/*The cursor of the next page, missing on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Repository `json:"body,omitempty"`
+}
+
+// NewListStarredRepositoriesOK creates ListStarredRepositoriesOK with default headers values
+func NewListStarredRepositoriesOK() *ListStarredRepositoriesOK {
+
+	return &ListStarredRepositoriesOK{}
+}
+
+// WithLink adds the link to the list starred repositories o k response
+func (o *ListStarredRepositoriesOK) WithLink(link string) *ListStarredRepositoriesOK {
+	o.Link = link
+	return o
+}
+
+// SetLink sets the link to the list starred repositories o k response
+func (o *ListStarredRepositoriesOK) SetLink(link string) {
+	o.Link = link
+}
+
+// WithXNextCursor adds the xNextCursor to the list starred repositories o k response
+func (o *ListStarredRepositoriesOK) WithXNextCursor(xNextCursor string) *ListStarredRepositoriesOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the list starred repositories o k response
+func (o *ListStarredRepositoriesOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
+// WithPayload adds the payload to the list starred repositories o k response
+func (o *ListStarredRepositoriesOK) WithPayload(payload []*models.Repository) *ListStarredRepositoriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list starred repositories o k response
+func (o *ListStarredRepositoriesOK) SetPayload(payload []*models.Repository) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListStarredRepositoriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Link
+
+	link := o.Link
+	if link != "" {
+		rw.Header().Set("Link", link)
+	}
+
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Repository, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListStarredRepositoriesUnprocessableEntityCode is the HTTP code returned for type ListStarredRepositoriesUnprocessableEntity
+const ListStarredRepositoriesUnprocessableEntityCode int = 422
+
+/*ListStarredRepositoriesUnprocessableEntity The cursor is not valid
+
+swagger:response listStarredRepositoriesUnprocessableEntity
+*/
+type ListStarredRepositoriesUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListStarredRepositoriesUnprocessableEntity creates ListStarredRepositoriesUnprocessableEntity with default headers values
+func NewListStarredRepositoriesUnprocessableEntity() *ListStarredRepositoriesUnprocessableEntity {
+
+	return &ListStarredRepositoriesUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the list starred repositories unprocessable entity response
+func (o *ListStarredRepositoriesUnprocessableEntity) WithPayload(payload *models.Error) *ListStarredRepositoriesUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list starred repositories unprocessable entity response
+func (o *ListStarredRepositoriesUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListStarredRepositoriesUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListStarredRepositoriesDefault unexpected error
+
+swagger:response listStarredRepositoriesDefault
+*/
+type ListStarredRepositoriesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListStarredRepositoriesDefault creates ListStarredRepositoriesDefault with default headers values
+func NewListStarredRepositoriesDefault(code int) *ListStarredRepositoriesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListStarredRepositoriesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list starred repositories default response
+func (o *ListStarredRepositoriesDefault) WithStatusCode(code int) *ListStarredRepositoriesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list starred repositories default response
+func (o *ListStarredRepositoriesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list starred repositories default response
+func (o *ListStarredRepositoriesDefault) WithPayload(payload *models.Error) *ListStarredRepositoriesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list starred repositories default response
+func (o *ListStarredRepositoriesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListStarredRepositoriesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package stars
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// ListUserStarredRepositoriesHandlerFunc turns a function with the right signature into a list user starred repositories handler
+type ListUserStarredRepositoriesHandlerFunc func(ListUserStarredRepositoriesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListUserStarredRepositoriesHandlerFunc) Handle(params ListUserStarredRepositoriesParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListUserStarredRepositoriesHandler interface for that can handle valid list user starred repositories params
+type ListUserStarredRepositoriesHandler interface {
+	Handle(ListUserStarredRepositoriesParams) middleware.Responder
+}
+
+// NewListUserStarredRepositories creates a new http.Handler for the list user starred repositories operation
+func NewListUserStarredRepositories(ctx *middleware.Context, handler ListUserStarredRepositoriesHandler) *ListUserStarredRepositories {
+	return &ListUserStarredRepositories{Context: ctx, Handler: handler}
+}
+
+/*ListUserStarredRepositories swagger:route GET /users/{username}/starred stars listUserStarredRepositories
+
+Get the repositories starred by a user, most recently starred first
+
+*/
+type ListUserStarredRepositories struct {
+	Context *middleware.Context
+	Handler ListUserStarredRepositoriesHandler
+}
+
+func (o *ListUserStarredRepositories) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListUserStarredRepositoriesParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,251 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package stars
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListUserStarredRepositoriesOKCode is the HTTP code returned for type ListUserStarredRepositoriesOK
+const ListUserStarredRepositoriesOKCode int = 200
+
+/*ListUserStarredRepositoriesOK The starred repositories with their owners
+
+swagger:response listUserStarredRepositoriesOK
+*/
+type ListUserStarredRepositoriesOK struct {
+	/*The URL of the next page with rel="next", missing on the last page
+
+	 */
+	Link string `json:"Link"`
+	/*The cursor of the next page, missing on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Repository `json:"body,omitempty"`
+}
+
+// NewListUserStarredRepositoriesOK creates ListUserStarredRepositoriesOK with default headers values
+func NewListUserStarredRepositoriesOK() *ListUserStarredRepositoriesOK {
+
+	return &ListUserStarredRepositoriesOK{}
+}
+
+// WithLink adds the link to the list user starred repositories o k response
+func (o *ListUserStarredRepositoriesOK) WithLink(link string) *ListUserStarredRepositoriesOK {
+	o.Link = link
+	return o
+}
+
+// SetLink sets the link to the list user starred repositories o k response
+func (o *ListUserStarredRepositoriesOK) SetLink(link string) {
+	o.Link = link
+}
+
+// WithXNextCursor adds the xNextCursor to the list user starred repositories o k response
+func (o *ListUserStarredRepositoriesOK) WithXNextCursor(xNextCursor string) *ListUserStarredRepositoriesOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the list user starred repositories o k response
+func (o *ListUserStarredRepositoriesOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
+// WithPayload adds the payload to the list user starred repositories o k response
+func (o *ListUserStarredRepositoriesOK) WithPayload(payload []*models.Repository) *ListUserStarredRepositoriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list user starred repositories o k response
+func (o *ListUserStarredRepositoriesOK) SetPayload(payload []*models.Repository) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUserStarredRepositoriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Link
+
+	link := o.Link
+	if link != "" {
+		rw.Header().Set("Link", link)
+	}
+
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Repository, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListUserStarredRepositoriesNotFoundCode is the HTTP code returned for type ListUserStarredRepositoriesNotFound
+const ListUserStarredRepositoriesNotFoundCode int = 404
+
+/*ListUserStarredRepositoriesNotFound The user could not be found
+
+swagger:response listUserStarredRepositoriesNotFound
+*/
+type ListUserStarredRepositoriesNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListUserStarredRepositoriesNotFound creates ListUserStarredRepositoriesNotFound with default headers values
+func NewListUserStarredRepositoriesNotFound() *ListUserStarredRepositoriesNotFound {
+
+	return &ListUserStarredRepositoriesNotFound{}
+}
+
+// WithPayload adds the payload to the list user starred repositories not found response
+func (o *ListUserStarredRepositoriesNotFound) WithPayload(payload *models.Error) *ListUserStarredRepositoriesNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list user starred repositories not found response
+func (o *ListUserStarredRepositoriesNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUserStarredRepositoriesNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListUserStarredRepositoriesUnprocessableEntityCode is the HTTP code returned for type ListUserStarredRepositoriesUnprocessableEntity
+const ListUserStarredRepositoriesUnprocessableEntityCode int = 422
+
+/*ListUserStarredRepositoriesUnprocessableEntity The cursor is not valid
+
+swagger:response listUserStarredRepositoriesUnprocessableEntity
+*/
+type ListUserStarredRepositoriesUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListUserStarredRepositoriesUnprocessableEntity creates ListUserStarredRepositoriesUnprocessableEntity with default headers values
+func NewListUserStarredRepositoriesUnprocessableEntity() *ListUserStarredRepositoriesUnprocessableEntity {
+
+	return &ListUserStarredRepositoriesUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the list user starred repositories unprocessable entity response
+func (o *ListUserStarredRepositoriesUnprocessableEntity) WithPayload(payload *models.Error) *ListUserStarredRepositoriesUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list user starred repositories unprocessable entity response
+func (o *ListUserStarredRepositoriesUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUserStarredRepositoriesUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListUserStarredRepositoriesDefault unexpected error
+
+swagger:response listUserStarredRepositoriesDefault
+*/
+type ListUserStarredRepositoriesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListUserStarredRepositoriesDefault creates ListUserStarredRepositoriesDefault with default headers values
+func NewListUserStarredRepositoriesDefault(code int) *ListUserStarredRepositoriesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListUserStarredRepositoriesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list user starred repositories default response
+func (o *ListUserStarredRepositoriesDefault) WithStatusCode(code int) *ListUserStarredRepositoriesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list user starred repositories default response
+func (o *ListUserStarredRepositoriesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list user starred repositories default response
+func (o *ListUserStarredRepositoriesDefault) WithPayload(payload *models.Error) *ListUserStarredRepositoriesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list user starred repositories default response
+func (o *ListUserStarredRepositoriesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUserStarredRepositoriesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package stars
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// StarRepositoryHandlerFunc turns a function with the right signature into a star repository handler
+type StarRepositoryHandlerFunc func(StarRepositoryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn StarRepositoryHandlerFunc) Handle(params StarRepositoryParams) middleware.Responder {
+	return fn(params)
+}
+
+// StarRepositoryHandler interface for that can handle valid star repository params
+type StarRepositoryHandler interface {
+	Handle(StarRepositoryParams) middleware.Responder
+}
+
+// NewStarRepository creates a new http.Handler for the star repository operation
+func NewStarRepository(ctx *middleware.Context, handler StarRepositoryHandler) *StarRepository {
+	return &StarRepository{Context: ctx, Handler: handler}
+}
+
+/*StarRepository swagger:route PUT /user/starred/{owner}/{name} stars starRepository
+
+Star a repository
+
+*/
+type StarRepository struct {
+	Context *middleware.Context
+	Handler StarRepositoryHandler
+}
+
+func (o *StarRepository) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewStarRepositoryParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
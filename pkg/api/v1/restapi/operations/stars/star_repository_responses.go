@@ -0,0 +1,140 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package stars
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// StarRepositoryNoContentCode is the HTTP code returned for type StarRepositoryNoContent
+const StarRepositoryNoContentCode int = 204
+
+/*StarRepositoryNoContent The current user starred the repository
+
+swagger:response starRepositoryNoContent
+*/
+type StarRepositoryNoContent struct {
+}
+
+// NewStarRepositoryNoContent creates StarRepositoryNoContent with default headers values
+func NewStarRepositoryNoContent() *StarRepositoryNoContent {
+
+	return &StarRepositoryNoContent{}
+}
+
+// WriteResponse to the client
+func (o *StarRepositoryNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// StarRepositoryNotFoundCode is the HTTP code returned for type StarRepositoryNotFound
+const StarRepositoryNotFoundCode int = 404
+
+/*StarRepositoryNotFound The owner and name combination could not be found
+
+swagger:response starRepositoryNotFound
+*/
+type StarRepositoryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewStarRepositoryNotFound creates StarRepositoryNotFound with default headers values
+func NewStarRepositoryNotFound() *StarRepositoryNotFound {
+
+	return &StarRepositoryNotFound{}
+}
+
+// WithPayload adds the payload to the star repository not found response
+func (o *StarRepositoryNotFound) WithPayload(payload *models.Error) *StarRepositoryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the star repository not found response
+func (o *StarRepositoryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StarRepositoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*StarRepositoryDefault unexpected error
+
+swagger:response starRepositoryDefault
+*/
+type StarRepositoryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewStarRepositoryDefault creates StarRepositoryDefault with default headers values
+func NewStarRepositoryDefault(code int) *StarRepositoryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &StarRepositoryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the star repository default response
+func (o *StarRepositoryDefault) WithStatusCode(code int) *StarRepositoryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the star repository default response
+func (o *StarRepositoryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the star repository default response
+func (o *StarRepositoryDefault) WithPayload(payload *models.Error) *StarRepositoryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the star repository default response
+func (o *StarRepositoryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StarRepositoryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package stars
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// UnstarRepositoryHandlerFunc turns a function with the right signature into a unstar repository handler
+type UnstarRepositoryHandlerFunc func(UnstarRepositoryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UnstarRepositoryHandlerFunc) Handle(params UnstarRepositoryParams) middleware.Responder {
+	return fn(params)
+}
+
+// UnstarRepositoryHandler interface for that can handle valid unstar repository params
+type UnstarRepositoryHandler interface {
+	Handle(UnstarRepositoryParams) middleware.Responder
+}
+
+// NewUnstarRepository creates a new http.Handler for the unstar repository operation
+func NewUnstarRepository(ctx *middleware.Context, handler UnstarRepositoryHandler) *UnstarRepository {
+	return &UnstarRepository{Context: ctx, Handler: handler}
+}
+
+/*UnstarRepository swagger:route DELETE /user/starred/{owner}/{name} stars unstarRepository
+
+Unstar a repository
+
+*/
+type UnstarRepository struct {
+	Context *middleware.Context
+	Handler UnstarRepositoryHandler
+}
+
+func (o *UnstarRepository) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewUnstarRepositoryParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,140 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package stars
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// UnstarRepositoryNoContentCode is the HTTP code returned for type UnstarRepositoryNoContent
+const UnstarRepositoryNoContentCode int = 204
+
+/*UnstarRepositoryNoContent The current user doesn't star the repository
+
+swagger:response unstarRepositoryNoContent
+*/
+type UnstarRepositoryNoContent struct {
+}
+
+// NewUnstarRepositoryNoContent creates UnstarRepositoryNoContent with default headers values
+func NewUnstarRepositoryNoContent() *UnstarRepositoryNoContent {
+
+	return &UnstarRepositoryNoContent{}
+}
+
+// WriteResponse to the client
+func (o *UnstarRepositoryNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// UnstarRepositoryNotFoundCode is the HTTP code returned for type UnstarRepositoryNotFound
+const UnstarRepositoryNotFoundCode int = 404
+
+/*UnstarRepositoryNotFound The owner and name combination could not be found
+
+swagger:response unstarRepositoryNotFound
+*/
+type UnstarRepositoryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUnstarRepositoryNotFound creates UnstarRepositoryNotFound with default headers values
+func NewUnstarRepositoryNotFound() *UnstarRepositoryNotFound {
+
+	return &UnstarRepositoryNotFound{}
+}
+
+// WithPayload adds the payload to the unstar repository not found response
+func (o *UnstarRepositoryNotFound) WithPayload(payload *models.Error) *UnstarRepositoryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the unstar repository not found response
+func (o *UnstarRepositoryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UnstarRepositoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UnstarRepositoryDefault unexpected error
+
+swagger:response unstarRepositoryDefault
+*/
+type UnstarRepositoryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUnstarRepositoryDefault creates UnstarRepositoryDefault with default headers values
+func NewUnstarRepositoryDefault(code int) *UnstarRepositoryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UnstarRepositoryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the unstar repository default response
+func (o *UnstarRepositoryDefault) WithStatusCode(code int) *UnstarRepositoryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the unstar repository default response
+func (o *UnstarRepositoryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the unstar repository default response
+func (o *UnstarRepositoryDefault) WithPayload(payload *models.Error) *UnstarRepositoryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the unstar repository default response
+func (o *UnstarRepositoryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UnstarRepositoryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package statuses
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// CreateStatusHandlerFunc turns a function with the right signature into a create status handler
+type CreateStatusHandlerFunc func(CreateStatusParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateStatusHandlerFunc) Handle(params CreateStatusParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateStatusHandler interface for that can handle valid create status params
+type CreateStatusHandler interface {
+	Handle(CreateStatusParams) middleware.Responder
+}
+
+// NewCreateStatus creates a new http.Handler for the create status operation
+func NewCreateStatus(ctx *middleware.Context, handler CreateStatusHandler) *CreateStatus {
+	return &CreateStatus{Context: ctx, Handler: handler}
+}
+
+/*CreateStatus swagger:route POST /repositories/{owner}/{name}/statuses/{ref} statuses createStatus
+
+Report the status of a commit, e.g. from CI
+
+*/
+type CreateStatus struct {
+	Context *middleware.Context
+	Handler CreateStatusHandler
+}
+
+func (o *CreateStatus) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewCreateStatusParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,248 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package statuses
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// CreateStatusOKCode is the HTTP code returned for type CreateStatusOK
+const CreateStatusOKCode int = 200
+
+/*CreateStatusOK The status has been created
+
+swagger:response createStatusOK
+*/
+type CreateStatusOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Status `json:"body,omitempty"`
+}
+
+// NewCreateStatusOK creates CreateStatusOK with default headers values
+func NewCreateStatusOK() *CreateStatusOK {
+
+	return &CreateStatusOK{}
+}
+
+// WithPayload adds the payload to the create status o k response
+func (o *CreateStatusOK) WithPayload(payload *models.Status) *CreateStatusOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create status o k response
+func (o *CreateStatusOK) SetPayload(payload *models.Status) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateStatusOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateStatusForbiddenCode is the HTTP code returned for type CreateStatusForbidden
+const CreateStatusForbiddenCode int = 403
+
+/*CreateStatusForbidden Only the repository's owner can report statuses
+
+swagger:response createStatusForbidden
+*/
+type CreateStatusForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateStatusForbidden creates CreateStatusForbidden with default headers values
+func NewCreateStatusForbidden() *CreateStatusForbidden {
+
+	return &CreateStatusForbidden{}
+}
+
+// WithPayload adds the payload to the create status forbidden response
+func (o *CreateStatusForbidden) WithPayload(payload *models.Error) *CreateStatusForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create status forbidden response
+func (o *CreateStatusForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateStatusForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateStatusNotFoundCode is the HTTP code returned for type CreateStatusNotFound
+const CreateStatusNotFoundCode int = 404
+
+/*CreateStatusNotFound The repository or commit could not be found
+
+swagger:response createStatusNotFound
+*/
+type CreateStatusNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateStatusNotFound creates CreateStatusNotFound with default headers values
+func NewCreateStatusNotFound() *CreateStatusNotFound {
+
+	return &CreateStatusNotFound{}
+}
+
+// WithPayload adds the payload to the create status not found response
+func (o *CreateStatusNotFound) WithPayload(payload *models.Error) *CreateStatusNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create status not found response
+func (o *CreateStatusNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateStatusNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateStatusUnprocessableEntityCode is the HTTP code returned for type CreateStatusUnprocessableEntity
+const CreateStatusUnprocessableEntityCode int = 422
+
+/*CreateStatusUnprocessableEntity The status is not valid
+
+swagger:response createStatusUnprocessableEntity
+*/
+type CreateStatusUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewCreateStatusUnprocessableEntity creates CreateStatusUnprocessableEntity with default headers values
+func NewCreateStatusUnprocessableEntity() *CreateStatusUnprocessableEntity {
+
+	return &CreateStatusUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the create status unprocessable entity response
+func (o *CreateStatusUnprocessableEntity) WithPayload(payload *models.ValidationError) *CreateStatusUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create status unprocessable entity response
+func (o *CreateStatusUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateStatusUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateStatusDefault unexpected error
+
+swagger:response createStatusDefault
+*/
+type CreateStatusDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateStatusDefault creates CreateStatusDefault with default headers values
+func NewCreateStatusDefault(code int) *CreateStatusDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateStatusDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create status default response
+func (o *CreateStatusDefault) WithStatusCode(code int) *CreateStatusDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create status default response
+func (o *CreateStatusDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create status default response
+func (o *CreateStatusDefault) WithPayload(payload *models.Error) *CreateStatusDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create status default response
+func (o *CreateStatusDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateStatusDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package statuses
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// GetBranchProtectionHandlerFunc turns a function with the right signature into a get branch protection handler
+type GetBranchProtectionHandlerFunc func(GetBranchProtectionParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetBranchProtectionHandlerFunc) Handle(params GetBranchProtectionParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetBranchProtectionHandler interface for that can handle valid get branch protection params
+type GetBranchProtectionHandler interface {
+	Handle(GetBranchProtectionParams) middleware.Responder
+}
+
+// NewGetBranchProtection creates a new http.Handler for the get branch protection operation
+func NewGetBranchProtection(ctx *middleware.Context, handler GetBranchProtectionHandler) *GetBranchProtection {
+	return &GetBranchProtection{Context: ctx, Handler: handler}
+}
+
+/*GetBranchProtection swagger:route GET /repositories/{owner}/{name}/branches/{branch}/protection statuses getBranchProtection
+
+Get the protection of a branch with its required status checks
+
+*/
+type GetBranchProtection struct {
+	Context *middleware.Context
+	Handler GetBranchProtectionHandler
+}
+
+func (o *GetBranchProtection) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetBranchProtectionParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
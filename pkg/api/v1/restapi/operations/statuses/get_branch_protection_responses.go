@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package statuses
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// GetBranchProtectionOKCode is the HTTP code returned for type GetBranchProtectionOK
+const GetBranchProtectionOKCode int = 200
+
+/*GetBranchProtectionOK The branch's protection, without required checks if it isn't protected
+
+swagger:response getBranchProtectionOK
+*/
+type GetBranchProtectionOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BranchProtection `json:"body,omitempty"`
+}
+
+// NewGetBranchProtectionOK creates GetBranchProtectionOK with default headers values
+func NewGetBranchProtectionOK() *GetBranchProtectionOK {
+
+	return &GetBranchProtectionOK{}
+}
+
+// WithPayload adds the payload to the get branch protection o k response
+func (o *GetBranchProtectionOK) WithPayload(payload *models.BranchProtection) *GetBranchProtectionOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get branch protection o k response
+func (o *GetBranchProtectionOK) SetPayload(payload *models.BranchProtection) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetBranchProtectionOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetBranchProtectionNotFoundCode is the HTTP code returned for type GetBranchProtectionNotFound
+const GetBranchProtectionNotFoundCode int = 404
+
+/*GetBranchProtectionNotFound The owner and name combination could not be found
+
+swagger:response getBranchProtectionNotFound
+*/
+type GetBranchProtectionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetBranchProtectionNotFound creates GetBranchProtectionNotFound with default headers values
+func NewGetBranchProtectionNotFound() *GetBranchProtectionNotFound {
+
+	return &GetBranchProtectionNotFound{}
+}
+
+// WithPayload adds the payload to the get branch protection not found response
+func (o *GetBranchProtectionNotFound) WithPayload(payload *models.Error) *GetBranchProtectionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get branch protection not found response
+func (o *GetBranchProtectionNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetBranchProtectionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetBranchProtectionDefault unexpected error
+
+swagger:response getBranchProtectionDefault
+*/
+type GetBranchProtectionDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetBranchProtectionDefault creates GetBranchProtectionDefault with default headers values
+func NewGetBranchProtectionDefault(code int) *GetBranchProtectionDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetBranchProtectionDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get branch protection default response
+func (o *GetBranchProtectionDefault) WithStatusCode(code int) *GetBranchProtectionDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get branch protection default response
+func (o *GetBranchProtectionDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get branch protection default response
+func (o *GetBranchProtectionDefault) WithPayload(payload *models.Error) *GetBranchProtectionDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get branch protection default response
+func (o *GetBranchProtectionDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetBranchProtectionDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package statuses
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// GetCombinedStatusHandlerFunc turns a function with the right signature into a get combined status handler
+type GetCombinedStatusHandlerFunc func(GetCombinedStatusParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetCombinedStatusHandlerFunc) Handle(params GetCombinedStatusParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetCombinedStatusHandler interface for that can handle valid get combined status params
+type GetCombinedStatusHandler interface {
+	Handle(GetCombinedStatusParams) middleware.Responder
+}
+
+// NewGetCombinedStatus creates a new http.Handler for the get combined status operation
+func NewGetCombinedStatus(ctx *middleware.Context, handler GetCombinedStatusHandler) *GetCombinedStatus {
+	return &GetCombinedStatus{Context: ctx, Handler: handler}
+}
+
+/*GetCombinedStatus swagger:route GET /repositories/{owner}/{name}/statuses/{ref}/combined statuses getCombinedStatus
+
+Get the state of a commit combined from the latest status of each context
+
+*/
+type GetCombinedStatus struct {
+	Context *middleware.Context
+	Handler GetCombinedStatusHandler
+}
+
+func (o *GetCombinedStatus) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetCombinedStatusParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package statuses
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// GetCombinedStatusOKCode is the HTTP code returned for type GetCombinedStatusOK
+const GetCombinedStatusOKCode int = 200
+
+/*GetCombinedStatusOK The commit's combined status
+
+swagger:response getCombinedStatusOK
+*/
+type GetCombinedStatusOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.CombinedStatus `json:"body,omitempty"`
+}
+
+// NewGetCombinedStatusOK creates GetCombinedStatusOK with default headers values
+func NewGetCombinedStatusOK() *GetCombinedStatusOK {
+
+	return &GetCombinedStatusOK{}
+}
+
+// WithPayload adds the payload to the get combined status o k response
+func (o *GetCombinedStatusOK) WithPayload(payload *models.CombinedStatus) *GetCombinedStatusOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get combined status o k response
+func (o *GetCombinedStatusOK) SetPayload(payload *models.CombinedStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetCombinedStatusOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetCombinedStatusNotFoundCode is the HTTP code returned for type GetCombinedStatusNotFound
+const GetCombinedStatusNotFoundCode int = 404
+
+/*GetCombinedStatusNotFound The repository or commit could not be found
+
+swagger:response getCombinedStatusNotFound
+*/
+type GetCombinedStatusNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetCombinedStatusNotFound creates GetCombinedStatusNotFound with default headers values
+func NewGetCombinedStatusNotFound() *GetCombinedStatusNotFound {
+
+	return &GetCombinedStatusNotFound{}
+}
+
+// WithPayload adds the payload to the get combined status not found response
+func (o *GetCombinedStatusNotFound) WithPayload(payload *models.Error) *GetCombinedStatusNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get combined status not found response
+func (o *GetCombinedStatusNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetCombinedStatusNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetCombinedStatusDefault unexpected error
+
+swagger:response getCombinedStatusDefault
+*/
+type GetCombinedStatusDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetCombinedStatusDefault creates GetCombinedStatusDefault with default headers values
+func NewGetCombinedStatusDefault(code int) *GetCombinedStatusDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetCombinedStatusDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get combined status default response
+func (o *GetCombinedStatusDefault) WithStatusCode(code int) *GetCombinedStatusDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get combined status default response
+func (o *GetCombinedStatusDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get combined status default response
+func (o *GetCombinedStatusDefault) WithPayload(payload *models.Error) *GetCombinedStatusDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get combined status default response
+func (o *GetCombinedStatusDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetCombinedStatusDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
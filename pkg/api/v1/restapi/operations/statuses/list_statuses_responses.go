@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package statuses
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListStatusesOKCode is the HTTP code returned for type ListStatusesOK
+const ListStatusesOKCode int = 200
+
+/*ListStatusesOK The commit's statuses
+
+swagger:response listStatusesOK
+*/
+type ListStatusesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Status `json:"body,omitempty"`
+}
+
+// NewListStatusesOK creates ListStatusesOK with default headers values
+func NewListStatusesOK() *ListStatusesOK {
+
+	return &ListStatusesOK{}
+}
+
+// WithPayload adds the payload to the list statuses o k response
+func (o *ListStatusesOK) WithPayload(payload []*models.Status) *ListStatusesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list statuses o k response
+func (o *ListStatusesOK) SetPayload(payload []*models.Status) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListStatusesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.Status, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListStatusesNotFoundCode is the HTTP code returned for type ListStatusesNotFound
+const ListStatusesNotFoundCode int = 404
+
+/*ListStatusesNotFound The repository or commit could not be found
+
+swagger:response listStatusesNotFound
+*/
+type ListStatusesNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListStatusesNotFound creates ListStatusesNotFound with default headers values
+func NewListStatusesNotFound() *ListStatusesNotFound {
+
+	return &ListStatusesNotFound{}
+}
+
+// WithPayload adds the payload to the list statuses not found response
+func (o *ListStatusesNotFound) WithPayload(payload *models.Error) *ListStatusesNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list statuses not found response
+func (o *ListStatusesNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListStatusesNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListStatusesDefault unexpected error
+
+swagger:response listStatusesDefault
+*/
+type ListStatusesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListStatusesDefault creates ListStatusesDefault with default headers values
+func NewListStatusesDefault(code int) *ListStatusesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListStatusesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list statuses default response
+func (o *ListStatusesDefault) WithStatusCode(code int) *ListStatusesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list statuses default response
+func (o *ListStatusesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list statuses default response
+func (o *ListStatusesDefault) WithPayload(payload *models.Error) *ListStatusesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list statuses default response
+func (o *ListStatusesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListStatusesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package statuses
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// UpdateBranchProtectionHandlerFunc turns a function with the right signature into a update branch protection handler
+type UpdateBranchProtectionHandlerFunc func(UpdateBranchProtectionParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateBranchProtectionHandlerFunc) Handle(params UpdateBranchProtectionParams) middleware.Responder {
+	return fn(params)
+}
+
+// UpdateBranchProtectionHandler interface for that can handle valid update branch protection params
+type UpdateBranchProtectionHandler interface {
+	Handle(UpdateBranchProtectionParams) middleware.Responder
+}
+
+// NewUpdateBranchProtection creates a new http.Handler for the update branch protection operation
+func NewUpdateBranchProtection(ctx *middleware.Context, handler UpdateBranchProtectionHandler) *UpdateBranchProtection {
+	return &UpdateBranchProtection{Context: ctx, Handler: handler}
+}
+
+/*UpdateBranchProtection swagger:route PUT /repositories/{owner}/{name}/branches/{branch}/protection statuses updateBranchProtection
+
+Replace the required status checks of a branch
+
+*/
+type UpdateBranchProtection struct {
+	Context *middleware.Context
+	Handler UpdateBranchProtectionHandler
+}
+
+func (o *UpdateBranchProtection) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewUpdateBranchProtectionParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
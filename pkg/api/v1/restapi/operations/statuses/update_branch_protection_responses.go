@@ -0,0 +1,248 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package statuses
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// UpdateBranchProtectionOKCode is the HTTP code returned for type UpdateBranchProtectionOK
+const UpdateBranchProtectionOKCode int = 200
+
+/*UpdateBranchProtectionOK The branch's protection has been updated
+
+swagger:response updateBranchProtectionOK
+*/
+type UpdateBranchProtectionOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BranchProtection `json:"body,omitempty"`
+}
+
+// NewUpdateBranchProtectionOK creates UpdateBranchProtectionOK with default headers values
+func NewUpdateBranchProtectionOK() *UpdateBranchProtectionOK {
+
+	return &UpdateBranchProtectionOK{}
+}
+
+// WithPayload adds the payload to the update branch protection o k response
+func (o *UpdateBranchProtectionOK) WithPayload(payload *models.BranchProtection) *UpdateBranchProtectionOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update branch protection o k response
+func (o *UpdateBranchProtectionOK) SetPayload(payload *models.BranchProtection) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateBranchProtectionOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateBranchProtectionForbiddenCode is the HTTP code returned for type UpdateBranchProtectionForbidden
+const UpdateBranchProtectionForbiddenCode int = 403
+
+/*UpdateBranchProtectionForbidden Only the repository's owner can protect branches
+
+swagger:response updateBranchProtectionForbidden
+*/
+type UpdateBranchProtectionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateBranchProtectionForbidden creates UpdateBranchProtectionForbidden with default headers values
+func NewUpdateBranchProtectionForbidden() *UpdateBranchProtectionForbidden {
+
+	return &UpdateBranchProtectionForbidden{}
+}
+
+// WithPayload adds the payload to the update branch protection forbidden response
+func (o *UpdateBranchProtectionForbidden) WithPayload(payload *models.Error) *UpdateBranchProtectionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update branch protection forbidden response
+func (o *UpdateBranchProtectionForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateBranchProtectionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateBranchProtectionNotFoundCode is the HTTP code returned for type UpdateBranchProtectionNotFound
+const UpdateBranchProtectionNotFoundCode int = 404
+
+/*UpdateBranchProtectionNotFound The owner and name combination could not be found
+
+swagger:response updateBranchProtectionNotFound
+*/
+type UpdateBranchProtectionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateBranchProtectionNotFound creates UpdateBranchProtectionNotFound with default headers values
+func NewUpdateBranchProtectionNotFound() *UpdateBranchProtectionNotFound {
+
+	return &UpdateBranchProtectionNotFound{}
+}
+
+// WithPayload adds the payload to the update branch protection not found response
+func (o *UpdateBranchProtectionNotFound) WithPayload(payload *models.Error) *UpdateBranchProtectionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update branch protection not found response
+func (o *UpdateBranchProtectionNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateBranchProtectionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateBranchProtectionUnprocessableEntityCode is the HTTP code returned for type UpdateBranchProtectionUnprocessableEntity
+const UpdateBranchProtectionUnprocessableEntityCode int = 422
+
+/*UpdateBranchProtectionUnprocessableEntity The required checks are not valid
+
+swagger:response updateBranchProtectionUnprocessableEntity
+*/
+type UpdateBranchProtectionUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ValidationError `json:"body,omitempty"`
+}
+
+// NewUpdateBranchProtectionUnprocessableEntity creates UpdateBranchProtectionUnprocessableEntity with default headers values
+func NewUpdateBranchProtectionUnprocessableEntity() *UpdateBranchProtectionUnprocessableEntity {
+
+	return &UpdateBranchProtectionUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the update branch protection unprocessable entity response
+func (o *UpdateBranchProtectionUnprocessableEntity) WithPayload(payload *models.ValidationError) *UpdateBranchProtectionUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update branch protection unprocessable entity response
+func (o *UpdateBranchProtectionUnprocessableEntity) SetPayload(payload *models.ValidationError) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateBranchProtectionUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdateBranchProtectionDefault unexpected error
+
+swagger:response updateBranchProtectionDefault
+*/
+type UpdateBranchProtectionDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateBranchProtectionDefault creates UpdateBranchProtectionDefault with default headers values
+func NewUpdateBranchProtectionDefault(code int) *UpdateBranchProtectionDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdateBranchProtectionDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update branch protection default response
+func (o *UpdateBranchProtectionDefault) WithStatusCode(code int) *UpdateBranchProtectionDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update branch protection default response
+func (o *UpdateBranchProtectionDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update branch protection default response
+func (o *UpdateBranchProtectionDefault) WithPayload(payload *models.Error) *UpdateBranchProtectionDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update branch protection default response
+func (o *UpdateBranchProtectionDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateBranchProtectionDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
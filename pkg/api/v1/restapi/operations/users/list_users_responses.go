@@ -21,6 +21,14 @@ const ListUsersOKCode int = 200
 swagger:response listUsersOK
 */
 type ListUsersOK struct {
+	/*The URL of the next page with rel="next", missing on the last page
+
+	 */
+	Link string `json:"Link"`
+	/*The cursor of the next page, missing on the last page
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
 
 	/*
 	  In: Body
@@ -34,6 +42,28 @@ func NewListUsersOK() *ListUsersOK {
 	return &ListUsersOK{}
 }
 
+// WithLink adds the link to the list users o k response
+func (o *ListUsersOK) WithLink(link string) *ListUsersOK {
+	o.Link = link
+	return o
+}
+
+// SetLink sets the link to the list users o k response
+func (o *ListUsersOK) SetLink(link string) {
+	o.Link = link
+}
+
+// WithXNextCursor adds the xNextCursor to the list users o k response
+func (o *ListUsersOK) WithXNextCursor(xNextCursor string) *ListUsersOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the list users o k response
+func (o *ListUsersOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
 // WithPayload adds the payload to the list users o k response
 func (o *ListUsersOK) WithPayload(payload []*models.User) *ListUsersOK {
 	o.Payload = payload
@@ -48,6 +78,20 @@ func (o *ListUsersOK) SetPayload(payload []*models.User) {
 // WriteResponse to the client
 func (o *ListUsersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header Link
+
+	link := o.Link
+	if link != "" {
+		rw.Header().Set("Link", link)
+	}
+
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -60,6 +104,50 @@ func (o *ListUsersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pro
 
 }
 
+// ListUsersUnprocessableEntityCode is the HTTP code returned for type ListUsersUnprocessableEntity
+const ListUsersUnprocessableEntityCode int = 422
+
+/*ListUsersUnprocessableEntity The cursor is not valid
+
+swagger:response listUsersUnprocessableEntity
+*/
+type ListUsersUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListUsersUnprocessableEntity creates ListUsersUnprocessableEntity with default headers values
+func NewListUsersUnprocessableEntity() *ListUsersUnprocessableEntity {
+
+	return &ListUsersUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the list users unprocessable entity response
+func (o *ListUsersUnprocessableEntity) WithPayload(payload *models.Error) *ListUsersUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list users unprocessable entity response
+func (o *ListUsersUnprocessableEntity) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUsersUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*ListUsersDefault unexpected error
 
 swagger:response listUsersDefault
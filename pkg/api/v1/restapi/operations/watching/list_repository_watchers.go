@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// ListRepositoryWatchersHandlerFunc turns a function with the right signature into a list repository watchers handler
+type ListRepositoryWatchersHandlerFunc func(ListRepositoryWatchersParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListRepositoryWatchersHandlerFunc) Handle(params ListRepositoryWatchersParams) middleware.Responder {
+	return fn(params)
+}
+
+// ListRepositoryWatchersHandler interface for that can handle valid list repository watchers params
+type ListRepositoryWatchersHandler interface {
+	Handle(ListRepositoryWatchersParams) middleware.Responder
+}
+
+// NewListRepositoryWatchers creates a new http.Handler for the list repository watchers operation
+func NewListRepositoryWatchers(ctx *middleware.Context, handler ListRepositoryWatchersHandler) *ListRepositoryWatchers {
+	return &ListRepositoryWatchers{Context: ctx, Handler: handler}
+}
+
+/*ListRepositoryWatchers swagger:route GET /repositories/{owner}/{name}/watchers watching listRepositoryWatchers
+
+Get the users watching a repository
+
+*/
+type ListRepositoryWatchers struct {
+	Context *middleware.Context
+	Handler ListRepositoryWatchersHandler
+}
+
+func (o *ListRepositoryWatchers) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewListRepositoryWatchersParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// ListRepositoryWatchersOKCode is the HTTP code returned for type ListRepositoryWatchersOK
+const ListRepositoryWatchersOKCode int = 200
+
+/*ListRepositoryWatchersOK The users watching the repository, in the order they started watching it
+
+swagger:response listRepositoryWatchersOK
+*/
+type ListRepositoryWatchersOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.User `json:"body,omitempty"`
+}
+
+// NewListRepositoryWatchersOK creates ListRepositoryWatchersOK with default headers values
+func NewListRepositoryWatchersOK() *ListRepositoryWatchersOK {
+
+	return &ListRepositoryWatchersOK{}
+}
+
+// WithPayload adds the payload to the list repository watchers o k response
+func (o *ListRepositoryWatchersOK) WithPayload(payload []*models.User) *ListRepositoryWatchersOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list repository watchers o k response
+func (o *ListRepositoryWatchersOK) SetPayload(payload []*models.User) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRepositoryWatchersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		payload = make([]*models.User, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+// ListRepositoryWatchersNotFoundCode is the HTTP code returned for type ListRepositoryWatchersNotFound
+const ListRepositoryWatchersNotFoundCode int = 404
+
+/*ListRepositoryWatchersNotFound The owner and name combination could not be found
+
+swagger:response listRepositoryWatchersNotFound
+*/
+type ListRepositoryWatchersNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListRepositoryWatchersNotFound creates ListRepositoryWatchersNotFound with default headers values
+func NewListRepositoryWatchersNotFound() *ListRepositoryWatchersNotFound {
+
+	return &ListRepositoryWatchersNotFound{}
+}
+
+// WithPayload adds the payload to the list repository watchers not found response
+func (o *ListRepositoryWatchersNotFound) WithPayload(payload *models.Error) *ListRepositoryWatchersNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list repository watchers not found response
+func (o *ListRepositoryWatchersNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRepositoryWatchersNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListRepositoryWatchersDefault unexpected error
+
+swagger:response listRepositoryWatchersDefault
+*/
+type ListRepositoryWatchersDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListRepositoryWatchersDefault creates ListRepositoryWatchersDefault with default headers values
+func NewListRepositoryWatchersDefault(code int) *ListRepositoryWatchersDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListRepositoryWatchersDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list repository watchers default response
+func (o *ListRepositoryWatchersDefault) WithStatusCode(code int) *ListRepositoryWatchersDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list repository watchers default response
+func (o *ListRepositoryWatchersDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list repository watchers default response
+func (o *ListRepositoryWatchersDefault) WithPayload(payload *models.Error) *ListRepositoryWatchersDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list repository watchers default response
+func (o *ListRepositoryWatchersDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRepositoryWatchersDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// UnwatchRepositoryHandlerFunc turns a function with the right signature into a unwatch repository handler
+type UnwatchRepositoryHandlerFunc func(UnwatchRepositoryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UnwatchRepositoryHandlerFunc) Handle(params UnwatchRepositoryParams) middleware.Responder {
+	return fn(params)
+}
+
+// UnwatchRepositoryHandler interface for that can handle valid unwatch repository params
+type UnwatchRepositoryHandler interface {
+	Handle(UnwatchRepositoryParams) middleware.Responder
+}
+
+// NewUnwatchRepository creates a new http.Handler for the unwatch repository operation
+func NewUnwatchRepository(ctx *middleware.Context, handler UnwatchRepositoryHandler) *UnwatchRepository {
+	return &UnwatchRepository{Context: ctx, Handler: handler}
+}
+
+/*UnwatchRepository swagger:route DELETE /repositories/{owner}/{name}/subscription watching unwatchRepository
+
+Stop watching a repository
+
+*/
+type UnwatchRepository struct {
+	Context *middleware.Context
+	Handler UnwatchRepositoryHandler
+}
+
+func (o *UnwatchRepository) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewUnwatchRepositoryParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
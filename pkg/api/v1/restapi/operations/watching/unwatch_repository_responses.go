@@ -0,0 +1,140 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// UnwatchRepositoryNoContentCode is the HTTP code returned for type UnwatchRepositoryNoContent
+const UnwatchRepositoryNoContentCode int = 204
+
+/*UnwatchRepositoryNoContent The current user doesn't watch the repository
+
+swagger:response unwatchRepositoryNoContent
+*/
+type UnwatchRepositoryNoContent struct {
+}
+
+// NewUnwatchRepositoryNoContent creates UnwatchRepositoryNoContent with default headers values
+func NewUnwatchRepositoryNoContent() *UnwatchRepositoryNoContent {
+
+	return &UnwatchRepositoryNoContent{}
+}
+
+// WriteResponse to the client
+func (o *UnwatchRepositoryNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// UnwatchRepositoryNotFoundCode is the HTTP code returned for type UnwatchRepositoryNotFound
+const UnwatchRepositoryNotFoundCode int = 404
+
+/*UnwatchRepositoryNotFound The owner and name combination could not be found
+
+swagger:response unwatchRepositoryNotFound
+*/
+type UnwatchRepositoryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUnwatchRepositoryNotFound creates UnwatchRepositoryNotFound with default headers values
+func NewUnwatchRepositoryNotFound() *UnwatchRepositoryNotFound {
+
+	return &UnwatchRepositoryNotFound{}
+}
+
+// WithPayload adds the payload to the unwatch repository not found response
+func (o *UnwatchRepositoryNotFound) WithPayload(payload *models.Error) *UnwatchRepositoryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the unwatch repository not found response
+func (o *UnwatchRepositoryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UnwatchRepositoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UnwatchRepositoryDefault unexpected error
+
+swagger:response unwatchRepositoryDefault
+*/
+type UnwatchRepositoryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUnwatchRepositoryDefault creates UnwatchRepositoryDefault with default headers values
+func NewUnwatchRepositoryDefault(code int) *UnwatchRepositoryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UnwatchRepositoryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the unwatch repository default response
+func (o *UnwatchRepositoryDefault) WithStatusCode(code int) *UnwatchRepositoryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the unwatch repository default response
+func (o *UnwatchRepositoryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the unwatch repository default response
+func (o *UnwatchRepositoryDefault) WithPayload(payload *models.Error) *UnwatchRepositoryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the unwatch repository default response
+func (o *UnwatchRepositoryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UnwatchRepositoryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// WatchRepositoryHandlerFunc turns a function with the right signature into a watch repository handler
+type WatchRepositoryHandlerFunc func(WatchRepositoryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn WatchRepositoryHandlerFunc) Handle(params WatchRepositoryParams) middleware.Responder {
+	return fn(params)
+}
+
+// WatchRepositoryHandler interface for that can handle valid watch repository params
+type WatchRepositoryHandler interface {
+	Handle(WatchRepositoryParams) middleware.Responder
+}
+
+// NewWatchRepository creates a new http.Handler for the watch repository operation
+func NewWatchRepository(ctx *middleware.Context, handler WatchRepositoryHandler) *WatchRepository {
+	return &WatchRepository{Context: ctx, Handler: handler}
+}
+
+/*WatchRepository swagger:route PUT /repositories/{owner}/{name}/subscription watching watchRepository
+
+Watch a repository to be notified about its activity
+
+*/
+type WatchRepository struct {
+	Context *middleware.Context
+	Handler WatchRepositoryHandler
+}
+
+func (o *WatchRepository) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewWatchRepositoryParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
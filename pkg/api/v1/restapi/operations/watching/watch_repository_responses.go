@@ -0,0 +1,140 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package watching
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	models "github.com/sourcepods/sourcepods/pkg/api/v1/models"
+)
+
+// WatchRepositoryNoContentCode is the HTTP code returned for type WatchRepositoryNoContent
+const WatchRepositoryNoContentCode int = 204
+
+/*WatchRepositoryNoContent The current user watches the repository
+
+swagger:response watchRepositoryNoContent
+*/
+type WatchRepositoryNoContent struct {
+}
+
+// NewWatchRepositoryNoContent creates WatchRepositoryNoContent with default headers values
+func NewWatchRepositoryNoContent() *WatchRepositoryNoContent {
+
+	return &WatchRepositoryNoContent{}
+}
+
+// WriteResponse to the client
+func (o *WatchRepositoryNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// WatchRepositoryNotFoundCode is the HTTP code returned for type WatchRepositoryNotFound
+const WatchRepositoryNotFoundCode int = 404
+
+/*WatchRepositoryNotFound The owner and name combination could not be found
+
+swagger:response watchRepositoryNotFound
+*/
+type WatchRepositoryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewWatchRepositoryNotFound creates WatchRepositoryNotFound with default headers values
+func NewWatchRepositoryNotFound() *WatchRepositoryNotFound {
+
+	return &WatchRepositoryNotFound{}
+}
+
+// WithPayload adds the payload to the watch repository not found response
+func (o *WatchRepositoryNotFound) WithPayload(payload *models.Error) *WatchRepositoryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the watch repository not found response
+func (o *WatchRepositoryNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *WatchRepositoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*WatchRepositoryDefault unexpected error
+
+swagger:response watchRepositoryDefault
+*/
+type WatchRepositoryDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewWatchRepositoryDefault creates WatchRepositoryDefault with default headers values
+func NewWatchRepositoryDefault(code int) *WatchRepositoryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &WatchRepositoryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the watch repository default response
+func (o *WatchRepositoryDefault) WithStatusCode(code int) *WatchRepositoryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the watch repository default response
+func (o *WatchRepositoryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the watch repository default response
+func (o *WatchRepositoryDefault) WithPayload(payload *models.Error) *WatchRepositoryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the watch repository default response
+func (o *WatchRepositoryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *WatchRepositoryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
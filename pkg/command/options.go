@@ -1,6 +1,9 @@
 package command
 
-import "io"
+import (
+	"io"
+	"os"
+)
 
 func StdinPipe(c *command) (err error) {
 	c.stdin, err = c.cmd.StdinPipe()
@@ -37,3 +40,13 @@ func StderrWriter(b io.Writer) Option {
 		return nil
 	}
 }
+
+func Env(env ...string) Option {
+	return func(c *command) error {
+		if c.cmd.Env == nil {
+			c.cmd.Env = os.Environ()
+		}
+		c.cmd.Env = append(c.cmd.Env, env...)
+		return nil
+	}
+}
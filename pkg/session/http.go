@@ -70,11 +70,46 @@ func Authorized(s Service) func(http.Handler) http.Handler {
 	}
 }
 
-// GetSessionUser from the http.Request
+// Optional adds the user information to the next handlers, if the request has a valid session.
+// Requests without a valid session are still passed on, but without any user.
+func Optional(s Service) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			span, ctx := opentracing.StartSpanFromContext(r.Context(), "session.Handler.Optional")
+			defer span.Finish()
+
+			cookie, err := r.Cookie(CookieName)
+			if err != nil || cookie.Value == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			session, err := s.Find(ctx, cookie.Value)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx = context.WithValue(ctx, CookieUserID, session.User.ID)
+			ctx = context.WithValue(ctx, CookieUserUsername, session.User.Username)
+			r = r.WithContext(ctx)
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// GetSessionUser from the http.Request, nil if there's no session.
 func GetSessionUser(ctx context.Context) *User {
+	id, ok := ctx.Value(CookieUserID).(string)
+	if !ok {
+		return nil
+	}
+	username, _ := ctx.Value(CookieUserUsername).(string)
+
 	return &User{
-		ID:       ctx.Value(CookieUserID).(string),
-		Username: ctx.Value(CookieUserUsername).(string),
+		ID:       id,
+		Username: username,
 	}
 }
 
@@ -110,3 +110,40 @@ func TestGetSessionUser(t *testing.T) {
 	assert.Equal(t, "id", user.ID)
 	assert.Equal(t, "username", user.Username)
 }
+
+func TestOptional(t *testing.T) {
+	s := &testService{}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Cookie", fmt.Sprintf("%s=%s", CookieName, uuid))
+
+	w := httptest.NewRecorder()
+	h := Optional(s)(http.HandlerFunc(testHandler))
+
+	h.ServeHTTP(w, req)
+
+	expected := `{"id":"ab2dfdfc-0603-4752-ad7f-0e57256feaa8","username":"foobar"}`
+	assert.Equal(t, http.StatusTeapot, w.Code)
+	assert.Equal(t, expected, w.Body.String())
+}
+
+func TestOptionalNoSession(t *testing.T) {
+	s := &testService{}
+
+	for _, cookie := range []string{"", fmt.Sprintf("%s=%s", CookieName, "foobar")} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if cookie != "" {
+			req.Header.Set("Cookie", cookie)
+		}
+
+		w := httptest.NewRecorder()
+		h := Optional(s)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Nil(t, GetSessionUser(r.Context()))
+			w.WriteHeader(http.StatusTeapot)
+		}))
+
+		h.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusTeapot, w.Code)
+	}
+}
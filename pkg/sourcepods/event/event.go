@@ -0,0 +1,67 @@
+package event
+
+import (
+	"time"
+
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+)
+
+// Types of events.
+const (
+	TypeRepositoryCreated = "repository.created"
+	TypeRepositoryDeleted = "repository.deleted"
+	TypeRepositoryRenamed = "repository.renamed"
+	TypePush              = "push"
+	TypeLoginSucceeded    = "login.succeeded"
+	TypeLoginFailed       = "login.failed"
+
+	TypeIssueOpened          = "issue.opened"
+	TypeIssueClosed          = "issue.closed"
+	TypeIssueCommented       = "issue.commented"
+	TypePullRequestOpened    = "pull_request.opened"
+	TypePullRequestClosed    = "pull_request.closed"
+	TypePullRequestMerged    = "pull_request.merged"
+	TypePullRequestCommented = "pull_request.commented"
+)
+
+// Event records who did what, events are never changed once recorded.
+type Event struct {
+	ID   string
+	Type string
+
+	// ActorID and Actor are empty if the actor isn't known, e.g. for failed logins.
+	ActorID string
+	Actor   string
+
+	// RepositoryID and Repository, as owner/name at the time, are empty for events without a repository.
+	RepositoryID string
+	Repository   string
+
+	// Public events are listed for everyone, others only for their actor,
+	// the users able to see their repository and admins.
+	Public bool
+
+	// Data holds details depending on the event's type.
+	Data map[string]interface{}
+
+	Created time.Time
+}
+
+// ListOptions filter and page events, most recent first.
+type ListOptions struct {
+	// ActorID only lists events of this actor.
+	ActorID string
+	// RepositoryID only lists events of this repository.
+	RepositoryID string
+	// Public only lists public events.
+	Public bool
+	Page   pagination.Options
+}
+
+// ExportOptions limit the exported events by when they were recorded.
+type ExportOptions struct {
+	// Since only exports events recorded at or after it, if not zero.
+	Since time.Time
+	// Until only exports events recorded before it, if not zero.
+	Until time.Time
+}
@@ -0,0 +1,107 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/google/jsonapi"
+	"github.com/opentracing/opentracing-go"
+)
+
+// exportedEvent is an event as a line of the export.
+type exportedEvent struct {
+	ID           string                 `json:"id"`
+	Type         string                 `json:"type"`
+	ActorID      string                 `json:"actor_id,omitempty"`
+	Actor        string                 `json:"actor,omitempty"`
+	RepositoryID string                 `json:"repository_id,omitempty"`
+	Repository   string                 `json:"repository,omitempty"`
+	Public       bool                   `json:"public"`
+	Data         map[string]interface{} `json:"data"`
+	Created      time.Time              `json:"created_at"`
+}
+
+// NewExportHandler returns a http router exporting all events as JSON lines, only for admins.
+// The since and until query parameters take RFC 3339 times to limit the exported events.
+func NewExportHandler(s Service) *chi.Mux {
+	r := chi.NewRouter()
+
+	r.Get("/", export(s))
+
+	return r
+}
+
+func export(s Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		span, ctx := opentracing.StartSpanFromContext(r.Context(), "event.Handler.export")
+		defer span.Finish()
+
+		var opts ExportOptions
+		for param, t := range map[string]*time.Time{"since": &opts.Since, "until": &opts.Until} {
+			v := r.URL.Query().Get(param)
+			if v == "" {
+				continue
+			}
+			parsed, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("%s is not a RFC 3339 time", param))
+				return
+			}
+			*t = parsed
+		}
+
+		// Headers are only written with the first event, errors before can still be responded properly.
+		written := false
+		header := func() {
+			if written {
+				return
+			}
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			w.Header().Set("Content-Disposition", `attachment; filename="events.jsonl"`)
+			w.WriteHeader(http.StatusOK)
+			written = true
+		}
+
+		enc := json.NewEncoder(w)
+		err := s.Export(ctx, opts, func(e *Event) error {
+			header()
+			return enc.Encode(exportedEvent{
+				ID:           e.ID,
+				Type:         e.Type,
+				ActorID:      e.ActorID,
+				Actor:        e.Actor,
+				RepositoryID: e.RepositoryID,
+				Repository:   e.Repository,
+				Public:       e.Public,
+				Data:         e.Data,
+				Created:      e.Created,
+			})
+		})
+		if err != nil {
+			if written {
+				return
+			}
+			if err == ErrPermissionDenied {
+				writeError(w, http.StatusForbidden, "only admins can export events")
+				return
+			}
+			writeError(w, http.StatusInternalServerError, "failed to export events")
+			return
+		}
+
+		header()
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, detail string) {
+	w.Header().Set("Content-Type", jsonapi.MediaType)
+	w.WriteHeader(status)
+	jsonapi.MarshalErrors(w, []*jsonapi.ErrorObject{{
+		Title:  http.StatusText(status),
+		Detail: detail,
+		Status: fmt.Sprintf("%d", status),
+	}})
+}
@@ -0,0 +1,158 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+)
+
+//LoggingRequestID returns the request ID as string for logging
+type LoggingRequestID func(context.Context) string
+
+type loggingService struct {
+	service   Service
+	requestID LoggingRequestID
+	logger    log.Logger
+}
+
+// NewLoggingService wraps the Service and provides logging for its methods.
+func NewLoggingService(s Service, requestID LoggingRequestID, logger log.Logger) Service {
+	return &loggingService{service: s, requestID: requestID, logger: logger}
+}
+
+func (s *loggingService) Record(ctx context.Context, e *Event) error {
+	start := time.Now()
+
+	err := s.service.Record(ctx, e)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Record",
+		"type", e.Type,
+		"actor", e.Actor,
+		"repository", e.Repository,
+		"duration", time.Since(start),
+	)
+
+	if err != nil {
+		level.Warn(logger).Log(
+			"msg", "failed to record event",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) UserFeed(ctx context.Context, username string, page pagination.Options) ([]*Event, string, error) {
+	start := time.Now()
+
+	events, next, err := s.service.UserFeed(ctx, username, page)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "UserFeed",
+		"username", username,
+		"cursor", page.Cursor,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list user's events",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return events, next, err
+}
+
+func (s *loggingService) RepositoryFeed(ctx context.Context, owner, name string, page pagination.Options) ([]*Event, string, error) {
+	start := time.Now()
+
+	events, next, err := s.service.RepositoryFeed(ctx, owner, name, page)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "RepositoryFeed",
+		"owner", owner,
+		"name", name,
+		"cursor", page.Cursor,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list repository's events",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return events, next, err
+}
+
+func (s *loggingService) Feed(ctx context.Context, page pagination.Options) ([]*Event, string, error) {
+	start := time.Now()
+
+	events, next, err := s.service.Feed(ctx, page)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Feed",
+		"cursor", page.Cursor,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list events",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return events, next, err
+}
+
+func (s *loggingService) Export(ctx context.Context, opts ExportOptions, fn func(*Event) error) error {
+	start := time.Now()
+
+	err := s.service.Export(ctx, opts, fn)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Export",
+		"since", opts.Since,
+		"until", opts.Until,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to export events",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func isUserError(err error) bool {
+	switch err {
+	case ErrRepositoryNotFound, ErrUserNotFound, ErrPermissionDenied, pagination.ErrCursorInvalid:
+		return true
+	}
+	return false
+}
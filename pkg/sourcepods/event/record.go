@@ -0,0 +1,369 @@
+package event
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/sourcepods/sourcepods/pkg/authorization"
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/issue"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pullrequest"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+)
+
+// The services wrapped to record events ignore errors of the Recorder,
+// recording an event must not fail what has already been done.
+// Wrap the Recorder with the logging service to know about them.
+
+type repositoryService struct {
+	repository.Service
+	events Recorder
+}
+
+// NewRepositoryService wraps the repository.Service and records the creation, renaming and deletion of repositories.
+func NewRepositoryService(s repository.Service, events Recorder) repository.Service {
+	return &repositoryService{Service: s, events: events}
+}
+
+func (s *repositoryService) Create(ctx context.Context, owner string, r *repository.Repository) (*repository.Repository, error) {
+	r, err := s.Service.Create(ctx, owner, r)
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.Record(ctx, repositoryEvent(ctx, TypeRepositoryCreated, owner, r, nil))
+
+	return r, nil
+}
+
+func (s *repositoryService) Fork(ctx context.Context, owner, name, forkName string) (*repository.Repository, error) {
+	r, err := s.Service.Fork(ctx, owner, name, forkName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Forks are created in the namespace of the session's user.
+	var forkOwner string
+	if u := session.GetSessionUser(ctx); u != nil {
+		forkOwner = u.Username
+	}
+	s.events.Record(ctx, repositoryEvent(ctx, TypeRepositoryCreated, forkOwner, r, map[string]interface{}{
+		"fork_of": owner + "/" + name,
+	}))
+
+	return r, nil
+}
+
+func (s *repositoryService) Delete(ctx context.Context, owner, name string) error {
+	// The repository is gone after deleting it, find it before.
+	r, _, err := s.Service.Find(ctx, owner, name)
+	if err != nil {
+		return s.Service.Delete(ctx, owner, name)
+	}
+
+	if err := s.Service.Delete(ctx, owner, name); err != nil {
+		return err
+	}
+
+	s.events.Record(ctx, repositoryEvent(ctx, TypeRepositoryDeleted, owner, r, nil))
+
+	return nil
+}
+
+func (s *repositoryService) Update(ctx context.Context, owner, name string, update repository.Update) (*repository.Repository, error) {
+	r, err := s.Service.Update(ctx, owner, name, update)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Name != name {
+		s.events.Record(ctx, repositoryEvent(ctx, TypeRepositoryRenamed, owner, r, map[string]interface{}{
+			"from": owner + "/" + name,
+		}))
+	}
+
+	return r, nil
+}
+
+func (s *repositoryService) Transfer(ctx context.Context, owner, name, newOwner string) (*repository.Repository, error) {
+	r, err := s.Service.Transfer(ctx, owner, name, newOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if newOwner != owner {
+		s.events.Record(ctx, repositoryEvent(ctx, TypeRepositoryRenamed, newOwner, r, map[string]interface{}{
+			"from": owner + "/" + name,
+		}))
+	}
+
+	return r, nil
+}
+
+// repositoryEvent is an event of the repository done by the session's user.
+func repositoryEvent(ctx context.Context, typ, owner string, r *repository.Repository, data map[string]interface{}) *Event {
+	e := &Event{
+		Type:         typ,
+		RepositoryID: r.ID,
+		Repository:   owner + "/" + r.Name,
+		Public:       !r.Private,
+		Data:         data,
+	}
+	if u := session.GetSessionUser(ctx); u != nil {
+		e.ActorID = u.ID
+		e.Actor = u.Username
+	}
+	return e
+}
+
+type issueService struct {
+	issue.Service
+	repositories Repositories
+	events       Recorder
+}
+
+// NewIssueService wraps the issue.Service and records opening, closing and commenting on issues.
+func NewIssueService(s issue.Service, repositories Repositories, events Recorder) issue.Service {
+	return &issueService{Service: s, repositories: repositories, events: events}
+}
+
+func (s *issueService) Create(ctx context.Context, owner, name string, i *issue.Issue) (*issue.Issue, error) {
+	i, err := s.Service.Create(ctx, owner, name, i)
+	if err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, TypeIssueOpened, owner, name, i, mentions(i.Body))
+
+	return i, nil
+}
+
+func (s *issueService) Update(ctx context.Context, owner, name string, number int, update issue.Update) (*issue.Issue, error) {
+	// Only closing an open issue is recorded, find out if it's open before.
+	before, err := s.Service.Find(ctx, owner, name, number)
+	if err != nil {
+		return s.Service.Update(ctx, owner, name, number, update)
+	}
+
+	i, err := s.Service.Update(ctx, owner, name, number, update)
+	if err != nil {
+		return nil, err
+	}
+
+	if before.State != issue.StateClosed && i.State == issue.StateClosed {
+		s.record(ctx, TypeIssueClosed, owner, name, i, nil)
+	}
+
+	return i, nil
+}
+
+func (s *issueService) CreateComment(ctx context.Context, owner, name string, number int, c *issue.Comment) (*issue.Comment, error) {
+	c, err := s.Service.CreateComment(ctx, owner, name, number, c)
+	if err != nil {
+		return nil, err
+	}
+
+	if i, err := s.Service.Find(ctx, owner, name, number); err == nil {
+		s.record(ctx, TypeIssueCommented, owner, name, i, mentions(c.Body))
+	}
+
+	return c, nil
+}
+
+func (s *issueService) record(ctx context.Context, typ, owner, name string, i *issue.Issue, mentioned []string) {
+	r, _, err := s.repositories.Find(ctx, owner, name)
+	if err != nil {
+		return
+	}
+
+	s.events.Record(ctx, repositoryEvent(ctx, typ, owner, r, map[string]interface{}{
+		"number":    i.Number,
+		"title":     i.Title,
+		"author_id": i.AuthorID,
+		"mentions":  mentioned,
+	}))
+}
+
+type pullRequestService struct {
+	pullrequest.Service
+	repositories Repositories
+	events       Recorder
+}
+
+// NewPullRequestService wraps the pullrequest.Service and records opening, closing, merging and commenting on pull requests.
+func NewPullRequestService(s pullrequest.Service, repositories Repositories, events Recorder) pullrequest.Service {
+	return &pullRequestService{Service: s, repositories: repositories, events: events}
+}
+
+func (s *pullRequestService) Create(ctx context.Context, owner, name string, pr *pullrequest.PullRequest) (*pullrequest.PullRequest, error) {
+	pr, err := s.Service.Create(ctx, owner, name, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, TypePullRequestOpened, owner, name, pr, mentions(pr.Body))
+
+	return pr, nil
+}
+
+func (s *pullRequestService) Update(ctx context.Context, owner, name string, number int, update pullrequest.Update) (*pullrequest.PullRequest, error) {
+	// Only closing an open pull request is recorded, find out if it's open before.
+	before, err := s.Service.Find(ctx, owner, name, number)
+	if err != nil {
+		return s.Service.Update(ctx, owner, name, number, update)
+	}
+
+	pr, err := s.Service.Update(ctx, owner, name, number, update)
+	if err != nil {
+		return nil, err
+	}
+
+	if before.State == pullrequest.StateOpen && pr.State == pullrequest.StateClosed {
+		s.record(ctx, TypePullRequestClosed, owner, name, pr, nil)
+	}
+
+	return pr, nil
+}
+
+func (s *pullRequestService) Merge(ctx context.Context, owner, name string, number int, m pullrequest.Merge) (*pullrequest.PullRequest, error) {
+	pr, err := s.Service.Merge(ctx, owner, name, number, m)
+	if err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, TypePullRequestMerged, owner, name, pr, nil)
+
+	return pr, nil
+}
+
+func (s *pullRequestService) CreateComment(ctx context.Context, owner, name string, number int, c *pullrequest.Comment) (*pullrequest.Comment, error) {
+	c, err := s.Service.CreateComment(ctx, owner, name, number, c)
+	if err != nil {
+		return nil, err
+	}
+
+	if pr, err := s.Service.Find(ctx, owner, name, number); err == nil {
+		s.record(ctx, TypePullRequestCommented, owner, name, pr, mentions(c.Body))
+	}
+
+	return c, nil
+}
+
+func (s *pullRequestService) record(ctx context.Context, typ, owner, name string, pr *pullrequest.PullRequest, mentioned []string) {
+	r, _, err := s.repositories.Find(ctx, owner, name)
+	if err != nil {
+		return
+	}
+
+	s.events.Record(ctx, repositoryEvent(ctx, typ, owner, r, map[string]interface{}{
+		"number":    pr.Number,
+		"title":     pr.Title,
+		"author_id": pr.AuthorID,
+		"mentions":  mentioned,
+	}))
+}
+
+// mentionPattern matches @username, but not the domain of email addresses.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@])@([a-zA-Z0-9]+)`)
+
+// mentions returns the usernames mentioned in the Markdown text, each only once.
+func mentions(text string) []string {
+	usernames := []string{}
+	seen := map[string]bool{}
+	for _, m := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		if seen[m[1]] {
+			continue
+		}
+		seen[m[1]] = true
+		usernames = append(usernames, m[1])
+	}
+	return usernames
+}
+
+type authorizationService struct {
+	authorization.Service
+	events Recorder
+}
+
+// NewAuthorizationService wraps the authorization.Service and records successful and failed logins.
+func NewAuthorizationService(s authorization.Service, events Recorder) authorization.Service {
+	return &authorizationService{Service: s, events: events}
+}
+
+func (s *authorizationService) AuthenticateUser(ctx context.Context, email, password string) (*user.User, error) {
+	u, err := s.Service.AuthenticateUser(ctx, email, password)
+	if err != nil {
+		s.events.Record(ctx, &Event{
+			Type: TypeLoginFailed,
+			Data: map[string]interface{}{"email": email},
+		})
+		return nil, err
+	}
+
+	s.events.Record(ctx, &Event{
+		Type:    TypeLoginSucceeded,
+		ActorID: u.ID,
+		Actor:   u.Username,
+	})
+
+	return u, nil
+}
+
+type (
+	// Pushes subscribes to the pushes to repositories, like the storage.Client.
+	Pushes interface {
+		Pushes(ctx context.Context, fn func(storage.PushEvent)) error
+	}
+
+	// OwnedRepositories finds repositories by their id regardless of the session's user.
+	OwnedRepositories interface {
+		FindByID(ctx context.Context, id string) (*repository.OwnedRepository, error)
+	}
+)
+
+// pushesRetry is how long to wait before subscribing to pushes again after the subscription broke.
+const pushesRetry = 5 * time.Second
+
+// RecordPushes records every push to a repository until ctx is cancelled.
+// Pushes aren't authenticated yet, so their actor isn't known.
+func RecordPushes(ctx context.Context, pushes Pushes, repositories OwnedRepositories, events Recorder, logger log.Logger) error {
+	record := func(p storage.PushEvent) {
+		o, err := repositories.FindByID(ctx, p.ID)
+		if err != nil {
+			level.Warn(logger).Log("msg", "failed to find pushed repository", "id", p.ID, "err", err)
+			return
+		}
+
+		refs := make([]map[string]string, 0, len(p.Refs))
+		for _, u := range p.Refs {
+			refs = append(refs, map[string]string{"ref": u.Ref, "old": u.Old, "new": u.New})
+		}
+
+		events.Record(ctx, &Event{
+			Type:         TypePush,
+			RepositoryID: o.Repository.ID,
+			Repository:   o.Owner + "/" + o.Repository.Name,
+			Public:       !o.Repository.Private,
+			Data:         map[string]interface{}{"refs": refs},
+		})
+	}
+
+	for {
+		err := pushes.Pushes(ctx, record)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		level.Warn(logger).Log("msg", "subscription to pushes broke, retrying", "err", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pushesRetry):
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package event
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+)
+
+var (
+	// ErrRepositoryNotFound returned if the repository of a feed is not found.
+	ErrRepositoryNotFound = errors.New("repository not found")
+
+	// ErrUserNotFound returned if the user of a feed is not found.
+	ErrUserNotFound = errors.New("user not found")
+
+	// ErrPermissionDenied returned if the user isn't an admin.
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+type (
+	// Store appends events to a log in some database and retrieves them.
+	Store interface {
+		Create(ctx context.Context, e *Event) error
+		List(ctx context.Context, opts ListOptions) ([]*Event, string, error)
+		Export(ctx context.Context, opts ExportOptions, fn func(*Event) error) error
+	}
+
+	// Repositories finds the repositories visible to the session's user.
+	Repositories interface {
+		Find(ctx context.Context, owner, name string) (*repository.Repository, string, error)
+	}
+
+	// Users finds the users whose events are listed.
+	Users interface {
+		FindByUsername(ctx context.Context, username string) (*user.User, error)
+	}
+
+	// Recorder records events.
+	Recorder interface {
+		Record(ctx context.Context, e *Event) error
+	}
+
+	// Service to record events and to list them as feeds.
+	Service interface {
+		Recorder
+		UserFeed(ctx context.Context, username string, page pagination.Options) ([]*Event, string, error)
+		RepositoryFeed(ctx context.Context, owner, name string, page pagination.Options) ([]*Event, string, error)
+		Feed(ctx context.Context, page pagination.Options) ([]*Event, string, error)
+		Export(ctx context.Context, opts ExportOptions, fn func(*Event) error) error
+	}
+
+	service struct {
+		events       Store
+		repositories Repositories
+		users        Users
+		admins       map[string]bool
+	}
+)
+
+// NewService to record and list events.
+// The users with the admins' usernames can list and export all events.
+func NewService(events Store, repositories Repositories, users Users, admins []string) Service {
+	s := &service{
+		events:       events,
+		repositories: repositories,
+		users:        users,
+		admins:       make(map[string]bool, len(admins)),
+	}
+	for _, username := range admins {
+		s.admins[username] = true
+	}
+	return s
+}
+
+// Record appends the event to the log.
+func (s *service) Record(ctx context.Context, e *Event) error {
+	if e.Data == nil {
+		e.Data = map[string]interface{}{}
+	}
+	return s.events.Create(ctx, e)
+}
+
+// UserFeed lists the events the user did, only public ones unless the session's user is them or an admin.
+func (s *service) UserFeed(ctx context.Context, username string, page pagination.Options) ([]*Event, string, error) {
+	u, err := s.users.FindByUsername(ctx, username)
+	if err == user.ErrNotFound {
+		return nil, "", ErrUserNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	viewer := session.GetSessionUser(ctx)
+	public := viewer == nil || (viewer.ID != u.ID && !s.admins[viewer.Username])
+
+	return s.events.List(ctx, ListOptions{ActorID: u.ID, Public: public, Page: page})
+}
+
+// RepositoryFeed lists the events of a repository visible to the session's user.
+func (s *service) RepositoryFeed(ctx context.Context, owner, name string, page pagination.Options) ([]*Event, string, error) {
+	r, _, err := s.repositories.Find(ctx, owner, name)
+	if err == repository.ErrRepositoryNotFound {
+		return nil, "", ErrRepositoryNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s.events.List(ctx, ListOptions{RepositoryID: r.ID, Page: page})
+}
+
+// Feed lists all events, only admins are allowed to.
+func (s *service) Feed(ctx context.Context, page pagination.Options) ([]*Event, string, error) {
+	if !s.isAdmin(ctx) {
+		return nil, "", ErrPermissionDenied
+	}
+
+	return s.events.List(ctx, ListOptions{Page: page})
+}
+
+// Export calls fn with all events in the order they were recorded, only admins are allowed to.
+func (s *service) Export(ctx context.Context, opts ExportOptions, fn func(*Event) error) error {
+	if !s.isAdmin(ctx) {
+		return ErrPermissionDenied
+	}
+
+	return s.events.Export(ctx, opts, fn)
+}
+
+func (s *service) isAdmin(ctx context.Context) bool {
+	u := session.GetSessionUser(ctx)
+	return u != nil && s.admins[u.Username]
+}
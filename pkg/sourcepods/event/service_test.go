@@ -0,0 +1,262 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/issue"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testStore struct {
+	events []*Event
+}
+
+func (s *testStore) Create(ctx context.Context, e *Event) error {
+	e.ID = fmt.Sprintf("event%d", len(s.events)+1)
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *testStore) List(ctx context.Context, opts ListOptions) ([]*Event, string, error) {
+	var events []*Event
+	for i := len(s.events) - 1; i >= 0; i-- {
+		e := s.events[i]
+		if opts.ActorID != "" && e.ActorID != opts.ActorID {
+			continue
+		}
+		if opts.RepositoryID != "" && e.RepositoryID != opts.RepositoryID {
+			continue
+		}
+		if opts.Public && !e.Public {
+			continue
+		}
+		events = append(events, e)
+	}
+	return events, "", nil
+}
+
+func (s *testStore) Export(ctx context.Context, opts ExportOptions, fn func(*Event) error) error {
+	for _, e := range s.events {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type testRepositories struct{}
+
+func (testRepositories) Find(ctx context.Context, owner, name string) (*repository.Repository, string, error) {
+	if owner != "foo" || name != "bar" {
+		return nil, "", repository.ErrRepositoryNotFound
+	}
+	return &repository.Repository{ID: "1", Name: name}, owner, nil
+}
+
+func (testRepositories) FindByID(ctx context.Context, id string) (*repository.OwnedRepository, error) {
+	if id != "1" {
+		return nil, repository.ErrRepositoryNotFound
+	}
+	return &repository.OwnedRepository{
+		Repository: &repository.Repository{ID: "1", Name: "bar"},
+		Owner:      "foo",
+	}, nil
+}
+
+type testUsers struct{}
+
+func (testUsers) FindByUsername(ctx context.Context, username string) (*user.User, error) {
+	if username != "foo" {
+		return nil, user.ErrNotFound
+	}
+	return &user.User{ID: "foo-id", Username: username}, nil
+}
+
+func withUser(username string) context.Context {
+	ctx := context.WithValue(context.Background(), session.CookieUserID, username+"-id")
+	return context.WithValue(ctx, session.CookieUserUsername, username)
+}
+
+func newTestService() (Service, *testStore) {
+	store := &testStore{}
+	return NewService(store, testRepositories{}, testUsers{}, []string{"admin"}), store
+}
+
+func TestServiceUserFeed(t *testing.T) {
+	s, _ := newTestService()
+	require.NoError(t, s.Record(context.Background(), &Event{Type: TypeRepositoryCreated, ActorID: "foo-id", Public: true}))
+	require.NoError(t, s.Record(context.Background(), &Event{Type: TypeRepositoryCreated, ActorID: "foo-id"}))
+	require.NoError(t, s.Record(context.Background(), &Event{Type: TypeRepositoryCreated, ActorID: "baz-id", Public: true}))
+
+	_, _, err := s.UserFeed(context.Background(), "baz", pagination.Options{})
+	assert.Equal(t, ErrUserNotFound, err)
+
+	events, _, err := s.UserFeed(context.Background(), "foo", pagination.Options{})
+	require.NoError(t, err)
+	assert.Len(t, events, 1, "others only see public events")
+
+	events, _, err = s.UserFeed(withUser("foo"), "foo", pagination.Options{})
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	events, _, err = s.UserFeed(withUser("admin"), "foo", pagination.Options{})
+	require.NoError(t, err)
+	assert.Len(t, events, 2)
+}
+
+func TestServiceRepositoryFeed(t *testing.T) {
+	s, _ := newTestService()
+	require.NoError(t, s.Record(context.Background(), &Event{Type: TypePush, RepositoryID: "1"}))
+	require.NoError(t, s.Record(context.Background(), &Event{Type: TypePush, RepositoryID: "2"}))
+
+	_, _, err := s.RepositoryFeed(withUser("foo"), "foo", "baz", pagination.Options{})
+	assert.Equal(t, ErrRepositoryNotFound, err)
+
+	events, _, err := s.RepositoryFeed(withUser("foo"), "foo", "bar", pagination.Options{})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, map[string]interface{}{}, events[0].Data)
+}
+
+func TestServiceFeedAndExport(t *testing.T) {
+	s, _ := newTestService()
+	require.NoError(t, s.Record(context.Background(), &Event{Type: TypeLoginFailed}))
+
+	_, _, err := s.Feed(withUser("foo"), pagination.Options{})
+	assert.Equal(t, ErrPermissionDenied, err)
+	assert.Equal(t, ErrPermissionDenied, s.Export(withUser("foo"), ExportOptions{}, nil))
+
+	events, _, err := s.Feed(withUser("admin"), pagination.Options{})
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+
+	var exported []*Event
+	err = s.Export(withUser("admin"), ExportOptions{}, func(e *Event) error {
+		exported = append(exported, e)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, events, exported)
+}
+
+type testRepositoryService struct {
+	repository.Service
+}
+
+func (testRepositoryService) Create(ctx context.Context, owner string, r *repository.Repository) (*repository.Repository, error) {
+	r.ID = "1"
+	return r, nil
+}
+
+func (testRepositoryService) Find(ctx context.Context, owner, name string) (*repository.Repository, string, error) {
+	return testRepositories{}.Find(ctx, owner, name)
+}
+
+func (testRepositoryService) Delete(ctx context.Context, owner, name string) error {
+	return nil
+}
+
+func TestRepositoryService(t *testing.T) {
+	s, store := newTestService()
+	rs := NewRepositoryService(testRepositoryService{}, s)
+
+	_, err := rs.Create(withUser("foo"), "foo", &repository.Repository{Name: "bar"})
+	require.NoError(t, err)
+	require.NoError(t, rs.Delete(withUser("foo"), "foo", "bar"))
+
+	require.Len(t, store.events, 2)
+	assert.Equal(t, TypeRepositoryCreated, store.events[0].Type)
+	assert.Equal(t, "foo-id", store.events[0].ActorID)
+	assert.Equal(t, "foo/bar", store.events[0].Repository)
+	assert.True(t, store.events[0].Public)
+	assert.Equal(t, TypeRepositoryDeleted, store.events[1].Type)
+	assert.Equal(t, "1", store.events[1].RepositoryID)
+}
+
+type testIssueService struct {
+	issue.Service
+	issue *issue.Issue
+}
+
+func (s *testIssueService) Create(ctx context.Context, owner, name string, i *issue.Issue) (*issue.Issue, error) {
+	i.Number = 1
+	i.AuthorID = "foo-id"
+	i.State = issue.StateOpen
+	s.issue = i
+	return i, nil
+}
+
+func (s *testIssueService) Find(ctx context.Context, owner, name string, number int) (*issue.Issue, error) {
+	i := *s.issue
+	return &i, nil
+}
+
+func (s *testIssueService) Update(ctx context.Context, owner, name string, number int, update issue.Update) (*issue.Issue, error) {
+	s.issue.State = *update.State
+	return s.issue, nil
+}
+
+func (s *testIssueService) CreateComment(ctx context.Context, owner, name string, number int, c *issue.Comment) (*issue.Comment, error) {
+	return c, nil
+}
+
+func TestIssueService(t *testing.T) {
+	s, store := newTestService()
+	is := NewIssueService(&testIssueService{}, testRepositories{}, s)
+
+	_, err := is.Create(withUser("foo"), "foo", "bar", &issue.Issue{Title: "Broken", Body: "@bar please look, mail foo@example.com"})
+	require.NoError(t, err)
+	_, err = is.CreateComment(withUser("bar"), "foo", "bar", 1, &issue.Comment{Body: "@baz and @baz, any idea?"})
+	require.NoError(t, err)
+
+	closed := issue.StateClosed
+	_, err = is.Update(withUser("foo"), "foo", "bar", 1, issue.Update{State: &closed})
+	require.NoError(t, err)
+	_, err = is.Update(withUser("foo"), "foo", "bar", 1, issue.Update{State: &closed})
+	require.NoError(t, err)
+
+	require.Len(t, store.events, 3, "closing a closed issue isn't recorded")
+	assert.Equal(t, TypeIssueOpened, store.events[0].Type)
+	assert.Equal(t, "foo/bar", store.events[0].Repository)
+	assert.Equal(t, []string{"bar"}, store.events[0].Data["mentions"])
+	assert.Equal(t, "Broken", store.events[0].Data["title"])
+	assert.Equal(t, TypeIssueCommented, store.events[1].Type)
+	assert.Equal(t, "bar-id", store.events[1].ActorID)
+	assert.Equal(t, "foo-id", store.events[1].Data["author_id"])
+	assert.Equal(t, []string{"baz"}, store.events[1].Data["mentions"])
+	assert.Equal(t, TypeIssueClosed, store.events[2].Type)
+}
+
+type testPushes struct {
+	cancel func()
+}
+
+func (p testPushes) Pushes(ctx context.Context, fn func(storage.PushEvent)) error {
+	fn(storage.PushEvent{ID: "1", Refs: []storage.RefUpdate{{Ref: "refs/heads/master", New: "a"}}})
+	fn(storage.PushEvent{ID: "2"})
+	p.cancel()
+	return errors.New("closed")
+}
+
+func TestRecordPushes(t *testing.T) {
+	s, store := newTestService()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := RecordPushes(ctx, testPushes{cancel: cancel}, testRepositories{}, s, log.NewNopLogger())
+	assert.Equal(t, context.Canceled, err)
+
+	require.Len(t, store.events, 1, "pushes to unknown repositories are not recorded")
+	assert.Equal(t, TypePush, store.events[0].Type)
+	assert.Equal(t, "foo/bar", store.events[0].Repository)
+	assert.Equal(t, []map[string]string{{"ref": "refs/heads/master", "old": "", "new": "a"}}, store.events[0].Data["refs"])
+}
@@ -0,0 +1,210 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+)
+
+// Postgres implementation of the Store.
+type Postgres struct {
+	db *sql.DB
+}
+
+// NewPostgresStore returns a Postgres implementation of the Store.
+func NewPostgresStore(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+const selectEvents = `
+SELECT
+	id,
+	type,
+	COALESCE(actor_id::TEXT, ''),
+	actor,
+	COALESCE(repository_id::TEXT, ''),
+	repository,
+	public,
+	data,
+	created_at
+FROM events
+`
+
+func scanEvent(row interface{ Scan(...interface{}) error }) (*Event, error) {
+	e := &Event{}
+	var data []byte
+	err := row.Scan(
+		&e.ID,
+		&e.Type,
+		&e.ActorID,
+		&e.Actor,
+		&e.RepositoryID,
+		&e.Repository,
+		&e.Public,
+		&data,
+		&e.Created,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &e.Data); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Create appends the event to the log.
+func (s *Postgres) Create(ctx context.Context, e *Event) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "event.Postgres.Create")
+	span.SetTag("type", e.Type)
+	defer span.Finish()
+
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return err
+	}
+
+	create := `
+INSERT INTO events (type, actor_id, actor, repository_id, repository, public, data)
+VALUES ($1, NULLIF($2, '')::UUID, $3, NULLIF($4, '')::UUID, $5, $6, $7)
+RETURNING id, created_at;
+`
+
+	row := s.db.QueryRowContext(ctx, create,
+		e.Type,
+		e.ActorID,
+		e.Actor,
+		e.RepositoryID,
+		e.Repository,
+		e.Public,
+		data,
+	)
+	return row.Scan(&e.ID, &e.Created)
+}
+
+// List a page of events, most recent first.
+// This func returns the events, the cursor of the next page if there's one and an error.
+func (s *Postgres) List(ctx context.Context, opts ListOptions) ([]*Event, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "event.Postgres.List")
+	span.SetTag("actor", opts.ActorID)
+	span.SetTag("repository", opts.RepositoryID)
+	span.SetTag("cursor", opts.Page.Cursor)
+	defer span.Finish()
+
+	var (
+		args  []interface{}
+		where []string
+	)
+	if opts.ActorID != "" {
+		args = append(args, opts.ActorID)
+		where = append(where, fmt.Sprintf("actor_id = $%d", len(args)))
+	}
+	if opts.RepositoryID != "" {
+		args = append(args, opts.RepositoryID)
+		where = append(where, fmt.Sprintf("repository_id = $%d", len(args)))
+	}
+	if opts.Public {
+		where = append(where, "public")
+	}
+
+	// The cursor holds the time and id of the last event.
+	if opts.Page.Cursor != "" {
+		keys, err := pagination.DecodeCursor(opts.Page.Cursor, 2)
+		if err != nil {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+		created, err := time.Parse(time.RFC3339Nano, keys[0])
+		if err != nil {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+		args = append(args, created, keys[1])
+		where = append(where, fmt.Sprintf("(created_at < $%d OR (created_at = $%d AND id < $%d))", len(args)-1, len(args)-1, len(args)))
+	}
+
+	query := selectEvents
+	if len(where) > 0 {
+		query += "WHERE " + strings.Join(where, " AND ") + "\n"
+	}
+
+	limit := opts.Page.Limit()
+	args = append(args, limit+1)
+	query += fmt.Sprintf("ORDER BY created_at DESC, id DESC\nLIMIT $%d;", len(args))
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var events []*Event
+	for rows.Next() {
+		e, err := scanEvent(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(events) > limit {
+		events = events[:limit]
+		last := events[limit-1]
+		next = pagination.EncodeCursor(last.Created.Format(time.RFC3339Nano), last.ID)
+	}
+
+	return events, next, nil
+}
+
+// Export calls fn with every event in the order they were recorded, without loading them all at once.
+func (s *Postgres) Export(ctx context.Context, opts ExportOptions, fn func(*Event) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "event.Postgres.Export")
+	span.SetTag("since", opts.Since)
+	span.SetTag("until", opts.Until)
+	defer span.Finish()
+
+	var (
+		args  []interface{}
+		where []string
+	)
+	if !opts.Since.IsZero() {
+		args = append(args, opts.Since)
+		where = append(where, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if !opts.Until.IsZero() {
+		args = append(args, opts.Until)
+		where = append(where, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+
+	query := selectEvents
+	if len(where) > 0 {
+		query += "WHERE " + strings.Join(where, " AND ") + "\n"
+	}
+	query += "ORDER BY created_at, id;"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		e, err := scanEvent(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,70 @@
+package event
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+)
+
+//TracingRequestID returns the request ID as string for tracing
+type TracingRequestID func(context.Context) string
+
+type tracingService struct {
+	service   Service
+	requestID TracingRequestID
+}
+
+// NewTracingService wraps the Service and provides tracing for its methods.
+func NewTracingService(s Service, requestID TracingRequestID) Service {
+	return &tracingService{service: s, requestID: requestID}
+}
+
+func (s *tracingService) Record(ctx context.Context, e *Event) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "event.Service.Record")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("type", e.Type)
+	defer span.Finish()
+
+	return s.service.Record(ctx, e)
+}
+
+func (s *tracingService) UserFeed(ctx context.Context, username string, page pagination.Options) ([]*Event, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "event.Service.UserFeed")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("username", username)
+	span.SetTag("cursor", page.Cursor)
+	defer span.Finish()
+
+	return s.service.UserFeed(ctx, username, page)
+}
+
+func (s *tracingService) RepositoryFeed(ctx context.Context, owner, name string, page pagination.Options) ([]*Event, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "event.Service.RepositoryFeed")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("cursor", page.Cursor)
+	defer span.Finish()
+
+	return s.service.RepositoryFeed(ctx, owner, name, page)
+}
+
+func (s *tracingService) Feed(ctx context.Context, page pagination.Options) ([]*Event, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "event.Service.Feed")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("cursor", page.Cursor)
+	defer span.Finish()
+
+	return s.service.Feed(ctx, page)
+}
+
+func (s *tracingService) Export(ctx context.Context, opts ExportOptions, fn func(*Event) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "event.Service.Export")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("since", opts.Since)
+	span.SetTag("until", opts.Until)
+	defer span.Finish()
+
+	return s.service.Export(ctx, opts, fn)
+}
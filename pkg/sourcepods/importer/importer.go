@@ -0,0 +1,41 @@
+package importer
+
+import "time"
+
+// States of import jobs.
+const (
+	StateQueued    = "queued"
+	StateRunning   = "running"
+	StateSucceeded = "succeeded"
+	StateFailed    = "failed"
+)
+
+// Job imports a repository from an external git URL in the background.
+type Job struct {
+	ID string
+	// RepositoryID is empty once the repository of a failed import is deleted.
+	RepositoryID string
+	Owner        string
+	Name         string
+	URL          string
+
+	State string
+	// Progress is the last line of progress git reported.
+	Progress string
+	// Error is why the import failed.
+	Error string
+
+	CreatorID string
+
+	Created  time.Time
+	Updated  time.Time
+	Finished time.Time
+}
+
+// Source is where a repository is imported from.
+// The credentials are only used for the import and not stored.
+type Source struct {
+	URL      string
+	Username string
+	Password string
+}
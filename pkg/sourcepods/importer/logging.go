@@ -0,0 +1,108 @@
+package importer
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+)
+
+//LoggingRequestID returns the request ID as string for logging
+type LoggingRequestID func(context.Context) string
+
+type loggingService struct {
+	service   Service
+	requestID LoggingRequestID
+	logger    log.Logger
+}
+
+// NewLoggingService wraps the Service and provides logging for its methods.
+func NewLoggingService(s Service, requestID LoggingRequestID, logger log.Logger) Service {
+	return &loggingService{service: s, requestID: requestID, logger: logger}
+}
+
+func (s *loggingService) Import(ctx context.Context, r *repository.Repository, source Source) (*Job, error) {
+	start := time.Now()
+
+	j, err := s.service.Import(ctx, r, source)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Import",
+		"name", r.Name,
+		"url", source.URL,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to import repository",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return j, err
+}
+
+func (s *loggingService) Find(ctx context.Context, id string) (*Job, error) {
+	start := time.Now()
+
+	j, err := s.service.Find(ctx, id)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Find",
+		"id", id,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to find import",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return j, err
+}
+
+func (s *loggingService) FailInterrupted(ctx context.Context) (int, error) {
+	start := time.Now()
+
+	failed, err := s.service.FailInterrupted(ctx)
+
+	logger := log.With(s.logger,
+		"method", "FailInterrupted",
+		"failed", failed,
+		"duration", time.Since(start),
+	)
+
+	if err != nil {
+		level.Warn(logger).Log(
+			"msg", "failed to fail interrupted imports",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return failed, err
+}
+
+func isUserError(err error) bool {
+	switch err.(type) {
+	case ValidationErrors, repository.ValidationErrors:
+		return true
+	}
+	switch err {
+	case ErrJobNotFound, ErrPermissionDenied, repository.ErrAlreadyExists:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,245 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// ErrJobNotFound returned if an import job is not found or not visible to the session's user.
+	ErrJobNotFound = errors.New("import not found")
+
+	// ErrPermissionDenied returned if there's no session user to import the repository for.
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+const (
+	// concurrentImports is how many imports run at once, the others are queued.
+	concurrentImports = 4
+	// progressInterval is how often the progress of running imports is stored.
+	progressInterval = 2 * time.Second
+)
+
+type (
+	// Store or retrieve import jobs from some database.
+	Store interface {
+		Create(ctx context.Context, j *Job) (*Job, error)
+		Find(ctx context.Context, id string) (*Job, error)
+		ListUnfinished(ctx context.Context) ([]*Job, error)
+		Update(ctx context.Context, j *Job) error
+	}
+
+	// Repositories creates the repositories imported into and deletes them if the import fails.
+	Repositories interface {
+		Create(ctx context.Context, owner string, r *repository.Repository) (*repository.Repository, error)
+		Delete(ctx context.Context, owner, name string) error
+	}
+
+	// Storage imports the repositories' git data.
+	Storage interface {
+		Import(ctx context.Context, id string, opts storage.ImportOptions, progress func(string)) error
+	}
+
+	// Limits apply to every import, zero values use the defaults of the storage.
+	Limits struct {
+		Timeout time.Duration
+		MaxSize int64
+	}
+
+	// Service to import repositories from external git URLs.
+	Service interface {
+		Import(ctx context.Context, r *repository.Repository, source Source) (*Job, error)
+		Find(ctx context.Context, id string) (*Job, error)
+		FailInterrupted(ctx context.Context) (int, error)
+	}
+
+	service struct {
+		jobs         Store
+		repositories Repositories
+		storage      Storage
+		limits       Limits
+		logger       log.Logger
+
+		running chan struct{}
+		wg      sync.WaitGroup
+	}
+)
+
+// NewService to import repositories from external git URLs.
+// Failures of the imports running in the background are logged to logger.
+func NewService(jobs Store, repositories Repositories, storage Storage, limits Limits, logger log.Logger) Service {
+	return &service{
+		jobs:         jobs,
+		repositories: repositories,
+		storage:      storage,
+		limits:       limits,
+		logger:       logger,
+		running:      make(chan struct{}, concurrentImports),
+	}
+}
+
+// Import creates the repository r for the session's user and imports source into it in the background.
+// The returned job reports the progress, the repository is deleted again if the import fails.
+func (s *service) Import(ctx context.Context, r *repository.Repository, source Source) (*Job, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return nil, ErrPermissionDenied
+	}
+
+	if err := ValidateSource(source); err != nil {
+		return nil, err
+	}
+
+	r, err := s.repositories.Create(ctx, u.Username, r)
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := s.jobs.Create(ctx, &Job{
+		RepositoryID: r.ID,
+		Owner:        u.Username,
+		Name:         r.Name,
+		URL:          source.URL,
+		State:        StateQueued,
+		CreatorID:    u.ID,
+	})
+	if err != nil {
+		s.repositories.Delete(ctx, u.Username, r.Name)
+		return nil, err
+	}
+
+	// The import outlives the request, it only keeps the session's user.
+	bg := context.WithValue(context.Background(), session.CookieUserID, u.ID)
+	bg = context.WithValue(bg, session.CookieUserUsername, u.Username)
+
+	job := *j
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.run(bg, &job, source)
+	}()
+
+	return j, nil
+}
+
+// run the job once fewer than concurrentImports are running and store its outcome.
+func (s *service) run(ctx context.Context, j *Job, source Source) {
+	logger := log.With(s.logger, "job", j.ID, "owner", j.Owner, "name", j.Name)
+
+	s.running <- struct{}{}
+	defer func() { <-s.running }()
+
+	j.State = StateRunning
+	if err := s.jobs.Update(ctx, j); err != nil {
+		level.Warn(logger).Log("msg", "failed to update import", "err", err)
+	}
+
+	var mu sync.Mutex
+	var stored time.Time
+	progress := func(line string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		j.Progress = line
+		if time.Since(stored) < progressInterval {
+			return
+		}
+		stored = time.Now()
+		if err := s.jobs.Update(ctx, j); err != nil {
+			level.Warn(logger).Log("msg", "failed to update import progress", "err", err)
+		}
+	}
+
+	err := s.storage.Import(ctx, j.RepositoryID, storage.ImportOptions{
+		URL:      source.URL,
+		Username: source.Username,
+		Password: source.Password,
+		Timeout:  s.limits.Timeout,
+		MaxSize:  s.limits.MaxSize,
+	}, progress)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	j.State = StateSucceeded
+	j.Finished = time.Now()
+	if err != nil {
+		level.Info(logger).Log("msg", "failed to import repository", "url", j.URL, "err", err)
+
+		j.State = StateFailed
+		j.Error = err.Error()
+		// Errors of the storage server's git are only the message of their status.
+		if st, ok := status.FromError(err); ok {
+			j.Error = st.Message()
+		}
+		if err := s.repositories.Delete(ctx, j.Owner, j.Name); err != nil {
+			level.Warn(logger).Log("msg", "failed to delete repository of failed import", "err", err)
+		} else {
+			j.RepositoryID = ""
+		}
+	}
+
+	if err := s.jobs.Update(ctx, j); err != nil {
+		level.Warn(logger).Log("msg", "failed to update import", "err", err)
+	}
+}
+
+// FailInterrupted fails the queued and running imports of a previous process and deletes their repositories.
+// Imports only run in the process that started them and the credentials of their source aren't stored,
+// so they can't be resumed. It has to be called before any imports are started, it returns how many failed.
+func (s *service) FailInterrupted(ctx context.Context) (int, error) {
+	jobs, err := s.jobs.ListUnfinished(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, j := range jobs {
+		// Only the creator is allowed to delete the repository.
+		ctx := context.WithValue(ctx, session.CookieUserID, j.CreatorID)
+		ctx = context.WithValue(ctx, session.CookieUserUsername, j.Owner)
+
+		j.State = StateFailed
+		j.Error = "import was interrupted"
+		j.Finished = time.Now()
+		if j.RepositoryID != "" {
+			err := s.repositories.Delete(ctx, j.Owner, j.Name)
+			if err != nil && err != repository.ErrRepositoryNotFound {
+				return 0, err
+			}
+			j.RepositoryID = ""
+		}
+
+		if err := s.jobs.Update(ctx, j); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(jobs), nil
+}
+
+// Find the import job, only the user who started it can see it.
+func (s *service) Find(ctx context.Context, id string) (*Job, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return nil, ErrJobNotFound
+	}
+
+	j, err := s.jobs.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if j.CreatorID != u.ID {
+		return nil, ErrJobNotFound
+	}
+
+	return j, nil
+}
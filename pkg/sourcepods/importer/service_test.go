@@ -0,0 +1,157 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testStore struct {
+	mu   sync.Mutex
+	jobs map[string]Job
+}
+
+func (s *testStore) Create(ctx context.Context, j *Job) (*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j.ID = fmt.Sprintf("import%d", len(s.jobs)+1)
+	s.jobs[j.ID] = *j
+	return j, nil
+}
+
+func (s *testStore) Find(ctx context.Context, id string) (*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j, ok := s.jobs[id]
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+	return &j, nil
+}
+
+func (s *testStore) ListUnfinished(ctx context.Context) ([]*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var jobs []*Job
+	for _, j := range s.jobs {
+		if j.State == StateQueued || j.State == StateRunning {
+			j := j
+			jobs = append(jobs, &j)
+		}
+	}
+	return jobs, nil
+}
+
+func (s *testStore) Update(ctx context.Context, j *Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[j.ID] = *j
+	return nil
+}
+
+type testRepositories struct {
+	deleted []string
+}
+
+func (r *testRepositories) Create(ctx context.Context, owner string, repo *repository.Repository) (*repository.Repository, error) {
+	if repo.Name == "" {
+		return nil, repository.ValidationErrors{}
+	}
+	repo.ID = repo.Name + "-id"
+	return repo, nil
+}
+
+func (r *testRepositories) Delete(ctx context.Context, owner, name string) error {
+	if session.GetSessionUser(ctx).Username != owner {
+		return repository.ErrPermissionDenied
+	}
+	r.deleted = append(r.deleted, owner+"/"+name)
+	return nil
+}
+
+type testStorage struct{}
+
+func (testStorage) Import(ctx context.Context, id string, opts storage.ImportOptions, progress func(string)) error {
+	progress("Receiving objects: 100% (3/3), done.")
+	if opts.URL == "https://example.com/large.git" {
+		return storage.ErrImportTooLarge
+	}
+	return nil
+}
+
+func withUser(username string) context.Context {
+	ctx := context.WithValue(context.Background(), session.CookieUserID, username+"-id")
+	return context.WithValue(ctx, session.CookieUserUsername, username)
+}
+
+func TestServiceImport(t *testing.T) {
+	store := &testStore{jobs: map[string]Job{}}
+	repositories := &testRepositories{}
+	s := NewService(store, repositories, testStorage{}, Limits{}, log.NewNopLogger())
+
+	_, err := s.Import(context.Background(), &repository.Repository{Name: "bar"}, Source{URL: "https://example.com/bar.git"})
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	_, err = s.Import(withUser("foo"), &repository.Repository{Name: "bar"}, Source{URL: "file:///etc"})
+	assert.IsType(t, ValidationErrors{}, err)
+
+	ok, err := s.Import(withUser("foo"), &repository.Repository{Name: "bar"}, Source{URL: "https://example.com/bar.git"})
+	require.NoError(t, err)
+	assert.Equal(t, StateQueued, ok.State)
+	assert.Equal(t, "bar-id", ok.RepositoryID)
+
+	failed, err := s.Import(withUser("foo"), &repository.Repository{Name: "large"}, Source{URL: "https://example.com/large.git"})
+	require.NoError(t, err)
+
+	s.(*service).wg.Wait()
+
+	j, err := s.Find(withUser("foo"), ok.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StateSucceeded, j.State)
+	assert.Equal(t, "Receiving objects: 100% (3/3), done.", j.Progress)
+	assert.Equal(t, "bar-id", j.RepositoryID)
+	assert.False(t, j.Finished.IsZero())
+
+	j, err = s.Find(withUser("foo"), failed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StateFailed, j.State)
+	assert.Equal(t, storage.ErrImportTooLarge.Error(), j.Error)
+	assert.Equal(t, "", j.RepositoryID)
+	assert.Equal(t, []string{"foo/large"}, repositories.deleted)
+
+	_, err = s.Find(withUser("baz"), ok.ID)
+	assert.Equal(t, ErrJobNotFound, err, "only the creator sees the import")
+}
+
+func TestServiceFailInterrupted(t *testing.T) {
+	store := &testStore{jobs: map[string]Job{
+		"import1": {ID: "import1", RepositoryID: "bar-id", Owner: "foo", Name: "bar", State: StateRunning, CreatorID: "foo-id"},
+		"import2": {ID: "import2", RepositoryID: "baz-id", Owner: "foo", Name: "baz", State: StateSucceeded, CreatorID: "foo-id"},
+	}}
+	repositories := &testRepositories{}
+	s := NewService(store, repositories, testStorage{}, Limits{}, log.NewNopLogger())
+
+	failed, err := s.FailInterrupted(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, failed)
+	assert.Equal(t, []string{"foo/bar"}, repositories.deleted)
+
+	j, err := s.Find(withUser("foo"), "import1")
+	require.NoError(t, err)
+	assert.Equal(t, StateFailed, j.State)
+	assert.Equal(t, "", j.RepositoryID)
+	assert.False(t, j.Finished.IsZero())
+
+	j, err = s.Find(withUser("foo"), "import2")
+	require.NoError(t, err)
+	assert.Equal(t, StateSucceeded, j.State, "finished imports are kept")
+	assert.Equal(t, "baz-id", j.RepositoryID)
+}
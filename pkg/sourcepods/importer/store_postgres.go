@@ -0,0 +1,169 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Postgres implementation of the Store.
+type Postgres struct {
+	db *sql.DB
+}
+
+// NewPostgresStore returns a Postgres implementation of the Store.
+func NewPostgresStore(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+// Create an import job, imports are always done into the creator's repositories.
+// This func returns the created job or an error.
+func (s *Postgres) Create(ctx context.Context, j *Job) (*Job, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "importer.Postgres.Create")
+	span.SetTag("repository", j.RepositoryID)
+	defer span.Finish()
+
+	create := `
+INSERT INTO imports (repository_id, name, url, state, creator_id)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, created_at, updated_at;
+`
+
+	row := s.db.QueryRowContext(ctx, create, j.RepositoryID, j.Name, j.URL, j.State, j.CreatorID)
+	if err := row.Scan(&j.ID, &j.Created, &j.Updated); err != nil {
+		return nil, err
+	}
+
+	return j, nil
+}
+
+// jobColumns are selected for jobs in the order scanJob expects them.
+const jobColumns = `
+	i.id,
+	COALESCE(i.repository_id::TEXT, ''),
+	u.username,
+	i.name,
+	i.url,
+	i.state,
+	i.progress,
+	i.error,
+	i.creator_id,
+	i.created_at,
+	i.updated_at,
+	i.finished_at
+FROM imports i
+JOIN users u ON u.id = i.creator_id`
+
+// Find the import job by its id.
+func (s *Postgres) Find(ctx context.Context, id string) (*Job, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "importer.Postgres.Find")
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	if !govalidator.IsUUID(id) {
+		return nil, ErrJobNotFound
+	}
+
+	find := "SELECT" + jobColumns + `
+WHERE i.id = $1;
+`
+
+	j, err := scanJob(s.db.QueryRowContext(ctx, find, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrJobNotFound
+	}
+	return j, err
+}
+
+// ListUnfinished returns the queued and running import jobs.
+func (s *Postgres) ListUnfinished(ctx context.Context) ([]*Job, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "importer.Postgres.ListUnfinished")
+	defer span.Finish()
+
+	list := "SELECT" + jobColumns + `
+WHERE i.state IN ($1, $2)
+ORDER BY i.created_at;
+`
+
+	rows, err := s.db.QueryContext(ctx, list, StateQueued, StateRunning)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []*Job
+	for rows.Next() {
+		j, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, j)
+	}
+
+	return jobs, rows.Err()
+}
+
+func scanJob(row interface{ Scan(...interface{}) error }) (*Job, error) {
+	var finished pq.NullTime
+	j := &Job{}
+	err := row.Scan(
+		&j.ID,
+		&j.RepositoryID,
+		&j.Owner,
+		&j.Name,
+		&j.URL,
+		&j.State,
+		&j.Progress,
+		&j.Error,
+		&j.CreatorID,
+		&j.Created,
+		&j.Updated,
+		&finished,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if finished.Valid {
+		j.Finished = finished.Time
+	}
+
+	return j, nil
+}
+
+// Update the state, progress and error of the import job.
+func (s *Postgres) Update(ctx context.Context, j *Job) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "importer.Postgres.Update")
+	span.SetTag("id", j.ID)
+	span.SetTag("state", j.State)
+	defer span.Finish()
+
+	var repositoryID *string
+	if j.RepositoryID != "" {
+		repositoryID = &j.RepositoryID
+	}
+	var finished *time.Time
+	if !j.Finished.IsZero() {
+		finished = &j.Finished
+	}
+
+	update := `
+UPDATE imports
+SET repository_id = $2, state = $3, progress = $4, error = $5, finished_at = $6, updated_at = now()
+WHERE id = $1
+RETURNING updated_at;
+`
+
+	row := s.db.QueryRowContext(ctx, update, j.ID, repositoryID, j.State, j.Progress, j.Error, finished)
+	if err := row.Scan(&j.Updated); err != nil {
+		if err == sql.ErrNoRows {
+			return ErrJobNotFound
+		}
+		return err
+	}
+
+	return nil
+}
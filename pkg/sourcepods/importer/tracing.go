@@ -0,0 +1,47 @@
+package importer
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+)
+
+//TracingRequestID returns the request ID as string for tracing
+type TracingRequestID func(context.Context) string
+
+type tracingService struct {
+	service   Service
+	requestID TracingRequestID
+}
+
+// NewTracingService wraps the Service and provides tracing for its methods.
+func NewTracingService(s Service, requestID TracingRequestID) Service {
+	return &tracingService{service: s, requestID: requestID}
+}
+
+func (s *tracingService) Import(ctx context.Context, r *repository.Repository, source Source) (*Job, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "importer.Service.Import")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("name", r.Name)
+	span.SetTag("url", source.URL)
+	defer span.Finish()
+
+	return s.service.Import(ctx, r, source)
+}
+
+func (s *tracingService) Find(ctx context.Context, id string) (*Job, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "importer.Service.Find")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	return s.service.Find(ctx, id)
+}
+
+func (s *tracingService) FailInterrupted(ctx context.Context) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "importer.Service.FailInterrupted")
+	defer span.Finish()
+
+	return s.service.FailInterrupted(ctx)
+}
@@ -0,0 +1,42 @@
+package importer
+
+import (
+	"fmt"
+	"net/url"
+)
+
+type (
+	//ValidationErrors are returned with a slice of all invalid fields
+	ValidationErrors struct {
+		Errors []ValidationError
+	}
+	//ValidationError knows for a given field the error
+	ValidationError struct {
+		Field string
+		Error error
+	}
+)
+
+func (e ValidationErrors) Error() string {
+	return fmt.Sprintf("there are %d validation errors", len(e.Errors))
+}
+
+// ValidateSource takes a Source and validates its URL,
+// only http, https and git URLs can be imported from.
+func ValidateSource(s Source) error {
+	var errs ValidationErrors
+
+	u, err := url.Parse(s.URL)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "git") {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "url",
+			Error: fmt.Errorf("url is not a http, https or git URL"),
+		})
+	}
+
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+
+	return nil
+}
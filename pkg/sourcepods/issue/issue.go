@@ -0,0 +1,101 @@
+package issue
+
+import (
+	"time"
+
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+)
+
+// States of issues and milestones.
+const (
+	StateOpen   = "open"
+	StateClosed = "closed"
+)
+
+// Issue tracks a bug, a task or an idea of a repository.
+// Issues are numbered together with the repository's pull requests.
+type Issue struct {
+	ID           string
+	RepositoryID string
+	Number       int
+	Title        string
+	// Body is written in Markdown.
+	Body  string
+	State string
+
+	AuthorID string
+	Author   string
+
+	Labels    []*Label
+	Assignees []*Assignee
+	// Milestone is nil if the issue isn't planned for one.
+	Milestone *Milestone
+
+	Created time.Time
+	Updated time.Time
+	// Closed is when the issue was closed, zero if it's open.
+	Closed time.Time
+}
+
+// Label categorizes issues of a repository.
+type Label struct {
+	ID   string
+	Name string
+	// Color is a hex color like #d73a4a.
+	Color       string
+	Description string
+}
+
+// Assignee is a user working on an issue.
+type Assignee struct {
+	ID       string
+	Username string
+}
+
+// Milestone groups the issues of a repository that are due together.
+type Milestone struct {
+	ID          string
+	Title       string
+	Description string
+	State       string
+	// Due is zero if the milestone has no due date.
+	Due time.Time
+
+	Created time.Time
+	Updated time.Time
+}
+
+// Comment on an issue.
+type Comment struct {
+	ID       string
+	Body     string
+	AuthorID string
+	Author   string
+
+	Created time.Time
+	Updated time.Time
+}
+
+// ListOptions filter and page a repository's issues.
+type ListOptions struct {
+	// State is StateOpen, StateClosed or empty for all issues.
+	State string
+	// Label only lists issues with the label of this name.
+	Label string
+	// Assignee only lists issues assigned to the user with this username.
+	Assignee string
+	Page     pagination.Options
+}
+
+// Update holds the changes to an issue, nil fields are left alone.
+type Update struct {
+	Title *string
+	Body  *string
+	// State is either StateOpen or StateClosed.
+	State *string
+	// Labels and Assignees replace all labels and assignees by their names.
+	Labels    *[]string
+	Assignees *[]string
+	// Milestone is the title of the milestone, empty removes the issue from its milestone.
+	Milestone *string
+}
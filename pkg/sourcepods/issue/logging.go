@@ -0,0 +1,322 @@
+package issue
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+)
+
+//LoggingRequestID returns the request ID as string for logging
+type LoggingRequestID func(context.Context) string
+
+type loggingService struct {
+	service   Service
+	requestID LoggingRequestID
+	logger    log.Logger
+}
+
+// NewLoggingService wraps the Service and provides logging for its methods.
+func NewLoggingService(s Service, requestID LoggingRequestID, logger log.Logger) Service {
+	return &loggingService{service: s, requestID: requestID, logger: logger}
+}
+
+func (s *loggingService) List(ctx context.Context, owner, name string, opts ListOptions) ([]*Issue, string, error) {
+	start := time.Now()
+
+	issues, next, err := s.service.List(ctx, owner, name, opts)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "List",
+		"owner", owner,
+		"name", name,
+		"state", opts.State,
+		"label", opts.Label,
+		"assignee", opts.Assignee,
+		"cursor", opts.Page.Cursor,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list issues",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return issues, next, err
+}
+
+func (s *loggingService) Find(ctx context.Context, owner, name string, number int) (*Issue, error) {
+	start := time.Now()
+
+	i, err := s.service.Find(ctx, owner, name, number)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Find",
+		"owner", owner,
+		"name", name,
+		"number", number,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to find issue",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return i, err
+}
+
+func (s *loggingService) Create(ctx context.Context, owner, name string, i *Issue) (*Issue, error) {
+	start := time.Now()
+
+	i, err := s.service.Create(ctx, owner, name, i)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Create",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to create issue",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return i, err
+}
+
+func (s *loggingService) Update(ctx context.Context, owner, name string, number int, update Update) (*Issue, error) {
+	start := time.Now()
+
+	i, err := s.service.Update(ctx, owner, name, number, update)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Update",
+		"owner", owner,
+		"name", name,
+		"number", number,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to update issue",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return i, err
+}
+
+func (s *loggingService) Comments(ctx context.Context, owner, name string, number int) ([]*Comment, error) {
+	start := time.Now()
+
+	comments, err := s.service.Comments(ctx, owner, name, number)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Comments",
+		"owner", owner,
+		"name", name,
+		"number", number,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list issue comments",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return comments, err
+}
+
+func (s *loggingService) CreateComment(ctx context.Context, owner, name string, number int, c *Comment) (*Comment, error) {
+	start := time.Now()
+
+	c, err := s.service.CreateComment(ctx, owner, name, number, c)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "CreateComment",
+		"owner", owner,
+		"name", name,
+		"number", number,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to create issue comment",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return c, err
+}
+
+func (s *loggingService) Labels(ctx context.Context, owner, name string) ([]*Label, error) {
+	start := time.Now()
+
+	labels, err := s.service.Labels(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Labels",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list labels",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return labels, err
+}
+
+func (s *loggingService) CreateLabel(ctx context.Context, owner, name string, l *Label) (*Label, error) {
+	start := time.Now()
+
+	l, err := s.service.CreateLabel(ctx, owner, name, l)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "CreateLabel",
+		"owner", owner,
+		"name", name,
+		"label", l.Name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to create label",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return l, err
+}
+
+func (s *loggingService) DeleteLabel(ctx context.Context, owner, name, label string) error {
+	start := time.Now()
+
+	err := s.service.DeleteLabel(ctx, owner, name, label)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "DeleteLabel",
+		"owner", owner,
+		"name", name,
+		"label", label,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to delete label",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) Milestones(ctx context.Context, owner, name string) ([]*Milestone, error) {
+	start := time.Now()
+
+	milestones, err := s.service.Milestones(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Milestones",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list milestones",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return milestones, err
+}
+
+func (s *loggingService) CreateMilestone(ctx context.Context, owner, name string, m *Milestone) (*Milestone, error) {
+	start := time.Now()
+
+	m, err := s.service.CreateMilestone(ctx, owner, name, m)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "CreateMilestone",
+		"owner", owner,
+		"name", name,
+		"title", m.Title,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to create milestone",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return m, err
+}
+
+func isUserError(err error) bool {
+	if _, ok := err.(ValidationErrors); ok {
+		return true
+	}
+	switch err {
+	case ErrRepositoryNotFound, ErrIssueNotFound, ErrLabelNotFound, ErrLabelExists, ErrMilestoneNotFound,
+		ErrMilestoneExists, ErrAssigneeNotFound, ErrStateInvalid, ErrPermissionDenied, pagination.ErrCursorInvalid:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,410 @@
+package issue
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+)
+
+var (
+	// ErrRepositoryNotFound returned if the repository of an issue is not found.
+	ErrRepositoryNotFound = errors.New("repository not found")
+
+	// ErrIssueNotFound returned if an issue is not found.
+	ErrIssueNotFound = errors.New("issue not found")
+
+	// ErrLabelNotFound returned if a label doesn't exist in the repository.
+	ErrLabelNotFound = errors.New("label not found")
+
+	// ErrLabelExists returned if a label with the same name already exists in the repository.
+	ErrLabelExists = errors.New("label already exists")
+
+	// ErrMilestoneNotFound returned if a milestone doesn't exist in the repository.
+	ErrMilestoneNotFound = errors.New("milestone not found")
+
+	// ErrMilestoneExists returned if a milestone with the same title already exists in the repository.
+	ErrMilestoneExists = errors.New("milestone already exists")
+
+	// ErrAssigneeNotFound returned if an assignee isn't a user.
+	ErrAssigneeNotFound = errors.New("assignee not found")
+
+	// ErrStateInvalid returned if an issue can't be changed to a state.
+	ErrStateInvalid = errors.New("state is not valid")
+
+	// ErrPermissionDenied returned if the user isn't allowed to change an issue.
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+type (
+	// Store or retrieve issues with their labels, milestones and comments from some database.
+	Store interface {
+		List(ctx context.Context, repositoryID string, opts ListOptions) ([]*Issue, string, error)
+		Find(ctx context.Context, repositoryID string, number int) (*Issue, error)
+		Create(ctx context.Context, i *Issue) (*Issue, error)
+		Update(ctx context.Context, i *Issue) (*Issue, error)
+		ListComments(ctx context.Context, issueID string) ([]*Comment, error)
+		CreateComment(ctx context.Context, issueID string, c *Comment) (*Comment, error)
+		ListLabels(ctx context.Context, repositoryID string) ([]*Label, error)
+		CreateLabel(ctx context.Context, repositoryID string, l *Label) (*Label, error)
+		DeleteLabel(ctx context.Context, repositoryID, name string) error
+		ListMilestones(ctx context.Context, repositoryID string) ([]*Milestone, error)
+		CreateMilestone(ctx context.Context, repositoryID string, m *Milestone) (*Milestone, error)
+	}
+
+	// Repositories finds the repositories visible to the session's user.
+	Repositories interface {
+		Find(ctx context.Context, owner, name string) (*repository.Repository, string, error)
+	}
+
+	// Users finds the assignees of issues.
+	Users interface {
+		FindByUsername(ctx context.Context, username string) (*user.User, error)
+	}
+
+	// Service to interact with issues.
+	Service interface {
+		List(ctx context.Context, owner, name string, opts ListOptions) ([]*Issue, string, error)
+		Find(ctx context.Context, owner, name string, number int) (*Issue, error)
+		Create(ctx context.Context, owner, name string, i *Issue) (*Issue, error)
+		Update(ctx context.Context, owner, name string, number int, update Update) (*Issue, error)
+		Comments(ctx context.Context, owner, name string, number int) ([]*Comment, error)
+		CreateComment(ctx context.Context, owner, name string, number int, c *Comment) (*Comment, error)
+		Labels(ctx context.Context, owner, name string) ([]*Label, error)
+		CreateLabel(ctx context.Context, owner, name string, l *Label) (*Label, error)
+		DeleteLabel(ctx context.Context, owner, name, label string) error
+		Milestones(ctx context.Context, owner, name string) ([]*Milestone, error)
+		CreateMilestone(ctx context.Context, owner, name string, m *Milestone) (*Milestone, error)
+	}
+
+	service struct {
+		issues       Store
+		repositories Repositories
+		users        Users
+	}
+)
+
+// NewService to interact with issues.
+func NewService(issues Store, repositories Repositories, users Users) Service {
+	return &service{
+		issues:       issues,
+		repositories: repositories,
+		users:        users,
+	}
+}
+
+// List a page of the repository's issues, most recent first.
+func (s *service) List(ctx context.Context, owner, name string, opts ListOptions) ([]*Issue, string, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s.issues.List(ctx, r.ID, opts)
+}
+
+// Find an issue by its number.
+func (s *service) Find(ctx context.Context, owner, name string, number int) (*Issue, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issues.Find(ctx, r.ID, number)
+}
+
+// Create an issue opened by the session's user.
+// Its labels, assignees and milestone are resolved in the repository by their names and title,
+// only the repository's owner is allowed to set them.
+func (s *service) Create(ctx context.Context, owner, name string, i *Issue) (*Issue, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return nil, ErrPermissionDenied
+	}
+	if u.Username != owner && (len(i.Labels) > 0 || len(i.Assignees) > 0 || i.Milestone != nil) {
+		return nil, ErrPermissionDenied
+	}
+
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	i.Title = strings.TrimSpace(i.Title)
+	if err := ValidateCreate(i); err != nil {
+		return nil, err
+	}
+
+	var labels, assignees []string
+	for _, l := range i.Labels {
+		labels = append(labels, l.Name)
+	}
+	for _, a := range i.Assignees {
+		assignees = append(assignees, a.Username)
+	}
+	var milestone string
+	if i.Milestone != nil {
+		milestone = i.Milestone.Title
+	}
+
+	i.RepositoryID = r.ID
+	i.State = StateOpen
+	i.AuthorID, i.Author = u.ID, u.Username
+	if i.Labels, err = s.resolveLabels(ctx, r.ID, labels); err != nil {
+		return nil, err
+	}
+	if i.Assignees, err = s.resolveAssignees(ctx, assignees); err != nil {
+		return nil, err
+	}
+	if i.Milestone, err = s.resolveMilestone(ctx, r.ID, milestone); err != nil {
+		return nil, err
+	}
+
+	return s.issues.Create(ctx, i)
+}
+
+// Update an issue. Its author and the repository's owner are allowed to change the title,
+// body and state. Only the repository's owner is allowed to change labels, assignees and milestone.
+func (s *service) Update(ctx context.Context, owner, name string, number int, update Update) (*Issue, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return nil, ErrPermissionDenied
+	}
+
+	i, err := s.Find(ctx, owner, name, number)
+	if err != nil {
+		return nil, err
+	}
+	if u.Username != owner && (u.ID != i.AuthorID || update.Labels != nil || update.Assignees != nil || update.Milestone != nil) {
+		return nil, ErrPermissionDenied
+	}
+
+	if update.Title != nil {
+		i.Title = strings.TrimSpace(*update.Title)
+	}
+	if update.Body != nil {
+		i.Body = *update.Body
+	}
+	if err := ValidateCreate(i); err != nil {
+		return nil, err
+	}
+
+	if update.State != nil && *update.State != i.State {
+		switch *update.State {
+		case StateOpen:
+			i.State = StateOpen
+			i.Closed = time.Time{}
+		case StateClosed:
+			i.State = StateClosed
+			i.Closed = time.Now()
+		default:
+			return nil, ErrStateInvalid
+		}
+	}
+
+	if update.Labels != nil {
+		if i.Labels, err = s.resolveLabels(ctx, i.RepositoryID, *update.Labels); err != nil {
+			return nil, err
+		}
+	}
+	if update.Assignees != nil {
+		if i.Assignees, err = s.resolveAssignees(ctx, *update.Assignees); err != nil {
+			return nil, err
+		}
+	}
+	if update.Milestone != nil {
+		if i.Milestone, err = s.resolveMilestone(ctx, i.RepositoryID, *update.Milestone); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.issues.Update(ctx, i)
+}
+
+// Comments returns all comments of an issue, oldest first.
+func (s *service) Comments(ctx context.Context, owner, name string, number int) ([]*Comment, error) {
+	i, err := s.Find(ctx, owner, name, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issues.ListComments(ctx, i.ID)
+}
+
+// CreateComment on an issue by the session's user.
+func (s *service) CreateComment(ctx context.Context, owner, name string, number int, c *Comment) (*Comment, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return nil, ErrPermissionDenied
+	}
+
+	if err := ValidateComment(c); err != nil {
+		return nil, err
+	}
+
+	i, err := s.Find(ctx, owner, name, number)
+	if err != nil {
+		return nil, err
+	}
+
+	c.AuthorID, c.Author = u.ID, u.Username
+	return s.issues.CreateComment(ctx, i.ID, c)
+}
+
+// Labels returns all labels of the repository by name.
+func (s *service) Labels(ctx context.Context, owner, name string) ([]*Label, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issues.ListLabels(ctx, r.ID)
+}
+
+// CreateLabel in the repository, only its owner is allowed to.
+func (s *service) CreateLabel(ctx context.Context, owner, name string, l *Label) (*Label, error) {
+	r, err := s.findOwnedRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	l.Name = strings.TrimSpace(l.Name)
+	l.Color = strings.ToLower(l.Color)
+	if err := ValidateLabel(l); err != nil {
+		return nil, err
+	}
+
+	return s.issues.CreateLabel(ctx, r.ID, l)
+}
+
+// DeleteLabel from the repository and all its issues, only its owner is allowed to.
+func (s *service) DeleteLabel(ctx context.Context, owner, name, label string) error {
+	r, err := s.findOwnedRepository(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+
+	return s.issues.DeleteLabel(ctx, r.ID, label)
+}
+
+// Milestones returns all milestones of the repository, the ones due first first.
+func (s *service) Milestones(ctx context.Context, owner, name string) ([]*Milestone, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issues.ListMilestones(ctx, r.ID)
+}
+
+// CreateMilestone in the repository, only its owner is allowed to.
+func (s *service) CreateMilestone(ctx context.Context, owner, name string, m *Milestone) (*Milestone, error) {
+	r, err := s.findOwnedRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Title = strings.TrimSpace(m.Title)
+	m.State = StateOpen
+	if err := ValidateMilestone(m); err != nil {
+		return nil, err
+	}
+
+	return s.issues.CreateMilestone(ctx, r.ID, m)
+}
+
+// resolveLabels returns the repository's labels with the names.
+func (s *service) resolveLabels(ctx context.Context, repositoryID string, names []string) ([]*Label, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	all, err := s.issues.ListLabels(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*Label, len(all))
+	for _, l := range all {
+		byName[l.Name] = l
+	}
+
+	var labels []*Label
+	seen := map[string]bool{}
+	for _, name := range names {
+		l, ok := byName[name]
+		if !ok {
+			return nil, ErrLabelNotFound
+		}
+		if !seen[name] {
+			seen[name] = true
+			labels = append(labels, l)
+		}
+	}
+
+	return labels, nil
+}
+
+// resolveAssignees returns the users with the usernames.
+func (s *service) resolveAssignees(ctx context.Context, usernames []string) ([]*Assignee, error) {
+	var assignees []*Assignee
+	seen := map[string]bool{}
+	for _, username := range usernames {
+		if seen[username] {
+			continue
+		}
+		seen[username] = true
+
+		u, err := s.users.FindByUsername(ctx, username)
+		if err == user.ErrNotFound {
+			return nil, ErrAssigneeNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		assignees = append(assignees, &Assignee{ID: u.ID, Username: u.Username})
+	}
+
+	return assignees, nil
+}
+
+// resolveMilestone returns the repository's milestone with the title, nil for an empty title.
+func (s *service) resolveMilestone(ctx context.Context, repositoryID, title string) (*Milestone, error) {
+	if title == "" {
+		return nil, nil
+	}
+
+	milestones, err := s.issues.ListMilestones(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range milestones {
+		if m.Title == title {
+			return m, nil
+		}
+	}
+
+	return nil, ErrMilestoneNotFound
+}
+
+func (s *service) findRepository(ctx context.Context, owner, name string) (*repository.Repository, error) {
+	r, _, err := s.repositories.Find(ctx, owner, name)
+	if err == repository.ErrRepositoryNotFound {
+		return nil, ErrRepositoryNotFound
+	}
+	return r, err
+}
+
+// findOwnedRepository finds the repository if the session's user owns it.
+func (s *service) findOwnedRepository(ctx context.Context, owner, name string) (*repository.Repository, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil || u.Username != owner {
+		return nil, ErrPermissionDenied
+	}
+
+	return s.findRepository(ctx, owner, name)
+}
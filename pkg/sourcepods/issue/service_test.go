@@ -0,0 +1,176 @@
+package issue
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testStore struct {
+	Store
+	issues     []*Issue
+	labels     []*Label
+	milestones []*Milestone
+	last       int
+}
+
+func (s *testStore) Find(ctx context.Context, repositoryID string, number int) (*Issue, error) {
+	for _, i := range s.issues {
+		if i.RepositoryID == repositoryID && i.Number == number {
+			return i, nil
+		}
+	}
+	return nil, ErrIssueNotFound
+}
+
+func (s *testStore) Create(ctx context.Context, i *Issue) (*Issue, error) {
+	s.last++
+	i.Number = s.last
+	i.ID = fmt.Sprintf("issue%d", s.last)
+	s.issues = append(s.issues, i)
+	return i, nil
+}
+
+func (s *testStore) Update(ctx context.Context, i *Issue) (*Issue, error) {
+	return i, nil
+}
+
+func (s *testStore) ListLabels(ctx context.Context, repositoryID string) ([]*Label, error) {
+	return s.labels, nil
+}
+
+func (s *testStore) CreateLabel(ctx context.Context, repositoryID string, l *Label) (*Label, error) {
+	for _, existing := range s.labels {
+		if existing.Name == l.Name {
+			return nil, ErrLabelExists
+		}
+	}
+	l.ID = "label-" + l.Name
+	s.labels = append(s.labels, l)
+	return l, nil
+}
+
+func (s *testStore) ListMilestones(ctx context.Context, repositoryID string) ([]*Milestone, error) {
+	return s.milestones, nil
+}
+
+func (s *testStore) CreateMilestone(ctx context.Context, repositoryID string, m *Milestone) (*Milestone, error) {
+	m.ID = "milestone-" + m.Title
+	s.milestones = append(s.milestones, m)
+	return m, nil
+}
+
+type testRepositories struct{}
+
+func (testRepositories) Find(ctx context.Context, owner, name string) (*repository.Repository, string, error) {
+	if owner != "foo" || name != "bar" {
+		return nil, "", repository.ErrRepositoryNotFound
+	}
+	return &repository.Repository{ID: "1", Name: name}, owner, nil
+}
+
+type testUsers struct{}
+
+func (testUsers) FindByUsername(ctx context.Context, username string) (*user.User, error) {
+	if username != "baz" {
+		return nil, user.ErrNotFound
+	}
+	return &user.User{ID: "baz-id", Username: username}, nil
+}
+
+func withUser(username string) context.Context {
+	ctx := context.WithValue(context.Background(), session.CookieUserID, username+"-id")
+	return context.WithValue(ctx, session.CookieUserUsername, username)
+}
+
+func TestServiceCreate(t *testing.T) {
+	store := &testStore{}
+	s := NewService(store, testRepositories{}, testUsers{})
+
+	_, err := s.CreateLabel(withUser("baz"), "foo", "bar", &Label{Name: "bug", Color: "#d73a4a"})
+	assert.Equal(t, ErrPermissionDenied, err)
+	_, err = s.CreateLabel(withUser("foo"), "foo", "bar", &Label{Name: "bug", Color: "red"})
+	assert.IsType(t, ValidationErrors{}, err)
+	l, err := s.CreateLabel(withUser("foo"), "foo", "bar", &Label{Name: " bug ", Color: "#D73A4A"})
+	require.NoError(t, err)
+	assert.Equal(t, "bug", l.Name)
+	assert.Equal(t, "#d73a4a", l.Color)
+	_, err = s.CreateMilestone(withUser("foo"), "foo", "bar", &Milestone{Title: "v1.0"})
+	require.NoError(t, err)
+
+	_, err = s.Create(context.Background(), "foo", "bar", &Issue{Title: "Crash"})
+	assert.Equal(t, ErrPermissionDenied, err)
+	_, err = s.Create(withUser("baz"), "foo", "bar", &Issue{Title: "Crash", Labels: []*Label{{Name: "bug"}}})
+	assert.Equal(t, ErrPermissionDenied, err)
+	_, err = s.Create(withUser("baz"), "foo", "unknown", &Issue{Title: "Crash"})
+	assert.Equal(t, ErrRepositoryNotFound, err)
+	_, err = s.Create(withUser("baz"), "foo", "bar", &Issue{Title: " "})
+	assert.IsType(t, ValidationErrors{}, err)
+
+	i, err := s.Create(withUser("baz"), "foo", "bar", &Issue{Title: " Crash ", Body: "It crashes."})
+	require.NoError(t, err)
+	assert.Equal(t, 1, i.Number)
+	assert.Equal(t, "Crash", i.Title)
+	assert.Equal(t, StateOpen, i.State)
+	assert.Equal(t, "baz", i.Author)
+
+	i, err = s.Create(withUser("foo"), "foo", "bar", &Issue{
+		Title:     "Crash again",
+		Labels:    []*Label{{Name: "bug"}, {Name: "bug"}},
+		Assignees: []*Assignee{{Username: "baz"}},
+		Milestone: &Milestone{Title: "v1.0"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, i.Number)
+	assert.Equal(t, []*Label{l}, i.Labels)
+	assert.Equal(t, []*Assignee{{ID: "baz-id", Username: "baz"}}, i.Assignees)
+	assert.Equal(t, "milestone-v1.0", i.Milestone.ID)
+
+	_, err = s.Create(withUser("foo"), "foo", "bar", &Issue{Title: "Crash", Labels: []*Label{{Name: "feature"}}})
+	assert.Equal(t, ErrLabelNotFound, err)
+	_, err = s.Create(withUser("foo"), "foo", "bar", &Issue{Title: "Crash", Assignees: []*Assignee{{Username: "qux"}}})
+	assert.Equal(t, ErrAssigneeNotFound, err)
+	_, err = s.Create(withUser("foo"), "foo", "bar", &Issue{Title: "Crash", Milestone: &Milestone{Title: "v2.0"}})
+	assert.Equal(t, ErrMilestoneNotFound, err)
+}
+
+func TestServiceUpdate(t *testing.T) {
+	store := &testStore{labels: []*Label{{ID: "label-bug", Name: "bug"}}}
+	s := NewService(store, testRepositories{}, testUsers{})
+
+	i, err := s.Create(withUser("baz"), "foo", "bar", &Issue{Title: "Crash"})
+	require.NoError(t, err)
+
+	closed := StateClosed
+	_, err = s.Update(withUser("qux"), "foo", "bar", i.Number, Update{State: &closed})
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	i, err = s.Update(withUser("baz"), "foo", "bar", i.Number, Update{State: &closed})
+	require.NoError(t, err)
+	assert.Equal(t, StateClosed, i.State)
+	assert.False(t, i.Closed.IsZero())
+
+	labels := []string{"bug"}
+	_, err = s.Update(withUser("baz"), "foo", "bar", i.Number, Update{Labels: &labels})
+	assert.Equal(t, ErrPermissionDenied, err, "only the owner triages issues")
+
+	open, assignees := StateOpen, []string{"baz"}
+	i, err = s.Update(withUser("foo"), "foo", "bar", i.Number, Update{State: &open, Labels: &labels, Assignees: &assignees})
+	require.NoError(t, err)
+	assert.Equal(t, StateOpen, i.State)
+	assert.True(t, i.Closed.IsZero())
+	assert.Len(t, i.Labels, 1)
+	assert.Len(t, i.Assignees, 1)
+
+	invalid := "merged"
+	_, err = s.Update(withUser("foo"), "foo", "bar", i.Number, Update{State: &invalid})
+	assert.Equal(t, ErrStateInvalid, err)
+	_, err = s.Update(withUser("foo"), "foo", "bar", 42, Update{State: &closed})
+	assert.Equal(t, ErrIssueNotFound, err)
+}
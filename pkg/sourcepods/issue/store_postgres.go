@@ -0,0 +1,580 @@
+package issue
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+)
+
+// Postgres implementation of the Store.
+type Postgres struct {
+	db *sql.DB
+}
+
+// NewPostgresStore returns a Postgres implementation of the Store.
+func NewPostgresStore(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+// selectIssues selects the columns scanned by scanIssue.
+const selectIssues = `
+SELECT
+	issues.id,
+	issues.repository_id,
+	issues.number,
+	issues.title,
+	issues.body,
+	issues.state,
+	issues.author_id,
+	authors.username,
+	milestones.id,
+	milestones.title,
+	milestones.description,
+	milestones.state,
+	milestones.due_on,
+	milestones.created_at,
+	milestones.updated_at,
+	issues.created_at,
+	issues.updated_at,
+	issues.closed_at
+FROM issues
+JOIN users authors ON authors.id = issues.author_id
+LEFT JOIN milestones ON milestones.id = issues.milestone_id`
+
+func scanIssue(row interface{ Scan(...interface{}) error }) (*Issue, error) {
+	var milestoneID, milestoneTitle, milestoneDescription, milestoneState sql.NullString
+	var due, milestoneCreated, milestoneUpdated, closed pq.NullTime
+	i := &Issue{}
+
+	if err := row.Scan(
+		&i.ID,
+		&i.RepositoryID,
+		&i.Number,
+		&i.Title,
+		&i.Body,
+		&i.State,
+		&i.AuthorID,
+		&i.Author,
+		&milestoneID,
+		&milestoneTitle,
+		&milestoneDescription,
+		&milestoneState,
+		&due,
+		&milestoneCreated,
+		&milestoneUpdated,
+		&i.Created,
+		&i.Updated,
+		&closed,
+	); err != nil {
+		return nil, err
+	}
+	if milestoneID.Valid {
+		i.Milestone = &Milestone{
+			ID:          milestoneID.String,
+			Title:       milestoneTitle.String,
+			Description: milestoneDescription.String,
+			State:       milestoneState.String,
+			Due:         due.Time,
+			Created:     milestoneCreated.Time,
+			Updated:     milestoneUpdated.Time,
+		}
+	}
+	i.Closed = closed.Time
+
+	return i, nil
+}
+
+// List retrieves a page of the repository's issues, the most recent first.
+// This func returns the issues, the cursor of the next page if there's one and an error.
+func (s *Postgres) List(ctx context.Context, repositoryID string, opts ListOptions) ([]*Issue, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Postgres.List")
+	span.SetTag("repository", repositoryID)
+	span.SetTag("state", opts.State)
+	span.SetTag("label", opts.Label)
+	span.SetTag("assignee", opts.Assignee)
+	span.SetTag("cursor", opts.Page.Cursor)
+	defer span.Finish()
+
+	args := []interface{}{repositoryID}
+	where := []string{"issues.repository_id = $1"}
+
+	if opts.State != "" {
+		args = append(args, opts.State)
+		where = append(where, fmt.Sprintf("issues.state = $%d", len(args)))
+	}
+	if opts.Label != "" {
+		args = append(args, opts.Label)
+		where = append(where, fmt.Sprintf(`EXISTS (
+	SELECT 1 FROM issue_labels JOIN labels ON labels.id = issue_labels.label_id
+	WHERE issue_labels.issue_id = issues.id AND labels.name = $%d
+)`, len(args)))
+	}
+	if opts.Assignee != "" {
+		args = append(args, opts.Assignee)
+		where = append(where, fmt.Sprintf(`EXISTS (
+	SELECT 1 FROM issue_assignees JOIN users ON users.id = issue_assignees.user_id
+	WHERE issue_assignees.issue_id = issues.id AND users.username = $%d
+)`, len(args)))
+	}
+
+	if opts.Page.Cursor != "" {
+		keys, err := pagination.DecodeCursor(opts.Page.Cursor, 4)
+		if err != nil || keys[0] != opts.State || keys[1] != opts.Label || keys[2] != opts.Assignee {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+		number, err := strconv.Atoi(keys[3])
+		if err != nil {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+		args = append(args, number)
+		where = append(where, fmt.Sprintf("issues.number < $%d", len(args)))
+	}
+
+	limit := opts.Page.Limit()
+	args = append(args, limit+1)
+
+	list := fmt.Sprintf(`%s
+WHERE %s
+ORDER BY issues.number DESC
+LIMIT $%d;
+`, selectIssues, strings.Join(where, " AND "), len(args))
+
+	rows, err := s.db.QueryContext(ctx, list, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var issues []*Issue
+	for rows.Next() {
+		i, err := scanIssue(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		issues = append(issues, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(issues) > limit {
+		issues = issues[:limit]
+		last := issues[limit-1]
+		next = pagination.EncodeCursor(opts.State, opts.Label, opts.Assignee, strconv.Itoa(last.Number))
+	}
+
+	if err := s.loadRelations(ctx, issues); err != nil {
+		return nil, "", err
+	}
+
+	return issues, next, nil
+}
+
+// loadRelations sets the labels and assignees of all issues.
+func (s *Postgres) loadRelations(ctx context.Context, issues []*Issue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(issues))
+	byID := make(map[string]*Issue, len(issues))
+	for _, i := range issues {
+		ids = append(ids, i.ID)
+		byID[i.ID] = i
+		i.Labels, i.Assignees = nil, nil
+	}
+
+	labels := `
+SELECT issue_labels.issue_id, labels.id, labels.name, labels.color, labels.description
+FROM issue_labels
+JOIN labels ON labels.id = issue_labels.label_id
+WHERE issue_labels.issue_id = ANY($1)
+ORDER BY labels.name;
+`
+
+	rows, err := s.db.QueryContext(ctx, labels, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var issueID string
+		l := &Label{}
+		if err := rows.Scan(&issueID, &l.ID, &l.Name, &l.Color, &l.Description); err != nil {
+			return err
+		}
+		byID[issueID].Labels = append(byID[issueID].Labels, l)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	assignees := `
+SELECT issue_assignees.issue_id, users.id, users.username
+FROM issue_assignees
+JOIN users ON users.id = issue_assignees.user_id
+WHERE issue_assignees.issue_id = ANY($1)
+ORDER BY users.username;
+`
+
+	rows, err = s.db.QueryContext(ctx, assignees, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var issueID string
+		a := &Assignee{}
+		if err := rows.Scan(&issueID, &a.ID, &a.Username); err != nil {
+			return err
+		}
+		byID[issueID].Assignees = append(byID[issueID].Assignees, a)
+	}
+
+	return rows.Err()
+}
+
+// Find an issue by the repository's id and its number.
+func (s *Postgres) Find(ctx context.Context, repositoryID string, number int) (*Issue, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Postgres.Find")
+	span.SetTag("repository", repositoryID)
+	span.SetTag("number", number)
+	defer span.Finish()
+
+	find := selectIssues + `
+WHERE issues.repository_id = $1 AND issues.number = $2;
+`
+
+	i, err := scanIssue(s.db.QueryRowContext(ctx, find, repositoryID, number))
+	if err == sql.ErrNoRows {
+		return nil, ErrIssueNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return i, s.loadRelations(ctx, []*Issue{i})
+}
+
+// Create an issue with the next number of its repository, shared with its pull requests.
+// This func returns the created issue or an error.
+func (s *Postgres) Create(ctx context.Context, i *Issue) (*Issue, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Postgres.Create")
+	span.SetTag("repository", i.RepositoryID)
+	defer span.Finish()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	nextNumber := `UPDATE repositories SET last_number = last_number + 1 WHERE id = $1 RETURNING last_number;`
+	if err := tx.QueryRowContext(ctx, nextNumber, i.RepositoryID).Scan(&i.Number); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrRepositoryNotFound
+		}
+		return nil, err
+	}
+
+	create := `
+INSERT INTO issues (repository_id, number, title, body, state, author_id, milestone_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, created_at, updated_at;
+`
+
+	row := tx.QueryRowContext(ctx, create,
+		i.RepositoryID,
+		i.Number,
+		i.Title,
+		i.Body,
+		i.State,
+		i.AuthorID,
+		milestoneID(i),
+	)
+	if err := row.Scan(&i.ID, &i.Created, &i.Updated); err != nil {
+		return nil, err
+	}
+
+	if err := insertRelations(ctx, tx, i); err != nil {
+		return nil, err
+	}
+
+	return i, tx.Commit()
+}
+
+// Update the title, body, state, milestone, labels and assignees of the issue.
+// This func returns the updated issue or an error.
+func (s *Postgres) Update(ctx context.Context, i *Issue) (*Issue, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Postgres.Update")
+	span.SetTag("id", i.ID)
+	defer span.Finish()
+
+	var closed *time.Time
+	if !i.Closed.IsZero() {
+		closed = &i.Closed
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	update := `
+UPDATE issues
+SET title = $2, body = $3, state = $4, milestone_id = $5, closed_at = $6, updated_at = now()
+WHERE id = $1
+RETURNING updated_at;
+`
+
+	row := tx.QueryRowContext(ctx, update, i.ID, i.Title, i.Body, i.State, milestoneID(i), closed)
+	if err := row.Scan(&i.Updated); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrIssueNotFound
+		}
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM issue_labels WHERE issue_id = $1;`, i.ID); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM issue_assignees WHERE issue_id = $1;`, i.ID); err != nil {
+		return nil, err
+	}
+	if err := insertRelations(ctx, tx, i); err != nil {
+		return nil, err
+	}
+
+	return i, tx.Commit()
+}
+
+func milestoneID(i *Issue) *string {
+	if i.Milestone == nil {
+		return nil
+	}
+	return &i.Milestone.ID
+}
+
+// insertRelations inserts the labels and assignees of the issue.
+func insertRelations(ctx context.Context, tx *sql.Tx, i *Issue) error {
+	for _, l := range i.Labels {
+		insert := `INSERT INTO issue_labels (issue_id, label_id) VALUES ($1, $2);`
+		if _, err := tx.ExecContext(ctx, insert, i.ID, l.ID); err != nil {
+			return err
+		}
+	}
+	for _, a := range i.Assignees {
+		insert := `INSERT INTO issue_assignees (issue_id, user_id) VALUES ($1, $2);`
+		if _, err := tx.ExecContext(ctx, insert, i.ID, a.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListComments of the issue, oldest first.
+func (s *Postgres) ListComments(ctx context.Context, issueID string) ([]*Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Postgres.ListComments")
+	span.SetTag("id", issueID)
+	defer span.Finish()
+
+	list := `
+SELECT
+	issue_comments.id,
+	issue_comments.body,
+	issue_comments.author_id,
+	users.username,
+	issue_comments.created_at,
+	issue_comments.updated_at
+FROM issue_comments
+JOIN users ON users.id = issue_comments.author_id
+WHERE issue_comments.issue_id = $1
+ORDER BY issue_comments.created_at, issue_comments.id;
+`
+
+	rows, err := s.db.QueryContext(ctx, list, issueID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var comments []*Comment
+	for rows.Next() {
+		c := &Comment{}
+		if err := rows.Scan(&c.ID, &c.Body, &c.AuthorID, &c.Author, &c.Created, &c.Updated); err != nil {
+			return nil, err
+		}
+		comments = append(comments, c)
+	}
+
+	return comments, rows.Err()
+}
+
+// CreateComment on the issue.
+// This func returns the created comment or an error.
+func (s *Postgres) CreateComment(ctx context.Context, issueID string, c *Comment) (*Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Postgres.CreateComment")
+	span.SetTag("id", issueID)
+	defer span.Finish()
+
+	create := `
+INSERT INTO issue_comments (issue_id, author_id, body)
+VALUES ($1, $2, $3)
+RETURNING id, created_at, updated_at;
+`
+
+	row := s.db.QueryRowContext(ctx, create, issueID, c.AuthorID, c.Body)
+	if err := row.Scan(&c.ID, &c.Created, &c.Updated); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// ListLabels of the repository by name.
+func (s *Postgres) ListLabels(ctx context.Context, repositoryID string) ([]*Label, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Postgres.ListLabels")
+	span.SetTag("repository", repositoryID)
+	defer span.Finish()
+
+	list := `
+SELECT id, name, color, description
+FROM labels
+WHERE repository_id = $1
+ORDER BY name;
+`
+
+	rows, err := s.db.QueryContext(ctx, list, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var labels []*Label
+	for rows.Next() {
+		l := &Label{}
+		if err := rows.Scan(&l.ID, &l.Name, &l.Color, &l.Description); err != nil {
+			return nil, err
+		}
+		labels = append(labels, l)
+	}
+
+	return labels, rows.Err()
+}
+
+// CreateLabel in the repository.
+// This func returns the created label or an error.
+func (s *Postgres) CreateLabel(ctx context.Context, repositoryID string, l *Label) (*Label, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Postgres.CreateLabel")
+	span.SetTag("repository", repositoryID)
+	span.SetTag("name", l.Name)
+	defer span.Finish()
+
+	create := `
+INSERT INTO labels (repository_id, name, color, description)
+VALUES ($1, $2, $3, $4)
+RETURNING id;
+`
+
+	row := s.db.QueryRowContext(ctx, create, repositoryID, l.Name, l.Color, l.Description)
+	if err := row.Scan(&l.ID); err != nil {
+		if err, ok := err.(*pq.Error); ok && err.Code == pq.ErrorCode("23505") {
+			return nil, ErrLabelExists
+		}
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// DeleteLabel with the name from the repository and all its issues.
+func (s *Postgres) DeleteLabel(ctx context.Context, repositoryID, name string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Postgres.DeleteLabel")
+	span.SetTag("repository", repositoryID)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	res, err := s.db.ExecContext(ctx, `DELETE FROM labels WHERE repository_id = $1 AND name = $2;`, repositoryID, name)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrLabelNotFound
+	}
+
+	return nil
+}
+
+// ListMilestones of the repository, the ones due first first and the ones without due date last.
+func (s *Postgres) ListMilestones(ctx context.Context, repositoryID string) ([]*Milestone, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Postgres.ListMilestones")
+	span.SetTag("repository", repositoryID)
+	defer span.Finish()
+
+	list := `
+SELECT id, title, description, state, due_on, created_at, updated_at
+FROM milestones
+WHERE repository_id = $1
+ORDER BY due_on IS NULL, due_on, title;
+`
+
+	rows, err := s.db.QueryContext(ctx, list, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var milestones []*Milestone
+	for rows.Next() {
+		var due pq.NullTime
+		m := &Milestone{}
+		if err := rows.Scan(&m.ID, &m.Title, &m.Description, &m.State, &due, &m.Created, &m.Updated); err != nil {
+			return nil, err
+		}
+		m.Due = due.Time
+		milestones = append(milestones, m)
+	}
+
+	return milestones, rows.Err()
+}
+
+// CreateMilestone in the repository.
+// This func returns the created milestone or an error.
+func (s *Postgres) CreateMilestone(ctx context.Context, repositoryID string, m *Milestone) (*Milestone, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Postgres.CreateMilestone")
+	span.SetTag("repository", repositoryID)
+	span.SetTag("title", m.Title)
+	defer span.Finish()
+
+	var due *time.Time
+	if !m.Due.IsZero() {
+		due = &m.Due
+	}
+
+	create := `
+INSERT INTO milestones (repository_id, title, description, state, due_on)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, created_at, updated_at;
+`
+
+	row := s.db.QueryRowContext(ctx, create, repositoryID, m.Title, m.Description, m.State, due)
+	if err := row.Scan(&m.ID, &m.Created, &m.Updated); err != nil {
+		if err, ok := err.(*pq.Error); ok && err.Code == pq.ErrorCode("23505") {
+			return nil, ErrMilestoneExists
+		}
+		return nil, err
+	}
+
+	return m, nil
+}
@@ -0,0 +1,141 @@
+package issue
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+//TracingRequestID returns the request ID as string for tracing
+type TracingRequestID func(context.Context) string
+
+type tracingService struct {
+	service   Service
+	requestID TracingRequestID
+}
+
+// NewTracingService wraps the Service and provides tracing for its methods.
+func NewTracingService(s Service, requestID TracingRequestID) Service {
+	return &tracingService{service: s, requestID: requestID}
+}
+
+func (s *tracingService) List(ctx context.Context, owner, name string, opts ListOptions) ([]*Issue, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Service.List")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("state", opts.State)
+	span.SetTag("label", opts.Label)
+	span.SetTag("assignee", opts.Assignee)
+	span.SetTag("cursor", opts.Page.Cursor)
+	defer span.Finish()
+
+	return s.service.List(ctx, owner, name, opts)
+}
+
+func (s *tracingService) Find(ctx context.Context, owner, name string, number int) (*Issue, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Service.Find")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("number", number)
+	defer span.Finish()
+
+	return s.service.Find(ctx, owner, name, number)
+}
+
+func (s *tracingService) Create(ctx context.Context, owner, name string, i *Issue) (*Issue, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Service.Create")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Create(ctx, owner, name, i)
+}
+
+func (s *tracingService) Update(ctx context.Context, owner, name string, number int, update Update) (*Issue, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Service.Update")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("number", number)
+	defer span.Finish()
+
+	return s.service.Update(ctx, owner, name, number, update)
+}
+
+func (s *tracingService) Comments(ctx context.Context, owner, name string, number int) ([]*Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Service.Comments")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("number", number)
+	defer span.Finish()
+
+	return s.service.Comments(ctx, owner, name, number)
+}
+
+func (s *tracingService) CreateComment(ctx context.Context, owner, name string, number int, c *Comment) (*Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Service.CreateComment")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("number", number)
+	defer span.Finish()
+
+	return s.service.CreateComment(ctx, owner, name, number, c)
+}
+
+func (s *tracingService) Labels(ctx context.Context, owner, name string) ([]*Label, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Service.Labels")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Labels(ctx, owner, name)
+}
+
+func (s *tracingService) CreateLabel(ctx context.Context, owner, name string, l *Label) (*Label, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Service.CreateLabel")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("label", l.Name)
+	defer span.Finish()
+
+	return s.service.CreateLabel(ctx, owner, name, l)
+}
+
+func (s *tracingService) DeleteLabel(ctx context.Context, owner, name, label string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Service.DeleteLabel")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("label", label)
+	defer span.Finish()
+
+	return s.service.DeleteLabel(ctx, owner, name, label)
+}
+
+func (s *tracingService) Milestones(ctx context.Context, owner, name string) ([]*Milestone, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Service.Milestones")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Milestones(ctx, owner, name)
+}
+
+func (s *tracingService) CreateMilestone(ctx context.Context, owner, name string, m *Milestone) (*Milestone, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "issue.Service.CreateMilestone")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("title", m.Title)
+	defer span.Finish()
+
+	return s.service.CreateMilestone(ctx, owner, name, m)
+}
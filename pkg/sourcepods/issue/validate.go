@@ -0,0 +1,160 @@
+package issue
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+type (
+	//ValidationErrors are returned with a slice of all invalid fields
+	ValidationErrors struct {
+		Errors []ValidationError
+	}
+	//ValidationError knows for a given field the error
+	ValidationError struct {
+		Field string
+		Error error
+	}
+)
+
+func (e ValidationErrors) Error() string {
+	return fmt.Sprintf("there are %d validation errors", len(e.Errors))
+}
+
+const (
+	titleMaxLength       = 256
+	bodyMaxLength        = 65536
+	labelMaxLength       = 50
+	descriptionMaxLength = 256
+)
+
+var colorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// ValidateCreate takes an Issue and validates its fields.
+func ValidateCreate(i *Issue) error {
+	var errs ValidationErrors
+
+	if err := validateTitle(i.Title); err != nil {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "title",
+			Error: err,
+		})
+	}
+
+	if err := validateBody(i.Body); err != nil {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "body",
+			Error: err,
+		})
+	}
+
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ValidateComment takes a Comment and validates its fields.
+func ValidateComment(c *Comment) error {
+	var errs ValidationErrors
+
+	if strings.TrimSpace(c.Body) == "" {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "body",
+			Error: fmt.Errorf("body is required"),
+		})
+	} else if err := validateBody(c.Body); err != nil {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "body",
+			Error: err,
+		})
+	}
+
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ValidateLabel takes a Label and validates its fields.
+func ValidateLabel(l *Label) error {
+	var errs ValidationErrors
+
+	if strings.TrimSpace(l.Name) == "" {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "name",
+			Error: fmt.Errorf("name is required"),
+		})
+	} else if utf8.RuneCountInString(l.Name) > labelMaxLength {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "name",
+			Error: fmt.Errorf("name is longer than %d characters", labelMaxLength),
+		})
+	}
+
+	if !colorRegexp.MatchString(l.Color) {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "color",
+			Error: fmt.Errorf("color is not a hex color like #d73a4a"),
+		})
+	}
+
+	if utf8.RuneCountInString(l.Description) > descriptionMaxLength {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "description",
+			Error: fmt.Errorf("description is longer than %d characters", descriptionMaxLength),
+		})
+	}
+
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ValidateMilestone takes a Milestone and validates its fields.
+func ValidateMilestone(m *Milestone) error {
+	var errs ValidationErrors
+
+	if err := validateTitle(m.Title); err != nil {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "title",
+			Error: err,
+		})
+	}
+
+	if err := validateBody(m.Description); err != nil {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "description",
+			Error: err,
+		})
+	}
+
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func validateTitle(title string) error {
+	if strings.TrimSpace(title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if utf8.RuneCountInString(title) > titleMaxLength {
+		return fmt.Errorf("title is longer than %d characters", titleMaxLength)
+	}
+	return nil
+}
+
+func validateBody(body string) error {
+	if utf8.RuneCountInString(body) > bodyMaxLength {
+		return fmt.Errorf("body is longer than %d characters", bodyMaxLength)
+	}
+	return nil
+}
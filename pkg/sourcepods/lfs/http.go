@@ -0,0 +1,534 @@
+package lfs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/opentracing/opentracing-go"
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+)
+
+// MediaType of the requests and responses of the batch and locking APIs.
+const MediaType = "application/vnd.git-lfs+json"
+
+// maxRequestSize limits the JSON requests, batches of a few thousand objects fit easily.
+const maxRequestSize = 1 << 20
+
+type ctxKey int
+
+// tokenOperation is the operation the request's token was signed for, if it has one.
+const tokenOperation ctxKey = iota
+
+type (
+	// Users finds users by their username, like the user.Service.
+	Users interface {
+		FindByUsername(ctx context.Context, username string) (*user.User, error)
+	}
+
+	// Authenticator authenticates users by their email and password, like the authorization.Service.
+	Authenticator interface {
+		AuthenticateUser(ctx context.Context, email, password string) (*user.User, error)
+	}
+)
+
+// Authenticate LFS requests of git clients, they don't have sessions but send either
+// a Bearer token from the batch API, or basic auth with the user's email and password.
+// Authenticated users are added to the request's context like sessions do, others are passed on without a user.
+// It needs to be used with the {owner} and {name} URL parameters, tokens only work for their repository.
+func Authenticate(tokens Tokens, users Users, authenticator Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			span, ctx := opentracing.StartSpanFromContext(r.Context(), "lfs.Handler.Authenticate")
+			defer span.Finish()
+
+			header := r.Header.Get("Authorization")
+			if header == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			var u *user.User
+			if strings.HasPrefix(header, "Bearer ") {
+				c, err := tokens.Verify(strings.TrimPrefix(header, "Bearer "))
+				if err != nil || c.Owner != chi.URLParam(r, "owner") || c.Name != chi.URLParam(r, "name") {
+					writeUnauthorized(w, "Your token is invalid or has expired")
+					return
+				}
+
+				u, err = users.FindByUsername(ctx, c.Username)
+				if err != nil {
+					writeUnauthorized(w, "Your token is invalid or has expired")
+					return
+				}
+				ctx = context.WithValue(ctx, tokenOperation, c.Operation)
+			} else {
+				email, password, ok := r.BasicAuth()
+				if !ok {
+					writeUnauthorized(w, "Authenticate with your email and password")
+					return
+				}
+
+				var err error
+				u, err = authenticator.AuthenticateUser(ctx, email, password)
+				if err != nil {
+					writeUnauthorized(w, "Incorrect email or password")
+					return
+				}
+			}
+
+			span.SetTag("user", u.Username)
+			ctx = context.WithValue(ctx, session.CookieUserID, u.ID)
+			ctx = context.WithValue(ctx, session.CookieUserUsername, u.Username)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// NewHandler returns a http router serving the Git LFS batch, basic transfer and locking APIs.
+// It needs to be mounted with the {owner} and {name} URL parameters, e.g.
+// /{owner}/{name}.git/info/lfs and serves /objects/batch and /locks from there.
+// tokens sign the tokens handed out with the actions of the batch API.
+func NewHandler(s Service, tokens Tokens) *chi.Mux {
+	r := chi.NewRouter()
+
+	r.Post("/objects/batch", batch(s, tokens))
+	r.Get("/objects/{oid}", download(s))
+	r.Put("/objects/{oid}", upload(s))
+	r.Post("/objects/{oid}/verify", verify(s))
+	r.Get("/locks", listLocks(s))
+	r.Post("/locks", createLock(s))
+	r.Post("/locks/verify", verifyLocks(s))
+	r.Post("/locks/{id}/unlock", unlock(s))
+
+	return r
+}
+
+type (
+	pointer struct {
+		OID  string `json:"oid"`
+		Size int64  `json:"size"`
+	}
+
+	batchRequest struct {
+		Operation string    `json:"operation"`
+		Transfers []string  `json:"transfers"`
+		Objects   []pointer `json:"objects"`
+		HashAlgo  string    `json:"hash_algo"`
+	}
+
+	batchResponse struct {
+		Transfer string        `json:"transfer"`
+		Objects  []batchObject `json:"objects"`
+		HashAlgo string        `json:"hash_algo"`
+	}
+
+	batchObject struct {
+		pointer
+		Authenticated bool              `json:"authenticated,omitempty"`
+		Actions       map[string]action `json:"actions,omitempty"`
+		Error         *objectError      `json:"error,omitempty"`
+	}
+
+	action struct {
+		Href      string            `json:"href"`
+		Header    map[string]string `json:"header,omitempty"`
+		ExpiresIn int               `json:"expires_in,omitempty"`
+	}
+
+	objectError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+)
+
+func batch(s Service, tokens Tokens) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		span, ctx := opentracing.StartSpanFromContext(r.Context(), "lfs.Handler.batch")
+		defer span.Finish()
+
+		owner := chi.URLParam(r, "owner")
+		name := chi.URLParam(r, "name")
+
+		var req batchRequest
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestSize)).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "The batch request is invalid")
+			return
+		}
+		if req.HashAlgo != "" && req.HashAlgo != "sha256" {
+			writeError(w, http.StatusUnprocessableEntity, "Only sha256 objects are supported")
+			return
+		}
+		if !transferAllowed(req.Transfers) {
+			writeError(w, http.StatusUnprocessableEntity, "Only the basic transfer is supported")
+			return
+		}
+		if req.Operation == OperationUpload && !tokenAllows(ctx, OperationUpload) {
+			writeError(w, http.StatusForbidden, "Your token only allows downloads")
+			return
+		}
+
+		span.SetTag("operation", req.Operation)
+		span.SetTag("objects", len(req.Objects))
+
+		pointers := make([]Pointer, 0, len(req.Objects))
+		for _, p := range req.Objects {
+			pointers = append(pointers, Pointer{OID: p.OID, Size: p.Size})
+		}
+
+		objects, err := s.Batch(ctx, owner, name, req.Operation, pointers)
+		if err != nil {
+			writeServiceError(w, r, err)
+			return
+		}
+
+		// Actions are authenticated with a token of their own, clients don't need to send their credentials again.
+		var header map[string]string
+		if u := session.GetSessionUser(ctx); u != nil {
+			token, err := tokens.Sign(Claims{Username: u.Username, Owner: owner, Name: name, Operation: req.Operation})
+			if err != nil {
+				writeError(w, http.StatusInternalServerError, "The batch request failed")
+				return
+			}
+			header = map[string]string{"Authorization": "Bearer " + token}
+		}
+
+		base := baseURL(r, "/objects/batch")
+		res := batchResponse{
+			Transfer: "basic",
+			Objects:  make([]batchObject, 0, len(objects)),
+			HashAlgo: "sha256",
+		}
+		for _, o := range objects {
+			bo := batchObject{pointer: pointer{OID: o.OID, Size: o.Size}}
+			if o.Error != nil {
+				bo.Error = &objectError{Code: errorStatus(ctx, o.Error), Message: o.Error.Error()}
+			}
+			if o.Action != "" {
+				href := base + "/objects/" + o.OID
+				bo.Authenticated = header != nil
+				bo.Actions = map[string]action{
+					o.Action: {Href: href, Header: header, ExpiresIn: int(TokenExpiry.Seconds())},
+				}
+				if o.Action == OperationUpload {
+					bo.Actions["verify"] = action{Href: href + "/verify", Header: header, ExpiresIn: int(TokenExpiry.Seconds())}
+				}
+			}
+			res.Objects = append(res.Objects, bo)
+		}
+
+		writeJSON(w, http.StatusOK, res)
+	}
+}
+
+// transferAllowed returns true if the client supports the basic transfer, which it does if it doesn't say.
+func transferAllowed(transfers []string) bool {
+	if len(transfers) == 0 {
+		return true
+	}
+	for _, t := range transfers {
+		if t == "basic" {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenAllows returns false if the request was authenticated with a token for another operation.
+func tokenAllows(ctx context.Context, operation string) bool {
+	op, ok := ctx.Value(tokenOperation).(string)
+	return !ok || op == operation
+}
+
+// baseURL of the LFS API of the repository, derived from the request's URL without suffix.
+func baseURL(r *http.Request, suffix string) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto == "http" || proto == "https" {
+		scheme = proto
+	}
+	return scheme + "://" + r.Host + strings.TrimSuffix(r.URL.Path, suffix)
+}
+
+func download(s Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		span, ctx := opentracing.StartSpanFromContext(r.Context(), "lfs.Handler.download")
+		defer span.Finish()
+
+		oid := chi.URLParam(r, "oid")
+		span.SetTag("oid", oid)
+
+		rc, size, err := s.Download(ctx, chi.URLParam(r, "owner"), chi.URLParam(r, "name"), oid)
+		if err != nil {
+			writeServiceError(w, r, err)
+			return
+		}
+		defer rc.Close()
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+		w.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+		w.WriteHeader(http.StatusOK)
+		io.Copy(w, rc)
+	}
+}
+
+func upload(s Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		span, ctx := opentracing.StartSpanFromContext(r.Context(), "lfs.Handler.upload")
+		defer span.Finish()
+
+		oid := chi.URLParam(r, "oid")
+		span.SetTag("oid", oid)
+		span.SetTag("size", r.ContentLength)
+
+		if !tokenAllows(ctx, OperationUpload) {
+			writeError(w, http.StatusForbidden, "Your token only allows downloads")
+			return
+		}
+		if r.ContentLength < 0 {
+			writeError(w, http.StatusLengthRequired, "The size of the object is required")
+			return
+		}
+
+		p := Pointer{OID: oid, Size: r.ContentLength}
+		if err := s.Upload(ctx, chi.URLParam(r, "owner"), chi.URLParam(r, "name"), p, r.Body); err != nil {
+			writeServiceError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func verify(s Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		span, ctx := opentracing.StartSpanFromContext(r.Context(), "lfs.Handler.verify")
+		defer span.Finish()
+
+		var p pointer
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestSize)).Decode(&p); err != nil || p.OID != chi.URLParam(r, "oid") {
+			writeError(w, http.StatusBadRequest, "The verify request is invalid")
+			return
+		}
+
+		span.SetTag("oid", p.OID)
+
+		if err := s.Verify(ctx, chi.URLParam(r, "owner"), chi.URLParam(r, "name"), Pointer{OID: p.OID, Size: p.Size}); err != nil {
+			writeServiceError(w, r, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+type (
+	lockOwner struct {
+		Name string `json:"name"`
+	}
+
+	jsonLock struct {
+		ID       string    `json:"id"`
+		Path     string    `json:"path"`
+		LockedAt time.Time `json:"locked_at"`
+		Owner    lockOwner `json:"owner"`
+	}
+)
+
+func convertLock(l *Lock) jsonLock {
+	return jsonLock{
+		ID:       l.ID,
+		Path:     l.Path,
+		LockedAt: l.Locked.UTC(),
+		Owner:    lockOwner{Name: l.Owner},
+	}
+}
+
+func convertLocks(locks []*Lock) []jsonLock {
+	converted := make([]jsonLock, 0, len(locks))
+	for _, l := range locks {
+		converted = append(converted, convertLock(l))
+	}
+	return converted
+}
+
+func listLocks(s Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		span, ctx := opentracing.StartSpanFromContext(r.Context(), "lfs.Handler.listLocks")
+		defer span.Finish()
+
+		opts := LockOptions{
+			ID:   r.URL.Query().Get("id"),
+			Path: r.URL.Query().Get("path"),
+		}
+
+		locks, err := s.Locks(ctx, chi.URLParam(r, "owner"), chi.URLParam(r, "name"), opts)
+		if err != nil {
+			writeServiceError(w, r, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, struct {
+			Locks []jsonLock `json:"locks"`
+		}{Locks: convertLocks(locks)})
+	}
+}
+
+func createLock(s Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		span, ctx := opentracing.StartSpanFromContext(r.Context(), "lfs.Handler.createLock")
+		defer span.Finish()
+
+		var req struct {
+			Path string `json:"path"`
+		}
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestSize)).Decode(&req); err != nil || req.Path == "" {
+			writeError(w, http.StatusBadRequest, "The lock request is invalid")
+			return
+		}
+		if !tokenAllows(ctx, OperationUpload) {
+			writeError(w, http.StatusForbidden, "Your token only allows downloads")
+			return
+		}
+
+		span.SetTag("path", req.Path)
+
+		l, err := s.Lock(ctx, chi.URLParam(r, "owner"), chi.URLParam(r, "name"), req.Path)
+		if err == ErrLockExists && l != nil {
+			writeJSON(w, http.StatusConflict, struct {
+				Lock    jsonLock `json:"lock"`
+				Message string   `json:"message"`
+			}{Lock: convertLock(l), Message: err.Error()})
+			return
+		}
+		if err != nil {
+			writeServiceError(w, r, err)
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, struct {
+			Lock jsonLock `json:"lock"`
+		}{Lock: convertLock(l)})
+	}
+}
+
+func verifyLocks(s Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		span, ctx := opentracing.StartSpanFromContext(r.Context(), "lfs.Handler.verifyLocks")
+		defer span.Finish()
+
+		if !tokenAllows(ctx, OperationUpload) {
+			writeError(w, http.StatusForbidden, "Your token only allows downloads")
+			return
+		}
+
+		ours, theirs, err := s.VerifyLocks(ctx, chi.URLParam(r, "owner"), chi.URLParam(r, "name"))
+		if err != nil {
+			writeServiceError(w, r, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, struct {
+			Ours   []jsonLock `json:"ours"`
+			Theirs []jsonLock `json:"theirs"`
+		}{Ours: convertLocks(ours), Theirs: convertLocks(theirs)})
+	}
+}
+
+func unlock(s Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		span, ctx := opentracing.StartSpanFromContext(r.Context(), "lfs.Handler.unlock")
+		defer span.Finish()
+
+		var req struct {
+			Force bool `json:"force"`
+		}
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestSize)).Decode(&req); err != nil && err != io.EOF {
+			writeError(w, http.StatusBadRequest, "The unlock request is invalid")
+			return
+		}
+		if !tokenAllows(ctx, OperationUpload) {
+			writeError(w, http.StatusForbidden, "Your token only allows downloads")
+			return
+		}
+
+		id := chi.URLParam(r, "id")
+		span.SetTag("id", id)
+		span.SetTag("force", req.Force)
+
+		l, err := s.Unlock(ctx, chi.URLParam(r, "owner"), chi.URLParam(r, "name"), id, req.Force)
+		if err != nil {
+			writeServiceError(w, r, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, struct {
+			Lock jsonLock `json:"lock"`
+		}{Lock: convertLock(l)})
+	}
+}
+
+// errorStatus returns the http status for errors of the Service.
+// Users that aren't authenticated get asked to, the repository might be private.
+func errorStatus(ctx context.Context, err error) int {
+	switch err {
+	case ErrRepositoryNotFound, ErrPermissionDenied:
+		if session.GetSessionUser(ctx) == nil {
+			return http.StatusUnauthorized
+		}
+		if err == ErrPermissionDenied {
+			return http.StatusForbidden
+		}
+		return http.StatusNotFound
+	case ErrObjectNotFound, ErrLockNotFound:
+		return http.StatusNotFound
+	case ErrObjectInvalid, ErrOperationInvalid:
+		return http.StatusUnprocessableEntity
+	case ErrLockOwned:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeServiceError(w http.ResponseWriter, r *http.Request, err error) {
+	status := errorStatus(r.Context(), err)
+	if status == http.StatusInternalServerError {
+		writeError(w, status, "The request failed")
+		return
+	}
+	writeError(w, status, err.Error())
+}
+
+func writeUnauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("LFS-Authenticate", `Basic realm="SourcePods"`)
+	writeJSON(w, http.StatusUnauthorized, struct {
+		Message string `json:"message"`
+	}{Message: message})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	if status == http.StatusUnauthorized {
+		writeUnauthorized(w, message)
+		return
+	}
+	writeJSON(w, status, struct {
+		Message string `json:"message"`
+	}{Message: message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", MediaType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
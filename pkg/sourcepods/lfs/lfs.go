@@ -0,0 +1,49 @@
+package lfs
+
+import (
+	"regexp"
+	"time"
+)
+
+// Operations of the batch API.
+const (
+	// OperationDownload fetches objects and needs read access to the repository.
+	OperationDownload = "download"
+	// OperationUpload stores objects and needs write access to the repository.
+	OperationUpload = "upload"
+)
+
+// oidPattern matches the sha256 object IDs of LFS.
+var oidPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// ValidOID returns true if the oid is a sha256 object ID.
+func ValidOID(oid string) bool {
+	return oidPattern.MatchString(oid)
+}
+
+// Pointer identifies an LFS object by the sha256 of its content and its size.
+type Pointer struct {
+	OID  string
+	Size int64
+}
+
+// BatchObject is the outcome of the batch API for one of the requested objects.
+type BatchObject struct {
+	Pointer
+	// Action is the operation the client needs to perform for the object,
+	// empty if there's nothing to do, like uploading an object that exists already.
+	Action string
+	// Error is why the object can't be transferred, like ErrObjectNotFound.
+	Error error
+}
+
+// Lock prevents others from pushing changes to a path of a repository.
+type Lock struct {
+	ID           string
+	RepositoryID string
+	Path         string
+	// Owner is the username of the user holding the lock.
+	Owner   string
+	OwnerID string
+	Locked  time.Time
+}
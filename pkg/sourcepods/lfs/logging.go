@@ -0,0 +1,244 @@
+package lfs
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+//LoggingRequestID returns the request ID as string for logging
+type LoggingRequestID func(context.Context) string
+
+type loggingService struct {
+	service   Service
+	requestID LoggingRequestID
+	logger    log.Logger
+}
+
+// NewLoggingService wraps the Service and provides logging for its methods.
+func NewLoggingService(s Service, requestID LoggingRequestID, logger log.Logger) Service {
+	return &loggingService{service: s, requestID: requestID, logger: logger}
+}
+
+func (s *loggingService) Batch(ctx context.Context, owner, name, operation string, objects []Pointer) ([]*BatchObject, error) {
+	start := time.Now()
+
+	objs, err := s.service.Batch(ctx, owner, name, operation, objects)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Batch",
+		"owner", owner,
+		"name", name,
+		"operation", operation,
+		"objects", len(objects),
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to batch objects",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return objs, err
+}
+
+func (s *loggingService) Download(ctx context.Context, owner, name, oid string) (io.ReadCloser, int64, error) {
+	start := time.Now()
+
+	rc, size, err := s.service.Download(ctx, owner, name, oid)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Download",
+		"owner", owner,
+		"name", name,
+		"oid", oid,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to download object",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return rc, size, err
+}
+
+func (s *loggingService) Upload(ctx context.Context, owner, name string, p Pointer, r io.Reader) error {
+	start := time.Now()
+
+	err := s.service.Upload(ctx, owner, name, p, r)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Upload",
+		"owner", owner,
+		"name", name,
+		"oid", p.OID,
+		"size", p.Size,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to upload object",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) Verify(ctx context.Context, owner, name string, p Pointer) error {
+	start := time.Now()
+
+	err := s.service.Verify(ctx, owner, name, p)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Verify",
+		"owner", owner,
+		"name", name,
+		"oid", p.OID,
+		"size", p.Size,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to verify object",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) Locks(ctx context.Context, owner, name string, opts LockOptions) ([]*Lock, error) {
+	start := time.Now()
+
+	locks, err := s.service.Locks(ctx, owner, name, opts)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Locks",
+		"owner", owner,
+		"name", name,
+		"path", opts.Path,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list locks",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return locks, err
+}
+
+func (s *loggingService) Lock(ctx context.Context, owner, name, path string) (*Lock, error) {
+	start := time.Now()
+
+	l, err := s.service.Lock(ctx, owner, name, path)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Lock",
+		"owner", owner,
+		"name", name,
+		"path", path,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to create lock",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return l, err
+}
+
+func (s *loggingService) Unlock(ctx context.Context, owner, name, id string, force bool) (*Lock, error) {
+	start := time.Now()
+
+	l, err := s.service.Unlock(ctx, owner, name, id, force)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Unlock",
+		"owner", owner,
+		"name", name,
+		"id", id,
+		"force", force,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to unlock lock",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return l, err
+}
+
+func (s *loggingService) VerifyLocks(ctx context.Context, owner, name string) ([]*Lock, []*Lock, error) {
+	start := time.Now()
+
+	ours, theirs, err := s.service.VerifyLocks(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "VerifyLocks",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to verify locks",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return ours, theirs, err
+}
+
+func isUserError(err error) bool {
+	switch err {
+	case ErrRepositoryNotFound, ErrPermissionDenied, ErrOperationInvalid, ErrObjectNotFound,
+		ErrObjectInvalid, ErrLockNotFound, ErrLockExists, ErrLockOwned:
+		return true
+	}
+	return false
+}
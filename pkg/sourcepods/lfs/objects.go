@@ -0,0 +1,114 @@
+package lfs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// ObjectStore stores the content of LFS objects by their oid.
+// The content is verified before it's stored, objects can't change once stored.
+type ObjectStore interface {
+	// Size returns the size of the object or ErrObjectNotFound.
+	Size(ctx context.Context, oid string) (int64, error)
+	// Get the content of the object or ErrObjectNotFound.
+	Get(ctx context.Context, oid string) (io.ReadCloser, error)
+	// Put the content read from r as the object, it returns ErrObjectInvalid
+	// if the content doesn't have the given size or its sha256 isn't the oid.
+	Put(ctx context.Context, oid string, size int64, r io.Reader) error
+}
+
+// LocalObjectStore stores objects as files in a directory on local disk.
+type LocalObjectStore struct {
+	root string
+}
+
+// NewLocalObjectStore returns a LocalObjectStore storing objects in root,
+// which is created if it doesn't exist yet.
+func NewLocalObjectStore(root string) (*LocalObjectStore, error) {
+	if err := os.MkdirAll(filepath.Join(root, "tmp"), 0750); err != nil {
+		return nil, err
+	}
+	return &LocalObjectStore{root: root}, nil
+}
+
+// path of the object, objects are spread over directories by the first bytes of their oid.
+func (s *LocalObjectStore) path(oid string) string {
+	return filepath.Join(s.root, oid[0:2], oid[2:4], oid)
+}
+
+// Size returns the size of the object or ErrObjectNotFound.
+func (s *LocalObjectStore) Size(ctx context.Context, oid string) (int64, error) {
+	if !ValidOID(oid) {
+		return 0, ErrObjectNotFound
+	}
+
+	fi, err := os.Stat(s.path(oid))
+	if os.IsNotExist(err) {
+		return 0, ErrObjectNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return fi.Size(), nil
+}
+
+// Get the content of the object or ErrObjectNotFound.
+func (s *LocalObjectStore) Get(ctx context.Context, oid string) (io.ReadCloser, error) {
+	if !ValidOID(oid) {
+		return nil, ErrObjectNotFound
+	}
+
+	f, err := os.Open(s.path(oid))
+	if os.IsNotExist(err) {
+		return nil, ErrObjectNotFound
+	}
+
+	return f, err
+}
+
+// Put the content read from r as the object once its size and sha256 are verified.
+// The content is written to a temporary file first, so partial uploads are never visible.
+func (s *LocalObjectStore) Put(ctx context.Context, oid string, size int64, r io.Reader) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "lfs.LocalObjectStore.Put")
+	span.SetTag("oid", oid)
+	span.SetTag("size", size)
+	defer span.Finish()
+
+	if !ValidOID(oid) {
+		return ErrObjectInvalid
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Join(s.root, "tmp"), oid)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	// Reading one more byte than expected tells content that's too large apart.
+	n, err := io.Copy(io.MultiWriter(tmp, hash), io.LimitReader(r, size+1))
+	if err != nil {
+		return err
+	}
+	if n != size || hex.EncodeToString(hash.Sum(nil)) != oid {
+		return ErrObjectInvalid
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path(oid)), 0750); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path(oid))
+}
@@ -0,0 +1,315 @@
+package lfs
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+)
+
+var (
+	// ErrRepositoryNotFound returned if the repository isn't found or visible to the user.
+	ErrRepositoryNotFound = errors.New("repository not found")
+
+	// ErrPermissionDenied returned if the user isn't allowed to write to the repository.
+	ErrPermissionDenied = errors.New("permission denied")
+
+	// ErrOperationInvalid returned if the batch API is asked for an unknown operation.
+	ErrOperationInvalid = errors.New("operation must be either download or upload")
+
+	// ErrObjectNotFound returned if an object isn't stored for the repository.
+	ErrObjectNotFound = errors.New("object not found")
+
+	// ErrObjectInvalid returned if an object's oid isn't a sha256 or doesn't match its content or size.
+	ErrObjectInvalid = errors.New("object doesn't match its oid or size")
+
+	// ErrLockNotFound returned if a lock isn't found for the repository.
+	ErrLockNotFound = errors.New("lock not found")
+
+	// ErrLockExists returned if a path is locked already.
+	ErrLockExists = errors.New("path is locked already")
+
+	// ErrLockOwned returned if a lock of someone else should be unlocked without forcing it.
+	ErrLockOwned = errors.New("lock is owned by another user")
+
+	// ErrTokenInvalid returned if a token isn't signed with the secret or has expired.
+	ErrTokenInvalid = errors.New("token is invalid")
+)
+
+type (
+	// Store or retrieve the objects and locks of repositories from some database.
+	Store interface {
+		// Objects returns the sizes of the objects stored for the repository by their oid,
+		// oids of objects that aren't stored for the repository are missing.
+		Objects(ctx context.Context, repositoryID string, oids []string) (map[string]int64, error)
+		// CreateObject stores the object for the repository, unless it's stored already.
+		CreateObject(ctx context.Context, repositoryID string, p Pointer) error
+		Locks(ctx context.Context, repositoryID string, opts LockOptions) ([]*Lock, error)
+		// CreateLock returns ErrLockExists if the path is locked already.
+		CreateLock(ctx context.Context, l *Lock) (*Lock, error)
+		DeleteLock(ctx context.Context, id string) error
+	}
+
+	// Repositories finds the repositories visible to the session's user.
+	Repositories interface {
+		Find(ctx context.Context, owner, name string) (*repository.Repository, string, error)
+	}
+
+	// LockOptions filter the locks of a repository.
+	LockOptions struct {
+		ID   string
+		Path string
+	}
+
+	// Service implements the Git LFS batch and locking APIs for repositories.
+	Service interface {
+		Batch(ctx context.Context, owner, name, operation string, objects []Pointer) ([]*BatchObject, error)
+		Download(ctx context.Context, owner, name, oid string) (io.ReadCloser, int64, error)
+		Upload(ctx context.Context, owner, name string, p Pointer, r io.Reader) error
+		Verify(ctx context.Context, owner, name string, p Pointer) error
+		Locks(ctx context.Context, owner, name string, opts LockOptions) ([]*Lock, error)
+		Lock(ctx context.Context, owner, name, path string) (*Lock, error)
+		Unlock(ctx context.Context, owner, name, id string, force bool) (*Lock, error)
+		VerifyLocks(ctx context.Context, owner, name string) (ours []*Lock, theirs []*Lock, err error)
+	}
+
+	service struct {
+		store        Store
+		objects      ObjectStore
+		repositories Repositories
+	}
+)
+
+// NewService returns a Service keeping track of the repositories' objects and locks in store
+// and storing the objects' content in objects.
+func NewService(store Store, objects ObjectStore, repositories Repositories) Service {
+	return &service{
+		store:        store,
+		objects:      objects,
+		repositories: repositories,
+	}
+}
+
+// findRepository finds the repository if it's visible to the session's user.
+// With write it needs to be the repository's owner, too.
+func (s *service) findRepository(ctx context.Context, owner, name string, write bool) (*repository.Repository, error) {
+	r, owner, err := s.repositories.Find(ctx, owner, name)
+	if err != nil {
+		if err == repository.ErrRepositoryNotFound {
+			return nil, ErrRepositoryNotFound
+		}
+		return nil, err
+	}
+
+	if write {
+		u := session.GetSessionUser(ctx)
+		if u == nil || u.Username != owner {
+			return nil, ErrPermissionDenied
+		}
+	}
+
+	return r, nil
+}
+
+// Batch returns what the client needs to do to transfer each of the objects.
+// Objects are downloaded only if they have been uploaded to the repository before,
+// even if another repository has the same content. They're uploaded unless they have been already.
+func (s *service) Batch(ctx context.Context, owner, name, operation string, objects []Pointer) ([]*BatchObject, error) {
+	if operation != OperationDownload && operation != OperationUpload {
+		return nil, ErrOperationInvalid
+	}
+
+	r, err := s.findRepository(ctx, owner, name, operation == OperationUpload)
+	if err != nil {
+		return nil, err
+	}
+
+	oids := make([]string, 0, len(objects))
+	for _, p := range objects {
+		oids = append(oids, p.OID)
+	}
+	stored, err := s.store.Objects(ctx, r.ID, oids)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := make([]*BatchObject, 0, len(objects))
+	for _, p := range objects {
+		o := &BatchObject{Pointer: p}
+		batch = append(batch, o)
+
+		if !ValidOID(p.OID) || p.Size < 0 {
+			o.Error = ErrObjectInvalid
+			continue
+		}
+
+		size, ok := stored[p.OID]
+		switch operation {
+		case OperationDownload:
+			if !ok {
+				o.Error = ErrObjectNotFound
+				continue
+			}
+			o.Size = size
+			o.Action = OperationDownload
+		case OperationUpload:
+			if ok && size == p.Size {
+				continue
+			}
+			o.Action = OperationUpload
+		}
+	}
+
+	return batch, nil
+}
+
+// Download the content of an object uploaded to the repository, the caller needs to close it.
+func (s *service) Download(ctx context.Context, owner, name, oid string) (io.ReadCloser, int64, error) {
+	r, err := s.findRepository(ctx, owner, name, false)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	stored, err := s.store.Objects(ctx, r.ID, []string{oid})
+	if err != nil {
+		return nil, 0, err
+	}
+	size, ok := stored[oid]
+	if !ok {
+		return nil, 0, ErrObjectNotFound
+	}
+
+	rc, err := s.objects.Get(ctx, oid)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return rc, size, nil
+}
+
+// Upload the content of an object to the repository, it's verified against the pointer before it's stored.
+func (s *service) Upload(ctx context.Context, owner, name string, p Pointer, rd io.Reader) error {
+	r, err := s.findRepository(ctx, owner, name, true)
+	if err != nil {
+		return err
+	}
+
+	if err := s.objects.Put(ctx, p.OID, p.Size, rd); err != nil {
+		return err
+	}
+
+	return s.store.CreateObject(ctx, r.ID, p)
+}
+
+// Verify that the object has been uploaded to the repository with the pointer's size.
+func (s *service) Verify(ctx context.Context, owner, name string, p Pointer) error {
+	r, err := s.findRepository(ctx, owner, name, true)
+	if err != nil {
+		return err
+	}
+
+	stored, err := s.store.Objects(ctx, r.ID, []string{p.OID})
+	if err != nil {
+		return err
+	}
+	size, ok := stored[p.OID]
+	if !ok {
+		return ErrObjectNotFound
+	}
+	if size != p.Size {
+		return ErrObjectInvalid
+	}
+
+	return nil
+}
+
+// Locks of the repository, filtered by opts.
+func (s *service) Locks(ctx context.Context, owner, name string, opts LockOptions) ([]*Lock, error) {
+	r, err := s.findRepository(ctx, owner, name, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.store.Locks(ctx, r.ID, opts)
+}
+
+// Lock the path of the repository for the session's user.
+// If the path is locked already, the existing lock is returned with ErrLockExists.
+func (s *service) Lock(ctx context.Context, owner, name, path string) (*Lock, error) {
+	r, err := s.findRepository(ctx, owner, name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	u := session.GetSessionUser(ctx)
+	l, err := s.store.CreateLock(ctx, &Lock{
+		RepositoryID: r.ID,
+		Path:         path,
+		Owner:        u.Username,
+		OwnerID:      u.ID,
+	})
+	if err == ErrLockExists {
+		locks, lerr := s.store.Locks(ctx, r.ID, LockOptions{Path: path})
+		if lerr != nil || len(locks) == 0 {
+			return nil, err
+		}
+		return locks[0], err
+	}
+
+	return l, err
+}
+
+// Unlock the lock of the repository, locks of other users are only unlocked if forced.
+func (s *service) Unlock(ctx context.Context, owner, name, id string, force bool) (*Lock, error) {
+	r, err := s.findRepository(ctx, owner, name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	locks, err := s.store.Locks(ctx, r.ID, LockOptions{ID: id})
+	if err != nil {
+		return nil, err
+	}
+	if len(locks) == 0 {
+		return nil, ErrLockNotFound
+	}
+	l := locks[0]
+
+	if u := session.GetSessionUser(ctx); l.OwnerID != u.ID && !force {
+		return nil, ErrLockOwned
+	}
+
+	if err := s.store.DeleteLock(ctx, l.ID); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// VerifyLocks splits the repository's locks into the session's user's and everyone else's,
+// clients check them before pushing.
+func (s *service) VerifyLocks(ctx context.Context, owner, name string) ([]*Lock, []*Lock, error) {
+	r, err := s.findRepository(ctx, owner, name, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	locks, err := s.store.Locks(ctx, r.ID, LockOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := session.GetSessionUser(ctx)
+	ours, theirs := []*Lock{}, []*Lock{}
+	for _, l := range locks {
+		if l.OwnerID == u.ID {
+			ours = append(ours, l)
+		} else {
+			theirs = append(theirs, l)
+		}
+	}
+
+	return ours, theirs, nil
+}
@@ -0,0 +1,265 @@
+package lfs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testStore struct {
+	Store
+	objects map[string]map[string]int64
+	locks   []*Lock
+}
+
+func newTestStore() *testStore {
+	return &testStore{objects: map[string]map[string]int64{}}
+}
+
+func (s *testStore) Objects(ctx context.Context, repositoryID string, oids []string) (map[string]int64, error) {
+	objects := map[string]int64{}
+	for _, oid := range oids {
+		if size, ok := s.objects[repositoryID][oid]; ok {
+			objects[oid] = size
+		}
+	}
+	return objects, nil
+}
+
+func (s *testStore) CreateObject(ctx context.Context, repositoryID string, p Pointer) error {
+	if s.objects[repositoryID] == nil {
+		s.objects[repositoryID] = map[string]int64{}
+	}
+	s.objects[repositoryID][p.OID] = p.Size
+	return nil
+}
+
+func (s *testStore) Locks(ctx context.Context, repositoryID string, opts LockOptions) ([]*Lock, error) {
+	var locks []*Lock
+	for _, l := range s.locks {
+		if l.RepositoryID == repositoryID && (opts.ID == "" || l.ID == opts.ID) && (opts.Path == "" || l.Path == opts.Path) {
+			locks = append(locks, l)
+		}
+	}
+	return locks, nil
+}
+
+func (s *testStore) CreateLock(ctx context.Context, l *Lock) (*Lock, error) {
+	for _, existing := range s.locks {
+		if existing.RepositoryID == l.RepositoryID && existing.Path == l.Path {
+			return nil, ErrLockExists
+		}
+	}
+	l.ID = fmt.Sprintf("lock%d", len(s.locks)+1)
+	l.Locked = time.Now()
+	s.locks = append(s.locks, l)
+	return l, nil
+}
+
+func (s *testStore) DeleteLock(ctx context.Context, id string) error {
+	for i, l := range s.locks {
+		if l.ID == id {
+			s.locks = append(s.locks[:i], s.locks[i+1:]...)
+			return nil
+		}
+	}
+	return ErrLockNotFound
+}
+
+type testRepositories struct{}
+
+// Find foo/bar and foo/private, which only foo can see.
+func (testRepositories) Find(ctx context.Context, owner, name string) (*repository.Repository, string, error) {
+	if owner != "foo" {
+		return nil, "", repository.ErrRepositoryNotFound
+	}
+	switch name {
+	case "bar":
+		return &repository.Repository{ID: "1", Name: name}, owner, nil
+	case "private":
+		if u := session.GetSessionUser(ctx); u != nil && u.Username == owner {
+			return &repository.Repository{ID: "2", Name: name, Private: true}, owner, nil
+		}
+	}
+	return nil, "", repository.ErrRepositoryNotFound
+}
+
+func withUser(username string) context.Context {
+	ctx := context.WithValue(context.Background(), session.CookieUserID, username+"-id")
+	return context.WithValue(ctx, session.CookieUserUsername, username)
+}
+
+func testObject(content string) Pointer {
+	sum := sha256.Sum256([]byte(content))
+	return Pointer{OID: hex.EncodeToString(sum[:]), Size: int64(len(content))}
+}
+
+func newTestObjectStore(t *testing.T) (*LocalObjectStore, func()) {
+	dir, err := ioutil.TempDir("", "sourcepods-lfs")
+	require.NoError(t, err)
+
+	objects, err := NewLocalObjectStore(dir)
+	require.NoError(t, err)
+
+	return objects, func() { os.RemoveAll(dir) }
+}
+
+func TestLocalObjectStore(t *testing.T) {
+	objects, cleanup := newTestObjectStore(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	p := testObject("large file")
+
+	_, err := objects.Size(ctx, p.OID)
+	assert.Equal(t, ErrObjectNotFound, err)
+
+	assert.Equal(t, ErrObjectInvalid, objects.Put(ctx, p.OID, p.Size, strings.NewReader("large file!")))
+	assert.Equal(t, ErrObjectInvalid, objects.Put(ctx, p.OID, p.Size, strings.NewReader("small file")))
+	assert.Equal(t, ErrObjectInvalid, objects.Put(ctx, "../../etc/passwd", 0, strings.NewReader("")))
+	_, err = objects.Size(ctx, p.OID)
+	assert.Equal(t, ErrObjectNotFound, err, "invalid content isn't stored")
+
+	require.NoError(t, objects.Put(ctx, p.OID, p.Size, strings.NewReader("large file")))
+
+	size, err := objects.Size(ctx, p.OID)
+	require.NoError(t, err)
+	assert.Equal(t, p.Size, size)
+
+	rc, err := objects.Get(ctx, p.OID)
+	require.NoError(t, err)
+	content, err := ioutil.ReadAll(rc)
+	rc.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "large file", string(content))
+}
+
+func TestTokens(t *testing.T) {
+	tokens := NewTokens("secret")
+
+	token, err := tokens.Sign(Claims{Username: "foo", Owner: "foo", Name: "bar", Operation: OperationUpload})
+	require.NoError(t, err)
+
+	c, err := tokens.Verify(token)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", c.Username)
+	assert.Equal(t, OperationUpload, c.Operation)
+	assert.True(t, c.Expires.After(time.Now().Add(59*time.Minute)))
+
+	_, err = NewTokens("other").Verify(token)
+	assert.Equal(t, ErrTokenInvalid, err)
+	_, err = NewTokens("").Verify(token)
+	assert.Equal(t, ErrTokenInvalid, err)
+	_, err = tokens.Verify(token[1:])
+	assert.Equal(t, ErrTokenInvalid, err)
+
+	expired, err := tokens.Sign(Claims{Username: "foo", Expires: time.Now().Add(-time.Second)})
+	require.NoError(t, err)
+	_, err = tokens.Verify(expired)
+	assert.Equal(t, ErrTokenInvalid, err)
+}
+
+func TestServiceBatch(t *testing.T) {
+	objects, cleanup := newTestObjectStore(t)
+	defer cleanup()
+
+	store := newTestStore()
+	s := NewService(store, objects, testRepositories{})
+
+	p := testObject("large file")
+	other := testObject("other file")
+
+	_, err := s.Batch(withUser("foo"), "foo", "bar", "delete", []Pointer{p})
+	assert.Equal(t, ErrOperationInvalid, err)
+	_, err = s.Batch(context.Background(), "foo", "private", OperationDownload, []Pointer{p})
+	assert.Equal(t, ErrRepositoryNotFound, err)
+	_, err = s.Batch(withUser("baz"), "foo", "bar", OperationUpload, []Pointer{p})
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	batch, err := s.Batch(withUser("foo"), "foo", "bar", OperationUpload, []Pointer{p, {OID: "foo", Size: 1}})
+	require.NoError(t, err)
+	require.Len(t, batch, 2)
+	assert.Equal(t, OperationUpload, batch[0].Action)
+	assert.Equal(t, ErrObjectInvalid, batch[1].Error)
+
+	assert.Equal(t, ErrPermissionDenied, s.Upload(withUser("baz"), "foo", "bar", p, strings.NewReader("large file")))
+	assert.Equal(t, ErrObjectInvalid, s.Upload(withUser("foo"), "foo", "bar", p, strings.NewReader("other file")))
+	require.NoError(t, s.Upload(withUser("foo"), "foo", "bar", p, strings.NewReader("large file")))
+	require.NoError(t, s.Verify(withUser("foo"), "foo", "bar", p))
+	assert.Equal(t, ErrObjectInvalid, s.Verify(withUser("foo"), "foo", "bar", Pointer{OID: p.OID, Size: 1}))
+
+	batch, err = s.Batch(withUser("foo"), "foo", "bar", OperationUpload, []Pointer{p})
+	require.NoError(t, err)
+	assert.Equal(t, "", batch[0].Action, "uploaded objects aren't uploaded again")
+
+	batch, err = s.Batch(context.Background(), "foo", "bar", OperationDownload, []Pointer{p, other})
+	require.NoError(t, err)
+	assert.Equal(t, OperationDownload, batch[0].Action)
+	assert.Equal(t, ErrObjectNotFound, batch[1].Error)
+
+	rc, size, err := s.Download(context.Background(), "foo", "bar", p.OID)
+	require.NoError(t, err)
+	rc.Close()
+	assert.Equal(t, p.Size, size)
+
+	// Objects are only available to the repositories they have been uploaded to.
+	_, _, err = s.Download(withUser("foo"), "foo", "private", p.OID)
+	assert.Equal(t, ErrObjectNotFound, err)
+	batch, err = s.Batch(withUser("foo"), "foo", "private", OperationUpload, []Pointer{p})
+	require.NoError(t, err)
+	assert.Equal(t, OperationUpload, batch[0].Action)
+}
+
+func TestServiceLocks(t *testing.T) {
+	store := newTestStore()
+	s := NewService(store, nil, testRepositories{})
+
+	_, err := s.Lock(withUser("baz"), "foo", "bar", "assets/hero.png")
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	l, err := s.Lock(withUser("foo"), "foo", "bar", "assets/hero.png")
+	require.NoError(t, err)
+	assert.Equal(t, "foo", l.Owner)
+
+	existing, err := s.Lock(withUser("foo"), "foo", "bar", "assets/hero.png")
+	assert.Equal(t, ErrLockExists, err)
+	assert.Equal(t, l.ID, existing.ID)
+
+	// Someone else's lock, like one of a user who has since lost access.
+	store.locks = append(store.locks, &Lock{ID: "lock2", RepositoryID: "1", Path: "assets/map.png", Owner: "baz", OwnerID: "baz-id"})
+
+	locks, err := s.Locks(context.Background(), "foo", "bar", LockOptions{Path: "assets/map.png"})
+	require.NoError(t, err)
+	require.Len(t, locks, 1)
+	assert.Equal(t, "lock2", locks[0].ID)
+
+	ours, theirs, err := s.VerifyLocks(withUser("foo"), "foo", "bar")
+	require.NoError(t, err)
+	assert.Equal(t, []*Lock{l}, ours)
+	assert.Equal(t, []*Lock{locks[0]}, theirs)
+
+	_, err = s.Unlock(withUser("foo"), "foo", "bar", "lock2", false)
+	assert.Equal(t, ErrLockOwned, err)
+	_, err = s.Unlock(withUser("foo"), "foo", "bar", "lock3", false)
+	assert.Equal(t, ErrLockNotFound, err)
+
+	unlocked, err := s.Unlock(withUser("foo"), "foo", "bar", "lock2", true)
+	require.NoError(t, err)
+	assert.Equal(t, "assets/map.png", unlocked.Path)
+
+	_, err = s.Unlock(withUser("foo"), "foo", "bar", l.ID, false)
+	require.NoError(t, err)
+	assert.Len(t, store.locks, 0)
+}
+
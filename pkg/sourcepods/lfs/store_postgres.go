@@ -0,0 +1,150 @@
+package lfs
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Postgres implementation of the Store.
+type Postgres struct {
+	db *sql.DB
+}
+
+// NewPostgresStore returns a Postgres implementation of the Store.
+func NewPostgresStore(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+// Objects returns the sizes of the objects stored for the repository by their oid.
+func (s *Postgres) Objects(ctx context.Context, repositoryID string, oids []string) (map[string]int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "lfs.Postgres.Objects")
+	span.SetTag("repository", repositoryID)
+	span.SetTag("objects", len(oids))
+	defer span.Finish()
+
+	rows, err := s.db.QueryContext(ctx, `
+SELECT oid, size
+FROM lfs_objects
+WHERE repository_id = $1 AND oid = ANY($2);
+`, repositoryID, pq.Array(oids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	objects := make(map[string]int64, len(oids))
+	for rows.Next() {
+		var oid string
+		var size int64
+		if err := rows.Scan(&oid, &size); err != nil {
+			return nil, err
+		}
+		objects[oid] = size
+	}
+
+	return objects, rows.Err()
+}
+
+// CreateObject stores the object for the repository, unless it's stored already.
+func (s *Postgres) CreateObject(ctx context.Context, repositoryID string, p Pointer) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "lfs.Postgres.CreateObject")
+	span.SetTag("repository", repositoryID)
+	span.SetTag("oid", p.OID)
+	defer span.Finish()
+
+	_, err := s.db.ExecContext(ctx, `
+INSERT INTO lfs_objects (repository_id, oid, size)
+VALUES ($1, $2, $3)
+ON CONFLICT (repository_id, oid) DO NOTHING;
+`, repositoryID, p.OID, p.Size)
+
+	return err
+}
+
+// Locks of the repository, oldest first.
+func (s *Postgres) Locks(ctx context.Context, repositoryID string, opts LockOptions) ([]*Lock, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "lfs.Postgres.Locks")
+	span.SetTag("repository", repositoryID)
+	span.SetTag("id", opts.ID)
+	span.SetTag("path", opts.Path)
+	defer span.Finish()
+
+	if opts.ID != "" && !govalidator.IsUUID(opts.ID) {
+		return nil, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+SELECT l.id, l.repository_id, l.path, u.username, l.owner_id, l.created_at
+FROM lfs_locks l
+JOIN users u ON u.id = l.owner_id
+WHERE l.repository_id = $1
+	AND ($2 = '' OR l.id::TEXT = $2)
+	AND ($3 = '' OR l.path = $3)
+ORDER BY l.created_at, l.id;
+`, repositoryID, opts.ID, opts.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var locks []*Lock
+	for rows.Next() {
+		l := &Lock{}
+		if err := rows.Scan(&l.ID, &l.RepositoryID, &l.Path, &l.Owner, &l.OwnerID, &l.Locked); err != nil {
+			return nil, err
+		}
+		locks = append(locks, l)
+	}
+
+	return locks, rows.Err()
+}
+
+// CreateLock for the path of a repository, ErrLockExists if it's locked already.
+func (s *Postgres) CreateLock(ctx context.Context, l *Lock) (*Lock, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "lfs.Postgres.CreateLock")
+	span.SetTag("repository", l.RepositoryID)
+	span.SetTag("path", l.Path)
+	defer span.Finish()
+
+	row := s.db.QueryRowContext(ctx, `
+INSERT INTO lfs_locks (repository_id, path, owner_id)
+VALUES ($1, $2, $3)
+RETURNING id, created_at;
+`, l.RepositoryID, l.Path, l.OwnerID)
+
+	if err := row.Scan(&l.ID, &l.Locked); err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == pq.ErrorCode("23505") {
+				return nil, ErrLockExists
+			}
+		}
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// DeleteLock by its id.
+func (s *Postgres) DeleteLock(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "lfs.Postgres.DeleteLock")
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	if !govalidator.IsUUID(id) {
+		return ErrLockNotFound
+	}
+
+	res, err := s.db.ExecContext(ctx, `DELETE FROM lfs_locks WHERE id = $1;`, id)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrLockNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package lfs
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// TokenExpiry is how long tokens are valid after they have been signed.
+const TokenExpiry = time.Hour
+
+// Claims of a token, it authenticates its user for one operation on one repository.
+type Claims struct {
+	Username  string    `json:"u"`
+	Owner     string    `json:"o"`
+	Name      string    `json:"n"`
+	Operation string    `json:"op"`
+	Expires   time.Time `json:"exp"`
+}
+
+// Tokens signs and verifies the tokens LFS requests authenticate with,
+// they're handed out by the batch API.
+type Tokens struct {
+	secret []byte
+}
+
+// NewTokens returns Tokens signed with the secret,
+// everyone verifying the tokens needs the same secret.
+func NewTokens(secret string) Tokens {
+	return Tokens{secret: []byte(secret)}
+}
+
+// Sign the claims, their Expires is set to TokenExpiry from now if zero.
+func (t Tokens) Sign(c Claims) (string, error) {
+	if c.Expires.IsZero() {
+		c.Expires = time.Now().Add(TokenExpiry)
+	}
+
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(t.mac(encoded)), nil
+}
+
+// Verify the token and return its claims, ErrTokenInvalid if it's not signed with
+// the secret or has expired.
+func (t Tokens) Verify(token string) (Claims, error) {
+	var c Claims
+
+	parts := strings.Split(token, ".")
+	if len(t.secret) == 0 || len(parts) != 2 {
+		return c, ErrTokenInvalid
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(mac, t.mac(parts[0])) {
+		return c, ErrTokenInvalid
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return c, ErrTokenInvalid
+	}
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return c, ErrTokenInvalid
+	}
+	if time.Now().After(c.Expires) {
+		return c, ErrTokenInvalid
+	}
+
+	return c, nil
+}
+
+func (t Tokens) mac(payload string) []byte {
+	h := hmac.New(sha256.New, t.secret)
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
@@ -0,0 +1,112 @@
+package lfs
+
+import (
+	"context"
+	"io"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+//TracingRequestID returns the request ID as string for tracing
+type TracingRequestID func(context.Context) string
+
+type tracingService struct {
+	service   Service
+	requestID TracingRequestID
+}
+
+// NewTracingService wraps the Service and provides tracing for its methods.
+func NewTracingService(s Service, requestID TracingRequestID) Service {
+	return &tracingService{service: s, requestID: requestID}
+}
+
+func (s *tracingService) Batch(ctx context.Context, owner, name, operation string, objects []Pointer) ([]*BatchObject, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "lfs.Service.Batch")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("operation", operation)
+	span.SetTag("objects", len(objects))
+	defer span.Finish()
+
+	return s.service.Batch(ctx, owner, name, operation, objects)
+}
+
+func (s *tracingService) Download(ctx context.Context, owner, name, oid string) (io.ReadCloser, int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "lfs.Service.Download")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("oid", oid)
+	defer span.Finish()
+
+	return s.service.Download(ctx, owner, name, oid)
+}
+
+func (s *tracingService) Upload(ctx context.Context, owner, name string, p Pointer, r io.Reader) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "lfs.Service.Upload")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("oid", p.OID)
+	span.SetTag("size", p.Size)
+	defer span.Finish()
+
+	return s.service.Upload(ctx, owner, name, p, r)
+}
+
+func (s *tracingService) Verify(ctx context.Context, owner, name string, p Pointer) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "lfs.Service.Verify")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("oid", p.OID)
+	span.SetTag("size", p.Size)
+	defer span.Finish()
+
+	return s.service.Verify(ctx, owner, name, p)
+}
+
+func (s *tracingService) Locks(ctx context.Context, owner, name string, opts LockOptions) ([]*Lock, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "lfs.Service.Locks")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("path", opts.Path)
+	defer span.Finish()
+
+	return s.service.Locks(ctx, owner, name, opts)
+}
+
+func (s *tracingService) Lock(ctx context.Context, owner, name, path string) (*Lock, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "lfs.Service.Lock")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("path", path)
+	defer span.Finish()
+
+	return s.service.Lock(ctx, owner, name, path)
+}
+
+func (s *tracingService) Unlock(ctx context.Context, owner, name, id string, force bool) (*Lock, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "lfs.Service.Unlock")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("id", id)
+	span.SetTag("force", force)
+	defer span.Finish()
+
+	return s.service.Unlock(ctx, owner, name, id, force)
+}
+
+func (s *tracingService) VerifyLocks(ctx context.Context, owner, name string) ([]*Lock, []*Lock, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "lfs.Service.VerifyLocks")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.VerifyLocks(ctx, owner, name)
+}
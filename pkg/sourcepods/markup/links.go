@@ -0,0 +1,47 @@
+package markup
+
+import (
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+// rewriteLinks replaces the destinations of the document's links and images with what rewrite returns for them.
+func rewriteLinks(document *blackfriday.Node, rewrite func(string) string) {
+	document.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if entering && (node.Type == blackfriday.Link || node.Type == blackfriday.Image) {
+			node.LinkData.Destination = []byte(rewrite(string(node.LinkData.Destination)))
+		}
+		return blackfriday.GoToNext
+	})
+}
+
+// RelativeLinks returns Options.Links pointing the links to paths in a repository to base,
+// like the URL the repository's raw files are served at with the rev appended.
+// Paths are relative to the root of the repository and can't leave it.
+// Absolute URLs, links to anchors and anything that can't be parsed are kept as they are.
+func RelativeLinks(base string) func(string) string {
+	base = strings.TrimSuffix(base, "/")
+	return func(link string) string {
+		u, err := url.Parse(link)
+		if err != nil || u.Scheme != "" || u.Host != "" || u.Opaque != "" || u.Path == "" {
+			return link
+		}
+
+		p := strings.TrimPrefix(path.Clean("/"+u.Path), "/")
+		if p == "" {
+			return link
+		}
+
+		rewritten := base + "/" + (&url.URL{Path: p}).EscapedPath()
+		if u.RawQuery != "" {
+			rewritten += "?" + u.RawQuery
+		}
+		if u.Fragment != "" {
+			rewritten += "#" + u.EscapedFragment()
+		}
+		return rewritten
+	}
+}
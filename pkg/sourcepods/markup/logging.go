@@ -0,0 +1,48 @@
+package markup
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+//LoggingRequestID returns the request ID as string for logging
+type LoggingRequestID func(context.Context) string
+
+type loggingService struct {
+	service   Service
+	requestID LoggingRequestID
+	logger    log.Logger
+}
+
+// NewLoggingService wraps the Service and provides logging for its methods.
+func NewLoggingService(s Service, requestID LoggingRequestID, logger log.Logger) Service {
+	return &loggingService{service: s, requestID: requestID, logger: logger}
+}
+
+func (s *loggingService) Render(ctx context.Context, text string, opts Options) (string, error) {
+	start := time.Now()
+
+	html, err := s.service.Render(ctx, text, opts)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Render",
+		"size", len(text),
+		"repository", opts.Repository,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrTextTooLarge && err != ErrRepositoryInvalid {
+		level.Warn(logger).Log(
+			"msg", "failed to render markdown",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return html, err
+}
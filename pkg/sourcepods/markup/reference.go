@@ -0,0 +1,239 @@
+package markup
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/russross/blackfriday/v2"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/issue"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pullrequest"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+)
+
+// referencePattern matches mentions like @foo, issue and pull request references like foo/bar#123 and #123 and commit SHAs.
+// They can't be part of a word, a path or an email address.
+var referencePattern = regexp.MustCompile(`(?:^|[^\w@/#.-])(@([a-zA-Z0-9]+)|([a-zA-Z0-9]+)/([a-zA-Z0-9]+)#([0-9]+)|#([0-9]+)|([0-9a-f]{7,40}))\b`)
+
+// lookupsMax is the most users, issues and commits a text's references are looked up for,
+// the references after that aren't linked.
+const lookupsMax = 50
+
+// linker replaces the references in the text of a document with links,
+// #123 and commit SHAs are only linked within the repository owner/name.
+type linker struct {
+	users   Users
+	commits Commits
+	issues  Issues
+	pulls   PullRequests
+	owner   string
+	name    string
+
+	lookups int
+	// urls has the URLs of the references looked up, they're empty if they don't exist.
+	urls map[string]string
+}
+
+func (l *linker) link(ctx context.Context, document *blackfriday.Node) error {
+	var texts []*blackfriday.Node
+	document.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		switch node.Type {
+		case blackfriday.Link, blackfriday.Image:
+			return blackfriday.SkipChildren
+		case blackfriday.Text:
+			texts = append(texts, node)
+		}
+		return blackfriday.GoToNext
+	})
+
+	for _, node := range texts {
+		if err := l.linkText(ctx, node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// linkText splits the text node into text and links for the references that exist.
+func (l *linker) linkText(ctx context.Context, node *blackfriday.Node) error {
+	text := node.Literal
+
+	linked := false
+	var last int
+	for _, m := range referencePattern.FindAllSubmatchIndex(text, -1) {
+		url, label, err := l.resolve(ctx, text, m)
+		if err != nil {
+			return err
+		}
+		if url == "" {
+			continue
+		}
+
+		start, end := m[2], m[3]
+		if start > last {
+			node.InsertBefore(textNode(text[last:start]))
+		}
+		node.InsertBefore(linkNode(url, label))
+		last = end
+		linked = true
+	}
+	if !linked {
+		return nil
+	}
+
+	if last < len(text) {
+		node.InsertBefore(textNode(text[last:]))
+	}
+	node.Unlink()
+
+	return nil
+}
+
+// resolve returns the URL and label of the reference matched by m, the URL is empty if it doesn't exist.
+func (l *linker) resolve(ctx context.Context, text []byte, m []int) (string, string, error) {
+	group := func(i int) string {
+		if m[2*i] < 0 {
+			return ""
+		}
+		return string(text[m[2*i]:m[2*i+1]])
+	}
+
+	switch {
+	case group(2) != "":
+		url, err := l.lookup("@"+group(2), func() (string, error) {
+			return l.user(ctx, group(2))
+		})
+		return url, group(1), err
+	case group(3) != "":
+		owner, name, number := group(3), group(4), group(5)
+		url, err := l.lookup(owner+"/"+name+"#"+number, func() (string, error) {
+			return l.issue(ctx, owner, name, number)
+		})
+		return url, group(1), err
+	case group(6) != "":
+		if l.owner == "" {
+			return "", "", nil
+		}
+		number := group(6)
+		url, err := l.lookup(l.owner+"/"+l.name+"#"+number, func() (string, error) {
+			return l.issue(ctx, l.owner, l.name, number)
+		})
+		return url, group(1), err
+	default:
+		if l.owner == "" {
+			return "", "", nil
+		}
+		sha := group(7)
+		url, err := l.lookup(sha, func() (string, error) {
+			return l.commit(ctx, sha)
+		})
+		if len(sha) > 7 {
+			sha = sha[:7]
+		}
+		return url, sha, err
+	}
+}
+
+// lookup returns the URL of the reference key, it's only looked up with fn the first time.
+func (l *linker) lookup(key string, fn func() (string, error)) (string, error) {
+	if url, ok := l.urls[key]; ok {
+		return url, nil
+	}
+	if l.lookups >= lookupsMax {
+		return "", nil
+	}
+	l.lookups++
+
+	url, err := fn()
+	if err != nil {
+		return "", err
+	}
+
+	if l.urls == nil {
+		l.urls = map[string]string{}
+	}
+	l.urls[key] = url
+
+	return url, nil
+}
+
+func (l *linker) user(ctx context.Context, username string) (string, error) {
+	u, err := l.users.FindByUsername(ctx, username)
+	if err == user.ErrNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return "/" + u.Username, nil
+}
+
+// issue links to the issue or pull request with the number, they share the numbers of a repository.
+func (l *linker) issue(ctx context.Context, owner, name, number string) (string, error) {
+	n, err := strconv.Atoi(number)
+	if err != nil {
+		return "", nil
+	}
+
+	_, err = l.issues.Find(ctx, owner, name, n)
+	if err == issue.ErrRepositoryNotFound {
+		return "", nil
+	}
+	if err == nil {
+		return issueURL(owner, name, number), nil
+	}
+	if err != issue.ErrIssueNotFound {
+		return "", err
+	}
+
+	_, err = l.pulls.Find(ctx, owner, name, n)
+	if err == pullrequest.ErrRepositoryNotFound || err == pullrequest.ErrPullRequestNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return pullRequestURL(owner, name, number), nil
+}
+
+func (l *linker) commit(ctx context.Context, sha string) (string, error) {
+	c, err := l.commits.Commit(ctx, l.owner, l.name, sha)
+	if err == repository.ErrRepositoryNotFound || err == repository.ErrRevNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	// The SHA could've been resolved as the name of a branch or tag instead.
+	if !strings.HasPrefix(c.Hash, sha) {
+		return "", nil
+	}
+	return commitURL(l.owner, l.name, c.Hash), nil
+}
+
+func issueURL(owner, name, number string) string {
+	return "/" + owner + "/" + name + "/issues/" + number
+}
+
+func pullRequestURL(owner, name, number string) string {
+	return "/" + owner + "/" + name + "/pulls/" + number
+}
+
+func commitURL(owner, name, sha string) string {
+	return "/" + owner + "/" + name + "/commit/" + sha
+}
+
+func textNode(text []byte) *blackfriday.Node {
+	node := blackfriday.NewNode(blackfriday.Text)
+	node.Literal = text
+	return node
+}
+
+func linkNode(url, label string) *blackfriday.Node {
+	node := blackfriday.NewNode(blackfriday.Link)
+	node.LinkData.Destination = []byte(url)
+	node.AppendChild(textNode([]byte(label)))
+	return node
+}
@@ -0,0 +1,86 @@
+package markup
+
+import (
+	"bytes"
+	"html"
+	"io"
+	"regexp"
+
+	"github.com/alecthomas/chroma"
+	chromahtml "github.com/alecthomas/chroma/formatters/html"
+	"github.com/alecthomas/chroma/lexers"
+	"github.com/alecthomas/chroma/styles"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/russross/blackfriday/v2"
+)
+
+// highlightPrefix is the prefix of the classes of highlighted code,
+// they're chroma's classes to be styled like its github style.
+const highlightPrefix = "hl-"
+
+// policy allows the HTML user generated content can safely contain, the language of code blocks
+// and the classes of highlighted code.
+var policy = func() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[a-zA-Z0-9+#-]+$`)).OnElements("code")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^` + highlightPrefix + `[a-z0-9]+$`)).OnElements("span")
+	return p
+}()
+
+var highlighter = chromahtml.New(
+	chromahtml.WithClasses(true),
+	chromahtml.ClassPrefix(highlightPrefix),
+	chromahtml.PreventSurroundingPre(true),
+)
+
+// renderer renders Markdown like the blackfriday.HTMLRenderer,
+// but highlights the code of fenced code blocks in languages it knows.
+type renderer struct {
+	*blackfriday.HTMLRenderer
+}
+
+func newRenderer() *renderer {
+	return &renderer{
+		HTMLRenderer: blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{
+			Flags: blackfriday.CommonHTMLFlags,
+		}),
+	}
+}
+
+func (r *renderer) RenderNode(w io.Writer, node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+	if node.Type == blackfriday.CodeBlock {
+		if highlighted, ok := highlight(node); ok {
+			w.Write(highlighted)
+			return blackfriday.GoToNext
+		}
+	}
+	return r.HTMLRenderer.RenderNode(w, node, entering)
+}
+
+// highlight returns the code block highlighted, if its language is known.
+func highlight(node *blackfriday.Node) ([]byte, bool) {
+	info := bytes.Fields(node.Info)
+	if len(info) == 0 {
+		return nil, false
+	}
+	language := string(info[0])
+
+	lexer := lexers.Get(language)
+	if lexer == nil {
+		return nil, false
+	}
+
+	iterator, err := chroma.Coalesce(lexer).Tokenise(nil, string(node.Literal))
+	if err != nil {
+		return nil, false
+	}
+
+	var b bytes.Buffer
+	b.WriteString(`<pre><code class="language-` + html.EscapeString(language) + `">`)
+	if err := highlighter.Format(&b, styles.GitHub, iterator); err != nil {
+		return nil, false
+	}
+	b.WriteString("</code></pre>\n")
+
+	return b.Bytes(), true
+}
@@ -0,0 +1,122 @@
+package markup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/russross/blackfriday/v2"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/issue"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pullrequest"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+)
+
+var (
+	// ErrTextTooLarge returned if the text is too large to be rendered.
+	ErrTextTooLarge = errors.New("text is too large")
+
+	// ErrRepositoryInvalid returned if the repository the text is about isn't like owner/name.
+	ErrRepositoryInvalid = errors.New("repository is not owner/name")
+)
+
+type (
+	// Users finds the mentioned users, like the user.Service.
+	Users interface {
+		FindByUsername(ctx context.Context, username string) (*user.User, error)
+	}
+
+	// Commits finds the referenced commits in the repositories visible to the session's user,
+	// like the repository.Service.
+	Commits interface {
+		Commit(ctx context.Context, owner, name, rev string) (storage.Commit, error)
+	}
+
+	// Issues finds the referenced issues in the repositories visible to the session's user,
+	// like the issue.Service.
+	Issues interface {
+		Find(ctx context.Context, owner, name string, number int) (*issue.Issue, error)
+	}
+
+	// PullRequests finds the referenced pull requests in the repositories visible to the session's user,
+	// like the pullrequest.Service. They're numbered along with the issues.
+	PullRequests interface {
+		Find(ctx context.Context, owner, name string, number int) (*pullrequest.PullRequest, error)
+	}
+
+	// Service renders Markdown to HTML.
+	Service interface {
+		Render(ctx context.Context, text string, opts Options) (string, error)
+	}
+
+	service struct {
+		users   Users
+		commits Commits
+		issues  Issues
+		pulls   PullRequests
+	}
+)
+
+// Options for rendering Markdown.
+type Options struct {
+	// Repository is the owner/name of the repository the text is about, like the issue's.
+	// References like #123 and commit SHAs are only linked within a repository.
+	Repository string
+	// Links rewrites the destinations of the text's links and images, like RelativeLinks.
+	Links func(destination string) string
+}
+
+// textMaxSize is the size of the largest text that is rendered.
+const textMaxSize = 1 << 20
+
+// NewService to render Markdown.
+func NewService(users Users, commits Commits, issues Issues, pulls PullRequests) Service {
+	return &service{
+		users:   users,
+		commits: commits,
+		issues:  issues,
+		pulls:   pulls,
+	}
+}
+
+// Render GitHub flavored Markdown to sanitized HTML with the code of fenced code blocks highlighted.
+// Mentions of users link to their profile, references like owner/name#123 to issues or pull requests
+// and SHAs of commits in the repository to the commits, if they exist.
+// The destinations of the text's own links are rewritten with the Links of the options.
+func (s *service) Render(ctx context.Context, text string, opts Options) (string, error) {
+	if len(text) > textMaxSize {
+		return "", ErrTextTooLarge
+	}
+
+	var owner, name string
+	if opts.Repository != "" {
+		parts := strings.Split(opts.Repository, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return "", ErrRepositoryInvalid
+		}
+		owner, name = parts[0], parts[1]
+	}
+
+	parser := blackfriday.New(blackfriday.WithExtensions(blackfriday.CommonExtensions))
+	document := parser.Parse([]byte(text))
+
+	if opts.Links != nil {
+		rewriteLinks(document, opts.Links)
+	}
+
+	l := &linker{users: s.users, commits: s.commits, issues: s.issues, pulls: s.pulls, owner: owner, name: name}
+	if err := l.link(ctx, document); err != nil {
+		return "", err
+	}
+
+	var b bytes.Buffer
+	r := newRenderer()
+	r.RenderHeader(&b, document)
+	document.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		return r.RenderNode(&b, node, entering)
+	})
+	r.RenderFooter(&b, document)
+
+	return string(policy.SanitizeBytes(b.Bytes())), nil
+}
@@ -0,0 +1,158 @@
+package markup
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/issue"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pullrequest"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testUsers struct {
+	found []string
+}
+
+func (u *testUsers) FindByUsername(ctx context.Context, username string) (*user.User, error) {
+	u.found = append(u.found, username)
+	switch username {
+	case "foo", "bar":
+		return &user.User{ID: username + "-id", Username: username}, nil
+	}
+	return nil, user.ErrNotFound
+}
+
+type testCommits struct{}
+
+func (testCommits) Commit(ctx context.Context, owner, name, rev string) (storage.Commit, error) {
+	if owner+"/"+name != "foo/bar" {
+		return storage.Commit{}, repository.ErrRepositoryNotFound
+	}
+	const hash = "deadbeef0123456789deadbeef0123456789abcd"
+	if !strings.HasPrefix(hash, rev) {
+		return storage.Commit{}, repository.ErrRevNotFound
+	}
+	return storage.Commit{Hash: hash}, nil
+}
+
+// testIssues has the issues #3 and #12 in foo/bar and #12 in bar/baz.
+type testIssues struct{}
+
+func (testIssues) Find(ctx context.Context, owner, name string, number int) (*issue.Issue, error) {
+	switch owner + "/" + name {
+	case "foo/bar":
+		if number == 3 || number == 12 {
+			return &issue.Issue{Number: number}, nil
+		}
+	case "bar/baz":
+		if number == 12 {
+			return &issue.Issue{Number: number}, nil
+		}
+	default:
+		return nil, issue.ErrRepositoryNotFound
+	}
+	return nil, issue.ErrIssueNotFound
+}
+
+// testPullRequests has the pull request #5 in foo/bar.
+type testPullRequests struct{}
+
+func (testPullRequests) Find(ctx context.Context, owner, name string, number int) (*pullrequest.PullRequest, error) {
+	switch owner + "/" + name {
+	case "foo/bar":
+		if number == 5 {
+			return &pullrequest.PullRequest{Number: number}, nil
+		}
+	case "bar/baz":
+	default:
+		return nil, pullrequest.ErrRepositoryNotFound
+	}
+	return nil, pullrequest.ErrPullRequestNotFound
+}
+
+func TestServiceRender(t *testing.T) {
+	users := &testUsers{}
+	s := NewService(users, testCommits{}, testIssues{}, testPullRequests{})
+
+	html, err := s.Render(context.Background(), "# Hello\n\n<script>alert(1)</script> **there** [site](https://example.com)", Options{})
+	require.NoError(t, err)
+	assert.Contains(t, html, "<h1>Hello</h1>")
+	assert.Contains(t, html, "<strong>there</strong>")
+	assert.Contains(t, html, `<a href="https://example.com" rel="nofollow">site</a>`)
+	assert.NotContains(t, html, "<script>")
+
+	_, err = s.Render(context.Background(), strings.Repeat("a", textMaxSize+1), Options{})
+	assert.Equal(t, ErrTextTooLarge, err)
+
+	_, err = s.Render(context.Background(), "foo", Options{Repository: "foo"})
+	assert.Equal(t, ErrRepositoryInvalid, err)
+}
+
+func TestServiceRenderReferences(t *testing.T) {
+	users := &testUsers{}
+	s := NewService(users, testCommits{}, testIssues{}, testPullRequests{})
+
+	text := "Thanks @foo and @bar, not @baz or me@foo.com! See bar/baz#12, #3 and deadbeef or cafebabe.\n\n" +
+		"Not bar/baz#13, foo/baz#1 or #4.\n\n" +
+		"@foo again, `@foo` in code and [@bar](https://example.com) in a link."
+
+	html, err := s.Render(context.Background(), text, Options{})
+	require.NoError(t, err)
+	assert.Contains(t, html, `Thanks <a href="/foo" rel="nofollow">@foo</a> and <a href="/bar" rel="nofollow">@bar</a>, not @baz or me@foo.com!`)
+	assert.Contains(t, html, `See <a href="/bar/baz/issues/12" rel="nofollow">bar/baz#12</a>, #3 and deadbeef or cafebabe.`)
+	assert.Contains(t, html, "<p>Not bar/baz#13, foo/baz#1 or #4.</p>", "issues that don't exist aren't linked")
+	assert.Contains(t, html, "<code>@foo</code> in code")
+	assert.Contains(t, html, `<a href="https://example.com" rel="nofollow">@bar</a> in a link`)
+	assert.Equal(t, []string{"foo", "bar", "baz"}, users.found, "users are only looked up once")
+
+	html, err = s.Render(context.Background(), text, Options{Repository: "foo/bar"})
+	require.NoError(t, err)
+	assert.Contains(t, html, `<a href="/foo/bar/issues/3" rel="nofollow">#3</a>`)
+	assert.Contains(t, html, "or #4.")
+	assert.Contains(t, html, `<a href="/foo/bar/commit/deadbeef0123456789deadbeef0123456789abcd" rel="nofollow">deadbee</a> or cafebabe.`)
+
+	html, err = s.Render(context.Background(), "Fixed in #5 and foo/bar#5, not bar/baz#5.", Options{Repository: "foo/bar"})
+	require.NoError(t, err)
+	assert.Equal(t, `<p>Fixed in <a href="/foo/bar/pulls/5" rel="nofollow">#5</a> and <a href="/foo/bar/pulls/5" rel="nofollow">foo/bar#5</a>, not bar/baz#5.</p>`+"\n", html, "pull requests are numbered along with issues")
+
+	html, err = s.Render(context.Background(), "deadbeef", Options{Repository: "foo/private"})
+	require.NoError(t, err)
+	assert.Equal(t, "<p>deadbeef</p>\n", html)
+}
+
+func TestServiceRenderHighlighting(t *testing.T) {
+	s := NewService(&testUsers{}, testCommits{}, testIssues{}, testPullRequests{})
+
+	html, err := s.Render(context.Background(), "```go\nfunc main() {}\n```\n\n```unknown\n<b>@foo</b>\n```", Options{})
+	require.NoError(t, err)
+	assert.Contains(t, html, `<pre><code class="language-go"><span class="hl-line"><span class="hl-cl"><span class="hl-kd">func</span>`)
+	assert.Contains(t, html, `<pre><code class="language-unknown">&lt;b&gt;@foo&lt;/b&gt;`+"\n</code></pre>")
+}
+
+func TestServiceRenderLinks(t *testing.T) {
+	s := NewService(&testUsers{}, testCommits{}, testIssues{}, testPullRequests{})
+
+	text := "# Foo\n\n" +
+		"![logo](docs/logo.png) [docs](./docs/../docs/index.md#install) [up](../../etc/passwd) [svg](docs/logo.svg?sanitize=true#top)\n\n" +
+		"[site](https://example.com) [top](#foo) [mail](mailto:foo@example.com) <script>alert(1)</script> #3\n\n" +
+		"```go\nfunc main() {}\n```"
+
+	html, err := s.Render(context.Background(), text, Options{Repository: "foo/bar", Links: RelativeLinks("/api/foo/bar/raw/master/")})
+	require.NoError(t, err)
+	assert.Contains(t, html, "<h1>Foo</h1>")
+	assert.Contains(t, html, `<img src="/api/foo/bar/raw/master/docs/logo.png" alt="logo"`)
+	assert.Contains(t, html, `<a href="/api/foo/bar/raw/master/docs/index.md#install"`)
+	assert.Contains(t, html, `<a href="/api/foo/bar/raw/master/etc/passwd"`)
+	assert.Contains(t, html, `<a href="/api/foo/bar/raw/master/docs/logo.svg?sanitize=true#top"`)
+	assert.Contains(t, html, `<a href="https://example.com"`)
+	assert.Contains(t, html, `<a href="#foo"`)
+	assert.Contains(t, html, `<a href="mailto:foo@example.com"`)
+	assert.Contains(t, html, `<a href="/foo/bar/issues/3" rel="nofollow">#3</a>`, "references aren't rewritten")
+	assert.Contains(t, html, `<pre><code class="language-go">`)
+	assert.NotContains(t, html, "<script>")
+}
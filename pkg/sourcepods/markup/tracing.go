@@ -0,0 +1,30 @@
+package markup
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+//TracingRequestID returns the request ID as string for tracing
+type TracingRequestID func(context.Context) string
+
+type tracingService struct {
+	service   Service
+	requestID TracingRequestID
+}
+
+// NewTracingService wraps the Service and provides tracing for its methods.
+func NewTracingService(s Service, requestID TracingRequestID) Service {
+	return &tracingService{service: s, requestID: requestID}
+}
+
+func (s *tracingService) Render(ctx context.Context, text string, opts Options) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "markup.Service.Render")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("size", len(text))
+	span.SetTag("repository", opts.Repository)
+	defer span.Finish()
+
+	return s.service.Render(ctx, text, opts)
+}
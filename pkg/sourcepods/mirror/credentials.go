@@ -0,0 +1,50 @@
+package mirror
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ErrCredentialsNotFound returned if there are no credentials for a reference.
+var ErrCredentialsNotFound = errors.New("credentials not found")
+
+// credentialsReference is what owners and references to credentials may look like,
+// they're the names of directories and files with CredentialsDir.
+var credentialsReference = regexp.MustCompile(`^[a-zA-Z0-9_-][a-zA-Z0-9._-]*$`)
+
+// Credentials resolve the references of mirrors to the username and password to authenticate with,
+// so the secrets themselves are never stored with the mirrors.
+// References belong to the owner of the repository, mirrors of other owners' repositories can't use them.
+type Credentials interface {
+	Lookup(owner, reference string) (username, password string, err error)
+}
+
+// CredentialsDir looks up credentials in the files of a directory per owner named like their reference,
+// e.g. mounted secrets. Files contain the username and password separated by a colon
+// or only a password or token.
+type CredentialsDir string
+
+// Lookup the credentials in the file named reference in the owner's directory.
+func (d CredentialsDir) Lookup(owner, reference string) (string, string, error) {
+	if d == "" || !credentialsReference.MatchString(owner) || !credentialsReference.MatchString(reference) {
+		return "", "", ErrCredentialsNotFound
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(string(d), owner, reference))
+	if os.IsNotExist(err) {
+		return "", "", ErrCredentialsNotFound
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	credentials := strings.TrimSpace(string(content))
+	if i := strings.Index(credentials, ":"); i >= 0 {
+		return credentials[:i], credentials[i+1:], nil
+	}
+	return "", credentials, nil
+}
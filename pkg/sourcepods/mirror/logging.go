@@ -0,0 +1,138 @@
+package mirror
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+//LoggingRequestID returns the request ID as string for logging
+type LoggingRequestID func(context.Context) string
+
+type loggingService struct {
+	service   Service
+	requestID LoggingRequestID
+	logger    log.Logger
+}
+
+// NewLoggingService wraps the Service and provides logging for its methods.
+func NewLoggingService(s Service, requestID LoggingRequestID, logger log.Logger) Service {
+	return &loggingService{service: s, requestID: requestID, logger: logger}
+}
+
+func (s *loggingService) List(ctx context.Context, owner, name string) ([]*Mirror, error) {
+	start := time.Now()
+
+	mirrors, err := s.service.List(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "List",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list mirrors",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return mirrors, err
+}
+
+func (s *loggingService) Create(ctx context.Context, owner, name string, m *Mirror) (*Mirror, error) {
+	start := time.Now()
+
+	direction := m.Direction
+	m, err := s.service.Create(ctx, owner, name, m)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Create",
+		"owner", owner,
+		"name", name,
+		"direction", direction,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to create mirror",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return m, err
+}
+
+func (s *loggingService) Delete(ctx context.Context, owner, name, id string) error {
+	start := time.Now()
+
+	err := s.service.Delete(ctx, owner, name, id)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Delete",
+		"owner", owner,
+		"name", name,
+		"id", id,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to delete mirror",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) Sync(ctx context.Context, owner, name, id string) (*Mirror, error) {
+	start := time.Now()
+
+	m, err := s.service.Sync(ctx, owner, name, id)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Sync",
+		"owner", owner,
+		"name", name,
+		"id", id,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to sync mirror",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return m, err
+}
+
+func isUserError(err error) bool {
+	if _, ok := err.(ValidationErrors); ok {
+		return true
+	}
+	switch err {
+	case ErrRepositoryNotFound, ErrMirrorNotFound, ErrPullMirrorExists, ErrPermissionDenied:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package mirror
+
+import "time"
+
+// Directions repositories are mirrored in.
+const (
+	// DirectionPull fetches the remote's branches and tags into the repository.
+	DirectionPull = "pull"
+	// DirectionPush pushes all refs of the repository to the remote.
+	DirectionPush = "push"
+)
+
+// Mirror keeps a repository in sync with a remote by syncing it every Interval.
+type Mirror struct {
+	ID           string
+	RepositoryID string
+	// Repository is the mirrored repository as owner/name.
+	Repository string
+
+	Direction string
+	URL       string
+	// Credentials references the username and password to authenticate with, see Credentials.
+	Credentials string
+	Interval    time.Duration
+
+	NextSync    time.Time
+	LastSuccess time.Time
+	LastFailure time.Time
+	// LastError is why the last failed sync failed.
+	LastError string
+
+	Created time.Time
+}
+
+// Lag is how long ago the mirror was last synced successfully, or was created if it never was.
+func (m *Mirror) Lag(now time.Time) time.Duration {
+	if m.LastSuccess.IsZero() {
+		return now.Sub(m.Created)
+	}
+	return now.Sub(m.LastSuccess)
+}
@@ -0,0 +1,182 @@
+package mirror
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/go-kit/kit/metrics"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// schedulerInterval is how often the scheduler looks for mirrors due to be synced.
+	schedulerInterval = 30 * time.Second
+	// concurrentSyncs is how many mirrors are synced at once.
+	concurrentSyncs = 4
+)
+
+type (
+	// Storage syncs the repositories' git data with their remotes, like the storage.Client.
+	Storage interface {
+		Mirror(ctx context.Context, id string, opts storage.MirrorOptions) error
+	}
+
+	// Scheduler syncs mirrors every time their interval passed or when asked to.
+	Scheduler struct {
+		mirrors     Store
+		storage     Storage
+		credentials Credentials
+		lag         metrics.Gauge
+		logger      log.Logger
+
+		running chan struct{}
+		wg      sync.WaitGroup
+
+		mu      sync.Mutex
+		syncing map[string]bool
+	}
+)
+
+// NewScheduler returns a Scheduler syncing the mirrors once Run.
+// lag is set to the seconds since each mirror was last synced successfully,
+// labelled with its repository and direction.
+func NewScheduler(mirrors Store, storage Storage, credentials Credentials, lag metrics.Gauge, logger log.Logger) *Scheduler {
+	return &Scheduler{
+		mirrors:     mirrors,
+		storage:     storage,
+		credentials: credentials,
+		lag:         lag,
+		logger:      logger,
+		running:     make(chan struct{}, concurrentSyncs),
+		syncing:     map[string]bool{},
+	}
+}
+
+// Run syncs the mirrors that are due until ctx is cancelled,
+// it returns once the running syncs are done.
+func (s *Scheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(schedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		s.schedule(ctx)
+
+		select {
+		case <-ctx.Done():
+			s.wg.Wait()
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// schedule the syncs of the mirrors that are due and observe the lag of all mirrors.
+func (s *Scheduler) schedule(ctx context.Context) {
+	due, err := s.mirrors.Due(ctx)
+	if err != nil {
+		level.Warn(s.logger).Log("msg", "failed to find mirrors due to be synced", "err", err)
+	}
+	for _, m := range due {
+		s.start(ctx, m)
+	}
+
+	all, err := s.mirrors.All(ctx)
+	if err != nil {
+		level.Warn(s.logger).Log("msg", "failed to list mirrors", "err", err)
+		return
+	}
+	now := time.Now()
+	for _, m := range all {
+		s.observe(m, now)
+	}
+}
+
+// SyncNow syncs the mirror in the background, unless it's already being synced.
+func (s *Scheduler) SyncNow(m *Mirror) {
+	s.start(context.Background(), m)
+}
+
+func (s *Scheduler) start(ctx context.Context, m *Mirror) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.syncing[m.ID] {
+		return
+	}
+	s.syncing[m.ID] = true
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer func() {
+			s.mu.Lock()
+			delete(s.syncing, m.ID)
+			s.mu.Unlock()
+		}()
+
+		s.running <- struct{}{}
+		defer func() { <-s.running }()
+
+		s.Sync(ctx, m)
+	}()
+}
+
+// Sync the mirror with its remote now and store the outcome.
+func (s *Scheduler) Sync(ctx context.Context, m *Mirror) error {
+	logger := log.With(s.logger, "mirror", m.ID, "repository", m.Repository, "direction", m.Direction)
+
+	err := s.sync(ctx, m)
+
+	now := time.Now()
+	m.NextSync = now.Add(m.Interval)
+	if err != nil {
+		level.Info(logger).Log("msg", "failed to sync mirror", "err", err)
+
+		m.LastFailure = now
+		m.LastError = err.Error()
+		// Errors of the storage server's git are only the message of their status.
+		if st, ok := status.FromError(err); ok {
+			m.LastError = st.Message()
+		}
+	} else {
+		level.Debug(logger).Log("msg", "synced mirror")
+
+		m.LastSuccess = now
+		m.LastError = ""
+	}
+
+	if err := s.mirrors.Update(ctx, m); err != nil {
+		level.Warn(logger).Log("msg", "failed to update mirror", "err", err)
+	}
+	s.observe(m, now)
+
+	return err
+}
+
+func (s *Scheduler) sync(ctx context.Context, m *Mirror) error {
+	opts := storage.MirrorOptions{
+		Direction: m.Direction,
+		URL:       m.URL,
+	}
+	if m.Credentials != "" {
+		// The credentials are the current owner's, they aren't kept when the repository is transferred.
+		owner := strings.SplitN(m.Repository, "/", 2)[0]
+		username, password, err := s.credentials.Lookup(owner, m.Credentials)
+		if err != nil {
+			return err
+		}
+		opts.Username = username
+		opts.Password = password
+	}
+
+	return s.storage.Mirror(ctx, m.RepositoryID, opts)
+}
+
+func (s *Scheduler) observe(m *Mirror, now time.Time) {
+	s.lag.With("repository", m.Repository, "direction", m.Direction).Set(m.Lag(now).Seconds())
+}
@@ -0,0 +1,193 @@
+package mirror
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+)
+
+var (
+	// ErrRepositoryNotFound returned if the repository of a mirror is not found.
+	ErrRepositoryNotFound = errors.New("repository not found")
+
+	// ErrMirrorNotFound returned if a mirror is not found for the repository.
+	ErrMirrorNotFound = errors.New("mirror not found")
+
+	// ErrPullMirrorExists returned if a repository should pull from more than one remote.
+	ErrPullMirrorExists = errors.New("repository already pulls from a mirror")
+
+	// ErrPermissionDenied returned if the user isn't allowed to manage the repository's mirrors.
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+type (
+	// Store or retrieve mirrors from some database.
+	Store interface {
+		List(ctx context.Context, repositoryID string) ([]*Mirror, error)
+		All(ctx context.Context) ([]*Mirror, error)
+		Find(ctx context.Context, id string) (*Mirror, error)
+		Create(ctx context.Context, m *Mirror) (*Mirror, error)
+		Update(ctx context.Context, m *Mirror) error
+		Delete(ctx context.Context, id string) error
+		// Due returns the mirrors due to be synced and schedules their next sync,
+		// so they're only returned once per interval.
+		Due(ctx context.Context) ([]*Mirror, error)
+	}
+
+	// Repositories finds the repositories visible to the session's user.
+	Repositories interface {
+		Find(ctx context.Context, owner, name string) (*repository.Repository, string, error)
+	}
+
+	// Syncer syncs mirrors in the background, like the Scheduler.
+	Syncer interface {
+		SyncNow(m *Mirror)
+	}
+
+	// Service to manage the mirrors of repositories.
+	Service interface {
+		List(ctx context.Context, owner, name string) ([]*Mirror, error)
+		Create(ctx context.Context, owner, name string, m *Mirror) (*Mirror, error)
+		Delete(ctx context.Context, owner, name, id string) error
+		Sync(ctx context.Context, owner, name, id string) (*Mirror, error)
+	}
+
+	service struct {
+		mirrors      Store
+		repositories Repositories
+		credentials  Credentials
+		syncer       Syncer
+	}
+)
+
+// NewService to manage the mirrors of repositories.
+func NewService(mirrors Store, repositories Repositories, credentials Credentials, syncer Syncer) Service {
+	return &service{
+		mirrors:      mirrors,
+		repositories: repositories,
+		credentials:  credentials,
+		syncer:       syncer,
+	}
+}
+
+// findRepository finds the repository if the session's user is its owner,
+// mirrors reveal the remotes and credentials references of repositories.
+func (s *service) findRepository(ctx context.Context, owner, name string) (*repository.Repository, error) {
+	r, _, err := s.repositories.Find(ctx, owner, name)
+	if err != nil {
+		if err == repository.ErrRepositoryNotFound {
+			return nil, ErrRepositoryNotFound
+		}
+		return nil, err
+	}
+
+	u := session.GetSessionUser(ctx)
+	if u == nil || u.Username != owner {
+		return nil, ErrPermissionDenied
+	}
+
+	return r, nil
+}
+
+// List the mirrors of the repository.
+func (s *service) List(ctx context.Context, owner, name string) ([]*Mirror, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.mirrors.List(ctx, r.ID)
+}
+
+// Create a mirror of the repository, it's synced for the first time right away.
+// A repository can pull from one remote only, but push to many.
+func (s *service) Create(ctx context.Context, owner, name string, m *Mirror) (*Mirror, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Interval == 0 {
+		m.Interval = DefaultInterval
+	}
+	if err := ValidateCreate(m); err != nil {
+		return nil, err
+	}
+	if m.Credentials != "" {
+		if _, _, err := s.credentials.Lookup(owner, m.Credentials); err != nil {
+			return nil, ValidationErrors{Errors: []ValidationError{{
+				Field: "credentials",
+				Error: err,
+			}}}
+		}
+	}
+
+	if m.Direction == DirectionPull {
+		mirrors, err := s.mirrors.List(ctx, r.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, existing := range mirrors {
+			if existing.Direction == DirectionPull {
+				return nil, ErrPullMirrorExists
+			}
+		}
+	}
+
+	m.RepositoryID = r.ID
+	m.Repository = owner + "/" + r.Name
+
+	m, err = s.mirrors.Create(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	// The sync updates its own copy, the returned mirror isn't changed concurrently.
+	sync := *m
+	s.syncer.SyncNow(&sync)
+
+	return m, nil
+}
+
+// Delete the mirror of the repository, the remote is left as it is.
+func (s *service) Delete(ctx context.Context, owner, name, id string) error {
+	m, err := s.find(ctx, owner, name, id)
+	if err != nil {
+		return err
+	}
+
+	return s.mirrors.Delete(ctx, m.ID)
+}
+
+// Sync the mirror now instead of waiting for its interval to pass.
+// It's synced in the background, the returned mirror doesn't have the outcome yet.
+func (s *service) Sync(ctx context.Context, owner, name, id string) (*Mirror, error) {
+	m, err := s.find(ctx, owner, name, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sync := *m
+	s.syncer.SyncNow(&sync)
+
+	return m, nil
+}
+
+func (s *service) find(ctx context.Context, owner, name, id string) (*Mirror, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := s.mirrors.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if m.RepositoryID != r.ID {
+		return nil, ErrMirrorNotFound
+	}
+
+	return m, nil
+}
@@ -0,0 +1,229 @@
+package mirror
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Postgres implementation of the Store.
+type Postgres struct {
+	db *sql.DB
+}
+
+// NewPostgresStore returns a Postgres implementation of the Store.
+func NewPostgresStore(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+const selectMirrors = `
+SELECT
+	m.id,
+	m.repository_id,
+	u.username || '/' || r.name,
+	m.direction,
+	m.url,
+	m.credentials,
+	m.interval_seconds,
+	m.next_sync_at,
+	m.last_success_at,
+	m.last_failure_at,
+	m.last_error,
+	m.created_at
+FROM %s m
+JOIN repositories r ON r.id = m.repository_id
+JOIN users u ON u.id = r.owner_id
+`
+
+func scanMirror(row interface{ Scan(...interface{}) error }) (*Mirror, error) {
+	var interval int64
+	var success, failure pq.NullTime
+	m := &Mirror{}
+
+	if err := row.Scan(
+		&m.ID,
+		&m.RepositoryID,
+		&m.Repository,
+		&m.Direction,
+		&m.URL,
+		&m.Credentials,
+		&interval,
+		&m.NextSync,
+		&success,
+		&failure,
+		&m.LastError,
+		&m.Created,
+	); err != nil {
+		return nil, err
+	}
+
+	m.Interval = time.Duration(interval) * time.Second
+	if success.Valid {
+		m.LastSuccess = success.Time
+	}
+	if failure.Valid {
+		m.LastFailure = failure.Time
+	}
+
+	return m, nil
+}
+
+func scanMirrors(rows *sql.Rows) ([]*Mirror, error) {
+	defer rows.Close()
+
+	var mirrors []*Mirror
+	for rows.Next() {
+		m, err := scanMirror(rows)
+		if err != nil {
+			return nil, err
+		}
+		mirrors = append(mirrors, m)
+	}
+
+	return mirrors, rows.Err()
+}
+
+// List the mirrors of a repository, oldest first.
+func (s *Postgres) List(ctx context.Context, repositoryID string) ([]*Mirror, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mirror.Postgres.List")
+	span.SetTag("repository", repositoryID)
+	defer span.Finish()
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(selectMirrors, "mirrors")+`
+WHERE m.repository_id = $1
+ORDER BY m.created_at, m.id;
+`, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMirrors(rows)
+}
+
+// All mirrors of all repositories.
+func (s *Postgres) All(ctx context.Context) ([]*Mirror, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mirror.Postgres.All")
+	defer span.Finish()
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(selectMirrors, "mirrors")+`
+ORDER BY m.created_at, m.id;
+`)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMirrors(rows)
+}
+
+// Find the mirror by its id.
+func (s *Postgres) Find(ctx context.Context, id string) (*Mirror, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mirror.Postgres.Find")
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	if !govalidator.IsUUID(id) {
+		return nil, ErrMirrorNotFound
+	}
+
+	m, err := scanMirror(s.db.QueryRowContext(ctx, fmt.Sprintf(selectMirrors, "mirrors")+`
+WHERE m.id = $1;
+`, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrMirrorNotFound
+	}
+	return m, err
+}
+
+// Create a mirror of a repository, it's due to be synced right away.
+// This func returns the created mirror or an error.
+func (s *Postgres) Create(ctx context.Context, m *Mirror) (*Mirror, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mirror.Postgres.Create")
+	span.SetTag("repository", m.RepositoryID)
+	span.SetTag("direction", m.Direction)
+	defer span.Finish()
+
+	create := `
+INSERT INTO mirrors (repository_id, direction, url, credentials, interval_seconds)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, next_sync_at, created_at;
+`
+
+	row := s.db.QueryRowContext(ctx, create,
+		m.RepositoryID,
+		m.Direction,
+		m.URL,
+		m.Credentials,
+		int64(m.Interval/time.Second),
+	)
+	if err := row.Scan(&m.ID, &m.NextSync, &m.Created); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Update the next sync and the outcome of the last syncs of the mirror.
+func (s *Postgres) Update(ctx context.Context, m *Mirror) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mirror.Postgres.Update")
+	span.SetTag("id", m.ID)
+	defer span.Finish()
+
+	var success, failure *time.Time
+	if !m.LastSuccess.IsZero() {
+		success = &m.LastSuccess
+	}
+	if !m.LastFailure.IsZero() {
+		failure = &m.LastFailure
+	}
+
+	update := `
+UPDATE mirrors
+SET next_sync_at = $2, last_success_at = $3, last_failure_at = $4, last_error = $5
+WHERE id = $1;
+`
+
+	res, err := s.db.ExecContext(ctx, update, m.ID, m.NextSync, success, failure, m.LastError)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrMirrorNotFound
+	}
+
+	return nil
+}
+
+// Delete the mirror.
+func (s *Postgres) Delete(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mirror.Postgres.Delete")
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	_, err := s.db.ExecContext(ctx, `DELETE FROM mirrors WHERE id = $1;`, id)
+	return err
+}
+
+// Due returns the mirrors whose next sync has passed and schedules their next sync after their interval.
+// Updating and returning them at once makes sure they are only synced once, even by many schedulers.
+func (s *Postgres) Due(ctx context.Context) ([]*Mirror, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mirror.Postgres.Due")
+	defer span.Finish()
+
+	rows, err := s.db.QueryContext(ctx, `
+WITH due AS (
+	UPDATE mirrors
+	SET next_sync_at = now() + interval_seconds * INTERVAL '1 second'
+	WHERE next_sync_at <= now()
+	RETURNING *
+)`+fmt.Sprintf(selectMirrors, "due")+`;`)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanMirrors(rows)
+}
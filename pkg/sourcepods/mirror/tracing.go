@@ -0,0 +1,63 @@
+package mirror
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+//TracingRequestID returns the request ID as string for tracing
+type TracingRequestID func(context.Context) string
+
+type tracingService struct {
+	service   Service
+	requestID TracingRequestID
+}
+
+// NewTracingService wraps the Service and provides tracing for its methods.
+func NewTracingService(s Service, requestID TracingRequestID) Service {
+	return &tracingService{service: s, requestID: requestID}
+}
+
+func (s *tracingService) List(ctx context.Context, owner, name string) ([]*Mirror, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mirror.Service.List")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.List(ctx, owner, name)
+}
+
+func (s *tracingService) Create(ctx context.Context, owner, name string, m *Mirror) (*Mirror, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mirror.Service.Create")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("direction", m.Direction)
+	defer span.Finish()
+
+	return s.service.Create(ctx, owner, name, m)
+}
+
+func (s *tracingService) Delete(ctx context.Context, owner, name, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mirror.Service.Delete")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	return s.service.Delete(ctx, owner, name, id)
+}
+
+func (s *tracingService) Sync(ctx context.Context, owner, name, id string) (*Mirror, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "mirror.Service.Sync")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	return s.service.Sync(ctx, owner, name, id)
+}
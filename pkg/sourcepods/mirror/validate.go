@@ -0,0 +1,75 @@
+package mirror
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+type (
+	//ValidationErrors are returned with a slice of all invalid fields
+	ValidationErrors struct {
+		Errors []ValidationError
+	}
+	//ValidationError knows for a given field the error
+	ValidationError struct {
+		Field string
+		Error error
+	}
+)
+
+func (e ValidationErrors) Error() string {
+	return fmt.Sprintf("there are %d validation errors", len(e.Errors))
+}
+
+const (
+	// DefaultInterval is used for mirrors created without an interval.
+	DefaultInterval = time.Hour
+	// MinInterval is the shortest interval mirrors are synced in.
+	MinInterval = 5 * time.Minute
+)
+
+// ValidateCreate takes a Mirror and validates its fields.
+func ValidateCreate(m *Mirror) error {
+	var errs ValidationErrors
+
+	if m.Direction != DirectionPull && m.Direction != DirectionPush {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "direction",
+			Error: fmt.Errorf("direction must be either pull or push"),
+		})
+	}
+
+	u, err := url.Parse(m.URL)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "git") {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "url",
+			Error: fmt.Errorf("url is not a http, https or git URL"),
+		})
+	} else if u.User != nil {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "url",
+			Error: fmt.Errorf("url must not contain credentials, reference them with credentials instead"),
+		})
+	}
+
+	if m.Credentials != "" && !credentialsReference.MatchString(m.Credentials) {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "credentials",
+			Error: fmt.Errorf("credentials may only contain letters, digits, dots, dashes and underscores"),
+		})
+	}
+
+	if m.Interval < MinInterval {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "interval",
+			Error: fmt.Errorf("interval must be at least %d seconds", int(MinInterval/time.Second)),
+		})
+	}
+
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+
+	return nil
+}
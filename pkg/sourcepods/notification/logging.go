@@ -0,0 +1,176 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/event"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+)
+
+//LoggingRequestID returns the request ID as string for logging
+type LoggingRequestID func(context.Context) string
+
+type loggingService struct {
+	service   Service
+	requestID LoggingRequestID
+	logger    log.Logger
+}
+
+// NewLoggingService wraps the Service and provides logging for its methods.
+func NewLoggingService(s Service, requestID LoggingRequestID, logger log.Logger) Service {
+	return &loggingService{service: s, requestID: requestID, logger: logger}
+}
+
+func (s *loggingService) Notify(ctx context.Context, e *event.Event) error {
+	start := time.Now()
+
+	err := s.service.Notify(ctx, e)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Notify",
+		"event", e.ID,
+		"type", e.Type,
+		"duration", time.Since(start),
+	)
+
+	if err != nil {
+		level.Warn(logger).Log(
+			"msg", "failed to notify about event",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) List(ctx context.Context, opts ListOptions) ([]*Notification, string, error) {
+	start := time.Now()
+
+	notifications, next, err := s.service.List(ctx, opts)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "List",
+		"all", opts.All,
+		"cursor", opts.Page.Cursor,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list notifications",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return notifications, next, err
+}
+
+func (s *loggingService) MarkRead(ctx context.Context, id string) error {
+	start := time.Now()
+
+	err := s.service.MarkRead(ctx, id)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "MarkRead",
+		"id", id,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to mark notification as read",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) MarkAllRead(ctx context.Context) error {
+	start := time.Now()
+
+	err := s.service.MarkAllRead(ctx)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "MarkAllRead",
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to mark all notifications as read",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) Preferences(ctx context.Context) (Preferences, error) {
+	start := time.Now()
+
+	p, err := s.service.Preferences(ctx)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Preferences",
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to get notification preferences",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return p, err
+}
+
+func (s *loggingService) UpdatePreferences(ctx context.Context, p Preferences) (Preferences, error) {
+	start := time.Now()
+
+	p, err := s.service.UpdatePreferences(ctx, p)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "UpdatePreferences",
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to update notification preferences",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return p, err
+}
+
+func isUserError(err error) bool {
+	switch err {
+	case ErrNotFound, ErrReasonInvalid, ErrPermissionDenied, pagination.ErrCursorInvalid:
+		return true
+	}
+	return false
+}
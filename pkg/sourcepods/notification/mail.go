@@ -0,0 +1,188 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/event"
+)
+
+// Sender sends emails, like the SMTPSender.
+// The message has its headers and body, from and to are the addresses of the envelope.
+type Sender interface {
+	Send(from string, to []string, msg []byte) error
+}
+
+// SMTPSender sends emails with the SMTP server at Addr,
+// which can be a local stand-in like MailHog in development.
+type SMTPSender struct {
+	Addr string
+	// Auth is nil if the server doesn't require authentication.
+	Auth smtp.Auth
+}
+
+// Send the email with the SMTP server.
+func (s SMTPSender) Send(from string, to []string, msg []byte) error {
+	return smtp.SendMail(s.Addr, s.Auth, from, to, msg)
+}
+
+// Mailer emails every user a digest of their notifications every interval,
+// instead of an email per notification.
+type Mailer struct {
+	notifications Store
+	sender        Sender
+	from          *mail.Address
+	interval      time.Duration
+	logger        log.Logger
+}
+
+// NewMailer returns a Mailer sending digests from the address, like "SourcePods <notifications@example.com>".
+func NewMailer(notifications Store, sender Sender, from string, interval time.Duration, logger log.Logger) (*Mailer, error) {
+	addr, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from address: %v", err)
+	}
+
+	return &Mailer{
+		notifications: notifications,
+		sender:        sender,
+		from:          addr,
+		interval:      interval,
+		logger:        logger,
+	}, nil
+}
+
+// Run sends the digests every interval until ctx is cancelled.
+func (m *Mailer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := m.SendDigests(ctx); err != nil {
+				level.Warn(m.logger).Log("msg", "failed to send notification digests", "err", err)
+			}
+		}
+	}
+}
+
+// SendDigests emails the notifications that haven't been emailed yet, a digest per user.
+// Notifications of digests that fail to be sent are tried again with the next ones.
+func (m *Mailer) SendDigests(ctx context.Context) error {
+	digests, err := m.notifications.Digests(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range digests {
+		msg := m.message(d, time.Now())
+		if err := m.sender.Send(m.from.Address, []string{d.Email}, msg); err != nil {
+			level.Warn(m.logger).Log("msg", "failed to send notification digest", "user", d.Username, "err", err)
+			continue
+		}
+
+		ids := make([]string, 0, len(d.Notifications))
+		for _, n := range d.Notifications {
+			ids = append(ids, n.ID)
+		}
+		if err := m.notifications.MarkEmailed(ctx, ids); err != nil {
+			return err
+		}
+
+		level.Debug(m.logger).Log("msg", "sent notification digest", "user", d.Username, "notifications", len(ids))
+	}
+
+	return nil
+}
+
+// message returns the digest as plain text email, the notifications grouped by their repository.
+func (m *Mailer) message(d *Digest, now time.Time) []byte {
+	subject := "1 new notification"
+	if len(d.Notifications) != 1 {
+		subject = fmt.Sprintf("%d new notifications", len(d.Notifications))
+	}
+
+	var groups []string
+	grouped := map[string][]*Notification{}
+	for _, n := range d.Notifications {
+		group := n.Event.Repository
+		if group == "" {
+			group = "Your account"
+		}
+		if _, ok := grouped[group]; !ok {
+			groups = append(groups, group)
+		}
+		grouped[group] = append(grouped[group], n)
+	}
+
+	to := mail.Address{Name: d.Username, Address: d.Email}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\n", m.from.String())
+	fmt.Fprintf(&b, "To: %s\n", to.String())
+	fmt.Fprintf(&b, "Subject: %s\n", mime.QEncoding.Encode("utf-8", "[SourcePods] "+subject))
+	fmt.Fprintf(&b, "Date: %s\n", now.Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "MIME-Version: 1.0\n")
+	fmt.Fprintf(&b, "Content-Type: text/plain; charset=utf-8\n")
+	fmt.Fprintf(&b, "\n")
+	fmt.Fprintf(&b, "Hi %s,\n\nthis happened since your last digest:\n", d.Username)
+	for _, group := range groups {
+		fmt.Fprintf(&b, "\n%s\n", group)
+		for _, n := range grouped[group] {
+			fmt.Fprintf(&b, "  - %s (%s)\n", summary(n), n.Created.UTC().Format("Jan 2 15:04 MST"))
+		}
+	}
+	fmt.Fprintf(&b, "\nYou can choose which notifications are emailed to you in your notification preferences.\n")
+
+	return b.Bytes()
+}
+
+// summary describes what the notification is about in a line.
+func summary(n *Notification) string {
+	e := n.Event
+
+	actor := e.Actor
+	if actor == "" {
+		actor = "Someone"
+	}
+	number, title := e.Data["number"], e.Data["title"]
+
+	var s string
+	switch e.Type {
+	case event.TypeLoginSucceeded:
+		return "New login to your account"
+	case event.TypePush:
+		s = fmt.Sprintf("%s pushed", actor)
+	case event.TypeIssueOpened:
+		s = fmt.Sprintf("%s opened issue #%v: %v", actor, number, title)
+	case event.TypeIssueClosed:
+		s = fmt.Sprintf("%s closed issue #%v: %v", actor, number, title)
+	case event.TypeIssueCommented:
+		s = fmt.Sprintf("%s commented on issue #%v: %v", actor, number, title)
+	case event.TypePullRequestOpened:
+		s = fmt.Sprintf("%s opened pull request #%v: %v", actor, number, title)
+	case event.TypePullRequestClosed:
+		s = fmt.Sprintf("%s closed pull request #%v: %v", actor, number, title)
+	case event.TypePullRequestMerged:
+		s = fmt.Sprintf("%s merged pull request #%v: %v", actor, number, title)
+	case event.TypePullRequestCommented:
+		s = fmt.Sprintf("%s commented on pull request #%v: %v", actor, number, title)
+	default:
+		s = fmt.Sprintf("%s: %s", actor, e.Type)
+	}
+
+	if n.Reason == ReasonMention {
+		s += ", mentioning you"
+	}
+	return s
+}
@@ -0,0 +1,184 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// smtpServer is a local stand-in for an SMTP server, it keeps the emails it receives.
+type smtpServer struct {
+	listener net.Listener
+
+	mu     sync.Mutex
+	emails []smtpEmail
+}
+
+type smtpEmail struct {
+	From string
+	To   []string
+	Data string
+}
+
+func newSMTPServer(t *testing.T) *smtpServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &smtpServer{listener: l}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *smtpServer) Close() error {
+	return s.listener.Close()
+}
+
+func (s *smtpServer) Emails() []smtpEmail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.emails
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	c := textproto.NewConn(conn)
+	c.PrintfLine("220 localhost ESMTP")
+
+	var email smtpEmail
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			c.PrintfLine("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			email = smtpEmail{From: strings.Trim(line[len("MAIL FROM:"):], "<>")}
+			c.PrintfLine("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			email.To = append(email.To, strings.Trim(line[len("RCPT TO:"):], "<>"))
+			c.PrintfLine("250 OK")
+		case command == "DATA":
+			c.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			email.Data = string(data)
+			s.mu.Lock()
+			s.emails = append(s.emails, email)
+			s.mu.Unlock()
+			c.PrintfLine("250 OK")
+		case command == "QUIT":
+			c.PrintfLine("221 Bye")
+			return
+		default:
+			c.PrintfLine("250 OK")
+		}
+	}
+}
+
+type failingSender struct{}
+
+func (failingSender) Send(from string, to []string, msg []byte) error {
+	return errors.New("connection refused")
+}
+
+type testDigestStore struct {
+	Store
+	digests []*Digest
+	emailed []string
+}
+
+func (s *testDigestStore) Digests(ctx context.Context) ([]*Digest, error) {
+	return s.digests, nil
+}
+
+func (s *testDigestStore) MarkEmailed(ctx context.Context, ids []string) error {
+	s.emailed = append(s.emailed, ids...)
+	return nil
+}
+
+func newTestDigestStore() *testDigestStore {
+	created := time.Date(2019, 2, 19, 10, 0, 0, 0, time.UTC)
+	return &testDigestStore{digests: []*Digest{{
+		UserID:   "foo-id",
+		Username: "foo",
+		Email:    "foo@example.com",
+		Notifications: []*Notification{{
+			ID:      "notification1",
+			Reason:  ReasonMention,
+			Created: created,
+			Event: &event.Event{
+				Type:       event.TypeIssueOpened,
+				Actor:      "bar",
+				Repository: "foo/bar",
+				Data:       map[string]interface{}{"number": float64(3), "title": "It's broken"},
+			},
+		}, {
+			ID:      "notification2",
+			Reason:  ReasonSecurity,
+			Created: created,
+			Event:   &event.Event{Type: event.TypeLoginSucceeded, Actor: "foo"},
+		}},
+	}}}
+}
+
+func TestMailerSendDigests(t *testing.T) {
+	server := newSMTPServer(t)
+	defer server.Close()
+
+	store := newTestDigestStore()
+	m, err := NewMailer(store, SMTPSender{Addr: server.Addr()}, "SourcePods <notifications@example.com>", time.Hour, log.NewNopLogger())
+	require.NoError(t, err)
+
+	require.NoError(t, m.SendDigests(context.Background()))
+	assert.Equal(t, []string{"notification1", "notification2"}, store.emailed)
+
+	emails := server.Emails()
+	require.Len(t, emails, 1)
+	assert.Equal(t, "notifications@example.com", emails[0].From)
+	assert.Equal(t, []string{"foo@example.com"}, emails[0].To)
+	assert.Contains(t, emails[0].Data, "Subject: [SourcePods] 2 new notifications\n")
+	assert.Contains(t, emails[0].Data, "To: \"foo\" <foo@example.com>\n")
+	assert.Contains(t, emails[0].Data, "foo/bar\n  - bar opened issue #3: It's broken, mentioning you (Feb 19 10:00 UTC)\n")
+	assert.Contains(t, emails[0].Data, "Your account\n  - New login to your account (Feb 19 10:00 UTC)\n")
+}
+
+func TestMailerSendDigestsFailed(t *testing.T) {
+	store := newTestDigestStore()
+	m, err := NewMailer(store, failingSender{}, "notifications@example.com", time.Hour, log.NewNopLogger())
+	require.NoError(t, err)
+
+	require.NoError(t, m.SendDigests(context.Background()))
+	assert.Len(t, store.emailed, 0, "notifications are emailed with the next digests")
+
+	_, err = NewMailer(store, failingSender{}, "not an address", time.Hour, log.NewNopLogger())
+	assert.Error(t, err)
+}
@@ -0,0 +1,79 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/event"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+)
+
+// Reasons users are notified about events for.
+const (
+	// ReasonSecurity notifies users about logins to their account.
+	ReasonSecurity = "security"
+	// ReasonMention notifies users mentioned in issues, pull requests and their comments.
+	ReasonMention = "mention"
+	// ReasonAuthor notifies users about activity on the issues and pull requests they opened.
+	ReasonAuthor = "author"
+	// ReasonWatching notifies users about pushes, issues and pull requests of the repositories they watch.
+	ReasonWatching = "watching"
+)
+
+// Reasons lists all reasons, a user notified for more than one reason of an event is notified for the first.
+var Reasons = []string{ReasonSecurity, ReasonMention, ReasonAuthor, ReasonWatching}
+
+// Notification tells a user about an event.
+type Notification struct {
+	ID     string
+	UserID string
+	Reason string
+	Event  *event.Event
+
+	// Read is when the user read the notification, zero if it's unread.
+	Read    time.Time
+	Created time.Time
+}
+
+// Delivery chooses the channels notifications are delivered to.
+type Delivery struct {
+	// Web lists the notifications in the user's inbox.
+	Web bool
+	// Email sends the notifications to the user's email address, batched in digests.
+	Email bool
+}
+
+// Preferences choose the delivery of a user's notifications by their reason.
+type Preferences map[string]Delivery
+
+// DefaultPreferences are used for the reasons a user hasn't chosen a delivery for.
+// Everything is listed in the inbox, only what's addressed to the user personally is emailed.
+func DefaultPreferences() Preferences {
+	return Preferences{
+		ReasonSecurity: {Web: true, Email: true},
+		ReasonMention:  {Web: true, Email: true},
+		ReasonAuthor:   {Web: true, Email: false},
+		ReasonWatching: {Web: true, Email: false},
+	}
+}
+
+// Recipient is notified about an event for a reason, delivered as they prefer.
+type Recipient struct {
+	UserID string
+	Reason string
+	Delivery
+}
+
+// ListOptions filter and page a user's notifications, most recent first.
+type ListOptions struct {
+	// All lists read notifications too, only unread ones are listed otherwise.
+	All  bool
+	Page pagination.Options
+}
+
+// Digest batches the notifications emailed to a user at once.
+type Digest struct {
+	UserID        string
+	Username      string
+	Email         string
+	Notifications []*Notification
+}
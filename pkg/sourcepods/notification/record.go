@@ -0,0 +1,28 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/event"
+)
+
+type eventService struct {
+	event.Service
+	notifier Notifier
+}
+
+// NewEventService wraps the event.Service and notifies users about every event once it's been recorded.
+// Errors of the Notifier are ignored like the Recorder's, wrap it with the logging service to know about them.
+func NewEventService(s event.Service, notifier Notifier) event.Service {
+	return &eventService{Service: s, notifier: notifier}
+}
+
+func (s *eventService) Record(ctx context.Context, e *event.Event) error {
+	if err := s.Service.Record(ctx, e); err != nil {
+		return err
+	}
+
+	s.notifier.Notify(ctx, e)
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/event"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+)
+
+var (
+	// ErrNotFound returned if the notification doesn't exist or isn't the session's user's.
+	ErrNotFound = errors.New("notification not found")
+
+	// ErrReasonInvalid returned if preferences are updated for an unknown reason.
+	ErrReasonInvalid = errors.New("notification reason is invalid")
+
+	// ErrPermissionDenied returned if there's no session's user.
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+type (
+	// Store notifications and the users' preferences in some database.
+	Store interface {
+		Create(ctx context.Context, eventID string, recipients []Recipient) error
+		List(ctx context.Context, userID string, opts ListOptions) ([]*Notification, string, error)
+		MarkRead(ctx context.Context, userID, id string) error
+		MarkAllRead(ctx context.Context, userID string) error
+		Preferences(ctx context.Context, userIDs []string) (map[string]Preferences, error)
+		UpdatePreferences(ctx context.Context, userID string, p Preferences) error
+		Digests(ctx context.Context) ([]*Digest, error)
+		MarkEmailed(ctx context.Context, ids []string) error
+	}
+
+	// Watchers lists the users watching a repository.
+	Watchers interface {
+		ListWatchers(ctx context.Context, id string) ([]*repository.Watcher, error)
+	}
+
+	// Users finds mentioned users and the owners of repositories.
+	Users interface {
+		FindByUsername(ctx context.Context, username string) (*user.User, error)
+		FindRepositoryOwner(ctx context.Context, repositoryID string) (*user.User, error)
+	}
+
+	// Notifier notifies users about events.
+	Notifier interface {
+		Notify(ctx context.Context, e *event.Event) error
+	}
+
+	// Service to notify users about events and to manage the inbox of the session's user.
+	Service interface {
+		Notifier
+		List(ctx context.Context, opts ListOptions) ([]*Notification, string, error)
+		MarkRead(ctx context.Context, id string) error
+		MarkAllRead(ctx context.Context) error
+		Preferences(ctx context.Context) (Preferences, error)
+		UpdatePreferences(ctx context.Context, p Preferences) (Preferences, error)
+	}
+
+	service struct {
+		notifications Store
+		watchers      Watchers
+		users         Users
+	}
+)
+
+// NewService to notify users and to manage their inbox.
+func NewService(notifications Store, watchers Watchers, users Users) Service {
+	return &service{
+		notifications: notifications,
+		watchers:      watchers,
+		users:         users,
+	}
+}
+
+// Notify the users concerned by the recorded event, as they prefer it delivered.
+// Users aren't notified about what they did themselves,
+// nor about events of private repositories other than their own.
+func (s *service) Notify(ctx context.Context, e *event.Event) error {
+	reasons := map[string]string{}
+	var userIDs []string
+	add := func(userID, reason string) {
+		if userID == "" || reasons[userID] != "" {
+			return
+		}
+		reasons[userID] = reason
+		userIDs = append(userIDs, userID)
+	}
+
+	switch e.Type {
+	case event.TypeLoginSucceeded:
+		add(e.ActorID, ReasonSecurity)
+	case event.TypePush:
+		if err := s.addWatchers(ctx, e, add); err != nil {
+			return err
+		}
+	case event.TypeIssueOpened, event.TypeIssueClosed, event.TypeIssueCommented,
+		event.TypePullRequestOpened, event.TypePullRequestClosed, event.TypePullRequestMerged, event.TypePullRequestCommented:
+		for _, username := range dataStrings(e.Data["mentions"]) {
+			u, err := s.users.FindByUsername(ctx, username)
+			if err == user.ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if u.ID != e.ActorID {
+				add(u.ID, ReasonMention)
+			}
+		}
+		if authorID, _ := e.Data["author_id"].(string); authorID != e.ActorID {
+			add(authorID, ReasonAuthor)
+		}
+		if err := s.addWatchers(ctx, e, add); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	// Only the owner can see a private repository.
+	if e.RepositoryID != "" && !e.Public {
+		owner, err := s.users.FindRepositoryOwner(ctx, e.RepositoryID)
+		if err != nil {
+			return err
+		}
+		visible := userIDs[:0]
+		for _, id := range userIDs {
+			if id == owner.ID {
+				visible = append(visible, id)
+			}
+		}
+		userIDs = visible
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	preferences, err := s.notifications.Preferences(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+
+	var recipients []Recipient
+	for _, id := range userIDs {
+		delivery := withDefaults(preferences[id])[reasons[id]]
+		if !delivery.Web && !delivery.Email {
+			continue
+		}
+		recipients = append(recipients, Recipient{UserID: id, Reason: reasons[id], Delivery: delivery})
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	return s.notifications.Create(ctx, e.ID, recipients)
+}
+
+func (s *service) addWatchers(ctx context.Context, e *event.Event, add func(userID, reason string)) error {
+	watchers, err := s.watchers.ListWatchers(ctx, e.RepositoryID)
+	if err != nil {
+		return err
+	}
+	for _, w := range watchers {
+		if w.UserID != e.ActorID {
+			add(w.UserID, ReasonWatching)
+		}
+	}
+	return nil
+}
+
+// dataStrings returns the strings of an event's data,
+// which are []interface{} once the event has been read from the store.
+func dataStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case []string:
+		return v
+	case []interface{}:
+		var strs []string
+		for _, s := range v {
+			if s, ok := s.(string); ok {
+				strs = append(strs, s)
+			}
+		}
+		return strs
+	}
+	return nil
+}
+
+// List a page of the session's user's notifications delivered to the inbox, most recent first.
+func (s *service) List(ctx context.Context, opts ListOptions) ([]*Notification, string, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return nil, "", ErrPermissionDenied
+	}
+
+	return s.notifications.List(ctx, u.ID, opts)
+}
+
+// MarkRead marks one of the session's user's notifications as read.
+func (s *service) MarkRead(ctx context.Context, id string) error {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return ErrPermissionDenied
+	}
+
+	return s.notifications.MarkRead(ctx, u.ID, id)
+}
+
+// MarkAllRead marks all of the session's user's notifications as read.
+func (s *service) MarkAllRead(ctx context.Context) error {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return ErrPermissionDenied
+	}
+
+	return s.notifications.MarkAllRead(ctx, u.ID)
+}
+
+// Preferences returns the delivery of the session's user's notifications for every reason.
+func (s *service) Preferences(ctx context.Context) (Preferences, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return nil, ErrPermissionDenied
+	}
+
+	preferences, err := s.notifications.Preferences(ctx, []string{u.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return withDefaults(preferences[u.ID]), nil
+}
+
+// UpdatePreferences changes the delivery for the given reasons, the others are kept.
+func (s *service) UpdatePreferences(ctx context.Context, p Preferences) (Preferences, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return nil, ErrPermissionDenied
+	}
+
+	defaults := DefaultPreferences()
+	for reason := range p {
+		if _, ok := defaults[reason]; !ok {
+			return nil, ErrReasonInvalid
+		}
+	}
+
+	if err := s.notifications.UpdatePreferences(ctx, u.ID, p); err != nil {
+		return nil, err
+	}
+
+	return s.Preferences(ctx)
+}
+
+// withDefaults completes a user's preferences with the default delivery of the reasons they haven't chosen one for.
+func withDefaults(p Preferences) Preferences {
+	preferences := DefaultPreferences()
+	for reason, delivery := range p {
+		if _, ok := preferences[reason]; ok {
+			preferences[reason] = delivery
+		}
+	}
+	return preferences
+}
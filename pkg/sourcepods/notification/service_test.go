@@ -0,0 +1,195 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/event"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testStore struct {
+	Store
+	notifications []*Notification
+	delivery      map[string]Delivery
+	preferences   map[string]Preferences
+}
+
+func newTestStore() *testStore {
+	return &testStore{
+		delivery:    map[string]Delivery{},
+		preferences: map[string]Preferences{},
+	}
+}
+
+func (s *testStore) Create(ctx context.Context, eventID string, recipients []Recipient) error {
+	for _, r := range recipients {
+		n := &Notification{
+			ID:     fmt.Sprintf("notification%d", len(s.notifications)+1),
+			UserID: r.UserID,
+			Reason: r.Reason,
+			Event:  &event.Event{ID: eventID},
+		}
+		s.notifications = append(s.notifications, n)
+		s.delivery[n.ID] = r.Delivery
+	}
+	return nil
+}
+
+func (s *testStore) List(ctx context.Context, userID string, opts ListOptions) ([]*Notification, string, error) {
+	var notifications []*Notification
+	for _, n := range s.notifications {
+		if n.UserID == userID && s.delivery[n.ID].Web && (opts.All || n.Read.IsZero()) {
+			notifications = append(notifications, n)
+		}
+	}
+	return notifications, "", nil
+}
+
+func (s *testStore) Preferences(ctx context.Context, userIDs []string) (map[string]Preferences, error) {
+	preferences := map[string]Preferences{}
+	for _, id := range userIDs {
+		if p, ok := s.preferences[id]; ok {
+			preferences[id] = p
+		}
+	}
+	return preferences, nil
+}
+
+func (s *testStore) UpdatePreferences(ctx context.Context, userID string, p Preferences) error {
+	if s.preferences[userID] == nil {
+		s.preferences[userID] = Preferences{}
+	}
+	for reason, d := range p {
+		s.preferences[userID][reason] = d
+	}
+	return nil
+}
+
+// recipients returns the notified users with their reason, in the order they were notified.
+func (s *testStore) recipients() []string {
+	var recipients []string
+	for _, n := range s.notifications {
+		recipients = append(recipients, n.UserID+" "+n.Reason)
+	}
+	return recipients
+}
+
+type testWatchers map[string][]string
+
+func (w testWatchers) ListWatchers(ctx context.Context, id string) ([]*repository.Watcher, error) {
+	var watchers []*repository.Watcher
+	for _, userID := range w[id] {
+		watchers = append(watchers, &repository.Watcher{UserID: userID})
+	}
+	return watchers, nil
+}
+
+type testUsers struct{}
+
+func (testUsers) FindByUsername(ctx context.Context, username string) (*user.User, error) {
+	switch username {
+	case "foo", "bar", "baz":
+		return &user.User{ID: username + "-id", Username: username}, nil
+	}
+	return nil, user.ErrNotFound
+}
+
+func (testUsers) FindRepositoryOwner(ctx context.Context, repositoryID string) (*user.User, error) {
+	return &user.User{ID: "foo-id", Username: "foo"}, nil
+}
+
+func withUser(username string) context.Context {
+	ctx := context.WithValue(context.Background(), session.CookieUserID, username+"-id")
+	return context.WithValue(ctx, session.CookieUserUsername, username)
+}
+
+func newTestService() (Service, *testStore) {
+	store := newTestStore()
+	watchers := testWatchers{"1": {"foo-id", "bar-id", "baz-id"}}
+	return NewService(store, watchers, testUsers{}), store
+}
+
+func TestServiceNotifyPush(t *testing.T) {
+	s, store := newTestService()
+
+	require.NoError(t, s.Notify(context.Background(), &event.Event{ID: "event1", Type: event.TypePush, RepositoryID: "1", Public: true}))
+	assert.Equal(t, []string{"foo-id watching", "bar-id watching", "baz-id watching"}, store.recipients())
+	assert.Equal(t, Delivery{Web: true, Email: false}, store.delivery["notification1"])
+}
+
+func TestServiceNotifyIssue(t *testing.T) {
+	s, store := newTestService()
+
+	err := s.Notify(context.Background(), &event.Event{
+		ID:           "event1",
+		Type:         event.TypeIssueCommented,
+		ActorID:      "bar-id",
+		RepositoryID: "1",
+		Public:       true,
+		Data: map[string]interface{}{
+			"author_id": "foo-id",
+			"mentions":  []interface{}{"baz", "bar", "unknown"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"baz-id mention", "foo-id author"}, store.recipients(), "the actor isn't notified, everyone only once")
+	assert.Equal(t, Delivery{Web: true, Email: true}, store.delivery["notification1"])
+}
+
+func TestServiceNotifyPrivate(t *testing.T) {
+	s, store := newTestService()
+
+	err := s.Notify(context.Background(), &event.Event{
+		ID:           "event1",
+		Type:         event.TypeIssueOpened,
+		ActorID:      "bar-id",
+		RepositoryID: "1",
+		Data:         map[string]interface{}{"author_id": "bar-id", "mentions": []string{"baz"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo-id watching"}, store.recipients(), "only the owner can see private repositories")
+}
+
+func TestServiceNotifySecurity(t *testing.T) {
+	s, store := newTestService()
+
+	require.NoError(t, s.Notify(context.Background(), &event.Event{ID: "event1", Type: event.TypeLoginSucceeded, ActorID: "foo-id"}))
+	require.NoError(t, s.Notify(context.Background(), &event.Event{ID: "event2", Type: event.TypeLoginFailed}))
+	assert.Equal(t, []string{"foo-id security"}, store.recipients())
+}
+
+func TestServicePreferences(t *testing.T) {
+	s, store := newTestService()
+
+	_, err := s.Preferences(context.Background())
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	p, err := s.Preferences(withUser("bar"))
+	require.NoError(t, err)
+	assert.Equal(t, DefaultPreferences(), p)
+
+	_, err = s.UpdatePreferences(withUser("bar"), Preferences{"unknown": {}})
+	assert.Equal(t, ErrReasonInvalid, err)
+
+	p, err = s.UpdatePreferences(withUser("bar"), Preferences{ReasonWatching: {Web: false, Email: true}})
+	require.NoError(t, err)
+	assert.Equal(t, Delivery{Web: false, Email: true}, p[ReasonWatching])
+	assert.Equal(t, DefaultPreferences()[ReasonMention], p[ReasonMention])
+
+	_, err = s.UpdatePreferences(withUser("baz"), Preferences{ReasonWatching: {}})
+	require.NoError(t, err)
+
+	require.NoError(t, s.Notify(context.Background(), &event.Event{ID: "event1", Type: event.TypePush, RepositoryID: "1", Public: true}))
+	assert.Equal(t, []string{"foo-id watching", "bar-id watching"}, store.recipients(), "baz doesn't want to be notified")
+	assert.Equal(t, Delivery{Web: false, Email: true}, store.delivery["notification2"])
+
+	list, _, err := s.List(withUser("bar"), ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, list, 0, "bar only gets emailed")
+}
@@ -0,0 +1,317 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/event"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+)
+
+// digestLimit is the most notifications emailed at once, the others are emailed with the next digests.
+const digestLimit = 1000
+
+// Postgres implementation of the Store.
+type Postgres struct {
+	db *sql.DB
+}
+
+// NewPostgresStore returns a Postgres implementation of the Store.
+func NewPostgresStore(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+const notificationColumns = `
+	notifications.id,
+	notifications.user_id,
+	notifications.reason,
+	notifications.read_at,
+	notifications.created_at,
+	events.id,
+	events.type,
+	COALESCE(events.actor_id::TEXT, ''),
+	events.actor,
+	COALESCE(events.repository_id::TEXT, ''),
+	events.repository,
+	events.public,
+	events.data,
+	events.created_at`
+
+func scanNotification(row interface{ Scan(...interface{}) error }, extra ...interface{}) (*Notification, error) {
+	n := &Notification{Event: &event.Event{}}
+	var (
+		read pq.NullTime
+		data []byte
+	)
+	dest := []interface{}{
+		&n.ID,
+		&n.UserID,
+		&n.Reason,
+		&read,
+		&n.Created,
+		&n.Event.ID,
+		&n.Event.Type,
+		&n.Event.ActorID,
+		&n.Event.Actor,
+		&n.Event.RepositoryID,
+		&n.Event.Repository,
+		&n.Event.Public,
+		&data,
+		&n.Event.Created,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	if read.Valid {
+		n.Read = read.Time
+	}
+	if err := json.Unmarshal(data, &n.Event.Data); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// Create notifies the recipients about the event, users already notified about it are skipped.
+func (s *Postgres) Create(ctx context.Context, eventID string, recipients []Recipient) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Postgres.Create")
+	span.SetTag("event", eventID)
+	span.SetTag("recipients", len(recipients))
+	defer span.Finish()
+
+	args := []interface{}{eventID}
+	values := make([]string, 0, len(recipients))
+	for _, r := range recipients {
+		args = append(args, r.UserID, r.Reason, r.Web, r.Email)
+		n := len(args)
+		values = append(values, fmt.Sprintf("($%d, $1, $%d, $%d, $%d)", n-3, n-2, n-1, n))
+	}
+
+	create := fmt.Sprintf(`
+INSERT INTO notifications (user_id, event_id, reason, web, email)
+VALUES %s
+ON CONFLICT (user_id, event_id) DO NOTHING;
+`, strings.Join(values, ", "))
+
+	_, err := s.db.ExecContext(ctx, create, args...)
+	return err
+}
+
+// List a page of the user's notifications delivered to the inbox, most recent first.
+// This func returns the notifications, the cursor of the next page if there's one and an error.
+func (s *Postgres) List(ctx context.Context, userID string, opts ListOptions) ([]*Notification, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Postgres.List")
+	span.SetTag("user", userID)
+	span.SetTag("all", opts.All)
+	span.SetTag("cursor", opts.Page.Cursor)
+	defer span.Finish()
+
+	args := []interface{}{userID}
+	where := []string{"notifications.user_id = $1", "notifications.web"}
+	if !opts.All {
+		where = append(where, "notifications.read_at IS NULL")
+	}
+
+	// The cursor holds the time and id of the last notification.
+	if opts.Page.Cursor != "" {
+		keys, err := pagination.DecodeCursor(opts.Page.Cursor, 2)
+		if err != nil {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+		created, err := time.Parse(time.RFC3339Nano, keys[0])
+		if err != nil {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+		args = append(args, created, keys[1])
+		where = append(where, "(notifications.created_at < $2 OR (notifications.created_at = $2 AND notifications.id < $3))")
+	}
+
+	limit := opts.Page.Limit()
+	args = append(args, limit+1)
+
+	list := fmt.Sprintf(`
+SELECT %s
+FROM notifications
+JOIN events ON events.id = notifications.event_id
+WHERE %s
+ORDER BY notifications.created_at DESC, notifications.id DESC
+LIMIT $%d;
+`, notificationColumns, strings.Join(where, " AND "), len(args))
+
+	rows, err := s.db.QueryContext(ctx, list, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var notifications []*Notification
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		notifications = append(notifications, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+		last := notifications[limit-1]
+		next = pagination.EncodeCursor(last.Created.Format(time.RFC3339Nano), last.ID)
+	}
+
+	return notifications, next, nil
+}
+
+// MarkRead marks the user's notification as read, it stays read if it's been read before.
+func (s *Postgres) MarkRead(ctx context.Context, userID, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Postgres.MarkRead")
+	span.SetTag("user", userID)
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	res, err := s.db.ExecContext(ctx, `
+UPDATE notifications
+SET read_at = COALESCE(read_at, now())
+WHERE id = $1 AND user_id = $2;
+`, id, userID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// MarkAllRead marks all of the user's unread notifications as read.
+func (s *Postgres) MarkAllRead(ctx context.Context, userID string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Postgres.MarkAllRead")
+	span.SetTag("user", userID)
+	defer span.Finish()
+
+	_, err := s.db.ExecContext(ctx, `UPDATE notifications SET read_at = now() WHERE user_id = $1 AND read_at IS NULL;`, userID)
+	return err
+}
+
+// Preferences returns the delivery the users have chosen by reason, users who haven't chosen any are left out.
+func (s *Postgres) Preferences(ctx context.Context, userIDs []string) (map[string]Preferences, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Postgres.Preferences")
+	span.SetTag("users", len(userIDs))
+	defer span.Finish()
+
+	rows, err := s.db.QueryContext(ctx, `
+SELECT user_id, reason, web, email
+FROM notification_preferences
+WHERE user_id = ANY($1);
+`, pq.Array(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	preferences := map[string]Preferences{}
+	for rows.Next() {
+		var (
+			userID, reason string
+			d              Delivery
+		)
+		if err := rows.Scan(&userID, &reason, &d.Web, &d.Email); err != nil {
+			return nil, err
+		}
+		if preferences[userID] == nil {
+			preferences[userID] = Preferences{}
+		}
+		preferences[userID][reason] = d
+	}
+
+	return preferences, rows.Err()
+}
+
+// UpdatePreferences stores the user's delivery for the given reasons.
+func (s *Postgres) UpdatePreferences(ctx context.Context, userID string, p Preferences) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Postgres.UpdatePreferences")
+	span.SetTag("user", userID)
+	defer span.Finish()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for reason, d := range p {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO notification_preferences (user_id, reason, web, email)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, reason) DO UPDATE SET web = excluded.web, email = excluded.email, updated_at = now();
+`, userID, reason, d.Web, d.Email)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// Digests returns the unread notifications to email that haven't been emailed yet, batched by user.
+func (s *Postgres) Digests(ctx context.Context) ([]*Digest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Postgres.Digests")
+	defer span.Finish()
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(`
+SELECT %s,
+	users.username,
+	users.email
+FROM notifications
+JOIN events ON events.id = notifications.event_id
+JOIN users ON users.id = notifications.user_id
+WHERE notifications.email AND notifications.emailed_at IS NULL AND notifications.read_at IS NULL
+ORDER BY notifications.user_id, notifications.created_at, notifications.id
+LIMIT $1;
+`, notificationColumns), digestLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var digests []*Digest
+	for rows.Next() {
+		var username, email string
+		n, err := scanNotification(rows, &username, &email)
+		if err != nil {
+			return nil, err
+		}
+		if len(digests) == 0 || digests[len(digests)-1].UserID != n.UserID {
+			digests = append(digests, &Digest{UserID: n.UserID, Username: username, Email: email})
+		}
+		d := digests[len(digests)-1]
+		d.Notifications = append(d.Notifications, n)
+	}
+
+	return digests, rows.Err()
+}
+
+// MarkEmailed marks the notifications as emailed, they're not part of the next digests.
+func (s *Postgres) MarkEmailed(ctx context.Context, ids []string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Postgres.MarkEmailed")
+	span.SetTag("notifications", len(ids))
+	defer span.Finish()
+
+	_, err := s.db.ExecContext(ctx, `UPDATE notifications SET emailed_at = now() WHERE id = ANY($1);`, pq.Array(ids))
+	return err
+}
@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/event"
+)
+
+//TracingRequestID returns the request ID as string for tracing
+type TracingRequestID func(context.Context) string
+
+type tracingService struct {
+	service   Service
+	requestID TracingRequestID
+}
+
+// NewTracingService wraps the Service and provides tracing for its methods.
+func NewTracingService(s Service, requestID TracingRequestID) Service {
+	return &tracingService{service: s, requestID: requestID}
+}
+
+func (s *tracingService) Notify(ctx context.Context, e *event.Event) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Service.Notify")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("event", e.ID)
+	span.SetTag("type", e.Type)
+	defer span.Finish()
+
+	return s.service.Notify(ctx, e)
+}
+
+func (s *tracingService) List(ctx context.Context, opts ListOptions) ([]*Notification, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Service.List")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("all", opts.All)
+	span.SetTag("cursor", opts.Page.Cursor)
+	defer span.Finish()
+
+	return s.service.List(ctx, opts)
+}
+
+func (s *tracingService) MarkRead(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Service.MarkRead")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	return s.service.MarkRead(ctx, id)
+}
+
+func (s *tracingService) MarkAllRead(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Service.MarkAllRead")
+	span.SetTag("request", s.requestID(ctx))
+	defer span.Finish()
+
+	return s.service.MarkAllRead(ctx)
+}
+
+func (s *tracingService) Preferences(ctx context.Context) (Preferences, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Service.Preferences")
+	span.SetTag("request", s.requestID(ctx))
+	defer span.Finish()
+
+	return s.service.Preferences(ctx)
+}
+
+func (s *tracingService) UpdatePreferences(ctx context.Context, p Preferences) (Preferences, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "notification.Service.UpdatePreferences")
+	span.SetTag("request", s.requestID(ctx))
+	defer span.Finish()
+
+	return s.service.UpdatePreferences(ctx, p)
+}
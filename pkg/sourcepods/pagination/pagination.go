@@ -0,0 +1,61 @@
+// Package pagination pages through lists with opaque cursors.
+//
+// A cursor holds the sort keys of the last item of a page,
+// the next page starts right after that item. This keeps pages
+// stable while items are added or removed, unlike offsets.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+const (
+	// DefaultPerPage is the number of items per page if none is given.
+	DefaultPerPage = 30
+	// MaxPerPage is the maximum number of items per page.
+	MaxPerPage = 100
+)
+
+// ErrCursorInvalid is returned if a cursor wasn't returned with a previous page.
+var ErrCursorInvalid = errors.New("cursor is not valid")
+
+// Options request a page of a list.
+type Options struct {
+	// Cursor returned with the previous page, empty for the first page.
+	Cursor  string
+	PerPage int
+}
+
+// Limit returns the number of items per page within DefaultPerPage and MaxPerPage.
+func (o Options) Limit() int {
+	if o.PerPage <= 0 {
+		return DefaultPerPage
+	}
+	if o.PerPage > MaxPerPage {
+		return MaxPerPage
+	}
+	return o.PerPage
+}
+
+// EncodeCursor returns an opaque cursor for the sort keys of a page's last item.
+func EncodeCursor(keys ...string) string {
+	b, _ := json.Marshal(keys)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor returns the n sort keys of the cursor.
+func DecodeCursor(cursor string, n int) ([]string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrCursorInvalid
+	}
+
+	var keys []string
+	if err := json.Unmarshal(b, &keys); err != nil || len(keys) != n {
+		return nil, ErrCursorInvalid
+	}
+
+	return keys, nil
+}
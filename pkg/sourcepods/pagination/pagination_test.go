@@ -0,0 +1,27 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor(t *testing.T) {
+	cursor := EncodeCursor("name", "foo bar", "1")
+
+	keys, err := DecodeCursor(cursor, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"name", "foo bar", "1"}, keys)
+
+	_, err = DecodeCursor(cursor, 2)
+	assert.Equal(t, ErrCursorInvalid, err)
+
+	_, err = DecodeCursor("not a cursor", 3)
+	assert.Equal(t, ErrCursorInvalid, err)
+}
+
+func TestOptionsLimit(t *testing.T) {
+	assert.Equal(t, DefaultPerPage, Options{}.Limit())
+	assert.Equal(t, 5, Options{PerPage: 5}.Limit())
+	assert.Equal(t, MaxPerPage, Options{PerPage: 1000}.Limit())
+}
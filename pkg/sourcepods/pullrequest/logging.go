@@ -0,0 +1,277 @@
+package pullrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+)
+
+//LoggingRequestID returns the request ID as string for logging
+type LoggingRequestID func(context.Context) string
+
+type loggingService struct {
+	service   Service
+	requestID LoggingRequestID
+	logger    log.Logger
+}
+
+// NewLoggingService wraps the Service and provides logging for its methods.
+func NewLoggingService(s Service, requestID LoggingRequestID, logger log.Logger) Service {
+	return &loggingService{service: s, requestID: requestID, logger: logger}
+}
+
+func (s *loggingService) List(ctx context.Context, owner, name string, opts ListOptions) ([]*PullRequest, string, error) {
+	start := time.Now()
+
+	pullRequests, next, err := s.service.List(ctx, owner, name, opts)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "List",
+		"owner", owner,
+		"name", name,
+		"state", opts.State,
+		"cursor", opts.Page.Cursor,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list pull requests",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return pullRequests, next, err
+}
+
+func (s *loggingService) Find(ctx context.Context, owner, name string, number int) (*PullRequest, error) {
+	start := time.Now()
+
+	pr, err := s.service.Find(ctx, owner, name, number)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Find",
+		"owner", owner,
+		"name", name,
+		"number", number,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to find pull request",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return pr, err
+}
+
+func (s *loggingService) Create(ctx context.Context, owner, name string, pr *PullRequest) (*PullRequest, error) {
+	start := time.Now()
+
+	pr, err := s.service.Create(ctx, owner, name, pr)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Create",
+		"owner", owner,
+		"name", name,
+		"source_branch", pr.Source.Branch,
+		"target_branch", pr.Target.Branch,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to create pull request",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return pr, err
+}
+
+func (s *loggingService) Update(ctx context.Context, owner, name string, number int, update Update) (*PullRequest, error) {
+	start := time.Now()
+
+	pr, err := s.service.Update(ctx, owner, name, number, update)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Update",
+		"owner", owner,
+		"name", name,
+		"number", number,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to update pull request",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return pr, err
+}
+
+func (s *loggingService) Merge(ctx context.Context, owner, name string, number int, m Merge) (*PullRequest, error) {
+	start := time.Now()
+
+	pr, err := s.service.Merge(ctx, owner, name, number, m)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Merge",
+		"owner", owner,
+		"name", name,
+		"number", number,
+		"strategy", m.Strategy,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to merge pull request",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return pr, err
+}
+
+func (s *loggingService) Commits(ctx context.Context, owner, name string, number int) ([]storage.Commit, error) {
+	start := time.Now()
+
+	commits, err := s.service.Commits(ctx, owner, name, number)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Commits",
+		"owner", owner,
+		"name", name,
+		"number", number,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list pull request commits",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return commits, err
+}
+
+func (s *loggingService) Diff(ctx context.Context, owner, name string, number int) (storage.Diff, error) {
+	start := time.Now()
+
+	diff, err := s.service.Diff(ctx, owner, name, number)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Diff",
+		"owner", owner,
+		"name", name,
+		"number", number,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to diff pull request",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return diff, err
+}
+
+func (s *loggingService) Comments(ctx context.Context, owner, name string, number int) ([]*Comment, error) {
+	start := time.Now()
+
+	comments, err := s.service.Comments(ctx, owner, name, number)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Comments",
+		"owner", owner,
+		"name", name,
+		"number", number,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list pull request comments",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return comments, err
+}
+
+func (s *loggingService) CreateComment(ctx context.Context, owner, name string, number int, c *Comment) (*Comment, error) {
+	start := time.Now()
+
+	c, err := s.service.CreateComment(ctx, owner, name, number, c)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "CreateComment",
+		"owner", owner,
+		"name", name,
+		"number", number,
+		"path", c.Path,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to create pull request comment",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return c, err
+}
+
+// isUserError is true for errors caused by the request rather than failures worth a warning.
+func isUserError(err error) bool {
+	if _, ok := err.(ValidationErrors); ok {
+		return true
+	}
+	switch err {
+	case ErrRepositoryNotFound, ErrPullRequestNotFound, ErrBranchNotFound, ErrSourceInvalid, ErrNoCommits,
+		ErrStateInvalid, ErrPermissionDenied, ErrCommentPositionInvalid, ErrMergeStrategyInvalid, ErrNotMergeable,
+		ErrHeadChanged, ErrChecksUnsatisfied, pagination.ErrCursorInvalid:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package pullrequest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+)
+
+// States of a pull request.
+const (
+	StateOpen   = "open"
+	StateClosed = "closed"
+	StateMerged = "merged"
+)
+
+// PullRequest proposes to merge the source branch into the target branch of a repository.
+type PullRequest struct {
+	ID     string
+	Number int
+	Title  string
+	Body   string
+	State  string
+
+	// Target is the branch of the repository the pull request belongs to.
+	Target Ref
+	// Source is a branch of the same repository or of one in its fork network.
+	Source Ref
+
+	// Head is the commit of the source branch fetched last,
+	// Base is where it branched off the target branch.
+	Head string
+	Base string
+
+	AuthorID string
+	Author   string
+
+	Created time.Time
+	Updated time.Time
+	// Closed is when the pull request was closed or merged, zero if it's open.
+	Closed time.Time
+
+	// Mergeable is computed by storage for open pull requests, nil if it's unknown.
+	Mergeable *bool
+	// Conflicts are the paths of the files that can't be merged.
+	Conflicts []string
+}
+
+// Ref is a branch of a repository.
+type Ref struct {
+	// RepositoryID is empty if the repository has been deleted.
+	RepositoryID string
+	Owner        string
+	Repository   string
+	Branch       string
+}
+
+// Comment on a pull request.
+// Review comments are anchored to a line of a file changed by the pull request.
+type Comment struct {
+	ID       string
+	Body     string
+	AuthorID string
+	Author   string
+
+	// Path and Line of the file the comment is anchored to, as of Commit.
+	// They're empty for comments on the pull request as a whole.
+	Path   string
+	Line   int
+	Commit string
+
+	Created time.Time
+	Updated time.Time
+}
+
+// ListOptions filter and page a repository's pull requests.
+type ListOptions struct {
+	// State is StateOpen, StateClosed, StateMerged or empty for all pull requests.
+	State string
+	Page  pagination.Options
+}
+
+// Update holds the changes to a pull request, nil fields are left alone.
+type Update struct {
+	Title *string
+	Body  *string
+	// State is either StateOpen or StateClosed.
+	State *string
+}
+
+// Merge describes how a pull request is merged into its target branch.
+type Merge struct {
+	// Strategy is storage.MergeCommit, storage.MergeSquash or storage.MergeRebase.
+	Strategy string
+	// Message of the merge or squashed commit, a default one is used if empty.
+	Message string
+	// Sha1 is the head the pull request is expected to have, if given.
+	Sha1 string
+}
+
+// headRef is where the source branch of the pull request is fetched to in the target repository.
+func headRef(number int) string {
+	return fmt.Sprintf("refs/pull/%d/head", number)
+}
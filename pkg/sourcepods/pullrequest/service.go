@@ -0,0 +1,524 @@
+package pullrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+)
+
+var (
+	// ErrRepositoryNotFound returned if the repository of a pull request is not found.
+	ErrRepositoryNotFound = errors.New("repository not found")
+
+	// ErrPullRequestNotFound returned if a pull request is not found.
+	ErrPullRequestNotFound = errors.New("pull request not found")
+
+	// ErrBranchNotFound returned if the source or target branch is not found.
+	ErrBranchNotFound = errors.New("branch not found")
+
+	// ErrSourceInvalid returned if the source repository isn't in the target repository's fork network.
+	ErrSourceInvalid = errors.New("source repository is not in the repository's network")
+
+	// ErrNoCommits returned if the source branch has no commits the target branch doesn't have.
+	ErrNoCommits = errors.New("source branch has no new commits")
+
+	// ErrStateInvalid returned if a pull request can't be changed to a state.
+	ErrStateInvalid = errors.New("state is not valid")
+
+	// ErrPermissionDenied returned if the user isn't allowed to change a pull request.
+	ErrPermissionDenied = errors.New("permission denied")
+
+	// ErrMergeStrategyInvalid returned for unknown merge strategies.
+	ErrMergeStrategyInvalid = errors.New("merge strategy is not valid")
+
+	// ErrNotMergeable returned if a pull request isn't open or has conflicts with its target branch.
+	ErrNotMergeable = errors.New("pull request is not mergeable")
+
+	// ErrHeadChanged returned if the source or target branch changed while merging.
+	ErrHeadChanged = errors.New("pull request has been changed")
+
+	// ErrChecksUnsatisfied returned if required status checks of the target branch haven't succeeded for the head.
+	ErrChecksUnsatisfied = errors.New("required status checks have not succeeded")
+
+	// ErrCommentPositionInvalid returned if a review comment isn't anchored to a changed file.
+	ErrCommentPositionInvalid = errors.New("comment position is not valid")
+)
+
+type (
+	// Store or retrieve pull requests from some database.
+	Store interface {
+		List(ctx context.Context, repositoryID string, opts ListOptions) ([]*PullRequest, string, error)
+		Find(ctx context.Context, repositoryID string, number int) (*PullRequest, error)
+		Create(ctx context.Context, pr *PullRequest) (*PullRequest, error)
+		Update(ctx context.Context, pr *PullRequest) (*PullRequest, error)
+		Delete(ctx context.Context, id string) error
+		ListComments(ctx context.Context, pullRequestID string) ([]*Comment, error)
+		CreateComment(ctx context.Context, pullRequestID string, c *Comment) (*Comment, error)
+	}
+
+	// Storage compares the branches of pull requests.
+	Storage interface {
+		FetchRef(ctx context.Context, id, sourceID, sourceRef, ref string) (string, error)
+		Commits(ctx context.Context, id, base, head string, limit int) ([]storage.Commit, error)
+		Diff(ctx context.Context, id, base, head string) (storage.Diff, error)
+		Mergeable(ctx context.Context, id, base, head string) (storage.Mergeability, error)
+		Merge(ctx context.Context, id string, opts storage.MergeOptions) (storage.MergeResult, error)
+	}
+
+	// Users finds the committers of merges.
+	Users interface {
+		Find(ctx context.Context, id string) (*user.User, error)
+	}
+
+	// Repositories finds the repositories visible to the session's user.
+	Repositories interface {
+		Find(ctx context.Context, owner, name string) (*repository.Repository, string, error)
+		Network(ctx context.Context, owner, name string) ([]*repository.OwnedRepository, error)
+	}
+
+	// Checks finds the required status checks of a protected branch that haven't succeeded for a commit.
+	Checks interface {
+		Unsatisfied(ctx context.Context, owner, name, branch, sha1 string) ([]string, error)
+	}
+
+	// Service to interact with pull requests.
+	Service interface {
+		List(ctx context.Context, owner, name string, opts ListOptions) ([]*PullRequest, string, error)
+		Find(ctx context.Context, owner, name string, number int) (*PullRequest, error)
+		Create(ctx context.Context, owner, name string, pr *PullRequest) (*PullRequest, error)
+		Update(ctx context.Context, owner, name string, number int, update Update) (*PullRequest, error)
+		Merge(ctx context.Context, owner, name string, number int, m Merge) (*PullRequest, error)
+		Commits(ctx context.Context, owner, name string, number int) ([]storage.Commit, error)
+		Diff(ctx context.Context, owner, name string, number int) (storage.Diff, error)
+		Comments(ctx context.Context, owner, name string, number int) ([]*Comment, error)
+		CreateComment(ctx context.Context, owner, name string, number int, c *Comment) (*Comment, error)
+	}
+
+	service struct {
+		pullRequests Store
+		repositories Repositories
+		users        Users
+		storage      Storage
+		checks       Checks
+	}
+)
+
+// NewService to interact with pull requests.
+func NewService(pullRequests Store, repositories Repositories, users Users, storage Storage, checks Checks) Service {
+	return &service{
+		pullRequests: pullRequests,
+		repositories: repositories,
+		users:        users,
+		storage:      storage,
+		checks:       checks,
+	}
+}
+
+// List a page of the repository's pull requests, most recent first.
+func (s *service) List(ctx context.Context, owner, name string, opts ListOptions) ([]*PullRequest, string, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s.pullRequests.List(ctx, r.ID, opts)
+}
+
+// Find a pull request by its number.
+// Open pull requests are updated to the source branch and their mergeability is computed.
+func (s *service) Find(ctx context.Context, owner, name string, number int) (*PullRequest, error) {
+	pr, err := s.find(ctx, owner, name, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, s.refresh(ctx, pr)
+}
+
+// Create a pull request opened by the session's user.
+// Only the title, body and the source and target branches are taken from pr.
+// The source repository is the repository itself, unless Source.Owner and Source.Repository are given.
+func (s *service) Create(ctx context.Context, owner, name string, pr *PullRequest) (*PullRequest, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return nil, ErrPermissionDenied
+	}
+
+	target, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ValidateCreate(pr); err != nil {
+		return nil, err
+	}
+
+	source := target
+	if pr.Source.Owner == "" && pr.Source.Repository == "" {
+		pr.Source.Owner, pr.Source.Repository = owner, name
+	} else if pr.Source.Owner != owner || pr.Source.Repository != name {
+		if source, err = s.findSource(ctx, owner, name, pr.Source); err != nil {
+			return nil, err
+		}
+	}
+	if source.ID == target.ID && pr.Source.Branch == pr.Target.Branch {
+		return nil, ErrNoCommits
+	}
+
+	pr.State = StateOpen
+	pr.Target.RepositoryID = target.ID
+	pr.Target.Owner, pr.Target.Repository = owner, name
+	pr.Source.RepositoryID = source.ID
+	pr.AuthorID, pr.Author = u.ID, u.Username
+
+	pr, err = s.pullRequests.Create(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.compare(ctx, pr); err != nil {
+		// Don't leave a pull request without commits behind.
+		s.pullRequests.Delete(ctx, pr.ID)
+		return nil, err
+	}
+
+	return s.pullRequests.Update(ctx, pr)
+}
+
+// findSource finds the source repository of a new pull request,
+// which has to be in the fork network of the target repository.
+func (s *service) findSource(ctx context.Context, owner, name string, source Ref) (*repository.Repository, error) {
+	r, _, err := s.repositories.Find(ctx, source.Owner, source.Repository)
+	if err == repository.ErrRepositoryNotFound {
+		return nil, ErrSourceInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	network, err := s.repositories.Network(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range network {
+		if o.Repository.ID == r.ID {
+			return r, nil
+		}
+	}
+
+	return nil, ErrSourceInvalid
+}
+
+// compare fetches the source branch into the target repository
+// and sets the pull request's head, base and mergeability.
+func (s *service) compare(ctx context.Context, pr *PullRequest) error {
+	_, err := s.storage.FetchRef(ctx, pr.Target.RepositoryID, pr.Source.RepositoryID, "refs/heads/"+pr.Source.Branch, headRef(pr.Number))
+	if err != nil {
+		return storageError(err)
+	}
+
+	m, err := s.storage.Mergeable(ctx, pr.Target.RepositoryID, "refs/heads/"+pr.Target.Branch, headRef(pr.Number))
+	if err != nil {
+		return storageError(err)
+	}
+	if m.MergeBase == m.Head {
+		return ErrNoCommits
+	}
+
+	pr.Head, pr.Base = m.Head, m.MergeBase
+	pr.Mergeable = &m.Mergeable
+	pr.Conflicts = m.Conflicts
+
+	return nil
+}
+
+// refresh updates an open pull request to the current source and target branches.
+// If either branch or the source repository is gone, the last known head is kept.
+func (s *service) refresh(ctx context.Context, pr *PullRequest) error {
+	if pr.State != StateOpen {
+		return nil
+	}
+
+	if pr.Source.RepositoryID != "" {
+		_, err := s.storage.FetchRef(ctx, pr.Target.RepositoryID, pr.Source.RepositoryID, "refs/heads/"+pr.Source.Branch, headRef(pr.Number))
+		if err != nil && err != storage.ErrBranchNotFound && err != storage.ErrRepoNotValid {
+			return err
+		}
+	}
+
+	m, err := s.storage.Mergeable(ctx, pr.Target.RepositoryID, "refs/heads/"+pr.Target.Branch, headRef(pr.Number))
+	if err == storage.ErrRevNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	pr.Mergeable = &m.Mergeable
+	pr.Conflicts = m.Conflicts
+
+	if m.Head == pr.Head && m.MergeBase == pr.Base {
+		return nil
+	}
+	pr.Head, pr.Base = m.Head, m.MergeBase
+	updated, err := s.pullRequests.Update(ctx, pr)
+	if err != nil {
+		return err
+	}
+	*pr = *updated
+
+	return nil
+}
+
+// Update the title, body or state of a pull request.
+// Only its author and the repository's owner are allowed to.
+func (s *service) Update(ctx context.Context, owner, name string, number int, update Update) (*PullRequest, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return nil, ErrPermissionDenied
+	}
+
+	pr, err := s.find(ctx, owner, name, number)
+	if err != nil {
+		return nil, err
+	}
+	if u.Username != owner && u.ID != pr.AuthorID {
+		return nil, ErrPermissionDenied
+	}
+
+	if update.Title != nil {
+		pr.Title = strings.TrimSpace(*update.Title)
+	}
+	if update.Body != nil {
+		pr.Body = *update.Body
+	}
+	if err := ValidateCreate(pr); err != nil {
+		return nil, err
+	}
+
+	if update.State != nil && *update.State != pr.State {
+		if pr.State == StateMerged {
+			return nil, ErrStateInvalid
+		}
+		switch *update.State {
+		case StateOpen:
+			pr.State = StateOpen
+			pr.Closed = time.Time{}
+		case StateClosed:
+			pr.State = StateClosed
+			pr.Closed = time.Now()
+		default:
+			return nil, ErrStateInvalid
+		}
+	}
+
+	pr, err = s.pullRequests.Update(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	return pr, s.refresh(ctx, pr)
+}
+
+// Merge an open pull request into its target branch, committed by the session's user.
+// Only the repository's owner is allowed to.
+func (s *service) Merge(ctx context.Context, owner, name string, number int, m Merge) (*PullRequest, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil || u.Username != owner {
+		return nil, ErrPermissionDenied
+	}
+
+	switch m.Strategy {
+	case storage.MergeCommit, storage.MergeSquash, storage.MergeRebase:
+	default:
+		return nil, ErrMergeStrategyInvalid
+	}
+
+	pr, err := s.Find(ctx, owner, name, number)
+	if err != nil {
+		return nil, err
+	}
+	if pr.State != StateOpen || pr.Mergeable == nil || !*pr.Mergeable {
+		return nil, ErrNotMergeable
+	}
+	if m.Sha1 != "" && m.Sha1 != pr.Head {
+		return nil, ErrHeadChanged
+	}
+
+	unsatisfied, err := s.checks.Unsatisfied(ctx, owner, name, pr.Target.Branch, pr.Head)
+	if err != nil {
+		return nil, err
+	}
+	if len(unsatisfied) > 0 {
+		return nil, ErrChecksUnsatisfied
+	}
+
+	committer, err := s.users.Find(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	signature := storage.Signature{Name: committer.Name, Email: committer.Email, Date: time.Now()}
+	if signature.Name == "" {
+		signature.Name = committer.Username
+	}
+
+	message := m.Message
+	if message == "" {
+		message = mergeMessage(pr, m.Strategy)
+	}
+
+	_, err = s.storage.Merge(ctx, pr.Target.RepositoryID, storage.MergeOptions{
+		Branch:    pr.Target.Branch,
+		Head:      pr.Head,
+		Strategy:  m.Strategy,
+		Message:   message,
+		Committer: signature,
+	})
+	switch err {
+	case nil:
+	case storage.ErrMergeConflict, storage.ErrNothingToMerge:
+		return nil, ErrNotMergeable
+	case storage.ErrRefChanged:
+		return nil, ErrHeadChanged
+	default:
+		return nil, storageError(err)
+	}
+
+	pr.State = StateMerged
+	pr.Closed = time.Now()
+	pr.Mergeable = nil
+	pr.Conflicts = nil
+
+	return s.pullRequests.Update(ctx, pr)
+}
+
+// mergeMessage is the default message of the merge or squashed commit of a pull request.
+func mergeMessage(pr *PullRequest, strategy string) string {
+	if strategy == storage.MergeSquash {
+		return fmt.Sprintf("%s (#%d)\n\n%s", pr.Title, pr.Number, pr.Body)
+	}
+	return fmt.Sprintf("Merge pull request #%d from %s/%s\n\n%s", pr.Number, pr.Source.Owner, pr.Source.Branch, pr.Title)
+}
+
+// Commits returns the commits of the pull request, oldest first.
+func (s *service) Commits(ctx context.Context, owner, name string, number int) ([]storage.Commit, error) {
+	pr, err := s.Find(ctx, owner, name, number)
+	if err != nil {
+		return nil, err
+	}
+
+	commits, err := s.storage.Commits(ctx, pr.Target.RepositoryID, base(pr), pr.Head, 0)
+	return commits, storageError(err)
+}
+
+// Diff returns the changes of the pull request since its source branch branched off.
+func (s *service) Diff(ctx context.Context, owner, name string, number int) (storage.Diff, error) {
+	pr, err := s.Find(ctx, owner, name, number)
+	if err != nil {
+		return storage.Diff{}, err
+	}
+
+	diff, err := s.storage.Diff(ctx, pr.Target.RepositoryID, base(pr), pr.Head)
+	return diff, storageError(err)
+}
+
+// base is the merge base of the pull request or the target branch for unrelated histories.
+func base(pr *PullRequest) string {
+	if pr.Base == "" {
+		return "refs/heads/" + pr.Target.Branch
+	}
+	return pr.Base
+}
+
+// Comments returns all comments of a pull request, oldest first.
+func (s *service) Comments(ctx context.Context, owner, name string, number int) ([]*Comment, error) {
+	pr, err := s.find(ctx, owner, name, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pullRequests.ListComments(ctx, pr.ID)
+}
+
+// CreateComment on a pull request by the session's user.
+// Review comments are anchored to the pull request's current head.
+func (s *service) CreateComment(ctx context.Context, owner, name string, number int, c *Comment) (*Comment, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return nil, ErrPermissionDenied
+	}
+
+	if err := ValidateComment(c); err != nil {
+		return nil, err
+	}
+
+	pr, err := s.Find(ctx, owner, name, number)
+	if err != nil {
+		return nil, err
+	}
+
+	c.AuthorID, c.Author = u.ID, u.Username
+	c.Commit = ""
+	if c.Path != "" {
+		if c.Line <= 0 {
+			return nil, ErrCommentPositionInvalid
+		}
+
+		diff, err := s.storage.Diff(ctx, pr.Target.RepositoryID, base(pr), pr.Head)
+		if err != nil {
+			return nil, storageError(err)
+		}
+		if !changes(diff, c.Path) {
+			return nil, ErrCommentPositionInvalid
+		}
+		c.Commit = pr.Head
+	} else {
+		c.Line = 0
+	}
+
+	return s.pullRequests.CreateComment(ctx, pr.ID, c)
+}
+
+func changes(diff storage.Diff, path string) bool {
+	for _, f := range diff.Files {
+		if f.Path == path || f.OldPath == path {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *service) findRepository(ctx context.Context, owner, name string) (*repository.Repository, error) {
+	r, _, err := s.repositories.Find(ctx, owner, name)
+	if err == repository.ErrRepositoryNotFound {
+		return nil, ErrRepositoryNotFound
+	}
+	return r, err
+}
+
+func (s *service) find(ctx context.Context, owner, name string, number int) (*PullRequest, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pullRequests.Find(ctx, r.ID, number)
+}
+
+func storageError(err error) error {
+	switch err {
+	case storage.ErrBranchNotFound, storage.ErrRevNotFound:
+		return ErrBranchNotFound
+	case storage.ErrRepoNotValid:
+		return ErrRepositoryNotFound
+	default:
+		return err
+	}
+}
@@ -0,0 +1,318 @@
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testStore struct {
+	Store
+	pullRequests []*PullRequest
+	comments     map[string][]*Comment
+	last         int
+}
+
+func (s *testStore) Find(ctx context.Context, repositoryID string, number int) (*PullRequest, error) {
+	for _, pr := range s.pullRequests {
+		if pr.Target.RepositoryID == repositoryID && pr.Number == number {
+			return pr, nil
+		}
+	}
+	return nil, ErrPullRequestNotFound
+}
+
+func (s *testStore) Create(ctx context.Context, pr *PullRequest) (*PullRequest, error) {
+	s.last++
+	pr.Number = s.last
+	pr.ID = fmt.Sprintf("pr%d", s.last)
+	s.pullRequests = append(s.pullRequests, pr)
+	return pr, nil
+}
+
+func (s *testStore) Update(ctx context.Context, pr *PullRequest) (*PullRequest, error) {
+	return pr, nil
+}
+
+func (s *testStore) Delete(ctx context.Context, id string) error {
+	for i, pr := range s.pullRequests {
+		if pr.ID == id {
+			s.pullRequests = append(s.pullRequests[:i], s.pullRequests[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (s *testStore) CreateComment(ctx context.Context, pullRequestID string, c *Comment) (*Comment, error) {
+	if s.comments == nil {
+		s.comments = map[string][]*Comment{}
+	}
+	s.comments[pullRequestID] = append(s.comments[pullRequestID], c)
+	return c, nil
+}
+
+// testStorage has the branches of all repositories by "<id>:<branch>" and their sha1s.
+type testStorage struct {
+	Storage
+	branches map[string]string
+	fetched  map[string]string
+	merged   []storage.MergeOptions
+}
+
+func (s *testStorage) FetchRef(ctx context.Context, id, sourceID, sourceRef, ref string) (string, error) {
+	sha1, ok := s.branches[sourceID+":"+sourceRef]
+	if !ok {
+		return "", storage.ErrBranchNotFound
+	}
+	if s.fetched == nil {
+		s.fetched = map[string]string{}
+	}
+	s.fetched[id+":"+ref] = sha1
+	return sha1, nil
+}
+
+func (s *testStorage) Mergeable(ctx context.Context, id, base, head string) (storage.Mergeability, error) {
+	baseSha1, ok := s.branches[id+":"+base]
+	if !ok {
+		return storage.Mergeability{}, storage.ErrRevNotFound
+	}
+	headSha1 := s.fetched[id+":"+head]
+	m := storage.Mergeability{Base: baseSha1, Head: headSha1, MergeBase: baseSha1, Mergeable: true}
+	if headSha1 == "conflict" {
+		m.Mergeable = false
+		m.Conflicts = []string{"README.md"}
+	}
+	return m, nil
+}
+
+func (s *testStorage) Diff(ctx context.Context, id, base, head string) (storage.Diff, error) {
+	return storage.Diff{Files: []storage.FileDiff{{Path: "main.go", Status: storage.DiffAdded}}}, nil
+}
+
+func (s *testStorage) Merge(ctx context.Context, id string, opts storage.MergeOptions) (storage.MergeResult, error) {
+	key := id + ":refs/heads/" + opts.Branch
+	s.merged = append(s.merged, opts)
+	s.branches[key] = "merged-" + opts.Head
+	return storage.MergeResult{Sha1: s.branches[key]}, nil
+}
+
+type testUsers struct{}
+
+func (testUsers) Find(ctx context.Context, id string) (*user.User, error) {
+	return &user.User{ID: id, Username: "foo", Name: "Foo Bar", Email: "foo@bar.com"}, nil
+}
+
+type testRepositories struct {
+	repositories map[string]*repository.OwnedRepository
+}
+
+func (r *testRepositories) Find(ctx context.Context, owner, name string) (*repository.Repository, string, error) {
+	o, ok := r.repositories[owner+"/"+name]
+	if !ok {
+		return nil, "", repository.ErrRepositoryNotFound
+	}
+	return o.Repository, owner, nil
+}
+
+func (r *testRepositories) Network(ctx context.Context, owner, name string) ([]*repository.OwnedRepository, error) {
+	var network []*repository.OwnedRepository
+	for _, o := range r.repositories {
+		if o.Repository.ID != "3" {
+			network = append(network, o)
+		}
+	}
+	return network, nil
+}
+
+type testChecks struct {
+	// unsatisfied checks by the head they're for.
+	unsatisfied map[string][]string
+}
+
+func (c testChecks) Unsatisfied(ctx context.Context, owner, name, branch, sha1 string) ([]string, error) {
+	return c.unsatisfied[sha1], nil
+}
+
+func newTestService(checks Checks) (Service, *testStore, *testStorage) {
+	store := &testStore{}
+	st := &testStorage{branches: map[string]string{
+		"1:refs/heads/master":  "a",
+		"1:refs/heads/feature": "b",
+		"1:refs/heads/merged":  "a",
+		"2:refs/heads/feature": "c",
+		"2:refs/heads/clash":   "conflict",
+		"3:refs/heads/feature": "d",
+	}}
+	repos := &testRepositories{repositories: map[string]*repository.OwnedRepository{
+		"foo/bar":   {Repository: &repository.Repository{ID: "1", Name: "bar"}, Owner: "foo"},
+		"baz/bar":   {Repository: &repository.Repository{ID: "2", Name: "bar", ParentID: "1"}, Owner: "baz"},
+		"baz/other": {Repository: &repository.Repository{ID: "3", Name: "other"}, Owner: "baz"},
+	}}
+	return NewService(store, repos, testUsers{}, st, checks), store, st
+}
+
+func withUser(username string) context.Context {
+	ctx := context.WithValue(context.Background(), session.CookieUserID, username+"-id")
+	return context.WithValue(ctx, session.CookieUserUsername, username)
+}
+
+func newPullRequest(sourceOwner, sourceRepository, sourceBranch string) *PullRequest {
+	return &PullRequest{
+		Title:  "Add feature",
+		Source: Ref{Owner: sourceOwner, Repository: sourceRepository, Branch: sourceBranch},
+		Target: Ref{Branch: "master"},
+	}
+}
+
+func TestServiceCreate(t *testing.T) {
+	s, store, _ := newTestService(testChecks{})
+
+	_, err := s.Create(context.Background(), "foo", "bar", newPullRequest("", "", "feature"))
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	pr, err := s.Create(withUser("baz"), "foo", "bar", newPullRequest("", "", "feature"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, pr.Number)
+	assert.Equal(t, StateOpen, pr.State)
+	assert.Equal(t, Ref{RepositoryID: "1", Owner: "foo", Repository: "bar", Branch: "feature"}, pr.Source)
+	assert.Equal(t, Ref{RepositoryID: "1", Owner: "foo", Repository: "bar", Branch: "master"}, pr.Target)
+	assert.Equal(t, "b", pr.Head)
+	assert.Equal(t, "a", pr.Base)
+	assert.Equal(t, "baz", pr.Author)
+	require.NotNil(t, pr.Mergeable)
+	assert.True(t, *pr.Mergeable)
+
+	pr, err = s.Create(withUser("baz"), "foo", "bar", newPullRequest("baz", "bar", "clash"))
+	require.NoError(t, err)
+	assert.Equal(t, "2", pr.Source.RepositoryID)
+	assert.False(t, *pr.Mergeable)
+	assert.Equal(t, []string{"README.md"}, pr.Conflicts)
+
+	_, err = s.Create(withUser("baz"), "foo", "bar", newPullRequest("baz", "other", "feature"))
+	assert.Equal(t, ErrSourceInvalid, err)
+	_, err = s.Create(withUser("baz"), "foo", "bar", newPullRequest("baz", "unknown", "feature"))
+	assert.Equal(t, ErrSourceInvalid, err)
+	_, err = s.Create(withUser("baz"), "foo", "bar", newPullRequest("", "", "master"))
+	assert.Equal(t, ErrNoCommits, err)
+	_, err = s.Create(withUser("baz"), "foo", "unknown", newPullRequest("", "", "feature"))
+	assert.Equal(t, ErrRepositoryNotFound, err)
+	_, err = s.Create(withUser("baz"), "foo", "bar", &PullRequest{Source: Ref{Branch: "feature"}, Target: Ref{Branch: "master"}})
+	assert.IsType(t, ValidationErrors{}, err)
+
+	// Failing to compare the branches doesn't leave a pull request behind.
+	_, err = s.Create(withUser("baz"), "foo", "bar", newPullRequest("", "", "unknown"))
+	assert.Equal(t, ErrBranchNotFound, err)
+	_, err = s.Create(withUser("baz"), "foo", "bar", newPullRequest("", "", "merged"))
+	assert.Equal(t, ErrNoCommits, err)
+	require.Len(t, store.pullRequests, 2)
+	assert.Equal(t, []int{1, 2}, []int{store.pullRequests[0].Number, store.pullRequests[1].Number})
+}
+
+func TestServiceUpdate(t *testing.T) {
+	s, _, st := newTestService(testChecks{})
+
+	pr, err := s.Create(withUser("baz"), "foo", "bar", newPullRequest("baz", "bar", "feature"))
+	require.NoError(t, err)
+
+	closed := StateClosed
+	_, err = s.Update(withUser("qux"), "foo", "bar", pr.Number, Update{State: &closed})
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	pr, err = s.Update(withUser("foo"), "foo", "bar", pr.Number, Update{State: &closed})
+	require.NoError(t, err)
+	assert.Equal(t, StateClosed, pr.State)
+	assert.False(t, pr.Closed.IsZero())
+
+	merged := StateMerged
+	_, err = s.Update(withUser("baz"), "foo", "bar", pr.Number, Update{State: &merged})
+	assert.Equal(t, ErrStateInvalid, err)
+
+	// Reopening fetches the source branch again.
+	st.branches["2:refs/heads/feature"] = "e"
+	open, title := StateOpen, " Reopened "
+	pr, err = s.Update(withUser("baz"), "foo", "bar", pr.Number, Update{State: &open, Title: &title})
+	require.NoError(t, err)
+	assert.Equal(t, StateOpen, pr.State)
+	assert.Equal(t, "Reopened", pr.Title)
+	assert.True(t, pr.Closed.IsZero())
+	assert.Equal(t, "e", pr.Head)
+}
+
+func TestServiceCreateComment(t *testing.T) {
+	s, store, _ := newTestService(testChecks{})
+
+	pr, err := s.Create(withUser("baz"), "foo", "bar", newPullRequest("", "", "feature"))
+	require.NoError(t, err)
+
+	c, err := s.CreateComment(withUser("qux"), "foo", "bar", pr.Number, &Comment{Body: "LGTM", Line: 3})
+	require.NoError(t, err)
+	assert.Equal(t, "qux", c.Author)
+	assert.Equal(t, 0, c.Line)
+	assert.Equal(t, "", c.Commit)
+
+	c, err = s.CreateComment(withUser("qux"), "foo", "bar", pr.Number, &Comment{Body: "typo", Path: "main.go", Line: 3})
+	require.NoError(t, err)
+	assert.Equal(t, "b", c.Commit)
+
+	_, err = s.CreateComment(withUser("qux"), "foo", "bar", pr.Number, &Comment{Body: "typo", Path: "README.md", Line: 3})
+	assert.Equal(t, ErrCommentPositionInvalid, err)
+	_, err = s.CreateComment(withUser("qux"), "foo", "bar", pr.Number, &Comment{Body: "typo", Path: "main.go"})
+	assert.Equal(t, ErrCommentPositionInvalid, err)
+	_, err = s.CreateComment(withUser("qux"), "foo", "bar", pr.Number, &Comment{Body: " "})
+	assert.IsType(t, ValidationErrors{}, err)
+	_, err = s.CreateComment(context.Background(), "foo", "bar", pr.Number, &Comment{Body: "LGTM"})
+	assert.Equal(t, ErrPermissionDenied, err)
+	_, err = s.CreateComment(withUser("qux"), "foo", "bar", 42, &Comment{Body: "LGTM"})
+	assert.Equal(t, ErrPullRequestNotFound, err)
+
+	assert.Len(t, store.comments[pr.ID], 2)
+}
+
+func TestServiceMerge(t *testing.T) {
+	checks := testChecks{unsatisfied: map[string][]string{}}
+	s, _, st := newTestService(checks)
+
+	pr, err := s.Create(withUser("baz"), "foo", "bar", newPullRequest("baz", "bar", "feature"))
+	require.NoError(t, err)
+	clash, err := s.Create(withUser("baz"), "foo", "bar", newPullRequest("baz", "bar", "clash"))
+	require.NoError(t, err)
+
+	m := Merge{Strategy: storage.MergeSquash}
+	_, err = s.Merge(withUser("baz"), "foo", "bar", pr.Number, m)
+	assert.Equal(t, ErrPermissionDenied, err)
+	_, err = s.Merge(withUser("foo"), "foo", "bar", pr.Number, Merge{Strategy: "octopus"})
+	assert.Equal(t, ErrMergeStrategyInvalid, err)
+	_, err = s.Merge(withUser("foo"), "foo", "bar", clash.Number, m)
+	assert.Equal(t, ErrNotMergeable, err)
+	_, err = s.Merge(withUser("foo"), "foo", "bar", pr.Number, Merge{Strategy: storage.MergeSquash, Sha1: "x"})
+	assert.Equal(t, ErrHeadChanged, err)
+	checks.unsatisfied["c"] = []string{"ci/build"}
+	_, err = s.Merge(withUser("foo"), "foo", "bar", pr.Number, m)
+	assert.Equal(t, ErrChecksUnsatisfied, err)
+	delete(checks.unsatisfied, "c")
+	assert.Empty(t, st.merged)
+
+	pr, err = s.Merge(withUser("foo"), "foo", "bar", pr.Number, Merge{Strategy: storage.MergeSquash, Sha1: "c"})
+	require.NoError(t, err)
+	assert.Equal(t, StateMerged, pr.State)
+	assert.False(t, pr.Closed.IsZero())
+	require.Len(t, st.merged, 1)
+	assert.Equal(t, "master", st.merged[0].Branch)
+	assert.Equal(t, "c", st.merged[0].Head)
+	assert.Equal(t, "Add feature (#1)\n\n", st.merged[0].Message)
+	assert.Equal(t, "Foo Bar", st.merged[0].Committer.Name)
+	assert.Equal(t, "foo@bar.com", st.merged[0].Committer.Email)
+
+	_, err = s.Merge(withUser("foo"), "foo", "bar", pr.Number, m)
+	assert.Equal(t, ErrNotMergeable, err)
+	_, err = s.Merge(withUser("foo"), "foo", "bar", 42, m)
+	assert.Equal(t, ErrPullRequestNotFound, err)
+}
@@ -0,0 +1,350 @@
+package pullrequest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+)
+
+// Postgres implementation of the Store.
+type Postgres struct {
+	db *sql.DB
+}
+
+// NewPostgresStore returns a Postgres implementation of the Store.
+func NewPostgresStore(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+// selectPullRequests selects the columns scanned by scanPullRequest.
+const selectPullRequests = `
+SELECT
+	pull_requests.id,
+	pull_requests.number,
+	pull_requests.title,
+	pull_requests.body,
+	pull_requests.state,
+	pull_requests.repository_id,
+	target_owners.username,
+	targets.name,
+	pull_requests.target_branch,
+	pull_requests.source_repository_id,
+	source_owners.username,
+	sources.name,
+	pull_requests.source_branch,
+	pull_requests.head,
+	pull_requests.base,
+	pull_requests.author_id,
+	authors.username,
+	pull_requests.created_at,
+	pull_requests.updated_at,
+	pull_requests.closed_at
+FROM pull_requests
+JOIN repositories targets ON targets.id = pull_requests.repository_id
+JOIN users target_owners ON target_owners.id = targets.owner_id
+LEFT JOIN repositories sources ON sources.id = pull_requests.source_repository_id
+LEFT JOIN users source_owners ON source_owners.id = sources.owner_id
+JOIN users authors ON authors.id = pull_requests.author_id`
+
+func scanPullRequest(row interface{ Scan(...interface{}) error }) (*PullRequest, error) {
+	var sourceID, sourceOwner, sourceName sql.NullString
+	var closed pq.NullTime
+	pr := &PullRequest{}
+
+	if err := row.Scan(
+		&pr.ID,
+		&pr.Number,
+		&pr.Title,
+		&pr.Body,
+		&pr.State,
+		&pr.Target.RepositoryID,
+		&pr.Target.Owner,
+		&pr.Target.Repository,
+		&pr.Target.Branch,
+		&sourceID,
+		&sourceOwner,
+		&sourceName,
+		&pr.Source.Branch,
+		&pr.Head,
+		&pr.Base,
+		&pr.AuthorID,
+		&pr.Author,
+		&pr.Created,
+		&pr.Updated,
+		&closed,
+	); err != nil {
+		return nil, err
+	}
+	pr.Source.RepositoryID = sourceID.String
+	pr.Source.Owner = sourceOwner.String
+	pr.Source.Repository = sourceName.String
+	pr.Closed = closed.Time
+
+	return pr, nil
+}
+
+// List retrieves a page of the repository's pull requests, the most recent first.
+// This func returns the pull requests, the cursor of the next page if there's one and an error.
+func (s *Postgres) List(ctx context.Context, repositoryID string, opts ListOptions) ([]*PullRequest, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Postgres.List")
+	span.SetTag("repository", repositoryID)
+	span.SetTag("state", opts.State)
+	span.SetTag("cursor", opts.Page.Cursor)
+	defer span.Finish()
+
+	args := []interface{}{repositoryID}
+	where := []string{"pull_requests.repository_id = $1"}
+
+	if opts.State != "" {
+		args = append(args, opts.State)
+		where = append(where, fmt.Sprintf("pull_requests.state = $%d", len(args)))
+	}
+
+	if opts.Page.Cursor != "" {
+		keys, err := pagination.DecodeCursor(opts.Page.Cursor, 2)
+		if err != nil || keys[0] != opts.State {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+		number, err := strconv.Atoi(keys[1])
+		if err != nil {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+		args = append(args, number)
+		where = append(where, fmt.Sprintf("pull_requests.number < $%d", len(args)))
+	}
+
+	limit := opts.Page.Limit()
+	args = append(args, limit+1)
+
+	list := fmt.Sprintf(`%s
+WHERE %s
+ORDER BY pull_requests.number DESC
+LIMIT $%d;
+`, selectPullRequests, strings.Join(where, " AND "), len(args))
+
+	rows, err := s.db.QueryContext(ctx, list, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var pullRequests []*PullRequest
+	for rows.Next() {
+		pr, err := scanPullRequest(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		pullRequests = append(pullRequests, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(pullRequests) <= limit {
+		return pullRequests, "", nil
+	}
+
+	pullRequests = pullRequests[:limit]
+	last := pullRequests[limit-1]
+	return pullRequests, pagination.EncodeCursor(opts.State, strconv.Itoa(last.Number)), nil
+}
+
+// Find a pull request by the repository's id and its number.
+func (s *Postgres) Find(ctx context.Context, repositoryID string, number int) (*PullRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Postgres.Find")
+	span.SetTag("repository", repositoryID)
+	span.SetTag("number", number)
+	defer span.Finish()
+
+	find := selectPullRequests + `
+WHERE pull_requests.repository_id = $1 AND pull_requests.number = $2;
+`
+
+	pr, err := scanPullRequest(s.db.QueryRowContext(ctx, find, repositoryID, number))
+	if err == sql.ErrNoRows {
+		return nil, ErrPullRequestNotFound
+	}
+	return pr, err
+}
+
+// Create a pull request with the next number of its target repository.
+// This func returns the created pull request or an error.
+func (s *Postgres) Create(ctx context.Context, pr *PullRequest) (*PullRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Postgres.Create")
+	span.SetTag("repository", pr.Target.RepositoryID)
+	defer span.Finish()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	nextNumber := `UPDATE repositories SET last_number = last_number + 1 WHERE id = $1 RETURNING last_number;`
+	if err := tx.QueryRowContext(ctx, nextNumber, pr.Target.RepositoryID).Scan(&pr.Number); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrRepositoryNotFound
+		}
+		return nil, err
+	}
+
+	var sourceID *string
+	if pr.Source.RepositoryID != "" {
+		sourceID = &pr.Source.RepositoryID
+	}
+
+	create := `
+INSERT INTO pull_requests (repository_id, number, title, body, state, target_branch, source_repository_id, source_branch, author_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, created_at, updated_at;
+`
+
+	row := tx.QueryRowContext(ctx, create,
+		pr.Target.RepositoryID,
+		pr.Number,
+		pr.Title,
+		pr.Body,
+		pr.State,
+		pr.Target.Branch,
+		sourceID,
+		pr.Source.Branch,
+		pr.AuthorID,
+	)
+	if err := row.Scan(&pr.ID, &pr.Created, &pr.Updated); err != nil {
+		return nil, err
+	}
+
+	return pr, tx.Commit()
+}
+
+// Update the title, body, state, head and base of the pull request.
+// This func returns the updated pull request or an error.
+func (s *Postgres) Update(ctx context.Context, pr *PullRequest) (*PullRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Postgres.Update")
+	span.SetTag("id", pr.ID)
+	defer span.Finish()
+
+	var closed *time.Time
+	if !pr.Closed.IsZero() {
+		closed = &pr.Closed
+	}
+
+	update := `
+UPDATE pull_requests
+SET title = $2, body = $3, state = $4, head = $5, base = $6, closed_at = $7, updated_at = now()
+WHERE id = $1
+RETURNING updated_at;
+`
+
+	row := s.db.QueryRowContext(ctx, update, pr.ID, pr.Title, pr.Body, pr.State, pr.Head, pr.Base, closed)
+	if err := row.Scan(&pr.Updated); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrPullRequestNotFound
+		}
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+// Delete the pull request with the id and its comments.
+func (s *Postgres) Delete(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Postgres.Delete")
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	_, err := s.db.ExecContext(ctx, `DELETE FROM pull_requests WHERE id = $1;`, id)
+	return err
+}
+
+// ListComments of the pull request, oldest first.
+func (s *Postgres) ListComments(ctx context.Context, pullRequestID string) ([]*Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Postgres.ListComments")
+	span.SetTag("id", pullRequestID)
+	defer span.Finish()
+
+	list := `
+SELECT
+	pull_request_comments.id,
+	pull_request_comments.body,
+	pull_request_comments.author_id,
+	users.username,
+	pull_request_comments.path,
+	pull_request_comments.line,
+	pull_request_comments.commit_sha1,
+	pull_request_comments.created_at,
+	pull_request_comments.updated_at
+FROM pull_request_comments
+JOIN users ON users.id = pull_request_comments.author_id
+WHERE pull_request_comments.pull_request_id = $1
+ORDER BY pull_request_comments.created_at, pull_request_comments.id;
+`
+
+	rows, err := s.db.QueryContext(ctx, list, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var comments []*Comment
+	for rows.Next() {
+		var path, commit sql.NullString
+		var line sql.NullInt64
+		c := &Comment{}
+
+		if err := rows.Scan(
+			&c.ID,
+			&c.Body,
+			&c.AuthorID,
+			&c.Author,
+			&path,
+			&line,
+			&commit,
+			&c.Created,
+			&c.Updated,
+		); err != nil {
+			return nil, err
+		}
+		c.Path = path.String
+		c.Line = int(line.Int64)
+		c.Commit = commit.String
+
+		comments = append(comments, c)
+	}
+
+	return comments, rows.Err()
+}
+
+// CreateComment on the pull request.
+// This func returns the created comment or an error.
+func (s *Postgres) CreateComment(ctx context.Context, pullRequestID string, c *Comment) (*Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Postgres.CreateComment")
+	span.SetTag("id", pullRequestID)
+	defer span.Finish()
+
+	var path, commit *string
+	var line *int
+	if c.Path != "" {
+		path, line, commit = &c.Path, &c.Line, &c.Commit
+	}
+
+	create := `
+INSERT INTO pull_request_comments (pull_request_id, author_id, body, path, line, commit_sha1)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, created_at, updated_at;
+`
+
+	row := s.db.QueryRowContext(ctx, create, pullRequestID, c.AuthorID, c.Body, path, line, commit)
+	if err := row.Scan(&c.ID, &c.Created, &c.Updated); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,124 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+)
+
+//TracingRequestID returns the request ID as string for tracing
+type TracingRequestID func(context.Context) string
+
+type tracingService struct {
+	service   Service
+	requestID TracingRequestID
+}
+
+// NewTracingService wraps the Service and provides tracing for its methods.
+func NewTracingService(s Service, requestID TracingRequestID) Service {
+	return &tracingService{service: s, requestID: requestID}
+}
+
+func (s *tracingService) List(ctx context.Context, owner, name string, opts ListOptions) ([]*PullRequest, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Service.List")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("state", opts.State)
+	span.SetTag("cursor", opts.Page.Cursor)
+	defer span.Finish()
+
+	return s.service.List(ctx, owner, name, opts)
+}
+
+func (s *tracingService) Find(ctx context.Context, owner, name string, number int) (*PullRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Service.Find")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("number", number)
+	defer span.Finish()
+
+	return s.service.Find(ctx, owner, name, number)
+}
+
+func (s *tracingService) Create(ctx context.Context, owner, name string, pr *PullRequest) (*PullRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Service.Create")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("source_branch", pr.Source.Branch)
+	span.SetTag("target_branch", pr.Target.Branch)
+	defer span.Finish()
+
+	return s.service.Create(ctx, owner, name, pr)
+}
+
+func (s *tracingService) Update(ctx context.Context, owner, name string, number int, update Update) (*PullRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Service.Update")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("number", number)
+	defer span.Finish()
+
+	return s.service.Update(ctx, owner, name, number, update)
+}
+
+func (s *tracingService) Merge(ctx context.Context, owner, name string, number int, m Merge) (*PullRequest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Service.Merge")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("number", number)
+	span.SetTag("strategy", m.Strategy)
+	defer span.Finish()
+
+	return s.service.Merge(ctx, owner, name, number, m)
+}
+
+func (s *tracingService) Commits(ctx context.Context, owner, name string, number int) ([]storage.Commit, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Service.Commits")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("number", number)
+	defer span.Finish()
+
+	return s.service.Commits(ctx, owner, name, number)
+}
+
+func (s *tracingService) Diff(ctx context.Context, owner, name string, number int) (storage.Diff, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Service.Diff")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("number", number)
+	defer span.Finish()
+
+	return s.service.Diff(ctx, owner, name, number)
+}
+
+func (s *tracingService) Comments(ctx context.Context, owner, name string, number int) ([]*Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Service.Comments")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("number", number)
+	defer span.Finish()
+
+	return s.service.Comments(ctx, owner, name, number)
+}
+
+func (s *tracingService) CreateComment(ctx context.Context, owner, name string, number int, c *Comment) (*Comment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "pullrequest.Service.CreateComment")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("number", number)
+	span.SetTag("path", c.Path)
+	defer span.Finish()
+
+	return s.service.CreateComment(ctx, owner, name, number, c)
+}
@@ -0,0 +1,107 @@
+package pullrequest
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+type (
+	//ValidationErrors are returned with a slice of all invalid fields
+	ValidationErrors struct {
+		Errors []ValidationError
+	}
+	//ValidationError knows for a given field the error
+	ValidationError struct {
+		Field string
+		Error error
+	}
+)
+
+func (e ValidationErrors) Error() string {
+	return fmt.Sprintf("there are %d validation errors", len(e.Errors))
+}
+
+const (
+	titleMaxLength = 256
+	bodyMaxLength  = 65536
+)
+
+// ValidateCreate takes a PullRequest and validates its fields.
+func ValidateCreate(pr *PullRequest) error {
+	var errs ValidationErrors
+
+	if err := validateTitle(pr.Title); err != nil {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "title",
+			Error: err,
+		})
+	}
+
+	if err := validateBody(pr.Body); err != nil {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "body",
+			Error: err,
+		})
+	}
+
+	if pr.Source.Branch == "" {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "source_branch",
+			Error: fmt.Errorf("source branch is required"),
+		})
+	}
+
+	if pr.Target.Branch == "" {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "target_branch",
+			Error: fmt.Errorf("target branch is required"),
+		})
+	}
+
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ValidateComment takes a Comment and validates its fields.
+func ValidateComment(c *Comment) error {
+	var errs ValidationErrors
+
+	if strings.TrimSpace(c.Body) == "" {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "body",
+			Error: fmt.Errorf("body is required"),
+		})
+	} else if err := validateBody(c.Body); err != nil {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "body",
+			Error: err,
+		})
+	}
+
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func validateTitle(title string) error {
+	if strings.TrimSpace(title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if utf8.RuneCountInString(title) > titleMaxLength {
+		return fmt.Errorf("title is longer than %d characters", titleMaxLength)
+	}
+	return nil
+}
+
+func validateBody(body string) error {
+	if utf8.RuneCountInString(body) > bodyMaxLength {
+		return fmt.Errorf("body is longer than %d characters", bodyMaxLength)
+	}
+	return nil
+}
@@ -0,0 +1,503 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/google/jsonapi"
+	"github.com/opentracing/opentracing-go"
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+)
+
+// commitSha1 matches full commit SHAs, which always point to the same tree.
+var commitSha1 = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// archiveFormats are the supported archive extensions and their Content-Type.
+var archiveFormats = []struct {
+	ext         string
+	format      string
+	contentType string
+}{
+	{".tar.gz", storage.ArchiveTarGz, "application/gzip"},
+	{".tgz", storage.ArchiveTarGz, "application/gzip"},
+	{".tar", storage.ArchiveTar, "application/x-tar"},
+	{".zip", storage.ArchiveZip, "application/zip"},
+}
+
+// NewArchiveHandler returns a http router streaming archives of a repository.
+// It needs to be mounted with the {owner} and {name} URL parameters, e.g.
+// /repositories/{owner}/{name}/archive and serves /{rev}.{ext} from there.
+func NewArchiveHandler(s Service) *chi.Mux {
+	r := chi.NewRouter()
+
+	r.Get("/*", archive(s))
+
+	return r
+}
+
+func archive(s Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		span, ctx := opentracing.StartSpanFromContext(r.Context(), "repository.Handler.archive")
+		defer span.Finish()
+
+		owner := chi.URLParam(r, "owner")
+		name := chi.URLParam(r, "name")
+		file := chi.URLParam(r, "*")
+
+		var rev, format, contentType, ext string
+		for _, f := range archiveFormats {
+			if strings.HasSuffix(file, f.ext) {
+				rev = strings.TrimSuffix(file, f.ext)
+				format, contentType, ext = f.format, f.contentType, f.ext
+				break
+			}
+		}
+		if rev == "" {
+			writeError(w, http.StatusNotFound, "archive format is not supported")
+			return
+		}
+
+		// The archive's top level directory, e.g. sourcepods-master/
+		base := name + "-" + strings.Replace(rev, "/", "-", -1)
+
+		aw := &archiveWriter{ResponseWriter: w, header: func(h http.Header) {
+			h.Set("Content-Type", contentType)
+			h.Set("Content-Disposition", attachment(base+ext))
+			h.Set("X-Content-Type-Options", "nosniff")
+			if commitSha1.MatchString(rev) {
+				h.Set("Cache-Control", "private, max-age=31536000, immutable")
+				h.Set("ETag", fmt.Sprintf(`"%s%s"`, rev, ext))
+			} else {
+				h.Set("Cache-Control", "no-cache")
+			}
+		}}
+
+		// The request's context is cancelled once the client disconnects, stopping the archive.
+		err := s.Archive(ctx, owner, name, rev, format, base+"/", aw)
+		if err != nil && !aw.written {
+			switch err {
+			case ErrRepositoryNotFound:
+				writeError(w, http.StatusNotFound, "repository not found")
+			case ErrRevNotFound:
+				writeError(w, http.StatusNotFound, "rev not found")
+			case ErrArchiveFormatInvalid:
+				writeError(w, http.StatusNotFound, err.Error())
+			default:
+				writeError(w, http.StatusInternalServerError, "failed to archive repository")
+			}
+		}
+	}
+}
+
+// archiveWriter only sets the archive's headers with the first bytes written,
+// errors before that can still be responded with a proper status code.
+type archiveWriter struct {
+	http.ResponseWriter
+	header  func(http.Header)
+	written bool
+}
+
+func (w *archiveWriter) Write(p []byte) (int, error) {
+	if !w.written {
+		w.header(w.ResponseWriter.Header())
+		w.WriteHeader(http.StatusOK)
+		w.written = true
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// Redirect requests of repositories renamed or transferred away from {owner}/{name}
+// to their current owner and name, old URLs and git remotes keep working that way.
+// It needs to be used with the {owner} and {name} URL parameters.
+func Redirect(s Service) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			owner := chi.URLParam(r, "owner")
+			name := chi.URLParam(r, "name")
+
+			newOwner, newName, err := s.Redirect(r.Context(), owner, name)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			u := *r.URL
+			u.Path = strings.Replace(u.Path, "/"+owner+"/"+name, "/"+newOwner+"/"+newName, 1)
+			u.RawPath = ""
+
+			// Only GET and HEAD may change to GET when redirected permanently.
+			code := http.StatusMovedPermanently
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				code = http.StatusPermanentRedirect
+			}
+			http.Redirect(w, r, u.String(), code)
+		})
+	}
+}
+
+// Authenticator authenticates users by their email and password, like the authorization.Service.
+type Authenticator interface {
+	AuthenticateUser(ctx context.Context, email, password string) (*user.User, error)
+}
+
+// GitAccess only lets git clients fetch repositories they can see and push to repositories they own,
+// with the same checks as the Service's Find. Git clients don't have sessions,
+// they authenticate with basic auth and the user's email and password instead.
+// Clients are asked to authenticate if they push or the repository isn't found without a user.
+// It needs to be used with the {owner} and {name} URL parameters.
+func GitAccess(s Service, authenticator Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			span, ctx := opentracing.StartSpanFromContext(r.Context(), "repository.Handler.GitAccess")
+			defer span.Finish()
+
+			var u *user.User
+			if email, password, ok := r.BasicAuth(); ok {
+				var err error
+				u, err = authenticator.AuthenticateUser(ctx, email, password)
+				if err != nil {
+					writeUnauthorized(w, "Incorrect email or password")
+					return
+				}
+				span.SetTag("user", u.Username)
+				ctx = context.WithValue(ctx, session.CookieUserID, u.ID)
+				ctx = context.WithValue(ctx, session.CookieUserUsername, u.Username)
+			}
+
+			push := r.URL.Query().Get("service") == "git-receive-pack" || strings.HasSuffix(r.URL.Path, "/git-receive-pack")
+			if push && u == nil {
+				writeUnauthorized(w, "Authenticate with your email and password to push")
+				return
+			}
+
+			_, owner, err := s.Find(ctx, chi.URLParam(r, "owner"), chi.URLParam(r, "name"))
+			if err == ErrRepositoryNotFound {
+				if u == nil {
+					writeUnauthorized(w, "Authenticate with your email and password")
+					return
+				}
+				writeError(w, http.StatusNotFound, err.Error())
+				return
+			}
+			if err != nil {
+				writeError(w, http.StatusInternalServerError, "failed to find repository")
+				return
+			}
+
+			if push && u.Username != owner {
+				writeError(w, http.StatusForbidden, ErrPermissionDenied.Error())
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// writeUnauthorized asks git clients for the user's email and password.
+func writeUnauthorized(w http.ResponseWriter, detail string) {
+	w.Header().Set("WWW-Authenticate", `Basic realm="SourcePods"`)
+	writeError(w, http.StatusUnauthorized, detail)
+}
+
+// NewRawHandler returns a http router serving files of a repository as they are.
+// It needs to be mounted with the {owner} and {name} URL parameters, e.g.
+// /{owner}/{name}/raw and serves /{rev}/{path} from there.
+func NewRawHandler(s Service) *chi.Mux {
+	r := chi.NewRouter()
+
+	r.Get("/*", raw(s))
+	r.Head("/*", raw(s))
+
+	return r
+}
+
+// rawCSP doesn't allow files to load anything or run scripts, if a browser renders them.
+const rawCSP = "default-src 'none'; style-src 'unsafe-inline'; sandbox"
+
+// sniffLen is the number of bytes http.DetectContentType considers.
+const sniffLen = 512
+
+func raw(s Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		span, ctx := opentracing.StartSpanFromContext(r.Context(), "repository.Handler.raw")
+		defer span.Finish()
+
+		owner := chi.URLParam(r, "owner")
+		name := chi.URLParam(r, "name")
+
+		var blob storage.Blob
+		rev, path, err := splitRevPath(chi.URLParam(r, "*"), func(rev, path string) error {
+			var err error
+			blob, err = s.Blob(ctx, owner, name, rev, path)
+			return err
+		})
+		if err != nil {
+			switch err {
+			case ErrRepositoryNotFound:
+				writeError(w, http.StatusNotFound, "repository not found")
+			case ErrRevNotFound:
+				writeError(w, http.StatusNotFound, "rev not found")
+			case ErrPathNotFound:
+				writeError(w, http.StatusNotFound, "file not found")
+			default:
+				writeError(w, http.StatusInternalServerError, "failed to get file")
+			}
+			return
+		}
+
+		etag := fmt.Sprintf(`"%s"`, blob.Sha1)
+
+		h := w.Header()
+		h.Set("ETag", etag)
+		h.Set("Accept-Ranges", "bytes")
+		h.Set("X-Content-Type-Options", "nosniff")
+		h.Set("Content-Security-Policy", rawCSP)
+		if commitSha1.MatchString(rev) {
+			h.Set("Cache-Control", "private, max-age=31536000, immutable")
+		} else {
+			h.Set("Cache-Control", "private, no-cache")
+		}
+
+		if etagMatch(r.Header.Get("If-None-Match"), etag) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		head := &bytes.Buffer{}
+		if err := s.ReadBlob(ctx, owner, name, blob.Sha1, 0, sniffLen, head); err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to read file")
+			return
+		}
+
+		contentType, download := rawContentType(path, head.Bytes())
+		h.Set("Content-Type", contentType)
+		if download {
+			h.Set("Content-Disposition", attachment(filepath.Base(path)))
+		}
+
+		offset, length := int64(0), blob.Size
+		status := http.StatusOK
+
+		// Ranges are ignored if the file has changed since the client got the first part.
+		ifRange := r.Header.Get("If-Range")
+		if rangeHeader := r.Header.Get("Range"); rangeHeader != "" && (ifRange == "" || ifRange == etag) {
+			start, end, ok := parseRange(rangeHeader, blob.Size)
+			if !ok {
+				h.Set("Content-Range", fmt.Sprintf("bytes */%d", blob.Size))
+				writeError(w, http.StatusRequestedRangeNotSatisfiable, "range not satisfiable")
+				return
+			}
+			if start >= 0 {
+				offset, length = start, end-start+1
+				status = http.StatusPartialContent
+				h.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, blob.Size))
+			}
+		}
+
+		h.Set("Content-Length", strconv.FormatInt(length, 10))
+		w.WriteHeader(status)
+
+		if r.Method == http.MethodHead || length == 0 {
+			return
+		}
+
+		// The headers are sent already, errors can't be returned to the client anymore.
+		s.ReadBlob(ctx, owner, name, blob.Sha1, offset, length, w)
+	}
+}
+
+// splitRevPath splits rev and path in p, calling fn until it doesn't return ErrRevNotFound.
+// Revs can contain slashes too, so the shortest rev is tried first, as GitHub does.
+func splitRevPath(p string, fn func(rev, path string) error) (string, string, error) {
+	var rev, path string
+	err := ErrPathNotFound
+	segments := strings.Split(p, "/")
+	for i := 1; i < len(segments); i++ {
+		rev = strings.Join(segments[:i], "/")
+		path = strings.Join(segments[i:], "/")
+
+		err = fn(rev, path)
+		if err != ErrRevNotFound {
+			break
+		}
+	}
+	return rev, path, err
+}
+
+// NewBlameHandler returns a http router responding with the blame of files as JSON.
+// It needs to be mounted with the {owner} and {name} URL parameters, e.g.
+// /repositories/{owner}/{name}/blame and serves /{rev}/{path} from there.
+func NewBlameHandler(s Service) *chi.Mux {
+	r := chi.NewRouter()
+
+	r.Get("/*", blame(s))
+
+	return r
+}
+
+type blameHunk struct {
+	Sha1        string    `json:"sha1"`
+	Summary     string    `json:"summary"`
+	AuthorName  string    `json:"author_name"`
+	AuthorEmail string    `json:"author_email"`
+	AuthorDate  time.Time `json:"author_date"`
+	Line        int       `json:"line"`
+	Lines       []string  `json:"lines"`
+}
+
+func blame(s Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		span, ctx := opentracing.StartSpanFromContext(r.Context(), "repository.Handler.blame")
+		defer span.Finish()
+
+		owner := chi.URLParam(r, "owner")
+		name := chi.URLParam(r, "name")
+
+		var hunks []storage.BlameHunk
+		_, _, err := splitRevPath(chi.URLParam(r, "*"), func(rev, path string) error {
+			var err error
+			hunks, err = s.Blame(ctx, owner, name, rev, path)
+			return err
+		})
+		if err != nil {
+			switch err {
+			case ErrRepositoryNotFound:
+				writeError(w, http.StatusNotFound, "repository not found")
+			case ErrRevNotFound:
+				writeError(w, http.StatusNotFound, "rev not found")
+			case ErrPathNotFound:
+				writeError(w, http.StatusNotFound, "file not found")
+			case ErrFileTooLarge:
+				writeError(w, http.StatusUnprocessableEntity, "file is too large to blame")
+			case ErrBlameTimeout:
+				writeError(w, http.StatusGatewayTimeout, "blaming the file took too long")
+			default:
+				writeError(w, http.StatusInternalServerError, "failed to blame file")
+			}
+			return
+		}
+
+		payload := make([]blameHunk, 0, len(hunks))
+		for _, h := range hunks {
+			payload = append(payload, blameHunk{
+				Sha1:        h.Sha1,
+				Summary:     h.Summary,
+				AuthorName:  h.Author.Name,
+				AuthorEmail: h.Author.Email,
+				AuthorDate:  h.Author.Date,
+				Line:        h.Line,
+				Lines:       h.Lines,
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(payload)
+	}
+}
+
+// rawContentType detects the Content-Type of a file by its content.
+// HTML and SVG or other XML files could run scripts and are only served as attachment.
+func rawContentType(path string, head []byte) (string, bool) {
+	contentType := http.DetectContentType(head)
+
+	// SVGs are often sniffed as plain text or XML.
+	if strings.EqualFold(filepath.Ext(path), ".svg") {
+		return "image/svg+xml", true
+	}
+
+	mediaType := strings.TrimSpace(strings.Split(contentType, ";")[0])
+	switch mediaType {
+	case "text/html", "text/xml", "application/xml", "application/xhtml+xml":
+		return contentType, true
+	}
+
+	return contentType, false
+}
+
+// etagMatch checks if an If-None-Match header matches the etag.
+func etagMatch(ifNoneMatch, etag string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == etag || tag == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRange parses a Range header with a single range of bytes.
+// Multiple ranges return a start of -1 and the whole file should be sent instead.
+// If the range can't be satisfied ok is false.
+func parseRange(header string, size int64) (start, end int64, ok bool) {
+	if !strings.HasPrefix(header, "bytes=") || strings.Contains(header, ",") {
+		return -1, -1, true
+	}
+
+	spec := strings.SplitN(strings.TrimPrefix(header, "bytes="), "-", 2)
+	if len(spec) != 2 {
+		return -1, -1, false
+	}
+	first, last := strings.TrimSpace(spec[0]), strings.TrimSpace(spec[1])
+
+	if first == "" {
+		// bytes=-500 are the last 500 bytes.
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n <= 0 || size == 0 {
+			return -1, -1, false
+		}
+		if n > size {
+			n = size
+		}
+		return size - n, size - 1, true
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 || start >= size {
+		return -1, -1, false
+	}
+
+	end = size - 1
+	if last != "" {
+		end, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || end < start {
+			return -1, -1, false
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+
+	return start, end, true
+}
+
+// attachment returns a Content-Disposition for downloading a file with the given name.
+func attachment(filename string) string {
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": filename})
+	if disposition == "" {
+		return "attachment"
+	}
+	return disposition
+}
+
+func writeError(w http.ResponseWriter, status int, detail string) {
+	w.Header().Set("Content-Type", jsonapi.MediaType)
+	w.WriteHeader(status)
+	jsonapi.MarshalErrors(w, []*jsonapi.ErrorObject{{
+		Title:  http.StatusText(status),
+		Detail: detail,
+		Status: fmt.Sprintf("%d", status),
+	}})
+}
@@ -0,0 +1,378 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/user"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+type archiveTestService struct {
+	Service
+	rev, format, prefix string
+}
+
+func (s *archiveTestService) Archive(ctx context.Context, owner, name, rev, format, prefix string, w io.Writer) error {
+	if owner != "foo" || name != "bar" {
+		return ErrRepositoryNotFound
+	}
+	if rev == "unknown" {
+		return ErrRevNotFound
+	}
+	s.rev, s.format, s.prefix = rev, format, prefix
+	_, err := w.Write([]byte("archive"))
+	return err
+}
+
+func archiveRouter(s Service) http.Handler {
+	r := chi.NewRouter()
+	r.Mount("/repositories/{owner}/{name}/archive", NewArchiveHandler(s))
+	return r
+}
+
+func TestHTTPArchive(t *testing.T) {
+	s := &archiveTestService{}
+	h := archiveRouter(s)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/repositories/foo/bar/archive/feature/baz.tar.gz", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "archive", w.Body.String())
+	assert.Equal(t, "application/gzip", w.Header().Get("Content-Type"))
+	assert.Equal(t, "attachment; filename=bar-feature-baz.tar.gz", w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "feature/baz", s.rev)
+	assert.Equal(t, storage.ArchiveTarGz, s.format)
+	assert.Equal(t, "bar-feature-baz/", s.prefix)
+}
+
+func TestHTTPArchiveCommit(t *testing.T) {
+	s := &archiveTestService{}
+	h := archiveRouter(s)
+
+	sha1 := "99cc2f794893815dfc69ab1ba3370ef3e7a9fed2"
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/repositories/foo/bar/archive/"+sha1+".zip", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+	assert.Equal(t, "private, max-age=31536000, immutable", w.Header().Get("Cache-Control"))
+	assert.Equal(t, `"`+sha1+`.zip"`, w.Header().Get("ETag"))
+	assert.Equal(t, storage.ArchiveZip, s.format)
+}
+
+func TestHTTPArchiveNotFound(t *testing.T) {
+	h := archiveRouter(&archiveTestService{})
+
+	for _, path := range []string{
+		"/repositories/foo/baz/archive/master.tar",
+		"/repositories/foo/bar/archive/unknown.tar",
+		"/repositories/foo/bar/archive/master.rar",
+	} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code, path)
+		assert.Empty(t, w.Header().Get("Content-Disposition"), path)
+	}
+}
+
+var rawFiles = map[string]string{
+	"README.md":       "# bar\n\nHello World!\n",
+	"docs/index.html": "<!DOCTYPE html><html><script>alert(1)</script></html>",
+	"logo.svg":        `<svg xmlns="http://www.w3.org/2000/svg"></svg>`,
+}
+
+type rawTestService struct {
+	Service
+}
+
+func (s *rawTestService) Blob(ctx context.Context, owner, name, rev, path string) (storage.Blob, error) {
+	if owner != "foo" || name != "bar" {
+		return storage.Blob{}, ErrRepositoryNotFound
+	}
+	if rev != "master" && rev != "feature/baz" {
+		return storage.Blob{}, ErrRevNotFound
+	}
+	content, ok := rawFiles[path]
+	if !ok {
+		return storage.Blob{}, ErrPathNotFound
+	}
+	return storage.Blob{Sha1: path, Mode: "100644", Size: int64(len(content))}, nil
+}
+
+func (s *rawTestService) ReadBlob(ctx context.Context, owner, name, sha1 string, offset, limit int64, w io.Writer) error {
+	content := rawFiles[sha1][offset:]
+	if limit > 0 && limit < int64(len(content)) {
+		content = content[:limit]
+	}
+	_, err := io.WriteString(w, content)
+	return err
+}
+
+func rawRouter() http.Handler {
+	r := chi.NewRouter()
+	r.Mount("/{owner}/{name}/raw", NewRawHandler(&rawTestService{}))
+	return r
+}
+
+func TestHTTPRaw(t *testing.T) {
+	w := httptest.NewRecorder()
+	rawRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/foo/bar/raw/feature/baz/README.md", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, rawFiles["README.md"], w.Body.String())
+	assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, `"README.md"`, w.Header().Get("ETag"))
+	assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, rawCSP, w.Header().Get("Content-Security-Policy"))
+	assert.Empty(t, w.Header().Get("Content-Disposition"))
+}
+
+func TestHTTPRawNotModified(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/foo/bar/raw/master/README.md", nil)
+	req.Header.Set("If-None-Match", `"foo", "README.md"`)
+
+	w := httptest.NewRecorder()
+	rawRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+}
+
+func TestHTTPRawRange(t *testing.T) {
+	tests := []struct {
+		header       string
+		status       int
+		body         string
+		contentRange string
+	}{
+		{"bytes=2-4", http.StatusPartialContent, "bar", "bytes 2-4/20"},
+		{"bytes=13-", http.StatusPartialContent, "World!\n", "bytes 13-19/20"},
+		{"bytes=-7", http.StatusPartialContent, "World!\n", "bytes 13-19/20"},
+		{"bytes=0-1,4-5", http.StatusOK, rawFiles["README.md"], ""},
+		{"bytes=20-", http.StatusRequestedRangeNotSatisfiable, "", "bytes */20"},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/foo/bar/raw/master/README.md", nil)
+		req.Header.Set("Range", tt.header)
+
+		w := httptest.NewRecorder()
+		rawRouter().ServeHTTP(w, req)
+
+		assert.Equal(t, tt.status, w.Code, tt.header)
+		assert.Equal(t, tt.contentRange, w.Header().Get("Content-Range"), tt.header)
+		if tt.body != "" {
+			assert.Equal(t, tt.body, w.Body.String(), tt.header)
+		}
+	}
+}
+
+func TestHTTPRawAttachment(t *testing.T) {
+	tests := []struct {
+		path        string
+		contentType string
+		disposition string
+	}{
+		{"docs/index.html", "text/html; charset=utf-8", "attachment; filename=index.html"},
+		{"logo.svg", "image/svg+xml", "attachment; filename=logo.svg"},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		rawRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/foo/bar/raw/master/"+tt.path, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code, tt.path)
+		assert.Equal(t, tt.contentType, w.Header().Get("Content-Type"), tt.path)
+		assert.Equal(t, tt.disposition, w.Header().Get("Content-Disposition"), tt.path)
+		assert.Equal(t, rawCSP, w.Header().Get("Content-Security-Policy"), tt.path)
+	}
+}
+
+func TestHTTPRawNotFound(t *testing.T) {
+	for _, path := range []string{
+		"/foo/baz/raw/master/README.md",
+		"/foo/bar/raw/unknown/README.md",
+		"/foo/bar/raw/master/LICENSE",
+		"/foo/bar/raw/master",
+	} {
+		w := httptest.NewRecorder()
+		rawRouter().ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		assert.Equal(t, http.StatusNotFound, w.Code, path)
+	}
+}
+
+func TestHTTPRawHead(t *testing.T) {
+	w := httptest.NewRecorder()
+	rawRouter().ServeHTTP(w, httptest.NewRequest(http.MethodHead, "/foo/bar/raw/master/README.md", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "20", w.Header().Get("Content-Length"))
+	assert.Empty(t, w.Body.String())
+}
+
+type blameTestService struct {
+	Service
+}
+
+func (s *blameTestService) Blame(ctx context.Context, owner, name, rev, path string) ([]storage.BlameHunk, error) {
+	if owner != "foo" || name != "bar" {
+		return nil, ErrRepositoryNotFound
+	}
+	if rev != "feature/baz" {
+		return nil, ErrRevNotFound
+	}
+	switch path {
+	case "README.md":
+		return []storage.BlameHunk{{
+			Sha1:    "99cc2f794893815dfc69ab1ba3370ef3e7a9fed2",
+			Summary: "initial commit",
+			Author:  storage.Signature{Name: "Foo Bar", Email: "foo@bar.com", Date: time.Unix(1505935797, 0).UTC()},
+			Line:    1,
+			Lines:   []string{"# foo", "bar"},
+		}}, nil
+	case "large.bin":
+		return nil, ErrFileTooLarge
+	default:
+		return nil, ErrPathNotFound
+	}
+}
+
+func TestHTTPBlame(t *testing.T) {
+	r := chi.NewRouter()
+	r.Mount("/repositories/{owner}/{name}/blame", NewBlameHandler(&blameTestService{}))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/repositories/foo/bar/blame/feature/baz/README.md", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `[{
+		"sha1": "99cc2f794893815dfc69ab1ba3370ef3e7a9fed2",
+		"summary": "initial commit",
+		"author_name": "Foo Bar",
+		"author_email": "foo@bar.com",
+		"author_date": "2017-09-20T19:29:57Z",
+		"line": 1,
+		"lines": ["# foo", "bar"]
+	}]`, w.Body.String())
+
+	for path, code := range map[string]int{
+		"/repositories/foo/baz/blame/master/README.md":      http.StatusNotFound,
+		"/repositories/foo/bar/blame/master/README.md":      http.StatusNotFound,
+		"/repositories/foo/bar/blame/feature/baz/LICENSE":   http.StatusNotFound,
+		"/repositories/foo/bar/blame/feature/baz/large.bin": http.StatusUnprocessableEntity,
+	} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, code, w.Code, path)
+	}
+}
+
+type redirectTestService struct {
+	Service
+}
+
+func (redirectTestService) Redirect(ctx context.Context, owner, name string) (string, string, error) {
+	if owner != "foo" || name != "old" {
+		return "", "", ErrRepositoryNotFound
+	}
+	return "bar", "new", nil
+}
+
+func TestHTTPRedirect(t *testing.T) {
+	r := chi.NewRouter()
+	r.With(Redirect(redirectTestService{})).Mount("/{owner}/{name}.git", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	res := httptest.NewRecorder()
+	r.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/foo/old.git/info/refs?service=git-upload-pack", nil))
+	assert.Equal(t, http.StatusMovedPermanently, res.Code)
+	assert.Equal(t, "/bar/new.git/info/refs?service=git-upload-pack", res.Header().Get("Location"))
+
+	res = httptest.NewRecorder()
+	r.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/foo/old.git/git-upload-pack", nil))
+	assert.Equal(t, http.StatusPermanentRedirect, res.Code)
+	assert.Equal(t, "/bar/new.git/git-upload-pack", res.Header().Get("Location"))
+
+	res = httptest.NewRecorder()
+	r.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/foo/bar.git/info/refs", nil))
+	assert.Equal(t, http.StatusTeapot, res.Code)
+}
+
+type gitAccessTestService struct {
+	Service
+}
+
+func (gitAccessTestService) Find(ctx context.Context, owner, name string) (*Repository, string, error) {
+	r := &Repository{Name: name, Private: name == "private"}
+	if owner != "foo" || !canView(ctx, r, owner) {
+		return nil, "", ErrRepositoryNotFound
+	}
+	return r, owner, nil
+}
+
+type gitAccessTestAuthenticator struct{}
+
+func (gitAccessTestAuthenticator) AuthenticateUser(ctx context.Context, email, password string) (*user.User, error) {
+	if password != "secret" {
+		return nil, errors.New("incorrect email or password")
+	}
+	username := strings.TrimSuffix(email, "@example.com")
+	return &user.User{ID: username + "-id", Username: username}, nil
+}
+
+func TestHTTPGitAccess(t *testing.T) {
+	r := chi.NewRouter()
+	r.With(GitAccess(gitAccessTestService{}, gitAccessTestAuthenticator{})).Mount("/{owner}/{name}.git", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		email    string
+		password string
+		code     int
+	}{
+		{name: "fetch public", method: http.MethodGet, path: "/foo/public.git/info/refs?service=git-upload-pack", code: http.StatusTeapot},
+		{name: "fetch private anonymously", method: http.MethodGet, path: "/foo/private.git/info/refs?service=git-upload-pack", code: http.StatusUnauthorized},
+		{name: "fetch private as owner", method: http.MethodPost, path: "/foo/private.git/git-upload-pack", email: "foo@example.com", password: "secret", code: http.StatusTeapot},
+		{name: "fetch private as other user", method: http.MethodPost, path: "/foo/private.git/git-upload-pack", email: "bar@example.com", password: "secret", code: http.StatusNotFound},
+		{name: "fetch with wrong password", method: http.MethodGet, path: "/foo/public.git/info/refs?service=git-upload-pack", email: "foo@example.com", password: "wrong", code: http.StatusUnauthorized},
+		{name: "push anonymously", method: http.MethodGet, path: "/foo/public.git/info/refs?service=git-receive-pack", code: http.StatusUnauthorized},
+		{name: "push as owner", method: http.MethodPost, path: "/foo/public.git/git-receive-pack", email: "foo@example.com", password: "secret", code: http.StatusTeapot},
+		{name: "push as other user", method: http.MethodPost, path: "/foo/public.git/git-receive-pack", email: "bar@example.com", password: "secret", code: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.email != "" {
+				req.SetBasicAuth(tt.email, tt.password)
+			}
+
+			res := httptest.NewRecorder()
+			r.ServeHTTP(res, req)
+			assert.Equal(t, tt.code, res.Code)
+			if tt.code == http.StatusUnauthorized {
+				assert.Equal(t, `Basic realm="SourcePods"`, res.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -2,10 +2,12 @@ package repository
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
 	"github.com/sourcepods/sourcepods/pkg/storage"
 )
 
@@ -23,18 +25,20 @@ func NewLoggingService(s Service, requestID LoggingRequestID, logger log.Logger)
 	return &loggingService{service: s, requestID: requestID, logger: logger}
 }
 
-func (s *loggingService) List(ctx context.Context, owner string) ([]*Repository, string, error) {
+func (s *loggingService) List(ctx context.Context, owner string, opts ListOptions) ([]*Repository, string, error) {
 	start := time.Now()
 
-	repositories, owner, err := s.service.List(ctx, owner)
+	repositories, next, err := s.service.List(ctx, owner, opts)
 
 	logger := log.With(s.logger,
 		"request", s.requestID(ctx),
 		"method", "List",
 		"duration", time.Since(start),
+		"sort", opts.Sort,
+		"cursor", opts.Page.Cursor,
 	)
 
-	if err != nil {
+	if err != nil && err != pagination.ErrCursorInvalid {
 		level.Warn(logger).Log(
 			"msg", "failed to list repositories by owner's username",
 			"err", err,
@@ -43,7 +47,7 @@ func (s *loggingService) List(ctx context.Context, owner string) ([]*Repository,
 		level.Debug(logger).Log()
 	}
 
-	return repositories, owner, err
+	return repositories, next, err
 
 }
 
@@ -97,10 +101,207 @@ func (s *loggingService) Create(ctx context.Context, owner string, repository *R
 
 	return repository, err
 }
-func (s *loggingService) Branches(ctx context.Context, owner string, name string) ([]*Branch, error) {
+
+func (s *loggingService) Delete(ctx context.Context, owner, name string) error {
+	start := time.Now()
+
+	err := s.service.Delete(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Delete",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound && err != ErrPermissionDenied {
+		level.Warn(logger).Log(
+			"msg", "failed to delete repository",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) Update(ctx context.Context, owner, name string, update Update) (*Repository, error) {
+	start := time.Now()
+
+	r, err := s.service.Update(ctx, owner, name, update)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Update",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUpdateUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to update repository",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return r, err
+}
+
+func (s *loggingService) Transfer(ctx context.Context, owner, name, newOwner string) (*Repository, error) {
+	start := time.Now()
+
+	r, err := s.service.Transfer(ctx, owner, name, newOwner)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Transfer",
+		"owner", owner,
+		"name", name,
+		"new_owner", newOwner,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUpdateUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to transfer repository",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return r, err
+}
+
+func isUpdateUserError(err error) bool {
+	if _, ok := err.(ValidationErrors); ok {
+		return true
+	}
+	switch err {
+	case ErrRepositoryNotFound, ErrPermissionDenied, ErrAlreadyExists, ErrOwnerNotFound, ErrBranchNotFound:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *loggingService) Redirect(ctx context.Context, owner, name string) (string, string, error) {
+	start := time.Now()
+
+	newOwner, newName, err := s.service.Redirect(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Redirect",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound {
+		level.Warn(logger).Log(
+			"msg", "failed to find redirect of repository",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return newOwner, newName, err
+}
+
+func (s *loggingService) Fork(ctx context.Context, owner, name, forkName string) (*Repository, error) {
+	start := time.Now()
+
+	r, err := s.service.Fork(ctx, owner, name, forkName)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Fork",
+		"owner", owner,
+		"name", name,
+		"fork", forkName,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isForkUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to fork repository",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return r, err
+}
+
+func isForkUserError(err error) bool {
+	if _, ok := err.(ValidationErrors); ok {
+		return true
+	}
+	return err == ErrRepositoryNotFound || err == ErrAlreadyExists || err == ErrPermissionDenied
+}
+
+func (s *loggingService) Forks(ctx context.Context, owner, name string) ([]*OwnedRepository, error) {
+	start := time.Now()
+
+	forks, err := s.service.Forks(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Forks",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound {
+		level.Warn(logger).Log(
+			"msg", "failed to list forks",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return forks, err
+}
+
+func (s *loggingService) Network(ctx context.Context, owner, name string) ([]*OwnedRepository, error) {
+	start := time.Now()
+
+	network, err := s.service.Network(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Network",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound {
+		level.Warn(logger).Log(
+			"msg", "failed to list fork network",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return network, err
+}
+
+func (s *loggingService) Branches(ctx context.Context, owner string, name string, opts BranchesOptions) ([]*Branch, string, error) {
 	start := time.Now()
 
-	branches, err := s.service.Branches(ctx, owner, name)
+	branches, next, err := s.service.Branches(ctx, owner, name, opts)
 
 	logger := log.With(s.logger,
 		"request", s.requestID(ctx),
@@ -109,7 +310,7 @@ func (s *loggingService) Branches(ctx context.Context, owner string, name string
 	)
 
 	if err != nil {
-		if err != ErrAlreadyExists {
+		if err != ErrAlreadyExists && err != pagination.ErrCursorInvalid {
 			level.Warn(logger).Log(
 				"msg", "failed to list branches",
 				"err", err,
@@ -119,10 +320,111 @@ func (s *loggingService) Branches(ctx context.Context, owner string, name string
 		level.Debug(logger).Log(
 			"owner", owner,
 			"name", name,
+			"prefix", opts.Prefix,
+			"sort", opts.Sort,
+			"cursor", opts.Page.Cursor,
+		)
+	}
+
+	return branches, next, err
+}
+
+func (s *loggingService) CreateBranch(ctx context.Context, owner, name, branch, rev string) (*Branch, error) {
+	start := time.Now()
+
+	b, err := s.service.CreateBranch(ctx, owner, name, branch, rev)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "CreateBranch",
+		"owner", owner,
+		"name", name,
+		"branch", branch,
+		"rev", rev,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isBranchUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to create branch",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return b, err
+}
+
+func (s *loggingService) DeleteBranch(ctx context.Context, owner, name, branch, sha1 string) error {
+	start := time.Now()
+
+	err := s.service.DeleteBranch(ctx, owner, name, branch, sha1)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "DeleteBranch",
+		"owner", owner,
+		"name", name,
+		"branch", branch,
+		"sha1", sha1,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isBranchUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to delete branch",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) RenameBranch(ctx context.Context, owner, name, branch, newName string) (*Branch, error) {
+	start := time.Now()
+
+	b, err := s.service.RenameBranch(ctx, owner, name, branch, newName)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "RenameBranch",
+		"owner", owner,
+		"name", name,
+		"branch", branch,
+		"new_name", newName,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isBranchUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to rename branch",
+			"err", err,
 		)
+	} else {
+		level.Debug(logger).Log()
 	}
 
-	return branches, err
+	return b, err
+}
+
+// isBranchUserError returns true for errors caused by the request, which aren't worth a warning.
+func isBranchUserError(err error) bool {
+	switch err {
+	case ErrRepositoryNotFound,
+		ErrRevNotFound,
+		ErrBranchNotFound,
+		ErrBranchAlreadyExists,
+		ErrBranchNameInvalid,
+		ErrBranchChanged,
+		ErrBranchProtected,
+		ErrPermissionDenied:
+		return true
+	default:
+		return false
+	}
 }
 
 func (s *loggingService) Commit(ctx context.Context, owner string, name string, rev string) (storage.Commit, error) {
@@ -177,3 +479,418 @@ func (s *loggingService) Tree(ctx context.Context, owner, name, rev, path string
 
 	return tree, err
 }
+
+func (s *loggingService) Archive(ctx context.Context, owner, name, rev, format, prefix string, w io.Writer) error {
+	start := time.Now()
+
+	err := s.service.Archive(ctx, owner, name, rev, format, prefix, w)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Archive",
+		"owner", owner,
+		"name", name,
+		"rev", rev,
+		"format", format,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound && err != ErrRevNotFound && err != ErrArchiveFormatInvalid {
+		level.Warn(logger).Log(
+			"msg", "failed to archive repository",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) Blob(ctx context.Context, owner, name, rev, path string) (storage.Blob, error) {
+	start := time.Now()
+
+	blob, err := s.service.Blob(ctx, owner, name, rev, path)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Blob",
+		"owner", owner,
+		"name", name,
+		"rev", rev,
+		"path", path,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound && err != ErrRevNotFound && err != ErrPathNotFound {
+		level.Warn(logger).Log(
+			"msg", "failed to get blob",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return blob, err
+}
+
+func (s *loggingService) ReadBlob(ctx context.Context, owner, name, sha1 string, offset, limit int64, w io.Writer) error {
+	start := time.Now()
+
+	err := s.service.ReadBlob(ctx, owner, name, sha1, offset, limit, w)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "ReadBlob",
+		"owner", owner,
+		"name", name,
+		"sha1", sha1,
+		"offset", offset,
+		"limit", limit,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound && err != ErrPathNotFound {
+		level.Warn(logger).Log(
+			"msg", "failed to read blob",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) Blame(ctx context.Context, owner, name, rev, path string) ([]storage.BlameHunk, error) {
+	start := time.Now()
+
+	hunks, err := s.service.Blame(ctx, owner, name, rev, path)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Blame",
+		"owner", owner,
+		"name", name,
+		"rev", rev,
+		"path", path,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound && err != ErrRevNotFound && err != ErrPathNotFound && err != ErrFileTooLarge {
+		level.Warn(logger).Log(
+			"msg", "failed to blame file",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return hunks, err
+}
+
+func (s *loggingService) Search(ctx context.Context, owner, name, rev string, opts storage.SearchOptions) ([]storage.SearchMatch, error) {
+	start := time.Now()
+
+	matches, err := s.service.Search(ctx, owner, name, rev, opts)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Search",
+		"owner", owner,
+		"name", name,
+		"rev", rev,
+		"query", opts.Query,
+		"regexp", opts.Regexp,
+		"matches", len(matches),
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound && err != ErrRevNotFound && err != ErrSearchQueryInvalid {
+		level.Warn(logger).Log(
+			"msg", "failed to search repository",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return matches, err
+}
+
+func (s *loggingService) SearchCode(ctx context.Context, opts storage.IndexSearchOptions) ([]*CodeMatch, error) {
+	start := time.Now()
+
+	matches, err := s.service.SearchCode(ctx, opts)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "SearchCode",
+		"query", opts.Query,
+		"matches", len(matches),
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrSearchQueryInvalid {
+		level.Warn(logger).Log(
+			"msg", "failed to search code",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return matches, err
+}
+
+func (s *loggingService) SearchRepositories(ctx context.Context, opts SearchOptions) ([]*OwnedRepository, string, error) {
+	start := time.Now()
+
+	results, next, err := s.service.SearchRepositories(ctx, opts)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "SearchRepositories",
+		"query", opts.Query,
+		"cursor", opts.Page.Cursor,
+		"results", len(results),
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrSearchQueryInvalid && err != pagination.ErrCursorInvalid {
+		level.Warn(logger).Log(
+			"msg", "failed to search repositories",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return results, next, err
+}
+
+func (s *loggingService) Readme(ctx context.Context, owner, name, rev string) (*Readme, error) {
+	start := time.Now()
+
+	readme, err := s.service.Readme(ctx, owner, name, rev)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Readme",
+		"owner", owner,
+		"name", name,
+		"rev", rev,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound && err != ErrRevNotFound && err != ErrReadmeNotFound && err != ErrFileTooLarge {
+		level.Warn(logger).Log(
+			"msg", "failed to get readme",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return readme, err
+}
+
+func (s *loggingService) Stats(ctx context.Context, owner, name string) (*Stats, error) {
+	start := time.Now()
+
+	stats, err := s.service.Stats(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Stats",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound {
+		level.Warn(logger).Log(
+			"msg", "failed to get repository stats",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return stats, err
+}
+
+func (s *loggingService) Star(ctx context.Context, owner, name string) error {
+	start := time.Now()
+
+	err := s.service.Star(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Star",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound && err != ErrPermissionDenied {
+		level.Warn(logger).Log(
+			"msg", "failed to star repository",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) Unstar(ctx context.Context, owner, name string) error {
+	start := time.Now()
+
+	err := s.service.Unstar(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Unstar",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound && err != ErrPermissionDenied {
+		level.Warn(logger).Log(
+			"msg", "failed to unstar repository",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) IsStarred(ctx context.Context, owner, name string) (bool, error) {
+	start := time.Now()
+
+	starred, err := s.service.IsStarred(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "IsStarred",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound && err != ErrPermissionDenied {
+		level.Warn(logger).Log(
+			"msg", "failed to check if repository is starred",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return starred, err
+}
+
+func (s *loggingService) Watch(ctx context.Context, owner, name string) error {
+	start := time.Now()
+
+	err := s.service.Watch(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Watch",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound && err != ErrPermissionDenied {
+		level.Warn(logger).Log(
+			"msg", "failed to watch repository",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) Unwatch(ctx context.Context, owner, name string) error {
+	start := time.Now()
+
+	err := s.service.Unwatch(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Unwatch",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound && err != ErrPermissionDenied {
+		level.Warn(logger).Log(
+			"msg", "failed to unwatch repository",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return err
+}
+
+func (s *loggingService) Watchers(ctx context.Context, owner, name string) ([]*Watcher, error) {
+	start := time.Now()
+
+	watchers, err := s.service.Watchers(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Watchers",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrRepositoryNotFound && err != ErrPermissionDenied {
+		level.Warn(logger).Log(
+			"msg", "failed to list watchers",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return watchers, err
+}
+
+func (s *loggingService) Starred(ctx context.Context, username string, opts pagination.Options) ([]*StarredRepository, string, error) {
+	start := time.Now()
+
+	starred, next, err := s.service.Starred(ctx, username, opts)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Starred",
+		"username", username,
+		"cursor", opts.Cursor,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && err != ErrOwnerNotFound && err != pagination.ErrCursorInvalid {
+		level.Warn(logger).Log(
+			"msg", "failed to list starred repositories",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return starred, next, err
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/sourcepods/sourcepods/pkg/storage"
+)
+
+// readmeMaxSize is the size of the largest README that is returned.
+const readmeMaxSize = 1 << 20
+
+// Readme of a repository.
+type Readme struct {
+	// Path of the README in the repository, like README.md.
+	Path string
+	Sha1 string
+	Size int64
+	// Rev the README was found at, the default branch if none was requested.
+	Rev     string
+	Content string
+	// Markdown is true if the README is Markdown, other READMEs are plain text.
+	Markdown bool
+}
+
+// Readme finds the README in the root of the repository at rev and returns it with its content.
+// The default branch is used if rev is empty.
+func (s *service) Readme(ctx context.Context, owner, name, rev string) (*Readme, error) {
+	r, _, err := s.find(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if rev == "" {
+		rev = defaultBranch(r)
+	}
+
+	entries, err := s.storage.Tree(ctx, r.ID, rev, ".")
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	entry, ok := findReadme(entries)
+	if !ok {
+		return nil, ErrReadmeNotFound
+	}
+
+	blob, err := s.storage.Blob(ctx, r.ID, rev, entry.Path)
+	if err != nil {
+		return nil, storageError(err)
+	}
+	if blob.Size > readmeMaxSize {
+		return nil, ErrFileTooLarge
+	}
+
+	var content bytes.Buffer
+	if err := s.storage.ReadBlob(ctx, r.ID, blob.Sha1, 0, 0, &content); err != nil {
+		return nil, storageError(err)
+	}
+
+	return &Readme{
+		Path:     entry.Path,
+		Sha1:     blob.Sha1,
+		Size:     blob.Size,
+		Rev:      rev,
+		Content:  content.String(),
+		Markdown: isMarkdown(entry.Path),
+	}, nil
+}
+
+// findReadme returns the README of the tree's files, preferring Markdown if there are multiple.
+func findReadme(entries []storage.TreeEntry) (storage.TreeEntry, bool) {
+	var readmes []storage.TreeEntry
+	for _, e := range entries {
+		if e.Type != "blob" {
+			continue
+		}
+		name := strings.ToLower(e.Path)
+		if name == "readme" || strings.HasPrefix(name, "readme.") {
+			readmes = append(readmes, e)
+		}
+	}
+	if len(readmes) == 0 {
+		return storage.TreeEntry{}, false
+	}
+
+	sort.SliceStable(readmes, func(i, j int) bool {
+		return isMarkdown(readmes[i].Path) && !isMarkdown(readmes[j].Path)
+	})
+	return readmes[0], true
+}
+
+func isMarkdown(name string) bool {
+	switch strings.ToLower(path.Ext(name)) {
+	case ".md", ".markdown", ".mdown", ".mkd":
+		return true
+	}
+	return false
+}
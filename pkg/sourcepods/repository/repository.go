@@ -1,6 +1,11 @@
 package repository
 
-import "time"
+import (
+	"time"
+
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+)
 
 // Repository containing source code.
 type Repository struct {
@@ -9,8 +14,29 @@ type Repository struct {
 	Description   string
 	Website       string
 	DefaultBranch string
+	Private       bool
 	Created       time.Time
 	Updated       time.Time
+
+	// ParentID is the repository this one was forked from, empty if it isn't a fork.
+	ParentID string
+
+	// Topics tag the repository to be found by them, sorted by name.
+	Topics []string
+
+	// Stars and Watchers count the users who starred and watch the repository.
+	Stars    int
+	Watchers int
+}
+
+// Update of a Repository, nil fields are left unchanged.
+type Update struct {
+	Name          *string
+	Description   *string
+	Website       *string
+	DefaultBranch *string
+	// Topics replace all topics of the repository.
+	Topics *[]string
 }
 
 // Branch of a Repository.
@@ -19,4 +45,75 @@ type Branch struct {
 	Sha1      string
 	Type      string
 	Protected bool
+
+	// Subject and Author of the branch's last commit.
+	Subject string
+	Author  storage.Signature
+
+	// Ahead and Behind count the commits compared to the default branch.
+	Ahead  int
+	Behind int
+}
+
+// BranchesOptions filter and sort a repository's branches.
+type BranchesOptions struct {
+	// Prefix only returns branches whose name starts with it.
+	Prefix string
+	// Sort is storage.BranchSortName or storage.BranchSortCommitterDate.
+	Sort string
+	Page pagination.Options
+}
+
+// CodeMatch is a line matching a code search on the default branch of a repository.
+type CodeMatch struct {
+	Owner      string
+	Repository string
+	Path       string
+	Line       int
+	Text       string
+}
+
+// Sort orders for searching and listing repositories.
+const (
+	// SortName sorts repositories by name.
+	SortName = "name"
+	// SortUpdated sorts repositories by their last update, most recent first.
+	SortUpdated = "updated"
+)
+
+// SearchOptions for searching repositories by their name, description and topics.
+type SearchOptions struct {
+	// Query has the words to search for, words like topic:go only find repositories with the topic.
+	Query string
+	// Sort is SortName or SortUpdated,
+	// by default the repositories matching the query best come first.
+	Sort string
+	Page pagination.Options
+}
+
+// ListOptions sort and page an owner's repositories.
+type ListOptions struct {
+	// Sort is SortUpdated (default) or SortName.
+	Sort string
+	Page pagination.Options
+}
+
+// StarredRepository is a repository starred by a user.
+type StarredRepository struct {
+	OwnedRepository
+	Starred time.Time
+}
+
+// Watcher is a user watching a repository, who is notified about its activity.
+type Watcher struct {
+	UserID   string
+	Username string
+	Watched  time.Time
+}
+
+// OwnedRepository is a repository together with its owner, e.g. found by searching.
+type OwnedRepository struct {
+	Repository *Repository
+	OwnerID    string
+	Owner      string
 }
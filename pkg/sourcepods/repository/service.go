@@ -3,7 +3,15 @@ package repository
 import (
 	"context"
 	"errors"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
 	"github.com/sourcepods/sourcepods/pkg/storage"
 )
 
@@ -16,58 +24,213 @@ var (
 
 	// ErrAlreadyExists returned if a repository with the same name for that owner already exists.
 	ErrAlreadyExists = errors.New("repository already exists")
+
+	// ErrRevNotFound returned if a rev can't be resolved to a commit.
+	ErrRevNotFound = errors.New("rev not found")
+
+	// ErrBranchNotFound returned if a branch is not found.
+	ErrBranchNotFound = errors.New("branch not found")
+
+	// ErrBranchAlreadyExists returned if a branch with the same name already exists.
+	ErrBranchAlreadyExists = errors.New("branch already exists")
+
+	// ErrBranchNameInvalid returned if a branch name isn't valid for git.
+	ErrBranchNameInvalid = errors.New("branch name is not valid")
+
+	// ErrBranchChanged returned if a branch was changed concurrently, e.g. by a push.
+	ErrBranchChanged = errors.New("branch has been changed")
+
+	// ErrBranchProtected returned if a protected branch should be deleted or renamed.
+	ErrBranchProtected = errors.New("branch is protected")
+
+	// ErrPathNotFound returned if there's no file at a path in the repository.
+	ErrPathNotFound = errors.New("path not found")
+
+	// ErrArchiveFormatInvalid returned if an archive format isn't supported.
+	ErrArchiveFormatInvalid = errors.New("archive format is not valid")
+
+	// ErrFileTooLarge returned if a file is too large to be blamed or rendered.
+	ErrFileTooLarge = errors.New("file is too large")
+
+	// ErrBlameTimeout returned if blaming a file took too long.
+	ErrBlameTimeout = errors.New("blame took too long")
+
+	// ErrSearchQueryInvalid returned if a search query is empty or not a valid regular expression.
+	ErrSearchQueryInvalid = errors.New("search query is not valid")
+
+	// ErrSearchTimeout returned if searching a repository took too long.
+	ErrSearchTimeout = errors.New("search took too long")
+
+	// ErrPermissionDenied returned if the user isn't allowed to change a repository.
+	ErrPermissionDenied = errors.New("permission denied")
+
+	// ErrReadmeNotFound returned if a repository has no README at a rev.
+	ErrReadmeNotFound = errors.New("readme not found")
 )
 
 type (
 	// Store or retrieve repositories from some database.
 	Store interface {
-		List(ctx context.Context, owner string) ([]*Repository, string, error)
+		List(ctx context.Context, owner, username string, opts ListOptions) ([]*Repository, string, error)
 		Find(ctx context.Context, owner, name string) (*Repository, string, error)
 		Create(ctx context.Context, owner string, repository *Repository) (*Repository, error)
+		ListVisible(ctx context.Context, username string) (map[string][]*Repository, error)
+		Search(ctx context.Context, username string, opts SearchOptions) ([]*OwnedRepository, string, error)
+		FindByID(ctx context.Context, id string) (*OwnedRepository, error)
+		ListForks(ctx context.Context, ids []string) ([]*OwnedRepository, error)
+		Delete(ctx context.Context, id string) ([]string, error)
+		Update(ctx context.Context, r *Repository) (*Repository, error)
+		Transfer(ctx context.Context, id, owner string) (*Repository, error)
+		FindRedirect(ctx context.Context, owner, name string) (string, error)
+		Star(ctx context.Context, userID, id string) error
+		Unstar(ctx context.Context, userID, id string) error
+		IsStarred(ctx context.Context, userID, id string) (bool, error)
+		ListStarred(ctx context.Context, username, viewer string, opts pagination.Options) ([]*StarredRepository, string, error)
+		Watch(ctx context.Context, userID, id string) error
+		Unwatch(ctx context.Context, userID, id string) error
+		ListWatchers(ctx context.Context, id string) ([]*Watcher, error)
 	}
 
 	// Storage manages the git storage
 	Storage interface {
 		Create(ctx context.Context, id string) error
+		Fork(ctx context.Context, id, sourceID string) error
+		Delete(ctx context.Context, id string) error
+		Dissociate(ctx context.Context, id string) error
 		SetDescription(ctx context.Context, id, description string) error
-		Branches(ctx context.Context, id string) ([]storage.Branch, error)
+		Branches(ctx context.Context, id string, opts storage.ListBranchesOptions) ([]storage.Branch, error)
+		CreateBranch(ctx context.Context, id, name, rev string) (storage.Branch, error)
+		DeleteBranch(ctx context.Context, id, name, sha1 string) error
+		RenameBranch(ctx context.Context, id, name, newName string) (storage.Branch, error)
+		SetDefaultBranch(ctx context.Context, id, name string) error
 		Commit(ctx context.Context, id, rev string) (storage.Commit, error)
 		Tree(ctx context.Context, id, rev, path string) ([]storage.TreeEntry, error)
+		Archive(ctx context.Context, id, rev, format, prefix string, w io.Writer) error
+		Blob(ctx context.Context, id, rev, path string) (storage.Blob, error)
+		ReadBlob(ctx context.Context, id, sha1 string, offset, limit int64, w io.Writer) error
+		Blame(ctx context.Context, id, rev, path string) ([]storage.BlameHunk, error)
+		Search(ctx context.Context, id, rev string, opts storage.SearchOptions) ([]storage.SearchMatch, error)
+		SearchIndex(ctx context.Context, ids []string, opts storage.IndexSearchOptions) ([]storage.IndexMatch, error)
+		Stats(ctx context.Context, id string) (storage.Stats, error)
+	}
+
+	// Protections finds the branches protected by required checks of commit statuses.
+	Protections interface {
+		ProtectedBranches(ctx context.Context, repositoryID string) ([]string, error)
 	}
 
 	// Service to interact with repositories.
 	Service interface {
-		List(ctx context.Context, owner string) ([]*Repository, string, error)
+		List(ctx context.Context, owner string, opts ListOptions) ([]*Repository, string, error)
 		Find(ctx context.Context, owner, name string) (*Repository, string, error)
 		Create(ctx context.Context, owner string, repository *Repository) (*Repository, error)
-		Branches(ctx context.Context, owner, name string) ([]*Branch, error)
+		Delete(ctx context.Context, owner, name string) error
+		Update(ctx context.Context, owner, name string, update Update) (*Repository, error)
+		Transfer(ctx context.Context, owner, name, newOwner string) (*Repository, error)
+		Redirect(ctx context.Context, owner, name string) (string, string, error)
+		Fork(ctx context.Context, owner, name, forkName string) (*Repository, error)
+		Forks(ctx context.Context, owner, name string) ([]*OwnedRepository, error)
+		Network(ctx context.Context, owner, name string) ([]*OwnedRepository, error)
+		Branches(ctx context.Context, owner, name string, opts BranchesOptions) ([]*Branch, string, error)
+		CreateBranch(ctx context.Context, owner, name, branch, rev string) (*Branch, error)
+		DeleteBranch(ctx context.Context, owner, name, branch, sha1 string) error
+		RenameBranch(ctx context.Context, owner, name, branch, newName string) (*Branch, error)
 		Commit(ctx context.Context, owner, name, rev string) (storage.Commit, error)
 		Tree(ctx context.Context, owner, name, rev, path string) ([]storage.TreeEntry, error)
+		Archive(ctx context.Context, owner, name, rev, format, prefix string, w io.Writer) error
+		Blob(ctx context.Context, owner, name, rev, path string) (storage.Blob, error)
+		ReadBlob(ctx context.Context, owner, name, sha1 string, offset, limit int64, w io.Writer) error
+		Blame(ctx context.Context, owner, name, rev, path string) ([]storage.BlameHunk, error)
+		Readme(ctx context.Context, owner, name, rev string) (*Readme, error)
+		Search(ctx context.Context, owner, name, rev string, opts storage.SearchOptions) ([]storage.SearchMatch, error)
+		SearchCode(ctx context.Context, opts storage.IndexSearchOptions) ([]*CodeMatch, error)
+		SearchRepositories(ctx context.Context, opts SearchOptions) ([]*OwnedRepository, string, error)
+		Stats(ctx context.Context, owner, name string) (*Stats, error)
+		Star(ctx context.Context, owner, name string) error
+		Unstar(ctx context.Context, owner, name string) error
+		IsStarred(ctx context.Context, owner, name string) (bool, error)
+		Starred(ctx context.Context, username string, opts pagination.Options) ([]*StarredRepository, string, error)
+		Watch(ctx context.Context, owner, name string) error
+		Unwatch(ctx context.Context, owner, name string) error
+		Watchers(ctx context.Context, owner, name string) ([]*Watcher, error)
 	}
 
 	service struct {
 		repositories Store
 		storage      Storage
+		stats        *StatsCache
+		protections  Protections
 	}
 )
 
 // NewService to interact with repositories.
-func NewService(repositories Store, storage Storage) Service {
+// The stats of repositories are cached in stats, unless it's nil.
+func NewService(repositories Store, storage Storage, stats *StatsCache, protections Protections) Service {
 	return &service{
 		repositories: repositories,
 		storage:      storage,
+		stats:        stats,
+		protections:  protections,
 	}
 }
 
-func (s *service) List(ctx context.Context, owner string) ([]*Repository, string, error) {
-	return s.repositories.List(ctx, owner)
+// List a page of the owner's repositories visible to the session's user.
+// It returns the cursor of the next page if there's one.
+func (s *service) List(ctx context.Context, owner string, opts ListOptions) ([]*Repository, string, error) {
+	if opts.Sort != SortName {
+		opts.Sort = SortUpdated
+	}
+
+	var username string
+	if u := session.GetSessionUser(ctx); u != nil {
+		username = u.Username
+	}
+
+	list, next, err := s.repositories.List(ctx, owner, username, opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var visible []*Repository
+	for _, r := range list {
+		if canView(ctx, r, owner) {
+			visible = append(visible, r)
+		}
+	}
+
+	return visible, next, nil
 }
 
 func (s *service) Find(ctx context.Context, owner, name string) (*Repository, string, error) {
-	return s.repositories.Find(ctx, owner, name)
+	return s.find(ctx, owner, name)
+}
+
+// find a repository and make sure the current user is allowed to see it.
+// Private repositories are returned as not found to everyone else, to not leak their existence.
+func (s *service) find(ctx context.Context, owner, name string) (*Repository, string, error) {
+	r, owner, err := s.repositories.Find(ctx, owner, name)
+	if err != nil {
+		return r, owner, err
+	}
+
+	if !canView(ctx, r, owner) {
+		return nil, "", ErrRepositoryNotFound
+	}
+
+	return r, owner, nil
+}
+
+// canView returns true if the repository is public or the session's user owns it.
+func canView(ctx context.Context, r *Repository, owner string) bool {
+	if !r.Private {
+		return true
+	}
+	u := session.GetSessionUser(ctx)
+	return u != nil && u.Username == owner
 }
 
 func (s *service) Create(ctx context.Context, owner string, repository *Repository) (*Repository, error) {
+	repository.Topics = normalizeTopics(repository.Topics)
 	if err := ValidateCreate(repository); err != nil {
 		return nil, err
 	}
@@ -88,48 +251,793 @@ func (s *service) Create(ctx context.Context, owner string, repository *Reposito
 	return r, nil
 }
 
-func (s *service) Branches(ctx context.Context, owner, name string) ([]*Branch, error) {
+// Delete the repository, only its owner may do so.
+// Forks borrowing its objects copy them beforehand and aren't forks anymore afterwards.
+func (s *service) Delete(ctx context.Context, owner, name string) error {
+	r, _, err := s.find(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+
+	if u := session.GetSessionUser(ctx); u == nil || u.Username != owner {
+		return ErrPermissionDenied
+	}
+
+	forks, err := s.repositories.ListForks(ctx, []string{r.ID})
+	if err != nil {
+		return err
+	}
+
+	dissociated := map[string]bool{}
+	for _, f := range forks {
+		if err := s.storage.Dissociate(ctx, f.Repository.ID); err != nil {
+			return storageError(err)
+		}
+		dissociated[f.Repository.ID] = true
+	}
+
+	detached, err := s.repositories.Delete(ctx, r.ID)
+	if err != nil {
+		return err
+	}
+	s.stats.Invalidate(r.ID)
+	if r.ParentID != "" {
+		s.stats.Invalidate(r.ParentID)
+	}
+
+	// Forks created in the meantime still borrow objects,
+	// keep them in storage if they can't be copied.
+	for _, id := range detached {
+		if dissociated[id] {
+			continue
+		}
+		if err := s.storage.Dissociate(ctx, id); err != nil {
+			return storageError(err)
+		}
+	}
+
+	return storageError(s.storage.Delete(ctx, r.ID))
+}
+
+// Update the name, description, website or default branch of a repository, only its owner may do so.
+// The old name keeps redirecting to the repository until another one takes it.
+func (s *service) Update(ctx context.Context, owner, name string, update Update) (*Repository, error) {
+	r, owner, err := s.find(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if u := session.GetSessionUser(ctx); u == nil || u.Username != owner {
+		return nil, ErrPermissionDenied
+	}
+
+	changed := *r
+	updated := &changed
+	if update.Name != nil {
+		updated.Name = *update.Name
+	}
+	if update.Description != nil {
+		updated.Description = *update.Description
+	}
+	if update.Website != nil {
+		updated.Website = *update.Website
+	}
+	if update.DefaultBranch != nil {
+		updated.DefaultBranch = *update.DefaultBranch
+	}
+	if update.Topics != nil {
+		updated.Topics = normalizeTopics(*update.Topics)
+	}
+	if err := ValidateCreate(updated); err != nil {
+		return nil, err
+	}
+
+	defaultBranchChanged := updated.DefaultBranch != r.DefaultBranch
+	descriptionChanged := updated.Description != r.Description
+
+	if defaultBranchChanged {
+		if _, err := s.storage.Commit(ctx, r.ID, "refs/heads/"+updated.DefaultBranch); err != nil {
+			if err == storage.ErrRevNotFound {
+				return nil, ErrBranchNotFound
+			}
+			return nil, storageError(err)
+		}
+	}
+
+	updated, err = s.repositories.Update(ctx, updated)
+	if err != nil {
+		return nil, err
+	}
+
+	if defaultBranchChanged {
+		if err := s.storage.SetDefaultBranch(ctx, r.ID, updated.DefaultBranch); err != nil {
+			return updated, storageError(err)
+		}
+	}
+
+	if descriptionChanged {
+		if err := s.storage.SetDescription(ctx, r.ID, updated.Description); err != nil {
+			return updated, err
+		}
+	}
+
+	return updated, nil
+}
+
+// Transfer the repository to another owner, only its owner may do so.
+// The old owner/name keeps redirecting to the repository until another one takes it.
+func (s *service) Transfer(ctx context.Context, owner, name, newOwner string) (*Repository, error) {
+	r, owner, err := s.find(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if u := session.GetSessionUser(ctx); u == nil || u.Username != owner {
+		return nil, ErrPermissionDenied
+	}
+
+	if newOwner == owner {
+		return r, nil
+	}
+
+	return s.repositories.Transfer(ctx, r.ID, newOwner)
+}
+
+// Redirect returns the current owner and name of a repository that was renamed or transferred away from owner/name.
+// ErrRepositoryNotFound is returned if there's no such repository visible to the session's user.
+func (s *service) Redirect(ctx context.Context, owner, name string) (string, string, error) {
+	id, err := s.repositories.FindRedirect(ctx, owner, name)
+	if err != nil {
+		return "", "", err
+	}
+
+	o, err := s.repositories.FindByID(ctx, id)
+	if err != nil {
+		return "", "", err
+	}
+
+	if !canView(ctx, o.Repository, o.Owner) {
+		return "", "", ErrRepositoryNotFound
+	}
+
+	return o.Owner, o.Repository.Name, nil
+}
+
+// Fork the repository into the session user's repositories.
+// The fork is named like the repository unless forkName is given.
+func (s *service) Fork(ctx context.Context, owner, name, forkName string) (*Repository, error) {
+	source, _, err := s.find(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return nil, ErrPermissionDenied
+	}
+
+	if forkName == "" {
+		forkName = source.Name
+	}
+
+	fork := &Repository{
+		Name:          forkName,
+		Description:   source.Description,
+		Website:       source.Website,
+		DefaultBranch: source.DefaultBranch,
+		Private:       source.Private,
+		ParentID:      source.ID,
+	}
+	if err := ValidateCreate(fork); err != nil {
+		return nil, err
+	}
+
+	r, err := s.repositories.Create(ctx, u.Username, fork)
+	if err != nil {
+		return nil, err
+	}
+	s.stats.Invalidate(source.ID)
+
+	if err := s.storage.Fork(ctx, r.ID, source.ID); err != nil {
+		// Don't leave a repository without storage behind.
+		s.repositories.Delete(ctx, r.ID)
+		return nil, storageError(err)
+	}
+
+	if err := s.storage.SetDescription(ctx, r.ID, r.Description); err != nil {
+		return r, err
+	}
+
+	return r, nil
+}
+
+// Forks returns the forks of the repository visible to the session's user.
+func (s *service) Forks(ctx context.Context, owner, name string) ([]*OwnedRepository, error) {
+	r, _, err := s.find(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	forks, err := s.repositories.ListForks(ctx, []string{r.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return visibleOwned(ctx, forks), nil
+}
+
+// Stats of the repository, they're cached until it's pushed to or forked.
+func (s *service) Stats(ctx context.Context, owner, name string) (*Stats, error) {
+	r, _, err := s.find(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	cached, generation, ok := s.stats.get(r.ID)
+	if ok {
+		return cached, nil
+	}
+
+	st, err := s.storage.Stats(ctx, r.ID)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	forks, err := s.repositories.ListForks(ctx, []string{r.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &Stats{Stats: st}
+	for _, f := range forks {
+		if !f.Repository.Private {
+			stats.Forks++
+		}
+	}
+
+	s.stats.set(r.ID, generation, stats)
+
+	return stats, nil
+}
+
+// Star the repository for the session's user.
+func (s *service) Star(ctx context.Context, owner, name string) error {
+	r, u, err := s.findForUser(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+	return s.repositories.Star(ctx, u.ID, r.ID)
+}
+
+// Unstar the repository for the session's user.
+func (s *service) Unstar(ctx context.Context, owner, name string) error {
+	r, u, err := s.findForUser(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+	return s.repositories.Unstar(ctx, u.ID, r.ID)
+}
+
+// IsStarred returns true if the session's user starred the repository.
+func (s *service) IsStarred(ctx context.Context, owner, name string) (bool, error) {
+	r, u, err := s.findForUser(ctx, owner, name)
+	if err != nil {
+		return false, err
+	}
+	return s.repositories.IsStarred(ctx, u.ID, r.ID)
+}
+
+// Starred returns a page of the repositories starred by the user which are visible to the session's user,
+// most recently starred first. It returns the cursor of the next page if there's one.
+func (s *service) Starred(ctx context.Context, username string, opts pagination.Options) ([]*StarredRepository, string, error) {
+	var viewer string
+	if u := session.GetSessionUser(ctx); u != nil {
+		viewer = u.Username
+	}
+
+	return s.repositories.ListStarred(ctx, username, viewer, opts)
+}
+
+// Watch the repository for the session's user, who is notified about its activity from now on.
+func (s *service) Watch(ctx context.Context, owner, name string) error {
+	r, u, err := s.findForUser(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+	return s.repositories.Watch(ctx, u.ID, r.ID)
+}
+
+// Unwatch the repository for the session's user.
+func (s *service) Unwatch(ctx context.Context, owner, name string) error {
+	r, u, err := s.findForUser(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+	return s.repositories.Unwatch(ctx, u.ID, r.ID)
+}
+
+// Watchers of the repository.
+func (s *service) Watchers(ctx context.Context, owner, name string) ([]*Watcher, error) {
+	r, _, err := s.find(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+	return s.repositories.ListWatchers(ctx, r.ID)
+}
+
+// findForUser finds a repository visible to the session's user, who has to be signed in.
+func (s *service) findForUser(ctx context.Context, owner, name string) (*Repository, *session.User, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil {
+		return nil, nil, ErrPermissionDenied
+	}
+
+	r, _, err := s.find(ctx, owner, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return r, u, nil
+}
+
+// networkMaxDepth limits how far the fork network is followed from a repository.
+const networkMaxDepth = 32
+
+// Network returns the fork network of the repository: its root, the repository
+// it was forked from originally, followed by all forks level by level.
+// Repositories the session's user can't view are left out with their forks.
+func (s *service) Network(ctx context.Context, owner, name string) ([]*OwnedRepository, error) {
+	r, _, err := s.find(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := s.repositories.FindByID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; root.Repository.ParentID != "" && i < networkMaxDepth; i++ {
+		parent, err := s.repositories.FindByID(ctx, root.Repository.ParentID)
+		if err == ErrRepositoryNotFound {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !canView(ctx, parent.Repository, parent.Owner) {
+			break
+		}
+		root = parent
+	}
+
+	network := []*OwnedRepository{root}
+	level := []string{root.Repository.ID}
+	for depth := 0; len(level) > 0 && depth < networkMaxDepth; depth++ {
+		forks, err := s.repositories.ListForks(ctx, level)
+		if err != nil {
+			return nil, err
+		}
+
+		level = nil
+		for _, f := range visibleOwned(ctx, forks) {
+			network = append(network, f)
+			level = append(level, f.Repository.ID)
+		}
+	}
+
+	return network, nil
+}
+
+func visibleOwned(ctx context.Context, list []*OwnedRepository) []*OwnedRepository {
+	var visible []*OwnedRepository
+	for _, o := range list {
+		if canView(ctx, o.Repository, o.Owner) {
+			visible = append(visible, o)
+		}
+	}
+	return visible
+}
+
+// Branches returns a page of the repository's branches and the cursor of the next page if there's one.
+func (s *service) Branches(ctx context.Context, owner, name string, opts BranchesOptions) ([]*Branch, string, error) {
 	// Check if the repository exists before requesting storage
 	// TODO: This should probably become a middleware implementation of the interface for all storage calls.
-	r, _, err := s.repositories.Find(ctx, owner, name)
+	r, _, err := s.find(ctx, owner, name)
 	if err != nil { // This includes ErrRepositoryNotFound
-		return nil, err
+		return nil, "", err
+	}
+
+	if opts.Sort != storage.BranchSortCommitterDate {
+		opts.Sort = storage.BranchSortName
 	}
 
-	bs, err := s.storage.Branches(ctx, r.ID)
+	limit := opts.Page.Limit()
+	list := storage.ListBranchesOptions{
+		Prefix: opts.Prefix,
+		Sort:   opts.Sort,
+		Base:   defaultBranch(r),
+		Limit:  limit + 1,
+	}
+
+	// The cursor holds the sort, the committer date for BranchSortCommitterDate and the name of the last branch.
+	if opts.Page.Cursor != "" {
+		keys, err := pagination.DecodeCursor(opts.Page.Cursor, 3)
+		if err != nil || keys[0] != opts.Sort {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+		if opts.Sort == storage.BranchSortCommitterDate {
+			committed, err := strconv.ParseInt(keys[1], 10, 64)
+			if err != nil {
+				return nil, "", pagination.ErrCursorInvalid
+			}
+			list.AfterCommitted = time.Unix(committed, 0)
+		}
+		list.After = keys[2]
+	}
+
+	bs, err := s.storage.Branches(ctx, r.ID, list)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	var next string
+	if len(bs) > limit {
+		bs = bs[:limit]
+		last := bs[limit-1]
+		var committed string
+		if opts.Sort == storage.BranchSortCommitterDate {
+			committed = strconv.FormatInt(last.Committed.Unix(), 10)
+		}
+		next = pagination.EncodeCursor(opts.Sort, committed, last.Name)
+	}
+
+	protected, err := s.protectedBranches(ctx, r)
+	if err != nil {
+		return nil, "", err
 	}
 
 	var branches []*Branch
 	for _, b := range bs {
-		branches = append(branches, &Branch{
-			Name: b.Name,
-			Sha1: b.Sha1,
-			Type: b.Type,
+		branches = append(branches, convertBranch(b, protected))
+	}
+
+	return branches, next, nil
+}
+
+func (s *service) CreateBranch(ctx context.Context, owner, name, branch, rev string) (*Branch, error) {
+	r, owner, err := s.find(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if u := session.GetSessionUser(ctx); u == nil || u.Username != owner {
+		return nil, ErrPermissionDenied
+	}
+
+	b, err := s.storage.CreateBranch(ctx, r.ID, branch, rev)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	protected, err := s.protectedBranches(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertBranch(b, protected), nil
+}
+
+func (s *service) DeleteBranch(ctx context.Context, owner, name, branch, sha1 string) error {
+	r, owner, err := s.find(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+
+	if u := session.GetSessionUser(ctx); u == nil || u.Username != owner {
+		return ErrPermissionDenied
+	}
+
+	protected, err := s.protectedBranches(ctx, r)
+	if err != nil {
+		return err
+	}
+	if protected[branch] {
+		return ErrBranchProtected
+	}
+
+	return storageError(s.storage.DeleteBranch(ctx, r.ID, branch, sha1))
+}
+
+func (s *service) RenameBranch(ctx context.Context, owner, name, branch, newName string) (*Branch, error) {
+	r, owner, err := s.find(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if u := session.GetSessionUser(ctx); u == nil || u.Username != owner {
+		return nil, ErrPermissionDenied
+	}
+
+	protected, err := s.protectedBranches(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if protected[branch] {
+		return nil, ErrBranchProtected
+	}
+
+	b, err := s.storage.RenameBranch(ctx, r.ID, branch, newName)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return convertBranch(b, protected), nil
+}
+
+func convertBranch(b storage.Branch, protected map[string]bool) *Branch {
+	return &Branch{
+		Name:      b.Name,
+		Sha1:      b.Sha1,
+		Type:      b.Type,
+		Protected: protected[b.Name],
+		Subject:   b.Subject,
+		Author:    b.Author,
+		Ahead:     b.Ahead,
+		Behind:    b.Behind,
+	}
+}
+
+// defaultBranch of a repository falls back to master if none is set.
+func defaultBranch(r *Repository) string {
+	if r.DefaultBranch == "" {
+		return "master"
+	}
+	return r.DefaultBranch
+}
+
+// protectedBranches can't be deleted or renamed,
+// that's the default branch and the branches with required checks.
+func (s *service) protectedBranches(ctx context.Context, r *Repository) (map[string]bool, error) {
+	branches, err := s.protections.ProtectedBranches(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	protected := map[string]bool{defaultBranch(r): true}
+	for _, branch := range branches {
+		protected[branch] = true
+	}
+	return protected, nil
+}
+
+func (s *service) Archive(ctx context.Context, owner, name, rev, format, prefix string, w io.Writer) error {
+	r, _, err := s.find(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+
+	return storageError(s.storage.Archive(ctx, r.ID, rev, format, prefix, w))
+}
+
+func (s *service) Blob(ctx context.Context, owner, name, rev, path string) (storage.Blob, error) {
+	r, _, err := s.find(ctx, owner, name)
+	if err != nil {
+		return storage.Blob{}, err
+	}
+
+	b, err := s.storage.Blob(ctx, r.ID, rev, path)
+	return b, storageError(err)
+}
+
+func (s *service) ReadBlob(ctx context.Context, owner, name, sha1 string, offset, limit int64, w io.Writer) error {
+	r, _, err := s.find(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+
+	return storageError(s.storage.ReadBlob(ctx, r.ID, sha1, offset, limit, w))
+}
+
+func (s *service) Blame(ctx context.Context, owner, name, rev, path string) ([]storage.BlameHunk, error) {
+	r, _, err := s.find(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	hunks, err := s.storage.Blame(ctx, r.ID, rev, path)
+	return hunks, storageError(err)
+}
+
+// Search the files of a repository at a rev, the default branch is searched if rev is empty.
+func (s *service) Search(ctx context.Context, owner, name, rev string, opts storage.SearchOptions) ([]storage.SearchMatch, error) {
+	r, _, err := s.find(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if rev == "" {
+		rev = defaultBranch(r)
+	}
+
+	matches, err := s.storage.Search(ctx, r.ID, rev, opts)
+	return matches, storageError(err)
+}
+
+// SearchCode searches the default branches of all repositories the current user can see.
+// At most opts.Limit matches are returned, ranked by repository and path.
+func (s *service) SearchCode(ctx context.Context, opts storage.IndexSearchOptions) ([]*CodeMatch, error) {
+	var username string
+	if u := session.GetSessionUser(ctx); u != nil {
+		username = u.Username
+	}
+
+	visible, err := s.repositories.ListVisible(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	type ownerName struct{ owner, name string }
+	repos := map[string]ownerName{}
+	var ids []string
+	for owner, list := range visible {
+		for _, r := range list {
+			repos[r.ID] = ownerName{owner: owner, name: r.Name}
+			ids = append(ids, r.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	found, err := s.storage.SearchIndex(ctx, ids, opts)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	matches := make([]*CodeMatch, 0, len(found))
+	for _, m := range found {
+		r, ok := repos[m.ID]
+		if !ok {
+			continue
+		}
+		matches = append(matches, &CodeMatch{
+			Owner:      r.owner,
+			Repository: r.name,
+			Path:       m.Path,
+			Line:       m.Line,
+			Text:       m.Text,
 		})
 	}
 
-	return branches, nil
+	rankCodeMatches(matches, opts.Query)
+
+	if opts.Limit > 0 && len(matches) > opts.Limit {
+		matches = matches[:opts.Limit]
+	}
+
+	return matches, nil
+}
+
+// rankCodeMatches orders matches by repository, the ones with the most matches first.
+// Within a repository files whose name contains the query come first, then files less deep in the tree.
+func rankCodeMatches(matches []*CodeMatch, query string) {
+	query = strings.ToLower(query)
+
+	counts := map[string]int{}
+	for _, m := range matches {
+		counts[m.Owner+"/"+m.Repository]++
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+
+		repoA, repoB := a.Owner+"/"+a.Repository, b.Owner+"/"+b.Repository
+		if repoA != repoB {
+			if counts[repoA] != counts[repoB] {
+				return counts[repoA] > counts[repoB]
+			}
+			return repoA < repoB
+		}
+
+		if a.Path != b.Path {
+			nameA := strings.Contains(strings.ToLower(path.Base(a.Path)), query)
+			nameB := strings.Contains(strings.ToLower(path.Base(b.Path)), query)
+			if nameA != nameB {
+				return nameA
+			}
+			depthA, depthB := strings.Count(a.Path, "/"), strings.Count(b.Path, "/")
+			if depthA != depthB {
+				return depthA < depthB
+			}
+			return a.Path < b.Path
+		}
+
+		return a.Line < b.Line
+	})
+}
+
+// normalizeTopics lowercases the topics and sorts them, empty ones are dropped.
+func normalizeTopics(topics []string) []string {
+	normalized := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		topic = strings.ToLower(strings.TrimSpace(topic))
+		if topic != "" {
+			normalized = append(normalized, topic)
+		}
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// storageError returns the service's errors for the ones returned by storage.
+func storageError(err error) error {
+	switch err {
+	case storage.ErrRevNotFound:
+		return ErrRevNotFound
+	case storage.ErrBranchNotFound:
+		return ErrBranchNotFound
+	case storage.ErrBranchExists:
+		return ErrBranchAlreadyExists
+	case storage.ErrBranchNameInvalid:
+		return ErrBranchNameInvalid
+	case storage.ErrRefChanged:
+		return ErrBranchChanged
+	case storage.ErrArchiveFormatInvalid:
+		return ErrArchiveFormatInvalid
+	case storage.ErrPathNotFound:
+		return ErrPathNotFound
+	case storage.ErrBlobTooLarge:
+		return ErrFileTooLarge
+	case storage.ErrBlameTimeout:
+		return ErrBlameTimeout
+	case storage.ErrSearchQueryInvalid:
+		return ErrSearchQueryInvalid
+	case storage.ErrSearchTimeout:
+		return ErrSearchTimeout
+	case storage.ErrRepoNotValid:
+		return ErrRepositoryNotFound
+	case storage.ErrRepoExists:
+		return ErrAlreadyExists
+	default:
+		return err
+	}
 }
 
 func (s *service) Commit(ctx context.Context, owner, name, rev string) (storage.Commit, error) {
-	r, _, err := s.repositories.Find(ctx, owner, name)
+	r, _, err := s.find(ctx, owner, name)
 	if err != nil { // This includes ErrRepositoryNotFound
 		return storage.Commit{}, err
 	}
 
-	return s.storage.Commit(ctx, r.ID, rev)
+	c, err := s.storage.Commit(ctx, r.ID, rev)
+	return c, storageError(err)
 }
 
 //Tree returns the git tree for the repository at a given rev and path
 func (s *service) Tree(ctx context.Context, owner, name, rev, path string) ([]storage.TreeEntry, error) {
 	// Check if the repository exists before requesting storage
 	// TODO: This should probably become a middleware implementation of the interface for all storage calls.
-	r, _, err := s.repositories.Find(ctx, owner, name)
+	r, _, err := s.find(ctx, owner, name)
 	if err != nil {
 		return nil, err
 	}
 
 	return s.storage.Tree(ctx, r.ID, rev, path)
 }
+
+func (s *service) SearchRepositories(ctx context.Context, opts SearchOptions) ([]*OwnedRepository, string, error) {
+	opts.Query = strings.TrimSpace(opts.Query)
+	if opts.Query == "" {
+		return nil, "", ErrSearchQueryInvalid
+	}
+	if opts.Sort != SortName && opts.Sort != SortUpdated {
+		opts.Sort = ""
+	}
+
+	var username string
+	if u := session.GetSessionUser(ctx); u != nil {
+		username = u.Username
+	}
+
+	return s.repositories.Search(ctx, username, opts)
+}
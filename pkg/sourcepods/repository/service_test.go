@@ -0,0 +1,761 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testStore struct {
+	Store
+	repositories map[string][]*Repository
+	listed       ListOptions
+	stars        []string
+	watches      []string
+}
+
+func (s *testStore) Star(ctx context.Context, userID, id string) error {
+	s.stars = append(s.stars, userID+" -> "+id)
+	return nil
+}
+
+func (s *testStore) Watch(ctx context.Context, userID, id string) error {
+	s.watches = append(s.watches, userID+" -> "+id)
+	return nil
+}
+
+func (s *testStore) ListWatchers(ctx context.Context, id string) ([]*Watcher, error) {
+	var watchers []*Watcher
+	for _, w := range s.watches {
+		if strings.HasSuffix(w, " -> "+id) {
+			userID := strings.TrimSuffix(w, " -> "+id)
+			watchers = append(watchers, &Watcher{UserID: userID, Username: strings.TrimSuffix(userID, "-id")})
+		}
+	}
+	return watchers, nil
+}
+
+func (s *testStore) List(ctx context.Context, owner, username string, opts ListOptions) ([]*Repository, string, error) {
+	s.listed = opts
+	return s.repositories[owner], "", nil
+}
+
+func (s *testStore) Find(ctx context.Context, owner, name string) (*Repository, string, error) {
+	for _, r := range s.repositories[owner] {
+		if r.Name == name {
+			return r, owner, nil
+		}
+	}
+	return nil, "", ErrRepositoryNotFound
+}
+
+func (s *testStore) ListVisible(ctx context.Context, username string) (map[string][]*Repository, error) {
+	visible := map[string][]*Repository{}
+	for owner, list := range s.repositories {
+		for _, r := range list {
+			if !r.Private || owner == username {
+				visible[owner] = append(visible[owner], r)
+			}
+		}
+	}
+	return visible, nil
+}
+
+func (s *testStore) Search(ctx context.Context, username string, opts SearchOptions) ([]*OwnedRepository, string, error) {
+	var results []*OwnedRepository
+	for owner, list := range s.repositories {
+		for _, r := range list {
+			if (!r.Private || owner == username) && strings.Contains(r.Name, opts.Query) && r.Name > opts.Page.Cursor {
+				results = append(results, &OwnedRepository{Repository: r, Owner: owner})
+			}
+		}
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Repository.Name < results[j].Repository.Name })
+
+	limit := opts.Page.Limit()
+	if len(results) <= limit {
+		return results, "", nil
+	}
+	results = results[:limit]
+	return results, results[limit-1].Repository.Name, nil
+}
+
+func (s *testStore) Create(ctx context.Context, owner string, r *Repository) (*Repository, error) {
+	r.ID = fmt.Sprintf("%s/%s", owner, r.Name)
+	s.repositories[owner] = append(s.repositories[owner], r)
+	return r, nil
+}
+
+func (s *testStore) FindByID(ctx context.Context, id string) (*OwnedRepository, error) {
+	for owner, list := range s.repositories {
+		for _, r := range list {
+			if r.ID == id {
+				return &OwnedRepository{Repository: r, Owner: owner}, nil
+			}
+		}
+	}
+	return nil, ErrRepositoryNotFound
+}
+
+func (s *testStore) ListForks(ctx context.Context, ids []string) ([]*OwnedRepository, error) {
+	owners := make([]string, 0, len(s.repositories))
+	for owner := range s.repositories {
+		owners = append(owners, owner)
+	}
+	sort.Strings(owners)
+
+	var forks []*OwnedRepository
+	for _, id := range ids {
+		for _, owner := range owners {
+			for _, r := range s.repositories[owner] {
+				if r.ParentID == id {
+					forks = append(forks, &OwnedRepository{Repository: r, Owner: owner})
+				}
+			}
+		}
+	}
+	return forks, nil
+}
+
+func (s *testStore) Delete(ctx context.Context, id string) ([]string, error) {
+	var detached []string
+	for owner, list := range s.repositories {
+		kept := list[:0]
+		for _, r := range list {
+			if r.ParentID == id {
+				r.ParentID = ""
+				detached = append(detached, r.ID)
+			}
+			if r.ID != id {
+				kept = append(kept, r)
+			}
+		}
+		s.repositories[owner] = kept
+	}
+	return detached, nil
+}
+
+type testStorage struct {
+	Storage
+	searched    []string
+	branches    []string
+	forkErr     error
+	forked      []string
+	deleted     []string
+	dissociated []string
+	stats       []string
+}
+
+func (s *testStorage) Fork(ctx context.Context, id, sourceID string) error {
+	if s.forkErr != nil {
+		return s.forkErr
+	}
+	s.forked = append(s.forked, sourceID+" -> "+id)
+	return nil
+}
+
+func (s *testStorage) Stats(ctx context.Context, id string) (storage.Stats, error) {
+	s.stats = append(s.stats, id)
+	return storage.Stats{Commits: 2, Languages: []storage.Language{{Name: "Go", Bytes: 42}}}, nil
+}
+
+func (s *testStorage) Delete(ctx context.Context, id string) error {
+	s.deleted = append(s.deleted, id)
+	return nil
+}
+
+func (s *testStorage) Dissociate(ctx context.Context, id string) error {
+	s.dissociated = append(s.dissociated, id)
+	return nil
+}
+
+func (s *testStorage) SetDescription(ctx context.Context, id, description string) error {
+	return nil
+}
+
+func (s *testStorage) Branches(ctx context.Context, id string, opts storage.ListBranchesOptions) ([]storage.Branch, error) {
+	var branches []storage.Branch
+	for _, name := range s.branches {
+		if name > opts.After && (opts.Limit == 0 || len(branches) < opts.Limit) {
+			branches = append(branches, storage.Branch{Name: name})
+		}
+	}
+	return branches, nil
+}
+
+func (s *testStorage) SearchIndex(ctx context.Context, ids []string, opts storage.IndexSearchOptions) ([]storage.IndexMatch, error) {
+	s.searched = ids
+	var matches []storage.IndexMatch
+	for _, id := range ids {
+		switch id {
+		case "1":
+			matches = append(matches,
+				storage.IndexMatch{ID: id, Path: "pkg/foo/bar.go", Line: 3, Text: "foo()"},
+			)
+		case "2":
+			matches = append(matches,
+				storage.IndexMatch{ID: id, Path: "pkg/foo/bar.go", Line: 1, Text: "foo"},
+				storage.IndexMatch{ID: id, Path: "README.md", Line: 2, Text: "foo"},
+				storage.IndexMatch{ID: id, Path: "cmd/foo.go", Line: 7, Text: "foo"},
+				storage.IndexMatch{ID: id, Path: "README.md", Line: 1, Text: "# foo"},
+			)
+		}
+	}
+	return matches, nil
+}
+
+func newTestStore() *testStore {
+	return &testStore{repositories: map[string][]*Repository{
+		"foo": {
+			{ID: "1", Name: "public"},
+			{ID: "2", Name: "private", Private: true},
+		},
+	}}
+}
+
+func withUser(username string) context.Context {
+	ctx := context.WithValue(context.Background(), session.CookieUserID, username+"-id")
+	return context.WithValue(ctx, session.CookieUserUsername, username)
+}
+
+func TestServiceFindPrivate(t *testing.T) {
+	s := NewService(newTestStore(), nil, nil, nil)
+
+	r, _, err := s.Find(context.Background(), "foo", "public")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", r.ID)
+
+	_, _, err = s.Find(context.Background(), "foo", "private")
+	assert.Equal(t, ErrRepositoryNotFound, err)
+
+	_, _, err = s.Find(withUser("bar"), "foo", "private")
+	assert.Equal(t, ErrRepositoryNotFound, err)
+
+	r, _, err = s.Find(withUser("foo"), "foo", "private")
+	assert.NoError(t, err)
+	assert.Equal(t, "2", r.ID)
+
+	_, _, err = s.Find(withUser("foo"), "foo", "unknown")
+	assert.Equal(t, ErrRepositoryNotFound, err)
+}
+
+func TestServiceListPrivate(t *testing.T) {
+	s := NewService(newTestStore(), nil, nil, nil)
+
+	list, _, err := s.List(context.Background(), "foo", ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	list, _, err = s.List(withUser("foo"), "foo", ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+}
+
+func TestServiceListSort(t *testing.T) {
+	store := newTestStore()
+	s := NewService(store, nil, nil, nil)
+
+	_, _, err := s.List(context.Background(), "foo", ListOptions{Sort: "unknown"})
+	assert.NoError(t, err)
+	assert.Equal(t, SortUpdated, store.listed.Sort)
+
+	_, _, err = s.List(context.Background(), "foo", ListOptions{Sort: SortName})
+	assert.NoError(t, err)
+	assert.Equal(t, SortName, store.listed.Sort)
+}
+
+func TestServiceBranchesPages(t *testing.T) {
+	st := &testStorage{branches: []string{"a", "b", "c"}}
+	s := NewService(newTestStore(), st, nil, testProtections{})
+
+	var names []string
+	var cursors []string
+	opts := BranchesOptions{Page: pagination.Options{PerPage: 2}}
+	for {
+		branches, next, err := s.Branches(context.Background(), "foo", "public", opts)
+		assert.NoError(t, err)
+		for _, b := range branches {
+			names = append(names, b.Name)
+		}
+		if next == "" {
+			break
+		}
+		cursors = append(cursors, next)
+		opts.Page.Cursor = next
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+	assert.Len(t, cursors, 1)
+
+	// Cursors of another sort order can't be used.
+	opts.Sort = storage.BranchSortCommitterDate
+	opts.Page.Cursor = cursors[0]
+	_, _, err := s.Branches(context.Background(), "foo", "public", opts)
+	assert.Equal(t, pagination.ErrCursorInvalid, err)
+}
+
+type branchTestStorage struct {
+	testStorage
+	changed []string
+}
+
+func (s *branchTestStorage) CreateBranch(ctx context.Context, id, name, rev string) (storage.Branch, error) {
+	s.changed = append(s.changed, "create "+name)
+	return storage.Branch{Name: name}, nil
+}
+
+func (s *branchTestStorage) DeleteBranch(ctx context.Context, id, name, sha1 string) error {
+	s.changed = append(s.changed, "delete "+name)
+	return nil
+}
+
+func (s *branchTestStorage) RenameBranch(ctx context.Context, id, name, newName string) (storage.Branch, error) {
+	s.changed = append(s.changed, "rename "+name)
+	return storage.Branch{Name: newName}, nil
+}
+
+type testProtections map[string][]string
+
+func (p testProtections) ProtectedBranches(ctx context.Context, repositoryID string) ([]string, error) {
+	return p[repositoryID], nil
+}
+
+func TestServiceBranchPermissions(t *testing.T) {
+	st := &branchTestStorage{}
+	s := NewService(newTestStore(), st, nil, testProtections{})
+
+	for _, ctx := range []context.Context{context.Background(), withUser("bar")} {
+		_, err := s.CreateBranch(ctx, "foo", "public", "feature", "master")
+		assert.Equal(t, ErrPermissionDenied, err)
+		err = s.DeleteBranch(ctx, "foo", "public", "feature", "")
+		assert.Equal(t, ErrPermissionDenied, err)
+		_, err = s.RenameBranch(ctx, "foo", "public", "feature", "renamed")
+		assert.Equal(t, ErrPermissionDenied, err)
+	}
+	assert.Len(t, st.changed, 0, "only the owner can change branches")
+
+	_, err := s.CreateBranch(withUser("foo"), "foo", "public", "feature", "master")
+	assert.NoError(t, err)
+	_, err = s.RenameBranch(withUser("foo"), "foo", "public", "feature", "renamed")
+	assert.NoError(t, err)
+	err = s.DeleteBranch(withUser("foo"), "foo", "public", "renamed", "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"create feature", "rename feature", "delete renamed"}, st.changed)
+}
+
+func TestServiceBranchProtected(t *testing.T) {
+	st := &branchTestStorage{testStorage: testStorage{branches: []string{"master", "feature", "release"}}}
+	s := NewService(newTestStore(), st, nil, testProtections{"1": {"release"}})
+
+	branches, _, err := s.Branches(withUser("foo"), "foo", "public", BranchesOptions{})
+	assert.NoError(t, err)
+	protected := map[string]bool{}
+	for _, b := range branches {
+		protected[b.Name] = b.Protected
+	}
+	assert.Equal(t, map[string]bool{"master": true, "feature": false, "release": true}, protected)
+
+	for _, branch := range []string{"master", "release"} {
+		err = s.DeleteBranch(withUser("foo"), "foo", "public", branch, "")
+		assert.Equal(t, ErrBranchProtected, err)
+		_, err = s.RenameBranch(withUser("foo"), "foo", "public", branch, "renamed")
+		assert.Equal(t, ErrBranchProtected, err)
+	}
+	assert.Len(t, st.changed, 0, "protected branches can't be changed")
+
+	err = s.DeleteBranch(withUser("foo"), "foo", "public", "feature", "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"delete feature"}, st.changed)
+}
+
+func TestServiceSearchCode(t *testing.T) {
+	st := &testStorage{}
+	s := NewService(newTestStore(), st, nil, nil)
+
+	matches, err := s.SearchCode(context.Background(), storage.IndexSearchOptions{Query: "foo"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, st.searched)
+	assert.Len(t, matches, 1)
+
+	matches, err = s.SearchCode(withUser("foo"), storage.IndexSearchOptions{Query: "foo", Limit: 4})
+	assert.NoError(t, err)
+	assert.Len(t, st.searched, 2)
+
+	var got []string
+	for _, m := range matches {
+		got = append(got, fmt.Sprintf("%s/%s:%s:%d", m.Owner, m.Repository, m.Path, m.Line))
+	}
+	assert.Equal(t, []string{
+		"foo/private:cmd/foo.go:7",
+		"foo/private:README.md:1",
+		"foo/private:README.md:2",
+		"foo/private:pkg/foo/bar.go:1",
+	}, got)
+}
+
+func TestServiceSearchRepositories(t *testing.T) {
+	s := NewService(newTestStore(), nil, nil, nil)
+
+	results, _, err := s.SearchRepositories(context.Background(), SearchOptions{Query: "p"})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "public", results[0].Repository.Name)
+
+	results, next, err := s.SearchRepositories(withUser("foo"), SearchOptions{Query: " p "})
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Empty(t, next)
+
+	results, next, err = s.SearchRepositories(withUser("foo"), SearchOptions{Query: "p", Page: pagination.Options{PerPage: 1}})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "private", next)
+
+	results, next, err = s.SearchRepositories(withUser("foo"), SearchOptions{Query: "p", Page: pagination.Options{Cursor: next, PerPage: 1}})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "public", results[0].Repository.Name)
+	assert.Empty(t, next)
+
+	_, _, err = s.SearchRepositories(context.Background(), SearchOptions{})
+	assert.Equal(t, ErrSearchQueryInvalid, err)
+}
+
+func TestServiceFork(t *testing.T) {
+	rs := newTestStore()
+	st := &testStorage{}
+	s := NewService(rs, st, nil, nil)
+
+	_, err := s.Fork(context.Background(), "foo", "public", "")
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	_, err = s.Fork(withUser("bar"), "foo", "private", "")
+	assert.Equal(t, ErrRepositoryNotFound, err)
+
+	r, err := s.Fork(withUser("bar"), "foo", "public", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "public", r.Name)
+	assert.Equal(t, "1", r.ParentID)
+	assert.Equal(t, []string{"1 -> bar/public"}, st.forked)
+
+	r, err = s.Fork(withUser("baz"), "bar", "public", "fork")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar/public", r.ParentID)
+
+	_, err = s.Fork(withUser("bar"), "foo", "public", "in valid")
+	assert.IsType(t, ValidationErrors{}, err)
+
+	st.forkErr = errors.New("disk full")
+	_, err = s.Fork(withUser("qux"), "foo", "public", "")
+	assert.Error(t, err)
+	assert.Empty(t, rs.repositories["qux"])
+
+	forks, err := s.Forks(context.Background(), "foo", "public")
+	assert.NoError(t, err)
+	assert.Len(t, forks, 1)
+	assert.Equal(t, "bar", forks[0].Owner)
+
+	network, err := s.Network(context.Background(), "baz", "fork")
+	assert.NoError(t, err)
+	var names []string
+	for _, o := range network {
+		names = append(names, o.Owner+"/"+o.Repository.Name)
+	}
+	assert.Equal(t, []string{"foo/public", "bar/public", "baz/fork"}, names)
+}
+
+func TestServiceDeleteDissociatesForks(t *testing.T) {
+	rs := newTestStore()
+	rs.repositories["bar"] = []*Repository{{ID: "3", Name: "public", ParentID: "1"}}
+	st := &testStorage{}
+	s := NewService(rs, st, nil, nil)
+
+	err := s.Delete(withUser("bar"), "foo", "public")
+	assert.Equal(t, ErrPermissionDenied, err)
+	assert.Empty(t, st.deleted)
+
+	err = s.Delete(withUser("foo"), "foo", "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3"}, st.dissociated)
+	assert.Equal(t, []string{"1"}, st.deleted)
+	assert.Equal(t, "", rs.repositories["bar"][0].ParentID)
+
+	_, _, err = s.Find(withUser("foo"), "foo", "public")
+	assert.Equal(t, ErrRepositoryNotFound, err)
+}
+
+type redirectTestStore struct {
+	*testStore
+	redirects map[string]string
+}
+
+func (s *redirectTestStore) Update(ctx context.Context, r *Repository) (*Repository, error) {
+	o, err := s.FindByID(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	if o.Repository.Name != r.Name {
+		if _, _, err := s.Find(ctx, o.Owner, r.Name); err == nil {
+			return nil, ErrAlreadyExists
+		}
+		s.redirects[o.Owner+"/"+o.Repository.Name] = r.ID
+	}
+	*o.Repository = *r
+	return r, nil
+}
+
+func (s *redirectTestStore) Transfer(ctx context.Context, id, owner string) (*Repository, error) {
+	o, err := s.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if owner == "unknown" {
+		return nil, ErrOwnerNotFound
+	}
+	s.redirects[o.Owner+"/"+o.Repository.Name] = id
+	s.Delete(ctx, id)
+	s.repositories[owner] = append(s.repositories[owner], o.Repository)
+	return o.Repository, nil
+}
+
+func (s *redirectTestStore) FindRedirect(ctx context.Context, owner, name string) (string, error) {
+	id, ok := s.redirects[owner+"/"+name]
+	if !ok {
+		return "", ErrRepositoryNotFound
+	}
+	return id, nil
+}
+
+type updateTestStorage struct {
+	testStorage
+	defaultBranch string
+	description   string
+}
+
+func (s *updateTestStorage) Commit(ctx context.Context, id, rev string) (storage.Commit, error) {
+	if rev != "refs/heads/develop" {
+		return storage.Commit{}, storage.ErrRevNotFound
+	}
+	return storage.Commit{Hash: "a"}, nil
+}
+
+func (s *updateTestStorage) SetDefaultBranch(ctx context.Context, id, name string) error {
+	s.defaultBranch = name
+	return nil
+}
+
+func (s *updateTestStorage) SetDescription(ctx context.Context, id, description string) error {
+	s.description = description
+	return nil
+}
+
+func TestServiceUpdate(t *testing.T) {
+	rs := &redirectTestStore{testStore: newTestStore(), redirects: map[string]string{}}
+	st := &updateTestStorage{}
+	s := NewService(rs, st, nil, nil)
+
+	renamed := "renamed"
+	_, err := s.Update(withUser("bar"), "foo", "public", Update{Name: &renamed})
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	invalid := "in valid"
+	_, err = s.Update(withUser("foo"), "foo", "public", Update{Name: &invalid})
+	assert.IsType(t, ValidationErrors{}, err)
+
+	unknown := "unknown"
+	_, err = s.Update(withUser("foo"), "foo", "public", Update{DefaultBranch: &unknown})
+	assert.Equal(t, ErrBranchNotFound, err)
+
+	description := "foo bar"
+	develop := "develop"
+	r, err := s.Update(withUser("foo"), "foo", "public", Update{Name: &renamed, Description: &description, DefaultBranch: &develop})
+	assert.NoError(t, err)
+	assert.Equal(t, "renamed", r.Name)
+	assert.Equal(t, "develop", st.defaultBranch)
+	assert.Equal(t, "foo bar", st.description)
+
+	owner, name, err := s.Redirect(context.Background(), "foo", "public")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo/renamed", owner+"/"+name)
+
+	_, _, err = s.Redirect(context.Background(), "foo", "unknown")
+	assert.Equal(t, ErrRepositoryNotFound, err)
+}
+
+func TestServiceTransfer(t *testing.T) {
+	rs := &redirectTestStore{testStore: newTestStore(), redirects: map[string]string{}}
+	s := NewService(rs, &updateTestStorage{}, nil, nil)
+
+	_, err := s.Transfer(withUser("bar"), "foo", "private", "bar")
+	assert.Equal(t, ErrRepositoryNotFound, err)
+
+	_, err = s.Transfer(withUser("bar"), "foo", "public", "bar")
+	assert.Equal(t, ErrPermissionDenied, err)
+
+	_, err = s.Transfer(withUser("foo"), "foo", "private", "unknown")
+	assert.Equal(t, ErrOwnerNotFound, err)
+
+	_, err = s.Transfer(withUser("foo"), "foo", "private", "bar")
+	assert.NoError(t, err)
+
+	_, _, err = s.Redirect(withUser("foo"), "foo", "private")
+	assert.Equal(t, ErrRepositoryNotFound, err, "the old owner can't see the private repository anymore")
+
+	owner, name, err := s.Redirect(withUser("bar"), "foo", "private")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar/private", owner+"/"+name)
+
+	_, _, err = s.Find(withUser("bar"), "bar", "private")
+	assert.NoError(t, err)
+}
+
+type testPushes []storage.PushEvent
+
+func (p testPushes) Pushes(ctx context.Context, fn func(storage.PushEvent)) error {
+	for _, e := range p {
+		fn(e)
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestServiceStats(t *testing.T) {
+	rs := newTestStore()
+	st := &testStorage{}
+	cache := NewStatsCache(time.Hour)
+	s := NewService(rs, st, cache, nil)
+
+	_, err := s.Stats(context.Background(), "foo", "private")
+	assert.Equal(t, ErrRepositoryNotFound, err)
+
+	stats, err := s.Stats(context.Background(), "foo", "public")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), stats.Commits)
+	assert.Equal(t, 0, stats.Forks)
+
+	_, err = s.Stats(context.Background(), "foo", "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, st.stats)
+
+	// Forking invalidates the stats of the source.
+	_, err = s.Fork(withUser("bar"), "foo", "public", "")
+	assert.NoError(t, err)
+	stats, err = s.Stats(context.Background(), "foo", "public")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, stats.Forks)
+	assert.Equal(t, []string{"1", "1"}, st.stats)
+
+	// So does pushing.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = cache.Run(ctx, testPushes{{ID: "1"}}, log.NewNopLogger())
+	assert.Equal(t, context.DeadlineExceeded, err)
+	_, err = s.Stats(context.Background(), "foo", "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "1", "1"}, st.stats)
+
+	// Without a cache the stats are computed every time.
+	s = NewService(rs, st, nil, nil)
+	_, err = s.Stats(context.Background(), "foo", "public")
+	assert.NoError(t, err)
+	_, err = s.Stats(context.Background(), "foo", "public")
+	assert.NoError(t, err)
+	assert.Len(t, st.stats, 5)
+}
+
+func TestServiceStarAndWatch(t *testing.T) {
+	rs := newTestStore()
+	s := NewService(rs, nil, nil, nil)
+
+	assert.Equal(t, ErrPermissionDenied, s.Star(context.Background(), "foo", "public"))
+	assert.Equal(t, ErrRepositoryNotFound, s.Star(withUser("bar"), "foo", "private"))
+	assert.NoError(t, s.Star(withUser("bar"), "foo", "public"))
+	assert.NoError(t, s.Star(withUser("foo"), "foo", "private"))
+	assert.Equal(t, []string{"bar-id -> 1", "foo-id -> 2"}, rs.stars)
+
+	assert.Equal(t, ErrPermissionDenied, s.Watch(context.Background(), "foo", "public"))
+	assert.Equal(t, ErrRepositoryNotFound, s.Watch(withUser("bar"), "foo", "private"))
+	assert.NoError(t, s.Watch(withUser("bar"), "foo", "public"))
+
+	watchers, err := s.Watchers(context.Background(), "foo", "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []*Watcher{{UserID: "bar-id", Username: "bar"}}, watchers)
+
+	_, err = s.Watchers(context.Background(), "foo", "private")
+	assert.Equal(t, ErrRepositoryNotFound, err)
+}
+
+type readmeTestStorage struct {
+	testStorage
+	files map[string]string
+}
+
+func (s *readmeTestStorage) Tree(ctx context.Context, id, rev, path string) ([]storage.TreeEntry, error) {
+	if rev != "master" && rev != "develop" {
+		return nil, storage.ErrRevNotFound
+	}
+	entries := []storage.TreeEntry{{Type: "tree", Path: "readme"}}
+	for name := range s.files {
+		entries = append(entries, storage.TreeEntry{Type: "blob", Path: name})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Path < entries[j].Path })
+	return entries, nil
+}
+
+func (s *readmeTestStorage) Blob(ctx context.Context, id, rev, path string) (storage.Blob, error) {
+	content, ok := s.files[path]
+	if !ok {
+		return storage.Blob{}, storage.ErrPathNotFound
+	}
+	return storage.Blob{Sha1: path, Size: int64(len(content))}, nil
+}
+
+func (s *readmeTestStorage) ReadBlob(ctx context.Context, id, sha1 string, offset, limit int64, w io.Writer) error {
+	_, err := io.WriteString(w, s.files[sha1])
+	return err
+}
+
+func TestServiceReadme(t *testing.T) {
+	st := &readmeTestStorage{files: map[string]string{
+		"LICENSE":    "MIT",
+		"README.txt": "Not rendered <b>at all</b>",
+		"README.md":  "# Foo\n",
+	}}
+	s := NewService(newTestStore(), st, nil, nil)
+
+	_, err := s.Readme(context.Background(), "foo", "private", "")
+	assert.Equal(t, ErrRepositoryNotFound, err)
+
+	_, err = s.Readme(context.Background(), "foo", "public", "unknown")
+	assert.Equal(t, ErrRevNotFound, err)
+
+	readme, err := s.Readme(context.Background(), "foo", "public", "")
+	require.NoError(t, err)
+	assert.Equal(t, "README.md", readme.Path, "markdown is preferred")
+	assert.Equal(t, "master", readme.Rev)
+	assert.Equal(t, "# Foo\n", readme.Content)
+	assert.True(t, readme.Markdown)
+
+	delete(st.files, "README.md")
+	readme, err = s.Readme(context.Background(), "foo", "public", "develop")
+	require.NoError(t, err)
+	assert.Equal(t, "README.txt", readme.Path)
+	assert.Equal(t, "develop", readme.Rev)
+	assert.Equal(t, "Not rendered <b>at all</b>", readme.Content)
+	assert.False(t, readme.Markdown)
+
+	delete(st.files, "README.txt")
+	_, err = s.Readme(context.Background(), "foo", "public", "")
+	assert.Equal(t, ErrReadmeNotFound, err)
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+)
+
+// Stats of a repository.
+type Stats struct {
+	storage.Stats
+	// Forks counts the public forks of the repository.
+	Forks int
+}
+
+// Pushes subscribes to the pushes to repositories, like the storage.Client.
+type Pushes interface {
+	Pushes(ctx context.Context, fn func(storage.PushEvent)) error
+}
+
+// pushesRetry is how long to wait before subscribing to pushes again after the subscription broke.
+const pushesRetry = 5 * time.Second
+
+// StatsCache keeps the Stats of repositories until they're pushed to, forked or maxAge has passed.
+// A nil StatsCache doesn't cache at all.
+type StatsCache struct {
+	maxAge time.Duration
+
+	mu    sync.Mutex
+	stats map[string]cachedStats
+	// generation is increased with every invalidation, so that stats computed
+	// while their repository was pushed to aren't cached afterwards.
+	generation uint64
+}
+
+type cachedStats struct {
+	stats  *Stats
+	cached time.Time
+}
+
+// NewStatsCache returns an empty StatsCache.
+func NewStatsCache(maxAge time.Duration) *StatsCache {
+	return &StatsCache{
+		maxAge: maxAge,
+		stats:  map[string]cachedStats{},
+	}
+}
+
+func (c *StatsCache) get(id string) (*Stats, uint64, bool) {
+	if c == nil {
+		return nil, 0, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached, ok := c.stats[id]
+	if !ok || time.Since(cached.cached) > c.maxAge {
+		return nil, c.generation, false
+	}
+	return cached.stats, c.generation, true
+}
+
+// set caches the stats unless something has been invalidated since the generation was returned by get.
+func (c *StatsCache) set(id string, generation uint64, stats *Stats) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation == c.generation {
+		c.stats[id] = cachedStats{stats: stats, cached: time.Now()}
+	}
+}
+
+// Invalidate the cached Stats of a repository.
+func (c *StatsCache) Invalidate(id string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.stats, id)
+	c.generation++
+}
+
+func (c *StatsCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stats = map[string]cachedStats{}
+	c.generation++
+}
+
+// Run invalidates the Stats of every repository pushed to until ctx is cancelled.
+// Everything is invalidated whenever the subscription breaks, as pushes might have been missed.
+func (c *StatsCache) Run(ctx context.Context, pushes Pushes, logger log.Logger) error {
+	for {
+		err := pushes.Pushes(ctx, func(p storage.PushEvent) {
+			c.Invalidate(p.ID)
+		})
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		level.Warn(logger).Log("msg", "subscription to pushes broke, retrying", "err", err)
+		c.reset()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pushesRetry):
+		}
+	}
+}
@@ -3,10 +3,15 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/lib/pq"
 	"github.com/opentracing/opentracing-go"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/sqlutil"
 )
 
 // Postgres implementation of the Store.
@@ -19,29 +24,71 @@ func NewPostgresStore(db *sql.DB) *Postgres {
 	return &Postgres{db: db}
 }
 
-// List retrieves a list of repositories based on their ownership.
-func (s *Postgres) List(ctx context.Context, owner string) ([]*Repository, string, error) {
+// List retrieves a page of repositories based on their ownership.
+// Private repositories are only listed if they're owned by username.
+// This func returns the repositories, the cursor of the next page if there's one and an error.
+func (s *Postgres) List(ctx context.Context, owner, username string, opts ListOptions) ([]*Repository, string, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.List")
 	span.SetTag("owner", owner)
+	span.SetTag("username", username)
+	span.SetTag("sort", opts.Sort)
+	span.SetTag("cursor", opts.Page.Cursor)
 	defer span.Finish()
 
-	listByOwnerID := `
+	args := []interface{}{owner, username}
+	where := []string{
+		"owner_id = (SELECT id FROM users WHERE username = $1 LIMIT 1)",
+		"(NOT private OR $1 = $2)",
+	}
+
+	order := "updated_at DESC, id DESC"
+	if opts.Sort == SortName {
+		order = "name ASC, id ASC"
+	}
+
+	if opts.Page.Cursor != "" {
+		keys, err := pagination.DecodeCursor(opts.Page.Cursor, 3)
+		if err != nil || keys[0] != opts.Sort {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+
+		switch opts.Sort {
+		case SortName:
+			args = append(args, keys[1], keys[2])
+			where = append(where, "(name > $3 OR (name = $3 AND id > $4))")
+		default:
+			updated, err := time.Parse(time.RFC3339Nano, keys[1])
+			if err != nil {
+				return nil, "", pagination.ErrCursorInvalid
+			}
+			args = append(args, updated, keys[2])
+			where = append(where, "(updated_at < $3 OR (updated_at = $3 AND id < $4))")
+		}
+	}
+
+	limit := opts.Page.Limit()
+	args = append(args, limit+1)
+
+	listByOwnerID := fmt.Sprintf(`
 SELECT
 	id,
 	name,
 	description,
 	website,
 	default_branch,
+	private,
 	created_at,
 	updated_at,
-	(SELECT 42) AS stars,
-	(SELECT 23) AS forks
+	(SELECT count(*) FROM stars WHERE stars.repository_id = repositories.id) AS stars,
+	(SELECT count(*) FROM watches WHERE watches.repository_id = repositories.id) AS watchers,
+	ARRAY(SELECT topic FROM repository_topics WHERE repository_topics.repository_id = repositories.id ORDER BY topic) AS topics
 FROM repositories
-WHERE owner_id = (SELECT id FROM users WHERE username = $1 LIMIT 1)
-ORDER BY updated_at DESC;
-`
+WHERE %s
+ORDER BY %s
+LIMIT $%d;
+`, strings.Join(where, " AND "), order, len(args))
 
-	rows, err := s.db.QueryContext(ctx, listByOwnerID, owner)
+	rows, err := s.db.QueryContext(ctx, listByOwnerID, args...)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, "", ErrOwnerNotFound
@@ -59,10 +106,12 @@ ORDER BY updated_at DESC;
 		var description sql.NullString
 		var website sql.NullString
 		var defaultBranch string
+		var private bool
 		var created time.Time
 		var updated time.Time
 		var stars int
-		var forks int
+		var watchers int
+		var topics []string
 
 		rows.Scan(
 			&id,
@@ -70,10 +119,12 @@ ORDER BY updated_at DESC;
 			&description,
 			&website,
 			&defaultBranch,
+			&private,
 			&created,
 			&updated,
 			&stars,
-			&forks,
+			&watchers,
+			pq.Array(&topics),
 		)
 
 		repositories = append(repositories, &Repository{
@@ -82,12 +133,28 @@ ORDER BY updated_at DESC;
 			Description:   description.String,
 			Website:       website.String,
 			DefaultBranch: defaultBranch,
+			Private:       private,
 			Created:       created,
 			Updated:       updated,
+			Stars:         stars,
+			Watchers:      watchers,
+			Topics:        topics,
 		})
 	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(repositories) <= limit {
+		return repositories, "", nil
+	}
 
-	return repositories, owner, nil
+	repositories = repositories[:limit]
+	last := repositories[limit-1]
+	if opts.Sort == SortName {
+		return repositories, pagination.EncodeCursor(opts.Sort, last.Name, last.ID), nil
+	}
+	return repositories, pagination.EncodeCursor(opts.Sort, last.Updated.Format(time.RFC3339Nano), last.ID), nil
 }
 
 // Find a Repository by its name and owner's username.
@@ -103,9 +170,14 @@ SELECT
 	description,
 	website,
 	default_branch,
+	private,
 	created_at,
 	updated_at,
-	owner_id
+	owner_id,
+	parent_id,
+	(SELECT count(*) FROM stars WHERE stars.repository_id = repositories.id) AS stars,
+	(SELECT count(*) FROM watches WHERE watches.repository_id = repositories.id) AS watchers,
+	ARRAY(SELECT topic FROM repository_topics WHERE repository_topics.repository_id = repositories.id ORDER BY topic) AS topics
 FROM repositories
 WHERE
 	name = $2 AND
@@ -117,18 +189,28 @@ WHERE
 	var description sql.NullString
 	var website sql.NullString
 	var defaultBranch string
+	var private bool
 	var created time.Time
 	var updated time.Time
 	var ownerID string
+	var parentID sql.NullString
+	var stars int
+	var watchers int
+	var topics []string
 
 	if err := row.Scan(
 		&id,
 		&description,
 		&website,
 		&defaultBranch,
+		&private,
 		&created,
 		&updated,
 		&ownerID,
+		&parentID,
+		&stars,
+		&watchers,
+		pq.Array(&topics),
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, "", ErrRepositoryNotFound
@@ -143,8 +225,13 @@ WHERE
 			Description:   description.String,
 			Website:       website.String,
 			DefaultBranch: defaultBranch,
+			Private:       private,
 			Created:       created,
 			Updated:       updated,
+			ParentID:      parentID.String,
+			Stars:         stars,
+			Watchers:      watchers,
+			Topics:        topics,
 		},
 		owner,
 		nil
@@ -168,9 +255,14 @@ func (s *Postgres) Create(ctx context.Context, owner string, r *Repository) (*Re
 		website = &r.Website
 	}
 
+	var parentID *string
+	if r.ParentID != "" {
+		parentID = &r.ParentID
+	}
+
 	create := `
-INSERT INTO repositories (owner_id, name, description, website, default_branch)
-VALUES ((SELECT id FROM users WHERE username = $1 LIMIT 1), $2, $3, $4, $5)
+INSERT INTO repositories (owner_id, name, description, website, default_branch, private, parent_id)
+VALUES ((SELECT id FROM users WHERE username = $1 LIMIT 1), $2, $3, $4, $5, $6, $7)
 RETURNING id, created_at, updated_at;
 `
 
@@ -180,6 +272,8 @@ RETURNING id, created_at, updated_at;
 		description,
 		website,
 		r.DefaultBranch,
+		r.Private,
+		parentID,
 	)
 
 	if err := row.Scan(&r.ID, &r.Created, &r.Updated); err != nil {
@@ -191,5 +285,705 @@ RETURNING id, created_at, updated_at;
 		return nil, err
 	}
 
+	if err := setTopics(ctx, s.db, r.ID, r.Topics); err != nil {
+		return nil, err
+	}
+
+	// The new repository takes the name from a repository that was renamed or transferred away.
+	_, err := s.db.ExecContext(ctx, `DELETE FROM repository_redirects WHERE owner_id = (SELECT owner_id FROM repositories WHERE id = $1) AND name = $2;`, r.ID, r.Name)
+	if err != nil {
+		return nil, err
+	}
+
 	return r, nil
 }
+
+// Update the name, description, website, default branch and topics of a Repository by its ID.
+// If the name changed, the old name redirects to the repository.
+func (s *Postgres) Update(ctx context.Context, r *Repository) (*Repository, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.Update")
+	span.SetTag("id", r.ID)
+	span.SetTag("name", r.Name)
+	defer span.Finish()
+
+	var description *string
+	if r.Description != "" {
+		description = &r.Description
+	}
+
+	var website *string
+	if r.Website != "" {
+		website = &r.Website
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var ownerID, name string
+	row := tx.QueryRowContext(ctx, `SELECT owner_id, name FROM repositories WHERE id = $1;`, r.ID)
+	if err := row.Scan(&ownerID, &name); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrRepositoryNotFound
+		}
+		return nil, err
+	}
+
+	update := `
+UPDATE repositories
+SET name = $2, description = $3, website = $4, default_branch = $5, updated_at = now()
+WHERE id = $1
+RETURNING updated_at;
+`
+
+	row = tx.QueryRowContext(ctx, update, r.ID, r.Name, description, website, r.DefaultBranch)
+	if err := row.Scan(&r.Updated); err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == pq.ErrorCode("23505") {
+				return nil, ErrAlreadyExists
+			}
+		}
+		return nil, err
+	}
+
+	if err := setTopics(ctx, tx, r.ID, r.Topics); err != nil {
+		return nil, err
+	}
+
+	if name != r.Name {
+		if err := moveRedirect(ctx, tx, r.ID, ownerID, name, ownerID, r.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, tx.Commit()
+}
+
+// setTopics replaces all topics of the repository.
+func setTopics(ctx context.Context, db interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+}, id string, topics []string) error {
+	if _, err := db.ExecContext(ctx, `DELETE FROM repository_topics WHERE repository_id = $1;`, id); err != nil {
+		return err
+	}
+	if len(topics) == 0 {
+		return nil
+	}
+
+	_, err := db.ExecContext(ctx, `
+INSERT INTO repository_topics (repository_id, topic)
+SELECT $1, unnest($2::TEXT[]);
+`, id, pq.Array(topics))
+	return err
+}
+
+// Transfer a Repository by its ID to another owner (by its username).
+// The old owner's name redirects to the repository.
+func (s *Postgres) Transfer(ctx context.Context, id, owner string) (*Repository, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.Transfer")
+	span.SetTag("id", id)
+	span.SetTag("owner", owner)
+	defer span.Finish()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var ownerID, name string
+	row := tx.QueryRowContext(ctx, `SELECT owner_id, name FROM repositories WHERE id = $1;`, id)
+	if err := row.Scan(&ownerID, &name); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrRepositoryNotFound
+		}
+		return nil, err
+	}
+
+	var newOwnerID string
+	row = tx.QueryRowContext(ctx, `SELECT id FROM users WHERE username = $1;`, owner)
+	if err := row.Scan(&newOwnerID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrOwnerNotFound
+		}
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE repositories SET owner_id = $2, updated_at = now() WHERE id = $1;`, id, newOwnerID)
+	if err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == pq.ErrorCode("23505") {
+				return nil, ErrAlreadyExists
+			}
+		}
+		return nil, err
+	}
+
+	if err := moveRedirect(ctx, tx, id, ownerID, name, newOwnerID, name); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	o, err := s.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.Repository, nil
+}
+
+// moveRedirect makes the old owner and name redirect to the repository,
+// the new owner and name don't redirect anymore as the repository is there now.
+func moveRedirect(ctx context.Context, tx *sql.Tx, id, oldOwnerID, oldName, newOwnerID, newName string) error {
+	redirect := `
+INSERT INTO repository_redirects (owner_id, name, repository_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (owner_id, name) DO UPDATE SET repository_id = excluded.repository_id, created_at = now();
+`
+	if _, err := tx.ExecContext(ctx, redirect, oldOwnerID, oldName, id); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, `DELETE FROM repository_redirects WHERE owner_id = $1 AND name = $2;`, newOwnerID, newName)
+	return err
+}
+
+// FindRedirect returns the ID of the repository that was renamed or transferred away from owner/name.
+func (s *Postgres) FindRedirect(ctx context.Context, owner, name string) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.FindRedirect")
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	findRedirect := `
+SELECT repository_id
+FROM repository_redirects
+WHERE
+	owner_id = (SELECT id FROM users WHERE username = $1) AND
+	name = $2;`
+
+	var id string
+	if err := s.db.QueryRowContext(ctx, findRedirect, owner, name).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return "", ErrRepositoryNotFound
+		}
+		return "", err
+	}
+
+	return id, nil
+}
+
+// ListVisible retrieves all public repositories and the private ones owned by username.
+// The repositories are returned by their owner's username.
+func (s *Postgres) ListVisible(ctx context.Context, username string) (map[string][]*Repository, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.ListVisible")
+	span.SetTag("username", username)
+	defer span.Finish()
+
+	listVisible := `
+SELECT
+	users.username,
+	repositories.id,
+	repositories.name,
+	repositories.description,
+	repositories.website,
+	repositories.default_branch,
+	repositories.private,
+	repositories.created_at,
+	repositories.updated_at
+FROM repositories
+JOIN users ON users.id = repositories.owner_id
+WHERE NOT repositories.private OR users.username = $1
+ORDER BY users.username, repositories.name;
+`
+
+	rows, err := s.db.QueryContext(ctx, listVisible, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	repositories := map[string][]*Repository{}
+
+	for rows.Next() {
+		var owner string
+		var description sql.NullString
+		var website sql.NullString
+		r := &Repository{}
+
+		if err := rows.Scan(
+			&owner,
+			&r.ID,
+			&r.Name,
+			&description,
+			&website,
+			&r.DefaultBranch,
+			&r.Private,
+			&r.Created,
+			&r.Updated,
+		); err != nil {
+			return nil, err
+		}
+		r.Description = description.String
+		r.Website = website.String
+
+		repositories[owner] = append(repositories[owner], r)
+	}
+
+	return repositories, rows.Err()
+}
+
+// ownedColumns are scanned by scanOwned, columns following them are scanned into extra.
+const ownedColumns = `
+	users.id,
+	users.username,
+	repositories.id,
+	repositories.name,
+	repositories.description,
+	repositories.website,
+	repositories.default_branch,
+	repositories.private,
+	repositories.created_at,
+	repositories.updated_at,
+	repositories.parent_id,
+	(SELECT count(*) FROM stars WHERE stars.repository_id = repositories.id),
+	(SELECT count(*) FROM watches WHERE watches.repository_id = repositories.id),
+	ARRAY(SELECT topic FROM repository_topics WHERE repository_topics.repository_id = repositories.id ORDER BY topic)`
+
+func scanOwned(row interface{ Scan(...interface{}) error }, extra ...interface{}) (*OwnedRepository, error) {
+	var description sql.NullString
+	var website sql.NullString
+	var parentID sql.NullString
+	o := &OwnedRepository{Repository: &Repository{}}
+	r := o.Repository
+
+	dest := []interface{}{
+		&o.OwnerID,
+		&o.Owner,
+		&r.ID,
+		&r.Name,
+		&description,
+		&website,
+		&r.DefaultBranch,
+		&r.Private,
+		&r.Created,
+		&r.Updated,
+		&parentID,
+		&r.Stars,
+		&r.Watchers,
+		pq.Array(&r.Topics),
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	r.Description = description.String
+	r.Website = website.String
+	r.ParentID = parentID.String
+
+	return o, nil
+}
+
+// FindByID returns the repository with the id together with its owner.
+func (s *Postgres) FindByID(ctx context.Context, id string) (*OwnedRepository, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.FindByID")
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	findByID := `
+SELECT` + ownedColumns + `
+FROM repositories
+JOIN users ON users.id = repositories.owner_id
+WHERE repositories.id = $1;
+`
+
+	o, err := scanOwned(s.db.QueryRowContext(ctx, findByID, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrRepositoryNotFound
+	}
+	return o, err
+}
+
+// ListForks returns the forks of all the given repositories together with their owners.
+// Private forks are included.
+func (s *Postgres) ListForks(ctx context.Context, ids []string) ([]*OwnedRepository, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.ListForks")
+	span.SetTag("ids", strings.Join(ids, ","))
+	defer span.Finish()
+
+	listForks := `
+SELECT` + ownedColumns + `
+FROM repositories
+JOIN users ON users.id = repositories.owner_id
+WHERE repositories.parent_id = ANY($1)
+ORDER BY repositories.created_at, repositories.id;
+`
+
+	rows, err := s.db.QueryContext(ctx, listForks, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var forks []*OwnedRepository
+	for rows.Next() {
+		o, err := scanOwned(rows)
+		if err != nil {
+			return nil, err
+		}
+		forks = append(forks, o)
+	}
+
+	return forks, rows.Err()
+}
+
+// Delete the repository with the id.
+// Its forks aren't forks anymore afterwards, their ids are returned.
+func (s *Postgres) Delete(ctx context.Context, id string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.Delete")
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `UPDATE repositories SET parent_id = NULL WHERE parent_id = $1 RETURNING id;`, id)
+	if err != nil {
+		return nil, err
+	}
+	var forks []string
+	for rows.Next() {
+		var fork string
+		if err := rows.Scan(&fork); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		forks = append(forks, fork)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM repositories WHERE id = $1;`, id)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return nil, ErrRepositoryNotFound
+	}
+
+	return forks, tx.Commit()
+}
+
+// repositoryDocument is the full text search document of repositories, like the repositories_search_idx.
+const repositoryDocument = "to_tsvector('simple', repositories.name || ' ' || coalesce(repositories.description, ''))"
+
+// Search repositories whose name or description contain words starting with all words of the query page by page,
+// it returns the cursor of the next page if there's one.
+// Private repositories are only found if they're owned by username.
+func (s *Postgres) Search(ctx context.Context, username string, opts SearchOptions) ([]*OwnedRepository, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.Search")
+	span.SetTag("username", username)
+	span.SetTag("query", opts.Query)
+	span.SetTag("sort", opts.Sort)
+	span.SetTag("cursor", opts.Page.Cursor)
+	defer span.Finish()
+
+	const hasTopic = "EXISTS (SELECT 1 FROM repository_topics WHERE repository_topics.repository_id = repositories.id AND repository_topics.topic = $%d)"
+
+	args := []interface{}{username}
+	where := []string{"(NOT repositories.private OR users.username = $1)"}
+
+	var words []string
+	for _, word := range sqlutil.SearchWords(opts.Query) {
+		if strings.HasPrefix(word, "topic:") {
+			args = append(args, strings.ToLower(strings.TrimPrefix(word, "topic:")))
+			where = append(where, fmt.Sprintf(hasTopic, len(args)))
+			continue
+		}
+		words = append(words, word)
+	}
+
+	// Searching only for topics matches all repositories with them equally well.
+	rank := "0::real"
+	if len(words) > 0 {
+		args = append(args, sqlutil.SearchPrefixes(words))
+		var match string
+		match, rank = sqlutil.TextSearch(repositoryDocument, len(args))
+		where = append(where, match)
+	}
+
+	var order string
+	switch opts.Sort {
+	case SortName:
+		order = "repositories.name ASC, repositories.id ASC"
+	case SortUpdated:
+		order = "repositories.updated_at DESC, repositories.id DESC"
+	default:
+		order = rank + " DESC, repositories.id ASC"
+	}
+
+	if opts.Page.Cursor != "" {
+		keys, err := pagination.DecodeCursor(opts.Page.Cursor, 3)
+		if err != nil || keys[0] != opts.Sort {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+
+		var after string
+		switch opts.Sort {
+		case SortName:
+			args = append(args, keys[1], keys[2])
+			after = "(repositories.name > $%[1]d OR (repositories.name = $%[1]d AND repositories.id > $%[2]d))"
+		case SortUpdated:
+			updated, err := time.Parse(time.RFC3339Nano, keys[1])
+			if err != nil {
+				return nil, "", pagination.ErrCursorInvalid
+			}
+			args = append(args, updated, keys[2])
+			after = "(repositories.updated_at < $%[1]d OR (repositories.updated_at = $%[1]d AND repositories.id < $%[2]d))"
+		default:
+			last, err := strconv.ParseFloat(keys[1], 32)
+			if err != nil {
+				return nil, "", pagination.ErrCursorInvalid
+			}
+			args = append(args, float32(last), keys[2])
+			after = "(" + rank + " < $%[1]d OR (" + rank + " = $%[1]d AND repositories.id > $%[2]d))"
+		}
+		where = append(where, fmt.Sprintf(after, len(args)-1, len(args)))
+	}
+
+	limit := opts.Page.Limit()
+	args = append(args, limit+1)
+
+	search := fmt.Sprintf(`
+SELECT%s,
+	%s
+FROM repositories
+JOIN users ON users.id = repositories.owner_id
+WHERE %s
+ORDER BY %s
+LIMIT $%d;
+`, ownedColumns, rank, strings.Join(where, " AND "), order, len(args))
+
+	rows, err := s.db.QueryContext(ctx, search, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var results []*OwnedRepository
+	var ranks []float32
+	for rows.Next() {
+		var rank float32
+		o, err := scanOwned(rows, &rank)
+		if err != nil {
+			return nil, "", err
+		}
+		results = append(results, o)
+		ranks = append(ranks, rank)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(results) <= limit {
+		return results, "", nil
+	}
+
+	results = results[:limit]
+	last := results[limit-1].Repository
+	switch opts.Sort {
+	case SortName:
+		return results, pagination.EncodeCursor(opts.Sort, last.Name, last.ID), nil
+	case SortUpdated:
+		return results, pagination.EncodeCursor(opts.Sort, last.Updated.Format(time.RFC3339Nano), last.ID), nil
+	default:
+		return results, pagination.EncodeCursor(opts.Sort, strconv.FormatFloat(float64(ranks[limit-1]), 'g', -1, 32), last.ID), nil
+	}
+}
+
+// Star the repository for the user, starring it again changes nothing.
+func (s *Postgres) Star(ctx context.Context, userID, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.Star")
+	span.SetTag("user_id", userID)
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	_, err := s.db.ExecContext(ctx, `
+INSERT INTO stars (user_id, repository_id) VALUES ($1, $2)
+ON CONFLICT (user_id, repository_id) DO NOTHING;
+`, userID, id)
+	return err
+}
+
+// Unstar the repository for the user, it doesn't matter if it wasn't starred.
+func (s *Postgres) Unstar(ctx context.Context, userID, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.Unstar")
+	span.SetTag("user_id", userID)
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	_, err := s.db.ExecContext(ctx, `DELETE FROM stars WHERE user_id = $1 AND repository_id = $2;`, userID, id)
+	return err
+}
+
+// IsStarred returns true if the user starred the repository.
+func (s *Postgres) IsStarred(ctx context.Context, userID, id string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.IsStarred")
+	span.SetTag("user_id", userID)
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	var starred bool
+	err := s.db.QueryRowContext(ctx, `
+SELECT EXISTS (SELECT 1 FROM stars WHERE user_id = $1 AND repository_id = $2);
+`, userID, id).Scan(&starred)
+	return starred, err
+}
+
+// ListStarred retrieves a page of the repositories starred by the user, most recently starred first.
+// Private repositories are only listed if they're owned by viewer.
+// This func returns the repositories, the cursor of the next page if there's one and an error.
+func (s *Postgres) ListStarred(ctx context.Context, username, viewer string, opts pagination.Options) ([]*StarredRepository, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.ListStarred")
+	span.SetTag("username", username)
+	span.SetTag("viewer", viewer)
+	span.SetTag("cursor", opts.Cursor)
+	defer span.Finish()
+
+	var userID string
+	err := s.db.QueryRowContext(ctx, `SELECT id FROM users WHERE username = $1;`, username).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return nil, "", ErrOwnerNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	args := []interface{}{userID, viewer}
+	where := []string{
+		"stars.user_id = $1",
+		"(NOT repositories.private OR users.username = $2)",
+	}
+
+	if opts.Cursor != "" {
+		keys, err := pagination.DecodeCursor(opts.Cursor, 2)
+		if err != nil {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+		starred, err := time.Parse(time.RFC3339Nano, keys[0])
+		if err != nil {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+		args = append(args, starred, keys[1])
+		where = append(where, "(stars.created_at < $3 OR (stars.created_at = $3 AND repositories.id < $4))")
+	}
+
+	limit := opts.Limit()
+	args = append(args, limit+1)
+
+	listStarred := fmt.Sprintf(`
+SELECT%s,
+	stars.created_at
+FROM stars
+JOIN repositories ON repositories.id = stars.repository_id
+JOIN users ON users.id = repositories.owner_id
+WHERE %s
+ORDER BY stars.created_at DESC, repositories.id DESC
+LIMIT $%d;
+`, ownedColumns, strings.Join(where, " AND "), len(args))
+
+	rows, err := s.db.QueryContext(ctx, listStarred, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var starred []*StarredRepository
+	for rows.Next() {
+		sr := &StarredRepository{}
+		o, err := scanOwned(rows, &sr.Starred)
+		if err != nil {
+			return nil, "", err
+		}
+		sr.OwnedRepository = *o
+		starred = append(starred, sr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(starred) <= limit {
+		return starred, "", nil
+	}
+
+	starred = starred[:limit]
+	last := starred[limit-1]
+	return starred, pagination.EncodeCursor(last.Starred.Format(time.RFC3339Nano), last.Repository.ID), nil
+}
+
+// Watch the repository for the user, watching it again changes nothing.
+func (s *Postgres) Watch(ctx context.Context, userID, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.Watch")
+	span.SetTag("user_id", userID)
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	_, err := s.db.ExecContext(ctx, `
+INSERT INTO watches (user_id, repository_id) VALUES ($1, $2)
+ON CONFLICT (user_id, repository_id) DO NOTHING;
+`, userID, id)
+	return err
+}
+
+// Unwatch the repository for the user, it doesn't matter if it wasn't watched.
+func (s *Postgres) Unwatch(ctx context.Context, userID, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.Unwatch")
+	span.SetTag("user_id", userID)
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	_, err := s.db.ExecContext(ctx, `DELETE FROM watches WHERE user_id = $1 AND repository_id = $2;`, userID, id)
+	return err
+}
+
+// ListWatchers returns all users watching the repository, in the order they started watching it.
+func (s *Postgres) ListWatchers(ctx context.Context, id string) ([]*Watcher, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Postgres.ListWatchers")
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	rows, err := s.db.QueryContext(ctx, `
+SELECT
+	users.id,
+	users.username,
+	watches.created_at
+FROM watches
+JOIN users ON users.id = watches.user_id
+WHERE watches.repository_id = $1
+ORDER BY watches.created_at, users.id;
+`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var watchers []*Watcher
+	for rows.Next() {
+		w := &Watcher{}
+		if err := rows.Scan(&w.UserID, &w.Username, &w.Watched); err != nil {
+			return nil, err
+		}
+		watchers = append(watchers, w)
+	}
+
+	return watchers, rows.Err()
+}
@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"io"
 
 	"github.com/opentracing/opentracing-go"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
 	"github.com/sourcepods/sourcepods/pkg/storage"
 )
 
@@ -20,13 +22,15 @@ func NewTracingService(s Service, requestID TracingRequestID) Service {
 	return &tracingService{service: s, requestID: requestID}
 }
 
-func (s *tracingService) List(ctx context.Context, owner string) ([]*Repository, string, error) {
+func (s *tracingService) List(ctx context.Context, owner string, opts ListOptions) ([]*Repository, string, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.List")
 	span.SetTag("request", s.requestID(ctx))
 	span.SetTag("owner", owner)
+	span.SetTag("sort", opts.Sort)
+	span.SetTag("cursor", opts.Page.Cursor)
 	defer span.Finish()
 
-	return s.service.List(ctx, owner)
+	return s.service.List(ctx, owner, opts)
 }
 
 func (s *tracingService) Find(ctx context.Context, owner string, name string) (*Repository, string, error) {
@@ -49,14 +53,125 @@ func (s *tracingService) Create(ctx context.Context, owner string, repository *R
 	return s.service.Create(ctx, owner, repository)
 }
 
-func (s *tracingService) Branches(ctx context.Context, owner string, name string) ([]*Branch, error) {
+func (s *tracingService) Delete(ctx context.Context, owner, name string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Delete")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Delete(ctx, owner, name)
+}
+
+func (s *tracingService) Update(ctx context.Context, owner, name string, update Update) (*Repository, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Update")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Update(ctx, owner, name, update)
+}
+
+func (s *tracingService) Transfer(ctx context.Context, owner, name, newOwner string) (*Repository, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Transfer")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("new_owner", newOwner)
+	defer span.Finish()
+
+	return s.service.Transfer(ctx, owner, name, newOwner)
+}
+
+func (s *tracingService) Redirect(ctx context.Context, owner, name string) (string, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Redirect")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Redirect(ctx, owner, name)
+}
+
+func (s *tracingService) Fork(ctx context.Context, owner, name, forkName string) (*Repository, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Fork")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("fork", forkName)
+	defer span.Finish()
+
+	return s.service.Fork(ctx, owner, name, forkName)
+}
+
+func (s *tracingService) Forks(ctx context.Context, owner, name string) ([]*OwnedRepository, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Forks")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Forks(ctx, owner, name)
+}
+
+func (s *tracingService) Network(ctx context.Context, owner, name string) ([]*OwnedRepository, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Network")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Network(ctx, owner, name)
+}
+
+func (s *tracingService) Branches(ctx context.Context, owner string, name string, opts BranchesOptions) ([]*Branch, string, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Branches")
 	span.SetTag("request", s.requestID(ctx))
 	span.SetTag("owner", owner)
 	span.SetTag("name", name)
+	span.SetTag("prefix", opts.Prefix)
+	span.SetTag("sort", opts.Sort)
+	span.SetTag("cursor", opts.Page.Cursor)
+	defer span.Finish()
+
+	return s.service.Branches(ctx, owner, name, opts)
+}
+
+func (s *tracingService) CreateBranch(ctx context.Context, owner, name, branch, rev string) (*Branch, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.CreateBranch")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("branch", branch)
+	span.SetTag("rev", rev)
+	defer span.Finish()
+
+	return s.service.CreateBranch(ctx, owner, name, branch, rev)
+}
+
+func (s *tracingService) DeleteBranch(ctx context.Context, owner, name, branch, sha1 string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.DeleteBranch")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("branch", branch)
+	span.SetTag("sha1", sha1)
+	defer span.Finish()
+
+	return s.service.DeleteBranch(ctx, owner, name, branch, sha1)
+}
+
+func (s *tracingService) RenameBranch(ctx context.Context, owner, name, branch, newName string) (*Branch, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.RenameBranch")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("branch", branch)
+	span.SetTag("new_name", newName)
 	defer span.Finish()
 
-	return s.service.Branches(ctx, owner, name)
+	return s.service.RenameBranch(ctx, owner, name, branch, newName)
 }
 
 func (s *tracingService) Commit(ctx context.Context, owner string, name string, rev string) (storage.Commit, error) {
@@ -81,3 +196,175 @@ func (s *tracingService) Tree(ctx context.Context, owner, name, rev, path string
 
 	return s.service.Tree(ctx, owner, name, rev, path)
 }
+
+func (s *tracingService) Archive(ctx context.Context, owner, name, rev, format, prefix string, w io.Writer) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Archive")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("rev", rev)
+	span.SetTag("format", format)
+	span.SetTag("prefix", prefix)
+	defer span.Finish()
+
+	return s.service.Archive(ctx, owner, name, rev, format, prefix, w)
+}
+
+func (s *tracingService) Blob(ctx context.Context, owner, name, rev, path string) (storage.Blob, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Blob")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("rev", rev)
+	span.SetTag("path", path)
+	defer span.Finish()
+
+	return s.service.Blob(ctx, owner, name, rev, path)
+}
+
+func (s *tracingService) Readme(ctx context.Context, owner, name, rev string) (*Readme, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Readme")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("rev", rev)
+	defer span.Finish()
+
+	return s.service.Readme(ctx, owner, name, rev)
+}
+
+func (s *tracingService) ReadBlob(ctx context.Context, owner, name, sha1 string, offset, limit int64, w io.Writer) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.ReadBlob")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("sha1", sha1)
+	span.SetTag("offset", offset)
+	span.SetTag("limit", limit)
+	defer span.Finish()
+
+	return s.service.ReadBlob(ctx, owner, name, sha1, offset, limit, w)
+}
+
+func (s *tracingService) Blame(ctx context.Context, owner, name, rev, path string) ([]storage.BlameHunk, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Blame")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("rev", rev)
+	span.SetTag("path", path)
+	defer span.Finish()
+
+	return s.service.Blame(ctx, owner, name, rev, path)
+}
+
+func (s *tracingService) Search(ctx context.Context, owner, name, rev string, opts storage.SearchOptions) ([]storage.SearchMatch, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Search")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("rev", rev)
+	span.SetTag("query", opts.Query)
+	defer span.Finish()
+
+	return s.service.Search(ctx, owner, name, rev, opts)
+}
+
+func (s *tracingService) SearchCode(ctx context.Context, opts storage.IndexSearchOptions) ([]*CodeMatch, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.SearchCode")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("query", opts.Query)
+	defer span.Finish()
+
+	return s.service.SearchCode(ctx, opts)
+}
+
+func (s *tracingService) SearchRepositories(ctx context.Context, opts SearchOptions) ([]*OwnedRepository, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.SearchRepositories")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("query", opts.Query)
+	span.SetTag("cursor", opts.Page.Cursor)
+	defer span.Finish()
+
+	return s.service.SearchRepositories(ctx, opts)
+}
+
+func (s *tracingService) Stats(ctx context.Context, owner, name string) (*Stats, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Stats")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Stats(ctx, owner, name)
+}
+
+func (s *tracingService) Star(ctx context.Context, owner, name string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Star")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Star(ctx, owner, name)
+}
+
+func (s *tracingService) Unstar(ctx context.Context, owner, name string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Unstar")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Unstar(ctx, owner, name)
+}
+
+func (s *tracingService) IsStarred(ctx context.Context, owner, name string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.IsStarred")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.IsStarred(ctx, owner, name)
+}
+
+func (s *tracingService) Watch(ctx context.Context, owner, name string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Watch")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Watch(ctx, owner, name)
+}
+
+func (s *tracingService) Unwatch(ctx context.Context, owner, name string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Unwatch")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Unwatch(ctx, owner, name)
+}
+
+func (s *tracingService) Watchers(ctx context.Context, owner, name string) ([]*Watcher, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Watchers")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Watchers(ctx, owner, name)
+}
+
+func (s *tracingService) Starred(ctx context.Context, username string, opts pagination.Options) ([]*StarredRepository, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository.Service.Starred")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("username", username)
+	span.SetTag("cursor", opts.Cursor)
+	defer span.Finish()
+
+	return s.service.Starred(ctx, username, opts)
+}
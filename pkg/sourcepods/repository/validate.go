@@ -2,6 +2,7 @@ package repository
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/asaskevich/govalidator"
 )
@@ -47,6 +48,13 @@ func ValidateCreate(r *Repository) error {
 		})
 	}
 
+	if err := validateTopics(r.Topics); err != nil {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "topics",
+			Error: err,
+		})
+	}
+
 	if len(errs.Errors) > 0 {
 		return errs
 	}
@@ -80,3 +88,27 @@ func validateWebsite(website string) error {
 
 	return nil
 }
+
+// topicsMax is the most topics a repository can have.
+const topicsMax = 20
+
+var topicPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,34}$`)
+
+func validateTopics(topics []string) error {
+	if len(topics) > topicsMax {
+		return fmt.Errorf("there are more than %d topics", topicsMax)
+	}
+
+	seen := make(map[string]bool, len(topics))
+	for _, topic := range topics {
+		if !topicPattern.MatchString(topic) {
+			return fmt.Errorf("topic %s is not lowercase letters, numbers and hyphens of at most 35 characters", topic)
+		}
+		if seen[topic] {
+			return fmt.Errorf("topic %s is given more than once", topic)
+		}
+		seen[topic] = true
+	}
+
+	return nil
+}
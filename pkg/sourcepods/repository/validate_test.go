@@ -46,12 +46,29 @@ func TestValidateCreate(t *testing.T) {
 				Error: errors.New("example is not a url"),
 			}},
 		},
+		{
+			Name:       "InvalidTopic",
+			Repository: &Repository{Name: "username", Topics: []string{"go", "Not a topic"}},
+			Errors: []ValidationError{{
+				Field: "topics",
+				Error: errors.New("topic Not a topic is not lowercase letters, numbers and hyphens of at most 35 characters"),
+			}},
+		},
+		{
+			Name:       "DuplicateTopic",
+			Repository: &Repository{Name: "username", Topics: []string{"go", "go"}},
+			Errors: []ValidationError{{
+				Field: "topics",
+				Error: errors.New("topic go is given more than once"),
+			}},
+		},
 		{
 			Name: "Valid",
 			Repository: &Repository{
 				Name:        "username",
 				Website:     "http://example.com",
 				Description: "Awesome repository!",
+				Topics:      []string{"git", "go", "self-hosted"},
 			},
 			Errors: nil,
 		},
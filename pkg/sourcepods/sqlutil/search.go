@@ -0,0 +1,39 @@
+// Package sqlutil has the helpers the Postgres stores share to build queries.
+package sqlutil
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SearchMaxWords limits the number of words of a search query.
+const SearchMaxWords = 10
+
+// SearchWords returns the words of the query, at most SearchMaxWords.
+func SearchWords(query string) []string {
+	words := strings.Fields(query)
+	if len(words) > SearchMaxWords {
+		words = words[:SearchMaxWords]
+	}
+	return words
+}
+
+// SearchPrefixes returns the tsquery of the words matching documents with words starting with each of them,
+// searching for "sourc" finds "sourcepods". The words are quoted so they can't contain tsquery operators.
+func SearchPrefixes(words []string) string {
+	escape := strings.NewReplacer(`\`, `\\`, `'`, `''`)
+	prefixes := make([]string, len(words))
+	for i, word := range words {
+		prefixes[i] = "'" + escape.Replace(word) + "':*"
+	}
+	return strings.Join(prefixes, " & ")
+}
+
+// TextSearch returns the condition matching the full text search document against the tsquery
+// of the argument $n, built by SearchPrefixes, and the rank of the document, to order the matches by relevance.
+// The document is a tsvector of the 'simple' configuration, it has to be the expression
+// of a GIN index for the search to be fast.
+func TextSearch(document string, n int) (match string, rank string) {
+	query := fmt.Sprintf("to_tsquery('simple', $%d)", n)
+	return fmt.Sprintf("%s @@ %s", document, query), fmt.Sprintf("ts_rank(%s, %s)", document, query)
+}
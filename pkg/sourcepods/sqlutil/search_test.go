@@ -0,0 +1,27 @@
+package sqlutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchWords(t *testing.T) {
+	assert.Equal(t, []string{"foo", "bar"}, SearchWords("  foo \t bar "))
+	assert.Len(t, SearchWords(strings.Repeat("foo ", 20)), SearchMaxWords)
+	assert.Empty(t, SearchWords(" "))
+}
+
+func TestSearchPrefixes(t *testing.T) {
+	assert.Equal(t, "'sourc':*", SearchPrefixes([]string{"sourc"}))
+	assert.Equal(t, "'foo':* & 'bar':*", SearchPrefixes([]string{"foo", "bar"}))
+	assert.Equal(t, `'it''s':* & 'a\\b':* & '!|&':*`, SearchPrefixes([]string{"it's", `a\b`, "!|&"}))
+	assert.Equal(t, "", SearchPrefixes(nil))
+}
+
+func TestTextSearch(t *testing.T) {
+	match, rank := TextSearch("to_tsvector('simple', name)", 2)
+	assert.Equal(t, "to_tsvector('simple', name) @@ to_tsquery('simple', $2)", match)
+	assert.Equal(t, "ts_rank(to_tsvector('simple', name), to_tsquery('simple', $2))", rank)
+}
@@ -0,0 +1,244 @@
+package status
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+//LoggingRequestID returns the request ID as string for logging
+type LoggingRequestID func(context.Context) string
+
+type loggingService struct {
+	service   Service
+	requestID LoggingRequestID
+	logger    log.Logger
+}
+
+// NewLoggingService wraps the Service and provides logging for its methods.
+func NewLoggingService(s Service, requestID LoggingRequestID, logger log.Logger) Service {
+	return &loggingService{service: s, requestID: requestID, logger: logger}
+}
+
+func (s *loggingService) Create(ctx context.Context, owner, name, rev string, st *Status) (*Status, error) {
+	start := time.Now()
+
+	st, err := s.service.Create(ctx, owner, name, rev, st)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Create",
+		"owner", owner,
+		"name", name,
+		"rev", rev,
+		"context", st.Context,
+		"state", st.State,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to create status",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return st, err
+}
+
+func (s *loggingService) List(ctx context.Context, owner, name, rev string) ([]*Status, error) {
+	start := time.Now()
+
+	statuses, err := s.service.List(ctx, owner, name, rev)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "List",
+		"owner", owner,
+		"name", name,
+		"rev", rev,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list statuses",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return statuses, err
+}
+
+func (s *loggingService) Combined(ctx context.Context, owner, name, rev string) (*Combined, error) {
+	start := time.Now()
+
+	c, err := s.service.Combined(ctx, owner, name, rev)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Combined",
+		"owner", owner,
+		"name", name,
+		"rev", rev,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to combine statuses",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return c, err
+}
+
+func (s *loggingService) Summaries(ctx context.Context, owner, name string, sha1s []string) (map[string]*Combined, error) {
+	start := time.Now()
+
+	summaries, err := s.service.Summaries(ctx, owner, name, sha1s)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Summaries",
+		"owner", owner,
+		"name", name,
+		"commits", len(sha1s),
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to summarize statuses",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return summaries, err
+}
+
+func (s *loggingService) Protections(ctx context.Context, owner, name string) ([]*Protection, error) {
+	start := time.Now()
+
+	protections, err := s.service.Protections(ctx, owner, name)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Protections",
+		"owner", owner,
+		"name", name,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to list branch protections",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return protections, err
+}
+
+func (s *loggingService) Protection(ctx context.Context, owner, name, branch string) (*Protection, error) {
+	start := time.Now()
+
+	p, err := s.service.Protection(ctx, owner, name, branch)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Protection",
+		"owner", owner,
+		"name", name,
+		"branch", branch,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to find branch protection",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return p, err
+}
+
+func (s *loggingService) UpdateProtection(ctx context.Context, owner, name string, p *Protection) (*Protection, error) {
+	start := time.Now()
+
+	p, err := s.service.UpdateProtection(ctx, owner, name, p)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "UpdateProtection",
+		"owner", owner,
+		"name", name,
+		"branch", p.Branch,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to update branch protection",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return p, err
+}
+
+func (s *loggingService) Unsatisfied(ctx context.Context, owner, name, branch, sha1 string) ([]string, error) {
+	start := time.Now()
+
+	checks, err := s.service.Unsatisfied(ctx, owner, name, branch, sha1)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Unsatisfied",
+		"owner", owner,
+		"name", name,
+		"branch", branch,
+		"sha1", sha1,
+		"duration", time.Since(start),
+	)
+
+	if err != nil && !isUserError(err) {
+		level.Warn(logger).Log(
+			"msg", "failed to check required statuses",
+			"err", err,
+		)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return checks, err
+}
+
+func isUserError(err error) bool {
+	if _, ok := err.(ValidationErrors); ok {
+		return true
+	}
+	switch err {
+	case ErrRepositoryNotFound, ErrCommitNotFound, ErrPermissionDenied:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,276 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+)
+
+var (
+	// ErrRepositoryNotFound returned if the repository of a status is not found.
+	ErrRepositoryNotFound = errors.New("repository not found")
+
+	// ErrCommitNotFound returned if the commit of a status doesn't exist in the repository.
+	ErrCommitNotFound = errors.New("commit not found")
+
+	// ErrPermissionDenied returned if the user isn't allowed to report statuses or protect branches.
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+type (
+	// Store or retrieve statuses and branch protections from some database.
+	Store interface {
+		Create(ctx context.Context, s *Status) (*Status, error)
+		List(ctx context.Context, repositoryID, sha1 string) ([]*Status, error)
+		Latest(ctx context.Context, repositoryID string, sha1s []string) (map[string][]*Status, error)
+		ListProtections(ctx context.Context, repositoryID string) ([]*Protection, error)
+		UpdateProtection(ctx context.Context, repositoryID string, p *Protection) error
+	}
+
+	// Repositories finds the repositories visible to the session's user and their commits.
+	Repositories interface {
+		Find(ctx context.Context, owner, name string) (*repository.Repository, string, error)
+		Commit(ctx context.Context, owner, name, rev string) (storage.Commit, error)
+	}
+
+	// Service to interact with commit statuses.
+	Service interface {
+		Create(ctx context.Context, owner, name, rev string, s *Status) (*Status, error)
+		List(ctx context.Context, owner, name, rev string) ([]*Status, error)
+		Combined(ctx context.Context, owner, name, rev string) (*Combined, error)
+		Summaries(ctx context.Context, owner, name string, sha1s []string) (map[string]*Combined, error)
+		Protections(ctx context.Context, owner, name string) ([]*Protection, error)
+		Protection(ctx context.Context, owner, name, branch string) (*Protection, error)
+		UpdateProtection(ctx context.Context, owner, name string, p *Protection) (*Protection, error)
+		Unsatisfied(ctx context.Context, owner, name, branch, sha1 string) ([]string, error)
+	}
+
+	service struct {
+		statuses     Store
+		repositories Repositories
+	}
+)
+
+// NewService to interact with commit statuses.
+func NewService(statuses Store, repositories Repositories) Service {
+	return &service{
+		statuses:     statuses,
+		repositories: repositories,
+	}
+}
+
+// Create a status for the commit rev resolves to, reported by the session's user.
+// Only the repository's owner is allowed to report statuses.
+func (s *service) Create(ctx context.Context, owner, name, rev string, st *Status) (*Status, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil || u.Username != owner {
+		return nil, ErrPermissionDenied
+	}
+
+	r, sha1, err := s.resolve(ctx, owner, name, rev)
+	if err != nil {
+		return nil, err
+	}
+
+	st.Context = strings.TrimSpace(st.Context)
+	if st.Context == "" {
+		st.Context = DefaultContext
+	}
+	if err := ValidateCreate(st); err != nil {
+		return nil, err
+	}
+
+	st.RepositoryID = r.ID
+	st.Sha1 = sha1
+	st.CreatorID = u.ID
+	st.Creator = u.Username
+
+	return s.statuses.Create(ctx, st)
+}
+
+// List all statuses of the commit rev resolves to, most recent first.
+func (s *service) List(ctx context.Context, owner, name, rev string) ([]*Status, error) {
+	r, sha1, err := s.resolve(ctx, owner, name, rev)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.statuses.List(ctx, r.ID, sha1)
+}
+
+// Combined returns the combined state of the commit rev resolves to.
+func (s *service) Combined(ctx context.Context, owner, name, rev string) (*Combined, error) {
+	r, sha1, err := s.resolve(ctx, owner, name, rev)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := s.statuses.Latest(ctx, r.ID, []string{sha1})
+	if err != nil {
+		return nil, err
+	}
+
+	return combine(sha1, latest[sha1]), nil
+}
+
+// Summaries combines the statuses of many commits at once, e.g. for the heads of branches.
+// Commits without statuses are pending.
+func (s *service) Summaries(ctx context.Context, owner, name string, sha1s []string) (map[string]*Combined, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := s.statuses.Latest(ctx, r.ID, sha1s)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make(map[string]*Combined, len(sha1s))
+	for _, sha1 := range sha1s {
+		summaries[sha1] = combine(sha1, latest[sha1])
+	}
+
+	return summaries, nil
+}
+
+// Protections lists the repository's protected branches with their required checks.
+func (s *service) Protections(ctx context.Context, owner, name string) ([]*Protection, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.statuses.ListProtections(ctx, r.ID)
+}
+
+// Protection of a branch, without any required checks if the branch isn't protected.
+func (s *service) Protection(ctx context.Context, owner, name, branch string) (*Protection, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.protection(ctx, r.ID, branch)
+}
+
+// UpdateProtection replaces the required checks of a branch, no required checks remove its protection.
+func (s *service) UpdateProtection(ctx context.Context, owner, name string, p *Protection) (*Protection, error) {
+	u := session.GetSessionUser(ctx)
+	if u == nil || u.Username != owner {
+		return nil, ErrPermissionDenied
+	}
+
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	p.RequiredChecks = uniqueChecks(p.RequiredChecks)
+	if err := ValidateProtection(p); err != nil {
+		return nil, err
+	}
+
+	if err := s.statuses.UpdateProtection(ctx, r.ID, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Unsatisfied returns the required checks of the branch whose latest status for sha1 isn't a success.
+func (s *service) Unsatisfied(ctx context.Context, owner, name, branch, sha1 string) ([]string, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.protection(ctx, r.ID, branch)
+	if err != nil {
+		return nil, err
+	}
+	if len(p.RequiredChecks) == 0 {
+		return nil, nil
+	}
+
+	latest, err := s.statuses.Latest(ctx, r.ID, []string{sha1})
+	if err != nil {
+		return nil, err
+	}
+
+	succeeded := make(map[string]bool)
+	for _, st := range latest[sha1] {
+		succeeded[st.Context] = st.State == StateSuccess
+	}
+
+	var unsatisfied []string
+	for _, check := range p.RequiredChecks {
+		if !succeeded[check] {
+			unsatisfied = append(unsatisfied, check)
+		}
+	}
+
+	return unsatisfied, nil
+}
+
+func (s *service) protection(ctx context.Context, repositoryID, branch string) (*Protection, error) {
+	protections, err := s.statuses.ListProtections(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range protections {
+		if p.Branch == branch {
+			return p, nil
+		}
+	}
+
+	return &Protection{Branch: branch}, nil
+}
+
+// resolve the rev to the sha1 of a commit in the repository.
+func (s *service) resolve(ctx context.Context, owner, name, rev string) (*repository.Repository, string, error) {
+	r, err := s.findRepository(ctx, owner, name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	c, err := s.repositories.Commit(ctx, owner, name, rev)
+	if err == repository.ErrRevNotFound {
+		return nil, "", ErrCommitNotFound
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	return r, c.Hash, nil
+}
+
+func (s *service) findRepository(ctx context.Context, owner, name string) (*repository.Repository, error) {
+	r, _, err := s.repositories.Find(ctx, owner, name)
+	if err == repository.ErrRepositoryNotFound {
+		return nil, ErrRepositoryNotFound
+	}
+	return r, err
+}
+
+// uniqueChecks trims and sorts the checks and removes duplicates.
+func uniqueChecks(checks []string) []string {
+	seen := make(map[string]bool, len(checks))
+	unique := []string{}
+	for _, check := range checks {
+		check = strings.TrimSpace(check)
+		if seen[check] {
+			continue
+		}
+		seen[check] = true
+		unique = append(unique, check)
+	}
+	sort.Strings(unique)
+	return unique
+}
@@ -0,0 +1,153 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sourcepods/sourcepods/pkg/session"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
+	"github.com/sourcepods/sourcepods/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testStore struct {
+	Store
+	statuses    []*Status
+	protections map[string][]string
+}
+
+func (s *testStore) Create(ctx context.Context, st *Status) (*Status, error) {
+	st.ID = fmt.Sprintf("status%d", len(s.statuses)+1)
+	s.statuses = append(s.statuses, st)
+	return st, nil
+}
+
+func (s *testStore) Latest(ctx context.Context, repositoryID string, sha1s []string) (map[string][]*Status, error) {
+	latest := make(map[string][]*Status)
+	for i := len(s.statuses) - 1; i >= 0; i-- {
+		st := s.statuses[i]
+		seen := false
+		for _, l := range latest[st.Sha1] {
+			seen = seen || l.Context == st.Context
+		}
+		if !seen {
+			latest[st.Sha1] = append(latest[st.Sha1], st)
+		}
+	}
+	return latest, nil
+}
+
+func (s *testStore) ListProtections(ctx context.Context, repositoryID string) ([]*Protection, error) {
+	var protections []*Protection
+	for branch, checks := range s.protections {
+		protections = append(protections, &Protection{Branch: branch, RequiredChecks: checks})
+	}
+	return protections, nil
+}
+
+func (s *testStore) UpdateProtection(ctx context.Context, repositoryID string, p *Protection) error {
+	s.protections[p.Branch] = p.RequiredChecks
+	return nil
+}
+
+type testRepositories struct{}
+
+func (testRepositories) Find(ctx context.Context, owner, name string) (*repository.Repository, string, error) {
+	if owner != "foo" || name != "bar" {
+		return nil, "", repository.ErrRepositoryNotFound
+	}
+	return &repository.Repository{ID: "1", Name: name}, owner, nil
+}
+
+func (testRepositories) Commit(ctx context.Context, owner, name, rev string) (storage.Commit, error) {
+	if rev == "master" || rev == "a" {
+		return storage.Commit{Hash: "a"}, nil
+	}
+	return storage.Commit{}, repository.ErrRevNotFound
+}
+
+func withUser(username string) context.Context {
+	ctx := context.WithValue(context.Background(), session.CookieUserID, username+"-id")
+	return context.WithValue(ctx, session.CookieUserUsername, username)
+}
+
+func TestServiceCreate(t *testing.T) {
+	s := NewService(&testStore{}, testRepositories{})
+
+	_, err := s.Create(withUser("baz"), "foo", "bar", "a", &Status{State: StateSuccess})
+	assert.Equal(t, ErrPermissionDenied, err)
+	_, err = s.Create(withUser("foo"), "foo", "bar", "b", &Status{State: StateSuccess})
+	assert.Equal(t, ErrCommitNotFound, err)
+	_, err = s.Create(withUser("foo"), "foo", "bar", "a", &Status{State: "done", TargetURL: "ftp://ci"})
+	require.IsType(t, ValidationErrors{}, err)
+	assert.Len(t, err.(ValidationErrors).Errors, 2)
+
+	st, err := s.Create(withUser("foo"), "foo", "bar", "master", &Status{State: StatePending, TargetURL: "https://ci/1"})
+	require.NoError(t, err)
+	assert.Equal(t, "a", st.Sha1)
+	assert.Equal(t, DefaultContext, st.Context)
+	assert.Equal(t, "foo", st.Creator)
+}
+
+func TestServiceCombined(t *testing.T) {
+	s := NewService(&testStore{}, testRepositories{})
+
+	c, err := s.Combined(context.Background(), "foo", "bar", "a")
+	require.NoError(t, err)
+	assert.Equal(t, StatePending, c.State)
+
+	for _, st := range []*Status{
+		{Context: "ci/build", State: StateFailure},
+		{Context: "ci/lint", State: StateSuccess},
+		{Context: "ci/build", State: StateSuccess},
+	} {
+		_, err := s.Create(withUser("foo"), "foo", "bar", "a", st)
+		require.NoError(t, err)
+	}
+
+	c, err = s.Combined(context.Background(), "foo", "bar", "a")
+	require.NoError(t, err)
+	assert.Equal(t, StateSuccess, c.State, "the latest status of a context counts")
+	assert.Len(t, c.Statuses, 2)
+
+	_, err = s.Create(withUser("foo"), "foo", "bar", "a", &Status{Context: "ci/lint", State: StateError})
+	require.NoError(t, err)
+	summaries, err := s.Summaries(context.Background(), "foo", "bar", []string{"a", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, StateFailure, summaries["a"].State)
+	assert.Equal(t, StatePending, summaries["b"].State)
+}
+
+func TestServiceProtection(t *testing.T) {
+	s := NewService(&testStore{protections: map[string][]string{}}, testRepositories{})
+
+	_, err := s.UpdateProtection(withUser("baz"), "foo", "bar", &Protection{Branch: "master"})
+	assert.Equal(t, ErrPermissionDenied, err)
+	_, err = s.UpdateProtection(withUser("foo"), "foo", "bar", &Protection{Branch: "master", RequiredChecks: []string{" "}})
+	assert.IsType(t, ValidationErrors{}, err)
+
+	p, err := s.UpdateProtection(withUser("foo"), "foo", "bar", &Protection{
+		Branch:         "master",
+		RequiredChecks: []string{"ci/lint", " ci/build", "ci/lint"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ci/build", "ci/lint"}, p.RequiredChecks)
+
+	unsatisfied, err := s.Unsatisfied(context.Background(), "foo", "bar", "master", "a")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ci/build", "ci/lint"}, unsatisfied)
+
+	_, err = s.Create(withUser("foo"), "foo", "bar", "a", &Status{Context: "ci/build", State: StateSuccess})
+	require.NoError(t, err)
+	_, err = s.Create(withUser("foo"), "foo", "bar", "a", &Status{Context: "ci/lint", State: StatePending})
+	require.NoError(t, err)
+	unsatisfied, err = s.Unsatisfied(context.Background(), "foo", "bar", "master", "a")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ci/lint"}, unsatisfied)
+
+	unsatisfied, err = s.Unsatisfied(context.Background(), "foo", "bar", "feature", "a")
+	require.NoError(t, err)
+	assert.Empty(t, unsatisfied, "unprotected branches have no required checks")
+}
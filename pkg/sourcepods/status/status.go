@@ -0,0 +1,70 @@
+package status
+
+import "time"
+
+// States of commit statuses and their combination.
+const (
+	StatePending = "pending"
+	StateSuccess = "success"
+	StateFailure = "failure"
+	StateError   = "error"
+)
+
+// DefaultContext is the context of statuses reported without one.
+const DefaultContext = "default"
+
+// Status is reported by an external system like CI for a commit of a repository.
+// Statuses with the same context replace each other, the latest one counts.
+type Status struct {
+	ID           string
+	RepositoryID string
+	Sha1         string
+	// Context identifies the system reporting the status, e.g. ci/build.
+	Context     string
+	State       string
+	TargetURL   string
+	Description string
+
+	CreatorID string
+	Creator   string
+
+	Created time.Time
+}
+
+// Combined is the state of a commit combined from the latest status of each context.
+type Combined struct {
+	Sha1 string
+	// State is StateFailure if any status failed or errored,
+	// StatePending if there are no statuses or any is pending and StateSuccess otherwise.
+	State    string
+	Statuses []*Status
+}
+
+// Protection of a branch, pull requests can only be merged into it
+// once the statuses of all required checks succeeded for their head.
+type Protection struct {
+	Branch string
+	// RequiredChecks are the contexts of statuses that need to succeed.
+	RequiredChecks []string
+}
+
+// combine the latest statuses of a commit.
+func combine(sha1 string, statuses []*Status) *Combined {
+	c := &Combined{Sha1: sha1, State: StateSuccess, Statuses: statuses}
+	if len(statuses) == 0 {
+		c.State = StatePending
+	}
+
+	for _, s := range statuses {
+		switch s.State {
+		case StateFailure, StateError:
+			c.State = StateFailure
+		case StatePending:
+			if c.State != StateFailure {
+				c.State = StatePending
+			}
+		}
+	}
+
+	return c
+}
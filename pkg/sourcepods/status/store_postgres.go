@@ -0,0 +1,233 @@
+package status
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go"
+)
+
+// Postgres implementation of the Store.
+type Postgres struct {
+	db *sql.DB
+}
+
+// NewPostgresStore returns a Postgres implementation of the Store.
+func NewPostgresStore(db *sql.DB) *Postgres {
+	return &Postgres{db: db}
+}
+
+const selectStatuses = `
+SELECT
+	s.id,
+	s.sha1,
+	s.context,
+	s.state,
+	s.target_url,
+	s.description,
+	s.creator_id,
+	u.username,
+	s.created_at
+FROM statuses s
+JOIN users u ON u.id = s.creator_id
+`
+
+func scanStatus(row interface{ Scan(...interface{}) error }) (*Status, error) {
+	s := &Status{}
+	err := row.Scan(
+		&s.ID,
+		&s.Sha1,
+		&s.Context,
+		&s.State,
+		&s.TargetURL,
+		&s.Description,
+		&s.CreatorID,
+		&s.Creator,
+		&s.Created,
+	)
+	return s, err
+}
+
+// Create a status for a commit of a repository.
+// This func returns the created status or an error.
+func (s *Postgres) Create(ctx context.Context, st *Status) (*Status, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "status.Postgres.Create")
+	span.SetTag("repository", st.RepositoryID)
+	span.SetTag("sha1", st.Sha1)
+	span.SetTag("context", st.Context)
+	defer span.Finish()
+
+	create := `
+INSERT INTO statuses (repository_id, sha1, context, state, target_url, description, creator_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, created_at;
+`
+
+	row := s.db.QueryRowContext(ctx, create,
+		st.RepositoryID,
+		st.Sha1,
+		st.Context,
+		st.State,
+		st.TargetURL,
+		st.Description,
+		st.CreatorID,
+	)
+	if err := row.Scan(&st.ID, &st.Created); err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// List all statuses of a commit, most recent first.
+func (s *Postgres) List(ctx context.Context, repositoryID, sha1 string) ([]*Status, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "status.Postgres.List")
+	span.SetTag("repository", repositoryID)
+	span.SetTag("sha1", sha1)
+	defer span.Finish()
+
+	rows, err := s.db.QueryContext(ctx, selectStatuses+`
+WHERE s.repository_id = $1 AND s.sha1 = $2
+ORDER BY s.created_at DESC, s.id DESC;
+`, repositoryID, sha1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var statuses []*Status
+	for rows.Next() {
+		st, err := scanStatus(rows)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, st)
+	}
+
+	return statuses, rows.Err()
+}
+
+// Latest returns the latest status of each context for many commits at once,
+// the statuses of a commit are sorted by their context.
+func (s *Postgres) Latest(ctx context.Context, repositoryID string, sha1s []string) (map[string][]*Status, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "status.Postgres.Latest")
+	span.SetTag("repository", repositoryID)
+	span.SetTag("commits", len(sha1s))
+	defer span.Finish()
+
+	latest := make(map[string][]*Status)
+	if len(sha1s) == 0 {
+		return latest, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+SELECT DISTINCT ON (s.sha1, s.context)
+	s.id,
+	s.sha1,
+	s.context,
+	s.state,
+	s.target_url,
+	s.description,
+	s.creator_id,
+	u.username,
+	s.created_at
+FROM statuses s
+JOIN users u ON u.id = s.creator_id
+WHERE s.repository_id = $1 AND s.sha1 = ANY($2)
+ORDER BY s.sha1, s.context, s.created_at DESC, s.id DESC;
+`, repositoryID, pq.Array(sha1s))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		st, err := scanStatus(rows)
+		if err != nil {
+			return nil, err
+		}
+		latest[st.Sha1] = append(latest[st.Sha1], st)
+	}
+
+	return latest, rows.Err()
+}
+
+// ListProtections of the repository's branches sorted by branch.
+func (s *Postgres) ListProtections(ctx context.Context, repositoryID string) ([]*Protection, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "status.Postgres.ListProtections")
+	span.SetTag("repository", repositoryID)
+	defer span.Finish()
+
+	list := `
+SELECT branch, context
+FROM branch_required_checks
+WHERE repository_id = $1
+ORDER BY branch, context;
+`
+
+	rows, err := s.db.QueryContext(ctx, list, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var protections []*Protection
+	for rows.Next() {
+		var branch, check string
+		if err := rows.Scan(&branch, &check); err != nil {
+			return nil, err
+		}
+		if len(protections) == 0 || protections[len(protections)-1].Branch != branch {
+			protections = append(protections, &Protection{Branch: branch})
+		}
+		p := protections[len(protections)-1]
+		p.RequiredChecks = append(p.RequiredChecks, check)
+	}
+
+	return protections, rows.Err()
+}
+
+// ProtectedBranches of the repository are the branches with required checks, sorted by name.
+func (s *Postgres) ProtectedBranches(ctx context.Context, repositoryID string) ([]string, error) {
+	protections, err := s.ListProtections(ctx, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	branches := make([]string, len(protections))
+	for i, p := range protections {
+		branches[i] = p.Branch
+	}
+	return branches, nil
+}
+
+// UpdateProtection replaces the required checks of a branch.
+func (s *Postgres) UpdateProtection(ctx context.Context, repositoryID string, p *Protection) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "status.Postgres.UpdateProtection")
+	span.SetTag("repository", repositoryID)
+	span.SetTag("branch", p.Branch)
+	defer span.Finish()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM branch_required_checks WHERE repository_id = $1 AND branch = $2;`, repositoryID, p.Branch)
+	if err != nil {
+		return err
+	}
+
+	for _, check := range p.RequiredChecks {
+		_, err := tx.ExecContext(ctx, `INSERT INTO branch_required_checks (repository_id, branch, context) VALUES ($1, $2, $3);`,
+			repositoryID, p.Branch, check,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
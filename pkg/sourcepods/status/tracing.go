@@ -0,0 +1,110 @@
+package status
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+//TracingRequestID returns the request ID as string for tracing
+type TracingRequestID func(context.Context) string
+
+type tracingService struct {
+	service   Service
+	requestID TracingRequestID
+}
+
+// NewTracingService wraps the Service and provides tracing for its methods.
+func NewTracingService(s Service, requestID TracingRequestID) Service {
+	return &tracingService{service: s, requestID: requestID}
+}
+
+func (s *tracingService) Create(ctx context.Context, owner, name, rev string, st *Status) (*Status, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "status.Service.Create")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("rev", rev)
+	span.SetTag("context", st.Context)
+	span.SetTag("state", st.State)
+	defer span.Finish()
+
+	return s.service.Create(ctx, owner, name, rev, st)
+}
+
+func (s *tracingService) List(ctx context.Context, owner, name, rev string) ([]*Status, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "status.Service.List")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("rev", rev)
+	defer span.Finish()
+
+	return s.service.List(ctx, owner, name, rev)
+}
+
+func (s *tracingService) Combined(ctx context.Context, owner, name, rev string) (*Combined, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "status.Service.Combined")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("rev", rev)
+	defer span.Finish()
+
+	return s.service.Combined(ctx, owner, name, rev)
+}
+
+func (s *tracingService) Summaries(ctx context.Context, owner, name string, sha1s []string) (map[string]*Combined, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "status.Service.Summaries")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("commits", len(sha1s))
+	defer span.Finish()
+
+	return s.service.Summaries(ctx, owner, name, sha1s)
+}
+
+func (s *tracingService) Protections(ctx context.Context, owner, name string) ([]*Protection, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "status.Service.Protections")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	return s.service.Protections(ctx, owner, name)
+}
+
+func (s *tracingService) Protection(ctx context.Context, owner, name, branch string) (*Protection, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "status.Service.Protection")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("branch", branch)
+	defer span.Finish()
+
+	return s.service.Protection(ctx, owner, name, branch)
+}
+
+func (s *tracingService) UpdateProtection(ctx context.Context, owner, name string, p *Protection) (*Protection, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "status.Service.UpdateProtection")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("branch", p.Branch)
+	defer span.Finish()
+
+	return s.service.UpdateProtection(ctx, owner, name, p)
+}
+
+func (s *tracingService) Unsatisfied(ctx context.Context, owner, name, branch, sha1 string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "status.Service.Unsatisfied")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("owner", owner)
+	span.SetTag("name", name)
+	span.SetTag("branch", branch)
+	span.SetTag("sha1", sha1)
+	defer span.Finish()
+
+	return s.service.Unsatisfied(ctx, owner, name, branch, sha1)
+}
@@ -0,0 +1,103 @@
+package status
+
+import (
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+)
+
+type (
+	//ValidationErrors are returned with a slice of all invalid fields
+	ValidationErrors struct {
+		Errors []ValidationError
+	}
+	//ValidationError knows for a given field the error
+	ValidationError struct {
+		Field string
+		Error error
+	}
+)
+
+func (e ValidationErrors) Error() string {
+	return fmt.Sprintf("there are %d validation errors", len(e.Errors))
+}
+
+const (
+	contextMaxLength     = 255
+	descriptionMaxLength = 140
+)
+
+// ValidateCreate takes a Status and validates its fields.
+func ValidateCreate(s *Status) error {
+	var errs ValidationErrors
+
+	switch s.State {
+	case StatePending, StateSuccess, StateFailure, StateError:
+	default:
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "state",
+			Error: fmt.Errorf("state must be one of pending, success, failure or error"),
+		})
+	}
+
+	if err := validateContext(s.Context); err != nil {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "context",
+			Error: err,
+		})
+	}
+
+	if s.TargetURL != "" {
+		u, err := url.Parse(s.TargetURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs.Errors = append(errs.Errors, ValidationError{
+				Field: "target_url",
+				Error: fmt.Errorf("target_url is not a http or https URL"),
+			})
+		}
+	}
+
+	if utf8.RuneCountInString(s.Description) > descriptionMaxLength {
+		errs.Errors = append(errs.Errors, ValidationError{
+			Field: "description",
+			Error: fmt.Errorf("description is longer than %d characters", descriptionMaxLength),
+		})
+	}
+
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ValidateProtection takes a Protection and validates its required checks.
+func ValidateProtection(p *Protection) error {
+	var errs ValidationErrors
+
+	for _, check := range p.RequiredChecks {
+		if err := validateContext(check); err != nil {
+			errs.Errors = append(errs.Errors, ValidationError{
+				Field: "required_checks",
+				Error: err,
+			})
+			break
+		}
+	}
+
+	if len(errs.Errors) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+func validateContext(context string) error {
+	if context == "" {
+		return fmt.Errorf("context is required")
+	}
+	if utf8.RuneCountInString(context) > contextMaxLength {
+		return fmt.Errorf("context is longer than %d characters", contextMaxLength)
+	}
+	return nil
+}
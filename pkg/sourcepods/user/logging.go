@@ -6,6 +6,7 @@ import (
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
 )
 
 //LoggingRequestID returns the request ID as string for logging
@@ -22,24 +23,26 @@ func NewLoggingService(s Service, requestID LoggingRequestID, logger log.Logger)
 	return &loggingService{service: s, requestID: requestID, logger: logger}
 }
 
-func (s *loggingService) FindAll(ctx context.Context) ([]*User, error) {
+func (s *loggingService) FindAll(ctx context.Context, opts ListOptions) ([]*User, string, error) {
 	start := time.Now()
 
-	users, err := s.service.FindAll(ctx)
+	users, next, err := s.service.FindAll(ctx, opts)
 
 	logger := log.With(s.logger,
 		"request", s.requestID(ctx),
 		"method", "FindAll",
 		"duration", time.Since(start),
+		"sort", opts.Sort,
+		"cursor", opts.Page.Cursor,
 	)
 
-	if err != nil {
+	if err != nil && err != pagination.ErrCursorInvalid {
 		level.Warn(logger).Log("msg", "failed to find all users", "err", err)
 	} else {
 		level.Debug(logger).Log()
 	}
 
-	return users, err
+	return users, next, err
 }
 
 func (s *loggingService) Find(context.Context, string) (*User, error) {
@@ -71,6 +74,28 @@ func (s *loggingService) FindRepositoryOwner(ctx context.Context, repositoryID s
 	panic("implement me")
 }
 
+func (s *loggingService) Search(ctx context.Context, opts SearchOptions) ([]*User, string, error) {
+	start := time.Now()
+
+	users, next, err := s.service.Search(ctx, opts)
+
+	logger := log.With(s.logger,
+		"request", s.requestID(ctx),
+		"method", "Search",
+		"duration", time.Since(start),
+		"query", opts.Query,
+		"cursor", opts.Page.Cursor,
+	)
+
+	if err != nil && err != ErrSearchQueryInvalid && err != pagination.ErrCursorInvalid {
+		level.Warn(logger).Log("msg", "failed to search users", "err", err)
+	} else {
+		level.Debug(logger).Log()
+	}
+
+	return users, next, err
+}
+
 func (s *loggingService) Create(ctx context.Context, user *User) (*User, error) {
 	start := time.Now()
 
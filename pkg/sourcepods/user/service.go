@@ -3,19 +3,23 @@ package user
 import (
 	"context"
 	"errors"
+	"strings"
 )
 
 var (
 	//ErrNotFound is returned when a user was not found
 	ErrNotFound = errors.New("user not found")
+	//ErrSearchQueryInvalid is returned when searching with an empty query
+	ErrSearchQueryInvalid = errors.New("search query is invalid")
 )
 
 // Service handles all interactions with users.
 type Service interface {
-	FindAll(context.Context) ([]*User, error)
+	FindAll(context.Context, ListOptions) ([]*User, string, error)
 	Find(context.Context, string) (*User, error)
 	FindByUsername(context.Context, string) (*User, error)
 	FindRepositoryOwner(ctx context.Context, repositoryID string) (*User, error)
+	Search(context.Context, SearchOptions) ([]*User, string, error)
 	Create(context.Context, *User) (*User, error)
 	Update(context.Context, *User) (*User, error)
 	Delete(context.Context, string) error
@@ -23,10 +27,11 @@ type Service interface {
 
 // Store users after manipulation or read them.
 type Store interface {
-	FindAll(context.Context) ([]*User, error)
+	FindAll(context.Context, ListOptions) ([]*User, string, error)
 	Find(context.Context, string) (*User, error)
 	FindByUsername(context.Context, string) (*User, error)
 	FindRepositoryOwner(ctx context.Context, repositoryID string) (*User, error)
+	Search(context.Context, SearchOptions) ([]*User, string, error)
 	Create(context.Context, *User) (*User, error)
 	Update(context.Context, *User) (*User, error)
 	Delete(context.Context, string) error
@@ -41,8 +46,11 @@ func NewService(users Store) Service {
 	return &service{users: users}
 }
 
-func (s *service) FindAll(ctx context.Context) ([]*User, error) {
-	return s.users.FindAll(ctx)
+func (s *service) FindAll(ctx context.Context, opts ListOptions) ([]*User, string, error) {
+	if opts.Sort != SortUpdated {
+		opts.Sort = SortName
+	}
+	return s.users.FindAll(ctx, opts)
 }
 
 func (s *service) Find(ctx context.Context, id string) (*User, error) {
@@ -57,6 +65,18 @@ func (s *service) FindRepositoryOwner(ctx context.Context, repositoryID string)
 	return s.users.FindRepositoryOwner(ctx, repositoryID)
 }
 
+func (s *service) Search(ctx context.Context, opts SearchOptions) ([]*User, string, error) {
+	opts.Query = strings.TrimSpace(opts.Query)
+	if opts.Query == "" {
+		return nil, "", ErrSearchQueryInvalid
+	}
+	if opts.Sort != SortName && opts.Sort != SortUpdated {
+		opts.Sort = ""
+	}
+
+	return s.users.Search(ctx, opts)
+}
+
 func (s *service) Create(ctx context.Context, user *User) (*User, error) {
 	panic("implement me")
 }
@@ -2,9 +2,11 @@ package user
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -30,8 +32,8 @@ var testUsers = []*User{{
 	Updated:  time.Now(),
 }}
 
-func (s *store) FindAll(ctx context.Context) ([]*User, error) {
-	return s.users, nil
+func (s *store) FindAll(ctx context.Context, opts ListOptions) ([]*User, string, error) {
+	return s.users, "", nil
 }
 
 func (s *store) Find(ctx context.Context, id string) (*User, error) {
@@ -56,6 +58,22 @@ func (s *store) FindRepositoryOwner(ctx context.Context, repositoryID string) (*
 	panic("implement me")
 }
 
+func (s *store) Search(ctx context.Context, opts SearchOptions) ([]*User, string, error) {
+	var users []*User
+	for _, u := range s.users {
+		if strings.Contains(u.Username, opts.Query) && (opts.Sort+u.Username) > opts.Page.Cursor {
+			users = append(users, u)
+		}
+	}
+
+	limit := opts.Page.Limit()
+	if len(users) <= limit {
+		return users, "", nil
+	}
+	users = users[:limit]
+	return users, opts.Sort + users[limit-1].Username, nil
+}
+
 func (s *store) Create(context.Context, *User) (*User, error) {
 	panic("implement me")
 }
@@ -72,7 +90,7 @@ func (s *store) Delete(context.Context, string) error {
 func TestService_FindAll(t *testing.T) {
 	service := NewService(&store{testUsers})
 
-	users, err := service.FindAll(context.Background())
+	users, _, err := service.FindAll(context.Background(), ListOptions{})
 	assert.NoError(t, err)
 	assert.Len(t, users, 2)
 	assert.Equal(t, "user1", users[0].Username)
@@ -110,6 +128,29 @@ func TestService_FindByUsername(t *testing.T) {
 	assert.Equal(t, ErrNotFound, err)
 }
 
+func TestService_Search(t *testing.T) {
+	service := NewService(&store{testUsers})
+
+	users, next, err := service.Search(context.Background(), SearchOptions{Query: " user "})
+	assert.NoError(t, err)
+	assert.Len(t, users, 2)
+	assert.Empty(t, next)
+
+	users, next, err = service.Search(context.Background(), SearchOptions{Query: "user", Sort: "unknown", Page: pagination.Options{PerPage: 1}})
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, "user1", next, "unknown sorts find the best matches first")
+
+	users, next, err = service.Search(context.Background(), SearchOptions{Query: "user", Page: pagination.Options{Cursor: next, PerPage: 1}})
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, "user2", users[0].Username)
+	assert.Empty(t, next)
+
+	_, _, err = service.Search(context.Background(), SearchOptions{Query: "  "})
+	assert.Equal(t, ErrSearchQueryInvalid, err)
+}
+
 func TestService_Update(t *testing.T) {
 	service := NewService(&store{testUsers})
 
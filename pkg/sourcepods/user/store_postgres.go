@@ -4,9 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/opentracing/opentracing-go"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/sqlutil"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -20,12 +25,45 @@ func NewPostgresStore(db *sql.DB) *Postgres {
 	return &Postgres{db: db}
 }
 
-// FindAll users.
-func (s *Postgres) FindAll(ctx context.Context) ([]*User, error) {
+// FindAll users page by page, it returns the cursor of the next page if there's one.
+func (s *Postgres) FindAll(ctx context.Context, opts ListOptions) ([]*User, string, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "user.Postgres.FindAll")
+	span.SetTag("sort", opts.Sort)
+	span.SetTag("cursor", opts.Page.Cursor)
 	defer span.Finish()
 
-	findAll := `
+	var where string
+	var args []interface{}
+
+	order := "name ASC, id ASC"
+	if opts.Sort == SortUpdated {
+		order = "updated_at DESC, id DESC"
+	}
+
+	if opts.Page.Cursor != "" {
+		keys, err := pagination.DecodeCursor(opts.Page.Cursor, 3)
+		if err != nil || keys[0] != opts.Sort {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+
+		switch opts.Sort {
+		case SortUpdated:
+			updated, err := time.Parse(time.RFC3339Nano, keys[1])
+			if err != nil {
+				return nil, "", pagination.ErrCursorInvalid
+			}
+			args = append(args, updated, keys[2])
+			where = "WHERE updated_at < $1 OR (updated_at = $1 AND id < $2)"
+		default:
+			args = append(args, keys[1], keys[2])
+			where = "WHERE name > $1 OR (name = $1 AND id > $2)"
+		}
+	}
+
+	limit := opts.Page.Limit()
+	args = append(args, limit+1)
+
+	findAll := fmt.Sprintf(`
 SELECT
 	id,
 	email,
@@ -34,36 +72,39 @@ SELECT
 	created_at,
 	updated_at
 FROM users
-ORDER BY name ASC;
-`
+%s
+ORDER BY %s
+LIMIT $%d;
+`, where, order, len(args))
 
-	rows, err := s.db.QueryContext(ctx, findAll)
+	rows, err := s.db.QueryContext(ctx, findAll, args...)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer rows.Close()
 
 	var users []*User
 	for rows.Next() {
-		var id string
-		var email string
-		var username string
-		var name string
-		var created time.Time
-		var updated time.Time
-		rows.Scan(&id, &email, &username, &name, &created, &updated)
-
-		users = append(users, &User{
-			ID:       id,
-			Email:    email,
-			Username: username,
-			Name:     name,
-			Created:  created,
-			Updated:  updated,
-		})
+		u := &User{}
+		if err := rows.Scan(&u.ID, &u.Email, &u.Username, &u.Name, &u.Created, &u.Updated); err != nil {
+			return nil, "", err
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
 	}
 
-	return users, nil
+	if len(users) <= limit {
+		return users, "", nil
+	}
+
+	users = users[:limit]
+	last := users[limit-1]
+	if opts.Sort == SortUpdated {
+		return users, pagination.EncodeCursor(opts.Sort, last.Updated.Format(time.RFC3339Nano), last.ID), nil
+	}
+	return users, pagination.EncodeCursor(opts.Sort, last.Name, last.ID), nil
 }
 
 // Find a user by its ID.
@@ -240,6 +281,115 @@ WHERE id = (SELECT owner_id
 	}, nil
 }
 
+// userDocument is the full text search document of users, like the users_search_idx.
+const userDocument = "to_tsvector('simple', username || ' ' || name)"
+
+// Search users whose username or name contain words starting with all words of the query page by page,
+// it returns the cursor of the next page if there's one.
+// The users matching the query best come first unless sorted otherwise.
+func (s *Postgres) Search(ctx context.Context, opts SearchOptions) ([]*User, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "user.Postgres.Search")
+	span.SetTag("query", opts.Query)
+	span.SetTag("sort", opts.Sort)
+	span.SetTag("cursor", opts.Page.Cursor)
+	defer span.Finish()
+
+	args := []interface{}{sqlutil.SearchPrefixes(sqlutil.SearchWords(opts.Query))}
+	match, rank := sqlutil.TextSearch(userDocument, 1)
+	where := []string{match}
+
+	var order string
+	switch opts.Sort {
+	case SortName:
+		order = "name ASC, id ASC"
+	case SortUpdated:
+		order = "updated_at DESC, id DESC"
+	default:
+		order = rank + " DESC, id ASC"
+	}
+
+	if opts.Page.Cursor != "" {
+		keys, err := pagination.DecodeCursor(opts.Page.Cursor, 3)
+		if err != nil || keys[0] != opts.Sort {
+			return nil, "", pagination.ErrCursorInvalid
+		}
+
+		switch opts.Sort {
+		case SortName:
+			args = append(args, keys[1], keys[2])
+			where = append(where, fmt.Sprintf("(name > $%d OR (name = $%d AND id > $%d))", len(args)-1, len(args)-1, len(args)))
+		case SortUpdated:
+			updated, err := time.Parse(time.RFC3339Nano, keys[1])
+			if err != nil {
+				return nil, "", pagination.ErrCursorInvalid
+			}
+			args = append(args, updated, keys[2])
+			where = append(where, fmt.Sprintf("(updated_at < $%d OR (updated_at = $%d AND id < $%d))", len(args)-1, len(args)-1, len(args)))
+		default:
+			last, err := strconv.ParseFloat(keys[1], 32)
+			if err != nil {
+				return nil, "", pagination.ErrCursorInvalid
+			}
+			args = append(args, float32(last), keys[2])
+			where = append(where, fmt.Sprintf("(%s < $%d OR (%s = $%d AND id > $%d))", rank, len(args)-1, rank, len(args)-1, len(args)))
+		}
+	}
+
+	limit := opts.Page.Limit()
+	args = append(args, limit+1)
+
+	search := fmt.Sprintf(`
+SELECT
+	id,
+	email,
+	username,
+	name,
+	created_at,
+	updated_at,
+	%s
+FROM users
+WHERE %s
+ORDER BY %s
+LIMIT $%d;
+`, rank, strings.Join(where, " AND "), order, len(args))
+
+	rows, err := s.db.QueryContext(ctx, search, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var users []*User
+	var ranks []float32
+	for rows.Next() {
+		u := &User{}
+		var rank float32
+		if err := rows.Scan(&u.ID, &u.Email, &u.Username, &u.Name, &u.Created, &u.Updated, &rank); err != nil {
+			return nil, "", err
+		}
+		users = append(users, u)
+		ranks = append(ranks, rank)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(users) <= limit {
+		return users, "", nil
+	}
+
+	users = users[:limit]
+	last := users[limit-1]
+	switch opts.Sort {
+	case SortName:
+		return users, pagination.EncodeCursor(opts.Sort, last.Name, last.ID), nil
+	case SortUpdated:
+		return users, pagination.EncodeCursor(opts.Sort, last.Updated.Format(time.RFC3339Nano), last.ID), nil
+	default:
+		return users, pagination.EncodeCursor(opts.Sort, strconv.FormatFloat(float64(ranks[limit-1]), 'g', -1, 32), last.ID), nil
+	}
+}
+
 // Create a user in postgres and return it with the ID set in the store.
 func (s *Postgres) Create(ctx context.Context, u *User) (*User, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "user.Postgres.Create")
@@ -19,12 +19,14 @@ func NewTracingService(s Service, requestID TracingRequestID) Service {
 	return &tracingService{s, requestID}
 }
 
-func (s *tracingService) FindAll(ctx context.Context) ([]*User, error) {
+func (s *tracingService) FindAll(ctx context.Context, opts ListOptions) ([]*User, string, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "user.Service.FindAll")
 	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("sort", opts.Sort)
+	span.SetTag("cursor", opts.Page.Cursor)
 	defer span.Finish()
 
-	return s.service.FindAll(ctx)
+	return s.service.FindAll(ctx, opts)
 }
 
 func (s *tracingService) Find(ctx context.Context, id string) (*User, error) {
@@ -54,6 +56,16 @@ func (s *tracingService) FindRepositoryOwner(ctx context.Context, repositoryID s
 	return s.service.FindRepositoryOwner(ctx, repositoryID)
 }
 
+func (s *tracingService) Search(ctx context.Context, opts SearchOptions) ([]*User, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "user.Service.Search")
+	span.SetTag("request", s.requestID(ctx))
+	span.SetTag("query", opts.Query)
+	span.SetTag("cursor", opts.Page.Cursor)
+	defer span.Finish()
+
+	return s.service.Search(ctx, opts)
+}
+
 func (s *tracingService) Create(ctx context.Context, user *User) (*User, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "user.Service.Create")
 	span.SetTag("request", s.requestID(ctx))
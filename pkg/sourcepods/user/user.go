@@ -1,6 +1,10 @@
 package user
 
-import "time"
+import (
+	"time"
+
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/pagination"
+)
 
 // User of SourcePods
 type User struct {
@@ -12,3 +16,27 @@ type User struct {
 	Created  time.Time
 	Updated  time.Time
 }
+
+// Sort orders for searching and listing users.
+const (
+	// SortName sorts users by name.
+	SortName = "name"
+	// SortUpdated sorts users by their last update, most recent first.
+	SortUpdated = "updated"
+)
+
+// SearchOptions for searching users by their username and name.
+type SearchOptions struct {
+	Query string
+	// Sort is SortName or SortUpdated,
+	// by default the users matching the query best come first.
+	Sort string
+	Page pagination.Options
+}
+
+// ListOptions sort and page the list of all users.
+type ListOptions struct {
+	// Sort is SortName (default) or SortUpdated.
+	Sort string
+	Page pagination.Options
+}
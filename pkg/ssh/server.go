@@ -12,17 +12,24 @@ import (
 	"github.com/gliderlabs/ssh"
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
+	"github.com/sourcepods/sourcepods/pkg/sourcepods/repository"
 	"github.com/sourcepods/sourcepods/pkg/storage"
 )
 
+// Repositories finds the repositories to serve, like the repository.Service
+// only repositories visible to the context's user are found.
+type Repositories interface {
+	Find(ctx context.Context, owner, name string) (*repository.Repository, string, error)
+}
+
 // NewServer returns a *grpc.Server serving SSH
 //  is no `hostKeyPath` is given, random hostkeys will be generated...
-func NewServer(addr, hostKeyPath string, logger log.Logger, cli *storage.Client) *ssh.Server {
+func NewServer(addr, hostKeyPath string, logger log.Logger, cli *storage.Client, repositories Repositories) *ssh.Server {
 	s := &ssh.Server{
 		Addr: addr,
 		Handler: tracingHandler(
 			logHandler(
-				mainHandler(cli),
+				mainHandler(cli, repositories),
 				logger,
 			),
 		),
@@ -44,7 +51,7 @@ func NewServer(addr, hostKeyPath string, logger log.Logger, cli *storage.Client)
 	return s
 }
 
-func mainHandler(cli *storage.Client) ssh.Handler {
+func mainHandler(cli *storage.Client, repositories Repositories) ssh.Handler {
 	return func(s ssh.Session) {
 		cmd := s.Command()
 		if len(cmd) < 1 {
@@ -53,7 +60,12 @@ func mainHandler(cli *storage.Client) ssh.Handler {
 		}
 		switch cmd[0] {
 		case "git", "git-upload-pack", "git-receive-pack":
-			storageHandler(cli, s)
+			storageHandler(cli, repositories, s)
+		case "git-lfs-authenticate":
+			// Public keys aren't checked against the users' keys yet,
+			// so the session's user can't be trusted to sign Git LFS tokens for.
+			fmt.Fprintf(s.Stderr(), "git lfs over ssh is not available, use https\n")
+			s.Exit(1)
 		default:
 			fmt.Fprintf(s, "unknown command given\n")
 			s.Exit(1)
@@ -71,47 +83,79 @@ func reformatWindowsCommand(command []string) []string {
 	return command
 }
 
-func storageHandler(cli *storage.Client, s ssh.Session) {
+// storageHandler serves "git-upload-pack owner/name.git" from the storage.
+// Public keys aren't checked against the users' keys yet, so sessions don't have a user:
+// only public repositories can be fetched and pushing isn't available.
+func storageHandler(cli *storage.Client, repositories Repositories, s ssh.Session) {
 	ctx := s.Context().Value("span-ctx").(context.Context)
 	span, ctx := opentracing.StartSpanFromContext(ctx, "ssh.Handler.Storage")
 	defer span.Finish()
 
 	command := reformatWindowsCommand(s.Command())
+	if len(command) != 2 {
+		fmt.Fprintf(s.Stderr(), "usage: %s <repository>\n", command[0])
+		s.Exit(1)
+		return
+	}
 
-	id := command[1]
-
-	span.SetTag("repo_path", id)
-
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+	span.SetTag("repo_path", command[1])
 
 	switch command[0] {
 	case "git-upload-pack":
-		ec, err := cli.UploadPack(ctx, id, s, s, s.Stderr())
-		if err != nil {
-			logger := s.Context().Value("logger").(log.Logger)
-			level.Error(logger).Log(
-				"msg", "upload-pack failed",
-				"err", err.Error(),
-			)
-			s.Exit(1)
-		}
-		s.Exit(int(ec))
 	case "git-receive-pack":
-		ec, err := cli.ReceivePack(ctx, id, s, s, s.Stderr())
-		if err != nil {
+		fmt.Fprintf(s.Stderr(), "pushing over ssh is not available, use https\n")
+		s.Exit(1)
+		return
+	default:
+		fmt.Fprintf(s, "unknown command given\n")
+		s.Exit(1)
+		return
+	}
+
+	owner, name, ok := splitRepositoryPath(command[1])
+	if !ok {
+		fmt.Fprintf(s.Stderr(), "repository not found\n")
+		s.Exit(1)
+		return
+	}
+
+	r, _, err := repositories.Find(ctx, owner, name)
+	if err != nil {
+		if err != repository.ErrRepositoryNotFound {
 			logger := s.Context().Value("logger").(log.Logger)
 			level.Error(logger).Log(
-				"msg", "recieve-pack failed",
+				"msg", "failed to find repository",
 				"err", err.Error(),
 			)
-			s.Exit(1)
 		}
-		s.Exit(int(ec))
-	default:
-		fmt.Fprintf(s, "unknown command given\n")
+		fmt.Fprintf(s.Stderr(), "repository not found\n")
 		s.Exit(1)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ec, err := cli.UploadPack(ctx, r.ID, s, s, s.Stderr())
+	if err != nil {
+		logger := s.Context().Value("logger").(log.Logger)
+		level.Error(logger).Log(
+			"msg", "upload-pack failed",
+			"err", err.Error(),
+		)
+		s.Exit(1)
+	}
+	s.Exit(int(ec))
+}
+
+// splitRepositoryPath splits paths like /owner/name.git into the owner and name.
+func splitRepositoryPath(path string) (string, string, bool) {
+	path = strings.TrimSuffix(strings.TrimPrefix(path, "/"), ".git")
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
 	}
+	return parts[0], parts[1], true
 }
 
 func loadHostKeys(dir string) ([]ssh.Option, error) {
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+// Formats supported by Archive.
+const (
+	ArchiveTar   = "tar"
+	ArchiveTarGz = "tar.gz"
+	ArchiveZip   = "zip"
+)
+
+// archiveCacheDir inside the repository holds already generated archives.
+const archiveCacheDir = "sourcepods-archives"
+
+// Archive writes an archive of the tree of the commit rev resolves to.
+// Archives are cached by commit, as the same commit always results in the same archive.
+func (r *LocalRepository) Archive(ctx context.Context, rev, format, prefix string, w io.Writer) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.Archive")
+	span.SetTag("rev", rev)
+	span.SetTag("format", format)
+	span.SetTag("prefix", prefix)
+	defer span.Finish()
+
+	switch format {
+	case ArchiveTar, ArchiveTarGz, ArchiveZip:
+	default:
+		return ErrArchiveFormatInvalid
+	}
+
+	sha1, err := r.revParse(ctx, rev+"^{commit}")
+	if err != nil {
+		return err
+	}
+	span.SetTag("sha1", sha1)
+
+	cache := r.archiveCachePath(sha1, format, prefix)
+
+	if f, err := os.Open(cache); err == nil {
+		defer f.Close()
+		span.SetTag("cached", true)
+		_, err := io.Copy(w, f)
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cache), 0755); err != nil {
+		return errors.Wrap(err, "failed to create archive cache")
+	}
+
+	// Write into a temporary file first, so that cancelled or failed archives never end up in the cache.
+	tmp, err := ioutil.TempFile(filepath.Dir(cache), "tmp-")
+	if err != nil {
+		return errors.Wrap(err, "failed to create archive cache file")
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	args := []string{"archive", "--format=" + format}
+	if prefix != "" {
+		args = append(args, "--prefix="+prefix)
+	}
+	args = append(args, sha1)
+
+	errBuf := &bytes.Buffer{}
+	cmd, err := command.New(ctx, r.path, r.git, args,
+		command.StdoutWriter(io.MultiWriter(w, tmp)),
+		command.StderrWriter(errBuf),
+	)
+	if err != nil {
+		injectError(span, err, "")
+		return err
+	}
+
+	if err := cmd.Wait(); err != nil {
+		injectError(span, err, errBuf.String())
+		return errors.Wrapf(err, "failed to archive: %s", errBuf.String())
+	}
+
+	// The archive has been written successfully, failing to cache it isn't an error.
+	if err := tmp.Close(); err == nil {
+		os.Rename(tmp.Name(), cache)
+	}
+
+	return nil
+}
+
+func (r *LocalRepository) archiveCachePath(sha1, format, prefix string) string {
+	name := sha1
+	if prefix != "" {
+		name = fmt.Sprintf("%s-%x", sha1, sha256.Sum256([]byte(prefix)))
+	}
+	return filepath.Join(r.path, archiveCacheDir, name+"."+format)
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalRepository_Archive(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	archive := &bytes.Buffer{}
+	require.NoError(t, r.Archive(ctx, "master", ArchiveTar, "foo-master/", archive))
+
+	var names []string
+	tr := tar.NewReader(bytes.NewReader(archive.Bytes()))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+	}
+	assert.Contains(t, names, "foo-master/README.md")
+
+	files, err := ioutil.ReadDir(filepath.Join(r.path, archiveCacheDir))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	// The cached archive is used for the same commit, even if requested by its sha1.
+	cached := &bytes.Buffer{}
+	require.NoError(t, r.Archive(ctx, sha1, ArchiveTar, "foo-master/", cached))
+	assert.Equal(t, archive.Bytes(), cached.Bytes())
+
+	assert.Equal(t, ErrArchiveFormatInvalid, r.Archive(ctx, "master", "rar", "", ioutil.Discard))
+	assert.Equal(t, ErrRevNotFound, r.Archive(ctx, "unknown", ArchiveZip, "", ioutil.Discard))
+
+	_, err = os.Stat(r.archiveCachePath(sha1, ArchiveZip, ""))
+	assert.True(t, os.IsNotExist(err))
+}
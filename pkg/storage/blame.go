@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+var (
+	// blameMaxSize is the biggest file in bytes Blame runs for.
+	blameMaxSize int64 = 1 << 20
+	// blameTimeout stops git blame on files with a long and complicated history.
+	blameTimeout = 30 * time.Second
+)
+
+// <sha1> SP <original line> SP <final line> [SP <lines in group>]
+var blameHeader = regexp.MustCompile(`^([0-9a-f]{40}) (\d+) (\d+)(?: (\d+))?$`)
+
+// BlameHunk is a range of consecutive lines last changed by the same commit.
+type BlameHunk struct {
+	Sha1    string
+	Summary string
+	Author  Signature
+	// Line is the first line of the hunk in the file, starting at 1.
+	Line  int
+	Lines []string
+}
+
+// blameCommit holds the commit information git blame only prints the first time a commit appears.
+type blameCommit struct {
+	summary    string
+	author     string
+	authorMail string
+	authorTime string
+	authorTz   string
+}
+
+// Blame calls fn for every hunk of the file at path, as of the commit rev resolves to, in order.
+func (r *LocalRepository) Blame(ctx context.Context, rev, path string, fn func(BlameHunk) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.Blame")
+	span.SetTag("rev", rev)
+	span.SetTag("path", path)
+	defer span.Finish()
+
+	sha1, err := r.revParse(ctx, rev+"^{commit}")
+	if err != nil {
+		return err
+	}
+
+	blob, err := r.Blob(ctx, sha1, path)
+	if err != nil {
+		return err
+	}
+	if blob.Size > blameMaxSize {
+		return ErrBlobTooLarge
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, blameTimeout)
+	defer cancel()
+
+	errBuf := &bytes.Buffer{}
+	cmd, err := command.New(ctx, r.path, r.git, []string{"blame", "--porcelain", sha1, "--", strings.Trim(path, "/")},
+		command.StdoutPipe,
+		command.StderrWriter(errBuf),
+	)
+	if err != nil {
+		injectError(span, err, "")
+		return err
+	}
+
+	err = parseBlame(cmd.Stdout(), fn)
+	if err != nil {
+		// Stop git, nobody is going to read the rest of the output.
+		cancel()
+	}
+
+	if werr := cmd.Wait(); werr != nil && err == nil {
+		injectError(span, werr, errBuf.String())
+		err = errors.Wrapf(werr, "failed to blame: %s", errBuf.String())
+	}
+
+	if ctx.Err() == context.DeadlineExceeded {
+		return ErrBlameTimeout
+	}
+
+	return err
+}
+
+// parseBlame parses the output of git blame --porcelain and groups lines into hunks.
+func parseBlame(r io.Reader, fn func(BlameHunk) error) error {
+	scanner := bufio.NewScanner(r)
+	// Lines can't be longer than the whole file.
+	scanner.Buffer(make([]byte, 64*1024), int(blameMaxSize)+1024)
+
+	commits := map[string]*blameCommit{}
+	var current *blameCommit
+	var hunk *BlameHunk
+	var sha1 string
+	var line int
+
+	flush := func() error {
+		if hunk == nil {
+			return nil
+		}
+		h := *hunk
+		hunk = nil
+		return fn(h)
+	}
+
+	for scanner.Scan() {
+		text := scanner.Text()
+
+		if strings.HasPrefix(text, "\t") {
+			if hunk != nil && (hunk.Sha1 != sha1 || hunk.Line+len(hunk.Lines) != line) {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+			if hunk == nil {
+				c := commits[sha1]
+				author, _ := parseSignature(fmt.Sprintf("%s %s %s %s", c.author, c.authorMail, c.authorTime, c.authorTz))
+				hunk = &BlameHunk{
+					Sha1:    sha1,
+					Summary: c.summary,
+					Author:  author,
+					Line:    line,
+				}
+			}
+			hunk.Lines = append(hunk.Lines, text[1:])
+			continue
+		}
+
+		if m := blameHeader.FindStringSubmatch(text); m != nil {
+			sha1 = m[1]
+			line, _ = strconv.Atoi(m[3])
+			current = commits[sha1]
+			if current == nil {
+				current = &blameCommit{}
+				commits[sha1] = current
+			}
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		kv := strings.SplitN(text, " ", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "summary":
+			current.summary = kv[1]
+		case "author":
+			current.author = kv[1]
+		case "author-mail":
+			current.authorMail = kv[1]
+		case "author-time":
+			current.authorTime = kv[1]
+		case "author-tz":
+			current.authorTz = kv[1]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	return flush()
+}
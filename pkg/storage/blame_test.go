@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalRepository_Blame(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	git := func(stdin string, args ...string) string {
+		cmd := exec.Command("/usr/bin/git", args...)
+		cmd.Dir = r.path
+		cmd.Stdin = strings.NewReader(stdin)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Bar Baz", "GIT_AUTHOR_EMAIL=bar@baz.com",
+			"GIT_COMMITTER_NAME=Bar Baz", "GIT_COMMITTER_EMAIL=bar@baz.com",
+			"GIT_AUTHOR_DATE=1505942997 -0700", "GIT_COMMITTER_DATE=1505942997 -0700",
+		)
+		out, err := cmd.Output()
+		require.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+
+	blob := git("# foo\n\nbar\nbaz\n", "hash-object", "-w", "--stdin")
+	tree := git("100644 blob "+blob+"\tREADME.md\n", "mktree")
+	third := git("", "commit-tree", tree, "-p", sha1, "-m", "third commit")
+	git("", "update-ref", "refs/heads/master", third)
+
+	var hunks []BlameHunk
+	err := r.Blame(ctx, "master", "README.md", func(h BlameHunk) error {
+		hunks = append(hunks, h)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, hunks, 2)
+
+	assert.Equal(t, 1, hunks[0].Line)
+	assert.Equal(t, []string{"# foo"}, hunks[0].Lines)
+	assert.Equal(t, "initial commit", hunks[0].Summary)
+	assert.Equal(t, "Foo Bar", hunks[0].Author.Name)
+	assert.Equal(t, "foo@bar.com", hunks[0].Author.Email)
+	assert.Equal(t, time.Unix(1505935797, 0).Unix(), hunks[0].Author.Date.Unix())
+
+	assert.Equal(t, third, hunks[1].Sha1)
+	assert.Equal(t, 2, hunks[1].Line)
+	assert.Equal(t, []string{"", "bar", "baz"}, hunks[1].Lines)
+	assert.Equal(t, "third commit", hunks[1].Summary)
+	assert.Equal(t, "Bar Baz", hunks[1].Author.Name)
+
+	noop := func(BlameHunk) error { return nil }
+	assert.Equal(t, ErrPathNotFound, r.Blame(ctx, "master", "LICENSE", noop))
+	assert.Equal(t, ErrRevNotFound, r.Blame(ctx, "unknown", "README.md", noop))
+
+	maxSize := blameMaxSize
+	blameMaxSize = 4
+	defer func() { blameMaxSize = maxSize }()
+	assert.Equal(t, ErrBlobTooLarge, r.Blame(ctx, "master", "README.md", noop))
+}
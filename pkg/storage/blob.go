@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+var objectSha1 = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// Blob is a file in a repository's tree
+type Blob struct {
+	Sha1 string
+	Mode string
+	Size int64
+}
+
+// Blob returns the blob at the path in the tree of the commit rev resolves to.
+func (r *LocalRepository) Blob(ctx context.Context, rev, path string) (Blob, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.Blob")
+	span.SetTag("rev", rev)
+	span.SetTag("path", path)
+	defer span.Finish()
+
+	sha1, err := r.revParse(ctx, rev+"^{commit}")
+	if err != nil {
+		return Blob{}, err
+	}
+
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return Blob{}, ErrPathNotFound
+	}
+
+	out, err := command.NewSimple(ctx, r.path, r.git, "ls-tree", "-l", "--full-tree", sha1, "--", path)
+	if err != nil {
+		injectError(span, err, out)
+		return Blob{}, errors.Wrap(err, "failed to run git ls-tree")
+	}
+
+	// <mode> SP <type> SP <object> SP+ <size> TAB <path>
+	line := strings.SplitN(strings.TrimSpace(out), "\t", 2)
+	if len(line) != 2 || line[1] != path {
+		return Blob{}, ErrPathNotFound
+	}
+
+	fields := strings.Fields(line[0])
+	if len(fields) != 4 || fields[1] != "blob" {
+		return Blob{}, ErrPathNotFound
+	}
+
+	size, err := strconv.ParseInt(fields[3], 10, 64)
+	if err != nil {
+		return Blob{}, errors.Wrap(err, "failed to parse blob size")
+	}
+
+	return Blob{
+		Sha1: fields[2],
+		Mode: fields[0],
+		Size: size,
+	}, nil
+}
+
+// ReadBlob writes the content of a blob to w, starting at offset.
+// Only limit bytes are written, unless limit is 0 and everything is written.
+func (r *LocalRepository) ReadBlob(ctx context.Context, sha1 string, offset, limit int64, w io.Writer) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.ReadBlob")
+	span.SetTag("sha1", sha1)
+	span.SetTag("offset", offset)
+	span.SetTag("limit", limit)
+	defer span.Finish()
+
+	if !objectSha1.MatchString(sha1) {
+		return ErrPathNotFound
+	}
+
+	// Stop git once we've read everything we need.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errBuf := &bytes.Buffer{}
+	cmd, err := command.New(ctx, r.path, r.git, []string{"cat-file", "blob", sha1},
+		command.StdoutPipe,
+		command.StderrWriter(errBuf),
+	)
+	if err != nil {
+		injectError(span, err, "")
+		return err
+	}
+
+	_, err = io.CopyN(ioutil.Discard, cmd.Stdout(), offset)
+	if err == nil {
+		if limit > 0 {
+			_, err = io.CopyN(w, cmd.Stdout(), limit)
+		} else {
+			_, err = io.Copy(w, cmd.Stdout())
+		}
+	}
+
+	if err == nil && limit > 0 {
+		// Everything needed has been read, stop git instead of waiting for it to write the rest.
+		cancel()
+		cmd.Wait()
+		return nil
+	}
+	if err != nil && err != io.EOF {
+		// Writing failed, most likely because the client went away.
+		cancel()
+		cmd.Wait()
+		return err
+	}
+
+	if err := cmd.Wait(); err != nil {
+		injectError(span, err, errBuf.String())
+		return ErrPathNotFound
+	}
+
+	return nil
+}
+
+// readBlobs reads the objects with one git cat-file --batch and calls fn with the index of each and its content.
+// Objects bigger than maxSize or that don't exist are skipped, fn is called with nil content.
+func (r *LocalRepository) readBlobs(ctx context.Context, objects []string, maxSize int64, fn func(i int, content []byte) error) error {
+	if len(objects) == 0 {
+		return nil
+	}
+
+	// Stop git if fn returns an error, before everything has been read.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errBuf := &bytes.Buffer{}
+	cmd, err := command.New(ctx, r.path, r.git, []string{"cat-file", "--batch"},
+		command.StdinWriter(strings.NewReader(strings.Join(objects, "\n")+"\n")),
+		command.StdoutPipe,
+		command.StderrWriter(errBuf),
+	)
+	if err != nil {
+		return err
+	}
+
+	fail := func(err error) error {
+		cancel()
+		cmd.Wait()
+		return err
+	}
+
+	reader := bufio.NewReader(cmd.Stdout())
+	for i, object := range objects {
+		// <sha1> SP <type> SP <size> LF <content> LF, or <object> SP missing LF
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return fail(errors.Wrapf(err, "failed to read %s: %s", object, errBuf.String()))
+		}
+		fields := strings.Fields(header)
+		if len(fields) != 3 {
+			if err := fn(i, nil); err != nil {
+				return fail(err)
+			}
+			continue
+		}
+		size, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			return fail(errors.Wrap(err, "failed to parse object size"))
+		}
+
+		var content []byte
+		if size > maxSize {
+			_, err = io.CopyN(ioutil.Discard, reader, size+1)
+		} else {
+			content = make([]byte, size+1)
+			_, err = io.ReadFull(reader, content)
+			content = content[:size]
+		}
+		if err != nil {
+			return fail(errors.Wrapf(err, "failed to read %s", object))
+		}
+
+		if err := fn(i, content); err != nil {
+			return fail(err)
+		}
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return errors.Wrapf(err, "failed to read objects: %s", errBuf.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalRepository_Blob(t *testing.T) {
+	r, _, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	b, err := r.Blob(ctx, "master", "/README.md")
+	require.NoError(t, err)
+	assert.Equal(t, "100644", b.Mode)
+	assert.Equal(t, int64(6), b.Size)
+	assert.Len(t, b.Sha1, 40)
+
+	_, err = r.Blob(ctx, "master", "LICENSE")
+	assert.Equal(t, ErrPathNotFound, err)
+
+	_, err = r.Blob(ctx, "unknown", "README.md")
+	assert.Equal(t, ErrRevNotFound, err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, r.ReadBlob(ctx, b.Sha1, 0, 0, buf))
+	assert.Equal(t, "# foo\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, r.ReadBlob(ctx, b.Sha1, 2, 3, buf))
+	assert.Equal(t, "foo", buf.String())
+
+	assert.Equal(t, ErrPathNotFound, r.ReadBlob(ctx, "0000000000000000000000000000000000000001", 0, 0, buf))
+	assert.Equal(t, ErrPathNotFound, r.ReadBlob(ctx, "--help", 0, 0, buf))
+}
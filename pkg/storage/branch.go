@@ -0,0 +1,212 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+const branchPrefix = "refs/heads/"
+
+// CreateBranch creates a new branch pointing to the commit the given rev resolves to
+func (r *LocalRepository) CreateBranch(ctx context.Context, name, rev string) (Branch, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.CreateBranch")
+	span.SetTag("name", name)
+	span.SetTag("rev", rev)
+	defer span.Finish()
+
+	if err := r.checkBranchName(ctx, name); err != nil {
+		injectError(span, err, "")
+		return Branch{}, err
+	}
+
+	sha1, err := r.revParse(ctx, rev+"^{commit}")
+	if err != nil {
+		injectError(span, err, "")
+		return Branch{}, err
+	}
+
+	if _, err := r.revParse(ctx, branchPrefix+name); err == nil {
+		return Branch{}, ErrBranchExists
+	}
+
+	unlock := r.lockPush()
+	defer unlock()
+
+	// create only succeeds if the ref doesn't exist, even if it was pushed in the meantime.
+	if err := r.updateRefs(ctx, fmt.Sprintf("create %s %s", branchPrefix+name, sha1)); err != nil {
+		injectError(span, err, "")
+		if err == ErrRefChanged {
+			return Branch{}, ErrBranchExists
+		}
+		return Branch{}, err
+	}
+	r.refsUpdated(RefUpdate{Ref: branchPrefix + name, New: sha1})
+
+	return Branch{Name: name, Sha1: sha1, Type: "commit"}, nil
+}
+
+// DeleteBranch deletes a branch if it still points to the given sha1.
+// If sha1 is empty the branch is deleted if it wasn't changed while deleting.
+func (r *LocalRepository) DeleteBranch(ctx context.Context, name, sha1 string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.DeleteBranch")
+	span.SetTag("name", name)
+	span.SetTag("sha1", sha1)
+	defer span.Finish()
+
+	current, err := r.revParse(ctx, branchPrefix+name)
+	if err != nil {
+		return ErrBranchNotFound
+	}
+	if sha1 == "" {
+		sha1 = current
+	}
+	if sha1 != current {
+		return ErrRefChanged
+	}
+
+	unlock := r.lockPush()
+	defer unlock()
+
+	if err := r.updateRefs(ctx, fmt.Sprintf("delete %s %s", branchPrefix+name, sha1)); err != nil {
+		injectError(span, err, "")
+		return err
+	}
+	r.refsUpdated(RefUpdate{Ref: branchPrefix + name, Old: sha1})
+
+	return nil
+}
+
+// RenameBranch moves a branch to a new name in one transaction.
+// HEAD is updated as well, if it pointed to the renamed branch.
+func (r *LocalRepository) RenameBranch(ctx context.Context, name, newName string) (Branch, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.RenameBranch")
+	span.SetTag("name", name)
+	span.SetTag("new_name", newName)
+	defer span.Finish()
+
+	if err := r.checkBranchName(ctx, newName); err != nil {
+		injectError(span, err, "")
+		return Branch{}, err
+	}
+
+	sha1, err := r.revParse(ctx, branchPrefix+name)
+	if err != nil {
+		return Branch{}, ErrBranchNotFound
+	}
+
+	if _, err := r.revParse(ctx, branchPrefix+newName); err == nil {
+		return Branch{}, ErrBranchExists
+	}
+
+	unlock := r.lockPush()
+	defer unlock()
+
+	if err := r.updateRefs(ctx,
+		fmt.Sprintf("create %s %s", branchPrefix+newName, sha1),
+		fmt.Sprintf("delete %s %s", branchPrefix+name, sha1),
+	); err != nil {
+		injectError(span, err, "")
+		return Branch{}, err
+	}
+
+	r.refsUpdated(
+		RefUpdate{Ref: branchPrefix + newName, New: sha1},
+		RefUpdate{Ref: branchPrefix + name, Old: sha1},
+	)
+
+	head, err := command.NewSimple(ctx, r.path, r.git, "symbolic-ref", "--quiet", "HEAD")
+	if err == nil && strings.TrimSpace(head) == branchPrefix+name {
+		out, err := command.NewSimple(ctx, r.path, r.git, "symbolic-ref", "HEAD", branchPrefix+newName)
+		if err != nil {
+			injectError(span, err, out)
+			return Branch{}, errors.Wrap(err, "failed to update HEAD")
+		}
+	}
+
+	return Branch{Name: newName, Sha1: sha1, Type: "commit"}, nil
+}
+
+// SetDefaultBranch points HEAD to the branch, which is checked out when cloning.
+func (r *LocalRepository) SetDefaultBranch(ctx context.Context, name string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.SetDefaultBranch")
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	if _, err := r.revParse(ctx, branchPrefix+name); err != nil {
+		return ErrBranchNotFound
+	}
+
+	out, err := command.NewSimple(ctx, r.path, r.git, "symbolic-ref", "HEAD", branchPrefix+name)
+	if err != nil {
+		injectError(span, err, out)
+		return errors.Wrap(err, "failed to update HEAD")
+	}
+
+	return nil
+}
+
+func (r *LocalRepository) checkBranchName(ctx context.Context, name string) error {
+	if name == "" || strings.HasPrefix(name, "-") {
+		return ErrBranchNameInvalid
+	}
+	if _, err := command.NewSimple(ctx, r.path, r.git, "check-ref-format", branchPrefix+name); err != nil {
+		return ErrBranchNameInvalid
+	}
+	return nil
+}
+
+// revParse returns the sha1 of the object the rev resolves to
+func (r *LocalRepository) revParse(ctx context.Context, rev string) (string, error) {
+	if rev == "" || strings.HasPrefix(rev, "-") {
+		return "", ErrRevNotFound
+	}
+
+	out, err := command.NewSimple(ctx, r.path, r.git, "rev-parse", "--verify", "--quiet", rev)
+	if err != nil {
+		return "", ErrRevNotFound
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+// updateRefs runs all instructions in a single transaction with git update-ref.
+// Old values given are compared before updating, so concurrent pushes aren't overwritten.
+func (r *LocalRepository) updateRefs(ctx context.Context, instructions ...string) error {
+	errBuf := &bytes.Buffer{}
+	stdin := strings.NewReader(strings.Join(instructions, "\n") + "\n")
+
+	cmd, err := command.New(ctx, r.path, r.git, []string{"update-ref", "--stdin"},
+		command.StdinWriter(stdin),
+		command.StderrWriter(errBuf),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to run git update-ref")
+	}
+
+	if err := cmd.Wait(); err != nil {
+		if strings.Contains(errBuf.String(), "cannot lock ref") {
+			return ErrRefChanged
+		}
+		return errors.Wrapf(err, "failed to update refs: %s", errBuf.String())
+	}
+
+	return nil
+}
+
+// refsUpdated lets the refs the storage updated itself be handled like pushed ones:
+// the post receive hook runs and the updates are published as PushEvent.
+func (r *LocalRepository) refsUpdated(updates ...RefUpdate) {
+	if r.postReceive != nil {
+		r.postReceive(r.id)
+	}
+	if r.events != nil {
+		r.events.Publish(PushEvent{ID: r.id, Refs: updates, Pushed: time.Now()})
+	}
+}
@@ -0,0 +1,235 @@
+package storage
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcepods/sourcepods/pkg/command"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRepository creates a bare repository with a single commit on master.
+func newTestRepository(t *testing.T) (*LocalRepository, string, func()) {
+	if _, err := os.Stat("/usr/bin/git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	root, err := ioutil.TempDir("", "sourcepods-storage")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	ls, err := NewLocalStorage(root)
+	require.NoError(t, err)
+	require.NoError(t, ls.Create(ctx, "foo-bar-baz"))
+
+	repo, err := ls.GetRepository(ctx, "foo-bar-baz")
+	require.NoError(t, err)
+	r := repo.(*LocalRepository)
+
+	date := "1505935797 -0700"
+	git := func(stdin string, args ...string) string {
+		cmd := exec.Command("/usr/bin/git", args...)
+		cmd.Dir = r.path
+		cmd.Stdin = strings.NewReader(stdin)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Foo Bar", "GIT_AUTHOR_EMAIL=foo@bar.com",
+			"GIT_COMMITTER_NAME=Foo Bar", "GIT_COMMITTER_EMAIL=foo@bar.com",
+			"GIT_AUTHOR_DATE="+date, "GIT_COMMITTER_DATE="+date,
+		)
+		out, err := cmd.Output()
+		require.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+
+	blob := git("# foo\n", "hash-object", "-w", "--stdin")
+	tree := git("100644 blob "+blob+"\tREADME.md\n", "mktree")
+	sha1 := git("", "commit-tree", tree, "-m", "initial commit")
+	date = "1505939397 -0700"
+	sha1 = git("", "commit-tree", tree, "-p", sha1, "-m", "second commit")
+	git("", "update-ref", "refs/heads/master", sha1)
+	git("", "symbolic-ref", "HEAD", "refs/heads/master")
+
+	return r, sha1, func() { os.RemoveAll(root) }
+}
+
+func TestLocalRepository_CreateBranch(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	b, err := r.CreateBranch(ctx, "feature", "master")
+	require.NoError(t, err)
+	assert.Equal(t, Branch{Name: "feature", Sha1: sha1, Type: "commit"}, b)
+
+	_, err = r.CreateBranch(ctx, "feature", "master")
+	assert.Equal(t, ErrBranchExists, err)
+
+	_, err = r.CreateBranch(ctx, "foo..bar", "master")
+	assert.Equal(t, ErrBranchNameInvalid, err)
+
+	_, err = r.CreateBranch(ctx, "-foo", "master")
+	assert.Equal(t, ErrBranchNameInvalid, err)
+
+	_, err = r.CreateBranch(ctx, "other", "doesnotexist")
+	assert.Equal(t, ErrRevNotFound, err)
+}
+
+func TestLocalRepository_DeleteBranch(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, err := r.CreateBranch(ctx, "feature", sha1)
+	require.NoError(t, err)
+
+	assert.Equal(t, ErrRefChanged, r.DeleteBranch(ctx, "feature", "0000000000000000000000000000000000000001"))
+	assert.NoError(t, r.DeleteBranch(ctx, "feature", sha1))
+	assert.Equal(t, ErrBranchNotFound, r.DeleteBranch(ctx, "feature", ""))
+}
+
+func TestLocalRepository_RenameBranch(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	b, err := r.RenameBranch(ctx, "master", "main")
+	require.NoError(t, err)
+	assert.Equal(t, Branch{Name: "main", Sha1: sha1, Type: "commit"}, b)
+
+	branches, err := r.ListBranches(ctx, ListBranchesOptions{})
+	require.NoError(t, err)
+	require.Len(t, branches, 1)
+	assert.Equal(t, "main", branches[0].Name)
+
+	head, err := r.revParse(ctx, "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, sha1, head)
+
+	_, err = r.RenameBranch(ctx, "master", "foo")
+	assert.Equal(t, ErrBranchNotFound, err)
+}
+
+func TestLocalRepository_SetDefaultBranch(t *testing.T) {
+	r, _, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, err := r.CreateBranch(ctx, "feature", "master^")
+	require.NoError(t, err)
+	require.NoError(t, r.SetDefaultBranch(ctx, "feature"))
+
+	head, err := command.NewSimple(ctx, r.path, r.git, "symbolic-ref", "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, "refs/heads/feature", strings.TrimSpace(head))
+
+	assert.Equal(t, ErrBranchNotFound, r.SetDefaultBranch(ctx, "foo"))
+}
+
+func TestLocalRepository_ListBranches(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, err := r.CreateBranch(ctx, "feature/old", sha1+"^")
+	require.NoError(t, err)
+	_, err = r.CreateBranch(ctx, "feature/new", sha1)
+	require.NoError(t, err)
+
+	branches, err := r.ListBranches(ctx, ListBranchesOptions{Base: "master"})
+	require.NoError(t, err)
+	require.Len(t, branches, 3)
+
+	assert.Equal(t, "feature/new", branches[0].Name)
+	assert.Equal(t, "second commit", branches[0].Subject)
+	assert.Equal(t, "Foo Bar", branches[0].Author.Name)
+	assert.Equal(t, "foo@bar.com", branches[0].Author.Email)
+	assert.Equal(t, 0, branches[0].Ahead)
+	assert.Equal(t, 0, branches[0].Behind)
+
+	assert.Equal(t, "feature/old", branches[1].Name)
+	assert.Equal(t, "initial commit", branches[1].Subject)
+	assert.Equal(t, 0, branches[1].Ahead)
+	assert.Equal(t, 1, branches[1].Behind)
+
+	assert.Equal(t, "master", branches[2].Name)
+
+	branches, err = r.ListBranches(ctx, ListBranchesOptions{Prefix: "feature/o"})
+	require.NoError(t, err)
+	require.Len(t, branches, 1)
+	assert.Equal(t, "feature/old", branches[0].Name)
+
+	branches, err = r.ListBranches(ctx, ListBranchesOptions{Sort: BranchSortCommitterDate})
+	require.NoError(t, err)
+	require.Len(t, branches, 3)
+	// Branches committed at the same time are sorted by name.
+	assert.Equal(t, "feature/new", branches[0].Name)
+	assert.Equal(t, "master", branches[1].Name)
+	assert.Equal(t, "feature/old", branches[2].Name)
+
+	committed := branches[0].Committed
+	branches, err = r.ListBranches(ctx, ListBranchesOptions{Sort: BranchSortCommitterDate, After: "feature/new", AfterCommitted: committed, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, branches, 1)
+	assert.Equal(t, "master", branches[0].Name)
+
+	branches, err = r.ListBranches(ctx, ListBranchesOptions{After: "feature/new", Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, branches, 1)
+	assert.Equal(t, "feature/old", branches[0].Name)
+
+	// The branch of the cursor might have been deleted in the meantime.
+	branches, err = r.ListBranches(ctx, ListBranchesOptions{After: "feature/p"})
+	require.NoError(t, err)
+	require.Len(t, branches, 1)
+	assert.Equal(t, "master", branches[0].Name)
+}
+
+func TestLocalRepository_BranchEvents(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	var received []string
+	r.postReceive = func(id string) { received = append(received, id) }
+	r.events = NewEvents()
+	pushes, unsubscribe := r.events.Subscribe()
+	defer unsubscribe()
+
+	_, err := r.CreateBranch(ctx, "feature", "master")
+	require.NoError(t, err)
+	e := <-pushes
+	assert.Equal(t, []RefUpdate{{Ref: "refs/heads/feature", New: sha1}}, e.Refs)
+
+	_, err = r.RenameBranch(ctx, "feature", "topic")
+	require.NoError(t, err)
+	e = <-pushes
+	assert.Equal(t, []RefUpdate{{Ref: "refs/heads/topic", New: sha1}, {Ref: "refs/heads/feature", Old: sha1}}, e.Refs)
+
+	require.NoError(t, r.DeleteBranch(ctx, "topic", ""))
+	e = <-pushes
+	assert.Equal(t, []RefUpdate{{Ref: "refs/heads/topic", Old: sha1}}, e.Refs)
+
+	assert.Equal(t, []string{r.id, r.id, r.id}, received)
+
+	// Branches aren't changed while the repository is maintained.
+	done, ok := r.locks.maintain(r.path)
+	require.True(t, ok)
+	created := make(chan struct{})
+	go func() {
+		r.CreateBranch(ctx, "later", "master")
+		close(created)
+	}()
+	select {
+	case <-created:
+		t.Fatal("branch was created during the maintenance")
+	case <-time.After(50 * time.Millisecond):
+	}
+	done()
+	<-created
+}
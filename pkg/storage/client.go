@@ -10,14 +10,46 @@ import (
 	"github.com/pkg/errors"
 	"gitlab.com/gitlab-org/gitaly/streamio"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 )
 
+// knownErrors are sent by the server with errorStatus and are returned as is by the client
+var knownErrors = []error{
+	ErrRevNotFound,
+	ErrBranchNotFound,
+	ErrBranchExists,
+	ErrBranchNameInvalid,
+	ErrRefChanged,
+	ErrPathNotFound,
+	ErrArchiveFormatInvalid,
+	ErrBlobTooLarge,
+	ErrBlameTimeout,
+	ErrSearchQueryInvalid,
+	ErrSearchTimeout,
+	ErrRepoNotValid,
+	ErrRepoExists,
+	ErrMergeStrategyInvalid,
+	ErrMergeConflict,
+	ErrNothingToMerge,
+	ErrSignatureInvalid,
+	ErrImportURLInvalid,
+	ErrImportTooLarge,
+	ErrImportTimeout,
+	ErrMirrorURLInvalid,
+	ErrMirrorDirectionInvalid,
+	ErrMirrorTimeout,
+	ErrMaintenanceBusy,
+}
+
 // Client holds the gRPC-connection to the storage-server
 type Client struct {
-	repos    RepositoryClient
-	branches BranchClient
-	commits  CommitClient
-	ssh      SSHClient
+	repos       RepositoryClient
+	branches    BranchClient
+	index       IndexClient
+	commits     CommitClient
+	ssh         SSHClient
+	maintenance MaintenanceClient
+	events      EventsClient
 }
 
 // NewClient returns a new Storage client.
@@ -33,10 +65,13 @@ func NewClient(storageAddr string) (*Client, error) {
 	}
 
 	return &Client{
-		repos:    NewRepositoryClient(conn),
-		branches: NewBranchClient(conn),
-		commits:  NewCommitClient(conn),
-		ssh:      NewSSHClient(conn),
+		repos:       NewRepositoryClient(conn),
+		branches:    NewBranchClient(conn),
+		index:       NewIndexClient(conn),
+		commits:     NewCommitClient(conn),
+		ssh:         NewSSHClient(conn),
+		maintenance: NewMaintenanceClient(conn),
+		events:      NewEventsClient(conn),
 	}, nil
 }
 
@@ -50,6 +85,115 @@ func (c *Client) Create(ctx context.Context, id string) error {
 	return err
 }
 
+// Fork the repository sourceID into a new repository id
+func (c *Client) Fork(ctx context.Context, id, sourceID string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Fork")
+	span.SetTag("id", id)
+	span.SetTag("source", sourceID)
+	defer span.Finish()
+
+	_, err := c.repos.Fork(ctx, &ForkRequest{Id: id, SourceId: sourceID})
+	return statusError(err)
+}
+
+// Delete a repository, its forks have to be dissociated before
+func (c *Client) Delete(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Delete")
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	_, err := c.repos.Delete(ctx, &DeleteRequest{Id: id})
+	return statusError(err)
+}
+
+// FetchRef updates ref of the repository id to sourceRef of the repository sourceID and returns its sha1
+func (c *Client) FetchRef(ctx context.Context, id, sourceID, sourceRef, ref string) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.FetchRef")
+	span.SetTag("id", id)
+	span.SetTag("source", sourceID)
+	span.SetTag("source_ref", sourceRef)
+	span.SetTag("ref", ref)
+	defer span.Finish()
+
+	res, err := c.repos.FetchRef(ctx, &FetchRefRequest{
+		Id:        id,
+		SourceId:  sourceID,
+		SourceRef: sourceRef,
+		Ref:       ref,
+	})
+	if err != nil {
+		return "", statusError(err)
+	}
+
+	return res.GetSha1(), nil
+}
+
+// Dissociate a fork from the objects of the repository it was forked from
+func (c *Client) Dissociate(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Dissociate")
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	_, err := c.repos.Dissociate(ctx, &DissociateRequest{Id: id})
+	return statusError(err)
+}
+
+// Import fetches a repository from opts.URL into the empty repository id,
+// progress is called with every line of progress reported.
+func (c *Client) Import(ctx context.Context, id string, opts ImportOptions, progress func(string)) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Import")
+	span.SetTag("id", id)
+	span.SetTag("url", opts.URL)
+	defer span.Finish()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.repos.Import(ctx, &ImportRequest{
+		Id:             id,
+		Url:            opts.URL,
+		Username:       opts.Username,
+		Password:       opts.Password,
+		TimeoutSeconds: int64(opts.Timeout / time.Second),
+		MaxSize:        opts.MaxSize,
+	})
+	if err != nil {
+		return statusError(err)
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return statusError(err)
+		}
+		if progress != nil {
+			progress(res.GetProgress())
+		}
+	}
+}
+
+// Mirror the repository id with the remote at opts.URL in opts.Direction
+func (c *Client) Mirror(ctx context.Context, id string, opts MirrorOptions) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Mirror")
+	span.SetTag("id", id)
+	span.SetTag("direction", opts.Direction)
+	span.SetTag("url", opts.URL)
+	defer span.Finish()
+
+	_, err := c.repos.Mirror(ctx, &MirrorRequest{
+		Id:             id,
+		Direction:      opts.Direction,
+		Url:            opts.URL,
+		Username:       opts.Username,
+		Password:       opts.Password,
+		TimeoutSeconds: int64(opts.Timeout / time.Second),
+	})
+	return statusError(err)
+}
+
 // SetDescription of a repository
 func (c *Client) SetDescription(ctx context.Context, id, description string) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Description")
@@ -65,23 +209,172 @@ func (c *Client) SetDescription(ctx context.Context, id, description string) err
 }
 
 // Branches returns all branches of a repository
-func (c *Client) Branches(ctx context.Context, id string) ([]Branch, error) {
+func (c *Client) Branches(ctx context.Context, id string, opts ListBranchesOptions) ([]Branch, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Branches")
 	span.SetTag("id", id)
+	span.SetTag("prefix", opts.Prefix)
+	span.SetTag("sort", opts.Sort)
+	span.SetTag("base", opts.Base)
 	defer span.Finish()
 
-	res, err := c.branches.List(ctx, &BranchesRequest{Id: id})
+	req := &BranchesRequest{
+		Id:     id,
+		Prefix: opts.Prefix,
+		Sort:   opts.Sort,
+		Base:   opts.Base,
+		After:  opts.After,
+		Limit:  int32(opts.Limit),
+	}
+	if !opts.AfterCommitted.IsZero() {
+		req.AfterCommitted = opts.AfterCommitted.Unix()
+	}
+
+	res, err := c.branches.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
 
 	var branches []Branch
 	for _, b := range res.Branch {
-		branches = append(branches, Branch{
-			Name: b.Name,
-			Sha1: b.Sha1,
-			Type: b.Type,
-		})
+		branches = append(branches, branchFromResponse(b))
+	}
+
+	return branches, nil
+}
+
+func branchFromResponse(res *BranchResponse) Branch {
+	b := Branch{
+		Name:    res.GetName(),
+		Sha1:    res.GetSha1(),
+		Type:    res.GetType(),
+		Subject: res.GetSubject(),
+		Ahead:   int(res.GetAhead()),
+		Behind:  int(res.GetBehind()),
+	}
+	if res.GetCommitted() != 0 {
+		b.Committed = time.Unix(res.GetCommitted(), 0)
+	}
+	if res.GetAuthor() != "" {
+		b.Author = Signature{
+			Name:  res.GetAuthor(),
+			Email: res.GetAuthorEmail(),
+			Date:  time.Unix(res.GetAuthorDate(), 0),
+		}
+	}
+	return b
+}
+
+// CreateBranch in a repository pointing to the given rev
+func (c *Client) CreateBranch(ctx context.Context, id, name, rev string) (Branch, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.CreateBranch")
+	span.SetTag("id", id)
+	span.SetTag("name", name)
+	span.SetTag("rev", rev)
+	defer span.Finish()
+
+	res, err := c.branches.Create(ctx, &CreateBranchRequest{
+		Id:   id,
+		Name: name,
+		Rev:  rev,
+	})
+	if err != nil {
+		return Branch{}, statusError(err)
+	}
+
+	return branchFromResponse(res), nil
+}
+
+// DeleteBranch from a repository, if sha1 is given it needs to match the branch's current sha1
+func (c *Client) DeleteBranch(ctx context.Context, id, name, sha1 string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.DeleteBranch")
+	span.SetTag("id", id)
+	span.SetTag("name", name)
+	span.SetTag("sha1", sha1)
+	defer span.Finish()
+
+	_, err := c.branches.Delete(ctx, &DeleteBranchRequest{
+		Id:   id,
+		Name: name,
+		Sha1: sha1,
+	})
+	return statusError(err)
+}
+
+// RenameBranch of a repository to a new name
+func (c *Client) RenameBranch(ctx context.Context, id, name, newName string) (Branch, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.RenameBranch")
+	span.SetTag("id", id)
+	span.SetTag("name", name)
+	span.SetTag("new_name", newName)
+	defer span.Finish()
+
+	res, err := c.branches.Rename(ctx, &RenameBranchRequest{
+		Id:      id,
+		Name:    name,
+		NewName: newName,
+	})
+	if err != nil {
+		return Branch{}, statusError(err)
+	}
+
+	return branchFromResponse(res), nil
+}
+
+// SetDefaultBranch of a repository, which is checked out when cloning.
+func (c *Client) SetDefaultBranch(ctx context.Context, id, name string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.SetDefaultBranch")
+	span.SetTag("id", id)
+	span.SetTag("name", name)
+	defer span.Finish()
+
+	_, err := c.branches.SetDefault(ctx, &SetDefaultBranchRequest{
+		Id:   id,
+		Name: name,
+	})
+	return statusError(err)
+}
+
+// Merge head into a branch of a repository.
+// If there are conflicts ErrMergeConflict is returned together with the conflicting paths.
+func (c *Client) Merge(ctx context.Context, id string, opts MergeOptions) (MergeResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Merge")
+	span.SetTag("id", id)
+	span.SetTag("branch", opts.Branch)
+	span.SetTag("head", opts.Head)
+	span.SetTag("strategy", opts.Strategy)
+	defer span.Finish()
+
+	res, err := c.branches.Merge(ctx, &MergeRequest{
+		Id:        id,
+		Branch:    opts.Branch,
+		Head:      opts.Head,
+		Sha1:      opts.Sha1,
+		Strategy:  opts.Strategy,
+		Message:   opts.Message,
+		Author:    signatureRequest(opts.Author),
+		Committer: signatureRequest(opts.Committer),
+	})
+	if err != nil {
+		return MergeResult{}, statusError(err)
 	}
+	if len(res.GetConflicts()) > 0 {
+		return MergeResult{Conflicts: res.GetConflicts()}, ErrMergeConflict
+	}
+
+	return MergeResult{Sha1: res.GetSha1()}, nil
+}
 
-	return branches, err
+func signatureRequest(s Signature) *SignatureRequest {
+	if s.Name == "" && s.Email == "" {
+		return nil
+	}
+	_, offset := s.Date.Zone()
+	return &SignatureRequest{
+		Name:   s.Name,
+		Email:  s.Email,
+		Date:   s.Date.Unix(),
+		Offset: int32(offset),
+	}
 }
 
 // Commit returns a single commit from a given repository
@@ -101,6 +394,10 @@ func (c *Client) Commit(ctx context.Context, id, ref string) (Commit, error) {
 		return Commit{}, err
 	}
 
+	return commitFromResponse(res), nil
+}
+
+func commitFromResponse(res *CommitResponse) Commit {
 	return Commit{
 		Hash:    res.GetHash(),
 		Tree:    res.GetTree(),
@@ -116,6 +413,91 @@ func (c *Client) Commit(ctx context.Context, id, ref string) (Commit, error) {
 			Email: res.GetCommitterEmail(),
 			Date:  time.Unix(res.GetCommitterDate(), 0),
 		},
+	}
+}
+
+// Commits returns the commits reachable from head but not from base, oldest first
+func (c *Client) Commits(ctx context.Context, id, base, head string, limit int) ([]Commit, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Commits")
+	span.SetTag("id", id)
+	span.SetTag("base", base)
+	span.SetTag("head", head)
+	defer span.Finish()
+
+	res, err := c.commits.List(ctx, &CommitsRequest{
+		Id:    id,
+		Base:  base,
+		Head:  head,
+		Limit: int32(limit),
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	commits := make([]Commit, 0, len(res.GetCommits()))
+	for _, r := range res.GetCommits() {
+		commits = append(commits, commitFromResponse(r))
+	}
+
+	return commits, nil
+}
+
+// Diff returns the changes of every file from base to head
+func (c *Client) Diff(ctx context.Context, id, base, head string) (Diff, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Diff")
+	span.SetTag("id", id)
+	span.SetTag("base", base)
+	span.SetTag("head", head)
+	defer span.Finish()
+
+	res, err := c.commits.Diff(ctx, &DiffRequest{
+		Id:   id,
+		Base: base,
+		Head: head,
+	})
+	if err != nil {
+		return Diff{}, statusError(err)
+	}
+
+	diff := Diff{Truncated: res.GetTruncated()}
+	for _, f := range res.GetFiles() {
+		diff.Files = append(diff.Files, FileDiff{
+			Path:      f.GetPath(),
+			OldPath:   f.GetOldPath(),
+			Status:    f.GetStatus(),
+			Binary:    f.GetBinary(),
+			Additions: int(f.GetAdditions()),
+			Deletions: int(f.GetDeletions()),
+			Patch:     f.GetPatch(),
+		})
+	}
+
+	return diff, nil
+}
+
+// Mergeable checks if head can be merged into base without conflicts
+func (c *Client) Mergeable(ctx context.Context, id, base, head string) (Mergeability, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Mergeable")
+	span.SetTag("id", id)
+	span.SetTag("base", base)
+	span.SetTag("head", head)
+	defer span.Finish()
+
+	res, err := c.commits.Mergeable(ctx, &MergeableRequest{
+		Id:   id,
+		Base: base,
+		Head: head,
+	})
+	if err != nil {
+		return Mergeability{}, statusError(err)
+	}
+
+	return Mergeability{
+		Base:      res.GetBase(),
+		Head:      res.GetHead(),
+		MergeBase: res.GetMergeBase(),
+		Mergeable: res.GetMergeable(),
+		Conflicts: res.GetConflicts(),
 	}, nil
 }
 
@@ -151,6 +533,283 @@ func (c *Client) Tree(ctx context.Context, id, ref, path string) ([]TreeEntry, e
 	return treeEntries, nil
 }
 
+// Archive writes an archive of a repository at the given rev to w
+func (c *Client) Archive(ctx context.Context, id, rev, format, prefix string, w io.Writer) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Archive")
+	span.SetTag("repo_path", id)
+	span.SetTag("rev", rev)
+	span.SetTag("format", format)
+	span.SetTag("prefix", prefix)
+	defer span.Finish()
+
+	// Cancelling the context stops the archive on the storage too.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.repos.Archive(ctx, &ArchiveRequest{
+		Id:     id,
+		Rev:    rev,
+		Format: format,
+		Prefix: prefix,
+	})
+	if err != nil {
+		return statusError(err)
+	}
+
+	r := streamio.NewReader(func() ([]byte, error) {
+		res, err := stream.Recv()
+		if err != nil && err != io.EOF {
+			return nil, statusError(err)
+		}
+		return res.GetData(), err
+	})
+
+	_, err = io.Copy(w, r)
+	return err
+}
+
+// Blob returns the blob at a path for a given rev
+func (c *Client) Blob(ctx context.Context, id, rev, path string) (Blob, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Blob")
+	span.SetTag("repo_path", id)
+	span.SetTag("rev", rev)
+	span.SetTag("path", path)
+	defer span.Finish()
+
+	res, err := c.repos.Blob(ctx, &BlobRequest{
+		Id:   id,
+		Rev:  rev,
+		Path: path,
+	})
+	if err != nil {
+		return Blob{}, statusError(err)
+	}
+
+	return Blob{
+		Sha1: res.GetSha1(),
+		Mode: res.GetMode(),
+		Size: res.GetSize(),
+	}, nil
+}
+
+// ReadBlob writes limit bytes of a blob starting at offset to w, a limit of 0 writes everything
+func (c *Client) ReadBlob(ctx context.Context, id, sha1 string, offset, limit int64, w io.Writer) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.ReadBlob")
+	span.SetTag("repo_path", id)
+	span.SetTag("sha1", sha1)
+	span.SetTag("offset", offset)
+	span.SetTag("limit", limit)
+	defer span.Finish()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.repos.ReadBlob(ctx, &ReadBlobRequest{
+		Id:     id,
+		Sha1:   sha1,
+		Offset: offset,
+		Limit:  limit,
+	})
+	if err != nil {
+		return statusError(err)
+	}
+
+	r := streamio.NewReader(func() ([]byte, error) {
+		res, err := stream.Recv()
+		if err != nil && err != io.EOF {
+			return nil, statusError(err)
+		}
+		return res.GetData(), err
+	})
+
+	_, err = io.Copy(w, r)
+	return err
+}
+
+// Blame returns the hunks of a file at a path for a given rev
+func (c *Client) Blame(ctx context.Context, id, rev, path string) ([]BlameHunk, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Blame")
+	span.SetTag("repo_path", id)
+	span.SetTag("rev", rev)
+	span.SetTag("path", path)
+	defer span.Finish()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.repos.Blame(ctx, &BlameRequest{
+		Id:   id,
+		Rev:  rev,
+		Path: path,
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	var hunks []BlameHunk
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, statusError(err)
+		}
+
+		hunks = append(hunks, BlameHunk{
+			Sha1:    res.GetSha1(),
+			Summary: res.GetSummary(),
+			Author: Signature{
+				Name:  res.GetAuthor(),
+				Email: res.GetAuthorEmail(),
+				Date:  time.Unix(res.GetAuthorDate(), 0),
+			},
+			Line:  int(res.GetLine()),
+			Lines: res.GetLines(),
+		})
+	}
+
+	return hunks, nil
+}
+
+// Search returns the lines matching a query in the tree of a given rev
+func (c *Client) Search(ctx context.Context, id, rev string, opts SearchOptions) ([]SearchMatch, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Search")
+	span.SetTag("repo_path", id)
+	span.SetTag("rev", rev)
+	span.SetTag("query", opts.Query)
+	defer span.Finish()
+
+	res, err := c.repos.Search(ctx, &SearchRequest{
+		Id:         id,
+		Rev:        rev,
+		Query:      opts.Query,
+		Regexp:     opts.Regexp,
+		IgnoreCase: opts.IgnoreCase,
+		Paths:      opts.Paths,
+		Limit:      int32(opts.Limit),
+		Context:    int32(opts.Context),
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	matches := make([]SearchMatch, 0, len(res.GetMatches()))
+	for _, m := range res.GetMatches() {
+		matches = append(matches, SearchMatch{
+			Path:   m.GetPath(),
+			Line:   int(m.GetLine()),
+			Text:   m.GetText(),
+			Before: m.GetBefore(),
+			After:  m.GetAfter(),
+		})
+	}
+
+	return matches, nil
+}
+
+// Stats of a repository
+func (c *Client) Stats(ctx context.Context, id string) (Stats, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Stats")
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	res, err := c.repos.Stats(ctx, &StatsRequest{Id: id})
+	if err != nil {
+		return Stats{}, statusError(err)
+	}
+
+	stats := Stats{
+		Size:          res.GetSize(),
+		LooseObjects:  res.GetLooseObjects(),
+		PackedObjects: res.GetPackedObjects(),
+		Commits:       res.GetCommits(),
+	}
+	for _, l := range res.GetLanguages() {
+		stats.Languages = append(stats.Languages, Language{Name: l.GetName(), Bytes: l.GetBytes()})
+	}
+
+	return stats, nil
+}
+
+// SearchIndex searches the indexed default branches of the repositories
+func (c *Client) SearchIndex(ctx context.Context, ids []string, opts IndexSearchOptions) ([]IndexMatch, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.SearchIndex")
+	span.SetTag("repositories", len(ids))
+	span.SetTag("query", opts.Query)
+	defer span.Finish()
+
+	res, err := c.index.Search(ctx, &IndexSearchRequest{
+		Ids:        ids,
+		Query:      opts.Query,
+		IgnoreCase: opts.IgnoreCase,
+		Limit:      int32(opts.Limit),
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	matches := make([]IndexMatch, 0, len(res.GetMatches()))
+	for _, m := range res.GetMatches() {
+		matches = append(matches, IndexMatch{
+			ID:   m.GetId(),
+			Path: m.GetPath(),
+			Line: int(m.GetLine()),
+			Text: m.GetText(),
+		})
+	}
+
+	return matches, nil
+}
+
+// Maintain a repository, see LocalRepository.Maintain
+func (c *Client) Maintain(ctx context.Context, id string, opts MaintenanceOptions) (MaintenanceStatus, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.Maintain")
+	span.SetTag("id", id)
+	span.SetTag("force", opts.Force)
+	defer span.Finish()
+
+	res, err := c.maintenance.Maintain(ctx, &MaintainRequest{Id: id, Force: opts.Force})
+	if err != nil {
+		return MaintenanceStatus{}, statusError(err)
+	}
+
+	return maintenanceStatus(res), nil
+}
+
+// MaintenanceStatus of a repository
+func (c *Client) MaintenanceStatus(ctx context.Context, id string) (MaintenanceStatus, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.MaintenanceStatus")
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	res, err := c.maintenance.Status(ctx, &MaintenanceStatusRequest{Id: id})
+	if err != nil {
+		return MaintenanceStatus{}, statusError(err)
+	}
+
+	return maintenanceStatus(res), nil
+}
+
+func maintenanceStatus(res *MaintenanceStatusResponse) MaintenanceStatus {
+	timestamp := func(sec int64) time.Time {
+		if sec == 0 {
+			return time.Time{}
+		}
+		return time.Unix(sec, 0)
+	}
+
+	return MaintenanceStatus{
+		LastRun:      timestamp(res.GetLastRun()),
+		LastSuccess:  timestamp(res.GetLastSuccess()),
+		LastFsck:     timestamp(res.GetLastFsck()),
+		LastError:    res.GetLastError(),
+		Tasks:        res.GetTasks(),
+		LooseObjects: res.GetLooseObjects(),
+		Packs:        res.GetPacks(),
+	}
+}
+
 // UploadPack to a git-repo
 func (c *Client) UploadPack(ctx context.Context, id string, stdin io.Reader, stdout, stderr io.Writer) (int32, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.Client.UploadPack")
@@ -269,3 +928,42 @@ func (c *Client) ReceivePack(ctx context.Context, id string, stdin io.Reader, st
 
 	return 0, err
 }
+
+// statusError turns a status sent by the server back into the known error
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	for _, known := range knownErrors {
+		if s.Message() == known.Error() {
+			return known
+		}
+	}
+	return err
+}
+
+// Pushes calls fn with every push to any repository until ctx is cancelled or the stream breaks.
+// Pushes happening while not being subscribed are missed.
+func (c *Client) Pushes(ctx context.Context, fn func(PushEvent)) error {
+	stream, err := c.events.Pushes(ctx, &PushesRequest{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		p := PushEvent{ID: res.GetId(), Pushed: time.Unix(res.GetPushed(), 0)}
+		for _, u := range res.GetRefs() {
+			p.Refs = append(p.Refs, RefUpdate{Ref: u.GetRef(), Old: u.GetOld(), New: u.GetNew()})
+		}
+		fn(p)
+	}
+}
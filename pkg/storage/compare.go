@@ -0,0 +1,393 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+// Limits for comparing revisions, so that huge changes can't keep a storage node busy.
+const (
+	CommitsDefaultLimit = 250
+	CommitsMaxLimit     = 1000
+	DiffMaxFiles        = 300
+	DiffMaxSize         = 1 << 20
+)
+
+// Status of a file in a FileDiff.
+const (
+	DiffAdded    = "added"
+	DiffDeleted  = "deleted"
+	DiffModified = "modified"
+	DiffRenamed  = "renamed"
+)
+
+// Mergeability tells if head can be merged into base without conflicts.
+type Mergeability struct {
+	// Base, Head and MergeBase are the resolved sha1s,
+	// MergeBase is empty if base and head have no history in common.
+	Base      string
+	Head      string
+	MergeBase string
+
+	Mergeable bool
+	// Conflicts are the paths of the files that can't be merged.
+	Conflicts []string
+}
+
+// FileDiff is the change of a single file between two revisions.
+type FileDiff struct {
+	Path string
+	// OldPath is the path before the file was renamed, empty otherwise.
+	OldPath string
+	// Status is DiffAdded, DiffDeleted, DiffModified or DiffRenamed.
+	Status    string
+	Binary    bool
+	Additions int
+	Deletions int
+	// Patch holds the hunks of the unified diff, it's empty for binary files.
+	Patch string
+}
+
+// Diff between two revisions.
+type Diff struct {
+	Files []FileDiff
+	// Truncated is true if the diff is too large and the last files are missing.
+	Truncated bool
+}
+
+// FetchRef updates the ref of the repository id to sourceRef of the repository sourceID,
+// which may be the same repository. It returns the sha1 the ref points to afterwards.
+func (s *LocalStorage) FetchRef(ctx context.Context, id, sourceID, sourceRef, ref string) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalStorage.FetchRef")
+	span.SetTag("id", id)
+	span.SetTag("source", sourceID)
+	span.SetTag("source_ref", sourceRef)
+	span.SetTag("ref", ref)
+	defer span.Finish()
+
+	if !strings.HasPrefix(ref, "refs/") || !strings.HasPrefix(sourceRef, "refs/") {
+		return "", ErrRevNotFound
+	}
+
+	source, err := s.GetRepository(ctx, sourceID)
+	if err != nil {
+		return "", err
+	}
+	src := source.(*LocalRepository)
+	if _, err := src.revParse(ctx, sourceRef+"^{commit}"); err != nil {
+		if strings.HasPrefix(sourceRef, "refs/heads/") {
+			return "", ErrBranchNotFound
+		}
+		return "", err
+	}
+
+	repo, err := s.GetRepository(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	r := repo.(*LocalRepository)
+
+	out, err := command.NewSimple(ctx, r.path, r.git,
+		"fetch", "--quiet", "--no-tags", "--no-write-fetch-head", src.path, "+"+sourceRef+":"+ref,
+	)
+	if err != nil {
+		injectError(span, err, out)
+		return "", errors.Wrapf(err, "failed to fetch ref: %s", out)
+	}
+
+	return r.revParse(ctx, ref)
+}
+
+// Mergeable checks if head can be merged into base, without changing the repository.
+func (r *LocalRepository) Mergeable(ctx context.Context, base, head string) (Mergeability, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.Mergeable")
+	span.SetTag("base", base)
+	span.SetTag("head", head)
+	defer span.Finish()
+
+	var m Mergeability
+	var err error
+	if m.Base, err = r.revParse(ctx, base+"^{commit}"); err != nil {
+		return m, err
+	}
+	if m.Head, err = r.revParse(ctx, head+"^{commit}"); err != nil {
+		return m, err
+	}
+
+	out, err := command.NewSimple(ctx, r.path, r.git, "merge-base", m.Base, m.Head)
+	if err != nil {
+		if exitErr, ok := errors.Cause(err).(*exec.ExitError); ok && exitErr.ExitCode() == 1 {
+			// Unrelated histories can't be merged.
+			return m, nil
+		}
+		injectError(span, err, out)
+		return m, errors.Wrapf(err, "failed to find merge base: %s", out)
+	}
+	m.MergeBase = strings.TrimSpace(out)
+
+	_, m.Conflicts, err = r.mergeTree(ctx, m.Base, m.Head)
+	if err != nil {
+		injectError(span, err, "")
+		return m, err
+	}
+	m.Mergeable = len(m.Conflicts) == 0
+	return m, nil
+}
+
+// ListCommits returns the commits reachable from head but not from base, oldest first.
+// If there are more than limit, only the most recent ones are returned.
+func (r *LocalRepository) ListCommits(ctx context.Context, base, head string, limit int) ([]Commit, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.ListCommits")
+	span.SetTag("base", base)
+	span.SetTag("head", head)
+	defer span.Finish()
+
+	if limit <= 0 {
+		limit = CommitsDefaultLimit
+	}
+	if limit > CommitsMaxLimit {
+		limit = CommitsMaxLimit
+	}
+
+	baseSha1, err := r.revParse(ctx, base+"^{commit}")
+	if err != nil {
+		return nil, err
+	}
+	headSha1, err := r.revParse(ctx, head+"^{commit}")
+	if err != nil {
+		return nil, err
+	}
+
+	const format = "%H%x1f%T%x1f%P%x1f%an%x1f%ae%x1f%at%x1f%cn%x1f%ce%x1f%ct%x1f%B"
+	errBuf := &bytes.Buffer{}
+	args := []string{"log", "-z", "--reverse", "--format=" + format, "--max-count=" + strconv.Itoa(limit), baseSha1 + ".." + headSha1}
+	cmd, err := command.New(ctx, r.path, r.git, args, command.StdoutPipe, command.StderrWriter(errBuf))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to run git log")
+	}
+	defer cmd.Finish()
+
+	commits, err := parseLog(cmd.Stdout())
+	if err != nil {
+		cmd.Wait()
+		return nil, err
+	}
+	if err := cmd.Wait(); err != nil {
+		injectError(span, err, errBuf.String())
+		return nil, errors.Wrapf(err, "failed to list commits: %s", errBuf.String())
+	}
+
+	return commits, nil
+}
+
+// parseLog reads commits separated by NUL with their fields separated by 0x1f.
+func parseLog(r io.Reader) ([]Commit, error) {
+	var commits []Commit
+	reader := bufio.NewReader(r)
+	for {
+		record, err := reader.ReadString('\x00')
+		if err == io.EOF && record == "" {
+			return commits, nil
+		}
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		fields := strings.SplitN(strings.TrimSuffix(record, "\x00"), "\x1f", 10)
+		if len(fields) != 10 {
+			return nil, errors.Errorf("unexpected commit format: %q", record)
+		}
+
+		c := Commit{
+			Hash: fields[0],
+			Tree: fields[1],
+			Author: Signature{
+				Name:  fields[3],
+				Email: fields[4],
+				Date:  parseUnix(fields[5]),
+			},
+			Committer: Signature{
+				Name:  fields[6],
+				Email: fields[7],
+				Date:  parseUnix(fields[8]),
+			},
+		}
+		if parents := strings.Fields(fields[2]); len(parents) > 0 {
+			c.Parent = parents[0]
+		}
+
+		message := strings.SplitN(strings.TrimRight(fields[9], "\n"), "\n", 2)
+		c.Message = message[0]
+		if len(message) == 2 {
+			c.Body = strings.TrimLeft(message[1], "\n")
+		}
+
+		commits = append(commits, c)
+		if err == io.EOF {
+			return commits, nil
+		}
+	}
+}
+
+func parseUnix(s string) time.Time {
+	sec, _ := strconv.ParseInt(s, 10, 64)
+	return time.Unix(sec, 0)
+}
+
+// Diff returns the changes of every file from base to head.
+// Files beyond DiffMaxFiles or DiffMaxSize are left out and the diff is marked as truncated.
+func (r *LocalRepository) Diff(ctx context.Context, base, head string) (Diff, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.Diff")
+	span.SetTag("base", base)
+	span.SetTag("head", head)
+	defer span.Finish()
+
+	baseSha1, err := r.revParse(ctx, base+"^{commit}")
+	if err != nil {
+		return Diff{}, err
+	}
+	headSha1, err := r.revParse(ctx, head+"^{commit}")
+	if err != nil {
+		return Diff{}, err
+	}
+
+	// Stop git once the diff is too large.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errBuf := &bytes.Buffer{}
+	args := []string{"-c", "core.quotePath=false", "diff", "--no-color", "--no-ext-diff", "--find-renames", baseSha1, headSha1}
+	cmd, err := command.New(ctx, r.path, r.git, args, command.StdoutPipe, command.StderrWriter(errBuf))
+	if err != nil {
+		return Diff{}, errors.Wrap(err, "failed to run git diff")
+	}
+
+	diff, err := parseDiff(cmd.Stdout(), DiffMaxFiles, DiffMaxSize)
+	if err != nil || diff.Truncated {
+		cancel()
+		cmd.Wait()
+		return diff, err
+	}
+	if err := cmd.Wait(); err != nil {
+		injectError(span, err, errBuf.String())
+		return Diff{}, errors.Wrapf(err, "failed to diff: %s", errBuf.String())
+	}
+
+	return diff, nil
+}
+
+// parseDiff reads the output of git diff until maxFiles files or maxSize bytes of patches.
+func parseDiff(r io.Reader, maxFiles, maxSize int) (Diff, error) {
+	var diff Diff
+	var file *FileDiff
+	var patch strings.Builder
+	size := 0
+	inHunk := false
+
+	finish := func() {
+		if file == nil {
+			return
+		}
+		if file.Path == "" {
+			file.Path = file.OldPath
+		}
+		if file.Status != DiffRenamed {
+			file.OldPath = ""
+		}
+		file.Patch = patch.String()
+		diff.Files = append(diff.Files, *file)
+		patch.Reset()
+	}
+
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if err == io.EOF && line == "" {
+			break
+		}
+		if err != nil && err != io.EOF {
+			return diff, err
+		}
+
+		if strings.HasPrefix(line, "diff --git ") {
+			finish()
+			if len(diff.Files) == maxFiles {
+				diff.Truncated = true
+				return diff, nil
+			}
+			file = &FileDiff{Status: DiffModified, Path: diffHeaderPath(strings.TrimSuffix(line, "\n"))}
+			inHunk = false
+			continue
+		}
+		if file == nil {
+			continue
+		}
+
+		if inHunk || strings.HasPrefix(line, "@@") {
+			inHunk = true
+			size += len(line)
+			if size > maxSize {
+				diff.Truncated = true
+				return diff, nil
+			}
+			patch.WriteString(line)
+			switch line[0] {
+			case '+':
+				file.Additions++
+			case '-':
+				file.Deletions++
+			}
+			continue
+		}
+
+		header := strings.TrimSuffix(line, "\n")
+		switch {
+		case strings.HasPrefix(header, "new file mode "):
+			file.Status = DiffAdded
+		case strings.HasPrefix(header, "deleted file mode "):
+			file.Status = DiffDeleted
+		case strings.HasPrefix(header, "rename from "):
+			file.Status = DiffRenamed
+			file.OldPath = strings.TrimPrefix(header, "rename from ")
+		case strings.HasPrefix(header, "rename to "):
+			file.Path = strings.TrimPrefix(header, "rename to ")
+		case strings.HasPrefix(header, "--- a/"):
+			file.OldPath = strings.TrimPrefix(header, "--- a/")
+		case strings.HasPrefix(header, "+++ b/"):
+			file.Path = strings.TrimPrefix(header, "+++ b/")
+		case strings.HasPrefix(header, "Binary files "):
+			file.Binary = true
+		}
+
+		if err == io.EOF {
+			break
+		}
+	}
+	finish()
+
+	return diff, nil
+}
+
+// diffHeaderPath returns the path of a "diff --git a/<path> b/<path>" line,
+// which is only unambiguous if the path didn't change.
+func diffHeaderPath(line string) string {
+	paths := strings.TrimPrefix(line, "diff --git ")
+	if len(paths)%2 == 0 {
+		return ""
+	}
+	n := (len(paths) - 5) / 2
+	if n <= 0 || !strings.HasPrefix(paths, "a/") || paths[2+n:5+n] != " b/" || paths[2:2+n] != paths[5+n:] {
+		return ""
+	}
+	return paths[2 : 2+n]
+}
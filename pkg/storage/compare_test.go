@@ -0,0 +1,177 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCommit commits the files, which replace all files of parent, and returns the commit's sha1.
+func testCommit(t *testing.T, r *LocalRepository, parent, message string, files map[string]string) string {
+	git := func(stdin string, args ...string) string {
+		cmd := exec.Command("/usr/bin/git", args...)
+		cmd.Dir = r.path
+		cmd.Stdin = strings.NewReader(stdin)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Foo Bar", "GIT_AUTHOR_EMAIL=foo@bar.com",
+			"GIT_COMMITTER_NAME=Foo Bar", "GIT_COMMITTER_EMAIL=foo@bar.com",
+			"GIT_AUTHOR_DATE=1505942997 -0700", "GIT_COMMITTER_DATE=1505942997 -0700",
+		)
+		out, err := cmd.Output()
+		require.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+
+	var paths []string
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var entries strings.Builder
+	for _, path := range paths {
+		blob := git(files[path], "hash-object", "-w", "--stdin")
+		entries.WriteString("100644 blob " + blob + "\t" + path + "\n")
+	}
+	tree := git(entries.String(), "mktree")
+
+	return git("", "commit-tree", tree, "-p", parent, "-m", message)
+}
+
+func TestLocalRepository_Compare(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	ls, err := NewLocalStorage(storageRoot(r))
+	require.NoError(t, err)
+	require.NoError(t, ls.Fork(ctx, "fork-bar-baz", "foo-bar-baz"))
+	repo, err := ls.GetRepository(ctx, "fork-bar-baz")
+	require.NoError(t, err)
+	fork := repo.(*LocalRepository)
+
+	feature := testCommit(t, fork, sha1, "add main\n\nwith a body", map[string]string{
+		"README.md": "# foo\nbar\n",
+		"main.go":   "package main\n",
+	})
+	_, err = fork.CreateBranch(ctx, "feature", feature)
+	require.NoError(t, err)
+
+	head, err := ls.FetchRef(ctx, "foo-bar-baz", "fork-bar-baz", "refs/heads/feature", "refs/pull/1/head")
+	require.NoError(t, err)
+	assert.Equal(t, feature, head)
+
+	_, err = ls.FetchRef(ctx, "foo-bar-baz", "fork-bar-baz", "refs/heads/unknown", "refs/pull/1/head")
+	assert.Equal(t, ErrBranchNotFound, err)
+	_, err = ls.FetchRef(ctx, "foo-bar-baz", "fork-bar-baz", "refs/heads/feature", "HEAD")
+	assert.Equal(t, ErrRevNotFound, err)
+
+	m, err := r.Mergeable(ctx, "refs/heads/master", "refs/pull/1/head")
+	require.NoError(t, err)
+	assert.True(t, m.Mergeable)
+	assert.Equal(t, sha1, m.Base)
+	assert.Equal(t, feature, m.Head)
+	assert.Equal(t, sha1, m.MergeBase)
+	assert.Empty(t, m.Conflicts)
+
+	_, err = r.Mergeable(ctx, "refs/heads/unknown", "refs/pull/1/head")
+	assert.Equal(t, ErrRevNotFound, err)
+
+	commits, err := r.ListCommits(ctx, "refs/heads/master", "refs/pull/1/head", 0)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	assert.Equal(t, feature, commits[0].Hash)
+	assert.Equal(t, sha1, commits[0].Parent)
+	assert.Equal(t, "add main", commits[0].Message)
+	assert.Equal(t, "with a body", commits[0].Body)
+	assert.Equal(t, "foo@bar.com", commits[0].Author.Email)
+	assert.Equal(t, int64(1505942997), commits[0].Committer.Date.Unix())
+
+	diff, err := r.Diff(ctx, m.MergeBase, "refs/pull/1/head")
+	require.NoError(t, err)
+	assert.False(t, diff.Truncated)
+	require.Len(t, diff.Files, 2)
+	assert.Equal(t, "README.md", diff.Files[0].Path)
+	assert.Equal(t, DiffModified, diff.Files[0].Status)
+	assert.Equal(t, 1, diff.Files[0].Additions)
+	assert.Equal(t, 0, diff.Files[0].Deletions)
+	assert.Equal(t, "@@ -1 +1,2 @@\n # foo\n+bar\n", diff.Files[0].Patch)
+	assert.Equal(t, "main.go", diff.Files[1].Path)
+	assert.Equal(t, DiffAdded, diff.Files[1].Status)
+
+	// Changing the same line on master conflicts.
+	master := testCommit(t, r, sha1, "change readme", map[string]string{
+		"README.md": "# foo\nbaz\n",
+	})
+	_, err = r.CreateBranch(ctx, "conflict", master)
+	require.NoError(t, err)
+
+	m, err = r.Mergeable(ctx, "refs/heads/conflict", "refs/pull/1/head")
+	require.NoError(t, err)
+	assert.False(t, m.Mergeable)
+	assert.Equal(t, sha1, m.MergeBase)
+	assert.Equal(t, []string{"README.md"}, m.Conflicts)
+}
+
+func TestParseDiff(t *testing.T) {
+	out := `diff --git a/old name.txt b/new name.txt
+similarity index 90%
+rename from old name.txt
+rename to new name.txt
+index 1111111..2222222 100644
+--- a/old name.txt
++++ b/new name.txt
+@@ -1,2 +1,2 @@
+ foo
+--- bar
++bar
+diff --git a/logo.png b/logo.png
+deleted file mode 100644
+index 3333333..0000000
+Binary files a/logo.png and /dev/null differ
+diff --git a/gone.txt b/gone.txt
+deleted file mode 100644
+index 4444444..0000000
+--- a/gone.txt
++++ /dev/null
+@@ -1 +0,0 @@
+-gone
+`
+
+	diff, err := parseDiff(strings.NewReader(out), DiffMaxFiles, DiffMaxSize)
+	require.NoError(t, err)
+	assert.False(t, diff.Truncated)
+	assert.Equal(t, []FileDiff{{
+		Path:      "new name.txt",
+		OldPath:   "old name.txt",
+		Status:    DiffRenamed,
+		Additions: 1,
+		Deletions: 1,
+		Patch:     "@@ -1,2 +1,2 @@\n foo\n--- bar\n+bar\n",
+	}, {
+		Path:   "logo.png",
+		Status: DiffDeleted,
+		Binary: true,
+	}, {
+		Path:      "gone.txt",
+		Status:    DiffDeleted,
+		Deletions: 1,
+		Patch:     "@@ -1 +0,0 @@\n-gone\n",
+	}}, diff.Files)
+
+	diff, err = parseDiff(strings.NewReader(out), 1, DiffMaxSize)
+	require.NoError(t, err)
+	assert.True(t, diff.Truncated)
+	assert.Len(t, diff.Files, 1)
+
+	diff, err = parseDiff(strings.NewReader(out), DiffMaxFiles, 20)
+	require.NoError(t, err)
+	assert.True(t, diff.Truncated)
+	assert.Empty(t, diff.Files)
+}
@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+// RefUpdate is a ref changed by a push.
+// Old is empty for created refs and New is empty for deleted refs.
+type RefUpdate struct {
+	Ref string
+	Old string
+	New string
+}
+
+// PushEvent is published after a push changed refs of a repository.
+type PushEvent struct {
+	ID     string
+	Refs   []RefUpdate
+	Pushed time.Time
+}
+
+// subscriberBuffer is the number of events buffered for each subscriber,
+// events are dropped for subscribers falling further behind.
+const subscriberBuffer = 64
+
+// Events publishes the push events of repositories to its subscribers.
+type Events struct {
+	mu          sync.Mutex
+	subscribers map[chan PushEvent]struct{}
+}
+
+// NewEvents returns Events without subscribers, it can be given to EventsOption.
+func NewEvents() *Events {
+	return &Events{subscribers: map[chan PushEvent]struct{}{}}
+}
+
+// Publish the event to all subscribers without waiting for them.
+func (e *Events) Publish(event PushEvent) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for sub := range e.subscribers {
+		select {
+		case sub <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe to all events published from now on.
+// The returned func unsubscribes and closes the channel.
+func (e *Events) Subscribe() (<-chan PushEvent, func()) {
+	sub := make(chan PushEvent, subscriberBuffer)
+
+	e.mu.Lock()
+	e.subscribers[sub] = struct{}{}
+	e.mu.Unlock()
+
+	var once sync.Once
+	return sub, func() {
+		once.Do(func() {
+			e.mu.Lock()
+			delete(e.subscribers, sub)
+			close(sub)
+			e.mu.Unlock()
+		})
+	}
+}
+
+// EventsOption injects Events to publish the pushes to repositories to
+func EventsOption(events *Events) StorageOption {
+	return func(s Storage) {
+		ls, ok := s.(*LocalStorage)
+		if !ok {
+			return
+		}
+		ls.events = events
+	}
+}
+
+// refs returns the sha1 of every ref in the repository by its name.
+func (r *LocalRepository) refs(ctx context.Context) (map[string]string, error) {
+	out, err := command.NewSimple(ctx, r.path, r.git, "for-each-ref", "--format=%(objectname) %(refname)")
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		fields := strings.SplitN(line, " ", 2)
+		if len(fields) == 2 {
+			refs[fields[1]] = fields[0]
+		}
+	}
+
+	return refs, nil
+}
+
+// refUpdates compares the refs before and after a push, sorted by ref.
+func refUpdates(before, after map[string]string) []RefUpdate {
+	var updates []RefUpdate
+	for ref, sha1 := range after {
+		if before[ref] != sha1 {
+			updates = append(updates, RefUpdate{Ref: ref, Old: before[ref], New: sha1})
+		}
+	}
+	for ref, sha1 := range before {
+		if _, ok := after[ref]; !ok {
+			updates = append(updates, RefUpdate{Ref: ref, Old: sha1})
+		}
+	}
+
+	sort.Slice(updates, func(i, j int) bool {
+		return updates[i].Ref < updates[j].Ref
+	})
+
+	return updates
+}
@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents(t *testing.T) {
+	events := NewEvents()
+
+	events.Publish(PushEvent{ID: "before"})
+
+	pushes, unsubscribe := events.Subscribe()
+	events.Publish(PushEvent{ID: "foo-bar-baz"})
+	assert.Equal(t, "foo-bar-baz", (<-pushes).ID, "only events published after subscribing are received")
+
+	// Slow subscribers miss events instead of blocking pushes.
+	for i := 0; i < subscriberBuffer+1; i++ {
+		events.Publish(PushEvent{ID: "foo-bar-baz"})
+	}
+	assert.Len(t, pushes, subscriberBuffer)
+
+	unsubscribe()
+	unsubscribe()
+	events.Publish(PushEvent{ID: "after"})
+	for range pushes {
+	}
+}
+
+func TestLocalRepository_refUpdates(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	before, err := r.refs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"refs/heads/master": sha1}, before)
+
+	_, err = r.CreateBranch(ctx, "feature", "master")
+	require.NoError(t, err)
+	require.NoError(t, r.DeleteBranch(ctx, "master", sha1))
+
+	after, err := r.refs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []RefUpdate{
+		{Ref: "refs/heads/feature", New: sha1},
+		{Ref: "refs/heads/master", Old: sha1},
+	}, refUpdates(before, after))
+	assert.Empty(t, refUpdates(after, after))
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+// Fork creates the repository id as a copy of the sourceID repository.
+// The fork borrows the source's objects through objects/info/alternates instead of copying them.
+// From then on the source keeps unreachable objects when it's garbage collected,
+// as forks might still reference them.
+func (s *LocalStorage) Fork(ctx context.Context, id, sourceID string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalStorage.Fork")
+	span.SetTag("id", id)
+	span.SetTag("source", sourceID)
+	defer span.Finish()
+
+	source, err := s.GetRepository(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+	sourcePath := source.(*LocalRepository).path
+
+	dir := s.repoPath(id)
+	if _, err := os.Stat(dir); err == nil {
+		return ErrRepoExists
+	}
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return errors.Wrap(err, "failed to create repository directory")
+	}
+
+	out, err := command.NewSimple(ctx, s.root, s.git, "clone", "--bare", "--shared", "--quiet", sourcePath, dir)
+	if err != nil {
+		injectError(span, err, out)
+		os.RemoveAll(dir)
+		return errors.Wrapf(err, "failed to clone repository: %s", out)
+	}
+
+	out, err = command.NewSimple(ctx, sourcePath, s.git, "config", "gc.pruneExpire", "never")
+	if err != nil {
+		injectError(span, err, out)
+		os.RemoveAll(dir)
+		return errors.Wrapf(err, "failed to keep unreachable objects: %s", out)
+	}
+
+	if s.postReceive != nil {
+		s.postReceive(id)
+	}
+
+	return nil
+}
+
+// Delete the repository from disk.
+// Forks borrowing its objects have to be dissociated before.
+func (s *LocalStorage) Delete(ctx context.Context, id string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalStorage.Delete")
+	span.SetTag("id", id)
+	defer span.Finish()
+
+	repo, err := s.GetRepository(ctx, id)
+	if err != nil {
+		return err
+	}
+	r := repo.(*LocalRepository)
+
+	// Move the repository out of the way first,
+	// so it's gone at once even if removing all its files takes a while.
+	deleted := r.path + ".deleted"
+	if err := os.Rename(r.path, deleted); err != nil {
+		injectError(span, err, "")
+		return errors.Wrap(err, "failed to move repository")
+	}
+	s.indexes.remove(r.indexPath())
+
+	return os.RemoveAll(deleted)
+}
+
+// Dissociate copies the objects borrowed from the repository it was forked from
+// and stops borrowing them. Repositories that aren't forks are left alone.
+func (r *LocalRepository) Dissociate(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.Dissociate")
+	defer span.Finish()
+
+	alternates := filepath.Join(r.path, "objects", "info", "alternates")
+	if _, err := os.Stat(alternates); os.IsNotExist(err) {
+		return nil
+	}
+
+	// Without --local the new pack includes the objects of the alternates.
+	out, err := command.NewSimple(ctx, r.path, r.git, "repack", "-a", "-d", "-q")
+	if err != nil {
+		injectError(span, err, out)
+		return errors.Wrapf(err, "failed to repack: %s", out)
+	}
+
+	return os.Remove(alternates)
+}
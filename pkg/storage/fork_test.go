@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sourcepods/sourcepods/pkg/command"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage_Fork(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	var received []string
+	ls, err := NewLocalStorage(storageRoot(r), PostReceiveOption(func(id string) {
+		received = append(received, id)
+	}))
+	require.NoError(t, err)
+
+	require.NoError(t, ls.Fork(ctx, "fork-bar-baz", "foo-bar-baz"))
+	assert.Equal(t, []string{"fork-bar-baz"}, received)
+	assert.Equal(t, ErrRepoExists, ls.Fork(ctx, "fork-bar-baz", "foo-bar-baz"))
+	assert.Equal(t, ErrRepoNotValid, ls.Fork(ctx, "fork-baz-baz", "unknown-bar-baz"))
+
+	repo, err := ls.GetRepository(ctx, "fork-bar-baz")
+	require.NoError(t, err)
+	fork := repo.(*LocalRepository)
+
+	// The objects are borrowed from the source instead of being copied.
+	alternates, err := ioutil.ReadFile(filepath.Join(fork.path, "objects", "info", "alternates"))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(r.path, "objects"), strings.TrimSpace(string(alternates)))
+
+	out, err := command.NewSimple(ctx, r.path, "/usr/bin/git", "config", "gc.pruneExpire")
+	require.NoError(t, err)
+	assert.Equal(t, "never", strings.TrimSpace(out))
+
+	branches, err := fork.ListBranches(ctx, ListBranchesOptions{})
+	require.NoError(t, err)
+	require.Len(t, branches, 1)
+	assert.Equal(t, "master", branches[0].Name)
+	assert.Equal(t, sha1, branches[0].Sha1)
+
+	// After dissociating the fork works without the source.
+	require.NoError(t, fork.Dissociate(ctx))
+	_, err = os.Stat(filepath.Join(fork.path, "objects", "info", "alternates"))
+	assert.True(t, os.IsNotExist(err))
+
+	require.NoError(t, ls.Delete(ctx, "foo-bar-baz"))
+	_, err = os.Stat(r.path)
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, ErrRepoNotValid, ls.Delete(ctx, "foo-bar-baz"))
+
+	sha, err := fork.revParse(ctx, "master")
+	require.NoError(t, err)
+	assert.Equal(t, sha1, sha)
+	blob, err := fork.Blob(ctx, "master", "README.md")
+	require.NoError(t, err)
+	buf := &strings.Builder{}
+	require.NoError(t, fork.ReadBlob(ctx, blob.Sha1, 0, 0, buf))
+	assert.Equal(t, "# foo\n", buf.String())
+
+	// Dissociating a repository that isn't a fork does nothing.
+	require.NoError(t, fork.Dissociate(ctx))
+}
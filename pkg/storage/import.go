@@ -0,0 +1,261 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+var (
+	// remoteProtocols are the URL schemes repositories can be imported from and mirrored with,
+	// separated by colons like GIT_ALLOW_PROTOCOL.
+	remoteProtocols = "http:https:git"
+	// importTimeout stops imports without a timeout of their own.
+	importTimeout = 30 * time.Minute
+	// importMaxSize is the most bytes of objects imports without a limit of their own fetch.
+	importMaxSize int64 = 2 << 30
+	// importSizeInterval is how often the size of the fetched objects is checked.
+	importSizeInterval = time.Second
+)
+
+// ImportOptions configure where a repository is imported from and what limits apply.
+type ImportOptions struct {
+	URL string
+	// Username and Password authenticate against http(s) URLs if not empty.
+	Username string
+	Password string
+
+	// Timeout stops the import if it takes longer, zero uses the default of the storage.
+	Timeout time.Duration
+	// MaxSize stops the import once the fetched objects take more bytes, zero uses the default of the storage.
+	MaxSize int64
+}
+
+// Import fetches all branches and tags from opts.URL into the repository, which has to be empty.
+// The default branch is set to the one of the remote if it exists.
+// progress is called with every line of progress git reports, returning an error stops the import.
+func (r *LocalRepository) Import(ctx context.Context, opts ImportOptions, progress func(string) error) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.Import")
+	span.SetTag("url", opts.URL)
+	defer span.Finish()
+
+	if !remoteAllowed(opts.URL) {
+		return ErrImportURLInvalid
+	}
+
+	refs, err := r.refs(ctx)
+	if err != nil {
+		injectError(span, err, "")
+		return err
+	}
+	if len(refs) > 0 {
+		return ErrRepoExists
+	}
+
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = importTimeout
+	}
+	maxSize := opts.MaxSize
+	if maxSize == 0 {
+		maxSize = importMaxSize
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	env := remoteEnv(opts.Username, opts.Password)
+
+	// The last line git writes is its error message if fetching fails.
+	var last string
+	args := []string{"fetch", "--progress", "--", opts.URL, "+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*"}
+	unlock := r.lockPush()
+	defer unlock()
+	cmd, err := command.New(ctx, r.path, r.git, args, command.Env(env...), command.StderrPipe)
+	if err != nil {
+		injectError(span, err, "")
+		return err
+	}
+
+	tooLarge := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(importSizeInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if dirSize(filepath.Join(r.path, "objects")) > maxSize {
+					close(tooLarge)
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	err = scanProgress(cmd.Stderr(), func(line string) error {
+		last = line
+		if progress == nil {
+			return nil
+		}
+		return progress(line)
+	})
+	if err != nil {
+		// Stop git, nobody is going to report its progress anymore.
+		cancel()
+	}
+
+	werr := cmd.Wait()
+	close(done)
+
+	select {
+	case <-tooLarge:
+		return ErrImportTooLarge
+	default:
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		return ErrImportTimeout
+	}
+	if err != nil {
+		return err
+	}
+	if werr != nil {
+		injectError(span, werr, last)
+		return errors.Wrapf(werr, "failed to fetch: %s", last)
+	}
+
+	// The fetch might have been quicker than the first check.
+	if dirSize(filepath.Join(r.path, "objects")) > maxSize {
+		return ErrImportTooLarge
+	}
+
+	if err := r.importHead(ctx, opts.URL, env); err != nil {
+		injectError(span, err, "")
+		return err
+	}
+
+	if r.postReceive != nil {
+		r.postReceive(r.id)
+	}
+
+	return nil
+}
+
+// importHead points HEAD to the branch HEAD of the remote points to, if it was imported.
+func (r *LocalRepository) importHead(ctx context.Context, remote string, env []string) error {
+	buf := &bytes.Buffer{}
+	cmd, err := command.New(ctx, r.path, r.git, []string{"ls-remote", "--symref", "--", remote, "HEAD"},
+		command.Env(env...),
+		command.StdoutWriter(buf),
+		command.StderrWriter(buf),
+	)
+	if err != nil {
+		return err
+	}
+	if err := cmd.Wait(); err != nil {
+		return errors.Wrapf(err, "failed to list remote HEAD: %s", buf.String())
+	}
+
+	// ref: refs/heads/master <TAB> HEAD
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if !strings.HasPrefix(line, "ref: ") {
+			continue
+		}
+		ref := strings.TrimPrefix(strings.SplitN(line, "\t", 2)[0], "ref: ")
+		if !strings.HasPrefix(ref, branchPrefix) {
+			return nil
+		}
+		err := r.SetDefaultBranch(ctx, strings.TrimPrefix(ref, branchPrefix))
+		if err == ErrBranchNotFound {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// remoteAllowed returns if the URL has one of the remoteProtocols as scheme.
+func remoteAllowed(rawurl string) bool {
+	u, err := url.Parse(rawurl)
+	if err != nil || u.Host == "" && u.Scheme != "file" {
+		return false
+	}
+	for _, p := range strings.Split(remoteProtocols, ":") {
+		if u.Scheme == p {
+			return true
+		}
+	}
+	return false
+}
+
+// remoteEnv makes git fail instead of prompting for credentials and
+// passes the credentials as header to not have them in the command's arguments.
+func remoteEnv(username, password string) []string {
+	env := []string{
+		"GIT_TERMINAL_PROMPT=0",
+		"GIT_ALLOW_PROTOCOL=" + remoteProtocols,
+	}
+	if username != "" || password != "" {
+		auth := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+		env = append(env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+auth,
+		)
+	}
+	return env
+}
+
+// scanProgress calls fn for every line of progress git writes.
+// Progress is updated in place with carriage returns, they end lines too.
+func scanProgress(r io.Reader, fn func(string) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	})
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if err := fn(line); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// dirSize returns the bytes of all files in dir.
+func dirSize(dir string) int64 {
+	var size int64
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sourcepods/sourcepods/pkg/command"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalRepository_Import(t *testing.T) {
+	source, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, err := source.CreateBranch(ctx, "develop", "master")
+	require.NoError(t, err)
+	require.NoError(t, source.SetDefaultBranch(ctx, "develop"))
+	out, err := command.NewSimple(ctx, source.path, "/usr/bin/git", "tag", "v1.0.0", sha1)
+	require.NoError(t, err, out)
+
+	ls, err := NewLocalStorage(storageRoot(source))
+	require.NoError(t, err)
+	require.NoError(t, ls.Create(ctx, "import-bar-baz"))
+	repo, err := ls.GetRepository(ctx, "import-bar-baz")
+	require.NoError(t, err)
+	r := repo.(*LocalRepository)
+
+	opts := ImportOptions{URL: "file://" + source.path}
+	assert.Equal(t, ErrImportURLInvalid, r.Import(ctx, opts, nil), "file isn't allowed by default")
+	assert.Equal(t, ErrImportURLInvalid, r.Import(ctx, ImportOptions{URL: "--upload-pack=touch /tmp/foo"}, nil))
+
+	protocols := remoteProtocols
+	remoteProtocols = "file"
+	defer func() { remoteProtocols = protocols }()
+
+	assert.Equal(t, ErrImportTooLarge, r.Import(ctx, ImportOptions{URL: opts.URL, MaxSize: 1}, nil))
+
+	require.NoError(t, ls.Delete(ctx, "import-bar-baz"))
+	require.NoError(t, ls.Create(ctx, "import-bar-baz"))
+
+	var progress []string
+	require.NoError(t, r.Import(ctx, opts, func(line string) error {
+		progress = append(progress, line)
+		return nil
+	}))
+	assert.NotEmpty(t, progress)
+
+	refs, err := r.refs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"refs/heads/master":  sha1,
+		"refs/heads/develop": sha1,
+		"refs/tags/v1.0.0":   sha1,
+	}, refs)
+
+	head, err := command.NewSimple(ctx, r.path, "/usr/bin/git", "symbolic-ref", "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, "refs/heads/develop", strings.TrimSpace(head))
+
+	assert.Equal(t, ErrRepoExists, r.Import(ctx, opts, nil), "only empty repositories are imported into")
+}
+
+func TestScanProgress(t *testing.T) {
+	var lines []string
+	err := scanProgress(strings.NewReader("Receiving objects:  50% (1/2)\rReceiving objects: 100% (2/2), done.\n\nfatal: foo\n"), func(line string) error {
+		lines = append(lines, line)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"Receiving objects:  50% (1/2)",
+		"Receiving objects: 100% (2/2), done.",
+		"fatal: foo",
+	}, lines)
+}
@@ -0,0 +1,481 @@
+package storage
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"encoding/gob"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+const (
+	// indexFileName inside the repository holds the search index of its default branch.
+	indexFileName = "sourcepods-index"
+	// indexVersion is increased whenever the index format changes, older indexes are rebuilt.
+	indexVersion = 1
+	// indexMaxFileSize is the biggest file in bytes that is indexed.
+	indexMaxFileSize = 1 << 20
+	// indexMinQuery is the shortest query the index can search for, a single trigram.
+	indexMinQuery = 3
+	// indexCacheSize is the number of indexes kept in memory,
+	// the least recently used ones are loaded from disk again when they're needed.
+	indexCacheSize = 64
+)
+
+// IndexSearchOptions for searching the indexed default branches of repositories.
+type IndexSearchOptions struct {
+	// Query is searched for as fixed string.
+	Query      string
+	IgnoreCase bool
+	// Limit is the maximum number of matches returned per repository, SearchDefaultLimit if 0.
+	Limit int
+}
+
+// IndexMatch is a line matching a search query in one of the searched repositories.
+type IndexMatch struct {
+	ID   string
+	Path string
+	Line int
+	Text string
+}
+
+// trigramIndex maps all trigrams of the indexed files to the files containing them.
+// Trigrams are lower case, so that the index can be searched ignoring case too.
+type trigramIndex struct {
+	mu sync.RWMutex
+
+	Version int
+	// Commit is the indexed commit, empty if nothing has been indexed yet.
+	Commit string
+	// Files are referenced by their position, removed files keep an empty Blob until the index is rebuilt.
+	Files    []indexedFile
+	Removed  int
+	Postings map[uint32][]uint32
+}
+
+type indexedFile struct {
+	Path string
+	Blob string
+}
+
+func (idx *trigramIndex) reset() {
+	idx.Version = indexVersion
+	idx.Commit = ""
+	idx.Files = nil
+	idx.Removed = 0
+	idx.Postings = map[uint32][]uint32{}
+}
+
+func (idx *trigramIndex) add(path, blob string, content []byte) {
+	id := uint32(len(idx.Files))
+	idx.Files = append(idx.Files, indexedFile{Path: path, Blob: blob})
+
+	// Ids only ever grow, posting lists stay sorted by appending.
+	for t := range trigrams(content) {
+		idx.Postings[t] = append(idx.Postings[t], id)
+	}
+}
+
+func (idx *trigramIndex) remove(id uint32, content []byte) {
+	for t := range trigrams(content) {
+		ids := idx.Postings[t]
+		i := sort.Search(len(ids), func(i int) bool { return ids[i] >= id })
+		if i < len(ids) && ids[i] == id {
+			ids = append(ids[:i], ids[i+1:]...)
+		}
+		if len(ids) == 0 {
+			delete(idx.Postings, t)
+		} else {
+			idx.Postings[t] = ids
+		}
+	}
+
+	idx.Files[id].Blob = ""
+	idx.Removed++
+}
+
+// candidates returns the files that contain all trigrams of the query.
+func (idx *trigramIndex) candidates(query string) []indexedFile {
+	var ids []uint32
+	for i, t := range sortedTrigrams([]byte(query)) {
+		postings := idx.Postings[t]
+		if i == 0 {
+			ids = append([]uint32(nil), postings...)
+		} else {
+			ids = intersect(ids, postings)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+	}
+
+	files := make([]indexedFile, 0, len(ids))
+	for _, id := range ids {
+		if f := idx.Files[id]; f.Blob != "" {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+// trigrams returns the set of lower case trigrams in content.
+func trigrams(content []byte) map[uint32]struct{} {
+	set := make(map[uint32]struct{})
+	content = bytes.ToLower(content)
+	for i := 0; i+2 < len(content); i++ {
+		set[uint32(content[i])<<16|uint32(content[i+1])<<8|uint32(content[i+2])] = struct{}{}
+	}
+	return set
+}
+
+func sortedTrigrams(content []byte) []uint32 {
+	set := trigrams(content)
+	sorted := make([]uint32, 0, len(set))
+	for t := range set {
+		sorted = append(sorted, t)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+func intersect(a, b []uint32) []uint32 {
+	var out []uint32
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			out = append(out, a[i])
+			i++
+			j++
+		}
+	}
+	return out
+}
+
+// indexable returns false for content that shouldn't be searched, like binary files.
+func indexable(content []byte) bool {
+	if content == nil {
+		return false
+	}
+	head := content
+	if len(head) > 8000 {
+		head = head[:8000]
+	}
+	return bytes.IndexByte(head, 0) < 0
+}
+
+// indexCache holds the recently used indexes of the repositories of a LocalStorage in memory.
+type indexCache struct {
+	size int
+
+	mu      sync.Mutex
+	indexes map[string]*list.Element
+	// recent has the cached indexes with the most recently used first.
+	recent *list.List
+}
+
+type cachedIndex struct {
+	path  string
+	index *trigramIndex
+}
+
+func newIndexCache(size int) *indexCache {
+	return &indexCache{
+		size:    size,
+		indexes: map[string]*list.Element{},
+		recent:  list.New(),
+	}
+}
+
+// get the index stored at path, loading it from disk if it isn't cached.
+// The least recently used index is dropped from the cache once it's full,
+// it's still stored on disk.
+func (c *indexCache) get(path string) *trigramIndex {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.indexes[path]; ok {
+		c.recent.MoveToFront(e)
+		return e.Value.(*cachedIndex).index
+	}
+
+	idx := &trigramIndex{}
+	if f, err := os.Open(path); err == nil {
+		err = gob.NewDecoder(f).Decode(idx)
+		f.Close()
+		if err != nil || idx.Version != indexVersion {
+			idx = &trigramIndex{}
+		}
+	}
+	if idx.Postings == nil {
+		idx.reset()
+	}
+
+	c.indexes[path] = c.recent.PushFront(&cachedIndex{path: path, index: idx})
+	for c.recent.Len() > c.size {
+		oldest := c.recent.Back()
+		c.recent.Remove(oldest)
+		delete(c.indexes, oldest.Value.(*cachedIndex).path)
+	}
+
+	return idx
+}
+
+// remove the index stored at path from the cache, e.g. after its repository was deleted.
+func (c *indexCache) remove(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.indexes[path]; ok {
+		c.recent.Remove(e)
+		delete(c.indexes, path)
+	}
+}
+
+func (r *LocalRepository) indexPath() string {
+	return filepath.Join(r.path, indexFileName)
+}
+
+// UpdateIndex updates the search index to the commit HEAD points to.
+// Only files changed since the last update are indexed again.
+func (r *LocalRepository) UpdateIndex(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.UpdateIndex")
+	defer span.Finish()
+
+	head, err := r.revParse(ctx, "HEAD^{commit}")
+	if err != nil {
+		// Nothing has been pushed yet.
+		return nil
+	}
+	span.SetTag("sha1", head)
+
+	idx := r.indexes.get(r.indexPath())
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.Commit == head {
+		return nil
+	}
+
+	// Rebuild the index from scratch once most of it is made of removed files.
+	rebuild := idx.Commit == "" || idx.Removed > len(idx.Files)/2
+	if !rebuild {
+		if err := r.updateIndex(ctx, idx, head); err != nil {
+			// The indexed commit might be gone after a force push.
+			injectError(span, err, "")
+			rebuild = true
+		}
+	}
+	if rebuild {
+		span.SetTag("rebuild", true)
+		idx.reset()
+		if err := r.buildIndex(ctx, idx, head); err != nil {
+			idx.reset()
+			return err
+		}
+	}
+
+	idx.Commit = head
+
+	return r.saveIndex(idx)
+}
+
+// buildIndex adds all files of the commit's tree to an empty index.
+func (r *LocalRepository) buildIndex(ctx context.Context, idx *trigramIndex, sha1 string) error {
+	out, err := command.NewSimple(ctx, r.path, r.git, "ls-tree", "-r", "-z", "--full-tree", sha1)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list files: %s", out)
+	}
+
+	var paths, blobs []string
+	for _, entry := range strings.Split(out, "\x00") {
+		// <mode> SP <type> SP <object> TAB <path>
+		line := strings.SplitN(entry, "\t", 2)
+		if len(line) != 2 {
+			continue
+		}
+		fields := strings.Fields(line[0])
+		if len(fields) != 3 || !indexedMode(fields[0]) {
+			continue
+		}
+		paths = append(paths, line[1])
+		blobs = append(blobs, fields[2])
+	}
+
+	return r.readBlobs(ctx, blobs, indexMaxFileSize, func(i int, content []byte) error {
+		if indexable(content) {
+			idx.add(paths[i], blobs[i], content)
+		}
+		return nil
+	})
+}
+
+// updateIndex removes the files changed since the indexed commit from the index and adds them again.
+func (r *LocalRepository) updateIndex(ctx context.Context, idx *trigramIndex, sha1 string) error {
+	out, err := command.NewSimple(ctx, r.path, r.git, "diff-tree", "-r", "-z", "--no-renames", idx.Commit, sha1)
+	if err != nil {
+		return errors.Wrapf(err, "failed to diff trees: %s", out)
+	}
+
+	ids := make(map[string]uint32, len(idx.Files))
+	for id, f := range idx.Files {
+		if f.Blob != "" {
+			ids[f.Path] = uint32(id)
+		}
+	}
+
+	var removed []uint32
+	var paths, blobs []string
+
+	// :<old mode> SP <new mode> SP <old object> SP <new object> SP <status> NUL <path> NUL
+	entries := strings.Split(out, "\x00")
+	for i := 0; i+1 < len(entries); i += 2 {
+		fields := strings.Fields(strings.TrimPrefix(entries[i], ":"))
+		if len(fields) != 5 {
+			continue
+		}
+		path := entries[i+1]
+
+		if id, ok := ids[path]; ok {
+			removed = append(removed, id)
+		}
+		if fields[4] != "D" && indexedMode(fields[1]) {
+			paths = append(paths, path)
+			blobs = append(blobs, fields[3])
+		}
+	}
+
+	// The content of removed files is needed once more to know their trigrams.
+	old := make([]string, len(removed))
+	for i, id := range removed {
+		old[i] = idx.Files[id].Blob
+	}
+	err = r.readBlobs(ctx, old, indexMaxFileSize, func(i int, content []byte) error {
+		idx.remove(removed[i], content)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return r.readBlobs(ctx, blobs, indexMaxFileSize, func(i int, content []byte) error {
+		if indexable(content) {
+			idx.add(paths[i], blobs[i], content)
+		}
+		return nil
+	})
+}
+
+// indexedMode returns true for regular and executable files, but not for symlinks or submodules.
+func indexedMode(mode string) bool {
+	return mode == "100644" || mode == "100755"
+}
+
+// saveIndex writes the index into a temporary file first, so that it's never read half written.
+func (r *LocalRepository) saveIndex(idx *trigramIndex) error {
+	tmp, err := ioutil.TempFile(r.path, indexFileName+"-")
+	if err != nil {
+		return errors.Wrap(err, "failed to create index file")
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := gob.NewEncoder(tmp).Encode(idx); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "failed to write index")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "failed to write index")
+	}
+
+	return os.Rename(tmp.Name(), r.indexPath())
+}
+
+// SearchIndex searches the files indexed by UpdateIndex.
+// The index is built first, if the repository hasn't been indexed yet.
+func (r *LocalRepository) SearchIndex(ctx context.Context, opts IndexSearchOptions) ([]SearchMatch, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.SearchIndex")
+	span.SetTag("query", opts.Query)
+	defer span.Finish()
+
+	if len(opts.Query) < indexMinQuery || strings.ContainsAny(opts.Query, "\n\x00") {
+		return nil, ErrSearchQueryInvalid
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = SearchDefaultLimit
+	}
+	if opts.Limit > SearchMaxLimit {
+		opts.Limit = SearchMaxLimit
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, searchTimeout)
+	defer cancel()
+
+	idx := r.indexes.get(r.indexPath())
+	idx.mu.RLock()
+	indexed := idx.Commit != ""
+	idx.mu.RUnlock()
+
+	if !indexed {
+		if err := r.UpdateIndex(ctx); err != nil {
+			injectError(span, err, "")
+			return nil, err
+		}
+	}
+
+	idx.mu.RLock()
+	files := idx.candidates(opts.Query)
+	idx.mu.RUnlock()
+
+	span.SetTag("candidates", len(files))
+
+	query := opts.Query
+	if opts.IgnoreCase {
+		query = strings.ToLower(query)
+	}
+
+	blobs := make([]string, len(files))
+	for i, f := range files {
+		blobs[i] = f.Blob
+	}
+
+	var matches []SearchMatch
+	errLimit := errors.New("limit reached")
+	err := r.readBlobs(ctx, blobs, indexMaxFileSize, func(i int, content []byte) error {
+		for n, line := range strings.Split(string(content), "\n") {
+			text := line
+			if opts.IgnoreCase {
+				text = strings.ToLower(line)
+			}
+			if !strings.Contains(text, query) {
+				continue
+			}
+
+			matches = append(matches, SearchMatch{Path: files[i].Path, Line: n + 1, Text: line})
+			if len(matches) == opts.Limit {
+				return errLimit
+			}
+		}
+		return nil
+	})
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, ErrSearchTimeout
+	}
+	if err != nil && err != errLimit {
+		injectError(span, err, "")
+		return nil, err
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalRepository_SearchIndex(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	git := func(stdin string, args ...string) string {
+		cmd := exec.Command("/usr/bin/git", args...)
+		cmd.Dir = r.path
+		cmd.Stdin = strings.NewReader(stdin)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Foo Bar", "GIT_AUTHOR_EMAIL=foo@bar.com",
+			"GIT_COMMITTER_NAME=Foo Bar", "GIT_COMMITTER_EMAIL=foo@bar.com",
+		)
+		out, err := cmd.Output()
+		require.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+
+	matches, err := r.SearchIndex(ctx, IndexSearchOptions{Query: "foo"})
+	require.NoError(t, err)
+	assert.Equal(t, []SearchMatch{{Path: "README.md", Line: 1, Text: "# foo"}}, matches)
+
+	// Push a commit changing README.md, adding main.go and a binary file.
+	readme := git("# bar\n", "hash-object", "-w", "--stdin")
+	main := git("package main\n\nfunc FooBar() {}\n", "hash-object", "-w", "--stdin")
+	binary := git("foo\x00bar", "hash-object", "-w", "--stdin")
+	tree := git("100644 blob "+readme+"\tREADME.md\n100644 blob "+main+"\tmain.go\n100644 blob "+binary+"\tfoo.bin\n", "mktree")
+	git("", "update-ref", "refs/heads/master", git("", "commit-tree", tree, "-p", sha1, "-m", "add main.go"))
+
+	require.NoError(t, r.UpdateIndex(ctx))
+
+	idx := r.indexes.get(r.indexPath())
+	assert.Equal(t, 1, idx.Removed, "only README.md should have been reindexed")
+
+	matches, err = r.SearchIndex(ctx, IndexSearchOptions{Query: "foo"})
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+
+	matches, err = r.SearchIndex(ctx, IndexSearchOptions{Query: "foo", IgnoreCase: true})
+	require.NoError(t, err)
+	assert.Equal(t, []SearchMatch{{Path: "main.go", Line: 3, Text: "func FooBar() {}"}}, matches)
+
+	matches, err = r.SearchIndex(ctx, IndexSearchOptions{Query: "bar"})
+	require.NoError(t, err)
+	assert.Equal(t, []SearchMatch{{Path: "README.md", Line: 1, Text: "# bar"}}, matches)
+
+	_, err = r.SearchIndex(ctx, IndexSearchOptions{Query: "fo"})
+	assert.Equal(t, ErrSearchQueryInvalid, err)
+
+	// The index is persisted and loaded by new storages.
+	_, err = os.Stat(r.indexPath())
+	require.NoError(t, err)
+
+	ls, err := NewLocalStorage(storageRoot(r))
+	require.NoError(t, err)
+	repo, err := ls.GetRepository(ctx, "foo-bar-baz")
+	require.NoError(t, err)
+	loaded := repo.(*LocalRepository).indexes.get(r.indexPath())
+	assert.Equal(t, idx.Commit, loaded.Commit)
+
+	matches, err = repo.SearchIndex(ctx, IndexSearchOptions{Query: "FooBar"})
+	require.NoError(t, err)
+	assert.Len(t, matches, 1)
+}
+
+func TestIndexCache(t *testing.T) {
+	c := newIndexCache(2)
+
+	a := c.get("a")
+	b := c.get("b")
+	assert.True(t, a == c.get("a"), "cached indexes are returned again")
+
+	c.get("c")
+	assert.Len(t, c.indexes, 2)
+	assert.True(t, a == c.get("a"), "a was used more recently than b")
+	assert.False(t, b == c.get("b"), "b was dropped and is loaded again")
+
+	c.remove("b")
+	assert.Len(t, c.indexes, 1)
+	assert.Equal(t, 1, c.recent.Len())
+}
+
+// storageRoot returns the root of the LocalStorage the repository was created in.
+func storageRoot(r *LocalRepository) string {
+	return filepath.Dir(filepath.Dir(filepath.Dir(r.path)))
+}
+
+func TestIndexer(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+
+	ls, err := NewLocalStorage(storageRoot(r))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	indexer := NewIndexer(log.NewNopLogger())
+	done := make(chan error)
+	go func() { done <- indexer.Run(ctx, ls) }()
+
+	indexer.Enqueue("foo-bar-baz")
+	indexer.Enqueue("foo-bar-baz")
+
+	idx := ls.indexes.get(r.indexPath())
+	indexed := func() bool {
+		idx.mu.RLock()
+		defer idx.mu.RUnlock()
+		return idx.Commit == sha1
+	}
+	for start := time.Now(); !indexed() && time.Since(start) < 5*time.Second; {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.True(t, indexed())
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+// Indexer updates the search index of repositories in the background after they have been pushed to.
+type Indexer struct {
+	logger log.Logger
+
+	mu      sync.Mutex
+	pending []string
+	queued  map[string]bool
+	wake    chan struct{}
+}
+
+// NewIndexer returns an Indexer, its Enqueue func can be given to PostReceiveOption.
+func NewIndexer(logger log.Logger) *Indexer {
+	return &Indexer{
+		logger: logger,
+		queued: map[string]bool{},
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// Enqueue a repository to be indexed. Repositories pushed to again before
+// they've been indexed are only indexed once.
+func (i *Indexer) Enqueue(id string) {
+	i.mu.Lock()
+	if !i.queued[id] {
+		i.queued[id] = true
+		i.pending = append(i.pending, id)
+	}
+	i.mu.Unlock()
+
+	select {
+	case i.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run indexes the enqueued repositories one after another until ctx is cancelled.
+func (i *Indexer) Run(ctx context.Context, s Storage) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-i.wake:
+		}
+
+		for {
+			i.mu.Lock()
+			if len(i.pending) == 0 {
+				i.mu.Unlock()
+				break
+			}
+			id := i.pending[0]
+			i.pending = i.pending[1:]
+			delete(i.queued, id)
+			i.mu.Unlock()
+
+			i.index(ctx, s, id)
+		}
+	}
+}
+
+func (i *Indexer) index(ctx context.Context, s Storage, id string) {
+	repo, err := s.GetRepository(ctx, id)
+	if err != nil {
+		level.Warn(i.logger).Log("msg", "failed to get repository to index", "id", id, "err", err)
+		return
+	}
+
+	if err := repo.UpdateIndex(ctx); err != nil {
+		level.Warn(i.logger).Log("msg", "failed to update search index", "id", id, "err", err)
+		return
+	}
+
+	level.Debug(i.logger).Log("msg", "updated search index", "id", id)
+}
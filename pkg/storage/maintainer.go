@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+)
+
+// Maintainer maintains repositories in the background, after they have been pushed to
+// and all of them every interval to catch the ones that aren't pushed to anymore.
+type Maintainer struct {
+	interval time.Duration
+	logger   log.Logger
+
+	mu      sync.Mutex
+	pending []string
+	queued  map[string]bool
+	wake    chan struct{}
+}
+
+// NewMaintainer returns a Maintainer, its Enqueue func can be given to PostReceiveOption.
+func NewMaintainer(interval time.Duration, logger log.Logger) *Maintainer {
+	return &Maintainer{
+		interval: interval,
+		logger:   logger,
+		queued:   map[string]bool{},
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// Enqueue a repository to be maintained if it needs to be.
+// Repositories pushed to again before they've been maintained are only maintained once.
+func (m *Maintainer) Enqueue(id string) {
+	m.mu.Lock()
+	if !m.queued[id] {
+		m.queued[id] = true
+		m.pending = append(m.pending, id)
+	}
+	m.mu.Unlock()
+
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run maintains the enqueued repositories one after another
+// and enqueues all repositories of s every interval until ctx is cancelled.
+func (m *Maintainer) Run(ctx context.Context, s *LocalStorage) error {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			ids, err := s.repositories()
+			if err != nil {
+				level.Warn(m.logger).Log("msg", "failed to list repositories to maintain", "err", err)
+				continue
+			}
+			for _, id := range ids {
+				m.Enqueue(id)
+			}
+		case <-m.wake:
+		}
+
+		for ctx.Err() == nil {
+			m.mu.Lock()
+			if len(m.pending) == 0 {
+				m.mu.Unlock()
+				break
+			}
+			id := m.pending[0]
+			m.pending = m.pending[1:]
+			delete(m.queued, id)
+			m.mu.Unlock()
+
+			m.maintain(ctx, s, id)
+		}
+	}
+}
+
+func (m *Maintainer) maintain(ctx context.Context, s Storage, id string) {
+	repo, err := s.GetRepository(ctx, id)
+	if err != nil {
+		level.Warn(m.logger).Log("msg", "failed to get repository to maintain", "id", id, "err", err)
+		return
+	}
+
+	status, err := repo.Maintain(ctx, MaintenanceOptions{})
+	if err == ErrMaintenanceBusy {
+		// It's being pushed to and is going to be enqueued again afterwards.
+		return
+	}
+	if err != nil {
+		level.Warn(m.logger).Log("msg", "failed to maintain repository", "id", id, "err", err)
+		return
+	}
+
+	level.Debug(m.logger).Log(
+		"msg", "maintained repository",
+		"id", id,
+		"last_run", status.LastRun,
+		"tasks", strings.Join(status.Tasks, ","),
+		"loose_objects", status.LooseObjects,
+		"packs", status.Packs,
+	)
+}
+
+// repositories returns the ids of all repositories, without dashes as they aren't part of their paths.
+func (s *LocalStorage) repositories() ([]string, error) {
+	dirs, err := filepath.Glob(filepath.Join(s.root, "*", "*", "*"))
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, dir := range dirs {
+		if strings.HasSuffix(dir, ".deleted") {
+			continue
+		}
+		rel, err := filepath.Rel(s.root, dir)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, strings.Replace(rel, string(filepath.Separator), "", -1))
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,379 @@
+package storage
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+// Tasks of the maintenance of a repository.
+const (
+	// MaintenanceRepack packs all objects into a single pack with a bitmap index and packs the refs.
+	MaintenanceRepack = "repack"
+	// MaintenancePrune deletes unreachable loose objects older than maintenancePruneExpire.
+	MaintenancePrune = "prune"
+	// MaintenanceCommitGraph writes the commit-graph speeding up history walks.
+	MaintenanceCommitGraph = "commit-graph"
+	// MaintenanceFsck checks the connectivity and validity of all objects.
+	MaintenanceFsck = "fsck"
+	// MaintenanceCaches deletes expired archives and leftover temporary files.
+	MaintenanceCaches = "caches"
+)
+
+// maintenanceFileName inside the repository holds its MaintenanceStatus.
+const maintenanceFileName = "sourcepods-maintenance"
+
+// The heuristics deciding which tasks a repository needs, changed by tests.
+var (
+	// maintenanceMaxLoose is how many loose objects a repository may have before it's repacked.
+	maintenanceMaxLoose int64 = 1000
+	// maintenanceMaxPacks is how many packs a repository may have before it's repacked.
+	maintenanceMaxPacks int64 = 10
+	// maintenanceFsckInterval is how often a repository is checked by fsck.
+	maintenanceFsckInterval = 7 * 24 * time.Hour
+	// maintenancePruneExpire keeps unreachable objects that are still being pushed or were recently.
+	maintenancePruneExpire = "2.weeks.ago"
+	// maintenanceArchiveExpiry is how long archives stay cached after they have been generated.
+	maintenanceArchiveExpiry = 7 * 24 * time.Hour
+	// maintenanceTmpExpiry is the age of temporary files that aren't being written anymore.
+	maintenanceTmpExpiry = time.Hour
+)
+
+// MaintenanceOptions for maintaining a repository.
+type MaintenanceOptions struct {
+	// Force runs all tasks, even if the heuristics say the repository doesn't need them.
+	Force bool
+}
+
+// MaintenanceStatus of a repository, kept across runs.
+type MaintenanceStatus struct {
+	// LastRun is when the maintenance last ran tasks, successful or not.
+	LastRun     time.Time `json:"last_run"`
+	LastSuccess time.Time `json:"last_success"`
+	LastFsck    time.Time `json:"last_fsck"`
+	// LastError is why the last run failed, empty if it succeeded.
+	LastError string `json:"last_error"`
+	// Tasks run the last time.
+	Tasks []string `json:"tasks"`
+	// LooseObjects and Packs are counted after the last run.
+	LooseObjects int64 `json:"loose_objects"`
+	Packs        int64 `json:"packs"`
+}
+
+// pushLocks keeps maintenance and pushes into the same repository from running at the same time.
+// Pushes wait for a running maintenance, but maintenance never waits for pushes and is skipped instead.
+// Repositories are identified by their path, ids might be given with or without dashes.
+type pushLocks struct {
+	mu         sync.Mutex
+	cond       *sync.Cond
+	pushes     map[string]int
+	maintained map[string]bool
+}
+
+func newPushLocks() *pushLocks {
+	l := &pushLocks{
+		pushes:     map[string]int{},
+		maintained: map[string]bool{},
+	}
+	l.cond = sync.NewCond(&l.mu)
+	return l
+}
+
+// push waits until the repository isn't maintained and returns the func to call once pushed.
+func (l *pushLocks) push(path string) func() {
+	l.mu.Lock()
+	for l.maintained[path] {
+		l.cond.Wait()
+	}
+	l.pushes[path]++
+	l.mu.Unlock()
+
+	return func() {
+		l.mu.Lock()
+		l.pushes[path]--
+		if l.pushes[path] == 0 {
+			delete(l.pushes, path)
+		}
+		l.mu.Unlock()
+	}
+}
+
+// maintain returns the func to call once maintained, or false if the repository is being pushed to or maintained.
+func (l *pushLocks) maintain(path string) (func(), bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.pushes[path] > 0 || l.maintained[path] {
+		return nil, false
+	}
+	l.maintained[path] = true
+
+	return func() {
+		l.mu.Lock()
+		delete(l.maintained, path)
+		l.mu.Unlock()
+		l.cond.Broadcast()
+	}, true
+}
+
+// lockPush waits for a running maintenance of the repository, see pushLocks.
+func (r *LocalRepository) lockPush() func() {
+	if r.locks == nil {
+		return func() {}
+	}
+	return r.locks.push(r.path)
+}
+
+// Maintain the repository by running the tasks it needs according to its object counts and status.
+// It returns ErrMaintenanceBusy without doing anything while the repository is pushed to.
+// A failing task doesn't stop the others, the first error is returned and kept in the status.
+func (r *LocalRepository) Maintain(ctx context.Context, opts MaintenanceOptions) (MaintenanceStatus, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.Maintain")
+	span.SetTag("id", r.id)
+	span.SetTag("force", opts.Force)
+	defer span.Finish()
+
+	if r.locks != nil {
+		done, ok := r.locks.maintain(r.path)
+		if !ok {
+			return MaintenanceStatus{}, ErrMaintenanceBusy
+		}
+		defer done()
+	}
+
+	status, err := r.MaintenanceStatus(ctx)
+	if err != nil {
+		return status, err
+	}
+
+	counts, err := r.countObjects(ctx)
+	if err != nil {
+		injectError(span, err, "")
+		return status, err
+	}
+
+	tasks := r.maintenanceTasks(status, counts, opts)
+	span.SetTag("tasks", strings.Join(tasks, ","))
+	if len(tasks) == 0 {
+		return status, nil
+	}
+
+	var firstErr error
+	for _, task := range tasks {
+		if err := r.runMaintenanceTask(ctx, task); err != nil {
+			injectError(span, err, "")
+			if firstErr == nil {
+				firstErr = errors.Wrapf(err, "failed to %s", task)
+			}
+			continue
+		}
+		if task == MaintenanceFsck {
+			status.LastFsck = time.Now()
+		}
+	}
+
+	now := time.Now()
+	status.LastRun = now
+	status.Tasks = tasks
+	status.LastError = ""
+	if firstErr != nil {
+		status.LastError = firstErr.Error()
+	} else {
+		status.LastSuccess = now
+	}
+	if counts, err := r.countObjects(ctx); err == nil {
+		status.LooseObjects = counts["count"]
+		status.Packs = counts["packs"]
+	}
+
+	if err := r.writeMaintenanceStatus(status); err != nil && firstErr == nil {
+		firstErr = err
+	}
+
+	return status, firstErr
+}
+
+// maintenanceTasks the repository needs, in the order they need to run.
+func (r *LocalRepository) maintenanceTasks(status MaintenanceStatus, counts map[string]int64, opts MaintenanceOptions) []string {
+	if opts.Force {
+		return []string{MaintenanceRepack, MaintenancePrune, MaintenanceCommitGraph, MaintenanceFsck, MaintenanceCaches}
+	}
+
+	var tasks []string
+	repack := counts["count"] > maintenanceMaxLoose || counts["packs"] > maintenanceMaxPacks
+	if repack {
+		tasks = append(tasks, MaintenanceRepack, MaintenancePrune)
+	}
+	if _, err := os.Stat(filepath.Join(r.path, "objects", "info", "commit-graph")); repack || os.IsNotExist(err) {
+		if counts["count"] > 0 || counts["packs"] > 0 {
+			tasks = append(tasks, MaintenanceCommitGraph)
+		}
+	}
+	if time.Since(status.LastFsck) > maintenanceFsckInterval {
+		tasks = append(tasks, MaintenanceFsck)
+	}
+	if len(tasks) > 0 || time.Since(status.LastRun) > maintenanceArchiveExpiry {
+		tasks = append(tasks, MaintenanceCaches)
+	}
+
+	return tasks
+}
+
+func (r *LocalRepository) runMaintenanceTask(ctx context.Context, task string) error {
+	switch task {
+	case MaintenanceRepack:
+		return r.repack(ctx)
+	case MaintenancePrune:
+		// Repositories forks borrow objects from keep them, see Fork.
+		if r.keepsUnreachable(ctx) {
+			return nil
+		}
+		return r.runGit(ctx, "prune", "--expire="+maintenancePruneExpire)
+	case MaintenanceCommitGraph:
+		return r.runGit(ctx, "commit-graph", "write", "--reachable")
+	case MaintenanceFsck:
+		return r.runGit(ctx, "fsck", "--no-progress", "--no-dangling")
+	case MaintenanceCaches:
+		return r.cleanCaches()
+	}
+	return errors.Errorf("unknown task %s", task)
+}
+
+// repack all objects into one pack, the refs too.
+func (r *LocalRepository) repack(ctx context.Context) error {
+	args := []string{"repack", "-a", "-d", "-q"}
+	if _, err := os.Stat(filepath.Join(r.path, "objects", "info", "alternates")); err == nil {
+		// Forks only pack their own objects, without -l the borrowed ones would be copied.
+		// Bitmaps need all objects in the pack, so forks can't have them.
+		args = append(args, "-l")
+	} else {
+		args = append(args, "--write-bitmap-index")
+	}
+	if r.keepsUnreachable(ctx) {
+		args = append(args, "--keep-unreachable")
+	}
+
+	if err := r.runGit(ctx, args...); err != nil {
+		return err
+	}
+
+	return r.runGit(ctx, "pack-refs", "--all", "--prune")
+}
+
+// keepsUnreachable returns true if forks borrow objects of the repository,
+// which then never prunes unreachable objects.
+func (r *LocalRepository) keepsUnreachable(ctx context.Context) bool {
+	out, err := command.NewSimple(ctx, r.path, r.git, "config", "gc.pruneExpire")
+	return err == nil && strings.TrimSpace(out) == "never"
+}
+
+// cleanCaches deletes archives that haven't been generated recently
+// and temporary files of archives and search indexes that are left over.
+func (r *LocalRepository) cleanCaches() error {
+	now := time.Now()
+
+	archives, err := ioutil.ReadDir(filepath.Join(r.path, archiveCacheDir))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, fi := range archives {
+		expiry := maintenanceArchiveExpiry
+		if strings.HasPrefix(fi.Name(), "tmp-") {
+			expiry = maintenanceTmpExpiry
+		}
+		if now.Sub(fi.ModTime()) > expiry {
+			os.Remove(filepath.Join(r.path, archiveCacheDir, fi.Name()))
+		}
+	}
+
+	tmps, err := filepath.Glob(filepath.Join(r.path, indexFileName+"-*"))
+	if err != nil {
+		return err
+	}
+	for _, tmp := range tmps {
+		if fi, err := os.Stat(tmp); err == nil && now.Sub(fi.ModTime()) > maintenanceTmpExpiry {
+			os.Remove(tmp)
+		}
+	}
+
+	return nil
+}
+
+// countObjects returns the counts of git count-objects -v by their name, e.g. count and packs.
+func (r *LocalRepository) countObjects(ctx context.Context) (map[string]int64, error) {
+	out, err := command.NewSimple(ctx, r.path, r.git, "count-objects", "-v")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to count objects: %s", out)
+	}
+
+	counts := map[string]int64{}
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ": ", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		if n, err := strconv.ParseInt(parts[1], 10, 64); err == nil {
+			counts[parts[0]] = n
+		}
+	}
+
+	return counts, scanner.Err()
+}
+
+func (r *LocalRepository) runGit(ctx context.Context, args ...string) error {
+	out, err := command.NewSimple(ctx, r.path, r.git, args...)
+	if err != nil {
+		return errors.Wrapf(err, "%s", strings.TrimSpace(out))
+	}
+	return nil
+}
+
+// MaintenanceStatus of the repository, empty if it has never been maintained.
+func (r *LocalRepository) MaintenanceStatus(ctx context.Context) (MaintenanceStatus, error) {
+	var status MaintenanceStatus
+
+	data, err := ioutil.ReadFile(filepath.Join(r.path, maintenanceFileName))
+	if os.IsNotExist(err) {
+		return status, nil
+	}
+	if err != nil {
+		return status, err
+	}
+
+	return status, json.Unmarshal(data, &status)
+}
+
+func (r *LocalRepository) writeMaintenanceStatus(status MaintenanceStatus) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(r.path, maintenanceFileName+"-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if _, err := tmp.Write(data); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filepath.Join(r.path, maintenanceFileName))
+}
@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalRepository_Maintain(t *testing.T) {
+	r, _, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	defer func(loose int64) { maintenanceMaxLoose = loose }(maintenanceMaxLoose)
+	maintenanceMaxLoose = 2
+
+	status, err := r.MaintenanceStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, MaintenanceStatus{}, status)
+
+	status, err = r.Maintain(ctx, MaintenanceOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{MaintenanceRepack, MaintenancePrune, MaintenanceCommitGraph, MaintenanceFsck, MaintenanceCaches}, status.Tasks)
+	assert.Empty(t, status.LastError)
+	assert.Equal(t, int64(0), status.LooseObjects)
+	assert.Equal(t, int64(1), status.Packs)
+	assert.False(t, status.LastFsck.IsZero())
+	assert.Equal(t, status.LastRun, status.LastSuccess)
+
+	bitmaps, err := filepath.Glob(filepath.Join(r.path, "objects", "pack", "*.bitmap"))
+	require.NoError(t, err)
+	assert.Len(t, bitmaps, 1)
+	_, err = os.Stat(filepath.Join(r.path, "objects", "info", "commit-graph"))
+	assert.NoError(t, err)
+
+	// Nothing is run while the repository doesn't need it.
+	again, err := r.Maintain(ctx, MaintenanceOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, status.Tasks, again.Tasks)
+	assert.True(t, status.LastRun.Equal(again.LastRun))
+
+	saved, err := r.MaintenanceStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, status.Tasks, saved.Tasks)
+	assert.True(t, status.LastRun.Equal(saved.LastRun))
+
+	forced, err := r.Maintain(ctx, MaintenanceOptions{Force: true})
+	require.NoError(t, err)
+	assert.Len(t, forced.Tasks, 5)
+
+	branch, err := r.GetCommit(ctx, "master")
+	require.NoError(t, err)
+	assert.Equal(t, "second commit", branch.Message)
+}
+
+func TestLocalRepository_MaintainCaches(t *testing.T) {
+	r, _, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	old := time.Now().Add(-30 * 24 * time.Hour)
+	files := map[string]bool{
+		filepath.Join(r.path, archiveCacheDir, "old.tar.gz"): false,
+		filepath.Join(r.path, archiveCacheDir, "new.tar.gz"): true,
+		filepath.Join(r.path, archiveCacheDir, "tmp-123"):    false,
+		filepath.Join(r.path, indexFileName+"-123"):          false,
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(r.path, archiveCacheDir), 0755))
+	for path, kept := range files {
+		require.NoError(t, ioutil.WriteFile(path, nil, 0644))
+		if !kept {
+			require.NoError(t, os.Chtimes(path, old, old))
+		}
+	}
+
+	_, err := r.Maintain(ctx, MaintenanceOptions{Force: true})
+	require.NoError(t, err)
+
+	for path, kept := range files {
+		_, err := os.Stat(path)
+		assert.Equal(t, kept, err == nil, path)
+	}
+}
+
+func TestLocalRepository_MaintainBusy(t *testing.T) {
+	r, _, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	unlock := r.lockPush()
+	_, err := r.Maintain(ctx, MaintenanceOptions{Force: true})
+	assert.Equal(t, ErrMaintenanceBusy, err)
+	unlock()
+
+	// Pushes wait for the maintenance to finish.
+	done, ok := r.locks.maintain(r.path)
+	require.True(t, ok)
+	_, ok = r.locks.maintain(r.path)
+	assert.False(t, ok)
+
+	pushed := make(chan struct{})
+	go func() {
+		r.lockPush()()
+		close(pushed)
+	}()
+
+	select {
+	case <-pushed:
+		t.Fatal("push didn't wait for the maintenance")
+	case <-time.After(50 * time.Millisecond):
+	}
+	done()
+	<-pushed
+
+	_, err = r.Maintain(ctx, MaintenanceOptions{})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,300 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+// Strategies to merge head into a branch.
+const (
+	// MergeCommit creates a merge commit with the branch and head as parents.
+	MergeCommit = "merge"
+	// MergeSquash creates a single commit with all changes of head on top of the branch.
+	MergeSquash = "squash"
+	// MergeRebase replays every commit of head on top of the branch, merge commits are dropped.
+	MergeRebase = "rebase"
+)
+
+var (
+	// ErrMergeStrategyInvalid is returned for unknown merge strategies
+	ErrMergeStrategyInvalid = fmt.Errorf("merge strategy is not valid")
+	// ErrMergeConflict is returned if head can't be merged without conflicts
+	ErrMergeConflict = fmt.Errorf("merge has conflicts")
+	// ErrNothingToMerge is returned if the branch already contains all commits of head
+	ErrNothingToMerge = fmt.Errorf("nothing to merge")
+	// ErrSignatureInvalid is returned if a signature lacks a name, an email or a date
+	ErrSignatureInvalid = fmt.Errorf("signature is not valid")
+)
+
+// MergeOptions describe how head is merged into a branch.
+type MergeOptions struct {
+	// Branch is the name of the branch that's updated.
+	Branch string
+	// Head is the rev that's merged into the branch.
+	Head string
+	// Sha1 is the commit the branch is expected to point to, if empty the current one is used.
+	Sha1 string
+	// Strategy is MergeCommit, MergeSquash or MergeRebase.
+	Strategy string
+	// Message of the merge or squashed commit, rebased commits keep their messages.
+	Message string
+	// Author of the merge or squashed commit, the committer if empty.
+	// Rebased commits keep their authors.
+	Author    Signature
+	Committer Signature
+}
+
+// MergeResult is the outcome of a merge.
+type MergeResult struct {
+	// Sha1 is the commit the branch points to after the merge.
+	Sha1 string
+	// Conflicts are the paths of the files that can't be merged.
+	Conflicts []string
+}
+
+// Merge head into the branch with the given strategy, without touching any worktree.
+// The branch is only updated if it still points to the expected sha1, otherwise ErrRefChanged is returned.
+// If head can't be merged ErrMergeConflict is returned together with the conflicting paths.
+func (r *LocalRepository) Merge(ctx context.Context, opts MergeOptions) (MergeResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.Merge")
+	span.SetTag("branch", opts.Branch)
+	span.SetTag("head", opts.Head)
+	span.SetTag("strategy", opts.Strategy)
+	defer span.Finish()
+
+	switch opts.Strategy {
+	case MergeCommit, MergeSquash, MergeRebase:
+	default:
+		return MergeResult{}, ErrMergeStrategyInvalid
+	}
+	if opts.Committer.Validate() != nil {
+		return MergeResult{}, ErrSignatureInvalid
+	}
+	if opts.Author.Name == "" && opts.Author.Email == "" {
+		opts.Author = opts.Committer
+	} else if opts.Author.Validate() != nil {
+		return MergeResult{}, ErrSignatureInvalid
+	}
+
+	// The merge writes objects, which mustn't be pruned by maintenance before the branch points to them.
+	unlock := r.lockPush()
+	defer unlock()
+
+	base, err := r.revParse(ctx, branchPrefix+opts.Branch)
+	if err != nil {
+		return MergeResult{}, ErrBranchNotFound
+	}
+	if opts.Sha1 != "" && opts.Sha1 != base {
+		return MergeResult{}, ErrRefChanged
+	}
+	head, err := r.revParse(ctx, opts.Head+"^{commit}")
+	if err != nil {
+		return MergeResult{}, err
+	}
+
+	if out, err := command.NewSimple(ctx, r.path, r.git, "merge-base", "--is-ancestor", head, base); err == nil {
+		return MergeResult{}, ErrNothingToMerge
+	} else if exitErr, ok := errors.Cause(err).(*exec.ExitError); !ok || exitErr.ExitCode() != 1 {
+		injectError(span, err, out)
+		return MergeResult{}, errors.Wrapf(err, "failed to check ancestry: %s", out)
+	}
+	if out, err := command.NewSimple(ctx, r.path, r.git, "merge-base", base, head); err != nil {
+		if exitErr, ok := errors.Cause(err).(*exec.ExitError); ok && exitErr.ExitCode() == 1 {
+			// Unrelated histories can't be merged.
+			return MergeResult{}, ErrMergeConflict
+		}
+		injectError(span, err, out)
+		return MergeResult{}, errors.Wrapf(err, "failed to find merge base: %s", out)
+	}
+
+	message := opts.Message
+	if message == "" {
+		message = fmt.Sprintf("Merge %s into %s", opts.Head, opts.Branch)
+	}
+
+	var sha1 string
+	var conflicts []string
+	switch opts.Strategy {
+	case MergeCommit, MergeSquash:
+		var tree string
+		tree, conflicts, err = r.mergeTree(ctx, base, head)
+		if err != nil || len(conflicts) > 0 {
+			break
+		}
+		parents := []string{base, head}
+		if opts.Strategy == MergeSquash {
+			if baseTree, err := r.revParse(ctx, base+"^{tree}"); err == nil && baseTree == tree {
+				// head was squashed before.
+				return MergeResult{}, ErrNothingToMerge
+			}
+			parents = parents[:1]
+		}
+		sha1, err = r.writeCommit(ctx, tree, parents, opts.Author.String(), opts.Committer.String(), message)
+	case MergeRebase:
+		sha1, conflicts, err = r.rebase(ctx, base, head, opts.Committer)
+	}
+	if err != nil {
+		if err != ErrMergeConflict && err != ErrNothingToMerge {
+			injectError(span, err, "")
+		}
+		return MergeResult{}, err
+	}
+	if len(conflicts) > 0 {
+		return MergeResult{Conflicts: conflicts}, ErrMergeConflict
+	}
+
+	if err := r.updateRefs(ctx, fmt.Sprintf("update %s %s %s", branchPrefix+opts.Branch, sha1, base)); err != nil {
+		injectError(span, err, "")
+		return MergeResult{}, err
+	}
+	r.refsUpdated(RefUpdate{Ref: branchPrefix + opts.Branch, Old: base, New: sha1})
+
+	return MergeResult{Sha1: sha1}, nil
+}
+
+// mergeTree writes the tree of merging theirs into ours and returns it,
+// unless there are conflicts, which are returned instead.
+func (r *LocalRepository) mergeTree(ctx context.Context, ours, theirs string) (string, []string, error) {
+	errBuf := &bytes.Buffer{}
+	outBuf := &bytes.Buffer{}
+	args := []string{"merge-tree", "--write-tree", "--name-only", "--no-messages", "-z", ours, theirs}
+	cmd, err := command.New(ctx, r.path, r.git, args, command.StdoutWriter(outBuf), command.StderrWriter(errBuf))
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to run git merge-tree")
+	}
+
+	err = cmd.Wait()
+	// <tree> NUL <path> NUL <path> NUL ...
+	fields := strings.Split(strings.TrimRight(outBuf.String(), "\x00"), "\x00")
+	if err != nil {
+		exitErr, ok := errors.Cause(err).(*exec.ExitError)
+		if !ok || exitErr.ExitCode() != 1 {
+			return "", nil, errors.Wrapf(err, "failed to merge: %s", errBuf.String())
+		}
+
+		var conflicts []string
+		for i, path := range fields {
+			if i == 0 || path == "" || path == fields[i-1] {
+				continue
+			}
+			conflicts = append(conflicts, path)
+		}
+		return "", conflicts, nil
+	}
+
+	return fields[0], nil, nil
+}
+
+// rebase replays the non-merge commits reachable from head but not from base on top of base.
+// Like git rebase, commits whose changes are already in base are skipped.
+// Each commit is cherry-picked by merging it with a temporary commit that has the tree
+// rebased so far and the commit's parent as its only parent, so that parent is the merge base.
+// This func returns the last rebased commit or the conflicts of the first commit that can't be replayed.
+func (r *LocalRepository) rebase(ctx context.Context, base, head string, committer Signature) (string, []string, error) {
+	out, err := command.NewSimple(ctx, r.path, r.git, "rev-list", "--reverse", "--topo-order", "--no-merges",
+		"--cherry-pick", "--right-only", base+"..."+head,
+	)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to list commits: %s", out)
+	}
+
+	current := base
+	for _, commit := range strings.Fields(out) {
+		raw, err := command.NewSimple(ctx, r.path, r.git, "cat-file", "commit", commit)
+		if err != nil {
+			return "", nil, errors.Wrapf(err, "failed to read commit: %s", raw)
+		}
+		headers, message := splitCommit(raw)
+
+		parent := headers["parent"]
+		if parent == "" {
+			// Commits without parent can't be replayed as there's nothing to compare them with.
+			return "", nil, ErrMergeConflict
+		}
+
+		onto, err := r.revParse(ctx, current+"^{tree}")
+		if err != nil {
+			return "", nil, err
+		}
+		ours, err := r.writeCommit(ctx, onto, []string{parent}, committer.String(), committer.String(), "rebase")
+		if err != nil {
+			return "", nil, err
+		}
+
+		tree, conflicts, err := r.mergeTree(ctx, ours, commit)
+		if err != nil || len(conflicts) > 0 {
+			return "", conflicts, err
+		}
+		if tree == onto {
+			// The commit's changes are already there.
+			continue
+		}
+
+		current, err = r.writeCommit(ctx, tree, []string{current}, headers["author"], committer.String(), message)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if current == base {
+		return "", nil, ErrNothingToMerge
+	}
+	return current, nil, nil
+}
+
+// splitCommit returns the first value of every header of a raw commit object and its message.
+func splitCommit(raw string) (map[string]string, string) {
+	headers := map[string]string{}
+	parts := strings.SplitN(raw, "\n\n", 2)
+	for _, line := range strings.Split(parts[0], "\n") {
+		kv := strings.SplitN(line, " ", 2)
+		if len(kv) != 2 || strings.HasPrefix(line, " ") {
+			continue
+		}
+		if _, ok := headers[kv[0]]; !ok {
+			headers[kv[0]] = kv[1]
+		}
+	}
+	if len(parts) == 2 {
+		return headers, parts[1]
+	}
+	return headers, ""
+}
+
+// writeCommit writes a commit object and returns its sha1.
+// author and committer are formatted like Signature.String().
+func (r *LocalRepository) writeCommit(ctx context.Context, tree string, parents []string, author, committer, message string) (string, error) {
+	content := &bytes.Buffer{}
+	fmt.Fprintf(content, "tree %s\n", tree)
+	for _, parent := range parents {
+		fmt.Fprintf(content, "parent %s\n", parent)
+	}
+	fmt.Fprintf(content, "author %s\ncommitter %s\n\n%s", author, committer, message)
+	if !strings.HasSuffix(message, "\n") {
+		content.WriteString("\n")
+	}
+
+	outBuf := &bytes.Buffer{}
+	errBuf := &bytes.Buffer{}
+	args := []string{"hash-object", "-t", "commit", "-w", "--stdin"}
+	cmd, err := command.New(ctx, r.path, r.git, args,
+		command.StdinWriter(content),
+		command.StdoutWriter(outBuf),
+		command.StderrWriter(errBuf),
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to run git hash-object")
+	}
+	if err := cmd.Wait(); err != nil {
+		return "", errors.Wrapf(err, "failed to write commit: %s", errBuf.String())
+	}
+
+	return strings.TrimSpace(outBuf.String()), nil
+}
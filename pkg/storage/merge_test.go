@@ -0,0 +1,161 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcepods/sourcepods/pkg/command"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalRepository_Merge(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	master := testCommit(t, r, sha1, "update readme", map[string]string{
+		"README.md": "# foo\nbar\n",
+	})
+	first := testCommit(t, r, sha1, "add main", map[string]string{
+		"README.md": "# foo\n",
+		"main.go":   "package main\n",
+	})
+	second := testCommit(t, r, first, "add func main\n\nwith a body", map[string]string{
+		"README.md": "# foo\n",
+		"main.go":   "package main\n\nfunc main() {}\n",
+	})
+	clash := testCommit(t, r, sha1, "rewrite readme", map[string]string{
+		"README.md": "# baz\n",
+	})
+	for _, name := range []string{MergeCommit, MergeSquash, MergeRebase} {
+		_, err := r.CreateBranch(ctx, name, master)
+		require.NoError(t, err)
+	}
+
+	committer := Signature{Name: "Baz Qux", Email: "baz@qux.com", Date: time.Unix(1549843200, 0).In(time.FixedZone("", 3600))}
+	opts := func(strategy, head string) MergeOptions {
+		return MergeOptions{Branch: strategy, Head: head, Strategy: strategy, Message: "Merge feature", Committer: committer}
+	}
+	mainGo, err := r.Blob(ctx, second, "main.go")
+	require.NoError(t, err)
+	readme, err := r.Blob(ctx, master, "README.md")
+	require.NoError(t, err)
+	// merged checks that rev has the changes of both the branch and head.
+	merged := func(rev string) {
+		b, err := r.Blob(ctx, rev, "main.go")
+		require.NoError(t, err)
+		assert.Equal(t, mainGo.Sha1, b.Sha1)
+		b, err = r.Blob(ctx, rev, "README.md")
+		require.NoError(t, err)
+		assert.Equal(t, readme.Sha1, b.Sha1)
+	}
+
+	res, err := r.Merge(ctx, opts(MergeCommit, second))
+	require.NoError(t, err)
+	merged(res.Sha1)
+	commits, err := r.ListCommits(ctx, master, res.Sha1, 0)
+	require.NoError(t, err)
+	require.Len(t, commits, 3)
+	assert.Equal(t, []string{first, second}, []string{commits[0].Hash, commits[1].Hash})
+	assert.Equal(t, master, commits[2].Parent)
+	assert.Equal(t, "Merge feature", commits[2].Message)
+	assert.Equal(t, "Baz Qux", commits[2].Author.Name)
+	assert.Equal(t, committer.Date.Unix(), commits[2].Committer.Date.Unix())
+
+	res, err = r.Merge(ctx, opts(MergeSquash, second))
+	require.NoError(t, err)
+	commits, err = r.ListCommits(ctx, master, res.Sha1, 0)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	assert.Equal(t, master, commits[0].Parent)
+	assert.Equal(t, "Merge feature", commits[0].Message)
+	raw, err := command.NewSimple(ctx, r.path, r.git, "cat-file", "commit", res.Sha1)
+	require.NoError(t, err)
+	headers, _ := splitCommit(raw)
+	assert.Equal(t, committer.String(), headers["author"])
+	assert.Equal(t, committer.String(), headers["committer"])
+	merged(res.Sha1)
+
+	res, err = r.Merge(ctx, opts(MergeRebase, second))
+	require.NoError(t, err)
+	commits, err = r.ListCommits(ctx, master, res.Sha1, 0)
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	assert.Equal(t, master, commits[0].Parent)
+	assert.Equal(t, commits[0].Hash, commits[1].Parent)
+	assert.Equal(t, "add func main", commits[1].Message)
+	assert.Equal(t, "with a body", strings.TrimSpace(commits[1].Body))
+	assert.Equal(t, "Foo Bar", commits[1].Author.Name)
+	assert.Equal(t, "baz@qux.com", commits[1].Committer.Email)
+	merged(res.Sha1)
+
+	for _, strategy := range []string{MergeCommit, MergeSquash, MergeRebase} {
+		current, err := r.revParse(ctx, branchPrefix+strategy)
+		require.NoError(t, err)
+
+		res, err := r.Merge(ctx, opts(strategy, clash))
+		assert.Equal(t, ErrMergeConflict, err, strategy)
+		assert.Equal(t, []string{"README.md"}, res.Conflicts, strategy)
+
+		_, err = r.Merge(ctx, opts(strategy, second))
+		assert.Equal(t, ErrNothingToMerge, err, strategy)
+
+		after, err := r.revParse(ctx, branchPrefix+strategy)
+		require.NoError(t, err)
+		assert.Equal(t, current, after, strategy)
+	}
+
+	o := opts(MergeCommit, clash)
+	o.Sha1 = master
+	_, err = r.Merge(ctx, o)
+	assert.Equal(t, ErrRefChanged, err)
+
+	_, err = r.Merge(ctx, opts("octopus", second))
+	assert.Equal(t, ErrMergeStrategyInvalid, err)
+	_, err = r.Merge(ctx, MergeOptions{Branch: "master", Head: second, Strategy: MergeCommit})
+	assert.Equal(t, ErrSignatureInvalid, err)
+	o = opts(MergeCommit, second)
+	o.Branch = "unknown"
+	_, err = r.Merge(ctx, o)
+	assert.Equal(t, ErrBranchNotFound, err)
+}
+
+func TestLocalRepository_MergeEvents(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	var received []string
+	r.postReceive = func(id string) { received = append(received, id) }
+	r.events = NewEvents()
+	pushes, unsubscribe := r.events.Subscribe()
+	defer unsubscribe()
+
+	committer := Signature{Name: "Baz Qux", Email: "baz@qux.com", Date: time.Now()}
+	head := testCommit(t, r, sha1, "add main", map[string]string{"main.go": "package main\n"})
+	opts := MergeOptions{Branch: "master", Head: head, Strategy: MergeCommit, Committer: committer}
+
+	// Merges wait for the maintenance to finish.
+	done, ok := r.locks.maintain(r.path)
+	require.True(t, ok)
+	merged := make(chan MergeResult)
+	go func() {
+		res, err := r.Merge(ctx, opts)
+		assert.NoError(t, err)
+		merged <- res
+	}()
+	select {
+	case <-merged:
+		t.Fatal("merged during the maintenance")
+	case <-time.After(50 * time.Millisecond):
+	}
+	done()
+	res := <-merged
+
+	e := <-pushes
+	assert.Equal(t, []RefUpdate{{Ref: "refs/heads/master", Old: sha1, New: res.Sha1}}, e.Refs)
+	assert.Equal(t, []string{r.id}, received)
+}
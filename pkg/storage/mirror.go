@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+// Directions repositories are mirrored in.
+const (
+	// MirrorPull fetches the remote's branches and tags into the repository.
+	MirrorPull = "pull"
+	// MirrorPush pushes all refs of the repository to the remote.
+	MirrorPush = "push"
+)
+
+// mirrorTimeout stops mirroring without a timeout of its own.
+var mirrorTimeout = 30 * time.Minute
+
+// MirrorOptions configure the remote a repository is mirrored with.
+type MirrorOptions struct {
+	Direction string
+	URL       string
+	// Username and Password authenticate against http(s) URLs if not empty.
+	Username string
+	Password string
+
+	// Timeout stops mirroring if it takes longer, zero uses the default of the storage.
+	Timeout time.Duration
+}
+
+// Mirror the repository with the remote at opts.URL.
+// MirrorPull fetches all branches and tags and deletes the ones deleted on the remote,
+// updated refs are published like a push.
+// MirrorPush pushes all refs and deletes the ones on the remote that don't exist anymore.
+func (r *LocalRepository) Mirror(ctx context.Context, opts MirrorOptions) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.Mirror")
+	span.SetTag("direction", opts.Direction)
+	span.SetTag("url", opts.URL)
+	defer span.Finish()
+
+	var args []string
+	switch opts.Direction {
+	case MirrorPull:
+		args = []string{"fetch", "--prune", "--quiet", "--", opts.URL, "+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*"}
+	case MirrorPush:
+		args = []string{"push", "--mirror", "--quiet", "--", opts.URL}
+	default:
+		return ErrMirrorDirectionInvalid
+	}
+	if !remoteAllowed(opts.URL) {
+		return ErrMirrorURLInvalid
+	}
+
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = mirrorTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	before, err := r.refs(ctx)
+	if err != nil {
+		injectError(span, err, "")
+		return errors.Wrap(err, "failed to list refs")
+	}
+
+	unlock := r.lockPush()
+	defer unlock()
+	errBuf := &bytes.Buffer{}
+	cmd, err := command.New(ctx, r.path, r.git, args,
+		command.Env(remoteEnv(opts.Username, opts.Password)...),
+		command.StderrWriter(errBuf),
+	)
+	if err != nil {
+		injectError(span, err, "")
+		return err
+	}
+
+	if err := cmd.Wait(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return ErrMirrorTimeout
+		}
+		injectError(span, err, errBuf.String())
+		lines := strings.Split(strings.TrimSpace(errBuf.String()), "\n")
+		return errors.Wrapf(err, "failed to %s: %s", args[0], lines[len(lines)-1])
+	}
+
+	if opts.Direction == MirrorPush {
+		return nil
+	}
+
+	after, err := r.refs(ctx)
+	if err != nil {
+		level.Warn(r.logger).Log("msg", "failed to list refs after mirroring", "id", r.id, "err", err)
+		return nil
+	}
+
+	updates := refUpdates(before, after)
+	if len(updates) == 0 {
+		return nil
+	}
+
+	r.refsUpdated(updates...)
+
+	return nil
+}
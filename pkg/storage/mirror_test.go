@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalRepository_Mirror(t *testing.T) {
+	source, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	events := NewEvents()
+	pushes, unsubscribe := events.Subscribe()
+	defer unsubscribe()
+
+	ls, err := NewLocalStorage(storageRoot(source), EventsOption(events))
+	require.NoError(t, err)
+
+	create := func(id string) *LocalRepository {
+		require.NoError(t, ls.Create(ctx, id))
+		repo, err := ls.GetRepository(ctx, id)
+		require.NoError(t, err)
+		return repo.(*LocalRepository)
+	}
+	pull := create("pull-bar-baz")
+	push := create("push-bar-baz")
+
+	url := "file://" + source.path
+	assert.Equal(t, ErrMirrorURLInvalid, pull.Mirror(ctx, MirrorOptions{Direction: MirrorPull, URL: url}))
+
+	protocols := remoteProtocols
+	remoteProtocols = "file"
+	defer func() { remoteProtocols = protocols }()
+
+	assert.Equal(t, ErrMirrorDirectionInvalid, pull.Mirror(ctx, MirrorOptions{Direction: "both", URL: url}))
+
+	require.NoError(t, pull.Mirror(ctx, MirrorOptions{Direction: MirrorPull, URL: url}))
+	refs, err := pull.refs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"refs/heads/master": sha1}, refs)
+
+	e := <-pushes
+	assert.Equal(t, PushEvent{ID: "pull-bar-baz", Refs: []RefUpdate{{Ref: "refs/heads/master", New: sha1}}, Pushed: e.Pushed}, e)
+
+	// Branches deleted on the remote are pruned.
+	_, err = source.CreateBranch(ctx, "feature", "master")
+	require.NoError(t, err)
+	require.NoError(t, source.SetDefaultBranch(ctx, "feature"))
+	require.NoError(t, source.DeleteBranch(ctx, "master", sha1))
+
+	require.NoError(t, pull.Mirror(ctx, MirrorOptions{Direction: MirrorPull, URL: url}))
+	refs, err = pull.refs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"refs/heads/feature": sha1}, refs)
+
+	e = <-pushes
+	assert.Equal(t, []RefUpdate{{Ref: "refs/heads/feature", New: sha1}, {Ref: "refs/heads/master", Old: sha1}}, e.Refs)
+
+	// Pushing mirrors all refs of the repository to the remote.
+	require.NoError(t, pull.Mirror(ctx, MirrorOptions{Direction: MirrorPush, URL: "file://" + push.path}))
+	refs, err = push.refs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"refs/heads/feature": sha1}, refs)
+}
@@ -0,0 +1,234 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+// Limits for Search, so that a single query can't keep a storage node busy.
+const (
+	SearchDefaultLimit = 100
+	SearchMaxLimit     = 1000
+	SearchMaxContext   = 5
+)
+
+var (
+	// searchTimeout stops git grep on huge repositories or expensive patterns.
+	searchTimeout = 10 * time.Second
+	// searchContextMaxSize is the biggest file in bytes context lines are returned for.
+	searchContextMaxSize int64 = 1 << 20
+)
+
+// SearchOptions for searching the tree of a commit.
+type SearchOptions struct {
+	Query string
+	// Regexp interprets Query as POSIX extended regular expression, instead of a fixed string.
+	Regexp     bool
+	IgnoreCase bool
+	// Paths are globs, only files matching at least one of them are searched.
+	Paths []string
+	// Limit is the maximum number of matches returned, SearchDefaultLimit if 0.
+	Limit int
+	// Context is the number of lines returned before and after each match.
+	Context int
+}
+
+// SearchMatch is a line matching a search query.
+type SearchMatch struct {
+	Path string
+	// Line number starting at 1.
+	Line   int
+	Text   string
+	Before []string
+	After  []string
+}
+
+// Search runs git grep on the tree of the commit rev resolves to.
+func (r *LocalRepository) Search(ctx context.Context, rev string, opts SearchOptions) ([]SearchMatch, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.Search")
+	span.SetTag("rev", rev)
+	span.SetTag("query", opts.Query)
+	span.SetTag("regexp", opts.Regexp)
+	defer span.Finish()
+
+	if opts.Query == "" || strings.ContainsAny(opts.Query, "\n\x00") {
+		return nil, ErrSearchQueryInvalid
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = SearchDefaultLimit
+	}
+	if opts.Limit > SearchMaxLimit {
+		opts.Limit = SearchMaxLimit
+	}
+	if opts.Context < 0 {
+		opts.Context = 0
+	}
+	if opts.Context > SearchMaxContext {
+		opts.Context = SearchMaxContext
+	}
+
+	sha1, err := r.revParse(ctx, rev+"^{commit}")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, searchTimeout)
+	defer cancel()
+
+	matches, err := r.grep(ctx, sha1, opts)
+	if err == nil && opts.Context > 0 && len(matches) > 0 {
+		err = r.searchContext(ctx, sha1, opts.Context, matches)
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, ErrSearchTimeout
+	}
+	if err != nil {
+		injectError(span, err, "")
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// grep returns the first opts.Limit matches of git grep without any context.
+func (r *LocalRepository) grep(ctx context.Context, sha1 string, opts SearchOptions) ([]SearchMatch, error) {
+	args := []string{"grep", "--null", "--line-number", "--full-name", "-I", "--no-color"}
+	if opts.Regexp {
+		args = append(args, "--extended-regexp")
+	} else {
+		args = append(args, "--fixed-strings")
+	}
+	if opts.IgnoreCase {
+		args = append(args, "--ignore-case")
+	}
+	args = append(args, "-e", opts.Query, sha1, "--")
+	for _, p := range opts.Paths {
+		args = append(args, ":(glob)"+strings.TrimPrefix(p, "/"))
+	}
+
+	// Stop git once enough matches have been read.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errBuf := &bytes.Buffer{}
+	cmd, err := command.New(ctx, r.path, r.git, args,
+		command.StdoutPipe,
+		command.StderrWriter(errBuf),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []SearchMatch
+	reader := bufio.NewReader(cmd.Stdout())
+	for len(matches) < opts.Limit {
+		line, err := reader.ReadString('\n')
+		if err == io.EOF && line == "" {
+			break
+		}
+		if err != nil && err != io.EOF {
+			cancel()
+			cmd.Wait()
+			return nil, err
+		}
+
+		// <sha1>:<path> NUL <line number> NUL <text>
+		fields := strings.SplitN(strings.TrimSuffix(line, "\n"), "\x00", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		n, err := strconv.Atoi(fields[1])
+		if err != nil {
+			continue
+		}
+		matches = append(matches, SearchMatch{
+			Path: strings.TrimPrefix(fields[0], sha1+":"),
+			Line: n,
+			Text: fields[2],
+		})
+	}
+
+	if len(matches) == opts.Limit {
+		cancel()
+		cmd.Wait()
+		return matches, nil
+	}
+
+	if err := cmd.Wait(); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if exitErr, ok := errors.Cause(err).(*exec.ExitError); ok {
+			switch exitErr.ExitCode() {
+			case 1: // Nothing matched.
+				return nil, nil
+			case 128: // The rev exists, so the pattern or a path is invalid.
+				return nil, ErrSearchQueryInvalid
+			}
+		}
+		return nil, errors.Wrapf(err, "failed to grep: %s", errBuf.String())
+	}
+
+	return matches, nil
+}
+
+// searchContext adds the lines before and after each match.
+// git grep --context can't be parsed reliably, as the separators of matches and context lines
+// are the same with --null. That's why all files with matches are read once more.
+func (r *LocalRepository) searchContext(ctx context.Context, sha1 string, n int, matches []SearchMatch) error {
+	var paths, objects []string
+	for i, m := range matches {
+		if i == 0 || matches[i-1].Path != m.Path {
+			paths = append(paths, m.Path)
+			objects = append(objects, sha1+":"+m.Path)
+		}
+	}
+
+	files := make(map[string][]string, len(paths))
+	err := r.readBlobs(ctx, objects, searchContextMaxSize, func(i int, content []byte) error {
+		if content != nil {
+			files[paths[i]] = strings.Split(string(content), "\n")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, m := range matches {
+		lines, ok := files[m.Path]
+		if !ok {
+			continue
+		}
+		// Line numbers start at 1, the index of the match's line is m.Line-1.
+		start := m.Line - 1 - n
+		if start < 0 {
+			start = 0
+		}
+		end := m.Line + n
+		if end > len(lines) {
+			end = len(lines)
+		}
+		// Files ending with a newline have an empty last element.
+		if end == len(lines) && end > 0 && lines[end-1] == "" {
+			end--
+		}
+		if m.Line < 1 || m.Line > end {
+			continue
+		}
+		matches[i].Before = lines[start : m.Line-1]
+		matches[i].After = lines[m.Line:end]
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalRepository_Search(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	git := func(stdin string, args ...string) string {
+		cmd := exec.Command("/usr/bin/git", args...)
+		cmd.Dir = r.path
+		cmd.Stdin = strings.NewReader(stdin)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Foo Bar", "GIT_AUTHOR_EMAIL=foo@bar.com",
+			"GIT_COMMITTER_NAME=Foo Bar", "GIT_COMMITTER_EMAIL=foo@bar.com",
+		)
+		out, err := cmd.Output()
+		require.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+
+	readme := git("# foo\n", "hash-object", "-w", "--stdin")
+	main := git("package main\n\n// Foo does foo.\nfunc Foo() {}\n\nfunc bar() {}\n", "hash-object", "-w", "--stdin")
+	cmd := git("100644 blob "+main+"\tmain.go\n", "mktree")
+	tree := git("100644 blob "+readme+"\tREADME.md\n040000 tree "+cmd+"\tcmd\n", "mktree")
+	git("", "update-ref", "refs/heads/master", git("", "commit-tree", tree, "-p", sha1, "-m", "add main.go"))
+
+	matches, err := r.Search(ctx, "master", SearchOptions{Query: "foo"})
+	require.NoError(t, err)
+	assert.Equal(t, []SearchMatch{
+		{Path: "README.md", Line: 1, Text: "# foo"},
+		{Path: "cmd/main.go", Line: 3, Text: "// Foo does foo."},
+	}, matches)
+
+	matches, err = r.Search(ctx, "master", SearchOptions{Query: "foo", IgnoreCase: true, Paths: []string{"**/*.go"}, Context: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []SearchMatch{
+		{Path: "cmd/main.go", Line: 3, Text: "// Foo does foo.", Before: []string{""}, After: []string{"func Foo() {}"}},
+		{Path: "cmd/main.go", Line: 4, Text: "func Foo() {}", Before: []string{"// Foo does foo."}, After: []string{""}},
+	}, matches)
+
+	matches, err = r.Search(ctx, "master", SearchOptions{Query: "^func [a-z]+\\(", Regexp: true, Context: 5})
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, 6, matches[0].Line)
+	assert.Equal(t, []string{"package main", "", "// Foo does foo.", "func Foo() {}", ""}, matches[0].Before)
+	assert.Empty(t, matches[0].After)
+
+	matches, err = r.Search(ctx, "master", SearchOptions{Query: "func", Limit: 1})
+	require.NoError(t, err)
+	assert.Len(t, matches, 1)
+
+	matches, err = r.Search(ctx, "master", SearchOptions{Query: "nothing"})
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+
+	// The initial commit doesn't have main.go yet.
+	matches, err = r.Search(ctx, sha1, SearchOptions{Query: "func"})
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+
+	_, err = r.Search(ctx, "master", SearchOptions{Query: "foo(", Regexp: true})
+	assert.Equal(t, ErrSearchQueryInvalid, err)
+
+	_, err = r.Search(ctx, "master", SearchOptions{})
+	assert.Equal(t, ErrSearchQueryInvalid, err)
+
+	_, err = r.Search(ctx, "unknown", SearchOptions{Query: "foo"})
+	assert.Equal(t, ErrRevNotFound, err)
+}
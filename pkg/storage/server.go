@@ -2,16 +2,23 @@ package storage
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
+	"gitlab.com/gitlab-org/gitaly/streamio"
 	"google.golang.org/grpc"
 )
 
-// NewStorageServer returns a grpc.Server serving Storage
-func NewStorageServer(storage Storage) *grpc.Server {
+// NewStorageServer returns a grpc.Server serving Storage and its Events,
+// errors that aren't returned to clients are logged with the logger.
+func NewStorageServer(storage Storage, events *Events, logger log.Logger) *grpc.Server {
 	var opts []grpc.ServerOption
 	opts = append(opts, grpc.UnaryInterceptor(grpcopentracing.UnaryServerInterceptor()))
 	opts = append(opts, grpc.StreamInterceptor(grpcopentracing.StreamServerInterceptor()))
@@ -20,8 +27,11 @@ func NewStorageServer(storage Storage) *grpc.Server {
 
 	RegisterRepositoryServer(s, &repositoryServer{storage: storage})
 	RegisterBranchServer(s, &branchesServer{storage: storage})
+	RegisterIndexServer(s, &indexServer{storage: storage, logger: logger})
 	RegisterCommitServer(s, &commitServer{storage: storage})
 	RegisterSSHServer(s, &sshService{storage: storage})
+	RegisterMaintenanceServer(s, &maintenanceServer{storage: storage})
+	RegisterEventsServer(s, &eventsServer{events: events})
 
 	return s
 }
@@ -34,6 +44,84 @@ func (s *repositoryServer) Create(ctx context.Context, req *CreateRequest) (*emp
 	return &empty.Empty{}, s.storage.Create(ctx, req.GetId())
 }
 
+func (s *repositoryServer) Fork(ctx context.Context, req *ForkRequest) (*empty.Empty, error) {
+	if err := s.storage.Fork(ctx, req.GetId(), req.GetSourceId()); err != nil {
+		return nil, errorStatus(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *repositoryServer) Delete(ctx context.Context, req *DeleteRequest) (*empty.Empty, error) {
+	if err := s.storage.Delete(ctx, req.GetId()); err != nil {
+		return nil, errorStatus(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *repositoryServer) FetchRef(ctx context.Context, req *FetchRefRequest) (*FetchRefResponse, error) {
+	sha1, err := s.storage.FetchRef(ctx, req.GetId(), req.GetSourceId(), req.GetSourceRef(), req.GetRef())
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+	return &FetchRefResponse{Sha1: sha1}, nil
+}
+
+func (s *repositoryServer) Dissociate(ctx context.Context, req *DissociateRequest) (*empty.Empty, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+	if err := repo.Dissociate(ctx); err != nil {
+		return nil, errorStatus(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *repositoryServer) Import(req *ImportRequest, stream Repository_ImportServer) error {
+	ctx := stream.Context()
+
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return grpc.Errorf(codes.NotFound, "%v", err)
+	}
+
+	opts := ImportOptions{
+		URL:      req.GetUrl(),
+		Username: req.GetUsername(),
+		Password: req.GetPassword(),
+		Timeout:  time.Duration(req.GetTimeoutSeconds()) * time.Second,
+		MaxSize:  req.GetMaxSize(),
+	}
+	err = repo.Import(ctx, opts, func(line string) error {
+		return stream.Send(&ImportResponse{Progress: line})
+	})
+	if err != nil {
+		return errorStatus(err)
+	}
+
+	return nil
+}
+
+func (s *repositoryServer) Mirror(ctx context.Context, req *MirrorRequest) (*empty.Empty, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+
+	err = repo.Mirror(ctx, MirrorOptions{
+		Direction: req.GetDirection(),
+		URL:       req.GetUrl(),
+		Username:  req.GetUsername(),
+		Password:  req.GetPassword(),
+		Timeout:   time.Duration(req.GetTimeoutSeconds()) * time.Second,
+	})
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (s *repositoryServer) SetDescriptions(ctx context.Context, req *SetDescriptionRequest) (*empty.Empty, error) {
 	repo, err := s.storage.GetRepository(ctx, req.GetId())
 	if err != nil {
@@ -51,18 +139,346 @@ func (s *branchesServer) List(ctx context.Context, req *BranchesRequest) (*Branc
 	if err != nil {
 		return nil, grpc.Errorf(codes.NotFound, "%v", err)
 	}
-	branches, err := repo.ListBranches(ctx)
+	opts := ListBranchesOptions{
+		Prefix: req.GetPrefix(),
+		Sort:   req.GetSort(),
+		Base:   req.GetBase(),
+		After:  req.GetAfter(),
+		Limit:  int(req.GetLimit()),
+	}
+	if req.GetAfterCommitted() != 0 {
+		opts.AfterCommitted = time.Unix(req.GetAfterCommitted(), 0)
+	}
+
+	branches, err := repo.ListBranches(ctx, opts)
 	if err != nil {
 		return nil, grpc.Errorf(codes.Internal, "%v", err)
 	}
 
 	res := &BranchesResponse{}
 	for _, b := range branches {
-		res.Branch = append(res.Branch, &BranchResponse{
-			Name: b.Name,
-			Sha1: b.Sha1,
-			Type: b.Type,
+		res.Branch = append(res.Branch, branchResponse(b))
+	}
+
+	return res, nil
+}
+
+func (s *branchesServer) Create(ctx context.Context, req *CreateBranchRequest) (*BranchResponse, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+	b, err := repo.CreateBranch(ctx, req.GetName(), req.GetRev())
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	return branchResponse(b), nil
+}
+
+func (s *branchesServer) Delete(ctx context.Context, req *DeleteBranchRequest) (*empty.Empty, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+	if err := repo.DeleteBranch(ctx, req.GetName(), req.GetSha1()); err != nil {
+		return nil, errorStatus(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *branchesServer) Rename(ctx context.Context, req *RenameBranchRequest) (*BranchResponse, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+	b, err := repo.RenameBranch(ctx, req.GetName(), req.GetNewName())
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	return branchResponse(b), nil
+}
+
+func (s *branchesServer) SetDefault(ctx context.Context, req *SetDefaultBranchRequest) (*empty.Empty, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+	if err := repo.SetDefaultBranch(ctx, req.GetName()); err != nil {
+		return nil, errorStatus(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *branchesServer) Merge(ctx context.Context, req *MergeRequest) (*MergeResponse, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+	res, err := repo.Merge(ctx, MergeOptions{
+		Branch:    req.GetBranch(),
+		Head:      req.GetHead(),
+		Sha1:      req.GetSha1(),
+		Strategy:  req.GetStrategy(),
+		Message:   req.GetMessage(),
+		Author:    signatureFromRequest(req.GetAuthor()),
+		Committer: signatureFromRequest(req.GetCommitter()),
+	})
+	// Conflicts are sent as part of the response, the client returns them with ErrMergeConflict.
+	if err != nil && (err != ErrMergeConflict || len(res.Conflicts) == 0) {
+		return nil, errorStatus(err)
+	}
+
+	return &MergeResponse{Sha1: res.Sha1, Conflicts: res.Conflicts}, nil
+}
+
+func signatureFromRequest(req *SignatureRequest) Signature {
+	if req == nil {
+		return Signature{}
+	}
+	return Signature{
+		Name:  req.GetName(),
+		Email: req.GetEmail(),
+		Date:  time.Unix(req.GetDate(), 0).In(time.FixedZone("", int(req.GetOffset()))),
+	}
+}
+
+func branchResponse(b Branch) *BranchResponse {
+	return &BranchResponse{
+		Name:        b.Name,
+		Sha1:        b.Sha1,
+		Type:        b.Type,
+		Subject:     b.Subject,
+		Author:      b.Author.Name,
+		AuthorEmail: b.Author.Email,
+		AuthorDate:  b.Author.Date.Unix(),
+		Ahead:       int32(b.Ahead),
+		Behind:      int32(b.Behind),
+		Committed:   b.Committed.Unix(),
+	}
+}
+
+// errorStatus returns the errors known to the client with a matching status code.
+// The client turns these back into the same errors with statusError.
+func errorStatus(err error) error {
+	switch err {
+	case ErrRevNotFound, ErrBranchNotFound, ErrPathNotFound, ErrRepoNotValid:
+		return status.Error(codes.NotFound, err.Error())
+	case ErrBranchExists, ErrRepoExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case ErrBranchNameInvalid, ErrArchiveFormatInvalid, ErrSearchQueryInvalid, ErrMergeStrategyInvalid, ErrSignatureInvalid, ErrImportURLInvalid, ErrMirrorURLInvalid, ErrMirrorDirectionInvalid:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case ErrRefChanged, ErrMaintenanceBusy:
+		return status.Error(codes.Aborted, err.Error())
+	case ErrBlobTooLarge, ErrMergeConflict, ErrNothingToMerge, ErrImportTooLarge:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case ErrBlameTimeout, ErrSearchTimeout, ErrImportTimeout, ErrMirrorTimeout:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (s *repositoryServer) Archive(req *ArchiveRequest, stream Repository_ArchiveServer) error {
+	ctx := stream.Context()
+
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return grpc.Errorf(codes.NotFound, "%v", err)
+	}
+
+	w := streamio.NewWriter(func(p []byte) error {
+		return stream.Send(&ArchiveResponse{Data: p})
+	})
+
+	if err := repo.Archive(ctx, req.GetRev(), req.GetFormat(), req.GetPrefix(), w); err != nil {
+		return errorStatus(err)
+	}
+
+	return nil
+}
+
+func (s *repositoryServer) Blob(ctx context.Context, req *BlobRequest) (*BlobResponse, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+
+	b, err := repo.Blob(ctx, req.GetRev(), req.GetPath())
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	return &BlobResponse{Sha1: b.Sha1, Mode: b.Mode, Size: b.Size}, nil
+}
+
+func (s *repositoryServer) ReadBlob(req *ReadBlobRequest, stream Repository_ReadBlobServer) error {
+	ctx := stream.Context()
+
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return grpc.Errorf(codes.NotFound, "%v", err)
+	}
+
+	w := streamio.NewWriter(func(p []byte) error {
+		return stream.Send(&ReadBlobResponse{Data: p})
+	})
+
+	if err := repo.ReadBlob(ctx, req.GetSha1(), req.GetOffset(), req.GetLimit(), w); err != nil {
+		return errorStatus(err)
+	}
+
+	return nil
+}
+
+func (s *repositoryServer) Blame(req *BlameRequest, stream Repository_BlameServer) error {
+	ctx := stream.Context()
+
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return grpc.Errorf(codes.NotFound, "%v", err)
+	}
+
+	err = repo.Blame(ctx, req.GetRev(), req.GetPath(), func(h BlameHunk) error {
+		return stream.Send(&BlameResponse{
+			Sha1:        h.Sha1,
+			Summary:     h.Summary,
+			Author:      h.Author.Name,
+			AuthorEmail: h.Author.Email,
+			AuthorDate:  h.Author.Date.Unix(),
+			Line:        int32(h.Line),
+			Lines:       h.Lines,
 		})
+	})
+	if err != nil {
+		return errorStatus(err)
+	}
+
+	return nil
+}
+
+func (s *repositoryServer) Search(ctx context.Context, req *SearchRequest) (*SearchResponse, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+
+	matches, err := repo.Search(ctx, req.GetRev(), SearchOptions{
+		Query:      req.GetQuery(),
+		Regexp:     req.GetRegexp(),
+		IgnoreCase: req.GetIgnoreCase(),
+		Paths:      req.GetPaths(),
+		Limit:      int(req.GetLimit()),
+		Context:    int(req.GetContext()),
+	})
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	res := &SearchResponse{}
+	for _, m := range matches {
+		res.Matches = append(res.Matches, &SearchMatchResponse{
+			Path:   m.Path,
+			Line:   int32(m.Line),
+			Text:   m.Text,
+			Before: m.Before,
+			After:  m.After,
+		})
+	}
+
+	return res, nil
+}
+
+// indexSearchWorkers is the number of repositories searched concurrently.
+const indexSearchWorkers = 8
+
+func (s *repositoryServer) Stats(ctx context.Context, req *StatsRequest) (*StatsResponse, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+
+	stats, err := repo.Stats(ctx)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	res := &StatsResponse{
+		Size:          stats.Size,
+		LooseObjects:  stats.LooseObjects,
+		PackedObjects: stats.PackedObjects,
+		Commits:       stats.Commits,
+	}
+	for _, l := range stats.Languages {
+		res.Languages = append(res.Languages, &LanguageResponse{Name: l.Name, Bytes: l.Bytes})
+	}
+
+	return res, nil
+}
+
+type indexServer struct {
+	storage Storage
+	logger  log.Logger
+}
+
+func (s *indexServer) Search(ctx context.Context, req *IndexSearchRequest) (*IndexSearchResponse, error) {
+	opts := IndexSearchOptions{
+		Query:      req.GetQuery(),
+		IgnoreCase: req.GetIgnoreCase(),
+		Limit:      int(req.GetLimit()),
+	}
+	if len(opts.Query) < indexMinQuery {
+		return nil, errorStatus(ErrSearchQueryInvalid)
+	}
+
+	ids := req.GetIds()
+	results := make([][]SearchMatch, len(ids))
+	errs := make([]error, len(ids))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < indexSearchWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				repo, err := s.storage.GetRepository(ctx, ids[i])
+				if err != nil {
+					continue // Repositories that don't exist (yet) have no matches.
+				}
+				results[i], errs[i] = repo.SearchIndex(ctx, opts)
+			}
+		}()
+	}
+	for i := range ids {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	res := &IndexSearchResponse{}
+	for i, matches := range results {
+		// A single broken repository shouldn't fail searching all the others.
+		if errs[i] == ErrSearchTimeout {
+			return nil, errorStatus(errs[i])
+		}
+		if errs[i] != nil {
+			level.Warn(s.logger).Log(
+				"msg", "failed to search repository index",
+				"id", ids[i],
+				"err", errs[i],
+			)
+		}
+		for _, m := range matches {
+			res.Matches = append(res.Matches, &IndexMatchResponse{
+				Id:   ids[i],
+				Path: m.Path,
+				Line: int32(m.Line),
+				Text: m.Text,
+			})
+		}
 	}
 
 	return res, nil
@@ -82,6 +498,10 @@ func (s *commitServer) Get(ctx context.Context, req *CommitRequest) (*CommitResp
 		return nil, grpc.Errorf(codes.Internal, "%v", err)
 	}
 
+	return commitResponse(c), nil
+}
+
+func commitResponse(c Commit) *CommitResponse {
 	return &CommitResponse{
 		Hash:           c.Hash,
 		Tree:           c.Tree,
@@ -93,8 +513,70 @@ func (s *commitServer) Get(ctx context.Context, req *CommitRequest) (*CommitResp
 		Committer:      c.Committer.Name,
 		CommitterEmail: c.Committer.Email,
 		CommitterDate:  c.Committer.Date.Unix(),
-	}, nil
+	}
+}
+
+func (s *commitServer) List(ctx context.Context, req *CommitsRequest) (*CommitsResponse, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+	commits, err := repo.ListCommits(ctx, req.GetBase(), req.GetHead(), int(req.GetLimit()))
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	res := &CommitsResponse{}
+	for _, c := range commits {
+		res.Commits = append(res.Commits, commitResponse(c))
+	}
 
+	return res, nil
+}
+
+func (s *commitServer) Diff(ctx context.Context, req *DiffRequest) (*DiffResponse, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+	diff, err := repo.Diff(ctx, req.GetBase(), req.GetHead())
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	res := &DiffResponse{Truncated: diff.Truncated}
+	for _, f := range diff.Files {
+		res.Files = append(res.Files, &FileDiffResponse{
+			Path:      f.Path,
+			OldPath:   f.OldPath,
+			Status:    f.Status,
+			Binary:    f.Binary,
+			Additions: int32(f.Additions),
+			Deletions: int32(f.Deletions),
+			Patch:     f.Patch,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *commitServer) Mergeable(ctx context.Context, req *MergeableRequest) (*MergeableResponse, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+	m, err := repo.Mergeable(ctx, req.GetBase(), req.GetHead())
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	return &MergeableResponse{
+		Base:      m.Base,
+		Head:      m.Head,
+		MergeBase: m.MergeBase,
+		Mergeable: m.Mergeable,
+		Conflicts: m.Conflicts,
+	}, nil
 }
 
 func (s *repositoryServer) Tree(ctx context.Context, req *TreeRequest) (*TreeResponse, error) {
@@ -119,3 +601,79 @@ func (s *repositoryServer) Tree(ctx context.Context, req *TreeRequest) (*TreeRes
 
 	return &TreeResponse{TreeEntries: treeEntryRes}, nil
 }
+
+type maintenanceServer struct {
+	storage Storage
+}
+
+func (s *maintenanceServer) Maintain(ctx context.Context, req *MaintainRequest) (*MaintenanceStatusResponse, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+
+	status, err := repo.Maintain(ctx, MaintenanceOptions{Force: req.GetForce()})
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	return maintenanceStatusResponse(status), nil
+}
+
+func (s *maintenanceServer) Status(ctx context.Context, req *MaintenanceStatusRequest) (*MaintenanceStatusResponse, error) {
+	repo, err := s.storage.GetRepository(ctx, req.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.NotFound, "%v", err)
+	}
+
+	status, err := repo.MaintenanceStatus(ctx)
+	if err != nil {
+		return nil, errorStatus(err)
+	}
+
+	return maintenanceStatusResponse(status), nil
+}
+
+func maintenanceStatusResponse(status MaintenanceStatus) *MaintenanceStatusResponse {
+	// Zero times are sent as zero, not as the seconds from year 1 to 1970.
+	unix := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.Unix()
+	}
+
+	return &MaintenanceStatusResponse{
+		LastRun:      unix(status.LastRun),
+		LastSuccess:  unix(status.LastSuccess),
+		LastFsck:     unix(status.LastFsck),
+		LastError:    status.LastError,
+		Tasks:        status.Tasks,
+		LooseObjects: status.LooseObjects,
+		Packs:        status.Packs,
+	}
+}
+
+type eventsServer struct {
+	events *Events
+}
+
+func (s *eventsServer) Pushes(req *PushesRequest, stream Events_PushesServer) error {
+	pushes, unsubscribe := s.events.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case p := <-pushes:
+			res := &PushResponse{Id: p.ID, Pushed: p.Pushed.Unix()}
+			for _, u := range p.Refs {
+				res.Refs = append(res.Refs, &RefUpdateResponse{Ref: u.Ref, Old: u.Old, New: u.New})
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -18,7 +18,7 @@ type sshService struct {
 // NOTE: #namingThings. And this does more than it should... for "simplicity"
 func validateRepoGRERequest(ctx context.Context, s Storage, req *GRERequest, errIn error) (Repository, error) {
 	if errIn != nil {
-		return nil, status.Errorf(codes.Internal, errIn.Error())
+		return nil, status.Error(codes.Internal, errIn.Error())
 	}
 	if len(req.GetId()) == 0 {
 		return nil, status.Errorf(codes.FailedPrecondition, "no repo id given")
@@ -60,7 +60,7 @@ func (s sshService) UploadPack(stream SSH_UploadPackServer) error {
 	)
 
 	if err != nil {
-		return status.Errorf(codes.Internal, err.Error())
+		return status.Error(codes.Internal, err.Error())
 	}
 	return stream.Send(&GREResponse{ExitCode: &GREExitCode{ExitCode: ec}})
 }
@@ -91,7 +91,7 @@ func (s sshService) ReceivePack(stream SSH_ReceivePackServer) error {
 	)
 
 	if err != nil {
-		return status.Errorf(codes.Internal, err.Error())
+		return status.Error(codes.Internal, err.Error())
 	}
 	return stream.Send(&GREResponse{ExitCode: &GREExitCode{ExitCode: ec}})
 }
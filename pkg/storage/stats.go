@@ -0,0 +1,189 @@
+package storage
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/sourcepods/sourcepods/pkg/command"
+)
+
+// Stats of a repository.
+type Stats struct {
+	// Size of all objects on disk in bytes, without the ones borrowed by forks.
+	Size          int64
+	LooseObjects  int64
+	PackedObjects int64
+	// Commits is the number of commits reachable from the default branch.
+	Commits int64
+	// Languages of the files on the default branch, most bytes first.
+	Languages []Language
+}
+
+// Language and the bytes of all files written in it.
+type Language struct {
+	Name  string
+	Bytes int64
+}
+
+// languages by the extension of files, without the dot, or the name of files without one.
+var languages = map[string]string{
+	"c":          "C",
+	"h":          "C",
+	"cc":         "C++",
+	"cpp":        "C++",
+	"cxx":        "C++",
+	"hpp":        "C++",
+	"cs":         "C#",
+	"clj":        "Clojure",
+	"coffee":     "CoffeeScript",
+	"css":        "CSS",
+	"dart":       "Dart",
+	"Dockerfile": "Dockerfile",
+	"ex":         "Elixir",
+	"exs":        "Elixir",
+	"elm":        "Elm",
+	"erl":        "Erlang",
+	"go":         "Go",
+	"groovy":     "Groovy",
+	"hs":         "Haskell",
+	"html":       "HTML",
+	"htm":        "HTML",
+	"java":       "Java",
+	"js":         "JavaScript",
+	"jsx":        "JavaScript",
+	"mjs":        "JavaScript",
+	"kt":         "Kotlin",
+	"lua":        "Lua",
+	"Makefile":   "Makefile",
+	"mk":         "Makefile",
+	"md":         "Markdown",
+	"m":          "Objective-C",
+	"ml":         "OCaml",
+	"pl":         "Perl",
+	"php":        "PHP",
+	"proto":      "Protocol Buffer",
+	"py":         "Python",
+	"r":          "R",
+	"rb":         "Ruby",
+	"rs":         "Rust",
+	"sass":       "Sass",
+	"scss":       "SCSS",
+	"scala":      "Scala",
+	"sh":         "Shell",
+	"bash":       "Shell",
+	"sql":        "SQL",
+	"swift":      "Swift",
+	"tf":         "HCL",
+	"ts":         "TypeScript",
+	"tsx":        "TypeScript",
+	"vue":        "Vue",
+	"yaml":       "YAML",
+	"yml":        "YAML",
+}
+
+// vendoredDirs contain dependencies that aren't counted as languages of the repository.
+var vendoredDirs = []string{"vendor/", "node_modules/", "third_party/", "bower_components/"}
+
+// language returns the name of the language the file at p is written in, empty if it isn't known or vendored.
+func language(p string) string {
+	for _, dir := range vendoredDirs {
+		if strings.HasPrefix(p, dir) || strings.Contains(p, "/"+dir) {
+			return ""
+		}
+	}
+
+	name := path.Base(p)
+	if ext := path.Ext(name); ext != "" {
+		return languages[strings.ToLower(ext[1:])]
+	}
+	return languages[name]
+}
+
+// Stats returns the Stats of the repository, they're all zero while it's empty.
+func (r *LocalRepository) Stats(ctx context.Context) (Stats, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.Stats")
+	span.SetTag("id", r.id)
+	defer span.Finish()
+
+	var stats Stats
+
+	counts, err := r.countObjects(ctx)
+	if err != nil {
+		injectError(span, err, "")
+		return stats, err
+	}
+	// git counts kibibytes.
+	stats.Size = (counts["size"] + counts["size-pack"]) * 1024
+	stats.LooseObjects = counts["count"]
+	stats.PackedObjects = counts["in-pack"]
+
+	head, err := r.revParse(ctx, "HEAD^{commit}")
+	if err == ErrRevNotFound {
+		return stats, nil
+	}
+
+	out, err := command.NewSimple(ctx, r.path, r.git, "rev-list", "--count", head)
+	if err != nil {
+		injectError(span, err, out)
+		return stats, errors.Wrapf(err, "failed to count commits: %s", out)
+	}
+	if stats.Commits, err = strconv.ParseInt(strings.TrimSpace(out), 10, 64); err != nil {
+		return stats, err
+	}
+
+	stats.Languages, err = r.languages(ctx, head)
+	if err != nil {
+		injectError(span, err, "")
+		return stats, err
+	}
+
+	return stats, nil
+}
+
+// languages returns the bytes of the files in the commit's tree by their language.
+func (r *LocalRepository) languages(ctx context.Context, sha1 string) ([]Language, error) {
+	out, err := command.NewSimple(ctx, r.path, r.git, "ls-tree", "-r", "-l", "-z", "--full-tree", sha1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list files: %s", out)
+	}
+
+	bytes := map[string]int64{}
+	for _, entry := range strings.Split(out, "\x00") {
+		// <mode> SP <type> SP <object> SP <size> TAB <path>
+		line := strings.SplitN(entry, "\t", 2)
+		if len(line) != 2 {
+			continue
+		}
+		fields := strings.Fields(line[0])
+		if len(fields) != 4 || fields[1] != "blob" || fields[0] == "120000" {
+			continue
+		}
+		name := language(line[1])
+		if name == "" {
+			continue
+		}
+		size, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			continue
+		}
+		bytes[name] += size
+	}
+
+	langs := make([]Language, 0, len(bytes))
+	for name, b := range bytes {
+		langs = append(langs, Language{Name: name, Bytes: b})
+	}
+	sort.Slice(langs, func(i, j int) bool {
+		if langs[i].Bytes != langs[j].Bytes {
+			return langs[i].Bytes > langs[j].Bytes
+		}
+		return langs[i].Name < langs[j].Name
+	})
+
+	return langs, nil
+}
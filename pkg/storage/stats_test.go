@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalRepository_Stats(t *testing.T) {
+	r, sha1, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	git := func(stdin string, args ...string) string {
+		cmd := exec.Command("/usr/bin/git", args...)
+		cmd.Dir = r.path
+		cmd.Stdin = strings.NewReader(stdin)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Foo Bar", "GIT_AUTHOR_EMAIL=foo@bar.com",
+			"GIT_COMMITTER_NAME=Foo Bar", "GIT_COMMITTER_EMAIL=foo@bar.com",
+		)
+		out, err := cmd.Output()
+		require.NoError(t, err)
+		return strings.TrimSpace(string(out))
+	}
+
+	readme := git("# foo\n", "hash-object", "-w", "--stdin")
+	main := git("package main\n\nfunc main() {}\n", "hash-object", "-w", "--stdin")
+	makefile := git("all:\n", "hash-object", "-w", "--stdin")
+	vendored := git("package dep\n\nfunc Dep() {}\n", "hash-object", "-w", "--stdin")
+	dep := git("100644 blob "+vendored+"\tdep.go\n", "mktree")
+	vendor := git("040000 tree "+dep+"\tdep\n", "mktree")
+	tree := git("100644 blob "+makefile+"\tMakefile\n100644 blob "+readme+"\tREADME.md\n100644 blob "+main+"\tmain.go\n040000 tree "+vendor+"\tvendor\n", "mktree")
+	git("", "update-ref", "refs/heads/master", git("", "commit-tree", tree, "-p", sha1, "-m", "add main.go"))
+
+	stats, err := r.Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), stats.Commits)
+	assert.Equal(t, int64(11), stats.LooseObjects)
+	assert.Equal(t, int64(0), stats.PackedObjects)
+	assert.True(t, stats.Size > 0)
+	assert.Equal(t, []Language{
+		{Name: "Go", Bytes: 29},
+		{Name: "Markdown", Bytes: 6},
+		{Name: "Makefile", Bytes: 5},
+	}, stats.Languages)
+}
+
+func TestLocalRepository_StatsEmpty(t *testing.T) {
+	r, _, cleanup := newTestRepository(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	require.NoError(t, os.Remove(filepath.Join(r.path, "refs", "heads", "master")))
+
+	stats, err := r.Stats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), stats.Commits)
+	assert.Empty(t, stats.Languages)
+}
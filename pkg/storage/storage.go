@@ -10,10 +10,14 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
 	"github.com/sourcepods/sourcepods/pkg/command"
@@ -22,12 +26,53 @@ import (
 var (
 	// ErrRepoNotValid is returned for invalid repositories
 	ErrRepoNotValid = fmt.Errorf("not a valid repository")
+	// ErrRevNotFound is returned if a rev can't be resolved to a commit
+	ErrRevNotFound = fmt.Errorf("rev not found")
+	// ErrBranchNotFound is returned if a branch doesn't exist
+	ErrBranchNotFound = fmt.Errorf("branch not found")
+	// ErrBranchExists is returned if a branch with the same name already exists
+	ErrBranchExists = fmt.Errorf("branch already exists")
+	// ErrBranchNameInvalid is returned for names git doesn't accept as branch
+	ErrBranchNameInvalid = fmt.Errorf("branch name is not valid")
+	// ErrRefChanged is returned if a ref was updated concurrently, e.g. by a push
+	ErrRefChanged = fmt.Errorf("ref has been changed concurrently")
+	// ErrPathNotFound is returned if there's no file at a path
+	ErrPathNotFound = fmt.Errorf("path not found")
+	// ErrArchiveFormatInvalid is returned for archive formats that aren't supported
+	ErrArchiveFormatInvalid = fmt.Errorf("archive format is not valid")
+	// ErrBlobTooLarge is returned if a file is too large to be processed
+	ErrBlobTooLarge = fmt.Errorf("file is too large")
+	// ErrBlameTimeout is returned if blaming a file takes too long
+	ErrBlameTimeout = fmt.Errorf("blame took too long")
+	// ErrSearchQueryInvalid is returned for empty or invalid search queries
+	ErrSearchQueryInvalid = fmt.Errorf("search query is not valid")
+	// ErrSearchTimeout is returned if searching a repository takes too long
+	ErrSearchTimeout = fmt.Errorf("search took too long")
+	// ErrRepoExists is returned if a repository should be created where one already exists
+	ErrRepoExists = fmt.Errorf("repository already exists")
+	// ErrImportURLInvalid is returned for URLs repositories can't be imported from
+	ErrImportURLInvalid = fmt.Errorf("import url is not valid")
+	// ErrImportTooLarge is returned if an imported repository exceeds the size limit
+	ErrImportTooLarge = fmt.Errorf("imported repository is too large")
+	// ErrImportTimeout is returned if importing a repository takes too long
+	ErrImportTimeout = fmt.Errorf("import took too long")
+	// ErrMirrorURLInvalid is returned for URLs repositories can't be mirrored with
+	ErrMirrorURLInvalid = fmt.Errorf("mirror url is not valid")
+	// ErrMirrorDirectionInvalid is returned for directions other than MirrorPull and MirrorPush
+	ErrMirrorDirectionInvalid = fmt.Errorf("mirror direction is not valid")
+	// ErrMirrorTimeout is returned if mirroring a repository takes too long
+	ErrMirrorTimeout = fmt.Errorf("mirroring took too long")
+	// ErrMaintenanceBusy is returned if a repository is pushed to or maintained already
+	ErrMaintenanceBusy = fmt.Errorf("repository is busy")
 )
 
 type (
 	// Storage TODO: is something that should be split up
 	Storage interface {
 		Create(ctx context.Context, id string) error
+		Fork(ctx context.Context, id, sourceID string) error
+		Delete(ctx context.Context, id string) error
+		FetchRef(ctx context.Context, id, sourceID, sourceRef, ref string) (string, error)
 		GetRepository(ctx context.Context, id string) (Repository, error)
 	}
 
@@ -36,28 +81,57 @@ type (
 
 	// LocalStorage implements Storage for Local disk-access
 	LocalStorage struct {
-		git    string
-		root   string
-		logger log.Logger
+		git         string
+		root        string
+		logger      log.Logger
+		indexes     *indexCache
+		postReceive func(id string)
+		events      *Events
+		locks       *pushLocks
 	}
 
 	// Repository is the interface for manipulating repos
 	Repository interface {
 		GetID() string
 		SetDescription(ctx context.Context, description string) error
-		ListBranches(ctx context.Context) ([]Branch, error)
+		ListBranches(ctx context.Context, opts ListBranchesOptions) ([]Branch, error)
+		CreateBranch(ctx context.Context, name, rev string) (Branch, error)
+		DeleteBranch(ctx context.Context, name, sha1 string) error
+		RenameBranch(ctx context.Context, name, newName string) (Branch, error)
+		SetDefaultBranch(ctx context.Context, name string) error
 		GetCommit(ctx context.Context, ref string) (Commit, error)
+		ListCommits(ctx context.Context, base, head string, limit int) ([]Commit, error)
+		Diff(ctx context.Context, base, head string) (Diff, error)
+		Mergeable(ctx context.Context, base, head string) (Mergeability, error)
+		Merge(ctx context.Context, opts MergeOptions) (MergeResult, error)
 		Tree(ctx context.Context, ref, path string) ([]TreeEntry, error)
+		Archive(ctx context.Context, rev, format, prefix string, w io.Writer) error
+		Blob(ctx context.Context, rev, path string) (Blob, error)
+		ReadBlob(ctx context.Context, sha1 string, offset, limit int64, w io.Writer) error
+		Blame(ctx context.Context, rev, path string, fn func(BlameHunk) error) error
+		Search(ctx context.Context, rev string, opts SearchOptions) ([]SearchMatch, error)
+		Stats(ctx context.Context) (Stats, error)
+		UpdateIndex(ctx context.Context) error
+		SearchIndex(ctx context.Context, opts IndexSearchOptions) ([]SearchMatch, error)
+		Dissociate(ctx context.Context) error
+		Import(ctx context.Context, opts ImportOptions, progress func(string) error) error
+		Mirror(ctx context.Context, opts MirrorOptions) error
+		Maintain(ctx context.Context, opts MaintenanceOptions) (MaintenanceStatus, error)
+		MaintenanceStatus(ctx context.Context) (MaintenanceStatus, error)
 		UploadPack(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer) (int32, error)
 		ReceivePack(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer) (int32, error)
 	}
 
 	// LocalRepository implements Repository for Local disk-access
 	LocalRepository struct {
-		git    string
-		path   string
-		id     string
-		logger log.Logger
+		git         string
+		path        string
+		id          string
+		logger      log.Logger
+		indexes     *indexCache
+		postReceive func(id string)
+		events      *Events
+		locks       *pushLocks
 	}
 )
 
@@ -72,15 +146,28 @@ func LoggerOption(logger log.Logger) StorageOption {
 	}
 }
 
+// PostReceiveOption injects a func called with the repository's id after every successful push
+func PostReceiveOption(fn func(id string)) StorageOption {
+	return func(s Storage) {
+		ls, ok := s.(*LocalStorage)
+		if !ok {
+			return
+		}
+		ls.postReceive = fn
+	}
+}
+
 // NewLocalStorage returns a LocalStorage in the given `root`
 func NewLocalStorage(root string, opts ...StorageOption) (*LocalStorage, error) {
 	if err := os.MkdirAll(root, 0755); err != nil {
 		return nil, fmt.Errorf("failed to create storage root: %s", root)
 	}
 	ls := &LocalStorage{
-		git:    "/usr/bin/git",
-		root:   root,
-		logger: log.NewNopLogger(),
+		git:     "/usr/bin/git",
+		root:    root,
+		logger:  log.NewNopLogger(),
+		indexes: newIndexCache(indexCacheSize),
+		locks:   newPushLocks(),
 	}
 
 	for _, opt := range opts {
@@ -137,7 +224,16 @@ func (s *LocalStorage) GetRepository(ctx context.Context, repoPath string) (Repo
 		return nil, ErrRepoNotValid
 	}
 
-	return &LocalRepository{git: s.git, path: dir, id: repoPath, logger: s.logger}, nil
+	return &LocalRepository{
+		git:         s.git,
+		path:        dir,
+		id:          repoPath,
+		logger:      s.logger,
+		indexes:     s.indexes,
+		postReceive: s.postReceive,
+		events:      s.events,
+		locks:       s.locks,
+	}, nil
 }
 
 // GetID returns the repos ID
@@ -150,6 +246,39 @@ type Branch struct {
 	Name string
 	Sha1 string
 	Type string
+
+	// Subject and Author of the commit the branch points to.
+	Subject string
+	Author  Signature
+	// Committed is the committer date the branches are sorted by with BranchSortCommitterDate.
+	Committed time.Time
+
+	// Ahead and Behind are the number of commits compared to the base branch.
+	Ahead  int
+	Behind int
+}
+
+// Sort orders for listing branches.
+const (
+	BranchSortName          = "name"
+	BranchSortCommitterDate = "committerdate"
+)
+
+// ListBranchesOptions filter and sort the listed branches
+type ListBranchesOptions struct {
+	// Prefix only lists branches whose name starts with it.
+	Prefix string
+	// Sort is either BranchSortName (default) or BranchSortCommitterDate, newest first.
+	Sort string
+	// Base is the branch Ahead and Behind are counted against, they're skipped if empty.
+	Base string
+
+	// After only lists the branches sorted after the one with this name and,
+	// with BranchSortCommitterDate, AfterCommitted date. That branch needn't exist anymore.
+	After          string
+	AfterCommitted time.Time
+	// Limit the number of branches listed, 0 lists all.
+	Limit int
 }
 
 // SetDescription of repository
@@ -159,12 +288,25 @@ func (r *LocalRepository) SetDescription(ctx context.Context, description string
 }
 
 // ListBranches returns all branches of a given repository
-func (r *LocalRepository) ListBranches(ctx context.Context) ([]Branch, error) {
+func (r *LocalRepository) ListBranches(ctx context.Context, opts ListBranchesOptions) ([]Branch, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.LocalRepository.ListBranches")
+	span.SetTag("prefix", opts.Prefix)
+	span.SetTag("sort", opts.Sort)
+	span.SetTag("base", opts.Base)
 	defer span.Finish()
 
+	sort := "--sort=refname"
+	if opts.Sort == BranchSortCommitterDate {
+		sort = "--sort=-committerdate"
+	}
+
 	errBuf := &bytes.Buffer{}
-	args := []string{"for-each-ref", "--format=%(objectname) %(objecttype) %(refname)", "refs/heads"}
+	args := []string{
+		"for-each-ref",
+		"--format=%(objectname)%00%(objecttype)%00%(refname)%00%(contents:subject)%00%(author)%00%(committerdate:unix)",
+		sort,
+		"refs/heads",
+	}
 	cmd, err := command.New(ctx, r.path, r.git, args, command.StderrWriter(errBuf), command.StdoutPipe)
 	if err != nil {
 		injectError(span, err, "")
@@ -174,12 +316,28 @@ func (r *LocalRepository) ListBranches(ctx context.Context) ([]Branch, error) {
 	var bs []Branch
 	scanner := bufio.NewScanner(cmd.Stdout())
 	for scanner.Scan() {
-		s := strings.Split(scanner.Text(), " ")
+		s := strings.SplitN(scanner.Text(), "\x00", 6)
+		if len(s) != 6 {
+			continue
+		}
+
+		name := strings.TrimPrefix(s[2], branchPrefix)
+		if !strings.HasPrefix(name, opts.Prefix) {
+			continue
+		}
+
+		// Only commits have an author, other objects keep an empty one.
+		author, _ := parseSignature(s[4])
+
+		committed, _ := strconv.ParseInt(s[5], 10, 64)
 
 		bs = append(bs, Branch{
-			Name: strings.TrimPrefix(s[2], "refs/heads/"),
-			Sha1: s[0],
-			Type: s[1],
+			Name:      name,
+			Sha1:      s[0],
+			Type:      s[1],
+			Subject:   s[3],
+			Author:    author,
+			Committed: time.Unix(committed, 0),
 		})
 	}
 
@@ -188,9 +346,95 @@ func (r *LocalRepository) ListBranches(ctx context.Context) ([]Branch, error) {
 		return nil, err
 	}
 
+	bs = pageBranches(bs, opts)
+
+	if opts.Base == "" {
+		return bs, nil
+	}
+
+	base, err := r.revParse(ctx, branchPrefix+opts.Base)
+	if err != nil {
+		// Without a base branch there's nothing to compare against.
+		return bs, nil
+	}
+
+	for i := range bs {
+		ahead, behind, err := r.aheadBehind(ctx, base, bs[i].Sha1)
+		if err != nil {
+			injectError(span, err, "")
+			return nil, err
+		}
+		bs[i].Ahead = ahead
+		bs[i].Behind = behind
+	}
+
 	return bs, nil
 }
 
+// pageBranches returns the branches sorted after opts.After, at most opts.Limit.
+// Branches committed at the same time are sorted by name,
+// git doesn't guarantee that order when sorting by date.
+func pageBranches(bs []Branch, opts ListBranchesOptions) []Branch {
+	after := func(b Branch) bool { return b.Name > opts.After }
+
+	if opts.Sort == BranchSortCommitterDate {
+		sort.SliceStable(bs, func(i, j int) bool {
+			if !bs[i].Committed.Equal(bs[j].Committed) {
+				return bs[i].Committed.After(bs[j].Committed)
+			}
+			return bs[i].Name < bs[j].Name
+		})
+		after = func(b Branch) bool {
+			if !b.Committed.Equal(opts.AfterCommitted) {
+				return b.Committed.Before(opts.AfterCommitted)
+			}
+			return b.Name > opts.After
+		}
+	}
+
+	if opts.After != "" {
+		i := 0
+		for i < len(bs) && !after(bs[i]) {
+			i++
+		}
+		bs = bs[i:]
+	}
+
+	if opts.Limit > 0 && len(bs) > opts.Limit {
+		bs = bs[:opts.Limit]
+	}
+
+	return bs
+}
+
+// aheadBehind counts the commits only reachable from sha1 (ahead) and only from base (behind).
+func (r *LocalRepository) aheadBehind(ctx context.Context, base, sha1 string) (int, int, error) {
+	if base == sha1 {
+		return 0, 0, nil
+	}
+
+	out, err := command.NewSimple(ctx, r.path, r.git, "rev-list", "--left-right", "--count", base+"..."+sha1)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to count commits: %s", out)
+	}
+
+	counts := strings.Fields(out)
+	if len(counts) != 2 {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %s", out)
+	}
+
+	behind, err := strconv.Atoi(counts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	ahead, err := strconv.Atoi(counts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return ahead, behind, nil
+}
+
 // Commit holds a commit
 type Commit struct {
 	Hash    string
@@ -438,16 +682,48 @@ func (r *LocalRepository) ReceivePack(ctx context.Context, stdin io.Reader, stdo
 	span.SetTag("repo_path", r.path)
 	defer span.Finish()
 
+	var before map[string]string
+	if r.events != nil {
+		refs, err := r.refs(ctx)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to list refs")
+		}
+		before = refs
+	}
+
+	unlock := r.lockPush()
 	cmd, err := command.New(ctx, r.path, r.git, []string{"receive-pack", "."},
 		command.StdinWriter(stdin),
 		command.StdoutWriter(stdout),
 		command.StderrWriter(stderr),
 	)
 	if err != nil {
+		unlock()
 		return 0, errors.Wrap(err, "command failed")
 	}
 
-	return exitStatus(cmd.Wait())
+	ec, err := exitStatus(cmd.Wait())
+	unlock()
+	if err != nil || ec != 0 {
+		return ec, err
+	}
+
+	if r.postReceive != nil {
+		r.postReceive(r.id)
+	}
+
+	if r.events != nil {
+		after, err := r.refs(ctx)
+		if err != nil {
+			level.Warn(r.logger).Log("msg", "failed to list refs after push", "id", r.id, "err", err)
+			return ec, nil
+		}
+		if updates := refUpdates(before, after); len(updates) > 0 {
+			r.events.Publish(PushEvent{ID: r.id, Refs: updates, Pushed: time.Now()})
+		}
+	}
+
+	return ec, nil
 }
 
 // Thankfully borrowed from https://github.com/gliderlabs/sshfront/blob/ff9cab19386c1b3bcdf1d574c5cbaf8bd046fc12/handlers.go#L25-L37
@@ -38,7 +38,7 @@ func (m *GRERequest) Reset()         { *m = GRERequest{} }
 func (m *GRERequest) String() string { return proto.CompactTextString(m) }
 func (*GRERequest) ProtoMessage()    {}
 func (*GRERequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_8b11b3624739db72, []int{0}
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{0}
 }
 func (m *GRERequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GRERequest.Unmarshal(m, b)
@@ -86,7 +86,7 @@ func (m *GREResponse) Reset()         { *m = GREResponse{} }
 func (m *GREResponse) String() string { return proto.CompactTextString(m) }
 func (*GREResponse) ProtoMessage()    {}
 func (*GREResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_8b11b3624739db72, []int{1}
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{1}
 }
 func (m *GREResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GREResponse.Unmarshal(m, b)
@@ -138,7 +138,7 @@ func (m *GREExitCode) Reset()         { *m = GREExitCode{} }
 func (m *GREExitCode) String() string { return proto.CompactTextString(m) }
 func (*GREExitCode) ProtoMessage()    {}
 func (*GREExitCode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_8b11b3624739db72, []int{2}
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{2}
 }
 func (m *GREExitCode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GREExitCode.Unmarshal(m, b)
@@ -176,7 +176,7 @@ func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
 func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRequest) ProtoMessage()    {}
 func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_8b11b3624739db72, []int{3}
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{3}
 }
 func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRequest.Unmarshal(m, b)
@@ -203,704 +203,4128 @@ func (m *CreateRequest) GetId() string {
 	return ""
 }
 
-type SetDescriptionRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+type ForkRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The repository forked, its objects are borrowed instead of copied.
+	SourceId             string   `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetDescriptionRequest) Reset()         { *m = SetDescriptionRequest{} }
-func (m *SetDescriptionRequest) String() string { return proto.CompactTextString(m) }
-func (*SetDescriptionRequest) ProtoMessage()    {}
-func (*SetDescriptionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_8b11b3624739db72, []int{4}
+func (m *ForkRequest) Reset()         { *m = ForkRequest{} }
+func (m *ForkRequest) String() string { return proto.CompactTextString(m) }
+func (*ForkRequest) ProtoMessage()    {}
+func (*ForkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{4}
 }
-func (m *SetDescriptionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetDescriptionRequest.Unmarshal(m, b)
+func (m *ForkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForkRequest.Unmarshal(m, b)
 }
-func (m *SetDescriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetDescriptionRequest.Marshal(b, m, deterministic)
+func (m *ForkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForkRequest.Marshal(b, m, deterministic)
 }
-func (dst *SetDescriptionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetDescriptionRequest.Merge(dst, src)
+func (dst *ForkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkRequest.Merge(dst, src)
 }
-func (m *SetDescriptionRequest) XXX_Size() int {
-	return xxx_messageInfo_SetDescriptionRequest.Size(m)
+func (m *ForkRequest) XXX_Size() int {
+	return xxx_messageInfo_ForkRequest.Size(m)
 }
-func (m *SetDescriptionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetDescriptionRequest.DiscardUnknown(m)
+func (m *ForkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetDescriptionRequest proto.InternalMessageInfo
+var xxx_messageInfo_ForkRequest proto.InternalMessageInfo
 
-func (m *SetDescriptionRequest) GetId() string {
+func (m *ForkRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *SetDescriptionRequest) GetDescription() string {
+func (m *ForkRequest) GetSourceId() string {
 	if m != nil {
-		return m.Description
+		return m.SourceId
 	}
 	return ""
 }
 
-type BranchesRequest struct {
+type DeleteRequest struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BranchesRequest) Reset()         { *m = BranchesRequest{} }
-func (m *BranchesRequest) String() string { return proto.CompactTextString(m) }
-func (*BranchesRequest) ProtoMessage()    {}
-func (*BranchesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_8b11b3624739db72, []int{5}
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{5}
 }
-func (m *BranchesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BranchesRequest.Unmarshal(m, b)
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
 }
-func (m *BranchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BranchesRequest.Marshal(b, m, deterministic)
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
 }
-func (dst *BranchesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BranchesRequest.Merge(dst, src)
+func (dst *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(dst, src)
 }
-func (m *BranchesRequest) XXX_Size() int {
-	return xxx_messageInfo_BranchesRequest.Size(m)
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
 }
-func (m *BranchesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BranchesRequest.DiscardUnknown(m)
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BranchesRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
 
-func (m *BranchesRequest) GetId() string {
+func (m *DeleteRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-type BranchResponse struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Sha1                 string   `protobuf:"bytes,2,opt,name=sha1,proto3" json:"sha1,omitempty"`
-	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+type DissociateRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BranchResponse) Reset()         { *m = BranchResponse{} }
-func (m *BranchResponse) String() string { return proto.CompactTextString(m) }
-func (*BranchResponse) ProtoMessage()    {}
-func (*BranchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_8b11b3624739db72, []int{6}
+func (m *DissociateRequest) Reset()         { *m = DissociateRequest{} }
+func (m *DissociateRequest) String() string { return proto.CompactTextString(m) }
+func (*DissociateRequest) ProtoMessage()    {}
+func (*DissociateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{6}
 }
-func (m *BranchResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BranchResponse.Unmarshal(m, b)
+func (m *DissociateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DissociateRequest.Unmarshal(m, b)
 }
-func (m *BranchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BranchResponse.Marshal(b, m, deterministic)
+func (m *DissociateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DissociateRequest.Marshal(b, m, deterministic)
 }
-func (dst *BranchResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BranchResponse.Merge(dst, src)
+func (dst *DissociateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DissociateRequest.Merge(dst, src)
 }
-func (m *BranchResponse) XXX_Size() int {
-	return xxx_messageInfo_BranchResponse.Size(m)
+func (m *DissociateRequest) XXX_Size() int {
+	return xxx_messageInfo_DissociateRequest.Size(m)
 }
-func (m *BranchResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BranchResponse.DiscardUnknown(m)
+func (m *DissociateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DissociateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BranchResponse proto.InternalMessageInfo
+var xxx_messageInfo_DissociateRequest proto.InternalMessageInfo
 
-func (m *BranchResponse) GetName() string {
+func (m *DissociateRequest) GetId() string {
 	if m != nil {
-		return m.Name
+		return m.Id
 	}
 	return ""
 }
 
-func (m *BranchResponse) GetSha1() string {
+type ImportRequest struct {
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url      string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Zero uses the defaults of the storage.
+	TimeoutSeconds       int64    `protobuf:"varint,5,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	MaxSize              int64    `protobuf:"varint,6,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
+func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportRequest) ProtoMessage()    {}
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{7}
+}
+func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportRequest.Unmarshal(m, b)
+}
+func (m *ImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRequest.Merge(dst, src)
+}
+func (m *ImportRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportRequest.Size(m)
+}
+func (m *ImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportRequest proto.InternalMessageInfo
+
+func (m *ImportRequest) GetId() string {
 	if m != nil {
-		return m.Sha1
+		return m.Id
 	}
 	return ""
 }
 
-func (m *BranchResponse) GetType() string {
+func (m *ImportRequest) GetUrl() string {
 	if m != nil {
-		return m.Type
+		return m.Url
 	}
 	return ""
 }
 
-type BranchesResponse struct {
-	Branch               []*BranchResponse `protobuf:"bytes,1,rep,name=branch,proto3" json:"branch,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *ImportRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
 }
 
-func (m *BranchesResponse) Reset()         { *m = BranchesResponse{} }
-func (m *BranchesResponse) String() string { return proto.CompactTextString(m) }
-func (*BranchesResponse) ProtoMessage()    {}
-func (*BranchesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_8b11b3624739db72, []int{7}
-}
-func (m *BranchesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BranchesResponse.Unmarshal(m, b)
-}
-func (m *BranchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BranchesResponse.Marshal(b, m, deterministic)
-}
-func (dst *BranchesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BranchesResponse.Merge(dst, src)
-}
-func (m *BranchesResponse) XXX_Size() int {
-	return xxx_messageInfo_BranchesResponse.Size(m)
-}
-func (m *BranchesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BranchesResponse.DiscardUnknown(m)
+func (m *ImportRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
 }
 
-var xxx_messageInfo_BranchesResponse proto.InternalMessageInfo
+func (m *ImportRequest) GetTimeoutSeconds() int64 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
 
-func (m *BranchesResponse) GetBranch() []*BranchResponse {
+func (m *ImportRequest) GetMaxSize() int64 {
 	if m != nil {
-		return m.Branch
+		return m.MaxSize
 	}
-	return nil
+	return 0
 }
 
-type CommitRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Ref                  string   `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+// ImportResponse is a line of progress git reports.
+type ImportResponse struct {
+	Progress             string   `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CommitRequest) Reset()         { *m = CommitRequest{} }
-func (m *CommitRequest) String() string { return proto.CompactTextString(m) }
-func (*CommitRequest) ProtoMessage()    {}
-func (*CommitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_8b11b3624739db72, []int{8}
+func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
+func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportResponse) ProtoMessage()    {}
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{8}
 }
-func (m *CommitRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CommitRequest.Unmarshal(m, b)
+func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResponse.Unmarshal(m, b)
 }
-func (m *CommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CommitRequest.Marshal(b, m, deterministic)
+func (m *ImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResponse.Marshal(b, m, deterministic)
 }
-func (dst *CommitRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitRequest.Merge(dst, src)
+func (dst *ImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResponse.Merge(dst, src)
 }
-func (m *CommitRequest) XXX_Size() int {
-	return xxx_messageInfo_CommitRequest.Size(m)
+func (m *ImportResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportResponse.Size(m)
 }
-func (m *CommitRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitRequest.DiscardUnknown(m)
+func (m *ImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitRequest proto.InternalMessageInfo
-
-func (m *CommitRequest) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
+var xxx_messageInfo_ImportResponse proto.InternalMessageInfo
 
-func (m *CommitRequest) GetRef() string {
+func (m *ImportResponse) GetProgress() string {
 	if m != nil {
-		return m.Ref
+		return m.Progress
 	}
 	return ""
 }
 
-type CommitResponse struct {
-	Hash                 string   `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
-	Tree                 string   `protobuf:"bytes,2,opt,name=Tree,proto3" json:"Tree,omitempty"`
-	Parent               string   `protobuf:"bytes,3,opt,name=Parent,proto3" json:"Parent,omitempty"`
-	Message              string   `protobuf:"bytes,4,opt,name=Message,proto3" json:"Message,omitempty"`
-	Author               string   `protobuf:"bytes,5,opt,name=Author,proto3" json:"Author,omitempty"`
-	AuthorEmail          string   `protobuf:"bytes,6,opt,name=AuthorEmail,proto3" json:"AuthorEmail,omitempty"`
-	AuthorDate           int64    `protobuf:"varint,7,opt,name=AuthorDate,proto3" json:"AuthorDate,omitempty"`
-	Committer            string   `protobuf:"bytes,8,opt,name=Committer,proto3" json:"Committer,omitempty"`
-	CommitterEmail       string   `protobuf:"bytes,9,opt,name=CommitterEmail,proto3" json:"CommitterEmail,omitempty"`
-	CommitterDate        int64    `protobuf:"varint,10,opt,name=CommitterDate,proto3" json:"CommitterDate,omitempty"`
+type MirrorRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Either pull or push.
+	Direction string `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	Url       string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Username  string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Password  string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// Zero uses the default of the storage.
+	TimeoutSeconds       int64    `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CommitResponse) Reset()         { *m = CommitResponse{} }
-func (m *CommitResponse) String() string { return proto.CompactTextString(m) }
-func (*CommitResponse) ProtoMessage()    {}
-func (*CommitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_8b11b3624739db72, []int{9}
+func (m *MirrorRequest) Reset()         { *m = MirrorRequest{} }
+func (m *MirrorRequest) String() string { return proto.CompactTextString(m) }
+func (*MirrorRequest) ProtoMessage()    {}
+func (*MirrorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{9}
 }
-func (m *CommitResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CommitResponse.Unmarshal(m, b)
+func (m *MirrorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MirrorRequest.Unmarshal(m, b)
 }
-func (m *CommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CommitResponse.Marshal(b, m, deterministic)
+func (m *MirrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MirrorRequest.Marshal(b, m, deterministic)
 }
-func (dst *CommitResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CommitResponse.Merge(dst, src)
+func (dst *MirrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorRequest.Merge(dst, src)
 }
-func (m *CommitResponse) XXX_Size() int {
-	return xxx_messageInfo_CommitResponse.Size(m)
+func (m *MirrorRequest) XXX_Size() int {
+	return xxx_messageInfo_MirrorRequest.Size(m)
 }
-func (m *CommitResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CommitResponse.DiscardUnknown(m)
+func (m *MirrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CommitResponse proto.InternalMessageInfo
+var xxx_messageInfo_MirrorRequest proto.InternalMessageInfo
 
-func (m *CommitResponse) GetHash() string {
+func (m *MirrorRequest) GetId() string {
 	if m != nil {
-		return m.Hash
+		return m.Id
 	}
 	return ""
 }
 
-func (m *CommitResponse) GetTree() string {
+func (m *MirrorRequest) GetDirection() string {
 	if m != nil {
-		return m.Tree
+		return m.Direction
 	}
 	return ""
 }
 
-func (m *CommitResponse) GetParent() string {
+func (m *MirrorRequest) GetUrl() string {
 	if m != nil {
-		return m.Parent
+		return m.Url
 	}
 	return ""
 }
 
-func (m *CommitResponse) GetMessage() string {
+func (m *MirrorRequest) GetUsername() string {
 	if m != nil {
-		return m.Message
+		return m.Username
 	}
 	return ""
 }
 
-func (m *CommitResponse) GetAuthor() string {
+func (m *MirrorRequest) GetPassword() string {
 	if m != nil {
-		return m.Author
+		return m.Password
 	}
 	return ""
 }
 
-func (m *CommitResponse) GetAuthorEmail() string {
+func (m *MirrorRequest) GetTimeoutSeconds() int64 {
 	if m != nil {
-		return m.AuthorEmail
+		return m.TimeoutSeconds
 	}
-	return ""
+	return 0
 }
 
-func (m *CommitResponse) GetAuthorDate() int64 {
+type FetchRefRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The repository source_ref is fetched from, may be the same as id.
+	SourceId             string   `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	SourceRef            string   `protobuf:"bytes,3,opt,name=source_ref,json=sourceRef,proto3" json:"source_ref,omitempty"`
+	Ref                  string   `protobuf:"bytes,4,opt,name=ref,proto3" json:"ref,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FetchRefRequest) Reset()         { *m = FetchRefRequest{} }
+func (m *FetchRefRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchRefRequest) ProtoMessage()    {}
+func (*FetchRefRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{10}
+}
+func (m *FetchRefRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FetchRefRequest.Unmarshal(m, b)
+}
+func (m *FetchRefRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FetchRefRequest.Marshal(b, m, deterministic)
+}
+func (dst *FetchRefRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchRefRequest.Merge(dst, src)
+}
+func (m *FetchRefRequest) XXX_Size() int {
+	return xxx_messageInfo_FetchRefRequest.Size(m)
+}
+func (m *FetchRefRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchRefRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FetchRefRequest proto.InternalMessageInfo
+
+func (m *FetchRefRequest) GetId() string {
 	if m != nil {
-		return m.AuthorDate
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *CommitResponse) GetCommitter() string {
+func (m *FetchRefRequest) GetSourceId() string {
 	if m != nil {
-		return m.Committer
+		return m.SourceId
 	}
 	return ""
 }
 
-func (m *CommitResponse) GetCommitterEmail() string {
+func (m *FetchRefRequest) GetSourceRef() string {
 	if m != nil {
-		return m.CommitterEmail
+		return m.SourceRef
 	}
 	return ""
 }
 
-func (m *CommitResponse) GetCommitterDate() int64 {
+func (m *FetchRefRequest) GetRef() string {
 	if m != nil {
-		return m.CommitterDate
+		return m.Ref
 	}
-	return 0
+	return ""
 }
 
-type TreeRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Ref                  string   `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
-	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+type FetchRefResponse struct {
+	Sha1                 string   `protobuf:"bytes,1,opt,name=sha1,proto3" json:"sha1,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TreeRequest) Reset()         { *m = TreeRequest{} }
-func (m *TreeRequest) String() string { return proto.CompactTextString(m) }
-func (*TreeRequest) ProtoMessage()    {}
-func (*TreeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_8b11b3624739db72, []int{10}
+func (m *FetchRefResponse) Reset()         { *m = FetchRefResponse{} }
+func (m *FetchRefResponse) String() string { return proto.CompactTextString(m) }
+func (*FetchRefResponse) ProtoMessage()    {}
+func (*FetchRefResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{11}
 }
-func (m *TreeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TreeRequest.Unmarshal(m, b)
+func (m *FetchRefResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FetchRefResponse.Unmarshal(m, b)
 }
-func (m *TreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TreeRequest.Marshal(b, m, deterministic)
+func (m *FetchRefResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FetchRefResponse.Marshal(b, m, deterministic)
 }
-func (dst *TreeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TreeRequest.Merge(dst, src)
+func (dst *FetchRefResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchRefResponse.Merge(dst, src)
 }
-func (m *TreeRequest) XXX_Size() int {
-	return xxx_messageInfo_TreeRequest.Size(m)
+func (m *FetchRefResponse) XXX_Size() int {
+	return xxx_messageInfo_FetchRefResponse.Size(m)
 }
-func (m *TreeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TreeRequest.DiscardUnknown(m)
+func (m *FetchRefResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchRefResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TreeRequest proto.InternalMessageInfo
+var xxx_messageInfo_FetchRefResponse proto.InternalMessageInfo
 
-func (m *TreeRequest) GetId() string {
+func (m *FetchRefResponse) GetSha1() string {
 	if m != nil {
-		return m.Id
+		return m.Sha1
 	}
 	return ""
 }
 
-func (m *TreeRequest) GetRef() string {
-	if m != nil {
-		return m.Ref
-	}
-	return ""
+type SetDescriptionRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TreeRequest) GetPath() string {
-	if m != nil {
-		return m.Path
-	}
-	return ""
+func (m *SetDescriptionRequest) Reset()         { *m = SetDescriptionRequest{} }
+func (m *SetDescriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDescriptionRequest) ProtoMessage()    {}
+func (*SetDescriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{12}
 }
-
-type TreeEntryResponse struct {
-	Mode                 string   `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
-	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Object               string   `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
-	Path                 string   `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+func (m *SetDescriptionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetDescriptionRequest.Unmarshal(m, b)
+}
+func (m *SetDescriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetDescriptionRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetDescriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDescriptionRequest.Merge(dst, src)
+}
+func (m *SetDescriptionRequest) XXX_Size() int {
+	return xxx_messageInfo_SetDescriptionRequest.Size(m)
+}
+func (m *SetDescriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDescriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDescriptionRequest proto.InternalMessageInfo
+
+func (m *SetDescriptionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SetDescriptionRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type BranchesRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Only list branches whose name starts with the prefix.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Sort by "name" (default) or "committerdate".
+	Sort string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	// The branch ahead and behind are counted against.
+	Base string `protobuf:"bytes,4,opt,name=base,proto3" json:"base,omitempty"`
+	// Only list branches sorted after the one with this name and committer date.
+	After          string `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`
+	AfterCommitted int64  `protobuf:"varint,6,opt,name=after_committed,json=afterCommitted,proto3" json:"after_committed,omitempty"`
+	// Limit the number of branches listed, 0 lists all.
+	Limit                int32    `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TreeEntryResponse) Reset()         { *m = TreeEntryResponse{} }
-func (m *TreeEntryResponse) String() string { return proto.CompactTextString(m) }
-func (*TreeEntryResponse) ProtoMessage()    {}
-func (*TreeEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_8b11b3624739db72, []int{11}
+func (m *BranchesRequest) Reset()         { *m = BranchesRequest{} }
+func (m *BranchesRequest) String() string { return proto.CompactTextString(m) }
+func (*BranchesRequest) ProtoMessage()    {}
+func (*BranchesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{13}
 }
-func (m *TreeEntryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TreeEntryResponse.Unmarshal(m, b)
+func (m *BranchesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BranchesRequest.Unmarshal(m, b)
 }
-func (m *TreeEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TreeEntryResponse.Marshal(b, m, deterministic)
+func (m *BranchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BranchesRequest.Marshal(b, m, deterministic)
 }
-func (dst *TreeEntryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TreeEntryResponse.Merge(dst, src)
+func (dst *BranchesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BranchesRequest.Merge(dst, src)
 }
-func (m *TreeEntryResponse) XXX_Size() int {
-	return xxx_messageInfo_TreeEntryResponse.Size(m)
+func (m *BranchesRequest) XXX_Size() int {
+	return xxx_messageInfo_BranchesRequest.Size(m)
 }
-func (m *TreeEntryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TreeEntryResponse.DiscardUnknown(m)
+func (m *BranchesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BranchesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TreeEntryResponse proto.InternalMessageInfo
+var xxx_messageInfo_BranchesRequest proto.InternalMessageInfo
 
-func (m *TreeEntryResponse) GetMode() string {
+func (m *BranchesRequest) GetId() string {
 	if m != nil {
-		return m.Mode
+		return m.Id
 	}
 	return ""
 }
 
-func (m *TreeEntryResponse) GetType() string {
+func (m *BranchesRequest) GetPrefix() string {
 	if m != nil {
-		return m.Type
+		return m.Prefix
 	}
 	return ""
 }
 
-func (m *TreeEntryResponse) GetObject() string {
+func (m *BranchesRequest) GetSort() string {
 	if m != nil {
-		return m.Object
+		return m.Sort
 	}
 	return ""
 }
 
-func (m *TreeEntryResponse) GetPath() string {
+func (m *BranchesRequest) GetBase() string {
 	if m != nil {
-		return m.Path
+		return m.Base
 	}
 	return ""
 }
 
-type TreeResponse struct {
-	TreeEntries          []*TreeEntryResponse `protobuf:"bytes,1,rep,name=treeEntries,proto3" json:"treeEntries,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *BranchesRequest) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
 }
 
-func (m *TreeResponse) Reset()         { *m = TreeResponse{} }
-func (m *TreeResponse) String() string { return proto.CompactTextString(m) }
-func (*TreeResponse) ProtoMessage()    {}
-func (*TreeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_8b11b3624739db72, []int{12}
+func (m *BranchesRequest) GetAfterCommitted() int64 {
+	if m != nil {
+		return m.AfterCommitted
+	}
+	return 0
 }
-func (m *TreeResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TreeResponse.Unmarshal(m, b)
+
+func (m *BranchesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
-func (m *TreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TreeResponse.Marshal(b, m, deterministic)
+
+type BranchResponse struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Sha1                 string   `protobuf:"bytes,2,opt,name=sha1,proto3" json:"sha1,omitempty"`
+	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Subject              string   `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Author               string   `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	AuthorEmail          string   `protobuf:"bytes,6,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	AuthorDate           int64    `protobuf:"varint,7,opt,name=author_date,json=authorDate,proto3" json:"author_date,omitempty"`
+	Ahead                int32    `protobuf:"varint,8,opt,name=ahead,proto3" json:"ahead,omitempty"`
+	Behind               int32    `protobuf:"varint,9,opt,name=behind,proto3" json:"behind,omitempty"`
+	Committed            int64    `protobuf:"varint,10,opt,name=committed,proto3" json:"committed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (dst *TreeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TreeResponse.Merge(dst, src)
+
+func (m *BranchResponse) Reset()         { *m = BranchResponse{} }
+func (m *BranchResponse) String() string { return proto.CompactTextString(m) }
+func (*BranchResponse) ProtoMessage()    {}
+func (*BranchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{14}
 }
-func (m *TreeResponse) XXX_Size() int {
-	return xxx_messageInfo_TreeResponse.Size(m)
+func (m *BranchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BranchResponse.Unmarshal(m, b)
 }
-func (m *TreeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TreeResponse.DiscardUnknown(m)
+func (m *BranchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BranchResponse.Marshal(b, m, deterministic)
+}
+func (dst *BranchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BranchResponse.Merge(dst, src)
+}
+func (m *BranchResponse) XXX_Size() int {
+	return xxx_messageInfo_BranchResponse.Size(m)
+}
+func (m *BranchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BranchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TreeResponse proto.InternalMessageInfo
+var xxx_messageInfo_BranchResponse proto.InternalMessageInfo
 
-func (m *TreeResponse) GetTreeEntries() []*TreeEntryResponse {
+func (m *BranchResponse) GetName() string {
 	if m != nil {
-		return m.TreeEntries
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*GRERequest)(nil), "storage.GRERequest")
-	proto.RegisterType((*GREResponse)(nil), "storage.GREResponse")
-	proto.RegisterType((*GREExitCode)(nil), "storage.GREExitCode")
-	proto.RegisterType((*CreateRequest)(nil), "storage.CreateRequest")
-	proto.RegisterType((*SetDescriptionRequest)(nil), "storage.SetDescriptionRequest")
-	proto.RegisterType((*BranchesRequest)(nil), "storage.BranchesRequest")
-	proto.RegisterType((*BranchResponse)(nil), "storage.BranchResponse")
-	proto.RegisterType((*BranchesResponse)(nil), "storage.BranchesResponse")
-	proto.RegisterType((*CommitRequest)(nil), "storage.CommitRequest")
-	proto.RegisterType((*CommitResponse)(nil), "storage.CommitResponse")
-	proto.RegisterType((*TreeRequest)(nil), "storage.TreeRequest")
-	proto.RegisterType((*TreeEntryResponse)(nil), "storage.TreeEntryResponse")
-	proto.RegisterType((*TreeResponse)(nil), "storage.TreeResponse")
+func (m *BranchResponse) GetSha1() string {
+	if m != nil {
+		return m.Sha1
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *BranchResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *BranchResponse) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
 
-// RepositoryClient is the client API for Repository service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RepositoryClient interface {
-	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	SetDescriptions(ctx context.Context, in *SetDescriptionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	Tree(ctx context.Context, in *TreeRequest, opts ...grpc.CallOption) (*TreeResponse, error)
+func (m *BranchResponse) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
 }
 
-type repositoryClient struct {
-	cc *grpc.ClientConn
+func (m *BranchResponse) GetAuthorEmail() string {
+	if m != nil {
+		return m.AuthorEmail
+	}
+	return ""
 }
 
-func NewRepositoryClient(cc *grpc.ClientConn) RepositoryClient {
-	return &repositoryClient{cc}
+func (m *BranchResponse) GetAuthorDate() int64 {
+	if m != nil {
+		return m.AuthorDate
+	}
+	return 0
 }
 
-func (c *repositoryClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/storage.Repository/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BranchResponse) GetAhead() int32 {
+	if m != nil {
+		return m.Ahead
 	}
-	return out, nil
+	return 0
 }
 
-func (c *repositoryClient) SetDescriptions(ctx context.Context, in *SetDescriptionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/storage.Repository/SetDescriptions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BranchResponse) GetBehind() int32 {
+	if m != nil {
+		return m.Behind
 	}
-	return out, nil
+	return 0
 }
 
-func (c *repositoryClient) Tree(ctx context.Context, in *TreeRequest, opts ...grpc.CallOption) (*TreeResponse, error) {
-	out := new(TreeResponse)
-	err := c.cc.Invoke(ctx, "/storage.Repository/Tree", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BranchResponse) GetCommitted() int64 {
+	if m != nil {
+		return m.Committed
 	}
-	return out, nil
+	return 0
 }
 
-// RepositoryServer is the server API for Repository service.
-type RepositoryServer interface {
-	Create(context.Context, *CreateRequest) (*empty.Empty, error)
-	SetDescriptions(context.Context, *SetDescriptionRequest) (*empty.Empty, error)
-	Tree(context.Context, *TreeRequest) (*TreeResponse, error)
+type BranchesResponse struct {
+	Branch               []*BranchResponse `protobuf:"bytes,1,rep,name=branch,proto3" json:"branch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BranchesResponse) Reset()         { *m = BranchesResponse{} }
+func (m *BranchesResponse) String() string { return proto.CompactTextString(m) }
+func (*BranchesResponse) ProtoMessage()    {}
+func (*BranchesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{15}
+}
+func (m *BranchesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BranchesResponse.Unmarshal(m, b)
+}
+func (m *BranchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BranchesResponse.Marshal(b, m, deterministic)
+}
+func (dst *BranchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BranchesResponse.Merge(dst, src)
+}
+func (m *BranchesResponse) XXX_Size() int {
+	return xxx_messageInfo_BranchesResponse.Size(m)
+}
+func (m *BranchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BranchesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BranchesResponse proto.InternalMessageInfo
+
+func (m *BranchesResponse) GetBranch() []*BranchResponse {
+	if m != nil {
+		return m.Branch
+	}
+	return nil
+}
+
+type CreateBranchRequest struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The rev the new branch will point to.
+	Rev                  string   `protobuf:"bytes,3,opt,name=rev,proto3" json:"rev,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateBranchRequest) Reset()         { *m = CreateBranchRequest{} }
+func (m *CreateBranchRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateBranchRequest) ProtoMessage()    {}
+func (*CreateBranchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{16}
+}
+func (m *CreateBranchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateBranchRequest.Unmarshal(m, b)
+}
+func (m *CreateBranchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateBranchRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateBranchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateBranchRequest.Merge(dst, src)
+}
+func (m *CreateBranchRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateBranchRequest.Size(m)
+}
+func (m *CreateBranchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateBranchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateBranchRequest proto.InternalMessageInfo
+
+func (m *CreateBranchRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CreateBranchRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateBranchRequest) GetRev() string {
+	if m != nil {
+		return m.Rev
+	}
+	return ""
+}
+
+type DeleteBranchRequest struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The sha1 the branch is expected to point to, if empty the current one is used.
+	Sha1                 string   `protobuf:"bytes,3,opt,name=sha1,proto3" json:"sha1,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteBranchRequest) Reset()         { *m = DeleteBranchRequest{} }
+func (m *DeleteBranchRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteBranchRequest) ProtoMessage()    {}
+func (*DeleteBranchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{17}
+}
+func (m *DeleteBranchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteBranchRequest.Unmarshal(m, b)
+}
+func (m *DeleteBranchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteBranchRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteBranchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteBranchRequest.Merge(dst, src)
+}
+func (m *DeleteBranchRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteBranchRequest.Size(m)
+}
+func (m *DeleteBranchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteBranchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteBranchRequest proto.InternalMessageInfo
+
+func (m *DeleteBranchRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteBranchRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeleteBranchRequest) GetSha1() string {
+	if m != nil {
+		return m.Sha1
+	}
+	return ""
+}
+
+type RenameBranchRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NewName              string   `protobuf:"bytes,3,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenameBranchRequest) Reset()         { *m = RenameBranchRequest{} }
+func (m *RenameBranchRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameBranchRequest) ProtoMessage()    {}
+func (*RenameBranchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{18}
+}
+func (m *RenameBranchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameBranchRequest.Unmarshal(m, b)
+}
+func (m *RenameBranchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameBranchRequest.Marshal(b, m, deterministic)
+}
+func (dst *RenameBranchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameBranchRequest.Merge(dst, src)
+}
+func (m *RenameBranchRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameBranchRequest.Size(m)
+}
+func (m *RenameBranchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameBranchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameBranchRequest proto.InternalMessageInfo
+
+func (m *RenameBranchRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RenameBranchRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RenameBranchRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+type SetDefaultBranchRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetDefaultBranchRequest) Reset()         { *m = SetDefaultBranchRequest{} }
+func (m *SetDefaultBranchRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDefaultBranchRequest) ProtoMessage()    {}
+func (*SetDefaultBranchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{19}
+}
+func (m *SetDefaultBranchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetDefaultBranchRequest.Unmarshal(m, b)
+}
+func (m *SetDefaultBranchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetDefaultBranchRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetDefaultBranchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDefaultBranchRequest.Merge(dst, src)
+}
+func (m *SetDefaultBranchRequest) XXX_Size() int {
+	return xxx_messageInfo_SetDefaultBranchRequest.Size(m)
+}
+func (m *SetDefaultBranchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDefaultBranchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDefaultBranchRequest proto.InternalMessageInfo
+
+func (m *SetDefaultBranchRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SetDefaultBranchRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type SignatureRequest struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Date  int64  `protobuf:"varint,3,opt,name=date,proto3" json:"date,omitempty"`
+	// The offset of the date's time zone in seconds east of UTC.
+	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignatureRequest) Reset()         { *m = SignatureRequest{} }
+func (m *SignatureRequest) String() string { return proto.CompactTextString(m) }
+func (*SignatureRequest) ProtoMessage()    {}
+func (*SignatureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{20}
+}
+func (m *SignatureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignatureRequest.Unmarshal(m, b)
+}
+func (m *SignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignatureRequest.Marshal(b, m, deterministic)
+}
+func (dst *SignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureRequest.Merge(dst, src)
+}
+func (m *SignatureRequest) XXX_Size() int {
+	return xxx_messageInfo_SignatureRequest.Size(m)
+}
+func (m *SignatureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureRequest proto.InternalMessageInfo
+
+func (m *SignatureRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SignatureRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *SignatureRequest) GetDate() int64 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+func (m *SignatureRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type MergeRequest struct {
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Head   string `protobuf:"bytes,3,opt,name=head,proto3" json:"head,omitempty"`
+	// The sha1 the branch is expected to point to, if empty the current one is used.
+	Sha1 string `protobuf:"bytes,4,opt,name=sha1,proto3" json:"sha1,omitempty"`
+	// Either "merge", "squash" or "rebase".
+	Strategy             string            `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Message              string            `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Author               *SignatureRequest `protobuf:"bytes,7,opt,name=author,proto3" json:"author,omitempty"`
+	Committer            *SignatureRequest `protobuf:"bytes,8,opt,name=committer,proto3" json:"committer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MergeRequest) Reset()         { *m = MergeRequest{} }
+func (m *MergeRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeRequest) ProtoMessage()    {}
+func (*MergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{21}
+}
+func (m *MergeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergeRequest.Unmarshal(m, b)
+}
+func (m *MergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergeRequest.Marshal(b, m, deterministic)
+}
+func (dst *MergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeRequest.Merge(dst, src)
+}
+func (m *MergeRequest) XXX_Size() int {
+	return xxx_messageInfo_MergeRequest.Size(m)
+}
+func (m *MergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeRequest proto.InternalMessageInfo
+
+func (m *MergeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MergeRequest) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
+func (m *MergeRequest) GetHead() string {
+	if m != nil {
+		return m.Head
+	}
+	return ""
+}
+
+func (m *MergeRequest) GetSha1() string {
+	if m != nil {
+		return m.Sha1
+	}
+	return ""
+}
+
+func (m *MergeRequest) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *MergeRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *MergeRequest) GetAuthor() *SignatureRequest {
+	if m != nil {
+		return m.Author
+	}
+	return nil
+}
+
+func (m *MergeRequest) GetCommitter() *SignatureRequest {
+	if m != nil {
+		return m.Committer
+	}
+	return nil
+}
+
+type MergeResponse struct {
+	Sha1 string `protobuf:"bytes,1,opt,name=sha1,proto3" json:"sha1,omitempty"`
+	// The paths of the files that can't be merged, the branch isn't updated if there are any.
+	Conflicts            []string `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergeResponse) Reset()         { *m = MergeResponse{} }
+func (m *MergeResponse) String() string { return proto.CompactTextString(m) }
+func (*MergeResponse) ProtoMessage()    {}
+func (*MergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{22}
+}
+func (m *MergeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergeResponse.Unmarshal(m, b)
+}
+func (m *MergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergeResponse.Marshal(b, m, deterministic)
+}
+func (dst *MergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeResponse.Merge(dst, src)
+}
+func (m *MergeResponse) XXX_Size() int {
+	return xxx_messageInfo_MergeResponse.Size(m)
+}
+func (m *MergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeResponse proto.InternalMessageInfo
+
+func (m *MergeResponse) GetSha1() string {
+	if m != nil {
+		return m.Sha1
+	}
+	return ""
+}
+
+func (m *MergeResponse) GetConflicts() []string {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
+type CommitRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ref                  string   `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitRequest) Reset()         { *m = CommitRequest{} }
+func (m *CommitRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitRequest) ProtoMessage()    {}
+func (*CommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{23}
+}
+func (m *CommitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommitRequest.Unmarshal(m, b)
+}
+func (m *CommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommitRequest.Marshal(b, m, deterministic)
+}
+func (dst *CommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitRequest.Merge(dst, src)
+}
+func (m *CommitRequest) XXX_Size() int {
+	return xxx_messageInfo_CommitRequest.Size(m)
+}
+func (m *CommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitRequest proto.InternalMessageInfo
+
+func (m *CommitRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CommitRequest) GetRef() string {
+	if m != nil {
+		return m.Ref
+	}
+	return ""
+}
+
+type CommitResponse struct {
+	Hash                 string   `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Tree                 string   `protobuf:"bytes,2,opt,name=Tree,proto3" json:"Tree,omitempty"`
+	Parent               string   `protobuf:"bytes,3,opt,name=Parent,proto3" json:"Parent,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=Message,proto3" json:"Message,omitempty"`
+	Author               string   `protobuf:"bytes,5,opt,name=Author,proto3" json:"Author,omitempty"`
+	AuthorEmail          string   `protobuf:"bytes,6,opt,name=AuthorEmail,proto3" json:"AuthorEmail,omitempty"`
+	AuthorDate           int64    `protobuf:"varint,7,opt,name=AuthorDate,proto3" json:"AuthorDate,omitempty"`
+	Committer            string   `protobuf:"bytes,8,opt,name=Committer,proto3" json:"Committer,omitempty"`
+	CommitterEmail       string   `protobuf:"bytes,9,opt,name=CommitterEmail,proto3" json:"CommitterEmail,omitempty"`
+	CommitterDate        int64    `protobuf:"varint,10,opt,name=CommitterDate,proto3" json:"CommitterDate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitResponse) Reset()         { *m = CommitResponse{} }
+func (m *CommitResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitResponse) ProtoMessage()    {}
+func (*CommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{24}
+}
+func (m *CommitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommitResponse.Unmarshal(m, b)
+}
+func (m *CommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommitResponse.Marshal(b, m, deterministic)
+}
+func (dst *CommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitResponse.Merge(dst, src)
+}
+func (m *CommitResponse) XXX_Size() int {
+	return xxx_messageInfo_CommitResponse.Size(m)
+}
+func (m *CommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitResponse proto.InternalMessageInfo
+
+func (m *CommitResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *CommitResponse) GetTree() string {
+	if m != nil {
+		return m.Tree
+	}
+	return ""
+}
+
+func (m *CommitResponse) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
+func (m *CommitResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *CommitResponse) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *CommitResponse) GetAuthorEmail() string {
+	if m != nil {
+		return m.AuthorEmail
+	}
+	return ""
+}
+
+func (m *CommitResponse) GetAuthorDate() int64 {
+	if m != nil {
+		return m.AuthorDate
+	}
+	return 0
+}
+
+func (m *CommitResponse) GetCommitter() string {
+	if m != nil {
+		return m.Committer
+	}
+	return ""
+}
+
+func (m *CommitResponse) GetCommitterEmail() string {
+	if m != nil {
+		return m.CommitterEmail
+	}
+	return ""
+}
+
+func (m *CommitResponse) GetCommitterDate() int64 {
+	if m != nil {
+		return m.CommitterDate
+	}
+	return 0
+}
+
+type CommitsRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Base                 string   `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Head                 string   `protobuf:"bytes,3,opt,name=head,proto3" json:"head,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommitsRequest) Reset()         { *m = CommitsRequest{} }
+func (m *CommitsRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitsRequest) ProtoMessage()    {}
+func (*CommitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{25}
+}
+func (m *CommitsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommitsRequest.Unmarshal(m, b)
+}
+func (m *CommitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommitsRequest.Marshal(b, m, deterministic)
+}
+func (dst *CommitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitsRequest.Merge(dst, src)
+}
+func (m *CommitsRequest) XXX_Size() int {
+	return xxx_messageInfo_CommitsRequest.Size(m)
+}
+func (m *CommitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitsRequest proto.InternalMessageInfo
+
+func (m *CommitsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CommitsRequest) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *CommitsRequest) GetHead() string {
+	if m != nil {
+		return m.Head
+	}
+	return ""
+}
+
+func (m *CommitsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type CommitsResponse struct {
+	Commits              []*CommitResponse `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CommitsResponse) Reset()         { *m = CommitsResponse{} }
+func (m *CommitsResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitsResponse) ProtoMessage()    {}
+func (*CommitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{26}
+}
+func (m *CommitsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommitsResponse.Unmarshal(m, b)
+}
+func (m *CommitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommitsResponse.Marshal(b, m, deterministic)
+}
+func (dst *CommitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitsResponse.Merge(dst, src)
+}
+func (m *CommitsResponse) XXX_Size() int {
+	return xxx_messageInfo_CommitsResponse.Size(m)
+}
+func (m *CommitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitsResponse proto.InternalMessageInfo
+
+func (m *CommitsResponse) GetCommits() []*CommitResponse {
+	if m != nil {
+		return m.Commits
+	}
+	return nil
+}
+
+type DiffRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Base                 string   `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Head                 string   `protobuf:"bytes,3,opt,name=head,proto3" json:"head,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffRequest) Reset()         { *m = DiffRequest{} }
+func (m *DiffRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffRequest) ProtoMessage()    {}
+func (*DiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{27}
+}
+func (m *DiffRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffRequest.Unmarshal(m, b)
+}
+func (m *DiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffRequest.Marshal(b, m, deterministic)
+}
+func (dst *DiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffRequest.Merge(dst, src)
+}
+func (m *DiffRequest) XXX_Size() int {
+	return xxx_messageInfo_DiffRequest.Size(m)
+}
+func (m *DiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffRequest proto.InternalMessageInfo
+
+func (m *DiffRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DiffRequest) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *DiffRequest) GetHead() string {
+	if m != nil {
+		return m.Head
+	}
+	return ""
+}
+
+type FileDiffResponse struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	OldPath              string   `protobuf:"bytes,2,opt,name=old_path,json=oldPath,proto3" json:"old_path,omitempty"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Binary               bool     `protobuf:"varint,4,opt,name=binary,proto3" json:"binary,omitempty"`
+	Additions            int32    `protobuf:"varint,5,opt,name=additions,proto3" json:"additions,omitempty"`
+	Deletions            int32    `protobuf:"varint,6,opt,name=deletions,proto3" json:"deletions,omitempty"`
+	Patch                string   `protobuf:"bytes,7,opt,name=patch,proto3" json:"patch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileDiffResponse) Reset()         { *m = FileDiffResponse{} }
+func (m *FileDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*FileDiffResponse) ProtoMessage()    {}
+func (*FileDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{28}
+}
+func (m *FileDiffResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileDiffResponse.Unmarshal(m, b)
+}
+func (m *FileDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileDiffResponse.Marshal(b, m, deterministic)
+}
+func (dst *FileDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileDiffResponse.Merge(dst, src)
+}
+func (m *FileDiffResponse) XXX_Size() int {
+	return xxx_messageInfo_FileDiffResponse.Size(m)
+}
+func (m *FileDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileDiffResponse proto.InternalMessageInfo
+
+func (m *FileDiffResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *FileDiffResponse) GetOldPath() string {
+	if m != nil {
+		return m.OldPath
+	}
+	return ""
+}
+
+func (m *FileDiffResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *FileDiffResponse) GetBinary() bool {
+	if m != nil {
+		return m.Binary
+	}
+	return false
+}
+
+func (m *FileDiffResponse) GetAdditions() int32 {
+	if m != nil {
+		return m.Additions
+	}
+	return 0
+}
+
+func (m *FileDiffResponse) GetDeletions() int32 {
+	if m != nil {
+		return m.Deletions
+	}
+	return 0
+}
+
+func (m *FileDiffResponse) GetPatch() string {
+	if m != nil {
+		return m.Patch
+	}
+	return ""
+}
+
+type DiffResponse struct {
+	Files                []*FileDiffResponse `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Truncated            bool                `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DiffResponse) Reset()         { *m = DiffResponse{} }
+func (m *DiffResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffResponse) ProtoMessage()    {}
+func (*DiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{29}
+}
+func (m *DiffResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffResponse.Unmarshal(m, b)
+}
+func (m *DiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffResponse.Marshal(b, m, deterministic)
+}
+func (dst *DiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffResponse.Merge(dst, src)
+}
+func (m *DiffResponse) XXX_Size() int {
+	return xxx_messageInfo_DiffResponse.Size(m)
+}
+func (m *DiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffResponse proto.InternalMessageInfo
+
+func (m *DiffResponse) GetFiles() []*FileDiffResponse {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *DiffResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+type MergeableRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Base                 string   `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Head                 string   `protobuf:"bytes,3,opt,name=head,proto3" json:"head,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergeableRequest) Reset()         { *m = MergeableRequest{} }
+func (m *MergeableRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeableRequest) ProtoMessage()    {}
+func (*MergeableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{30}
+}
+func (m *MergeableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergeableRequest.Unmarshal(m, b)
+}
+func (m *MergeableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergeableRequest.Marshal(b, m, deterministic)
+}
+func (dst *MergeableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeableRequest.Merge(dst, src)
+}
+func (m *MergeableRequest) XXX_Size() int {
+	return xxx_messageInfo_MergeableRequest.Size(m)
+}
+func (m *MergeableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeableRequest proto.InternalMessageInfo
+
+func (m *MergeableRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MergeableRequest) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *MergeableRequest) GetHead() string {
+	if m != nil {
+		return m.Head
+	}
+	return ""
+}
+
+type MergeableResponse struct {
+	Base                 string   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Head                 string   `protobuf:"bytes,2,opt,name=head,proto3" json:"head,omitempty"`
+	MergeBase            string   `protobuf:"bytes,3,opt,name=merge_base,json=mergeBase,proto3" json:"merge_base,omitempty"`
+	Mergeable            bool     `protobuf:"varint,4,opt,name=mergeable,proto3" json:"mergeable,omitempty"`
+	Conflicts            []string `protobuf:"bytes,5,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergeableResponse) Reset()         { *m = MergeableResponse{} }
+func (m *MergeableResponse) String() string { return proto.CompactTextString(m) }
+func (*MergeableResponse) ProtoMessage()    {}
+func (*MergeableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{31}
+}
+func (m *MergeableResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergeableResponse.Unmarshal(m, b)
+}
+func (m *MergeableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergeableResponse.Marshal(b, m, deterministic)
+}
+func (dst *MergeableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeableResponse.Merge(dst, src)
+}
+func (m *MergeableResponse) XXX_Size() int {
+	return xxx_messageInfo_MergeableResponse.Size(m)
+}
+func (m *MergeableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeableResponse proto.InternalMessageInfo
+
+func (m *MergeableResponse) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *MergeableResponse) GetHead() string {
+	if m != nil {
+		return m.Head
+	}
+	return ""
+}
+
+func (m *MergeableResponse) GetMergeBase() string {
+	if m != nil {
+		return m.MergeBase
+	}
+	return ""
+}
+
+func (m *MergeableResponse) GetMergeable() bool {
+	if m != nil {
+		return m.Mergeable
+	}
+	return false
+}
+
+func (m *MergeableResponse) GetConflicts() []string {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
+type TreeRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ref                  string   `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TreeRequest) Reset()         { *m = TreeRequest{} }
+func (m *TreeRequest) String() string { return proto.CompactTextString(m) }
+func (*TreeRequest) ProtoMessage()    {}
+func (*TreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{32}
+}
+func (m *TreeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TreeRequest.Unmarshal(m, b)
+}
+func (m *TreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TreeRequest.Marshal(b, m, deterministic)
+}
+func (dst *TreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreeRequest.Merge(dst, src)
+}
+func (m *TreeRequest) XXX_Size() int {
+	return xxx_messageInfo_TreeRequest.Size(m)
+}
+func (m *TreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreeRequest proto.InternalMessageInfo
+
+func (m *TreeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TreeRequest) GetRef() string {
+	if m != nil {
+		return m.Ref
+	}
+	return ""
+}
+
+func (m *TreeRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type TreeEntryResponse struct {
+	Mode                 string   `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Object               string   `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	Path                 string   `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TreeEntryResponse) Reset()         { *m = TreeEntryResponse{} }
+func (m *TreeEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*TreeEntryResponse) ProtoMessage()    {}
+func (*TreeEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{33}
+}
+func (m *TreeEntryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TreeEntryResponse.Unmarshal(m, b)
+}
+func (m *TreeEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TreeEntryResponse.Marshal(b, m, deterministic)
+}
+func (dst *TreeEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreeEntryResponse.Merge(dst, src)
+}
+func (m *TreeEntryResponse) XXX_Size() int {
+	return xxx_messageInfo_TreeEntryResponse.Size(m)
+}
+func (m *TreeEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreeEntryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreeEntryResponse proto.InternalMessageInfo
+
+func (m *TreeEntryResponse) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *TreeEntryResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TreeEntryResponse) GetObject() string {
+	if m != nil {
+		return m.Object
+	}
+	return ""
+}
+
+func (m *TreeEntryResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type TreeResponse struct {
+	TreeEntries          []*TreeEntryResponse `protobuf:"bytes,1,rep,name=treeEntries,proto3" json:"treeEntries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TreeResponse) Reset()         { *m = TreeResponse{} }
+func (m *TreeResponse) String() string { return proto.CompactTextString(m) }
+func (*TreeResponse) ProtoMessage()    {}
+func (*TreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{34}
+}
+func (m *TreeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TreeResponse.Unmarshal(m, b)
+}
+func (m *TreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TreeResponse.Marshal(b, m, deterministic)
+}
+func (dst *TreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreeResponse.Merge(dst, src)
+}
+func (m *TreeResponse) XXX_Size() int {
+	return xxx_messageInfo_TreeResponse.Size(m)
+}
+func (m *TreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreeResponse proto.InternalMessageInfo
+
+func (m *TreeResponse) GetTreeEntries() []*TreeEntryResponse {
+	if m != nil {
+		return m.TreeEntries
+	}
+	return nil
+}
+
+type ArchiveRequest struct {
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rev string `protobuf:"bytes,2,opt,name=rev,proto3" json:"rev,omitempty"`
+	// One of tar, tar.gz or zip.
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// Prefix prepended to all paths in the archive, e.g. "name-master/".
+	Prefix               string   `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveRequest) Reset()         { *m = ArchiveRequest{} }
+func (m *ArchiveRequest) String() string { return proto.CompactTextString(m) }
+func (*ArchiveRequest) ProtoMessage()    {}
+func (*ArchiveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{35}
+}
+func (m *ArchiveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveRequest.Unmarshal(m, b)
+}
+func (m *ArchiveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveRequest.Marshal(b, m, deterministic)
+}
+func (dst *ArchiveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveRequest.Merge(dst, src)
+}
+func (m *ArchiveRequest) XXX_Size() int {
+	return xxx_messageInfo_ArchiveRequest.Size(m)
+}
+func (m *ArchiveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveRequest proto.InternalMessageInfo
+
+func (m *ArchiveRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ArchiveRequest) GetRev() string {
+	if m != nil {
+		return m.Rev
+	}
+	return ""
+}
+
+func (m *ArchiveRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ArchiveRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+type ArchiveResponse struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveResponse) Reset()         { *m = ArchiveResponse{} }
+func (m *ArchiveResponse) String() string { return proto.CompactTextString(m) }
+func (*ArchiveResponse) ProtoMessage()    {}
+func (*ArchiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{36}
+}
+func (m *ArchiveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveResponse.Unmarshal(m, b)
+}
+func (m *ArchiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveResponse.Marshal(b, m, deterministic)
+}
+func (dst *ArchiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveResponse.Merge(dst, src)
+}
+func (m *ArchiveResponse) XXX_Size() int {
+	return xxx_messageInfo_ArchiveResponse.Size(m)
+}
+func (m *ArchiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveResponse proto.InternalMessageInfo
+
+func (m *ArchiveResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type BlobRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rev                  string   `protobuf:"bytes,2,opt,name=rev,proto3" json:"rev,omitempty"`
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlobRequest) Reset()         { *m = BlobRequest{} }
+func (m *BlobRequest) String() string { return proto.CompactTextString(m) }
+func (*BlobRequest) ProtoMessage()    {}
+func (*BlobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{37}
+}
+func (m *BlobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlobRequest.Unmarshal(m, b)
+}
+func (m *BlobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlobRequest.Marshal(b, m, deterministic)
+}
+func (dst *BlobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobRequest.Merge(dst, src)
+}
+func (m *BlobRequest) XXX_Size() int {
+	return xxx_messageInfo_BlobRequest.Size(m)
+}
+func (m *BlobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobRequest proto.InternalMessageInfo
+
+func (m *BlobRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BlobRequest) GetRev() string {
+	if m != nil {
+		return m.Rev
+	}
+	return ""
+}
+
+func (m *BlobRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type BlobResponse struct {
+	Sha1                 string   `protobuf:"bytes,1,opt,name=sha1,proto3" json:"sha1,omitempty"`
+	Mode                 string   `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Size                 int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlobResponse) Reset()         { *m = BlobResponse{} }
+func (m *BlobResponse) String() string { return proto.CompactTextString(m) }
+func (*BlobResponse) ProtoMessage()    {}
+func (*BlobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{38}
+}
+func (m *BlobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlobResponse.Unmarshal(m, b)
+}
+func (m *BlobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlobResponse.Marshal(b, m, deterministic)
+}
+func (dst *BlobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobResponse.Merge(dst, src)
+}
+func (m *BlobResponse) XXX_Size() int {
+	return xxx_messageInfo_BlobResponse.Size(m)
+}
+func (m *BlobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobResponse proto.InternalMessageInfo
+
+func (m *BlobResponse) GetSha1() string {
+	if m != nil {
+		return m.Sha1
+	}
+	return ""
+}
+
+func (m *BlobResponse) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *BlobResponse) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type ReadBlobRequest struct {
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sha1   string `protobuf:"bytes,2,opt,name=sha1,proto3" json:"sha1,omitempty"`
+	Offset int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Number of bytes to read, 0 reads the whole blob.
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadBlobRequest) Reset()         { *m = ReadBlobRequest{} }
+func (m *ReadBlobRequest) String() string { return proto.CompactTextString(m) }
+func (*ReadBlobRequest) ProtoMessage()    {}
+func (*ReadBlobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{39}
+}
+func (m *ReadBlobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadBlobRequest.Unmarshal(m, b)
+}
+func (m *ReadBlobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadBlobRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReadBlobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadBlobRequest.Merge(dst, src)
+}
+func (m *ReadBlobRequest) XXX_Size() int {
+	return xxx_messageInfo_ReadBlobRequest.Size(m)
+}
+func (m *ReadBlobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadBlobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadBlobRequest proto.InternalMessageInfo
+
+func (m *ReadBlobRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReadBlobRequest) GetSha1() string {
+	if m != nil {
+		return m.Sha1
+	}
+	return ""
+}
+
+func (m *ReadBlobRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ReadBlobRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ReadBlobResponse struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadBlobResponse) Reset()         { *m = ReadBlobResponse{} }
+func (m *ReadBlobResponse) String() string { return proto.CompactTextString(m) }
+func (*ReadBlobResponse) ProtoMessage()    {}
+func (*ReadBlobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{40}
+}
+func (m *ReadBlobResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadBlobResponse.Unmarshal(m, b)
+}
+func (m *ReadBlobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadBlobResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReadBlobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadBlobResponse.Merge(dst, src)
+}
+func (m *ReadBlobResponse) XXX_Size() int {
+	return xxx_messageInfo_ReadBlobResponse.Size(m)
+}
+func (m *ReadBlobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadBlobResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadBlobResponse proto.InternalMessageInfo
+
+func (m *ReadBlobResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type BlameRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rev                  string   `protobuf:"bytes,2,opt,name=rev,proto3" json:"rev,omitempty"`
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlameRequest) Reset()         { *m = BlameRequest{} }
+func (m *BlameRequest) String() string { return proto.CompactTextString(m) }
+func (*BlameRequest) ProtoMessage()    {}
+func (*BlameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{41}
+}
+func (m *BlameRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlameRequest.Unmarshal(m, b)
+}
+func (m *BlameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlameRequest.Marshal(b, m, deterministic)
+}
+func (dst *BlameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlameRequest.Merge(dst, src)
+}
+func (m *BlameRequest) XXX_Size() int {
+	return xxx_messageInfo_BlameRequest.Size(m)
+}
+func (m *BlameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlameRequest proto.InternalMessageInfo
+
+func (m *BlameRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BlameRequest) GetRev() string {
+	if m != nil {
+		return m.Rev
+	}
+	return ""
+}
+
+func (m *BlameRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// BlameResponse is a hunk of consecutive lines last changed by the same commit.
+type BlameResponse struct {
+	Sha1        string `protobuf:"bytes,1,opt,name=sha1,proto3" json:"sha1,omitempty"`
+	Summary     string `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Author      string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	AuthorEmail string `protobuf:"bytes,4,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	AuthorDate  int64  `protobuf:"varint,5,opt,name=author_date,json=authorDate,proto3" json:"author_date,omitempty"`
+	// First line of the hunk, starting at 1.
+	Line                 int32    `protobuf:"varint,6,opt,name=line,proto3" json:"line,omitempty"`
+	Lines                []string `protobuf:"bytes,7,rep,name=lines,proto3" json:"lines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlameResponse) Reset()         { *m = BlameResponse{} }
+func (m *BlameResponse) String() string { return proto.CompactTextString(m) }
+func (*BlameResponse) ProtoMessage()    {}
+func (*BlameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{42}
+}
+func (m *BlameResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlameResponse.Unmarshal(m, b)
+}
+func (m *BlameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlameResponse.Marshal(b, m, deterministic)
+}
+func (dst *BlameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlameResponse.Merge(dst, src)
+}
+func (m *BlameResponse) XXX_Size() int {
+	return xxx_messageInfo_BlameResponse.Size(m)
+}
+func (m *BlameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlameResponse proto.InternalMessageInfo
+
+func (m *BlameResponse) GetSha1() string {
+	if m != nil {
+		return m.Sha1
+	}
+	return ""
+}
+
+func (m *BlameResponse) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *BlameResponse) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *BlameResponse) GetAuthorEmail() string {
+	if m != nil {
+		return m.AuthorEmail
+	}
+	return ""
+}
+
+func (m *BlameResponse) GetAuthorDate() int64 {
+	if m != nil {
+		return m.AuthorDate
+	}
+	return 0
+}
+
+func (m *BlameResponse) GetLine() int32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *BlameResponse) GetLines() []string {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+type StatsRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatsRequest) Reset()         { *m = StatsRequest{} }
+func (m *StatsRequest) String() string { return proto.CompactTextString(m) }
+func (*StatsRequest) ProtoMessage()    {}
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{43}
+}
+func (m *StatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsRequest.Unmarshal(m, b)
+}
+func (m *StatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsRequest.Marshal(b, m, deterministic)
+}
+func (dst *StatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsRequest.Merge(dst, src)
+}
+func (m *StatsRequest) XXX_Size() int {
+	return xxx_messageInfo_StatsRequest.Size(m)
+}
+func (m *StatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsRequest proto.InternalMessageInfo
+
+func (m *StatsRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type LanguageResponse struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Bytes                int64    `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LanguageResponse) Reset()         { *m = LanguageResponse{} }
+func (m *LanguageResponse) String() string { return proto.CompactTextString(m) }
+func (*LanguageResponse) ProtoMessage()    {}
+func (*LanguageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{44}
+}
+func (m *LanguageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LanguageResponse.Unmarshal(m, b)
+}
+func (m *LanguageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LanguageResponse.Marshal(b, m, deterministic)
+}
+func (dst *LanguageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LanguageResponse.Merge(dst, src)
+}
+func (m *LanguageResponse) XXX_Size() int {
+	return xxx_messageInfo_LanguageResponse.Size(m)
+}
+func (m *LanguageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LanguageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LanguageResponse proto.InternalMessageInfo
+
+func (m *LanguageResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LanguageResponse) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+type StatsResponse struct {
+	Size                 int64               `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	LooseObjects         int64               `protobuf:"varint,2,opt,name=loose_objects,json=looseObjects,proto3" json:"loose_objects,omitempty"`
+	PackedObjects        int64               `protobuf:"varint,3,opt,name=packed_objects,json=packedObjects,proto3" json:"packed_objects,omitempty"`
+	Commits              int64               `protobuf:"varint,4,opt,name=commits,proto3" json:"commits,omitempty"`
+	Languages            []*LanguageResponse `protobuf:"bytes,5,rep,name=languages,proto3" json:"languages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *StatsResponse) Reset()         { *m = StatsResponse{} }
+func (m *StatsResponse) String() string { return proto.CompactTextString(m) }
+func (*StatsResponse) ProtoMessage()    {}
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{45}
+}
+func (m *StatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatsResponse.Unmarshal(m, b)
+}
+func (m *StatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *StatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatsResponse.Merge(dst, src)
+}
+func (m *StatsResponse) XXX_Size() int {
+	return xxx_messageInfo_StatsResponse.Size(m)
+}
+func (m *StatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatsResponse proto.InternalMessageInfo
+
+func (m *StatsResponse) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *StatsResponse) GetLooseObjects() int64 {
+	if m != nil {
+		return m.LooseObjects
+	}
+	return 0
+}
+
+func (m *StatsResponse) GetPackedObjects() int64 {
+	if m != nil {
+		return m.PackedObjects
+	}
+	return 0
+}
+
+func (m *StatsResponse) GetCommits() int64 {
+	if m != nil {
+		return m.Commits
+	}
+	return 0
+}
+
+func (m *StatsResponse) GetLanguages() []*LanguageResponse {
+	if m != nil {
+		return m.Languages
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rev   string `protobuf:"bytes,2,opt,name=rev,proto3" json:"rev,omitempty"`
+	Query string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// Interpret the query as regular expression instead of a fixed string.
+	Regexp     bool `protobuf:"varint,4,opt,name=regexp,proto3" json:"regexp,omitempty"`
+	IgnoreCase bool `protobuf:"varint,5,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+	// Only search files matching one of the globs.
+	Paths []string `protobuf:"bytes,6,rep,name=paths,proto3" json:"paths,omitempty"`
+	Limit int32    `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Number of lines before and after each match.
+	Context              int32    `protobuf:"varint,8,opt,name=context,proto3" json:"context,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{46}
+}
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+}
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+}
+func (dst *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(dst, src)
+}
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
+}
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+
+func (m *SearchRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetRev() string {
+	if m != nil {
+		return m.Rev
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchRequest) GetRegexp() bool {
+	if m != nil {
+		return m.Regexp
+	}
+	return false
+}
+
+func (m *SearchRequest) GetIgnoreCase() bool {
+	if m != nil {
+		return m.IgnoreCase
+	}
+	return false
+}
+
+func (m *SearchRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+func (m *SearchRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetContext() int32 {
+	if m != nil {
+		return m.Context
+	}
+	return 0
+}
+
+type SearchMatchResponse struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Line                 int32    `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	Text                 string   `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Before               []string `protobuf:"bytes,4,rep,name=before,proto3" json:"before,omitempty"`
+	After                []string `protobuf:"bytes,5,rep,name=after,proto3" json:"after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchMatchResponse) Reset()         { *m = SearchMatchResponse{} }
+func (m *SearchMatchResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchMatchResponse) ProtoMessage()    {}
+func (*SearchMatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{47}
+}
+func (m *SearchMatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchMatchResponse.Unmarshal(m, b)
+}
+func (m *SearchMatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchMatchResponse.Marshal(b, m, deterministic)
+}
+func (dst *SearchMatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchMatchResponse.Merge(dst, src)
+}
+func (m *SearchMatchResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchMatchResponse.Size(m)
+}
+func (m *SearchMatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchMatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchMatchResponse proto.InternalMessageInfo
+
+func (m *SearchMatchResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SearchMatchResponse) GetLine() int32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *SearchMatchResponse) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *SearchMatchResponse) GetBefore() []string {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *SearchMatchResponse) GetAfter() []string {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+type SearchResponse struct {
+	Matches              []*SearchMatchResponse `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SearchResponse) Reset()         { *m = SearchResponse{} }
+func (m *SearchResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchResponse) ProtoMessage()    {}
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{48}
+}
+func (m *SearchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResponse.Unmarshal(m, b)
+}
+func (m *SearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResponse.Marshal(b, m, deterministic)
+}
+func (dst *SearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResponse.Merge(dst, src)
+}
+func (m *SearchResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchResponse.Size(m)
+}
+func (m *SearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchResponse proto.InternalMessageInfo
+
+func (m *SearchResponse) GetMatches() []*SearchMatchResponse {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+type IndexSearchRequest struct {
+	// The repositories to search, their default branches have to be indexed.
+	Ids        []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Query      string   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	IgnoreCase bool     `protobuf:"varint,3,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+	// Maximum number of matches per repository.
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexSearchRequest) Reset()         { *m = IndexSearchRequest{} }
+func (m *IndexSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*IndexSearchRequest) ProtoMessage()    {}
+func (*IndexSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{49}
+}
+func (m *IndexSearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexSearchRequest.Unmarshal(m, b)
+}
+func (m *IndexSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexSearchRequest.Marshal(b, m, deterministic)
+}
+func (dst *IndexSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexSearchRequest.Merge(dst, src)
+}
+func (m *IndexSearchRequest) XXX_Size() int {
+	return xxx_messageInfo_IndexSearchRequest.Size(m)
+}
+func (m *IndexSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexSearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexSearchRequest proto.InternalMessageInfo
+
+func (m *IndexSearchRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *IndexSearchRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *IndexSearchRequest) GetIgnoreCase() bool {
+	if m != nil {
+		return m.IgnoreCase
+	}
+	return false
+}
+
+func (m *IndexSearchRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type IndexMatchResponse struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Line                 int32    `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	Text                 string   `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IndexMatchResponse) Reset()         { *m = IndexMatchResponse{} }
+func (m *IndexMatchResponse) String() string { return proto.CompactTextString(m) }
+func (*IndexMatchResponse) ProtoMessage()    {}
+func (*IndexMatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{50}
+}
+func (m *IndexMatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexMatchResponse.Unmarshal(m, b)
+}
+func (m *IndexMatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexMatchResponse.Marshal(b, m, deterministic)
+}
+func (dst *IndexMatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexMatchResponse.Merge(dst, src)
+}
+func (m *IndexMatchResponse) XXX_Size() int {
+	return xxx_messageInfo_IndexMatchResponse.Size(m)
+}
+func (m *IndexMatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexMatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexMatchResponse proto.InternalMessageInfo
+
+func (m *IndexMatchResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *IndexMatchResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *IndexMatchResponse) GetLine() int32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *IndexMatchResponse) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type IndexSearchResponse struct {
+	Matches              []*IndexMatchResponse `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *IndexSearchResponse) Reset()         { *m = IndexSearchResponse{} }
+func (m *IndexSearchResponse) String() string { return proto.CompactTextString(m) }
+func (*IndexSearchResponse) ProtoMessage()    {}
+func (*IndexSearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{51}
+}
+func (m *IndexSearchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexSearchResponse.Unmarshal(m, b)
+}
+func (m *IndexSearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexSearchResponse.Marshal(b, m, deterministic)
+}
+func (dst *IndexSearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexSearchResponse.Merge(dst, src)
+}
+func (m *IndexSearchResponse) XXX_Size() int {
+	return xxx_messageInfo_IndexSearchResponse.Size(m)
+}
+func (m *IndexSearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexSearchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexSearchResponse proto.InternalMessageInfo
+
+func (m *IndexSearchResponse) GetMatches() []*IndexMatchResponse {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+type PushesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushesRequest) Reset()         { *m = PushesRequest{} }
+func (m *PushesRequest) String() string { return proto.CompactTextString(m) }
+func (*PushesRequest) ProtoMessage()    {}
+func (*PushesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{52}
+}
+func (m *PushesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushesRequest.Unmarshal(m, b)
+}
+func (m *PushesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushesRequest.Marshal(b, m, deterministic)
+}
+func (dst *PushesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushesRequest.Merge(dst, src)
+}
+func (m *PushesRequest) XXX_Size() int {
+	return xxx_messageInfo_PushesRequest.Size(m)
+}
+func (m *PushesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushesRequest proto.InternalMessageInfo
+
+type RefUpdateResponse struct {
+	Ref string `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// Empty for created refs.
+	Old string `protobuf:"bytes,2,opt,name=old,proto3" json:"old,omitempty"`
+	// Empty for deleted refs.
+	New                  string   `protobuf:"bytes,3,opt,name=new,proto3" json:"new,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefUpdateResponse) Reset()         { *m = RefUpdateResponse{} }
+func (m *RefUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*RefUpdateResponse) ProtoMessage()    {}
+func (*RefUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{53}
+}
+func (m *RefUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefUpdateResponse.Unmarshal(m, b)
+}
+func (m *RefUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefUpdateResponse.Marshal(b, m, deterministic)
+}
+func (dst *RefUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefUpdateResponse.Merge(dst, src)
+}
+func (m *RefUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_RefUpdateResponse.Size(m)
+}
+func (m *RefUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefUpdateResponse proto.InternalMessageInfo
+
+func (m *RefUpdateResponse) GetRef() string {
+	if m != nil {
+		return m.Ref
+	}
+	return ""
+}
+
+func (m *RefUpdateResponse) GetOld() string {
+	if m != nil {
+		return m.Old
+	}
+	return ""
+}
+
+func (m *RefUpdateResponse) GetNew() string {
+	if m != nil {
+		return m.New
+	}
+	return ""
+}
+
+type PushResponse struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Refs                 []*RefUpdateResponse `protobuf:"bytes,2,rep,name=refs,proto3" json:"refs,omitempty"`
+	Pushed               int64                `protobuf:"varint,3,opt,name=pushed,proto3" json:"pushed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PushResponse) Reset()         { *m = PushResponse{} }
+func (m *PushResponse) String() string { return proto.CompactTextString(m) }
+func (*PushResponse) ProtoMessage()    {}
+func (*PushResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{54}
+}
+func (m *PushResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushResponse.Unmarshal(m, b)
+}
+func (m *PushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushResponse.Marshal(b, m, deterministic)
+}
+func (dst *PushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushResponse.Merge(dst, src)
+}
+func (m *PushResponse) XXX_Size() int {
+	return xxx_messageInfo_PushResponse.Size(m)
+}
+func (m *PushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushResponse proto.InternalMessageInfo
+
+func (m *PushResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PushResponse) GetRefs() []*RefUpdateResponse {
+	if m != nil {
+		return m.Refs
+	}
+	return nil
+}
+
+func (m *PushResponse) GetPushed() int64 {
+	if m != nil {
+		return m.Pushed
+	}
+	return 0
+}
+
+type MaintainRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaintainRequest) Reset()         { *m = MaintainRequest{} }
+func (m *MaintainRequest) String() string { return proto.CompactTextString(m) }
+func (*MaintainRequest) ProtoMessage()    {}
+func (*MaintainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{55}
+}
+func (m *MaintainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintainRequest.Unmarshal(m, b)
+}
+func (m *MaintainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintainRequest.Marshal(b, m, deterministic)
+}
+func (dst *MaintainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintainRequest.Merge(dst, src)
+}
+func (m *MaintainRequest) XXX_Size() int {
+	return xxx_messageInfo_MaintainRequest.Size(m)
+}
+func (m *MaintainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintainRequest proto.InternalMessageInfo
+
+func (m *MaintainRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MaintainRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type MaintenanceStatusRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaintenanceStatusRequest) Reset()         { *m = MaintenanceStatusRequest{} }
+func (m *MaintenanceStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceStatusRequest) ProtoMessage()    {}
+func (*MaintenanceStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{56}
+}
+func (m *MaintenanceStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintenanceStatusRequest.Unmarshal(m, b)
+}
+func (m *MaintenanceStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintenanceStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *MaintenanceStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceStatusRequest.Merge(dst, src)
+}
+func (m *MaintenanceStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_MaintenanceStatusRequest.Size(m)
+}
+func (m *MaintenanceStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceStatusRequest proto.InternalMessageInfo
+
+func (m *MaintenanceStatusRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type MaintenanceStatusResponse struct {
+	// Zero if the repository has never been maintained.
+	LastRun     int64 `protobuf:"varint,1,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	LastSuccess int64 `protobuf:"varint,2,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	LastFsck    int64 `protobuf:"varint,3,opt,name=last_fsck,json=lastFsck,proto3" json:"last_fsck,omitempty"`
+	// Empty if the last run succeeded.
+	LastError            string   `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Tasks                []string `protobuf:"bytes,5,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	LooseObjects         int64    `protobuf:"varint,6,opt,name=loose_objects,json=looseObjects,proto3" json:"loose_objects,omitempty"`
+	Packs                int64    `protobuf:"varint,7,opt,name=packs,proto3" json:"packs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MaintenanceStatusResponse) Reset()         { *m = MaintenanceStatusResponse{} }
+func (m *MaintenanceStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceStatusResponse) ProtoMessage()    {}
+func (*MaintenanceStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_8aa5529174a8d9b9, []int{57}
+}
+func (m *MaintenanceStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MaintenanceStatusResponse.Unmarshal(m, b)
+}
+func (m *MaintenanceStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MaintenanceStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *MaintenanceStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceStatusResponse.Merge(dst, src)
+}
+func (m *MaintenanceStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_MaintenanceStatusResponse.Size(m)
+}
+func (m *MaintenanceStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceStatusResponse proto.InternalMessageInfo
+
+func (m *MaintenanceStatusResponse) GetLastRun() int64 {
+	if m != nil {
+		return m.LastRun
+	}
+	return 0
+}
+
+func (m *MaintenanceStatusResponse) GetLastSuccess() int64 {
+	if m != nil {
+		return m.LastSuccess
+	}
+	return 0
+}
+
+func (m *MaintenanceStatusResponse) GetLastFsck() int64 {
+	if m != nil {
+		return m.LastFsck
+	}
+	return 0
+}
+
+func (m *MaintenanceStatusResponse) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *MaintenanceStatusResponse) GetTasks() []string {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func (m *MaintenanceStatusResponse) GetLooseObjects() int64 {
+	if m != nil {
+		return m.LooseObjects
+	}
+	return 0
+}
+
+func (m *MaintenanceStatusResponse) GetPacks() int64 {
+	if m != nil {
+		return m.Packs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GRERequest)(nil), "storage.GRERequest")
+	proto.RegisterType((*GREResponse)(nil), "storage.GREResponse")
+	proto.RegisterType((*GREExitCode)(nil), "storage.GREExitCode")
+	proto.RegisterType((*CreateRequest)(nil), "storage.CreateRequest")
+	proto.RegisterType((*ForkRequest)(nil), "storage.ForkRequest")
+	proto.RegisterType((*DeleteRequest)(nil), "storage.DeleteRequest")
+	proto.RegisterType((*DissociateRequest)(nil), "storage.DissociateRequest")
+	proto.RegisterType((*ImportRequest)(nil), "storage.ImportRequest")
+	proto.RegisterType((*ImportResponse)(nil), "storage.ImportResponse")
+	proto.RegisterType((*MirrorRequest)(nil), "storage.MirrorRequest")
+	proto.RegisterType((*FetchRefRequest)(nil), "storage.FetchRefRequest")
+	proto.RegisterType((*FetchRefResponse)(nil), "storage.FetchRefResponse")
+	proto.RegisterType((*SetDescriptionRequest)(nil), "storage.SetDescriptionRequest")
+	proto.RegisterType((*BranchesRequest)(nil), "storage.BranchesRequest")
+	proto.RegisterType((*BranchResponse)(nil), "storage.BranchResponse")
+	proto.RegisterType((*BranchesResponse)(nil), "storage.BranchesResponse")
+	proto.RegisterType((*CreateBranchRequest)(nil), "storage.CreateBranchRequest")
+	proto.RegisterType((*DeleteBranchRequest)(nil), "storage.DeleteBranchRequest")
+	proto.RegisterType((*RenameBranchRequest)(nil), "storage.RenameBranchRequest")
+	proto.RegisterType((*SetDefaultBranchRequest)(nil), "storage.SetDefaultBranchRequest")
+	proto.RegisterType((*SignatureRequest)(nil), "storage.SignatureRequest")
+	proto.RegisterType((*MergeRequest)(nil), "storage.MergeRequest")
+	proto.RegisterType((*MergeResponse)(nil), "storage.MergeResponse")
+	proto.RegisterType((*CommitRequest)(nil), "storage.CommitRequest")
+	proto.RegisterType((*CommitResponse)(nil), "storage.CommitResponse")
+	proto.RegisterType((*CommitsRequest)(nil), "storage.CommitsRequest")
+	proto.RegisterType((*CommitsResponse)(nil), "storage.CommitsResponse")
+	proto.RegisterType((*DiffRequest)(nil), "storage.DiffRequest")
+	proto.RegisterType((*FileDiffResponse)(nil), "storage.FileDiffResponse")
+	proto.RegisterType((*DiffResponse)(nil), "storage.DiffResponse")
+	proto.RegisterType((*MergeableRequest)(nil), "storage.MergeableRequest")
+	proto.RegisterType((*MergeableResponse)(nil), "storage.MergeableResponse")
+	proto.RegisterType((*TreeRequest)(nil), "storage.TreeRequest")
+	proto.RegisterType((*TreeEntryResponse)(nil), "storage.TreeEntryResponse")
+	proto.RegisterType((*TreeResponse)(nil), "storage.TreeResponse")
+	proto.RegisterType((*ArchiveRequest)(nil), "storage.ArchiveRequest")
+	proto.RegisterType((*ArchiveResponse)(nil), "storage.ArchiveResponse")
+	proto.RegisterType((*BlobRequest)(nil), "storage.BlobRequest")
+	proto.RegisterType((*BlobResponse)(nil), "storage.BlobResponse")
+	proto.RegisterType((*ReadBlobRequest)(nil), "storage.ReadBlobRequest")
+	proto.RegisterType((*ReadBlobResponse)(nil), "storage.ReadBlobResponse")
+	proto.RegisterType((*BlameRequest)(nil), "storage.BlameRequest")
+	proto.RegisterType((*BlameResponse)(nil), "storage.BlameResponse")
+	proto.RegisterType((*StatsRequest)(nil), "storage.StatsRequest")
+	proto.RegisterType((*LanguageResponse)(nil), "storage.LanguageResponse")
+	proto.RegisterType((*StatsResponse)(nil), "storage.StatsResponse")
+	proto.RegisterType((*SearchRequest)(nil), "storage.SearchRequest")
+	proto.RegisterType((*SearchMatchResponse)(nil), "storage.SearchMatchResponse")
+	proto.RegisterType((*SearchResponse)(nil), "storage.SearchResponse")
+	proto.RegisterType((*IndexSearchRequest)(nil), "storage.IndexSearchRequest")
+	proto.RegisterType((*IndexMatchResponse)(nil), "storage.IndexMatchResponse")
+	proto.RegisterType((*IndexSearchResponse)(nil), "storage.IndexSearchResponse")
+	proto.RegisterType((*PushesRequest)(nil), "storage.PushesRequest")
+	proto.RegisterType((*RefUpdateResponse)(nil), "storage.RefUpdateResponse")
+	proto.RegisterType((*PushResponse)(nil), "storage.PushResponse")
+	proto.RegisterType((*MaintainRequest)(nil), "storage.MaintainRequest")
+	proto.RegisterType((*MaintenanceStatusRequest)(nil), "storage.MaintenanceStatusRequest")
+	proto.RegisterType((*MaintenanceStatusResponse)(nil), "storage.MaintenanceStatusResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RepositoryClient is the client API for Repository service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RepositoryClient interface {
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Fork(ctx context.Context, in *ForkRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Dissociate(ctx context.Context, in *DissociateRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (Repository_ImportClient, error)
+	Mirror(ctx context.Context, in *MirrorRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	FetchRef(ctx context.Context, in *FetchRefRequest, opts ...grpc.CallOption) (*FetchRefResponse, error)
+	SetDescriptions(ctx context.Context, in *SetDescriptionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Tree(ctx context.Context, in *TreeRequest, opts ...grpc.CallOption) (*TreeResponse, error)
+	Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (Repository_ArchiveClient, error)
+	Blob(ctx context.Context, in *BlobRequest, opts ...grpc.CallOption) (*BlobResponse, error)
+	ReadBlob(ctx context.Context, in *ReadBlobRequest, opts ...grpc.CallOption) (Repository_ReadBlobClient, error)
+	Blame(ctx context.Context, in *BlameRequest, opts ...grpc.CallOption) (Repository_BlameClient, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+}
+
+type repositoryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRepositoryClient(cc *grpc.ClientConn) RepositoryClient {
+	return &repositoryClient{cc}
+}
+
+func (c *repositoryClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/storage.Repository/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) Fork(ctx context.Context, in *ForkRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/storage.Repository/Fork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/storage.Repository/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) Dissociate(ctx context.Context, in *DissociateRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/storage.Repository/Dissociate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (Repository_ImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Repository_serviceDesc.Streams[0], "/storage.Repository/Import", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repositoryImportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Repository_ImportClient interface {
+	Recv() (*ImportResponse, error)
+	grpc.ClientStream
+}
+
+type repositoryImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *repositoryImportClient) Recv() (*ImportResponse, error) {
+	m := new(ImportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *repositoryClient) Mirror(ctx context.Context, in *MirrorRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/storage.Repository/Mirror", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) FetchRef(ctx context.Context, in *FetchRefRequest, opts ...grpc.CallOption) (*FetchRefResponse, error) {
+	out := new(FetchRefResponse)
+	err := c.cc.Invoke(ctx, "/storage.Repository/FetchRef", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) SetDescriptions(ctx context.Context, in *SetDescriptionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/storage.Repository/SetDescriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) Tree(ctx context.Context, in *TreeRequest, opts ...grpc.CallOption) (*TreeResponse, error) {
+	out := new(TreeResponse)
+	err := c.cc.Invoke(ctx, "/storage.Repository/Tree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (Repository_ArchiveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Repository_serviceDesc.Streams[1], "/storage.Repository/Archive", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repositoryArchiveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Repository_ArchiveClient interface {
+	Recv() (*ArchiveResponse, error)
+	grpc.ClientStream
+}
+
+type repositoryArchiveClient struct {
+	grpc.ClientStream
+}
+
+func (x *repositoryArchiveClient) Recv() (*ArchiveResponse, error) {
+	m := new(ArchiveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *repositoryClient) Blob(ctx context.Context, in *BlobRequest, opts ...grpc.CallOption) (*BlobResponse, error) {
+	out := new(BlobResponse)
+	err := c.cc.Invoke(ctx, "/storage.Repository/Blob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) ReadBlob(ctx context.Context, in *ReadBlobRequest, opts ...grpc.CallOption) (Repository_ReadBlobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Repository_serviceDesc.Streams[2], "/storage.Repository/ReadBlob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repositoryReadBlobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Repository_ReadBlobClient interface {
+	Recv() (*ReadBlobResponse, error)
+	grpc.ClientStream
+}
+
+type repositoryReadBlobClient struct {
+	grpc.ClientStream
+}
+
+func (x *repositoryReadBlobClient) Recv() (*ReadBlobResponse, error) {
+	m := new(ReadBlobResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *repositoryClient) Blame(ctx context.Context, in *BlameRequest, opts ...grpc.CallOption) (Repository_BlameClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Repository_serviceDesc.Streams[3], "/storage.Repository/Blame", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repositoryBlameClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Repository_BlameClient interface {
+	Recv() (*BlameResponse, error)
+	grpc.ClientStream
+}
+
+type repositoryBlameClient struct {
+	grpc.ClientStream
+}
+
+func (x *repositoryBlameClient) Recv() (*BlameResponse, error) {
+	m := new(BlameResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *repositoryClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/storage.Repository/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repositoryClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, "/storage.Repository/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RepositoryServer is the server API for Repository service.
+type RepositoryServer interface {
+	Create(context.Context, *CreateRequest) (*empty.Empty, error)
+	Fork(context.Context, *ForkRequest) (*empty.Empty, error)
+	Delete(context.Context, *DeleteRequest) (*empty.Empty, error)
+	Dissociate(context.Context, *DissociateRequest) (*empty.Empty, error)
+	Import(*ImportRequest, Repository_ImportServer) error
+	Mirror(context.Context, *MirrorRequest) (*empty.Empty, error)
+	FetchRef(context.Context, *FetchRefRequest) (*FetchRefResponse, error)
+	SetDescriptions(context.Context, *SetDescriptionRequest) (*empty.Empty, error)
+	Tree(context.Context, *TreeRequest) (*TreeResponse, error)
+	Archive(*ArchiveRequest, Repository_ArchiveServer) error
+	Blob(context.Context, *BlobRequest) (*BlobResponse, error)
+	ReadBlob(*ReadBlobRequest, Repository_ReadBlobServer) error
+	Blame(*BlameRequest, Repository_BlameServer) error
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+}
+
+func RegisterRepositoryServer(s *grpc.Server, srv RepositoryServer) {
+	s.RegisterService(&_Repository_serviceDesc, srv)
+}
+
+func _Repository_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Repository/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_Fork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).Fork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Repository/Fork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Fork(ctx, req.(*ForkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Repository/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_Dissociate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DissociateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).Dissociate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Repository/Dissociate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Dissociate(ctx, req.(*DissociateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepositoryServer).Import(m, &repositoryImportServer{stream})
+}
+
+type Repository_ImportServer interface {
+	Send(*ImportResponse) error
+	grpc.ServerStream
+}
+
+type repositoryImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *repositoryImportServer) Send(m *ImportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Repository_Mirror_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MirrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).Mirror(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Repository/Mirror",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Mirror(ctx, req.(*MirrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_FetchRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).FetchRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Repository/FetchRef",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).FetchRef(ctx, req.(*FetchRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_SetDescriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDescriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).SetDescriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Repository/SetDescriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).SetDescriptions(ctx, req.(*SetDescriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_Tree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).Tree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Repository/Tree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Tree(ctx, req.(*TreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_Archive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepositoryServer).Archive(m, &repositoryArchiveServer{stream})
+}
+
+type Repository_ArchiveServer interface {
+	Send(*ArchiveResponse) error
+	grpc.ServerStream
+}
+
+type repositoryArchiveServer struct {
+	grpc.ServerStream
+}
+
+func (x *repositoryArchiveServer) Send(m *ArchiveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Repository_Blob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).Blob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Repository/Blob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Blob(ctx, req.(*BlobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_ReadBlob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadBlobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepositoryServer).ReadBlob(m, &repositoryReadBlobServer{stream})
+}
+
+type Repository_ReadBlobServer interface {
+	Send(*ReadBlobResponse) error
+	grpc.ServerStream
+}
+
+type repositoryReadBlobServer struct {
+	grpc.ServerStream
+}
+
+func (x *repositoryReadBlobServer) Send(m *ReadBlobResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Repository_Blame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepositoryServer).Blame(m, &repositoryBlameServer{stream})
+}
+
+type Repository_BlameServer interface {
+	Send(*BlameResponse) error
+	grpc.ServerStream
+}
+
+type repositoryBlameServer struct {
+	grpc.ServerStream
+}
+
+func (x *repositoryBlameServer) Send(m *BlameResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Repository_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Repository/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repository_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Repository/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServer).Stats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Repository_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "storage.Repository",
+	HandlerType: (*RepositoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Repository_Create_Handler,
+		},
+		{
+			MethodName: "Fork",
+			Handler:    _Repository_Fork_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Repository_Delete_Handler,
+		},
+		{
+			MethodName: "Dissociate",
+			Handler:    _Repository_Dissociate_Handler,
+		},
+		{
+			MethodName: "Mirror",
+			Handler:    _Repository_Mirror_Handler,
+		},
+		{
+			MethodName: "FetchRef",
+			Handler:    _Repository_FetchRef_Handler,
+		},
+		{
+			MethodName: "SetDescriptions",
+			Handler:    _Repository_SetDescriptions_Handler,
+		},
+		{
+			MethodName: "Tree",
+			Handler:    _Repository_Tree_Handler,
+		},
+		{
+			MethodName: "Blob",
+			Handler:    _Repository_Blob_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Repository_Search_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Repository_Stats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Import",
+			Handler:       _Repository_Import_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Archive",
+			Handler:       _Repository_Archive_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReadBlob",
+			Handler:       _Repository_ReadBlob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Blame",
+			Handler:       _Repository_Blame_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/storage/storage.proto",
+}
+
+// BranchClient is the client API for Branch service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BranchClient interface {
+	List(ctx context.Context, in *BranchesRequest, opts ...grpc.CallOption) (*BranchesResponse, error)
+	Create(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*BranchResponse, error)
+	Delete(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Rename(ctx context.Context, in *RenameBranchRequest, opts ...grpc.CallOption) (*BranchResponse, error)
+	Merge(ctx context.Context, in *MergeRequest, opts ...grpc.CallOption) (*MergeResponse, error)
+	SetDefault(ctx context.Context, in *SetDefaultBranchRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type branchClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBranchClient(cc *grpc.ClientConn) BranchClient {
+	return &branchClient{cc}
+}
+
+func (c *branchClient) List(ctx context.Context, in *BranchesRequest, opts ...grpc.CallOption) (*BranchesResponse, error) {
+	out := new(BranchesResponse)
+	err := c.cc.Invoke(ctx, "/storage.Branch/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchClient) Create(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*BranchResponse, error) {
+	out := new(BranchResponse)
+	err := c.cc.Invoke(ctx, "/storage.Branch/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchClient) Delete(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/storage.Branch/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchClient) Rename(ctx context.Context, in *RenameBranchRequest, opts ...grpc.CallOption) (*BranchResponse, error) {
+	out := new(BranchResponse)
+	err := c.cc.Invoke(ctx, "/storage.Branch/Rename", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchClient) Merge(ctx context.Context, in *MergeRequest, opts ...grpc.CallOption) (*MergeResponse, error) {
+	out := new(MergeResponse)
+	err := c.cc.Invoke(ctx, "/storage.Branch/Merge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *branchClient) SetDefault(ctx context.Context, in *SetDefaultBranchRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/storage.Branch/SetDefault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BranchServer is the server API for Branch service.
+type BranchServer interface {
+	List(context.Context, *BranchesRequest) (*BranchesResponse, error)
+	Create(context.Context, *CreateBranchRequest) (*BranchResponse, error)
+	Delete(context.Context, *DeleteBranchRequest) (*empty.Empty, error)
+	Rename(context.Context, *RenameBranchRequest) (*BranchResponse, error)
+	Merge(context.Context, *MergeRequest) (*MergeResponse, error)
+	SetDefault(context.Context, *SetDefaultBranchRequest) (*empty.Empty, error)
+}
+
+func RegisterBranchServer(s *grpc.Server, srv BranchServer) {
+	s.RegisterService(&_Branch_serviceDesc, srv)
+}
+
+func _Branch_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BranchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Branch/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServer).List(ctx, req.(*BranchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Branch_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Branch/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServer).Create(ctx, req.(*CreateBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRepositoryServer(s *grpc.Server, srv RepositoryServer) {
-	s.RegisterService(&_Repository_serviceDesc, srv)
+func _Branch_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Branch/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServer).Delete(ctx, req.(*DeleteBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRequest)
+func _Branch_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameBranchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).Create(ctx, in)
+		return srv.(BranchServer).Rename(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/storage.Repository/Create",
+		FullMethod: "/storage.Branch/Rename",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).Create(ctx, req.(*CreateRequest))
+		return srv.(BranchServer).Rename(ctx, req.(*RenameBranchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_SetDescriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetDescriptionRequest)
+func _Branch_Merge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).SetDescriptions(ctx, in)
+		return srv.(BranchServer).Merge(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/storage.Repository/SetDescriptions",
+		FullMethod: "/storage.Branch/Merge",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).SetDescriptions(ctx, req.(*SetDescriptionRequest))
+		return srv.(BranchServer).Merge(ctx, req.(*MergeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Repository_Tree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TreeRequest)
+func _Branch_SetDefault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultBranchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RepositoryServer).Tree(ctx, in)
+		return srv.(BranchServer).SetDefault(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/storage.Repository/Tree",
+		FullMethod: "/storage.Branch/SetDefault",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RepositoryServer).Tree(ctx, req.(*TreeRequest))
+		return srv.(BranchServer).SetDefault(ctx, req.(*SetDefaultBranchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Repository_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "storage.Repository",
-	HandlerType: (*RepositoryServer)(nil),
+var _Branch_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "storage.Branch",
+	HandlerType: (*BranchServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Branch_List_Handler,
+		},
 		{
 			MethodName: "Create",
-			Handler:    _Repository_Create_Handler,
+			Handler:    _Branch_Create_Handler,
 		},
 		{
-			MethodName: "SetDescriptions",
-			Handler:    _Repository_SetDescriptions_Handler,
+			MethodName: "Delete",
+			Handler:    _Branch_Delete_Handler,
 		},
 		{
-			MethodName: "Tree",
-			Handler:    _Repository_Tree_Handler,
+			MethodName: "Rename",
+			Handler:    _Branch_Rename_Handler,
+		},
+		{
+			MethodName: "Merge",
+			Handler:    _Branch_Merge_Handler,
+		},
+		{
+			MethodName: "SetDefault",
+			Handler:    _Branch_SetDefault_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/storage/storage.proto",
 }
 
-// BranchClient is the client API for Branch service.
+// IndexClient is the client API for Index service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BranchClient interface {
-	List(ctx context.Context, in *BranchesRequest, opts ...grpc.CallOption) (*BranchesResponse, error)
+type IndexClient interface {
+	Search(ctx context.Context, in *IndexSearchRequest, opts ...grpc.CallOption) (*IndexSearchResponse, error)
 }
 
-type branchClient struct {
+type indexClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewBranchClient(cc *grpc.ClientConn) BranchClient {
-	return &branchClient{cc}
+func NewIndexClient(cc *grpc.ClientConn) IndexClient {
+	return &indexClient{cc}
 }
 
-func (c *branchClient) List(ctx context.Context, in *BranchesRequest, opts ...grpc.CallOption) (*BranchesResponse, error) {
-	out := new(BranchesResponse)
-	err := c.cc.Invoke(ctx, "/storage.Branch/List", in, out, opts...)
+func (c *indexClient) Search(ctx context.Context, in *IndexSearchRequest, opts ...grpc.CallOption) (*IndexSearchResponse, error) {
+	out := new(IndexSearchResponse)
+	err := c.cc.Invoke(ctx, "/storage.Index/Search", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// BranchServer is the server API for Branch service.
-type BranchServer interface {
-	List(context.Context, *BranchesRequest) (*BranchesResponse, error)
+// IndexServer is the server API for Index service.
+type IndexServer interface {
+	Search(context.Context, *IndexSearchRequest) (*IndexSearchResponse, error)
 }
 
-func RegisterBranchServer(s *grpc.Server, srv BranchServer) {
-	s.RegisterService(&_Branch_serviceDesc, srv)
+func RegisterIndexServer(s *grpc.Server, srv IndexServer) {
+	s.RegisterService(&_Index_serviceDesc, srv)
 }
 
-func _Branch_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BranchesRequest)
+func _Index_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexSearchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BranchServer).List(ctx, in)
+		return srv.(IndexServer).Search(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/storage.Branch/List",
+		FullMethod: "/storage.Index/Search",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BranchServer).List(ctx, req.(*BranchesRequest))
+		return srv.(IndexServer).Search(ctx, req.(*IndexSearchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Branch_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "storage.Branch",
-	HandlerType: (*BranchServer)(nil),
+var _Index_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "storage.Index",
+	HandlerType: (*IndexServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "List",
-			Handler:    _Branch_List_Handler,
+			MethodName: "Search",
+			Handler:    _Index_Search_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -912,6 +4336,9 @@ var _Branch_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type CommitClient interface {
 	Get(ctx context.Context, in *CommitRequest, opts ...grpc.CallOption) (*CommitResponse, error)
+	List(ctx context.Context, in *CommitsRequest, opts ...grpc.CallOption) (*CommitsResponse, error)
+	Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffResponse, error)
+	Mergeable(ctx context.Context, in *MergeableRequest, opts ...grpc.CallOption) (*MergeableResponse, error)
 }
 
 type commitClient struct {
@@ -931,9 +4358,39 @@ func (c *commitClient) Get(ctx context.Context, in *CommitRequest, opts ...grpc.
 	return out, nil
 }
 
+func (c *commitClient) List(ctx context.Context, in *CommitsRequest, opts ...grpc.CallOption) (*CommitsResponse, error) {
+	out := new(CommitsResponse)
+	err := c.cc.Invoke(ctx, "/storage.Commit/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commitClient) Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffResponse, error) {
+	out := new(DiffResponse)
+	err := c.cc.Invoke(ctx, "/storage.Commit/Diff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commitClient) Mergeable(ctx context.Context, in *MergeableRequest, opts ...grpc.CallOption) (*MergeableResponse, error) {
+	out := new(MergeableResponse)
+	err := c.cc.Invoke(ctx, "/storage.Commit/Mergeable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommitServer is the server API for Commit service.
 type CommitServer interface {
 	Get(context.Context, *CommitRequest) (*CommitResponse, error)
+	List(context.Context, *CommitsRequest) (*CommitsResponse, error)
+	Diff(context.Context, *DiffRequest) (*DiffResponse, error)
+	Mergeable(context.Context, *MergeableRequest) (*MergeableResponse, error)
 }
 
 func RegisterCommitServer(s *grpc.Server, srv CommitServer) {
@@ -958,6 +4415,60 @@ func _Commit_Get_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Commit_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommitServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Commit/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommitServer).List(ctx, req.(*CommitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Commit_Diff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommitServer).Diff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Commit/Diff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommitServer).Diff(ctx, req.(*DiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Commit_Mergeable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommitServer).Mergeable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Commit/Mergeable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommitServer).Mergeable(ctx, req.(*MergeableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Commit_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "storage.Commit",
 	HandlerType: (*CommitServer)(nil),
@@ -966,6 +4477,18 @@ var _Commit_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _Commit_Get_Handler,
 		},
+		{
+			MethodName: "List",
+			Handler:    _Commit_List_Handler,
+		},
+		{
+			MethodName: "Diff",
+			Handler:    _Commit_Diff_Handler,
+		},
+		{
+			MethodName: "Mergeable",
+			Handler:    _Commit_Mergeable_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/storage/storage.proto",
@@ -1132,52 +4655,359 @@ var _SSH_serviceDesc = grpc.ServiceDesc{
 	Metadata: "pkg/storage/storage.proto",
 }
 
-func init() { proto.RegisterFile("pkg/storage/storage.proto", fileDescriptor_storage_8b11b3624739db72) }
-
-var fileDescriptor_storage_8b11b3624739db72 = []byte{
-	// 698 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xdd, 0x4e, 0xdb, 0x4a,
-	0x10, 0x96, 0x93, 0x10, 0xc8, 0x84, 0xbf, 0xb3, 0x27, 0x80, 0x09, 0x47, 0x9c, 0xd4, 0xaa, 0xaa,
-	0xa8, 0x17, 0x49, 0x09, 0x52, 0x55, 0x89, 0x5e, 0xb4, 0x0d, 0x16, 0x20, 0x51, 0x09, 0x2d, 0xed,
-	0x75, 0xe5, 0xc4, 0x43, 0xe2, 0x42, 0xbc, 0xee, 0xee, 0xa6, 0x22, 0x77, 0x7d, 0xa4, 0x3e, 0x45,
-	0x9f, 0xab, 0xda, 0x3f, 0xc7, 0x01, 0x22, 0x55, 0xbd, 0xf2, 0xcc, 0xe7, 0x99, 0xf9, 0xf6, 0x9b,
-	0x9d, 0x59, 0xd8, 0xcf, 0x6e, 0x47, 0x5d, 0x21, 0x19, 0x8f, 0x46, 0xe8, 0xbe, 0x9d, 0x8c, 0x33,
-	0xc9, 0xc8, 0xaa, 0x75, 0x9b, 0x07, 0x23, 0xc6, 0x46, 0x77, 0xd8, 0xd5, 0xf0, 0x60, 0x7a, 0xd3,
-	0xc5, 0x49, 0x26, 0x67, 0x26, 0x2a, 0xe8, 0x01, 0x9c, 0xd1, 0x90, 0xe2, 0xb7, 0x29, 0x0a, 0x49,
-	0x36, 0xa1, 0x94, 0xc4, 0xbe, 0xd7, 0xf2, 0xda, 0x35, 0x5a, 0x4a, 0x62, 0xd2, 0x80, 0x15, 0x21,
-	0xe3, 0x24, 0xf5, 0x4b, 0x2d, 0xaf, 0xbd, 0x4e, 0x8d, 0x13, 0x64, 0x50, 0xd7, 0x39, 0x22, 0x63,
-	0xa9, 0x40, 0xb2, 0x0b, 0x55, 0x21, 0x63, 0x36, 0x95, 0x3a, 0x71, 0x9d, 0x5a, 0xcf, 0xe2, 0xc8,
-	0xb9, 0xcd, 0xb6, 0x1e, 0x39, 0x82, 0x1a, 0xde, 0x27, 0xf2, 0xcb, 0x90, 0xc5, 0xe8, 0x97, 0x5b,
-	0x5e, 0xbb, 0xde, 0x6b, 0x74, 0xdc, 0xd9, 0xcf, 0x68, 0x18, 0xde, 0x27, 0xb2, 0xcf, 0x62, 0xa4,
-	0x6b, 0x68, 0xad, 0xe0, 0xa5, 0x66, 0x74, 0x3f, 0xc8, 0x41, 0xb1, 0x82, 0x22, 0x5d, 0x29, 0xc4,
-	0xfe, 0x0f, 0x1b, 0x7d, 0x8e, 0x91, 0xc4, 0x25, 0xa2, 0x82, 0x0b, 0xd8, 0xb9, 0x46, 0x79, 0x8a,
-	0x62, 0xc8, 0x93, 0x4c, 0x26, 0x2c, 0x5d, 0xa6, 0xbe, 0x05, 0xf5, 0x78, 0x1e, 0xa5, 0x55, 0xd4,
-	0x68, 0x11, 0x0a, 0x9e, 0xc1, 0xd6, 0x07, 0x1e, 0xa5, 0xc3, 0x31, 0x8a, 0x65, 0x6c, 0x97, 0xb0,
-	0x69, 0x42, 0xf2, 0x7e, 0x11, 0xa8, 0xa4, 0xd1, 0x04, 0x6d, 0x8c, 0xb6, 0x15, 0x26, 0xc6, 0xd1,
-	0x91, 0xe5, 0xd0, 0xb6, 0xc2, 0xe4, 0x2c, 0x33, 0x2d, 0xaa, 0x51, 0x6d, 0x07, 0x7d, 0xd8, 0x9e,
-	0x13, 0xda, 0x7a, 0x5d, 0xa8, 0x0e, 0x34, 0xe6, 0x7b, 0xad, 0x72, 0xbb, 0xde, 0xdb, 0xcb, 0x9b,
-	0xb9, 0x48, 0x4c, 0x6d, 0x58, 0x70, 0x04, 0x1b, 0x7d, 0x36, 0x99, 0x24, 0x72, 0x99, 0xf0, 0x6d,
-	0x28, 0x73, 0xbc, 0xb1, 0x87, 0x51, 0x66, 0xf0, 0xb3, 0x04, 0x9b, 0x2e, 0x67, 0x2e, 0xe3, 0x3c,
-	0x12, 0x63, 0x27, 0x43, 0xd9, 0x0a, 0xfb, 0xc4, 0x11, 0x9d, 0x0c, 0x65, 0xab, 0x31, 0xb8, 0x8a,
-	0x38, 0xa6, 0xd2, 0x0a, 0xb1, 0x1e, 0xf1, 0x61, 0xf5, 0x23, 0x0a, 0x11, 0x8d, 0xd0, 0xaf, 0xe8,
-	0x1f, 0xce, 0x55, 0x19, 0xef, 0xa7, 0x72, 0xcc, 0xb8, 0xbf, 0x62, 0x32, 0x8c, 0xa7, 0xee, 0xc3,
-	0x58, 0xe1, 0x24, 0x4a, 0xee, 0xfc, 0xaa, 0xb9, 0x8f, 0x02, 0x44, 0x0e, 0x01, 0x8c, 0x7b, 0x1a,
-	0x49, 0xf4, 0x57, 0x5b, 0x5e, 0xbb, 0x4c, 0x0b, 0x08, 0xf9, 0x0f, 0x6a, 0x46, 0x85, 0x44, 0xee,
-	0xaf, 0xe9, 0xfc, 0x39, 0x40, 0x5e, 0x38, 0x8d, 0x12, 0x2d, 0x45, 0x4d, 0x87, 0x3c, 0x40, 0xc9,
-	0x73, 0xd7, 0x3f, 0x89, 0x86, 0x08, 0x34, 0xd1, 0x22, 0x18, 0xf4, 0xa1, 0xae, 0xf4, 0xff, 0x71,
-	0x8f, 0x55, 0xf3, 0xb2, 0x48, 0x8e, 0xdd, 0x7d, 0x2b, 0x3b, 0x18, 0xc1, 0x3f, 0xaa, 0x48, 0x98,
-	0x4a, 0x3e, 0x2b, 0x76, 0x7e, 0xe2, 0x26, 0xbf, 0x46, 0xb5, 0x9d, 0x0f, 0x4b, 0x69, 0x3e, 0x2c,
-	0xaa, 0x8f, 0x6c, 0xf0, 0x15, 0x87, 0x79, 0xe7, 0x8d, 0x97, 0x13, 0x55, 0x0a, 0x44, 0x97, 0xb0,
-	0x6e, 0x4e, 0x6b, 0x39, 0xde, 0x42, 0x5d, 0x5a, 0xe2, 0x04, 0x85, 0x9d, 0xac, 0x66, 0x3e, 0x59,
-	0x8f, 0x0e, 0x45, 0x8b, 0xe1, 0xbd, 0x5f, 0x1e, 0x00, 0xc5, 0x8c, 0x89, 0x44, 0x32, 0x3e, 0x23,
-	0x6f, 0xa0, 0x6a, 0x56, 0x92, 0xec, 0xe6, 0x15, 0x16, 0x76, 0xb4, 0xb9, 0xdb, 0x31, 0x8f, 0x54,
-	0xc7, 0x3d, 0x52, 0x9d, 0x50, 0x3d, 0x52, 0xe4, 0x02, 0xb6, 0x16, 0x77, 0x55, 0x90, 0xc3, 0xbc,
-	0xc4, 0x93, 0x5b, 0xbc, 0xb4, 0xd4, 0xb1, 0x99, 0x4d, 0xd2, 0x58, 0x10, 0xe1, 0xb2, 0x76, 0x1e,
-	0xa0, 0x46, 0x55, 0x2f, 0x84, 0xaa, 0x59, 0x22, 0x72, 0x02, 0x95, 0xcb, 0x44, 0x48, 0xe2, 0x3f,
-	0xd8, 0xae, 0x7c, 0xf3, 0x9b, 0xfb, 0x4f, 0xfc, 0xb1, 0x65, 0xde, 0x41, 0xd5, 0x0c, 0x07, 0x79,
-	0x0d, 0xe5, 0x33, 0x94, 0xc5, 0x3e, 0x14, 0x37, 0xb1, 0xb9, 0xf7, 0x08, 0xb7, 0x15, 0x7e, 0x78,
-	0x50, 0xbe, 0xbe, 0x3e, 0x27, 0x27, 0x00, 0x9f, 0xb3, 0x3b, 0x16, 0xc5, 0x57, 0xd1, 0xf0, 0x96,
-	0xfc, 0x5b, 0x7c, 0x37, 0x5d, 0x8d, 0xc6, 0x22, 0x68, 0x0a, 0xb4, 0xbd, 0x57, 0x9e, 0xba, 0x54,
-	0x8a, 0x43, 0x4c, 0xbe, 0xe3, 0x5f, 0x64, 0x0f, 0xaa, 0xba, 0xa1, 0xc7, 0xbf, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x59, 0x81, 0x1f, 0xfb, 0x74, 0x06, 0x00, 0x00,
+// MaintenanceClient is the client API for Maintenance service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MaintenanceClient interface {
+	// Maintain runs the maintenance tasks the repository needs, or all of them if forced.
+	Maintain(ctx context.Context, in *MaintainRequest, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error)
+	Status(ctx context.Context, in *MaintenanceStatusRequest, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error)
+}
+
+type maintenanceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMaintenanceClient(cc *grpc.ClientConn) MaintenanceClient {
+	return &maintenanceClient{cc}
+}
+
+func (c *maintenanceClient) Maintain(ctx context.Context, in *MaintainRequest, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error) {
+	out := new(MaintenanceStatusResponse)
+	err := c.cc.Invoke(ctx, "/storage.Maintenance/Maintain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceClient) Status(ctx context.Context, in *MaintenanceStatusRequest, opts ...grpc.CallOption) (*MaintenanceStatusResponse, error) {
+	out := new(MaintenanceStatusResponse)
+	err := c.cc.Invoke(ctx, "/storage.Maintenance/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MaintenanceServer is the server API for Maintenance service.
+type MaintenanceServer interface {
+	// Maintain runs the maintenance tasks the repository needs, or all of them if forced.
+	Maintain(context.Context, *MaintainRequest) (*MaintenanceStatusResponse, error)
+	Status(context.Context, *MaintenanceStatusRequest) (*MaintenanceStatusResponse, error)
+}
+
+func RegisterMaintenanceServer(s *grpc.Server, srv MaintenanceServer) {
+	s.RegisterService(&_Maintenance_serviceDesc, srv)
+}
+
+func _Maintenance_Maintain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).Maintain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Maintenance/Maintain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).Maintain(ctx, req.(*MaintainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Maintenance_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/storage.Maintenance/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).Status(ctx, req.(*MaintenanceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Maintenance_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "storage.Maintenance",
+	HandlerType: (*MaintenanceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Maintain",
+			Handler:    _Maintenance_Maintain_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _Maintenance_Status_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/storage/storage.proto",
+}
+
+// EventsClient is the client API for Events service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventsClient interface {
+	// Pushes streams the pushes to all repositories from now on.
+	Pushes(ctx context.Context, in *PushesRequest, opts ...grpc.CallOption) (Events_PushesClient, error)
+}
+
+type eventsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventsClient(cc *grpc.ClientConn) EventsClient {
+	return &eventsClient{cc}
+}
+
+func (c *eventsClient) Pushes(ctx context.Context, in *PushesRequest, opts ...grpc.CallOption) (Events_PushesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Events_serviceDesc.Streams[0], "/storage.Events/Pushes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventsPushesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Events_PushesClient interface {
+	Recv() (*PushResponse, error)
+	grpc.ClientStream
+}
+
+type eventsPushesClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventsPushesClient) Recv() (*PushResponse, error) {
+	m := new(PushResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EventsServer is the server API for Events service.
+type EventsServer interface {
+	// Pushes streams the pushes to all repositories from now on.
+	Pushes(*PushesRequest, Events_PushesServer) error
+}
+
+func RegisterEventsServer(s *grpc.Server, srv EventsServer) {
+	s.RegisterService(&_Events_serviceDesc, srv)
+}
+
+func _Events_Pushes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PushesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventsServer).Pushes(m, &eventsPushesServer{stream})
+}
+
+type Events_PushesServer interface {
+	Send(*PushResponse) error
+	grpc.ServerStream
+}
+
+type eventsPushesServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventsPushesServer) Send(m *PushResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Events_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "storage.Events",
+	HandlerType: (*EventsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Pushes",
+			Handler:       _Events_Pushes_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/storage/storage.proto",
+}
+
+func init() { proto.RegisterFile("pkg/storage/storage.proto", fileDescriptor_storage_8aa5529174a8d9b9) }
+
+var fileDescriptor_storage_8aa5529174a8d9b9 = []byte{
+	// 2542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x19, 0xcb, 0x72, 0x1b, 0xc7,
+	0xb1, 0x16, 0x8b, 0x67, 0x83, 0x2f, 0x2d, 0x25, 0x0a, 0x5c, 0x31, 0x32, 0xbd, 0x8e, 0x15, 0x96,
+	0x2b, 0x45, 0x59, 0x54, 0x22, 0xdb, 0x91, 0x63, 0x87, 0x22, 0x29, 0x89, 0x8e, 0x18, 0xab, 0x96,
+	0xf6, 0x31, 0x41, 0x86, 0xbb, 0x03, 0x60, 0x43, 0x60, 0x17, 0x9e, 0x19, 0xf0, 0xe1, 0x4b, 0x7c,
+	0xcb, 0x2d, 0xb7, 0xe4, 0x1b, 0x72, 0x49, 0x95, 0x4f, 0xf9, 0x80, 0xb8, 0x2a, 0x9f, 0x92, 0x2f,
+	0xc8, 0x07, 0xa4, 0xe6, 0xb5, 0x33, 0x0b, 0x2c, 0x20, 0xc9, 0x3a, 0x61, 0xba, 0x67, 0xa6, 0xdf,
+	0xdd, 0xd3, 0xdb, 0x80, 0xcd, 0xf1, 0x79, 0xff, 0x3e, 0x65, 0x19, 0x41, 0x7d, 0xac, 0x7f, 0x77,
+	0xc7, 0x24, 0x63, 0x99, 0xd7, 0x50, 0xa0, 0x7f, 0xa7, 0x9f, 0x65, 0xfd, 0x21, 0xbe, 0x2f, 0xd0,
+	0x67, 0x93, 0xde, 0x7d, 0x3c, 0x1a, 0xb3, 0x6b, 0x79, 0x2a, 0xd8, 0x03, 0x78, 0x16, 0x1e, 0x85,
+	0xf8, 0x9b, 0x09, 0xa6, 0xcc, 0x5b, 0x81, 0x4a, 0x12, 0x77, 0x9c, 0x6d, 0x67, 0xa7, 0x15, 0x56,
+	0x92, 0xd8, 0xbb, 0x09, 0x35, 0xca, 0xe2, 0x24, 0xed, 0x54, 0xb6, 0x9d, 0x9d, 0xa5, 0x50, 0x02,
+	0xc1, 0x18, 0xda, 0xe2, 0x0e, 0x1d, 0x67, 0x29, 0xc5, 0xde, 0x06, 0xd4, 0x29, 0x8b, 0xb3, 0x09,
+	0x13, 0x17, 0x97, 0x42, 0x05, 0x29, 0x3c, 0x26, 0x44, 0xdd, 0x56, 0x90, 0xf7, 0x00, 0x5a, 0xf8,
+	0x2a, 0x61, 0xdd, 0x28, 0x8b, 0x71, 0xc7, 0xdd, 0x76, 0x76, 0xda, 0x7b, 0x37, 0x77, 0xb5, 0xec,
+	0xcf, 0xc2, 0xa3, 0xa3, 0xab, 0x84, 0x1d, 0x64, 0x31, 0x0e, 0x9b, 0x58, 0xad, 0x82, 0x0f, 0xa0,
+	0x6d, 0x6d, 0x78, 0x77, 0x6c, 0x0a, 0x9c, 0x69, 0xcd, 0x3a, 0xfb, 0x0e, 0x2c, 0x1f, 0x10, 0x8c,
+	0x18, 0x9e, 0xa3, 0x54, 0xf0, 0x2b, 0x68, 0x3f, 0xcd, 0xc8, 0xf9, 0x3c, 0x9d, 0xef, 0x40, 0x8b,
+	0x66, 0x13, 0x12, 0xe1, 0x6e, 0x12, 0x0b, 0xc9, 0x5b, 0x61, 0x53, 0x22, 0x8e, 0x63, 0x4e, 0xfc,
+	0x10, 0x0f, 0xf1, 0x7c, 0xe2, 0xef, 0xc1, 0x8d, 0xc3, 0x84, 0xd2, 0x2c, 0x4a, 0x16, 0x48, 0xf0,
+	0x4f, 0x07, 0x96, 0x8f, 0x47, 0xe3, 0x8c, 0xb0, 0x79, 0x42, 0xac, 0x81, 0x3b, 0x21, 0x43, 0xc5,
+	0x9e, 0x2f, 0x3d, 0x1f, 0x9a, 0x13, 0x8a, 0x49, 0x8a, 0x46, 0xd2, 0x68, 0xad, 0x30, 0x87, 0xf9,
+	0xde, 0x18, 0x51, 0x7a, 0x99, 0x91, 0xb8, 0x53, 0x95, 0x7b, 0x1a, 0xf6, 0x7e, 0x06, 0xab, 0x2c,
+	0x19, 0xe1, 0x6c, 0xc2, 0xba, 0x14, 0x47, 0x59, 0x1a, 0xd3, 0x4e, 0x6d, 0xdb, 0xd9, 0x71, 0xc3,
+	0x15, 0x85, 0x3e, 0x95, 0x58, 0x6f, 0x13, 0x9a, 0x23, 0x74, 0xd5, 0xa5, 0xc9, 0xb7, 0xb8, 0x53,
+	0x17, 0x27, 0x1a, 0x23, 0x74, 0x75, 0x9a, 0x7c, 0x8b, 0x83, 0x9f, 0xc3, 0x8a, 0x16, 0x57, 0xf9,
+	0x9c, 0x73, 0x24, 0x59, 0x9f, 0x60, 0x4a, 0x95, 0xd4, 0x39, 0x1c, 0x7c, 0xef, 0xc0, 0xf2, 0x49,
+	0x42, 0x48, 0x46, 0xe6, 0x69, 0xb7, 0x05, 0xad, 0x38, 0x21, 0x38, 0x62, 0x49, 0x96, 0x2a, 0x1d,
+	0x0d, 0x42, 0xeb, 0xee, 0x96, 0xeb, 0x5e, 0x5d, 0xa0, 0x7b, 0xed, 0xd5, 0xba, 0xd7, 0xcb, 0x74,
+	0x0f, 0x32, 0x58, 0x7d, 0x8a, 0x59, 0x34, 0x08, 0x71, 0xef, 0xc7, 0x84, 0x85, 0xf7, 0x13, 0x00,
+	0xb5, 0x49, 0x70, 0x4f, 0x49, 0xae, 0x8e, 0x87, 0xb8, 0xc7, 0x35, 0xe2, 0x78, 0x29, 0x3a, 0x5f,
+	0x06, 0xf7, 0x60, 0xcd, 0x30, 0x54, 0x36, 0xf5, 0xa0, 0x4a, 0x07, 0xe8, 0x81, 0xe2, 0x29, 0xd6,
+	0xc1, 0x31, 0xdc, 0x3a, 0xc5, 0xec, 0x10, 0xd3, 0x88, 0x24, 0x63, 0x6e, 0x9d, 0x79, 0xe2, 0x6d,
+	0x43, 0x3b, 0x36, 0xa7, 0x94, 0x80, 0x36, 0x2a, 0xf8, 0x97, 0x03, 0xab, 0x4f, 0x08, 0x4a, 0xa3,
+	0x01, 0xa6, 0xf3, 0xa8, 0x6c, 0x40, 0x7d, 0x4c, 0x70, 0x2f, 0xb9, 0x52, 0x04, 0x14, 0x24, 0x44,
+	0xcb, 0x08, 0x53, 0x9a, 0x89, 0x35, 0xc7, 0x9d, 0x21, 0xaa, 0x1d, 0x22, 0xd6, 0xbc, 0x5e, 0xa0,
+	0x1e, 0xc3, 0x44, 0x79, 0x42, 0x02, 0xdc, 0x0d, 0x62, 0xd1, 0x8d, 0xb2, 0xd1, 0x28, 0x61, 0x0c,
+	0xc7, 0xda, 0x0d, 0x02, 0x7d, 0xa0, 0xb1, 0xfc, 0xfa, 0x30, 0x19, 0x25, 0xac, 0xd3, 0x10, 0x39,
+	0x2d, 0x81, 0xe0, 0xaf, 0x15, 0x58, 0x91, 0x82, 0xdb, 0xa6, 0x12, 0xc1, 0xa0, 0x4c, 0xc5, 0xd7,
+	0xb9, 0xf9, 0x2a, 0xc6, 0x7c, 0x1c, 0xc7, 0xae, 0xc7, 0x3a, 0x61, 0xc4, 0xda, 0xeb, 0x40, 0x83,
+	0x4e, 0xce, 0xfe, 0x84, 0x23, 0xa6, 0x44, 0xd7, 0x20, 0xd7, 0x1e, 0x4d, 0xd8, 0x20, 0xd3, 0xe2,
+	0x2b, 0xc8, 0x7b, 0x17, 0x96, 0xe4, 0xaa, 0x8b, 0x47, 0x28, 0x19, 0x0a, 0xe1, 0x5b, 0x61, 0x5b,
+	0xe2, 0x8e, 0x38, 0xca, 0x7b, 0x07, 0x14, 0xd8, 0x8d, 0x11, 0xc3, 0x42, 0x7e, 0x37, 0x04, 0x89,
+	0x3a, 0x44, 0x4c, 0x5a, 0x66, 0x80, 0x51, 0xdc, 0x69, 0x4a, 0xd5, 0x04, 0xc0, 0x39, 0x9e, 0xe1,
+	0x41, 0x92, 0xc6, 0x9d, 0x96, 0x40, 0x2b, 0x88, 0x27, 0x88, 0xb1, 0x15, 0x08, 0x62, 0x06, 0x11,
+	0x1c, 0xc0, 0x9a, 0x71, 0xa4, 0xb2, 0xc8, 0x7d, 0xa8, 0x9f, 0x09, 0x5c, 0xc7, 0xd9, 0x76, 0x77,
+	0xda, 0x7b, 0xb7, 0xf3, 0x8a, 0x5a, 0x34, 0x5d, 0xa8, 0x8e, 0x05, 0xbf, 0x85, 0x75, 0x59, 0x26,
+	0xf5, 0x7e, 0x79, 0x44, 0x68, 0x4b, 0x57, 0x2c, 0x4b, 0x8b, 0x70, 0xbe, 0xd0, 0x09, 0x4a, 0xf0,
+	0x45, 0x70, 0x02, 0xeb, 0xb2, 0x2c, 0xbe, 0x39, 0x31, 0xed, 0x36, 0xd7, 0x8a, 0xfa, 0xaf, 0x60,
+	0x3d, 0xc4, 0x7c, 0xf7, 0xcd, 0xc9, 0x6d, 0x42, 0x33, 0xc5, 0x97, 0x5d, 0xab, 0x4c, 0x36, 0x52,
+	0x7c, 0xf9, 0x3b, 0x34, 0xc2, 0xc1, 0xaf, 0xe1, 0xb6, 0xc8, 0xa5, 0x1e, 0x9a, 0x0c, 0xd9, 0x1b,
+	0x53, 0x0e, 0x06, 0xb0, 0x76, 0x9a, 0xf4, 0x53, 0xc4, 0x26, 0x24, 0x2f, 0xec, 0x65, 0x71, 0x78,
+	0x13, 0x6a, 0x32, 0x4c, 0xe4, 0x65, 0x09, 0xf0, 0x93, 0x22, 0x32, 0x5c, 0xe1, 0x4c, 0xb1, 0xe6,
+	0xde, 0xcf, 0x7a, 0x3d, 0x8a, 0x65, 0x20, 0xd6, 0x42, 0x05, 0x05, 0xdf, 0x55, 0x60, 0xe9, 0x04,
+	0x93, 0x3e, 0x5e, 0x90, 0xa6, 0xca, 0xd9, 0x2a, 0x4d, 0x25, 0xc4, 0x99, 0x88, 0x18, 0x53, 0xb6,
+	0xe4, 0xeb, 0xdc, 0xbe, 0x55, 0x2b, 0x2d, 0x7c, 0x68, 0x52, 0x46, 0x10, 0xc3, 0xfd, 0x6b, 0x5d,
+	0x33, 0x35, 0xcc, 0xd3, 0x63, 0x84, 0x29, 0x45, 0x7d, 0xac, 0xe2, 0x5c, 0x83, 0xde, 0x83, 0x3c,
+	0x3d, 0x1a, 0xe2, 0xd1, 0xde, 0xcc, 0x43, 0x6c, 0xda, 0x2e, 0x79, 0xe6, 0x7c, 0x64, 0xe2, 0x98,
+	0x74, 0x9a, 0xaf, 0xba, 0x65, 0xce, 0x06, 0xfb, 0xb0, 0xac, 0x2c, 0x30, 0xbf, 0x38, 0xca, 0x2c,
+	0x49, 0x7b, 0xc3, 0x24, 0x62, 0xb4, 0x53, 0xd9, 0x76, 0x79, 0xd1, 0xcd, 0x11, 0xc1, 0x03, 0x58,
+	0x96, 0x95, 0x65, 0xc1, 0x1b, 0xcb, 0xab, 0x72, 0xc5, 0x54, 0xe5, 0xef, 0x2b, 0xb0, 0xa2, 0xef,
+	0x18, 0xbe, 0xcf, 0x11, 0x1d, 0x68, 0xbe, 0x7c, 0xcd, 0x71, 0x5f, 0x11, 0x9c, 0x47, 0x07, 0x5f,
+	0x73, 0x97, 0xbc, 0x44, 0x04, 0xa7, 0xba, 0x46, 0x2a, 0x88, 0x9b, 0xf3, 0x44, 0x99, 0x53, 0x55,
+	0x1b, 0x05, 0xf2, 0x1b, 0xfb, 0x85, 0x6a, 0x23, 0x21, 0x5e, 0xc9, 0xf7, 0x4d, 0x65, 0xd1, 0xc5,
+	0xc6, 0x42, 0x79, 0x77, 0x01, 0xf6, 0xf3, 0xca, 0xa2, 0x6b, 0x8d, 0xc1, 0x70, 0xbb, 0x1c, 0x14,
+	0xac, 0xde, 0x0a, 0x0d, 0xc2, 0xbb, 0xa7, 0x75, 0x64, 0x58, 0xb1, 0x68, 0x89, 0x23, 0x53, 0x58,
+	0xef, 0xa7, 0xda, 0x7e, 0x0c, 0x4b, 0x46, 0xb2, 0x0e, 0x15, 0x91, 0xc1, 0x1f, 0x34, 0x35, 0xba,
+	0x20, 0x97, 0xc4, 0x3b, 0x51, 0xb1, 0xde, 0x89, 0xb2, 0x40, 0xcd, 0x8b, 0x7f, 0xd5, 0x2e, 0xfe,
+	0x87, 0xb0, 0x9a, 0xd3, 0x57, 0x2e, 0x79, 0x00, 0x0d, 0x19, 0x28, 0x74, 0xa6, 0xd6, 0x15, 0x9d,
+	0x17, 0xea, 0x73, 0xc1, 0x11, 0xb4, 0x0f, 0x93, 0x5e, 0xef, 0x2d, 0x45, 0x0c, 0x7e, 0x70, 0x60,
+	0xed, 0x69, 0x32, 0xc4, 0x92, 0x96, 0x89, 0x90, 0x31, 0x62, 0x79, 0x84, 0xf0, 0x35, 0xaf, 0x42,
+	0xd9, 0x30, 0xee, 0x0a, 0xbc, 0x24, 0xda, 0xc8, 0x86, 0xf1, 0x4b, 0xbe, 0x25, 0xba, 0x62, 0xc4,
+	0x26, 0x54, 0x07, 0x8a, 0x84, 0x44, 0x4e, 0x27, 0x29, 0x22, 0xd7, 0x42, 0xff, 0x66, 0xa8, 0x20,
+	0xee, 0x4c, 0x14, 0xc7, 0x09, 0x7f, 0xc2, 0x65, 0xe7, 0x56, 0x0b, 0x0d, 0x82, 0xef, 0xc6, 0x78,
+	0x88, 0xe5, 0x6e, 0x5d, 0xee, 0xe6, 0x08, 0x6e, 0xd2, 0x31, 0x62, 0xd1, 0x40, 0xc4, 0x48, 0x2b,
+	0x94, 0x40, 0xf0, 0x7b, 0x58, 0x2a, 0x28, 0x70, 0x1f, 0x6a, 0xbd, 0x64, 0x88, 0xb5, 0x35, 0x4d,
+	0x82, 0x4e, 0xab, 0x1a, 0xca, 0x73, 0x9c, 0x29, 0x23, 0x93, 0x34, 0x42, 0xfc, 0x75, 0xaa, 0x08,
+	0x69, 0x0d, 0x22, 0xf8, 0x02, 0xd6, 0x44, 0xea, 0xa2, 0xb3, 0x21, 0x7e, 0x5b, 0x83, 0xff, 0xdd,
+	0x81, 0x1b, 0x16, 0x31, 0x63, 0x71, 0x71, 0xdb, 0x29, 0xb9, 0x5d, 0x31, 0xb7, 0x79, 0x57, 0x36,
+	0xe2, 0x97, 0xbb, 0xe2, 0xb4, 0xea, 0xca, 0x04, 0xe6, 0x09, 0xbf, 0xb2, 0x05, 0xad, 0x91, 0xa6,
+	0xad, 0x8c, 0x6e, 0x10, 0xc5, 0xe2, 0x52, 0x9b, 0x2e, 0x2e, 0x07, 0xd0, 0xe6, 0x69, 0xff, 0xda,
+	0xa5, 0x25, 0x8f, 0x12, 0xd7, 0x44, 0x49, 0xd0, 0x87, 0x1b, 0x9c, 0xc8, 0x51, 0xca, 0xc8, 0xb5,
+	0xad, 0xdc, 0x48, 0x7f, 0xd6, 0xb4, 0x42, 0xb1, 0xce, 0xdb, 0x98, 0x8a, 0xd5, 0xc6, 0xf0, 0xc7,
+	0x43, 0x76, 0x31, 0x2a, 0x8e, 0x24, 0x94, 0x33, 0xaa, 0x5a, 0x8c, 0x5e, 0xc0, 0x92, 0x94, 0x56,
+	0xf1, 0xf8, 0x14, 0xda, 0x4c, 0x31, 0x4e, 0x72, 0xbf, 0xfb, 0xb9, 0xdf, 0x67, 0x84, 0x0a, 0xed,
+	0xe3, 0xc1, 0x19, 0xac, 0xec, 0x93, 0x68, 0x90, 0x5c, 0x2c, 0x56, 0xff, 0xc2, 0xa8, 0x7f, 0xc1,
+	0xa5, 0xed, 0x65, 0x64, 0x84, 0x72, 0x69, 0x25, 0x64, 0x35, 0x9c, 0x55, 0xbb, 0xe1, 0x0c, 0xde,
+	0x87, 0xd5, 0x9c, 0x87, 0x31, 0x4c, 0x8c, 0x18, 0x52, 0x1f, 0x99, 0x62, 0xcd, 0xdd, 0xf0, 0x64,
+	0x98, 0x9d, 0xbd, 0xbe, 0x1c, 0x65, 0x6e, 0xf8, 0x02, 0x96, 0x24, 0x91, 0x05, 0x4f, 0x8d, 0xf6,
+	0x4a, 0xa5, 0xe8, 0x15, 0xf1, 0xb1, 0xa4, 0x9e, 0x74, 0xbe, 0x0e, 0x22, 0x58, 0x0d, 0x31, 0x8a,
+	0x17, 0x09, 0x55, 0xd6, 0xa7, 0x9a, 0x4e, 0x40, 0x12, 0x53, 0x50, 0xb1, 0x26, 0xba, 0xba, 0x26,
+	0xde, 0x83, 0x35, 0xc3, 0x64, 0x81, 0x75, 0x0e, 0xb9, 0x62, 0x68, 0x84, 0xdf, 0xce, 0x3c, 0x3f,
+	0x38, 0xb0, 0xac, 0xc8, 0x2c, 0x30, 0x90, 0xe8, 0xaa, 0x47, 0x23, 0x5e, 0xbf, 0x2a, 0xba, 0xab,
+	0x16, 0xa0, 0xd5, 0x55, 0xbb, 0x0b, 0xbb, 0xea, 0xea, 0x2b, 0xbb, 0xea, 0xda, 0x4c, 0x57, 0xed,
+	0x41, 0x75, 0x98, 0xa4, 0x58, 0x55, 0x3e, 0xb1, 0x96, 0x36, 0x4b, 0x31, 0xed, 0x34, 0x44, 0xd2,
+	0x4a, 0x20, 0xb8, 0x0b, 0x4b, 0xa7, 0x0c, 0xcd, 0x7d, 0xa5, 0x82, 0x4f, 0x61, 0xed, 0x05, 0x4a,
+	0xfb, 0x13, 0x54, 0xec, 0x39, 0xca, 0xba, 0xbb, 0xb3, 0x6b, 0x86, 0xa9, 0xd0, 0xd2, 0x0d, 0x25,
+	0x10, 0xfc, 0xdb, 0x81, 0x65, 0x45, 0xde, 0xdc, 0x15, 0xc1, 0xe1, 0x98, 0xe0, 0xf0, 0xde, 0x83,
+	0xe5, 0x61, 0x96, 0x51, 0xdc, 0x95, 0xa9, 0xaa, 0x69, 0x2c, 0x09, 0xe4, 0x97, 0x12, 0xe7, 0xbd,
+	0x0f, 0x2b, 0x63, 0x14, 0x9d, 0xe3, 0x38, 0x3f, 0x25, 0x43, 0x62, 0x59, 0x62, 0xf5, 0xb1, 0x8e,
+	0x79, 0x04, 0x65, 0x6c, 0x68, 0x90, 0xf7, 0x5c, 0x43, 0xa5, 0x89, 0x2c, 0x5c, 0x76, 0x49, 0x9f,
+	0xd6, 0x31, 0x34, 0x67, 0x83, 0xff, 0x70, 0x25, 0x30, 0x22, 0xd1, 0xe0, 0xf5, 0x03, 0xe6, 0x26,
+	0xd4, 0xbe, 0x99, 0x60, 0x72, 0xad, 0x7c, 0x2b, 0x01, 0xee, 0x72, 0x82, 0xfb, 0xf8, 0x6a, 0xac,
+	0xdf, 0x32, 0x09, 0x71, 0x7f, 0x26, 0xfd, 0x34, 0x23, 0xb8, 0x1b, 0x21, 0x2a, 0xfd, 0xd9, 0x0c,
+	0x41, 0xa2, 0x0e, 0xd4, 0xf7, 0x23, 0x8f, 0x39, 0xfe, 0x94, 0xb9, 0xea, 0xc1, 0x1a, 0xd0, 0xf2,
+	0xcf, 0x42, 0x69, 0x81, 0x94, 0xe1, 0x2b, 0xa6, 0xbe, 0xa9, 0x34, 0x18, 0xfc, 0x19, 0xd6, 0xa5,
+	0x1e, 0x27, 0x88, 0x15, 0x3f, 0x1a, 0x67, 0x1e, 0x6a, 0x1d, 0x40, 0x15, 0x2b, 0x80, 0x78, 0xb5,
+	0xe5, 0x54, 0xf5, 0x47, 0x23, 0xbe, 0x62, 0xf2, 0x43, 0xad, 0x97, 0x11, 0xfe, 0x50, 0xb8, 0xa2,
+	0xe3, 0x16, 0x90, 0xfd, 0xc1, 0xeb, 0xe6, 0x1f, 0xbc, 0xc1, 0x73, 0x58, 0xd1, 0x86, 0x54, 0xbc,
+	0x1f, 0x41, 0x63, 0xc4, 0x85, 0xc9, 0xab, 0xed, 0x96, 0x69, 0x83, 0x67, 0x45, 0x0d, 0xf5, 0xe1,
+	0x80, 0x82, 0x77, 0x9c, 0xc6, 0xf8, 0xaa, 0xe8, 0x97, 0x35, 0x70, 0x93, 0x58, 0x52, 0x6a, 0x85,
+	0x7c, 0x69, 0xfc, 0x50, 0xb1, 0xfd, 0x30, 0x65, 0x6f, 0xb7, 0xcc, 0xde, 0x25, 0x3d, 0xd7, 0x1f,
+	0x15, 0xd3, 0xa2, 0xf9, 0x4a, 0xea, 0x98, 0xd5, 0xdf, 0x14, 0xcd, 0xe9, 0x96, 0x98, 0xb3, 0x6a,
+	0xcc, 0x19, 0xbc, 0x80, 0xf5, 0x82, 0x5a, 0x8a, 0xc5, 0x2f, 0xa7, 0xad, 0x74, 0x27, 0xb7, 0xd2,
+	0xac, 0x40, 0xc6, 0x48, 0xab, 0xb0, 0xfc, 0x72, 0x42, 0xcd, 0x58, 0x23, 0x38, 0x86, 0x1b, 0x21,
+	0xee, 0x7d, 0x3d, 0x8e, 0xc5, 0x0c, 0x4e, 0x11, 0x57, 0x6f, 0xb2, 0x63, 0xde, 0xe4, 0x35, 0x70,
+	0xb3, 0xa1, 0x6e, 0x19, 0xf8, 0x92, 0x63, 0x52, 0x7c, 0xa9, 0xbf, 0x6c, 0x53, 0x7c, 0x19, 0xf4,
+	0x60, 0x89, 0xd3, 0x9e, 0x6b, 0x85, 0x5d, 0xa8, 0x12, 0xdc, 0x93, 0x9f, 0x1f, 0xf6, 0x1b, 0x3a,
+	0xc3, 0x3f, 0x14, 0xe7, 0xc4, 0x83, 0xc7, 0x65, 0x8d, 0x75, 0xa5, 0x97, 0x50, 0xf0, 0x11, 0xac,
+	0x9e, 0xa0, 0x24, 0x65, 0x28, 0x49, 0x17, 0x0c, 0x63, 0x7b, 0x19, 0x89, 0xb0, 0x6a, 0xb9, 0x24,
+	0x10, 0x7c, 0x00, 0x1d, 0x71, 0x11, 0xa7, 0x28, 0x8d, 0xf0, 0xa9, 0x68, 0x26, 0xe7, 0x15, 0xb9,
+	0xff, 0x3a, 0xb0, 0x59, 0x72, 0x58, 0xa9, 0xb6, 0x09, 0xcd, 0x21, 0xa2, 0xac, 0x4b, 0x26, 0xa9,
+	0x2a, 0x5b, 0x0d, 0x0e, 0x87, 0x93, 0x94, 0xd7, 0x6a, 0xb1, 0x45, 0x27, 0x51, 0x84, 0xa9, 0x2e,
+	0x5c, 0x6d, 0x8e, 0x3b, 0x95, 0x28, 0x3e, 0x1f, 0x13, 0x47, 0x7a, 0x34, 0x3a, 0x57, 0xba, 0x09,
+	0x72, 0x4f, 0x69, 0x74, 0xce, 0x3b, 0x31, 0xb1, 0x89, 0xf9, 0x54, 0x50, 0x45, 0x82, 0x38, 0x7e,
+	0xc4, 0x11, 0x5c, 0x33, 0x86, 0xe8, 0xb9, 0xee, 0xb3, 0x24, 0x30, 0x5b, 0x2e, 0xeb, 0x25, 0xe5,
+	0x52, 0x54, 0x8c, 0xe8, 0x9c, 0xaa, 0xcf, 0x20, 0x09, 0xec, 0xfd, 0xad, 0x01, 0x10, 0xe2, 0x71,
+	0x46, 0x13, 0x96, 0x91, 0x6b, 0xef, 0x63, 0xa8, 0xcb, 0x59, 0x87, 0xb7, 0x61, 0x3e, 0x15, 0xec,
+	0x19, 0xb1, 0xbf, 0xb1, 0x2b, 0x87, 0xe4, 0xbb, 0x7a, 0x48, 0xbe, 0x7b, 0xc4, 0x87, 0xe4, 0xde,
+	0x2f, 0xa0, 0xca, 0x67, 0xc5, 0x9e, 0x19, 0x50, 0x5b, 0xa3, 0xe3, 0xb9, 0xb7, 0x3e, 0x86, 0xba,
+	0x1c, 0x87, 0x58, 0xfc, 0x0a, 0x63, 0xe3, 0xb9, 0x37, 0x7f, 0x03, 0x60, 0xc6, 0xc7, 0x9e, 0x09,
+	0xa7, 0x99, 0x99, 0xf2, 0x5c, 0x0a, 0x8f, 0xa1, 0x2e, 0x67, 0xb5, 0x16, 0xef, 0xc2, 0xac, 0xd9,
+	0xbf, 0x3d, 0x83, 0x97, 0x01, 0xf0, 0xa1, 0xc3, 0x05, 0x97, 0x93, 0x5b, 0xeb, 0x72, 0x61, 0x94,
+	0x3b, 0x97, 0xed, 0xe7, 0xd0, 0xd4, 0x03, 0x4d, 0xaf, 0x63, 0x8c, 0x55, 0x1c, 0xaa, 0xfa, 0x9b,
+	0x25, 0x3b, 0x2a, 0xfa, 0x8e, 0x61, 0xb5, 0x38, 0xe9, 0xa4, 0xde, 0x5d, 0xab, 0x46, 0x96, 0xcc,
+	0x40, 0xe7, 0xca, 0xf2, 0x50, 0x7e, 0x9f, 0x5b, 0x4e, 0xb3, 0x7a, 0x75, 0xff, 0xd6, 0x14, 0x56,
+	0xf1, 0xff, 0x0c, 0x1a, 0xaa, 0xe3, 0xf4, 0x8c, 0x81, 0x8a, 0x7d, 0xae, 0xdf, 0x99, 0xdd, 0xc8,
+	0x4d, 0xf7, 0x10, 0xaa, 0xbc, 0x21, 0xb3, 0x98, 0x5a, 0x4d, 0xa0, 0x7f, 0x6b, 0x0a, 0xab, 0x98,
+	0xee, 0x43, 0x53, 0x77, 0x72, 0x96, 0xd5, 0xa6, 0x3a, 0x48, 0x7f, 0xb3, 0x64, 0xc7, 0x72, 0x59,
+	0x4d, 0x74, 0x67, 0x9e, 0xcd, 0xc2, 0x34, 0x7d, 0xfe, 0xc6, 0x34, 0x3a, 0xbf, 0xf9, 0x09, 0xd4,
+	0x65, 0xfd, 0xb5, 0x9c, 0x5d, 0x78, 0x67, 0xfc, 0xdb, 0x33, 0xf8, 0xfc, 0x39, 0xab, 0x89, 0x76,
+	0xc7, 0x62, 0x6a, 0x77, 0x57, 0xfe, 0xc6, 0x34, 0x5a, 0xde, 0xdb, 0xfb, 0x8b, 0x0b, 0x75, 0x39,
+	0x79, 0xf3, 0x1e, 0x43, 0xf5, 0x45, 0x42, 0x99, 0xa5, 0xf6, 0xd4, 0x70, 0xda, 0xdf, 0x2c, 0xd9,
+	0x51, 0xfc, 0x3f, 0xcf, 0x13, 0x7a, 0x6b, 0x2a, 0xa1, 0x0b, 0x73, 0x3d, 0x7f, 0xde, 0x14, 0xd4,
+	0xfb, 0x2c, 0xcf, 0xd0, 0xad, 0xa9, 0x0c, 0x2d, 0x12, 0x98, 0x1f, 0xee, 0x75, 0x39, 0xa1, 0xb4,
+	0xee, 0x97, 0x8c, 0x2c, 0xe7, 0x0b, 0xf0, 0x08, 0x6a, 0xe2, 0xc3, 0xd6, 0xb2, 0xa0, 0x3d, 0xf2,
+	0xf3, 0x37, 0xa6, 0xd1, 0xea, 0xde, 0x53, 0x00, 0x33, 0xc4, 0xf4, 0xb6, 0x8b, 0x19, 0x32, 0x3b,
+	0xd9, 0x9c, 0xa7, 0xc0, 0xde, 0x0b, 0xa8, 0x89, 0x27, 0xd5, 0x3b, 0xc8, 0xa3, 0x60, 0xea, 0xb1,
+	0x2d, 0x86, 0xc2, 0x56, 0xf9, 0xa6, 0xf2, 0xeb, 0xff, 0x1c, 0xa8, 0xcb, 0xd9, 0x8b, 0xf7, 0x08,
+	0xdc, 0x67, 0xd8, 0x2e, 0x3e, 0x85, 0x21, 0x9c, 0x3f, 0x6f, 0x56, 0xe3, 0x7d, 0xa2, 0xe2, 0x61,
+	0xfa, 0x00, 0x9d, 0x4d, 0xbe, 0xe9, 0x81, 0xd0, 0x43, 0xa8, 0xf2, 0x31, 0x85, 0x95, 0x7a, 0xd6,
+	0xb0, 0xc7, 0xbf, 0x35, 0x85, 0x55, 0x97, 0x9e, 0x40, 0x2b, 0x9f, 0x2c, 0x78, 0x9b, 0x45, 0x6b,
+	0x5b, 0xa3, 0x0b, 0xdf, 0x2f, 0xdb, 0x52, 0x6a, 0x7f, 0xe7, 0x80, 0x7b, 0x7a, 0xfa, 0xdc, 0x7b,
+	0x0c, 0xf0, 0xf5, 0x78, 0x98, 0xa1, 0xf8, 0x25, 0x8a, 0xce, 0xbd, 0x75, 0xfb, 0xcf, 0x4c, 0x4d,
+	0xe6, 0x66, 0x11, 0x29, 0x09, 0xec, 0x38, 0x1f, 0x3a, 0xfc, 0x63, 0x3c, 0xc4, 0x11, 0x4e, 0x2e,
+	0xf0, 0x8f, 0xb8, 0xbd, 0xf7, 0x0f, 0x07, 0xda, 0xd6, 0x93, 0xee, 0x3d, 0x87, 0xa6, 0xee, 0x23,
+	0xac, 0xd4, 0x9a, 0x6a, 0x2d, 0xfc, 0xa0, 0xb8, 0x53, 0xda, 0x0e, 0x7c, 0x09, 0x75, 0x89, 0xf1,
+	0xde, 0x5d, 0x74, 0xfa, 0xb5, 0x09, 0xee, 0x1d, 0x40, 0xfd, 0xe8, 0x02, 0xa7, 0x8c, 0xf2, 0xca,
+	0x23, 0x1b, 0x36, 0x2b, 0x4c, 0x0a, 0x1d, 0x9c, 0x7f, 0xab, 0x80, 0x37, 0x45, 0xeb, 0xac, 0x2e,
+	0xe2, 0xf8, 0xe1, 0xff, 0x07, 0x00, 0xc5, 0x41, 0x52, 0xe2, 0xf9, 0x1e, 0x00, 0x00,
 }
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS users@users_updated_at_idx;
+DROP INDEX IF EXISTS users@users_name_idx;
+
+DROP INDEX IF EXISTS repositories@repositories_updated_at_idx;
+DROP INDEX IF EXISTS repositories@repositories_name_idx;
@@ -0,0 +1,5 @@
+CREATE INDEX repositories_name_idx ON repositories (name);
+CREATE INDEX repositories_updated_at_idx ON repositories (updated_at);
+
+CREATE INDEX users_name_idx ON users (name);
+CREATE INDEX users_updated_at_idx ON users (updated_at);
@@ -0,0 +1,3 @@
+DROP TABLE pull_request_comments;
+DROP TABLE pull_requests;
+ALTER TABLE repositories DROP COLUMN last_number;
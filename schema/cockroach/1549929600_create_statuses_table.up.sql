@@ -0,0 +1,20 @@
+CREATE TABLE statuses (
+  id            UUID PRIMARY KEY                              DEFAULT gen_random_uuid(),
+  repository_id UUID REFERENCES repositories ON DELETE CASCADE NOT NULL,
+  sha1          TEXT         NOT NULL,
+  context       TEXT         NOT NULL                         DEFAULT 'default',
+  state         TEXT         NOT NULL,
+  target_url    TEXT         NOT NULL                         DEFAULT '',
+  description   TEXT         NOT NULL                         DEFAULT '',
+  creator_id    UUID REFERENCES users NOT NULL,
+  created_at    TIMESTAMPTZ  NOT NULL                         DEFAULT now()
+);
+
+CREATE INDEX statuses_repository_id_sha1_idx ON statuses (repository_id, sha1, context, created_at);
+
+CREATE TABLE branch_required_checks (
+  repository_id UUID REFERENCES repositories ON DELETE CASCADE NOT NULL,
+  branch        TEXT NOT NULL,
+  context       TEXT NOT NULL,
+  PRIMARY KEY (repository_id, branch, context)
+);
@@ -0,0 +1,15 @@
+CREATE TABLE events (
+  id            UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+  type          TEXT        NOT NULL,
+  actor_id      UUID,
+  actor         TEXT        NOT NULL DEFAULT '',
+  repository_id UUID,
+  repository    TEXT        NOT NULL DEFAULT '',
+  public        BOOLEAN     NOT NULL DEFAULT FALSE,
+  data          JSONB       NOT NULL DEFAULT '{}',
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX events_created_at_idx ON events (created_at, id);
+CREATE INDEX events_actor_id_idx ON events (actor_id, created_at);
+CREATE INDEX events_repository_id_idx ON events (repository_id, created_at);
@@ -0,0 +1,9 @@
+CREATE TABLE repository_redirects (
+  owner_id      UUID REFERENCES users ON DELETE CASCADE NOT NULL,
+  name          TEXT        NOT NULL,
+  repository_id UUID REFERENCES repositories ON DELETE CASCADE NOT NULL,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (owner_id, name)
+);
+
+CREATE INDEX repository_redirects_repository_id_idx ON repository_redirects (repository_id);
@@ -0,0 +1,16 @@
+CREATE TABLE mirrors (
+  id               UUID PRIMARY KEY                                DEFAULT gen_random_uuid(),
+  repository_id    UUID REFERENCES repositories ON DELETE CASCADE NOT NULL,
+  direction        TEXT        NOT NULL,
+  url              TEXT        NOT NULL,
+  credentials      TEXT        NOT NULL                            DEFAULT '',
+  interval_seconds INT         NOT NULL,
+  next_sync_at     TIMESTAMPTZ NOT NULL                            DEFAULT now(),
+  last_success_at  TIMESTAMPTZ,
+  last_failure_at  TIMESTAMPTZ,
+  last_error       TEXT        NOT NULL                            DEFAULT '',
+  created_at       TIMESTAMPTZ NOT NULL                            DEFAULT now()
+);
+
+CREATE INDEX mirrors_repository_id_idx ON mirrors (repository_id);
+CREATE INDEX mirrors_next_sync_at_idx ON mirrors (next_sync_at);
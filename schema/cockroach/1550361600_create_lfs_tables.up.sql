@@ -0,0 +1,17 @@
+CREATE TABLE lfs_objects (
+  repository_id UUID REFERENCES repositories ON DELETE CASCADE NOT NULL,
+  oid           TEXT        NOT NULL,
+  size          BIGINT      NOT NULL,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (repository_id, oid)
+);
+
+CREATE TABLE lfs_locks (
+  id            UUID PRIMARY KEY                                DEFAULT gen_random_uuid(),
+  repository_id UUID REFERENCES repositories ON DELETE CASCADE NOT NULL,
+  path          TEXT        NOT NULL,
+  owner_id      UUID REFERENCES users ON DELETE CASCADE        NOT NULL,
+  created_at    TIMESTAMPTZ NOT NULL                            DEFAULT now()
+);
+
+ALTER TABLE lfs_locks ADD UNIQUE (repository_id, path);
@@ -0,0 +1,17 @@
+CREATE TABLE stars (
+  user_id       UUID REFERENCES users ON DELETE CASCADE        NOT NULL,
+  repository_id UUID REFERENCES repositories ON DELETE CASCADE NOT NULL,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, repository_id)
+);
+
+CREATE INDEX stars_repository_id_idx ON stars (repository_id);
+
+CREATE TABLE watches (
+  user_id       UUID REFERENCES users ON DELETE CASCADE        NOT NULL,
+  repository_id UUID REFERENCES repositories ON DELETE CASCADE NOT NULL,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, repository_id)
+);
+
+CREATE INDEX watches_repository_id_idx ON watches (repository_id);
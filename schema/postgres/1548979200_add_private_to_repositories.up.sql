@@ -0,0 +1 @@
+ALTER TABLE repositories ADD COLUMN private BOOLEAN NOT NULL DEFAULT false;
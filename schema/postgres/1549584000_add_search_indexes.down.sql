@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS users_search_idx;
+DROP INDEX IF EXISTS users_updated_at_idx;
+DROP INDEX IF EXISTS users_name_idx;
+
+DROP INDEX IF EXISTS repositories_search_idx;
+DROP INDEX IF EXISTS repositories_updated_at_idx;
+DROP INDEX IF EXISTS repositories_name_idx;
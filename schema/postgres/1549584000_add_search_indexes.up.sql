@@ -0,0 +1,9 @@
+CREATE INDEX repositories_name_idx ON repositories (name);
+CREATE INDEX repositories_updated_at_idx ON repositories (updated_at);
+CREATE INDEX repositories_search_idx ON repositories
+  USING gin (to_tsvector('simple', name || ' ' || coalesce(description, '')));
+
+CREATE INDEX users_name_idx ON users (name);
+CREATE INDEX users_updated_at_idx ON users (updated_at);
+CREATE INDEX users_search_idx ON users
+  USING gin (to_tsvector('simple', username || ' ' || name));
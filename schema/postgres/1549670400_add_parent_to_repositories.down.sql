@@ -0,0 +1,3 @@
+ALTER TABLE repositories DROP CONSTRAINT repositories_parent_id_fkey;
+DROP INDEX IF EXISTS repositories_parent_id_idx;
+ALTER TABLE repositories DROP COLUMN parent_id;
@@ -0,0 +1,3 @@
+ALTER TABLE repositories ADD COLUMN parent_id UUID;
+CREATE INDEX repositories_parent_id_idx ON repositories (parent_id);
+ALTER TABLE repositories ADD CONSTRAINT repositories_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES repositories ON DELETE SET NULL;
@@ -0,0 +1,36 @@
+ALTER TABLE repositories ADD COLUMN last_number INT NOT NULL DEFAULT 0;
+
+CREATE TABLE pull_requests (
+  id                   UUID PRIMARY KEY                              DEFAULT gen_random_uuid(),
+  repository_id        UUID REFERENCES repositories ON DELETE CASCADE NOT NULL,
+  number               INT          NOT NULL,
+  title                TEXT         NOT NULL,
+  body                 TEXT         NOT NULL                         DEFAULT '',
+  state                TEXT         NOT NULL                         DEFAULT 'open',
+  target_branch        TEXT         NOT NULL,
+  source_repository_id UUID REFERENCES repositories ON DELETE SET NULL,
+  source_branch        TEXT         NOT NULL,
+  head                 TEXT         NOT NULL                         DEFAULT '',
+  base                 TEXT         NOT NULL                         DEFAULT '',
+  author_id            UUID REFERENCES users NOT NULL,
+  created_at           TIMESTAMPTZ  NOT NULL                         DEFAULT now(),
+  updated_at           TIMESTAMPTZ  NOT NULL                         DEFAULT now(),
+  closed_at            TIMESTAMPTZ
+);
+
+ALTER TABLE pull_requests ADD UNIQUE (repository_id, number);
+CREATE INDEX pull_requests_source_repository_id_idx ON pull_requests (source_repository_id);
+
+CREATE TABLE pull_request_comments (
+  id              UUID PRIMARY KEY                               DEFAULT gen_random_uuid(),
+  pull_request_id UUID REFERENCES pull_requests ON DELETE CASCADE NOT NULL,
+  author_id       UUID REFERENCES users NOT NULL,
+  body            TEXT         NOT NULL,
+  path            TEXT,
+  line            INT,
+  commit_sha1     TEXT,
+  created_at      TIMESTAMPTZ  NOT NULL                          DEFAULT now(),
+  updated_at      TIMESTAMPTZ  NOT NULL                          DEFAULT now()
+);
+
+CREATE INDEX pull_request_comments_pull_request_id_idx ON pull_request_comments (pull_request_id, created_at);
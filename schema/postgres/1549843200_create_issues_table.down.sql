@@ -0,0 +1,6 @@
+DROP TABLE issue_comments;
+DROP TABLE issue_assignees;
+DROP TABLE issue_labels;
+DROP TABLE issues;
+DROP TABLE labels;
+DROP TABLE milestones;
@@ -0,0 +1,66 @@
+CREATE TABLE milestones (
+  id            UUID PRIMARY KEY                              DEFAULT gen_random_uuid(),
+  repository_id UUID REFERENCES repositories ON DELETE CASCADE NOT NULL,
+  title         TEXT         NOT NULL,
+  description   TEXT         NOT NULL                         DEFAULT '',
+  state         TEXT         NOT NULL                         DEFAULT 'open',
+  due_on        TIMESTAMPTZ,
+  created_at    TIMESTAMPTZ  NOT NULL                         DEFAULT now(),
+  updated_at    TIMESTAMPTZ  NOT NULL                         DEFAULT now()
+);
+
+ALTER TABLE milestones ADD UNIQUE (repository_id, title);
+
+CREATE TABLE labels (
+  id            UUID PRIMARY KEY                              DEFAULT gen_random_uuid(),
+  repository_id UUID REFERENCES repositories ON DELETE CASCADE NOT NULL,
+  name          TEXT         NOT NULL,
+  color         TEXT         NOT NULL,
+  description   TEXT         NOT NULL                         DEFAULT ''
+);
+
+ALTER TABLE labels ADD UNIQUE (repository_id, name);
+
+CREATE TABLE issues (
+  id            UUID PRIMARY KEY                              DEFAULT gen_random_uuid(),
+  repository_id UUID REFERENCES repositories ON DELETE CASCADE NOT NULL,
+  number        INT          NOT NULL,
+  title         TEXT         NOT NULL,
+  body          TEXT         NOT NULL                         DEFAULT '',
+  state         TEXT         NOT NULL                         DEFAULT 'open',
+  author_id     UUID REFERENCES users NOT NULL,
+  milestone_id  UUID REFERENCES milestones ON DELETE SET NULL,
+  created_at    TIMESTAMPTZ  NOT NULL                         DEFAULT now(),
+  updated_at    TIMESTAMPTZ  NOT NULL                         DEFAULT now(),
+  closed_at     TIMESTAMPTZ
+);
+
+ALTER TABLE issues ADD UNIQUE (repository_id, number);
+CREATE INDEX issues_milestone_id_idx ON issues (milestone_id);
+
+CREATE TABLE issue_labels (
+  issue_id UUID REFERENCES issues ON DELETE CASCADE NOT NULL,
+  label_id UUID REFERENCES labels ON DELETE CASCADE NOT NULL,
+  PRIMARY KEY (issue_id, label_id)
+);
+
+CREATE INDEX issue_labels_label_id_idx ON issue_labels (label_id);
+
+CREATE TABLE issue_assignees (
+  issue_id UUID REFERENCES issues ON DELETE CASCADE NOT NULL,
+  user_id  UUID REFERENCES users ON DELETE CASCADE NOT NULL,
+  PRIMARY KEY (issue_id, user_id)
+);
+
+CREATE INDEX issue_assignees_user_id_idx ON issue_assignees (user_id);
+
+CREATE TABLE issue_comments (
+  id         UUID PRIMARY KEY                        DEFAULT gen_random_uuid(),
+  issue_id   UUID REFERENCES issues ON DELETE CASCADE NOT NULL,
+  author_id  UUID REFERENCES users NOT NULL,
+  body       TEXT         NOT NULL,
+  created_at TIMESTAMPTZ  NOT NULL                   DEFAULT now(),
+  updated_at TIMESTAMPTZ  NOT NULL                   DEFAULT now()
+);
+
+CREATE INDEX issue_comments_issue_id_idx ON issue_comments (issue_id, created_at);
@@ -0,0 +1,2 @@
+DROP TABLE branch_required_checks;
+DROP TABLE statuses;
@@ -0,0 +1,13 @@
+CREATE TABLE imports (
+  id            UUID PRIMARY KEY                               DEFAULT gen_random_uuid(),
+  repository_id UUID REFERENCES repositories ON DELETE SET NULL,
+  name          TEXT        NOT NULL,
+  url           TEXT        NOT NULL,
+  state         TEXT        NOT NULL,
+  progress      TEXT        NOT NULL                           DEFAULT '',
+  error         TEXT        NOT NULL                           DEFAULT '',
+  creator_id    UUID REFERENCES users ON DELETE CASCADE NOT NULL,
+  created_at    TIMESTAMPTZ NOT NULL                           DEFAULT now(),
+  updated_at    TIMESTAMPTZ NOT NULL                           DEFAULT now(),
+  finished_at   TIMESTAMPTZ
+);
@@ -0,0 +1,24 @@
+CREATE TABLE notifications (
+  id         UUID PRIMARY KEY     DEFAULT gen_random_uuid(),
+  user_id    UUID REFERENCES users ON DELETE CASCADE  NOT NULL,
+  event_id   UUID REFERENCES events ON DELETE CASCADE NOT NULL,
+  reason     TEXT        NOT NULL,
+  web        BOOLEAN     NOT NULL,
+  email      BOOLEAN     NOT NULL,
+  read_at    TIMESTAMPTZ,
+  emailed_at TIMESTAMPTZ,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  UNIQUE (user_id, event_id)
+);
+
+CREATE INDEX notifications_user_id_idx ON notifications (user_id, created_at, id);
+CREATE INDEX notifications_email_idx ON notifications (email, emailed_at, read_at);
+
+CREATE TABLE notification_preferences (
+  user_id    UUID REFERENCES users ON DELETE CASCADE NOT NULL,
+  reason     TEXT        NOT NULL,
+  web        BOOLEAN     NOT NULL,
+  email      BOOLEAN     NOT NULL,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (user_id, reason)
+);
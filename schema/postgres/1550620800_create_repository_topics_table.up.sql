@@ -0,0 +1,7 @@
+CREATE TABLE repository_topics (
+  repository_id UUID REFERENCES repositories ON DELETE CASCADE NOT NULL,
+  topic         TEXT NOT NULL,
+  PRIMARY KEY (repository_id, topic)
+);
+
+CREATE INDEX repository_topics_topic_idx ON repository_topics (topic);